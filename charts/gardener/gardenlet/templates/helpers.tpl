@@ -325,6 +325,9 @@ server:
     {{- end }}
     port: {{ required ".Values.config.server.metrics.port is required" .Values.config.server.metrics.port }}
   {{- end }}
+  {{- if .Values.config.server.enableDebugHandlers }}
+  enableDebugHandlers: {{ .Values.config.server.enableDebugHandlers }}
+  {{- end }}
 {{- if .Values.config.debugging }}
 debugging:
   enableProfiling: {{ .Values.config.debugging.enableProfiling | default false }}
@@ -362,6 +365,14 @@ exposureClassHandlers:
 nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 2 }}
 {{- end}}
+{{- if .Values.config.tracing }}
+tracing:
+{{ toYaml .Values.config.tracing | indent 2 }}
+{{- end }}
+{{- if .Values.config.oci }}
+oci:
+{{ toYaml .Values.config.oci | indent 2 }}
+{{- end }}
 {{- end -}}
 
 {{- define "gardenlet.config.name" -}}
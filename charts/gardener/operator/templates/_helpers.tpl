@@ -75,6 +75,9 @@ config.yaml: |
     metrics:
       bindAddress: {{ .Values.config.server.metrics.bindAddress }}
       port: {{ .Values.config.server.metrics.port }}
+    {{- if .Values.config.server.enableDebugHandlers }}
+    enableDebugHandlers: {{ .Values.config.server.enableDebugHandlers }}
+    {{- end }}
   {{- if .Values.config.debugging }}
   debugging:
     enableProfiling: {{ .Values.config.debugging.enableProfiling }}
@@ -148,6 +151,14 @@ config.yaml: |
   nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 4 }}
   {{- end }}
+  {{- if .Values.config.tracing }}
+  tracing:
+{{ toYaml .Values.config.tracing | indent 4 }}
+  {{- end }}
+  {{- if .Values.config.oci }}
+  oci:
+{{ toYaml .Values.config.oci | indent 4 }}
+  {{- end }}
 {{- end -}}
 
 {{- define "operator.config.name" -}}
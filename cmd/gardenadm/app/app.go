@@ -14,9 +14,12 @@ import (
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/bootstrap"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/connect"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/discover"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/etcd"
 	initcmd "github.com/gardener/gardener/pkg/gardenadm/cmd/init"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/join"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/reset"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/token"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/version"
 )
 
@@ -67,6 +70,9 @@ func prepareClusterBootstrapGroup(cmd *cobra.Command, opts *cmd.Options) {
 	for _, subcommand := range []*cobra.Command{
 		initcmd.NewCommand(opts),
 		join.NewCommand(opts),
+		reset.NewCommand(opts),
+		upgrade.NewCommand(opts),
+		etcd.NewCommand(opts),
 		bootstrap.NewCommand(opts),
 		token.NewCommand(opts),
 	} {
@@ -7,6 +7,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
 	"os"
@@ -39,10 +40,14 @@ import (
 	"github.com/gardener/gardener/pkg/features"
 	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
 	operatorconfigv1alpha1 "github.com/gardener/gardener/pkg/operator/apis/config/v1alpha1"
+	operatorhelper "github.com/gardener/gardener/pkg/operator/apis/config/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/operator/bootstrappers"
 	operatorclient "github.com/gardener/gardener/pkg/operator/client"
 	"github.com/gardener/gardener/pkg/operator/controller"
 	"github.com/gardener/gardener/pkg/operator/webhook"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/oci"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 // Name is a const for the name of this component.
@@ -86,13 +91,52 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *o
 		return err
 	}
 
-	var extraHandlers map[string]http.Handler
+	extraHandlers := make(map[string]http.Handler)
 	if cfg.Debugging != nil && ptr.Deref(cfg.Debugging.EnableProfiling, false) {
-		extraHandlers = routes.ProfilingHandlers
+		maps.Copy(extraHandlers, routes.ProfilingHandlers)
 		if ptr.Deref(cfg.Debugging.EnableContentionProfiling, false) {
 			goruntime.SetBlockProfileRate(1)
 		}
 	}
+	if ptr.Deref(cfg.Server.EnableDebugHandlers, false) {
+		log.Info("Registering flow debug handler", "path", flow.DebugHandlerPath)
+		extraHandlers[flow.DebugHandlerPath] = flow.NewDebugHandler(flow.DefaultExecutionRecorder)
+	}
+
+	if cfg.Tracing != nil {
+		log.Info("Setting up tracing", "endpoint", cfg.Tracing.Endpoint)
+		shutdownTracerProvider, err := tracing.SetupGlobalTracerProvider(ctx, Name, cfg.Tracing.Endpoint, ptr.Deref(cfg.Tracing.Insecure, false))
+		if err != nil {
+			return fmt.Errorf("failed setting up tracing: %w", err)
+		}
+
+		defer func() {
+			// the given context is already canceled when the manager has stopped, hence use a new one for flushing the
+			// remaining spans
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			if err := shutdownTracerProvider(shutdownCtx); err != nil {
+				log.Error(err, "Failed shutting down tracer provider")
+			}
+		}()
+	}
+
+	helmRegistryOptions, err := operatorhelper.NewHelmRegistryOptions(cfg.OCI)
+	if err != nil {
+		return err
+	}
+	if len(helmRegistryOptions.Mirrors) > 0 {
+		log.Info("Using registry mirrors for container images and OCI artifacts", "mirrors", helmRegistryOptions.Mirrors)
+	}
+
+	caKeyBackend, err := operatorhelper.NewCAKeyBackend(cfg.CAKeyBackend)
+	if err != nil {
+		return fmt.Errorf("failed configuring key backend for private keys of CAs: %w", err)
+	}
+	if caKeyBackend != nil {
+		log.Info("Using key backend for private keys of CAs", "backend", caKeyBackend.Name())
+	}
 
 	log.Info("Setting up manager")
 	mgr, err := manager.New(restConfig, manager.Options{
@@ -200,7 +244,7 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *o
 	}
 
 	log.Info("Adding controllers to manager")
-	if err := controller.AddToManager(cancel, mgr, cfg, gardenClientMap); err != nil {
+	if err := controller.AddToManager(cancel, mgr, cfg, gardenClientMap, oci.NewHelmRegistry(mgr.GetClient(), helmRegistryOptions), caKeyBackend); err != nil {
 		return fmt.Errorf("failed adding controllers to manager: %w", err)
 	}
 
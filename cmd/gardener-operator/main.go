@@ -9,16 +9,20 @@ import (
 	"os"
 
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	"github.com/gardener/gardener/cmd/gardener-operator/app"
 	"github.com/gardener/gardener/cmd/utils"
 	"github.com/gardener/gardener/pkg/operator/features"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
 func main() {
 	utils.DeduplicateWarnings()
 	features.RegisterFeatureGates()
 
+	secretsmanager.RegisterMetrics(runtimemetrics.Registry)
+
 	if err := app.NewCommand().ExecuteContext(signals.SetupSignalHandler()); err != nil {
 		fmt.Println(err)
 		os.Exit(1)
@@ -9,14 +9,18 @@ import (
 	"os"
 
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	"github.com/gardener/gardener/cmd/gardener-resource-manager/app"
 	"github.com/gardener/gardener/cmd/utils"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/managedresource"
 )
 
 func main() {
 	utils.DeduplicateWarnings()
 
+	managedresource.RegisterMetrics(runtimemetrics.Registry)
+
 	if err := app.NewCommand().ExecuteContext(signals.SetupSignalHandler()); err != nil {
 		fmt.Println(err)
 		os.Exit(1)
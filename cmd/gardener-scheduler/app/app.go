@@ -64,6 +64,8 @@ func NewCommand() *cobra.Command {
 	verflag.AddFlags(flags)
 	opts.addFlags(flags)
 
+	cmd.AddCommand(newExplainCommand())
+
 	return cmd
 }
 
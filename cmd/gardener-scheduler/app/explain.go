@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/printers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+)
+
+const (
+	outputFormatTable = "table"
+	outputFormatJSON  = "json"
+	outputFormatYAML  = "yaml"
+)
+
+var outputFormats = []string{outputFormatTable, outputFormatJSON, outputFormatYAML}
+
+type explainOptions struct {
+	options
+
+	shootFile string
+	output    string
+
+	shoot *gardencorev1beta1.Shoot
+	out   io.Writer
+}
+
+func (o *explainOptions) addFlags(fs *pflag.FlagSet) {
+	o.options.addFlags(fs)
+	fs.StringVar(&o.shootFile, "shoot", o.shootFile, "Path to the Shoot manifest to explain the scheduling decision for ('-' reads from stdin).")
+	fs.StringVarP(&o.output, "output", "o", outputFormatTable, fmt.Sprintf("Output format, one of %v.", outputFormats))
+}
+
+func (o *explainOptions) Complete() error {
+	if err := o.options.Complete(); err != nil {
+		return err
+	}
+
+	if len(o.shootFile) == 0 {
+		return fmt.Errorf("missing shoot manifest")
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+	if o.shootFile == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(o.shootFile)
+	}
+	if err != nil {
+		return fmt.Errorf("error reading shoot manifest: %w", err)
+	}
+
+	o.shoot = &gardencorev1beta1.Shoot{}
+	if err := runtime.DecodeInto(kubernetes.GardenCodec.UniversalDeserializer(), data, o.shoot); err != nil {
+		return fmt.Errorf("error decoding shoot manifest: %w", err)
+	}
+	return nil
+}
+
+func (o *explainOptions) Validate() error {
+	if err := o.options.Validate(); err != nil {
+		return err
+	}
+
+	if o.config.Schedulers.Shoot == nil {
+		return fmt.Errorf("shoot scheduler is not configured")
+	}
+	if !slices.Contains(outputFormats, o.output) {
+		return fmt.Errorf("unsupported output format %q, valid formats are: %v", o.output, outputFormats)
+	}
+	return nil
+}
+
+// newExplainCommand creates a new cobra.Command for explaining the scheduling decision for a shoot.
+func newExplainCommand() *cobra.Command {
+	opts := &explainOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "explain",
+		Short: "Explain the scheduling decision for a Shoot without scheduling it",
+		Long: `The "explain" command evaluates the filters and scores of the scheduler for the given Shoot manifest against the
+seeds of the garden cluster. It reports for each seed which filter rejected it or which rank and score it got.
+Neither the Shoot nor any other object is created or modified.`,
+		Example: `# Explain on which seed the given Shoot would be scheduled:
+gardener-scheduler explain --config config.yaml --shoot shoot.yaml
+
+# Explain the scheduling decision for an existing Shoot:
+kubectl get shoot -n garden-dev my-shoot -o yaml | gardener-scheduler explain --config config.yaml --shoot - -o yaml`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			logLevel, logFormat := opts.LogConfig()
+			log, err := logger.NewZapLogger(logLevel, logFormat)
+			if err != nil {
+				return fmt.Errorf("error instantiating zap logger: %w", err)
+			}
+
+			// don't output usage on further errors raised during execution
+			cmd.SilenceUsage = true
+
+			opts.out = cmd.OutOrStdout()
+			return explain(cmd.Context(), log, opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func explain(ctx context.Context, log logr.Logger, opts *explainOptions) error {
+	if kubeconfig := os.Getenv("KUBECONFIG"); kubeconfig != "" {
+		opts.config.ClientConnection.Kubeconfig = kubeconfig
+	}
+
+	restCfg, err := kubernetes.RESTConfigFromClientConnectionConfiguration(&opts.config.ClientConnection, nil, kubernetes.AuthTokenFile)
+	if err != nil {
+		return err
+	}
+
+	c, err := client.New(restCfg, client.Options{Scheme: kubernetes.GardenScheme})
+	if err != nil {
+		return fmt.Errorf("failed creating client: %w", err)
+	}
+
+	// The reconciler is only used for reading, hence, the client is wrapped to make sure that nothing is mutated.
+	scheduler := &shoot.Reconciler{
+		Client: client.NewDryRunClient(c),
+		Config: opts.config.Schedulers.Shoot,
+	}
+
+	explanation, err := scheduler.Explain(ctx, log, opts.shoot)
+	if err != nil {
+		return fmt.Errorf("failed explaining scheduling decision for shoot: %w", err)
+	}
+
+	return printExplanation(opts.out, opts.output, explanation)
+}
+
+func printExplanation(out io.Writer, format string, explanation *shoot.Explanation) error {
+	switch format {
+	case outputFormatJSON:
+		data, err := json.MarshalIndent(explanation, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+
+	case outputFormatYAML:
+		data, err := yaml.Marshal(explanation)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(data)
+		return err
+	}
+
+	if explanation.SeedName == "" {
+		fmt.Fprintln(out, "No seed is eligible for scheduling the shoot.")
+	} else {
+		fmt.Fprintf(out, "Shoot would be scheduled onto seed %q (strategy %q).\n", explanation.SeedName, explanation.Strategy)
+	}
+	fmt.Fprintln(out)
+
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "SEED", Type: "string", Format: "name", Description: "Name of the seed"},
+			{Name: "RANK", Type: "string", Description: "Rank of the seed among the eligible seeds"},
+			{Name: "SCORE", Type: "string", Description: "Total score of the seed"},
+			{Name: "SHOOTS", Type: "integer", Description: "Number of shoots scheduled onto the seed"},
+			{Name: "DETAILS", Type: "string", Description: "Scores of the score plugins or the reason why the seed was rejected"},
+		},
+		Rows: make([]metav1.TableRow, 0, len(explanation.Seeds)),
+	}
+
+	for _, seed := range explanation.Seeds {
+		var rank, score, details string
+		if seed.Filter != "" {
+			rank, score, details = "-", "-", fmt.Sprintf("rejected by %s: %s", seed.Filter, seed.Reason)
+		} else {
+			rank, score, details = fmt.Sprint(seed.Rank), fmt.Sprint(seed.Score), pluginScoresToString(seed.PluginScores)
+		}
+
+		table.Rows = append(table.Rows, metav1.TableRow{Cells: []any{seed.Name, rank, score, seed.Shoots, details}})
+	}
+
+	return printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(table, out)
+}
+
+func pluginScoresToString(pluginScores map[string]int64) string {
+	scores := make([]string, 0, len(pluginScores))
+	for name, score := range pluginScores {
+		scores = append(scores, fmt.Sprintf("%s=%d", name, score))
+	}
+	slices.Sort(scores)
+	return strings.Join(scores, ", ")
+}
@@ -7,6 +7,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
 	"os"
@@ -59,6 +60,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils/routes"
 	"github.com/gardener/gardener/pkg/features"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/gardenlet/bootstrap"
 	"github.com/gardener/gardener/pkg/gardenlet/bootstrap/certificate"
 	"github.com/gardener/gardener/pkg/gardenlet/bootstrappers"
@@ -68,7 +70,10 @@ import (
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
+	"github.com/gardener/gardener/pkg/utils/oci"
 	"github.com/gardener/gardener/pkg/utils/retry"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 // Name is a const for the name of this component.
@@ -115,13 +120,52 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *g
 		return err
 	}
 
-	var extraHandlers map[string]http.Handler
+	extraHandlers := make(map[string]http.Handler)
 	if cfg.Debugging != nil && ptr.Deref(cfg.Debugging.EnableProfiling, false) {
-		extraHandlers = routes.ProfilingHandlers
+		maps.Copy(extraHandlers, routes.ProfilingHandlers)
 		if ptr.Deref(cfg.Debugging.EnableContentionProfiling, false) {
 			goruntime.SetBlockProfileRate(1)
 		}
 	}
+	if ptr.Deref(cfg.Server.EnableDebugHandlers, false) {
+		log.Info("Registering flow debug handler", "path", flow.DebugHandlerPath)
+		extraHandlers[flow.DebugHandlerPath] = flow.NewDebugHandler(flow.DefaultExecutionRecorder)
+	}
+
+	if cfg.Tracing != nil {
+		log.Info("Setting up tracing", "endpoint", cfg.Tracing.Endpoint)
+		shutdownTracerProvider, err := tracing.SetupGlobalTracerProvider(ctx, Name, cfg.Tracing.Endpoint, ptr.Deref(cfg.Tracing.Insecure, false))
+		if err != nil {
+			return fmt.Errorf("failed setting up tracing: %w", err)
+		}
+
+		defer func() {
+			// the given context is already canceled when the manager has stopped, hence use a new one for flushing the
+			// remaining spans
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			if err := shutdownTracerProvider(shutdownCtx); err != nil {
+				log.Error(err, "Failed shutting down tracer provider")
+			}
+		}()
+	}
+
+	helmRegistryOptions, err := gardenlethelper.NewHelmRegistryOptions(cfg.OCI)
+	if err != nil {
+		return err
+	}
+	if len(helmRegistryOptions.Mirrors) > 0 {
+		log.Info("Using registry mirrors for container images and OCI artifacts", "mirrors", helmRegistryOptions.Mirrors)
+	}
+
+	caKeyBackend, err := gardenlethelper.NewCAKeyBackend(cfg.CAKeyBackend)
+	if err != nil {
+		return fmt.Errorf("failed configuring key backend for private keys of CAs: %w", err)
+	}
+	if caKeyBackend != nil {
+		log.Info("Using key backend for private keys of CAs", "backend", caKeyBackend.Name())
+	}
 
 	log.Info("Setting up manager")
 	mgr, err := manager.New(runtimeRESTConfig, manager.Options{
@@ -213,6 +257,8 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *g
 					selfHostedShootInfo:       selfHostedShootInfo,
 					healthManager:             healthManager,
 					kubeconfigBootstrapResult: kubeconfigBootstrapResult,
+					helmRegistryOptions:       helmRegistryOptions,
+					caKeyBackend:              caKeyBackend,
 				},
 			},
 		}
@@ -242,6 +288,8 @@ type garden struct {
 	selfHostedShootInfo       *gardenlet.SelfHostedShootInfo
 	healthManager             gardenerhealthz.Manager
 	kubeconfigBootstrapResult *bootstrappers.KubeconfigBootstrapResult
+	helmRegistryOptions       oci.HelmRegistryOptions
+	caKeyBackend              secretsutils.KeyBackend
 }
 
 func (g *garden) Start(ctx context.Context) error {
@@ -519,6 +567,8 @@ func (g *garden) Start(ctx context.Context) error {
 		shootClientMap,
 		g.config,
 		g.healthManager,
+		oci.NewHelmRegistry(gardenCluster.GetClient(), g.helmRegistryOptions),
+		g.caKeyBackend,
 	); err != nil {
 		return fmt.Errorf("failed adding controllers to manager: %w", err)
 	}
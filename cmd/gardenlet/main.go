@@ -12,6 +12,7 @@ import (
 	"github.com/gardener/gardener/cmd/utils"
 	"github.com/gardener/gardener/pkg/gardenlet/features"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
 func main() {
@@ -19,6 +20,7 @@ func main() {
 	features.RegisterFeatureGates()
 
 	flow.RegisterMetrics(runtimemetrics.Registry)
+	secretsmanager.RegisterMetrics(runtimemetrics.Registry)
 
 	if err := app.NewCommand().ExecuteContext(signals.SetupSignalHandler()); err != nil {
 		panic(err)
@@ -35,6 +35,8 @@ var (
 // Adds the list of known types to the given scheme.
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
+		&AccessRequest{},
+		&AccessRequestList{},
 		&BackupBucket{},
 		&BackupBucketList{},
 		&BackupEntry{},
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessRequest is a request for a time-bound membership in a Project. The membership is granted once the request has
+// been approved by the owner of the Project.
+type AccessRequest struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+
+	// Spec contains the specification of the AccessRequest.
+	Spec AccessRequestSpec
+	// Status contains the most recently observed status of the AccessRequest.
+	Status AccessRequestStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessRequestList is a collection of AccessRequests.
+type AccessRequestList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+
+	// Items is the list of AccessRequests.
+	Items []AccessRequest
+}
+
+// AccessRequestSpec is the specification of an AccessRequest.
+type AccessRequestSpec struct {
+	// Subject is the user, group, or service account which requests the membership.
+	Subject rbacv1.Subject
+	// Roles is the list of roles the subject requests.
+	Roles []string
+	// Duration is the duration of the membership after the request has been approved.
+	Duration metav1.Duration
+	// Reason is the justification of the request.
+	Reason string
+}
+
+// AccessRequestStatus holds the most recently observed status of an AccessRequest.
+type AccessRequestStatus struct {
+	// Phase is the current phase of the AccessRequest.
+	Phase AccessRequestPhase
+	// ExpirationTimestamp is the time at which the granted membership expires.
+	ExpirationTimestamp *metav1.Time
+}
+
+// AccessRequestPhase is a label for the condition of an AccessRequest at the current time.
+type AccessRequestPhase string
+
+const (
+	// AccessRequestPending indicates that the AccessRequest has not been approved yet.
+	AccessRequestPending AccessRequestPhase = "Pending"
+	// AccessRequestGranted indicates that the AccessRequest has been approved and the membership has been granted.
+	AccessRequestGranted AccessRequestPhase = "Granted"
+	// AccessRequestExpired indicates that the granted membership has expired.
+	AccessRequestExpired AccessRequestPhase = "Expired"
+)
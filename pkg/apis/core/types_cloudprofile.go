@@ -171,6 +171,8 @@ type MachineType struct {
 	Architecture *string
 	// Capabilities contains the machine type capabilities.
 	Capabilities Capabilities
+	// Price is the price of a machine of this type. It is used for estimating the cost of Shoots.
+	Price *Price
 }
 
 // MachineTypeStorage is the amount of storage associated with the root volume of this machine type.
@@ -198,6 +200,9 @@ type Region struct {
 	Labels map[string]string
 	// AccessRestrictions describe a list of access restrictions that can be used for Shoots using this region.
 	AccessRestrictions []AccessRestriction
+	// PriceFactor is multiplied with the prices of machine and volume types for Shoots in this region, e.g. `1.2`.
+	// Defaults to 1 if not specified.
+	PriceFactor *resource.Quantity
 }
 
 // AvailabilityZone is an availability zone.
@@ -220,6 +225,16 @@ type VolumeType struct {
 	Usable *bool
 	// MinSize is the minimal supported storage size.
 	MinSize *resource.Quantity
+	// Price is the price of one GiB of a volume of this type. It is used for estimating the cost of Shoots.
+	Price *Price
+}
+
+// Price is the price of a resource.
+type Price struct {
+	// Currency is the ISO 4217 code of the currency, e.g. `EUR` or `USD`.
+	Currency string
+	// Hourly is the price per hour.
+	Hourly resource.Quantity
 }
 
 // Bastion contains the bastions creation info
@@ -6,6 +6,7 @@ package core
 
 import (
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -63,6 +64,9 @@ type ProjectSpec struct {
 	Tolerations *ProjectTolerations
 	// DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 	DualApprovalForDeletion []DualApprovalForDeletion
+	// CostBudget is the budget for the estimated cost of all Shoots in this project. Changes to Shoots which increase
+	// the estimated cost beyond the budget are admitted with a warning.
+	CostBudget *ProjectCostBudget
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -88,6 +92,9 @@ type ProjectMember struct {
 
 	// Roles is a list of roles of this member.
 	Roles []string
+	// ExpirationTimestamp is the time after which the membership expires. Expired members are automatically removed from
+	// the project, i.e., their access is revoked. If not set, the membership does not expire.
+	ExpirationTimestamp *metav1.Time
 }
 
 // ProjectTolerations contains the tolerations for taints on seed clusters.
@@ -117,6 +124,14 @@ type DualApprovalForDeletion struct {
 	IncludeServiceAccounts *bool
 }
 
+// ProjectCostBudget is the budget for the estimated cost of all Shoots in a project.
+type ProjectCostBudget struct {
+	// Currency is the ISO 4217 code of the currency of the budget.
+	Currency string
+	// Monthly is the monthly budget.
+	Monthly resource.Quantity
+}
+
 const (
 	// ProjectMemberAdmin is a const for a role that provides full admin access.
 	ProjectMemberAdmin = "admin"
@@ -20,6 +20,8 @@ type Quota struct {
 
 	// Spec defines the Quota constraints.
 	Spec QuotaSpec
+	// Status contains the most recently observed usage of the Quota.
+	Status QuotaStatus
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -42,6 +44,17 @@ type QuotaSpec struct {
 	Metrics corev1.ResourceList
 	// Scope is the scope of the Quota object, either 'project', 'secret' or 'workloadidentity'. This field is immutable.
 	Scope corev1.ObjectReference
+	// WarningThresholdPercentage is the percentage of the limits in Metrics above which Shoot creations and updates
+	// result in warnings and events are recorded for the Quota.
+	WarningThresholdPercentage *int32
+}
+
+// QuotaStatus holds the most recently observed usage of a Quota.
+type QuotaStatus struct {
+	// Hard is the set of enforced limits for each metric.
+	Hard corev1.ResourceList
+	// Used is the amount of resources allocated by the Shoots bound to the Quota for each metric.
+	Used corev1.ResourceList
 }
 
 const (
@@ -174,6 +174,8 @@ type ShootStatus struct {
 	InPlaceUpdates *InPlaceUpdatesStatus
 	// ManualWorkerPoolRollout contains information about the worker pool rollout progress.
 	ManualWorkerPoolRollout *ManualWorkerPoolRollout
+	// EstimatedCost contains the estimated cost of the Shoot based on the prices specified in the CloudProfile.
+	EstimatedCost *ShootCostEstimate
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -272,6 +274,21 @@ type ManualWorkerPoolRollout struct {
 	PendingWorkersRollouts []PendingWorkersRollout
 }
 
+// ShootCostEstimate contains the estimated cost of a Shoot. The minimum is computed with the minimum number of worker
+// nodes, the maximum with the maximum number of worker nodes. Monthly values take the hibernation schedules into account.
+type ShootCostEstimate struct {
+	// Currency is the ISO 4217 code of the currency of the estimated cost.
+	Currency string
+	// HourlyMinimum is the estimated hourly cost of the Shoot while it is awake with the minimum number of worker nodes.
+	HourlyMinimum resource.Quantity
+	// HourlyMaximum is the estimated hourly cost of the Shoot while it is awake with the maximum number of worker nodes.
+	HourlyMaximum resource.Quantity
+	// MonthlyMinimum is the estimated monthly cost of the Shoot with the minimum number of worker nodes.
+	MonthlyMinimum resource.Quantity
+	// MonthlyMaximum is the estimated monthly cost of the Shoot with the maximum number of worker nodes.
+	MonthlyMaximum resource.Quantity
+}
+
 // ShootKubeconfigRotation contains information about the kubeconfig credential rotation.
 type ShootKubeconfigRotation struct {
 	// LastInitiationTime is the most recent time when the kubeconfig credential rotation was initiated.
@@ -1581,6 +1598,10 @@ const (
 	ShootEventSchedulingSuccessful = "SchedulingSuccessful"
 	// ShootEventSchedulingFailed indicates that a scheduling decision failed.
 	ShootEventSchedulingFailed = "SchedulingFailed"
+	// ShootEventSeedRebalancingRecommended indicates that a better seed for the shoot's control plane was found.
+	ShootEventSeedRebalancingRecommended = "SeedRebalancingRecommended"
+	// ShootEventSeedRebalancingTriggered indicates that the control plane migration to a better seed was triggered.
+	ShootEventSeedRebalancingTriggered = "SeedRebalancingTriggered"
 )
 
 const (
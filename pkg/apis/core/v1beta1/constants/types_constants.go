@@ -51,6 +51,9 @@ const (
 	// SecretNameSSHKeyPair is a constant for the name of a Kubernetes secret object that contains the SSH key pair
 	// (public and private key) that can be used to SSH into the shoot nodes.
 	SecretNameSSHKeyPair = "ssh-keypair" // #nosec G101 -- No credential.
+	// SecretNameSSHCertificateAuthority is a constant for the name of a Kubernetes secret object that contains the
+	// SSH certificate authority (public and private key) that is used to sign SSH user certificates for the shoot nodes.
+	SecretNameSSHCertificateAuthority = "ssh-ca" // #nosec G101 -- No credential.
 	// SecretNameServiceAccountKey is a constant for the name of a Kubernetes secret object that contains a
 	// PEM-encoded private RSA or ECDSA key used by the Kube Controller Manager to sign service account tokens.
 	SecretNameServiceAccountKey = "service-account-key"
@@ -228,6 +231,9 @@ const (
 	// GardenerTimestamp is a constant for an annotation on a resource that describes the timestamp when a reconciliation has been requested.
 	// It is only used to guarantee an update event for watching clients in case the operation-annotation is already present.
 	GardenerTimestamp = "gardener.cloud/timestamp"
+	// GardenerTraceParent is a constant for an annotation on a resource that contains the W3C trace context (the
+	// `traceparent` header) of the operation which has requested the last reconciliation of the resource.
+	GardenerTraceParent = "gardener.cloud/traceparent"
 	// GardenerOperationMigrate is a constant for the value of the operation annotation describing a migration
 	// operation.
 	GardenerOperationMigrate = "migrate"
@@ -459,6 +465,13 @@ const (
 	ConfirmationDeletion = "confirmation.gardener.cloud/deletion"
 	// DeletionConfirmedBy is an annotation on a resource whose value is the subject which confirmed the deletion.
 	DeletionConfirmedBy = "deletion.gardener.cloud/confirmed-by"
+	// AccessRequestApproved is an annotation on an AccessRequest whose value must be set to "true" by the owner of the
+	// project in order to approve the request.
+	AccessRequestApproved = "access.gardener.cloud/approved"
+	// AccessRequestApprovedBy is an annotation on an AccessRequest whose value is the subject which approved the request.
+	AccessRequestApprovedBy = "access.gardener.cloud/approved-by"
+	// AccessRequestRequestedBy is an annotation on an AccessRequest whose value is the subject which created the request.
+	AccessRequestRequestedBy = "access.gardener.cloud/requested-by"
 
 	// SeedResourceManagerClass is the resource-class managed by the Gardener-Resource-Manager
 	// instance in the garden namespace on the seeds.
@@ -711,6 +724,9 @@ const (
 	// AnnotationSchedulingCloudProfiles is a constant for an annotation key on a configmap which denotes
 	// the linked cloudprofiles containing the region distances.
 	AnnotationSchedulingCloudProfiles = "scheduling.gardener.cloud/cloudprofiles"
+	// AnnotationSchedulingRecommendedSeed is a constant for an annotation key on a shoot which contains the name of the
+	// seed recommended for hosting the shoot's control plane instead of the current one.
+	AnnotationSchedulingRecommendedSeed = "scheduling.gardener.cloud/recommended-seed"
 
 	// AnnotationConfirmationForceDeletion is a constant for an annotation on a Shoot resource whose value must be set to "true" in order to
 	// trigger force-deletion of the cluster. It can only be set if the Shoot has a deletion timestamp and contains an ErrorCode in the Shoot Status.
@@ -1069,6 +1085,10 @@ const (
 	// should wait with reconciliation of the operating system config (to prevent too many node-agents from restarting
 	// kubelet or other critical units at the same time).
 	AnnotationNodeAgentReconciliationDelay = "node-agent.gardener.cloud/reconciliation-delay"
+	// AnnotationNodeAgentHoldOperatingSystemConfigUpdate is the annotation key for instructing the gardener-node-agent
+	// to not apply changes of the operating system config to the node as long as the annotation is present. It is used
+	// by gardenadm to roll out changes to control plane nodes one after another.
+	AnnotationNodeAgentHoldOperatingSystemConfigUpdate = "node-agent.gardener.cloud/hold-operating-system-config-update"
 	// NodeAgentsGroup is the identity group for gardener-node-agents when authenticating to the API server.
 	NodeAgentsGroup = "gardener.cloud:node-agents"
 	// NodeAgentUserNamePrefix is the identity username prefix for gardener-node-agent when authenticating to the API server.
@@ -1,7 +1,6 @@
 // SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
 //
 // SPDX-License-Identifier: Apache-2.0
-
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: github.com/gardener/gardener/pkg/apis/core/v1beta1/generated.proto
 
@@ -16,11 +15,11 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
-	v1 "k8s.io/api/core/v1"
-	v14 "k8s.io/api/rbac/v1"
-	v12 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	v12 "k8s.io/api/core/v1"
+	v11 "k8s.io/api/rbac/v1"
+	v13 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
-	v11 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	math "math"
@@ -99,10 +98,122 @@ func (m *APIServerRequests) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_APIServerRequests proto.InternalMessageInfo
 
+func (m *AccessRequest) Reset()      { *m = AccessRequest{} }
+func (*AccessRequest) ProtoMessage() {}
+func (*AccessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{2}
+}
+func (m *AccessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessRequest.Merge(m, src)
+}
+func (m *AccessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessRequest proto.InternalMessageInfo
+
+func (m *AccessRequestList) Reset()      { *m = AccessRequestList{} }
+func (*AccessRequestList) ProtoMessage() {}
+func (*AccessRequestList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{3}
+}
+func (m *AccessRequestList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessRequestList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccessRequestList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessRequestList.Merge(m, src)
+}
+func (m *AccessRequestList) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessRequestList) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessRequestList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessRequestList proto.InternalMessageInfo
+
+func (m *AccessRequestSpec) Reset()      { *m = AccessRequestSpec{} }
+func (*AccessRequestSpec) ProtoMessage() {}
+func (*AccessRequestSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{4}
+}
+func (m *AccessRequestSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessRequestSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccessRequestSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessRequestSpec.Merge(m, src)
+}
+func (m *AccessRequestSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessRequestSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessRequestSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessRequestSpec proto.InternalMessageInfo
+
+func (m *AccessRequestStatus) Reset()      { *m = AccessRequestStatus{} }
+func (*AccessRequestStatus) ProtoMessage() {}
+func (*AccessRequestStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{5}
+}
+func (m *AccessRequestStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessRequestStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccessRequestStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessRequestStatus.Merge(m, src)
+}
+func (m *AccessRequestStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessRequestStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessRequestStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessRequestStatus proto.InternalMessageInfo
+
 func (m *AccessRestriction) Reset()      { *m = AccessRestriction{} }
 func (*AccessRestriction) ProtoMessage() {}
 func (*AccessRestriction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{2}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{6}
 }
 func (m *AccessRestriction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -130,7 +241,7 @@ var xxx_messageInfo_AccessRestriction proto.InternalMessageInfo
 func (m *AccessRestrictionWithOptions) Reset()      { *m = AccessRestrictionWithOptions{} }
 func (*AccessRestrictionWithOptions) ProtoMessage() {}
 func (*AccessRestrictionWithOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{3}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{7}
 }
 func (m *AccessRestrictionWithOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,7 +269,7 @@ var xxx_messageInfo_AccessRestrictionWithOptions proto.InternalMessageInfo
 func (m *Addon) Reset()      { *m = Addon{} }
 func (*Addon) ProtoMessage() {}
 func (*Addon) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{4}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{8}
 }
 func (m *Addon) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -186,7 +297,7 @@ var xxx_messageInfo_Addon proto.InternalMessageInfo
 func (m *Addons) Reset()      { *m = Addons{} }
 func (*Addons) ProtoMessage() {}
 func (*Addons) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{5}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{9}
 }
 func (m *Addons) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,7 +325,7 @@ var xxx_messageInfo_Addons proto.InternalMessageInfo
 func (m *AdmissionPlugin) Reset()      { *m = AdmissionPlugin{} }
 func (*AdmissionPlugin) ProtoMessage() {}
 func (*AdmissionPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{6}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{10}
 }
 func (m *AdmissionPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,7 +353,7 @@ var xxx_messageInfo_AdmissionPlugin proto.InternalMessageInfo
 func (m *Alerting) Reset()      { *m = Alerting{} }
 func (*Alerting) ProtoMessage() {}
 func (*Alerting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{7}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{11}
 }
 func (m *Alerting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,7 +381,7 @@ var xxx_messageInfo_Alerting proto.InternalMessageInfo
 func (m *AuditConfig) Reset()      { *m = AuditConfig{} }
 func (*AuditConfig) ProtoMessage() {}
 func (*AuditConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{8}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{12}
 }
 func (m *AuditConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -298,7 +409,7 @@ var xxx_messageInfo_AuditConfig proto.InternalMessageInfo
 func (m *AuditPolicy) Reset()      { *m = AuditPolicy{} }
 func (*AuditPolicy) ProtoMessage() {}
 func (*AuditPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{9}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{13}
 }
 func (m *AuditPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -326,7 +437,7 @@ var xxx_messageInfo_AuditPolicy proto.InternalMessageInfo
 func (m *AuthorizerKubeconfigReference) Reset()      { *m = AuthorizerKubeconfigReference{} }
 func (*AuthorizerKubeconfigReference) ProtoMessage() {}
 func (*AuthorizerKubeconfigReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{10}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{14}
 }
 func (m *AuthorizerKubeconfigReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,7 +465,7 @@ var xxx_messageInfo_AuthorizerKubeconfigReference proto.InternalMessageInfo
 func (m *AvailabilityZone) Reset()      { *m = AvailabilityZone{} }
 func (*AvailabilityZone) ProtoMessage() {}
 func (*AvailabilityZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{11}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{15}
 }
 func (m *AvailabilityZone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,7 +493,7 @@ var xxx_messageInfo_AvailabilityZone proto.InternalMessageInfo
 func (m *Backup) Reset()      { *m = Backup{} }
 func (*Backup) ProtoMessage() {}
 func (*Backup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{12}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{16}
 }
 func (m *Backup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,7 +521,7 @@ var xxx_messageInfo_Backup proto.InternalMessageInfo
 func (m *BackupBucket) Reset()      { *m = BackupBucket{} }
 func (*BackupBucket) ProtoMessage() {}
 func (*BackupBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{13}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{17}
 }
 func (m *BackupBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -438,7 +549,7 @@ var xxx_messageInfo_BackupBucket proto.InternalMessageInfo
 func (m *BackupBucketList) Reset()      { *m = BackupBucketList{} }
 func (*BackupBucketList) ProtoMessage() {}
 func (*BackupBucketList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{14}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{18}
 }
 func (m *BackupBucketList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -466,7 +577,7 @@ var xxx_messageInfo_BackupBucketList proto.InternalMessageInfo
 func (m *BackupBucketProvider) Reset()      { *m = BackupBucketProvider{} }
 func (*BackupBucketProvider) ProtoMessage() {}
 func (*BackupBucketProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{15}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{19}
 }
 func (m *BackupBucketProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -494,7 +605,7 @@ var xxx_messageInfo_BackupBucketProvider proto.InternalMessageInfo
 func (m *BackupBucketSpec) Reset()      { *m = BackupBucketSpec{} }
 func (*BackupBucketSpec) ProtoMessage() {}
 func (*BackupBucketSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{16}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{20}
 }
 func (m *BackupBucketSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -522,7 +633,7 @@ var xxx_messageInfo_BackupBucketSpec proto.InternalMessageInfo
 func (m *BackupBucketStatus) Reset()      { *m = BackupBucketStatus{} }
 func (*BackupBucketStatus) ProtoMessage() {}
 func (*BackupBucketStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{17}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{21}
 }
 func (m *BackupBucketStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,7 +661,7 @@ var xxx_messageInfo_BackupBucketStatus proto.InternalMessageInfo
 func (m *BackupEntry) Reset()      { *m = BackupEntry{} }
 func (*BackupEntry) ProtoMessage() {}
 func (*BackupEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{18}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{22}
 }
 func (m *BackupEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -578,7 +689,7 @@ var xxx_messageInfo_BackupEntry proto.InternalMessageInfo
 func (m *BackupEntryList) Reset()      { *m = BackupEntryList{} }
 func (*BackupEntryList) ProtoMessage() {}
 func (*BackupEntryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{19}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{23}
 }
 func (m *BackupEntryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -606,7 +717,7 @@ var xxx_messageInfo_BackupEntryList proto.InternalMessageInfo
 func (m *BackupEntrySpec) Reset()      { *m = BackupEntrySpec{} }
 func (*BackupEntrySpec) ProtoMessage() {}
 func (*BackupEntrySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{20}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{24}
 }
 func (m *BackupEntrySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -634,7 +745,7 @@ var xxx_messageInfo_BackupEntrySpec proto.InternalMessageInfo
 func (m *BackupEntryStatus) Reset()      { *m = BackupEntryStatus{} }
 func (*BackupEntryStatus) ProtoMessage() {}
 func (*BackupEntryStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{21}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{25}
 }
 func (m *BackupEntryStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -662,7 +773,7 @@ var xxx_messageInfo_BackupEntryStatus proto.InternalMessageInfo
 func (m *Bastion) Reset()      { *m = Bastion{} }
 func (*Bastion) ProtoMessage() {}
 func (*Bastion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{22}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{26}
 }
 func (m *Bastion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -690,7 +801,7 @@ var xxx_messageInfo_Bastion proto.InternalMessageInfo
 func (m *BastionMachineImage) Reset()      { *m = BastionMachineImage{} }
 func (*BastionMachineImage) ProtoMessage() {}
 func (*BastionMachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{23}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{27}
 }
 func (m *BastionMachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -718,7 +829,7 @@ var xxx_messageInfo_BastionMachineImage proto.InternalMessageInfo
 func (m *BastionMachineType) Reset()      { *m = BastionMachineType{} }
 func (*BastionMachineType) ProtoMessage() {}
 func (*BastionMachineType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{24}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{28}
 }
 func (m *BastionMachineType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -746,7 +857,7 @@ var xxx_messageInfo_BastionMachineType proto.InternalMessageInfo
 func (m *CARotation) Reset()      { *m = CARotation{} }
 func (*CARotation) ProtoMessage() {}
 func (*CARotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{25}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{29}
 }
 func (m *CARotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -774,7 +885,7 @@ var xxx_messageInfo_CARotation proto.InternalMessageInfo
 func (m *CRI) Reset()      { *m = CRI{} }
 func (*CRI) ProtoMessage() {}
 func (*CRI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{26}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{30}
 }
 func (m *CRI) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -802,7 +913,7 @@ var xxx_messageInfo_CRI proto.InternalMessageInfo
 func (m *CapabilityDefinition) Reset()      { *m = CapabilityDefinition{} }
 func (*CapabilityDefinition) ProtoMessage() {}
 func (*CapabilityDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{27}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{31}
 }
 func (m *CapabilityDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -830,7 +941,7 @@ var xxx_messageInfo_CapabilityDefinition proto.InternalMessageInfo
 func (m *CapabilityValues) Reset()      { *m = CapabilityValues{} }
 func (*CapabilityValues) ProtoMessage() {}
 func (*CapabilityValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{28}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{32}
 }
 func (m *CapabilityValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -858,7 +969,7 @@ var xxx_messageInfo_CapabilityValues proto.InternalMessageInfo
 func (m *CloudProfile) Reset()      { *m = CloudProfile{} }
 func (*CloudProfile) ProtoMessage() {}
 func (*CloudProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{29}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{33}
 }
 func (m *CloudProfile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -886,7 +997,7 @@ var xxx_messageInfo_CloudProfile proto.InternalMessageInfo
 func (m *CloudProfileList) Reset()      { *m = CloudProfileList{} }
 func (*CloudProfileList) ProtoMessage() {}
 func (*CloudProfileList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{30}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{34}
 }
 func (m *CloudProfileList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -914,7 +1025,7 @@ var xxx_messageInfo_CloudProfileList proto.InternalMessageInfo
 func (m *CloudProfileReference) Reset()      { *m = CloudProfileReference{} }
 func (*CloudProfileReference) ProtoMessage() {}
 func (*CloudProfileReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{31}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{35}
 }
 func (m *CloudProfileReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -942,7 +1053,7 @@ var xxx_messageInfo_CloudProfileReference proto.InternalMessageInfo
 func (m *CloudProfileSpec) Reset()      { *m = CloudProfileSpec{} }
 func (*CloudProfileSpec) ProtoMessage() {}
 func (*CloudProfileSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{32}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{36}
 }
 func (m *CloudProfileSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -970,7 +1081,7 @@ var xxx_messageInfo_CloudProfileSpec proto.InternalMessageInfo
 func (m *ClusterAutoscaler) Reset()      { *m = ClusterAutoscaler{} }
 func (*ClusterAutoscaler) ProtoMessage() {}
 func (*ClusterAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{33}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{37}
 }
 func (m *ClusterAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -998,7 +1109,7 @@ var xxx_messageInfo_ClusterAutoscaler proto.InternalMessageInfo
 func (m *ClusterAutoscalerOptions) Reset()      { *m = ClusterAutoscalerOptions{} }
 func (*ClusterAutoscalerOptions) ProtoMessage() {}
 func (*ClusterAutoscalerOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{34}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{38}
 }
 func (m *ClusterAutoscalerOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1026,7 +1137,7 @@ var xxx_messageInfo_ClusterAutoscalerOptions proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{35}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{39}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1054,7 +1165,7 @@ var xxx_messageInfo_Condition proto.InternalMessageInfo
 func (m *ContainerRuntime) Reset()      { *m = ContainerRuntime{} }
 func (*ContainerRuntime) ProtoMessage() {}
 func (*ContainerRuntime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{36}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{40}
 }
 func (m *ContainerRuntime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1082,7 +1193,7 @@ var xxx_messageInfo_ContainerRuntime proto.InternalMessageInfo
 func (m *ControlPlane) Reset()      { *m = ControlPlane{} }
 func (*ControlPlane) ProtoMessage() {}
 func (*ControlPlane) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{37}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{41}
 }
 func (m *ControlPlane) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1110,7 +1221,7 @@ var xxx_messageInfo_ControlPlane proto.InternalMessageInfo
 func (m *ControlPlaneAutoscaling) Reset()      { *m = ControlPlaneAutoscaling{} }
 func (*ControlPlaneAutoscaling) ProtoMessage() {}
 func (*ControlPlaneAutoscaling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{38}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{42}
 }
 func (m *ControlPlaneAutoscaling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1138,7 +1249,7 @@ var xxx_messageInfo_ControlPlaneAutoscaling proto.InternalMessageInfo
 func (m *ControllerDeployment) Reset()      { *m = ControllerDeployment{} }
 func (*ControllerDeployment) ProtoMessage() {}
 func (*ControllerDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{39}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{43}
 }
 func (m *ControllerDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1166,7 +1277,7 @@ var xxx_messageInfo_ControllerDeployment proto.InternalMessageInfo
 func (m *ControllerDeploymentList) Reset()      { *m = ControllerDeploymentList{} }
 func (*ControllerDeploymentList) ProtoMessage() {}
 func (*ControllerDeploymentList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{40}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{44}
 }
 func (m *ControllerDeploymentList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1194,7 +1305,7 @@ var xxx_messageInfo_ControllerDeploymentList proto.InternalMessageInfo
 func (m *ControllerInstallation) Reset()      { *m = ControllerInstallation{} }
 func (*ControllerInstallation) ProtoMessage() {}
 func (*ControllerInstallation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{41}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{45}
 }
 func (m *ControllerInstallation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1222,7 +1333,7 @@ var xxx_messageInfo_ControllerInstallation proto.InternalMessageInfo
 func (m *ControllerInstallationList) Reset()      { *m = ControllerInstallationList{} }
 func (*ControllerInstallationList) ProtoMessage() {}
 func (*ControllerInstallationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{42}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{46}
 }
 func (m *ControllerInstallationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1250,7 +1361,7 @@ var xxx_messageInfo_ControllerInstallationList proto.InternalMessageInfo
 func (m *ControllerInstallationSpec) Reset()      { *m = ControllerInstallationSpec{} }
 func (*ControllerInstallationSpec) ProtoMessage() {}
 func (*ControllerInstallationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{43}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{47}
 }
 func (m *ControllerInstallationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1278,7 +1389,7 @@ var xxx_messageInfo_ControllerInstallationSpec proto.InternalMessageInfo
 func (m *ControllerInstallationStatus) Reset()      { *m = ControllerInstallationStatus{} }
 func (*ControllerInstallationStatus) ProtoMessage() {}
 func (*ControllerInstallationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{44}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{48}
 }
 func (m *ControllerInstallationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1306,7 +1417,7 @@ var xxx_messageInfo_ControllerInstallationStatus proto.InternalMessageInfo
 func (m *ControllerRegistration) Reset()      { *m = ControllerRegistration{} }
 func (*ControllerRegistration) ProtoMessage() {}
 func (*ControllerRegistration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{45}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{49}
 }
 func (m *ControllerRegistration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1334,7 +1445,7 @@ var xxx_messageInfo_ControllerRegistration proto.InternalMessageInfo
 func (m *ControllerRegistrationDeployment) Reset()      { *m = ControllerRegistrationDeployment{} }
 func (*ControllerRegistrationDeployment) ProtoMessage() {}
 func (*ControllerRegistrationDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{46}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{50}
 }
 func (m *ControllerRegistrationDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1362,7 +1473,7 @@ var xxx_messageInfo_ControllerRegistrationDeployment proto.InternalMessageInfo
 func (m *ControllerRegistrationList) Reset()      { *m = ControllerRegistrationList{} }
 func (*ControllerRegistrationList) ProtoMessage() {}
 func (*ControllerRegistrationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{47}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{51}
 }
 func (m *ControllerRegistrationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1390,7 +1501,7 @@ var xxx_messageInfo_ControllerRegistrationList proto.InternalMessageInfo
 func (m *ControllerRegistrationSpec) Reset()      { *m = ControllerRegistrationSpec{} }
 func (*ControllerRegistrationSpec) ProtoMessage() {}
 func (*ControllerRegistrationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{48}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{52}
 }
 func (m *ControllerRegistrationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1418,7 +1529,7 @@ var xxx_messageInfo_ControllerRegistrationSpec proto.InternalMessageInfo
 func (m *ControllerResource) Reset()      { *m = ControllerResource{} }
 func (*ControllerResource) ProtoMessage() {}
 func (*ControllerResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{49}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{53}
 }
 func (m *ControllerResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1446,7 +1557,7 @@ var xxx_messageInfo_ControllerResource proto.InternalMessageInfo
 func (m *ControllerResourceLifecycle) Reset()      { *m = ControllerResourceLifecycle{} }
 func (*ControllerResourceLifecycle) ProtoMessage() {}
 func (*ControllerResourceLifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{50}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{54}
 }
 func (m *ControllerResourceLifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1474,7 +1585,7 @@ var xxx_messageInfo_ControllerResourceLifecycle proto.InternalMessageInfo
 func (m *CoreDNS) Reset()      { *m = CoreDNS{} }
 func (*CoreDNS) ProtoMessage() {}
 func (*CoreDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{51}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{55}
 }
 func (m *CoreDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1502,7 +1613,7 @@ var xxx_messageInfo_CoreDNS proto.InternalMessageInfo
 func (m *CoreDNSAutoscaling) Reset()      { *m = CoreDNSAutoscaling{} }
 func (*CoreDNSAutoscaling) ProtoMessage() {}
 func (*CoreDNSAutoscaling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{52}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{56}
 }
 func (m *CoreDNSAutoscaling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1530,7 +1641,7 @@ var xxx_messageInfo_CoreDNSAutoscaling proto.InternalMessageInfo
 func (m *CoreDNSRewriting) Reset()      { *m = CoreDNSRewriting{} }
 func (*CoreDNSRewriting) ProtoMessage() {}
 func (*CoreDNSRewriting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{53}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{57}
 }
 func (m *CoreDNSRewriting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1558,7 +1669,7 @@ var xxx_messageInfo_CoreDNSRewriting proto.InternalMessageInfo
 func (m *DNS) Reset()      { *m = DNS{} }
 func (*DNS) ProtoMessage() {}
 func (*DNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{54}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{58}
 }
 func (m *DNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1586,7 +1697,7 @@ var xxx_messageInfo_DNS proto.InternalMessageInfo
 func (m *DNSIncludeExclude) Reset()      { *m = DNSIncludeExclude{} }
 func (*DNSIncludeExclude) ProtoMessage() {}
 func (*DNSIncludeExclude) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{55}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{59}
 }
 func (m *DNSIncludeExclude) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1614,7 +1725,7 @@ var xxx_messageInfo_DNSIncludeExclude proto.InternalMessageInfo
 func (m *DNSProvider) Reset()      { *m = DNSProvider{} }
 func (*DNSProvider) ProtoMessage() {}
 func (*DNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{56}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{60}
 }
 func (m *DNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1642,7 +1753,7 @@ var xxx_messageInfo_DNSProvider proto.InternalMessageInfo
 func (m *DataVolume) Reset()      { *m = DataVolume{} }
 func (*DataVolume) ProtoMessage() {}
 func (*DataVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{57}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{61}
 }
 func (m *DataVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1670,7 +1781,7 @@ var xxx_messageInfo_DataVolume proto.InternalMessageInfo
 func (m *DeploymentRef) Reset()      { *m = DeploymentRef{} }
 func (*DeploymentRef) ProtoMessage() {}
 func (*DeploymentRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{58}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{62}
 }
 func (m *DeploymentRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1698,7 +1809,7 @@ var xxx_messageInfo_DeploymentRef proto.InternalMessageInfo
 func (m *DualApprovalForDeletion) Reset()      { *m = DualApprovalForDeletion{} }
 func (*DualApprovalForDeletion) ProtoMessage() {}
 func (*DualApprovalForDeletion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{59}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{63}
 }
 func (m *DualApprovalForDeletion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1726,7 +1837,7 @@ var xxx_messageInfo_DualApprovalForDeletion proto.InternalMessageInfo
 func (m *ETCD) Reset()      { *m = ETCD{} }
 func (*ETCD) ProtoMessage() {}
 func (*ETCD) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{60}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{64}
 }
 func (m *ETCD) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1754,7 +1865,7 @@ var xxx_messageInfo_ETCD proto.InternalMessageInfo
 func (m *ETCDConfig) Reset()      { *m = ETCDConfig{} }
 func (*ETCDConfig) ProtoMessage() {}
 func (*ETCDConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{61}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{65}
 }
 func (m *ETCDConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1782,7 +1893,7 @@ var xxx_messageInfo_ETCDConfig proto.InternalMessageInfo
 func (m *ETCDEncryptionKeyRotation) Reset()      { *m = ETCDEncryptionKeyRotation{} }
 func (*ETCDEncryptionKeyRotation) ProtoMessage() {}
 func (*ETCDEncryptionKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{62}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{66}
 }
 func (m *ETCDEncryptionKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1810,7 +1921,7 @@ var xxx_messageInfo_ETCDEncryptionKeyRotation proto.InternalMessageInfo
 func (m *EncryptionAtRest) Reset()      { *m = EncryptionAtRest{} }
 func (*EncryptionAtRest) ProtoMessage() {}
 func (*EncryptionAtRest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{63}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{67}
 }
 func (m *EncryptionAtRest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1838,7 +1949,7 @@ var xxx_messageInfo_EncryptionAtRest proto.InternalMessageInfo
 func (m *EncryptionConfig) Reset()      { *m = EncryptionConfig{} }
 func (*EncryptionConfig) ProtoMessage() {}
 func (*EncryptionConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{64}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{68}
 }
 func (m *EncryptionConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1866,7 +1977,7 @@ var xxx_messageInfo_EncryptionConfig proto.InternalMessageInfo
 func (m *ExpirableVersion) Reset()      { *m = ExpirableVersion{} }
 func (*ExpirableVersion) ProtoMessage() {}
 func (*ExpirableVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{65}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{69}
 }
 func (m *ExpirableVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1894,7 +2005,7 @@ var xxx_messageInfo_ExpirableVersion proto.InternalMessageInfo
 func (m *ExposureClass) Reset()      { *m = ExposureClass{} }
 func (*ExposureClass) ProtoMessage() {}
 func (*ExposureClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{66}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{70}
 }
 func (m *ExposureClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1922,7 +2033,7 @@ var xxx_messageInfo_ExposureClass proto.InternalMessageInfo
 func (m *ExposureClassList) Reset()      { *m = ExposureClassList{} }
 func (*ExposureClassList) ProtoMessage() {}
 func (*ExposureClassList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{67}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{71}
 }
 func (m *ExposureClassList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1950,7 +2061,7 @@ var xxx_messageInfo_ExposureClassList proto.InternalMessageInfo
 func (m *ExposureClassScheduling) Reset()      { *m = ExposureClassScheduling{} }
 func (*ExposureClassScheduling) ProtoMessage() {}
 func (*ExposureClassScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{68}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{72}
 }
 func (m *ExposureClassScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1978,7 +2089,7 @@ var xxx_messageInfo_ExposureClassScheduling proto.InternalMessageInfo
 func (m *Extension) Reset()      { *m = Extension{} }
 func (*Extension) ProtoMessage() {}
 func (*Extension) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{69}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{73}
 }
 func (m *Extension) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2006,7 +2117,7 @@ var xxx_messageInfo_Extension proto.InternalMessageInfo
 func (m *ExtensionResourceState) Reset()      { *m = ExtensionResourceState{} }
 func (*ExtensionResourceState) ProtoMessage() {}
 func (*ExtensionResourceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{70}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{74}
 }
 func (m *ExtensionResourceState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2034,7 +2145,7 @@ var xxx_messageInfo_ExtensionResourceState proto.InternalMessageInfo
 func (m *FailureTolerance) Reset()      { *m = FailureTolerance{} }
 func (*FailureTolerance) ProtoMessage() {}
 func (*FailureTolerance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{71}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{75}
 }
 func (m *FailureTolerance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2062,7 +2173,7 @@ var xxx_messageInfo_FailureTolerance proto.InternalMessageInfo
 func (m *Gardener) Reset()      { *m = Gardener{} }
 func (*Gardener) ProtoMessage() {}
 func (*Gardener) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{72}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{76}
 }
 func (m *Gardener) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2090,7 +2201,7 @@ var xxx_messageInfo_Gardener proto.InternalMessageInfo
 func (m *GardenerResourceData) Reset()      { *m = GardenerResourceData{} }
 func (*GardenerResourceData) ProtoMessage() {}
 func (*GardenerResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{73}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{77}
 }
 func (m *GardenerResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2118,7 +2229,7 @@ var xxx_messageInfo_GardenerResourceData proto.InternalMessageInfo
 func (m *HelmControllerDeployment) Reset()      { *m = HelmControllerDeployment{} }
 func (*HelmControllerDeployment) ProtoMessage() {}
 func (*HelmControllerDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{74}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{78}
 }
 func (m *HelmControllerDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2146,7 +2257,7 @@ var xxx_messageInfo_HelmControllerDeployment proto.InternalMessageInfo
 func (m *Hibernation) Reset()      { *m = Hibernation{} }
 func (*Hibernation) ProtoMessage() {}
 func (*Hibernation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{75}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{79}
 }
 func (m *Hibernation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2174,7 +2285,7 @@ var xxx_messageInfo_Hibernation proto.InternalMessageInfo
 func (m *HibernationSchedule) Reset()      { *m = HibernationSchedule{} }
 func (*HibernationSchedule) ProtoMessage() {}
 func (*HibernationSchedule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{76}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{80}
 }
 func (m *HibernationSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2202,7 +2313,7 @@ var xxx_messageInfo_HibernationSchedule proto.InternalMessageInfo
 func (m *HighAvailability) Reset()      { *m = HighAvailability{} }
 func (*HighAvailability) ProtoMessage() {}
 func (*HighAvailability) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{77}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{81}
 }
 func (m *HighAvailability) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2230,7 +2341,7 @@ var xxx_messageInfo_HighAvailability proto.InternalMessageInfo
 func (m *HorizontalPodAutoscalerConfig) Reset()      { *m = HorizontalPodAutoscalerConfig{} }
 func (*HorizontalPodAutoscalerConfig) ProtoMessage() {}
 func (*HorizontalPodAutoscalerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{78}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{82}
 }
 func (m *HorizontalPodAutoscalerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2258,7 +2369,7 @@ var xxx_messageInfo_HorizontalPodAutoscalerConfig proto.InternalMessageInfo
 func (m *InPlaceUpdates) Reset()      { *m = InPlaceUpdates{} }
 func (*InPlaceUpdates) ProtoMessage() {}
 func (*InPlaceUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{79}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{83}
 }
 func (m *InPlaceUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2286,7 +2397,7 @@ var xxx_messageInfo_InPlaceUpdates proto.InternalMessageInfo
 func (m *InPlaceUpdatesStatus) Reset()      { *m = InPlaceUpdatesStatus{} }
 func (*InPlaceUpdatesStatus) ProtoMessage() {}
 func (*InPlaceUpdatesStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{80}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{84}
 }
 func (m *InPlaceUpdatesStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2314,7 +2425,7 @@ var xxx_messageInfo_InPlaceUpdatesStatus proto.InternalMessageInfo
 func (m *Ingress) Reset()      { *m = Ingress{} }
 func (*Ingress) ProtoMessage() {}
 func (*Ingress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{81}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{85}
 }
 func (m *Ingress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2342,7 +2453,7 @@ var xxx_messageInfo_Ingress proto.InternalMessageInfo
 func (m *IngressController) Reset()      { *m = IngressController{} }
 func (*IngressController) ProtoMessage() {}
 func (*IngressController) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{82}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{86}
 }
 func (m *IngressController) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2370,7 +2481,7 @@ var xxx_messageInfo_IngressController proto.InternalMessageInfo
 func (m *InternalSecret) Reset()      { *m = InternalSecret{} }
 func (*InternalSecret) ProtoMessage() {}
 func (*InternalSecret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{83}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{87}
 }
 func (m *InternalSecret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2398,7 +2509,7 @@ var xxx_messageInfo_InternalSecret proto.InternalMessageInfo
 func (m *InternalSecretList) Reset()      { *m = InternalSecretList{} }
 func (*InternalSecretList) ProtoMessage() {}
 func (*InternalSecretList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{84}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{88}
 }
 func (m *InternalSecretList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2426,7 +2537,7 @@ var xxx_messageInfo_InternalSecretList proto.InternalMessageInfo
 func (m *KubeAPIServerConfig) Reset()      { *m = KubeAPIServerConfig{} }
 func (*KubeAPIServerConfig) ProtoMessage() {}
 func (*KubeAPIServerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{85}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{89}
 }
 func (m *KubeAPIServerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2454,7 +2565,7 @@ var xxx_messageInfo_KubeAPIServerConfig proto.InternalMessageInfo
 func (m *KubeControllerManagerConfig) Reset()      { *m = KubeControllerManagerConfig{} }
 func (*KubeControllerManagerConfig) ProtoMessage() {}
 func (*KubeControllerManagerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{86}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{90}
 }
 func (m *KubeControllerManagerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2482,7 +2593,7 @@ var xxx_messageInfo_KubeControllerManagerConfig proto.InternalMessageInfo
 func (m *KubeProxyConfig) Reset()      { *m = KubeProxyConfig{} }
 func (*KubeProxyConfig) ProtoMessage() {}
 func (*KubeProxyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{87}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{91}
 }
 func (m *KubeProxyConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2510,7 +2621,7 @@ var xxx_messageInfo_KubeProxyConfig proto.InternalMessageInfo
 func (m *KubeSchedulerConfig) Reset()      { *m = KubeSchedulerConfig{} }
 func (*KubeSchedulerConfig) ProtoMessage() {}
 func (*KubeSchedulerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{88}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{92}
 }
 func (m *KubeSchedulerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2538,7 +2649,7 @@ var xxx_messageInfo_KubeSchedulerConfig proto.InternalMessageInfo
 func (m *KubeletConfig) Reset()      { *m = KubeletConfig{} }
 func (*KubeletConfig) ProtoMessage() {}
 func (*KubeletConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{89}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{93}
 }
 func (m *KubeletConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2566,7 +2677,7 @@ var xxx_messageInfo_KubeletConfig proto.InternalMessageInfo
 func (m *KubeletConfigEviction) Reset()      { *m = KubeletConfigEviction{} }
 func (*KubeletConfigEviction) ProtoMessage() {}
 func (*KubeletConfigEviction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{90}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{94}
 }
 func (m *KubeletConfigEviction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2594,7 +2705,7 @@ var xxx_messageInfo_KubeletConfigEviction proto.InternalMessageInfo
 func (m *KubeletConfigEvictionMinimumReclaim) Reset()      { *m = KubeletConfigEvictionMinimumReclaim{} }
 func (*KubeletConfigEvictionMinimumReclaim) ProtoMessage() {}
 func (*KubeletConfigEvictionMinimumReclaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{91}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{95}
 }
 func (m *KubeletConfigEvictionMinimumReclaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2622,7 +2733,7 @@ var xxx_messageInfo_KubeletConfigEvictionMinimumReclaim proto.InternalMessageInf
 func (m *KubeletConfigEvictionSoftGracePeriod) Reset()      { *m = KubeletConfigEvictionSoftGracePeriod{} }
 func (*KubeletConfigEvictionSoftGracePeriod) ProtoMessage() {}
 func (*KubeletConfigEvictionSoftGracePeriod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{92}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{96}
 }
 func (m *KubeletConfigEvictionSoftGracePeriod) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2650,7 +2761,7 @@ var xxx_messageInfo_KubeletConfigEvictionSoftGracePeriod proto.InternalMessageIn
 func (m *KubeletConfigReserved) Reset()      { *m = KubeletConfigReserved{} }
 func (*KubeletConfigReserved) ProtoMessage() {}
 func (*KubeletConfigReserved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{93}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{97}
 }
 func (m *KubeletConfigReserved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2678,7 +2789,7 @@ var xxx_messageInfo_KubeletConfigReserved proto.InternalMessageInfo
 func (m *Kubernetes) Reset()      { *m = Kubernetes{} }
 func (*Kubernetes) ProtoMessage() {}
 func (*Kubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{94}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{98}
 }
 func (m *Kubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2706,7 +2817,7 @@ var xxx_messageInfo_Kubernetes proto.InternalMessageInfo
 func (m *KubernetesConfig) Reset()      { *m = KubernetesConfig{} }
 func (*KubernetesConfig) ProtoMessage() {}
 func (*KubernetesConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{95}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{99}
 }
 func (m *KubernetesConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2734,7 +2845,7 @@ var xxx_messageInfo_KubernetesConfig proto.InternalMessageInfo
 func (m *KubernetesDashboard) Reset()      { *m = KubernetesDashboard{} }
 func (*KubernetesDashboard) ProtoMessage() {}
 func (*KubernetesDashboard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{96}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{100}
 }
 func (m *KubernetesDashboard) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2762,7 +2873,7 @@ var xxx_messageInfo_KubernetesDashboard proto.InternalMessageInfo
 func (m *KubernetesSettings) Reset()      { *m = KubernetesSettings{} }
 func (*KubernetesSettings) ProtoMessage() {}
 func (*KubernetesSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{97}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
 }
 func (m *KubernetesSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2790,7 +2901,7 @@ var xxx_messageInfo_KubernetesSettings proto.InternalMessageInfo
 func (m *LastError) Reset()      { *m = LastError{} }
 func (*LastError) ProtoMessage() {}
 func (*LastError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{98}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
 }
 func (m *LastError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2818,7 +2929,7 @@ var xxx_messageInfo_LastError proto.InternalMessageInfo
 func (m *LastMaintenance) Reset()      { *m = LastMaintenance{} }
 func (*LastMaintenance) ProtoMessage() {}
 func (*LastMaintenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{99}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
 }
 func (m *LastMaintenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2846,7 +2957,7 @@ var xxx_messageInfo_LastMaintenance proto.InternalMessageInfo
 func (m *LastOperation) Reset()      { *m = LastOperation{} }
 func (*LastOperation) ProtoMessage() {}
 func (*LastOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{100}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
 }
 func (m *LastOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2874,7 +2985,7 @@ var xxx_messageInfo_LastOperation proto.InternalMessageInfo
 func (m *Limits) Reset()      { *m = Limits{} }
 func (*Limits) ProtoMessage() {}
 func (*Limits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
 }
 func (m *Limits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2902,7 +3013,7 @@ var xxx_messageInfo_Limits proto.InternalMessageInfo
 func (m *LoadBalancerServicesProxyProtocol) Reset()      { *m = LoadBalancerServicesProxyProtocol{} }
 func (*LoadBalancerServicesProxyProtocol) ProtoMessage() {}
 func (*LoadBalancerServicesProxyProtocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
 }
 func (m *LoadBalancerServicesProxyProtocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2930,7 +3041,7 @@ var xxx_messageInfo_LoadBalancerServicesProxyProtocol proto.InternalMessageInfo
 func (m *Machine) Reset()      { *m = Machine{} }
 func (*Machine) ProtoMessage() {}
 func (*Machine) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
 }
 func (m *Machine) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2958,7 +3069,7 @@ var xxx_messageInfo_Machine proto.InternalMessageInfo
 func (m *MachineControllerManagerSettings) Reset()      { *m = MachineControllerManagerSettings{} }
 func (*MachineControllerManagerSettings) ProtoMessage() {}
 func (*MachineControllerManagerSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
 }
 func (m *MachineControllerManagerSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2986,7 +3097,7 @@ var xxx_messageInfo_MachineControllerManagerSettings proto.InternalMessageInfo
 func (m *MachineImage) Reset()      { *m = MachineImage{} }
 func (*MachineImage) ProtoMessage() {}
 func (*MachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
 }
 func (m *MachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3014,7 +3125,7 @@ var xxx_messageInfo_MachineImage proto.InternalMessageInfo
 func (m *MachineImageFlavor) Reset()      { *m = MachineImageFlavor{} }
 func (*MachineImageFlavor) ProtoMessage() {}
 func (*MachineImageFlavor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
 }
 func (m *MachineImageFlavor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3042,7 +3153,7 @@ var xxx_messageInfo_MachineImageFlavor proto.InternalMessageInfo
 func (m *MachineImageVersion) Reset()      { *m = MachineImageVersion{} }
 func (*MachineImageVersion) ProtoMessage() {}
 func (*MachineImageVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
 }
 func (m *MachineImageVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3070,7 +3181,7 @@ var xxx_messageInfo_MachineImageVersion proto.InternalMessageInfo
 func (m *MachineType) Reset()      { *m = MachineType{} }
 func (*MachineType) ProtoMessage() {}
 func (*MachineType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
 }
 func (m *MachineType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3098,7 +3209,7 @@ var xxx_messageInfo_MachineType proto.InternalMessageInfo
 func (m *MachineTypeStorage) Reset()      { *m = MachineTypeStorage{} }
 func (*MachineTypeStorage) ProtoMessage() {}
 func (*MachineTypeStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
 }
 func (m *MachineTypeStorage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3126,7 +3237,7 @@ var xxx_messageInfo_MachineTypeStorage proto.InternalMessageInfo
 func (m *Maintenance) Reset()      { *m = Maintenance{} }
 func (*Maintenance) ProtoMessage() {}
 func (*Maintenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
 }
 func (m *Maintenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3154,7 +3265,7 @@ var xxx_messageInfo_Maintenance proto.InternalMessageInfo
 func (m *MaintenanceAutoUpdate) Reset()      { *m = MaintenanceAutoUpdate{} }
 func (*MaintenanceAutoUpdate) ProtoMessage() {}
 func (*MaintenanceAutoUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
 }
 func (m *MaintenanceAutoUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3182,7 +3293,7 @@ var xxx_messageInfo_MaintenanceAutoUpdate proto.InternalMessageInfo
 func (m *MaintenanceTimeWindow) Reset()      { *m = MaintenanceTimeWindow{} }
 func (*MaintenanceTimeWindow) ProtoMessage() {}
 func (*MaintenanceTimeWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
 }
 func (m *MaintenanceTimeWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3210,7 +3321,7 @@ var xxx_messageInfo_MaintenanceTimeWindow proto.InternalMessageInfo
 func (m *ManualWorkerPoolRollout) Reset()      { *m = ManualWorkerPoolRollout{} }
 func (*ManualWorkerPoolRollout) ProtoMessage() {}
 func (*ManualWorkerPoolRollout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
 }
 func (m *ManualWorkerPoolRollout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3238,7 +3349,7 @@ var xxx_messageInfo_ManualWorkerPoolRollout proto.InternalMessageInfo
 func (m *MemorySwapConfiguration) Reset()      { *m = MemorySwapConfiguration{} }
 func (*MemorySwapConfiguration) ProtoMessage() {}
 func (*MemorySwapConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
 }
 func (m *MemorySwapConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3266,7 +3377,7 @@ var xxx_messageInfo_MemorySwapConfiguration proto.InternalMessageInfo
 func (m *Monitoring) Reset()      { *m = Monitoring{} }
 func (*Monitoring) ProtoMessage() {}
 func (*Monitoring) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
 }
 func (m *Monitoring) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3294,7 +3405,7 @@ var xxx_messageInfo_Monitoring proto.InternalMessageInfo
 func (m *NamedResourceReference) Reset()      { *m = NamedResourceReference{} }
 func (*NamedResourceReference) ProtoMessage() {}
 func (*NamedResourceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
 }
 func (m *NamedResourceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3322,7 +3433,7 @@ var xxx_messageInfo_NamedResourceReference proto.InternalMessageInfo
 func (m *NamespacedCloudProfile) Reset()      { *m = NamespacedCloudProfile{} }
 func (*NamespacedCloudProfile) ProtoMessage() {}
 func (*NamespacedCloudProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
 }
 func (m *NamespacedCloudProfile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3350,7 +3461,7 @@ var xxx_messageInfo_NamespacedCloudProfile proto.InternalMessageInfo
 func (m *NamespacedCloudProfileList) Reset()      { *m = NamespacedCloudProfileList{} }
 func (*NamespacedCloudProfileList) ProtoMessage() {}
 func (*NamespacedCloudProfileList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
 }
 func (m *NamespacedCloudProfileList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3378,7 +3489,7 @@ var xxx_messageInfo_NamespacedCloudProfileList proto.InternalMessageInfo
 func (m *NamespacedCloudProfileSpec) Reset()      { *m = NamespacedCloudProfileSpec{} }
 func (*NamespacedCloudProfileSpec) ProtoMessage() {}
 func (*NamespacedCloudProfileSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
 }
 func (m *NamespacedCloudProfileSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3406,7 +3517,7 @@ var xxx_messageInfo_NamespacedCloudProfileSpec proto.InternalMessageInfo
 func (m *NamespacedCloudProfileStatus) Reset()      { *m = NamespacedCloudProfileStatus{} }
 func (*NamespacedCloudProfileStatus) ProtoMessage() {}
 func (*NamespacedCloudProfileStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
 }
 func (m *NamespacedCloudProfileStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3434,7 +3545,7 @@ var xxx_messageInfo_NamespacedCloudProfileStatus proto.InternalMessageInfo
 func (m *Networking) Reset()      { *m = Networking{} }
 func (*Networking) ProtoMessage() {}
 func (*Networking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
 }
 func (m *Networking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3462,7 +3573,7 @@ var xxx_messageInfo_Networking proto.InternalMessageInfo
 func (m *NetworkingStatus) Reset()      { *m = NetworkingStatus{} }
 func (*NetworkingStatus) ProtoMessage() {}
 func (*NetworkingStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
 }
 func (m *NetworkingStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3490,7 +3601,7 @@ var xxx_messageInfo_NetworkingStatus proto.InternalMessageInfo
 func (m *NginxIngress) Reset()      { *m = NginxIngress{} }
 func (*NginxIngress) ProtoMessage() {}
 func (*NginxIngress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
 }
 func (m *NginxIngress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3518,7 +3629,7 @@ var xxx_messageInfo_NginxIngress proto.InternalMessageInfo
 func (m *NodeLocalDNS) Reset()      { *m = NodeLocalDNS{} }
 func (*NodeLocalDNS) ProtoMessage() {}
 func (*NodeLocalDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
 }
 func (m *NodeLocalDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3546,7 +3657,7 @@ var xxx_messageInfo_NodeLocalDNS proto.InternalMessageInfo
 func (m *OCIRepository) Reset()      { *m = OCIRepository{} }
 func (*OCIRepository) ProtoMessage() {}
 func (*OCIRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
 }
 func (m *OCIRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3574,7 +3685,7 @@ var xxx_messageInfo_OCIRepository proto.InternalMessageInfo
 func (m *OIDCConfig) Reset()      { *m = OIDCConfig{} }
 func (*OIDCConfig) ProtoMessage() {}
 func (*OIDCConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
 }
 func (m *OIDCConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3602,7 +3713,7 @@ var xxx_messageInfo_OIDCConfig proto.InternalMessageInfo
 func (m *ObservabilityRotation) Reset()      { *m = ObservabilityRotation{} }
 func (*ObservabilityRotation) ProtoMessage() {}
 func (*ObservabilityRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
 }
 func (m *ObservabilityRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3630,7 +3741,7 @@ var xxx_messageInfo_ObservabilityRotation proto.InternalMessageInfo
 func (m *OpenIDConnectClientAuthentication) Reset()      { *m = OpenIDConnectClientAuthentication{} }
 func (*OpenIDConnectClientAuthentication) ProtoMessage() {}
 func (*OpenIDConnectClientAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
 }
 func (m *OpenIDConnectClientAuthentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3658,7 +3769,7 @@ var xxx_messageInfo_OpenIDConnectClientAuthentication proto.InternalMessageInfo
 func (m *PendingWorkerUpdates) Reset()      { *m = PendingWorkerUpdates{} }
 func (*PendingWorkerUpdates) ProtoMessage() {}
 func (*PendingWorkerUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
 }
 func (m *PendingWorkerUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3686,7 +3797,7 @@ var xxx_messageInfo_PendingWorkerUpdates proto.InternalMessageInfo
 func (m *PendingWorkersRollout) Reset()      { *m = PendingWorkersRollout{} }
 func (*PendingWorkersRollout) ProtoMessage() {}
 func (*PendingWorkersRollout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
 }
 func (m *PendingWorkersRollout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3711,10 +3822,38 @@ func (m *PendingWorkersRollout) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PendingWorkersRollout proto.InternalMessageInfo
 
+func (m *Price) Reset()      { *m = Price{} }
+func (*Price) ProtoMessage() {}
+func (*Price) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
+}
+func (m *Price) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Price) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Price) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Price.Merge(m, src)
+}
+func (m *Price) XXX_Size() int {
+	return m.Size()
+}
+func (m *Price) XXX_DiscardUnknown() {
+	xxx_messageInfo_Price.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Price proto.InternalMessageInfo
+
 func (m *Project) Reset()      { *m = Project{} }
 func (*Project) ProtoMessage() {}
 func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
 }
 func (m *Project) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3739,10 +3878,38 @@ func (m *Project) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Project proto.InternalMessageInfo
 
+func (m *ProjectCostBudget) Reset()      { *m = ProjectCostBudget{} }
+func (*ProjectCostBudget) ProtoMessage() {}
+func (*ProjectCostBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
+}
+func (m *ProjectCostBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectCostBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectCostBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectCostBudget.Merge(m, src)
+}
+func (m *ProjectCostBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectCostBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectCostBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectCostBudget proto.InternalMessageInfo
+
 func (m *ProjectList) Reset()      { *m = ProjectList{} }
 func (*ProjectList) ProtoMessage() {}
 func (*ProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
 }
 func (m *ProjectList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3770,7 +3937,7 @@ var xxx_messageInfo_ProjectList proto.InternalMessageInfo
 func (m *ProjectMember) Reset()      { *m = ProjectMember{} }
 func (*ProjectMember) ProtoMessage() {}
 func (*ProjectMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
 }
 func (m *ProjectMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3798,7 +3965,7 @@ var xxx_messageInfo_ProjectMember proto.InternalMessageInfo
 func (m *ProjectSpec) Reset()      { *m = ProjectSpec{} }
 func (*ProjectSpec) ProtoMessage() {}
 func (*ProjectSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
 }
 func (m *ProjectSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3826,7 +3993,7 @@ var xxx_messageInfo_ProjectSpec proto.InternalMessageInfo
 func (m *ProjectStatus) Reset()      { *m = ProjectStatus{} }
 func (*ProjectStatus) ProtoMessage() {}
 func (*ProjectStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
 }
 func (m *ProjectStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3854,7 +4021,7 @@ var xxx_messageInfo_ProjectStatus proto.InternalMessageInfo
 func (m *ProjectTolerations) Reset()      { *m = ProjectTolerations{} }
 func (*ProjectTolerations) ProtoMessage() {}
 func (*ProjectTolerations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
 }
 func (m *ProjectTolerations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3882,7 +4049,7 @@ var xxx_messageInfo_ProjectTolerations proto.InternalMessageInfo
 func (m *Provider) Reset()      { *m = Provider{} }
 func (*Provider) ProtoMessage() {}
 func (*Provider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
 }
 func (m *Provider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3910,7 +4077,7 @@ var xxx_messageInfo_Provider proto.InternalMessageInfo
 func (m *Quota) Reset()      { *m = Quota{} }
 func (*Quota) ProtoMessage() {}
 func (*Quota) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
 }
 func (m *Quota) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3938,7 +4105,7 @@ var xxx_messageInfo_Quota proto.InternalMessageInfo
 func (m *QuotaList) Reset()      { *m = QuotaList{} }
 func (*QuotaList) ProtoMessage() {}
 func (*QuotaList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
 }
 func (m *QuotaList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3966,7 +4133,7 @@ var xxx_messageInfo_QuotaList proto.InternalMessageInfo
 func (m *QuotaSpec) Reset()      { *m = QuotaSpec{} }
 func (*QuotaSpec) ProtoMessage() {}
 func (*QuotaSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
 }
 func (m *QuotaSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3991,10 +4158,38 @@ func (m *QuotaSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuotaSpec proto.InternalMessageInfo
 
+func (m *QuotaStatus) Reset()      { *m = QuotaStatus{} }
+func (*QuotaStatus) ProtoMessage() {}
+func (*QuotaStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
+}
+func (m *QuotaStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QuotaStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaStatus.Merge(m, src)
+}
+func (m *QuotaStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaStatus proto.InternalMessageInfo
+
 func (m *Region) Reset()      { *m = Region{} }
 func (*Region) ProtoMessage() {}
 func (*Region) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
 }
 func (m *Region) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4022,7 +4217,7 @@ var xxx_messageInfo_Region proto.InternalMessageInfo
 func (m *ResourceData) Reset()      { *m = ResourceData{} }
 func (*ResourceData) ProtoMessage() {}
 func (*ResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
 }
 func (m *ResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4050,7 +4245,7 @@ var xxx_messageInfo_ResourceData proto.InternalMessageInfo
 func (m *ResourceWatchCacheSize) Reset()      { *m = ResourceWatchCacheSize{} }
 func (*ResourceWatchCacheSize) ProtoMessage() {}
 func (*ResourceWatchCacheSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
 }
 func (m *ResourceWatchCacheSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4078,7 +4273,7 @@ var xxx_messageInfo_ResourceWatchCacheSize proto.InternalMessageInfo
 func (m *SSHAccess) Reset()      { *m = SSHAccess{} }
 func (*SSHAccess) ProtoMessage() {}
 func (*SSHAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
 }
 func (m *SSHAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4106,7 +4301,7 @@ var xxx_messageInfo_SSHAccess proto.InternalMessageInfo
 func (m *SecretBinding) Reset()      { *m = SecretBinding{} }
 func (*SecretBinding) ProtoMessage() {}
 func (*SecretBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
 }
 func (m *SecretBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4134,7 +4329,7 @@ var xxx_messageInfo_SecretBinding proto.InternalMessageInfo
 func (m *SecretBindingList) Reset()      { *m = SecretBindingList{} }
 func (*SecretBindingList) ProtoMessage() {}
 func (*SecretBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
 }
 func (m *SecretBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4162,7 +4357,7 @@ var xxx_messageInfo_SecretBindingList proto.InternalMessageInfo
 func (m *SecretBindingProvider) Reset()      { *m = SecretBindingProvider{} }
 func (*SecretBindingProvider) ProtoMessage() {}
 func (*SecretBindingProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
 }
 func (m *SecretBindingProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4190,7 +4385,7 @@ var xxx_messageInfo_SecretBindingProvider proto.InternalMessageInfo
 func (m *Seed) Reset()      { *m = Seed{} }
 func (*Seed) ProtoMessage() {}
 func (*Seed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
 }
 func (m *Seed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4218,7 +4413,7 @@ var xxx_messageInfo_Seed proto.InternalMessageInfo
 func (m *SeedDNS) Reset()      { *m = SeedDNS{} }
 func (*SeedDNS) ProtoMessage() {}
 func (*SeedDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
 }
 func (m *SeedDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4246,7 +4441,7 @@ var xxx_messageInfo_SeedDNS proto.InternalMessageInfo
 func (m *SeedDNSProvider) Reset()      { *m = SeedDNSProvider{} }
 func (*SeedDNSProvider) ProtoMessage() {}
 func (*SeedDNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
 }
 func (m *SeedDNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4274,7 +4469,7 @@ var xxx_messageInfo_SeedDNSProvider proto.InternalMessageInfo
 func (m *SeedDNSProviderConfig) Reset()      { *m = SeedDNSProviderConfig{} }
 func (*SeedDNSProviderConfig) ProtoMessage() {}
 func (*SeedDNSProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
 }
 func (m *SeedDNSProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4302,7 +4497,7 @@ var xxx_messageInfo_SeedDNSProviderConfig proto.InternalMessageInfo
 func (m *SeedList) Reset()      { *m = SeedList{} }
 func (*SeedList) ProtoMessage() {}
 func (*SeedList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
 }
 func (m *SeedList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4330,7 +4525,7 @@ var xxx_messageInfo_SeedList proto.InternalMessageInfo
 func (m *SeedNetworks) Reset()      { *m = SeedNetworks{} }
 func (*SeedNetworks) ProtoMessage() {}
 func (*SeedNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
 }
 func (m *SeedNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4358,7 +4553,7 @@ var xxx_messageInfo_SeedNetworks proto.InternalMessageInfo
 func (m *SeedProvider) Reset()      { *m = SeedProvider{} }
 func (*SeedProvider) ProtoMessage() {}
 func (*SeedProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
 }
 func (m *SeedProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4386,7 +4581,7 @@ var xxx_messageInfo_SeedProvider proto.InternalMessageInfo
 func (m *SeedSelector) Reset()      { *m = SeedSelector{} }
 func (*SeedSelector) ProtoMessage() {}
 func (*SeedSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
 }
 func (m *SeedSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4414,7 +4609,7 @@ var xxx_messageInfo_SeedSelector proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdog) Reset()      { *m = SeedSettingDependencyWatchdog{} }
 func (*SeedSettingDependencyWatchdog) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
 }
 func (m *SeedSettingDependencyWatchdog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4442,7 +4637,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdog proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdogProber) Reset()      { *m = SeedSettingDependencyWatchdogProber{} }
 func (*SeedSettingDependencyWatchdogProber) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogProber) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
 }
 func (m *SeedSettingDependencyWatchdogProber) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4470,7 +4665,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogProber proto.InternalMessageInf
 func (m *SeedSettingDependencyWatchdogWeeder) Reset()      { *m = SeedSettingDependencyWatchdogWeeder{} }
 func (*SeedSettingDependencyWatchdogWeeder) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogWeeder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
 }
 func (m *SeedSettingDependencyWatchdogWeeder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4498,7 +4693,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogWeeder proto.InternalMessageInf
 func (m *SeedSettingExcessCapacityReservation) Reset()      { *m = SeedSettingExcessCapacityReservation{} }
 func (*SeedSettingExcessCapacityReservation) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
 }
 func (m *SeedSettingExcessCapacityReservation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4528,7 +4723,7 @@ func (m *SeedSettingExcessCapacityReservationConfig) Reset() {
 }
 func (*SeedSettingExcessCapacityReservationConfig) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservationConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
 }
 func (m *SeedSettingExcessCapacityReservationConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4556,7 +4751,7 @@ var xxx_messageInfo_SeedSettingExcessCapacityReservationConfig proto.InternalMes
 func (m *SeedSettingLoadBalancerServices) Reset()      { *m = SeedSettingLoadBalancerServices{} }
 func (*SeedSettingLoadBalancerServices) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
 }
 func (m *SeedSettingLoadBalancerServices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4586,7 +4781,7 @@ func (m *SeedSettingLoadBalancerServicesZonalIngress) Reset() {
 }
 func (*SeedSettingLoadBalancerServicesZonalIngress) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServicesZonalIngress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
 }
 func (m *SeedSettingLoadBalancerServicesZonalIngress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4614,7 +4809,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServicesZonalIngress proto.InternalMe
 func (m *SeedSettingLoadBalancerServicesZones) Reset()      { *m = SeedSettingLoadBalancerServicesZones{} }
 func (*SeedSettingLoadBalancerServicesZones) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServicesZones) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
 }
 func (m *SeedSettingLoadBalancerServicesZones) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4642,7 +4837,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServicesZones proto.InternalMessageIn
 func (m *SeedSettingScheduling) Reset()      { *m = SeedSettingScheduling{} }
 func (*SeedSettingScheduling) ProtoMessage() {}
 func (*SeedSettingScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
 }
 func (m *SeedSettingScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4670,7 +4865,7 @@ var xxx_messageInfo_SeedSettingScheduling proto.InternalMessageInfo
 func (m *SeedSettingTopologyAwareRouting) Reset()      { *m = SeedSettingTopologyAwareRouting{} }
 func (*SeedSettingTopologyAwareRouting) ProtoMessage() {}
 func (*SeedSettingTopologyAwareRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
 }
 func (m *SeedSettingTopologyAwareRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4698,7 +4893,7 @@ var xxx_messageInfo_SeedSettingTopologyAwareRouting proto.InternalMessageInfo
 func (m *SeedSettingVerticalPodAutoscaler) Reset()      { *m = SeedSettingVerticalPodAutoscaler{} }
 func (*SeedSettingVerticalPodAutoscaler) ProtoMessage() {}
 func (*SeedSettingVerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
 }
 func (m *SeedSettingVerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4726,7 +4921,7 @@ var xxx_messageInfo_SeedSettingVerticalPodAutoscaler proto.InternalMessageInfo
 func (m *SeedSettings) Reset()      { *m = SeedSettings{} }
 func (*SeedSettings) ProtoMessage() {}
 func (*SeedSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
 }
 func (m *SeedSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4754,7 +4949,7 @@ var xxx_messageInfo_SeedSettings proto.InternalMessageInfo
 func (m *SeedSpec) Reset()      { *m = SeedSpec{} }
 func (*SeedSpec) ProtoMessage() {}
 func (*SeedSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{175}
 }
 func (m *SeedSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4782,7 +4977,7 @@ var xxx_messageInfo_SeedSpec proto.InternalMessageInfo
 func (m *SeedStatus) Reset()      { *m = SeedStatus{} }
 func (*SeedStatus) ProtoMessage() {}
 func (*SeedStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{176}
 }
 func (m *SeedStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4810,7 +5005,7 @@ var xxx_messageInfo_SeedStatus proto.InternalMessageInfo
 func (m *SeedTaint) Reset()      { *m = SeedTaint{} }
 func (*SeedTaint) ProtoMessage() {}
 func (*SeedTaint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{177}
 }
 func (m *SeedTaint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4838,7 +5033,7 @@ var xxx_messageInfo_SeedTaint proto.InternalMessageInfo
 func (m *SeedTemplate) Reset()      { *m = SeedTemplate{} }
 func (*SeedTemplate) ProtoMessage() {}
 func (*SeedTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{178}
 }
 func (m *SeedTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4866,7 +5061,7 @@ var xxx_messageInfo_SeedTemplate proto.InternalMessageInfo
 func (m *SeedVolume) Reset()      { *m = SeedVolume{} }
 func (*SeedVolume) ProtoMessage() {}
 func (*SeedVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{179}
 }
 func (m *SeedVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4894,7 +5089,7 @@ var xxx_messageInfo_SeedVolume proto.InternalMessageInfo
 func (m *SeedVolumeProvider) Reset()      { *m = SeedVolumeProvider{} }
 func (*SeedVolumeProvider) ProtoMessage() {}
 func (*SeedVolumeProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{180}
 }
 func (m *SeedVolumeProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4922,7 +5117,7 @@ var xxx_messageInfo_SeedVolumeProvider proto.InternalMessageInfo
 func (m *ServiceAccountConfig) Reset()      { *m = ServiceAccountConfig{} }
 func (*ServiceAccountConfig) ProtoMessage() {}
 func (*ServiceAccountConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{181}
 }
 func (m *ServiceAccountConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4950,7 +5145,7 @@ var xxx_messageInfo_ServiceAccountConfig proto.InternalMessageInfo
 func (m *ServiceAccountKeyRotation) Reset()      { *m = ServiceAccountKeyRotation{} }
 func (*ServiceAccountKeyRotation) ProtoMessage() {}
 func (*ServiceAccountKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{175}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{182}
 }
 func (m *ServiceAccountKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4978,7 +5173,7 @@ var xxx_messageInfo_ServiceAccountKeyRotation proto.InternalMessageInfo
 func (m *Shoot) Reset()      { *m = Shoot{} }
 func (*Shoot) ProtoMessage() {}
 func (*Shoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{176}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{183}
 }
 func (m *Shoot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5006,7 +5201,7 @@ var xxx_messageInfo_Shoot proto.InternalMessageInfo
 func (m *ShootAdvertisedAddress) Reset()      { *m = ShootAdvertisedAddress{} }
 func (*ShootAdvertisedAddress) ProtoMessage() {}
 func (*ShootAdvertisedAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{177}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{184}
 }
 func (m *ShootAdvertisedAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5031,10 +5226,38 @@ func (m *ShootAdvertisedAddress) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ShootAdvertisedAddress proto.InternalMessageInfo
 
+func (m *ShootCostEstimate) Reset()      { *m = ShootCostEstimate{} }
+func (*ShootCostEstimate) ProtoMessage() {}
+func (*ShootCostEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{185}
+}
+func (m *ShootCostEstimate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootCostEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootCostEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootCostEstimate.Merge(m, src)
+}
+func (m *ShootCostEstimate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootCostEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootCostEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootCostEstimate proto.InternalMessageInfo
+
 func (m *ShootCredentials) Reset()      { *m = ShootCredentials{} }
 func (*ShootCredentials) ProtoMessage() {}
 func (*ShootCredentials) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{178}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{186}
 }
 func (m *ShootCredentials) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5062,7 +5285,7 @@ var xxx_messageInfo_ShootCredentials proto.InternalMessageInfo
 func (m *ShootCredentialsRotation) Reset()      { *m = ShootCredentialsRotation{} }
 func (*ShootCredentialsRotation) ProtoMessage() {}
 func (*ShootCredentialsRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{179}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{187}
 }
 func (m *ShootCredentialsRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5090,7 +5313,7 @@ var xxx_messageInfo_ShootCredentialsRotation proto.InternalMessageInfo
 func (m *ShootKubeconfigRotation) Reset()      { *m = ShootKubeconfigRotation{} }
 func (*ShootKubeconfigRotation) ProtoMessage() {}
 func (*ShootKubeconfigRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{180}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{188}
 }
 func (m *ShootKubeconfigRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5118,7 +5341,7 @@ var xxx_messageInfo_ShootKubeconfigRotation proto.InternalMessageInfo
 func (m *ShootList) Reset()      { *m = ShootList{} }
 func (*ShootList) ProtoMessage() {}
 func (*ShootList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{181}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{189}
 }
 func (m *ShootList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5146,7 +5369,7 @@ var xxx_messageInfo_ShootList proto.InternalMessageInfo
 func (m *ShootMachineImage) Reset()      { *m = ShootMachineImage{} }
 func (*ShootMachineImage) ProtoMessage() {}
 func (*ShootMachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{182}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{190}
 }
 func (m *ShootMachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5174,7 +5397,7 @@ var xxx_messageInfo_ShootMachineImage proto.InternalMessageInfo
 func (m *ShootNetworks) Reset()      { *m = ShootNetworks{} }
 func (*ShootNetworks) ProtoMessage() {}
 func (*ShootNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{183}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{191}
 }
 func (m *ShootNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5202,7 +5425,7 @@ var xxx_messageInfo_ShootNetworks proto.InternalMessageInfo
 func (m *ShootSSHKeypairRotation) Reset()      { *m = ShootSSHKeypairRotation{} }
 func (*ShootSSHKeypairRotation) ProtoMessage() {}
 func (*ShootSSHKeypairRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{184}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{192}
 }
 func (m *ShootSSHKeypairRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5230,7 +5453,7 @@ var xxx_messageInfo_ShootSSHKeypairRotation proto.InternalMessageInfo
 func (m *ShootSpec) Reset()      { *m = ShootSpec{} }
 func (*ShootSpec) ProtoMessage() {}
 func (*ShootSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{185}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{193}
 }
 func (m *ShootSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5258,7 +5481,7 @@ var xxx_messageInfo_ShootSpec proto.InternalMessageInfo
 func (m *ShootState) Reset()      { *m = ShootState{} }
 func (*ShootState) ProtoMessage() {}
 func (*ShootState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{186}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{194}
 }
 func (m *ShootState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5286,7 +5509,7 @@ var xxx_messageInfo_ShootState proto.InternalMessageInfo
 func (m *ShootStateList) Reset()      { *m = ShootStateList{} }
 func (*ShootStateList) ProtoMessage() {}
 func (*ShootStateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{187}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{195}
 }
 func (m *ShootStateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5314,7 +5537,7 @@ var xxx_messageInfo_ShootStateList proto.InternalMessageInfo
 func (m *ShootStateSpec) Reset()      { *m = ShootStateSpec{} }
 func (*ShootStateSpec) ProtoMessage() {}
 func (*ShootStateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{188}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{196}
 }
 func (m *ShootStateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5342,7 +5565,7 @@ var xxx_messageInfo_ShootStateSpec proto.InternalMessageInfo
 func (m *ShootStatus) Reset()      { *m = ShootStatus{} }
 func (*ShootStatus) ProtoMessage() {}
 func (*ShootStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{189}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{197}
 }
 func (m *ShootStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5370,7 +5593,7 @@ var xxx_messageInfo_ShootStatus proto.InternalMessageInfo
 func (m *ShootTemplate) Reset()      { *m = ShootTemplate{} }
 func (*ShootTemplate) ProtoMessage() {}
 func (*ShootTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{190}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{198}
 }
 func (m *ShootTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5398,7 +5621,7 @@ var xxx_messageInfo_ShootTemplate proto.InternalMessageInfo
 func (m *StructuredAuthentication) Reset()      { *m = StructuredAuthentication{} }
 func (*StructuredAuthentication) ProtoMessage() {}
 func (*StructuredAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{191}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{199}
 }
 func (m *StructuredAuthentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5426,7 +5649,7 @@ var xxx_messageInfo_StructuredAuthentication proto.InternalMessageInfo
 func (m *StructuredAuthorization) Reset()      { *m = StructuredAuthorization{} }
 func (*StructuredAuthorization) ProtoMessage() {}
 func (*StructuredAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{192}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{200}
 }
 func (m *StructuredAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5454,7 +5677,7 @@ var xxx_messageInfo_StructuredAuthorization proto.InternalMessageInfo
 func (m *SystemComponents) Reset()      { *m = SystemComponents{} }
 func (*SystemComponents) ProtoMessage() {}
 func (*SystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{193}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{201}
 }
 func (m *SystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5482,7 +5705,7 @@ var xxx_messageInfo_SystemComponents proto.InternalMessageInfo
 func (m *Toleration) Reset()      { *m = Toleration{} }
 func (*Toleration) ProtoMessage() {}
 func (*Toleration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{194}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{202}
 }
 func (m *Toleration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5510,7 +5733,7 @@ var xxx_messageInfo_Toleration proto.InternalMessageInfo
 func (m *VerticalPodAutoscaler) Reset()      { *m = VerticalPodAutoscaler{} }
 func (*VerticalPodAutoscaler) ProtoMessage() {}
 func (*VerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{195}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{203}
 }
 func (m *VerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5538,7 +5761,7 @@ var xxx_messageInfo_VerticalPodAutoscaler proto.InternalMessageInfo
 func (m *Volume) Reset()      { *m = Volume{} }
 func (*Volume) ProtoMessage() {}
 func (*Volume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{196}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{204}
 }
 func (m *Volume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5566,7 +5789,7 @@ var xxx_messageInfo_Volume proto.InternalMessageInfo
 func (m *VolumeType) Reset()      { *m = VolumeType{} }
 func (*VolumeType) ProtoMessage() {}
 func (*VolumeType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{197}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{205}
 }
 func (m *VolumeType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5594,7 +5817,7 @@ var xxx_messageInfo_VolumeType proto.InternalMessageInfo
 func (m *WatchCacheSizes) Reset()      { *m = WatchCacheSizes{} }
 func (*WatchCacheSizes) ProtoMessage() {}
 func (*WatchCacheSizes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{198}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{206}
 }
 func (m *WatchCacheSizes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5622,7 +5845,7 @@ var xxx_messageInfo_WatchCacheSizes proto.InternalMessageInfo
 func (m *Worker) Reset()      { *m = Worker{} }
 func (*Worker) ProtoMessage() {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{199}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{207}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5650,7 +5873,7 @@ var xxx_messageInfo_Worker proto.InternalMessageInfo
 func (m *WorkerControlPlane) Reset()      { *m = WorkerControlPlane{} }
 func (*WorkerControlPlane) ProtoMessage() {}
 func (*WorkerControlPlane) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{200}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{208}
 }
 func (m *WorkerControlPlane) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5678,7 +5901,7 @@ var xxx_messageInfo_WorkerControlPlane proto.InternalMessageInfo
 func (m *WorkerKubernetes) Reset()      { *m = WorkerKubernetes{} }
 func (*WorkerKubernetes) ProtoMessage() {}
 func (*WorkerKubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{201}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{209}
 }
 func (m *WorkerKubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5706,7 +5929,7 @@ var xxx_messageInfo_WorkerKubernetes proto.InternalMessageInfo
 func (m *WorkerSystemComponents) Reset()      { *m = WorkerSystemComponents{} }
 func (*WorkerSystemComponents) ProtoMessage() {}
 func (*WorkerSystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{202}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{210}
 }
 func (m *WorkerSystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5734,7 +5957,7 @@ var xxx_messageInfo_WorkerSystemComponents proto.InternalMessageInfo
 func (m *WorkersSettings) Reset()      { *m = WorkersSettings{} }
 func (*WorkersSettings) ProtoMessage() {}
 func (*WorkersSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{203}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{211}
 }
 func (m *WorkersSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5762,6 +5985,10 @@ var xxx_messageInfo_WorkersSettings proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*APIServerLogging)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.APIServerLogging")
 	proto.RegisterType((*APIServerRequests)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.APIServerRequests")
+	proto.RegisterType((*AccessRequest)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AccessRequest")
+	proto.RegisterType((*AccessRequestList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AccessRequestList")
+	proto.RegisterType((*AccessRequestSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AccessRequestSpec")
+	proto.RegisterType((*AccessRequestStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AccessRequestStatus")
 	proto.RegisterType((*AccessRestriction)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AccessRestriction")
 	proto.RegisterType((*AccessRestrictionWithOptions)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AccessRestrictionWithOptions")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AccessRestrictionWithOptions.OptionsEntry")
@@ -5903,7 +6130,9 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OpenIDConnectClientAuthentication.ExtraConfigEntry")
 	proto.RegisterType((*PendingWorkerUpdates)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.PendingWorkerUpdates")
 	proto.RegisterType((*PendingWorkersRollout)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.PendingWorkersRollout")
+	proto.RegisterType((*Price)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Price")
 	proto.RegisterType((*Project)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Project")
+	proto.RegisterType((*ProjectCostBudget)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectCostBudget")
 	proto.RegisterType((*ProjectList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectList")
 	proto.RegisterType((*ProjectMember)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectMember")
 	proto.RegisterType((*ProjectSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectSpec")
@@ -5914,6 +6143,9 @@ func init() {
 	proto.RegisterType((*QuotaList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaList")
 	proto.RegisterType((*QuotaSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec")
 	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec.MetricsEntry")
+	proto.RegisterType((*QuotaStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaStatus")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaStatus.HardEntry")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaStatus.UsedEntry")
 	proto.RegisterType((*Region)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Region")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Region.LabelsEntry")
 	proto.RegisterType((*ResourceData)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ResourceData")
@@ -5960,6 +6192,7 @@ func init() {
 	proto.RegisterType((*ServiceAccountKeyRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ServiceAccountKeyRotation")
 	proto.RegisterType((*Shoot)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Shoot")
 	proto.RegisterType((*ShootAdvertisedAddress)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootAdvertisedAddress")
+	proto.RegisterType((*ShootCostEstimate)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootCostEstimate")
 	proto.RegisterType((*ShootCredentials)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootCredentials")
 	proto.RegisterType((*ShootCredentialsRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootCredentialsRotation")
 	proto.RegisterType((*ShootKubeconfigRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootKubeconfigRotation")
@@ -5998,937 +6231,966 @@ func init() {
 }
 
 var fileDescriptor_ca37af0df9a5bbd2 = []byte{
-	// 14874 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x70, 0x24, 0xd9,
-	0x55, 0x20, 0xec, 0x2c, 0xbd, 0x8f, 0x1e, 0x2d, 0xdd, 0x7e, 0x69, 0xd4, 0x8f, 0x6a, 0xe7, 0xd8,
-	0x66, 0x86, 0xb1, 0xd5, 0x78, 0xfc, 0x18, 0x7b, 0xcc, 0x78, 0x2c, 0x95, 0xd4, 0xdd, 0xe5, 0x96,
-	0xd4, 0xf2, 0x29, 0xf5, 0xcc, 0x78, 0x0c, 0x63, 0xb2, 0xab, 0xae, 0x4a, 0x39, 0x9d, 0x95, 0x59,
-	0x93, 0x99, 0xa5, 0x96, 0x66, 0x6c, 0x8c, 0x0d, 0xe6, 0xb3, 0x8d, 0xe1, 0xe3, 0xed, 0xcf, 0x36,
-	0x04, 0xc6, 0x04, 0xe6, 0xfb, 0x3e, 0xbe, 0xcf, 0x7c, 0x01, 0xc1, 0x17, 0x01, 0x04, 0x04, 0x10,
-	0xc1, 0x62, 0x08, 0xcc, 0x12, 0x18, 0x76, 0x4d, 0x00, 0x02, 0x6b, 0xbd, 0xb0, 0x11, 0xfb, 0x8c,
-	0x25, 0x62, 0x89, 0xe8, 0x25, 0xd8, 0x8d, 0xfb, 0xc8, 0xcc, 0x9b, 0xaf, 0x52, 0x29, 0x4b, 0x92,
-	0x67, 0x16, 0x7e, 0x49, 0x75, 0xcf, 0xbd, 0xe7, 0xdc, 0x57, 0x9e, 0x7b, 0xce, 0xb9, 0xe7, 0x9e,
-	0x03, 0x8b, 0x4d, 0xd3, 0xdf, 0xea, 0xdc, 0x99, 0xaf, 0x3b, 0xad, 0xab, 0x4d, 0xc3, 0x6d, 0x50,
-	0x9b, 0xba, 0xd1, 0x3f, 0xed, 0xbb, 0xcd, 0xab, 0x46, 0xdb, 0xf4, 0xae, 0xd6, 0x1d, 0x97, 0x5e,
-	0xdd, 0x7e, 0xe3, 0x1d, 0xea, 0x1b, 0x6f, 0xbc, 0xda, 0x64, 0x30, 0xc3, 0xa7, 0x8d, 0xf9, 0xb6,
-	0xeb, 0xf8, 0x0e, 0x79, 0x34, 0xc2, 0x31, 0x1f, 0x34, 0x8d, 0xfe, 0x69, 0xdf, 0x6d, 0xce, 0x33,
-	0x1c, 0xf3, 0x0c, 0xc7, 0xbc, 0xc4, 0x31, 0xf7, 0x06, 0x95, 0xae, 0xd3, 0x74, 0xae, 0x72, 0x54,
-	0x77, 0x3a, 0x9b, 0xfc, 0x17, 0xff, 0xc1, 0xff, 0x13, 0x24, 0xe6, 0x1e, 0xbe, 0xfb, 0x36, 0x6f,
-	0xde, 0x74, 0x58, 0x67, 0xae, 0x1a, 0x1d, 0xdf, 0xf1, 0xea, 0x86, 0x65, 0xda, 0xcd, 0xab, 0xdb,
-	0xa9, 0xde, 0xcc, 0xe9, 0x4a, 0x55, 0xd9, 0xed, 0xae, 0x75, 0xdc, 0x3b, 0x46, 0x3d, 0xab, 0xce,
-	0x8d, 0xa8, 0x0e, 0xdd, 0xf1, 0xa9, 0xed, 0x99, 0x8e, 0xed, 0xbd, 0x81, 0x8d, 0x84, 0xba, 0xdb,
-	0xea, 0xdc, 0xc4, 0x2a, 0x64, 0x61, 0x7a, 0x73, 0x84, 0xa9, 0x65, 0xd4, 0xb7, 0x4c, 0x9b, 0xba,
-	0xbb, 0x41, 0xf3, 0xab, 0x2e, 0xf5, 0x9c, 0x8e, 0x5b, 0xa7, 0x87, 0x6a, 0xe5, 0x5d, 0x6d, 0x51,
-	0xdf, 0xc8, 0xa2, 0x75, 0x35, 0xaf, 0x95, 0xdb, 0xb1, 0x7d, 0xb3, 0x95, 0x26, 0xf3, 0xd6, 0x83,
-	0x1a, 0x78, 0xf5, 0x2d, 0xda, 0x32, 0x52, 0xed, 0xde, 0x94, 0xd7, 0xae, 0xe3, 0x9b, 0xd6, 0x55,
-	0xd3, 0xf6, 0x3d, 0xdf, 0x4d, 0x36, 0xd2, 0x3f, 0xa1, 0xc1, 0xf4, 0xc2, 0x7a, 0xb5, 0xc6, 0x67,
-	0x70, 0xc5, 0x69, 0x36, 0x4d, 0xbb, 0x49, 0x1e, 0x81, 0xb1, 0x6d, 0xea, 0xde, 0x71, 0x3c, 0xd3,
-	0xdf, 0x9d, 0xd5, 0xae, 0x68, 0x0f, 0x0d, 0x2d, 0x4e, 0xee, 0xef, 0x95, 0xc7, 0x9e, 0x0a, 0x0a,
-	0x31, 0x82, 0x93, 0x2a, 0x9c, 0xde, 0xf2, 0xfd, 0xf6, 0x42, 0xbd, 0x4e, 0x3d, 0x2f, 0xac, 0x31,
-	0x5b, 0xe2, 0xcd, 0xce, 0xef, 0xef, 0x95, 0x4f, 0xdf, 0xd8, 0xd8, 0x58, 0x4f, 0x80, 0x31, 0xab,
-	0x8d, 0xfe, 0x8b, 0x1a, 0xcc, 0x84, 0x9d, 0x41, 0xfa, 0x42, 0x87, 0x7a, 0xbe, 0x47, 0x10, 0xce,
-	0xb5, 0x8c, 0x9d, 0x35, 0xc7, 0x5e, 0xed, 0xf8, 0x86, 0x6f, 0xda, 0xcd, 0xaa, 0xbd, 0x69, 0x99,
-	0xcd, 0x2d, 0x5f, 0x76, 0x6d, 0x6e, 0x7f, 0xaf, 0x7c, 0x6e, 0x35, 0xb3, 0x06, 0xe6, 0xb4, 0x64,
-	0x9d, 0x6e, 0x19, 0x3b, 0x29, 0x84, 0x4a, 0xa7, 0x57, 0xd3, 0x60, 0xcc, 0x6a, 0xa3, 0xbf, 0x05,
-	0x66, 0xc4, 0x38, 0x90, 0x7a, 0xbe, 0x6b, 0xd6, 0x7d, 0xd3, 0xb1, 0xc9, 0x15, 0x18, 0xb4, 0x8d,
-	0x16, 0xe5, 0x3d, 0x1c, 0x5b, 0x9c, 0xf8, 0xd2, 0x5e, 0xf9, 0x55, 0xfb, 0x7b, 0xe5, 0xc1, 0x35,
-	0xa3, 0x45, 0x91, 0x43, 0xf4, 0xff, 0x56, 0x82, 0x8b, 0xa9, 0x76, 0x4f, 0x9b, 0xfe, 0xd6, 0xad,
-	0x36, 0xfb, 0xcf, 0x23, 0x3f, 0xa0, 0xc1, 0x8c, 0x91, 0xac, 0xc0, 0x11, 0x8e, 0x3f, 0xba, 0x3c,
-	0x7f, 0xf8, 0x0f, 0x7c, 0x3e, 0x45, 0x6d, 0xf1, 0x01, 0xd9, 0xaf, 0xf4, 0x00, 0x30, 0x4d, 0x9a,
-	0x7c, 0x4c, 0x83, 0x11, 0x47, 0x74, 0x6e, 0xb6, 0x74, 0x65, 0xe0, 0xa1, 0xf1, 0x47, 0xbf, 0xfd,
-	0x48, 0xba, 0xa1, 0x0c, 0x7a, 0x5e, 0xfe, 0x5d, 0xb6, 0x7d, 0x77, 0x77, 0xf1, 0x94, 0xec, 0xde,
-	0x88, 0x2c, 0xc5, 0x80, 0xfc, 0xdc, 0xe3, 0x30, 0xa1, 0xd6, 0x24, 0xd3, 0x30, 0x70, 0x97, 0x8a,
-	0xad, 0x3a, 0x86, 0xec, 0x5f, 0x72, 0x06, 0x86, 0xb6, 0x0d, 0xab, 0x43, 0xf9, 0x92, 0x8e, 0xa1,
-	0xf8, 0xf1, 0x78, 0xe9, 0x6d, 0x9a, 0xfe, 0x28, 0x0c, 0x2d, 0x34, 0x1a, 0x8e, 0x4d, 0x1e, 0x86,
-	0x11, 0x6a, 0x1b, 0x77, 0x2c, 0xda, 0xe0, 0x0d, 0x47, 0x23, 0x7a, 0xcb, 0xa2, 0x18, 0x03, 0xb8,
-	0xfe, 0x63, 0x25, 0x18, 0xe6, 0x8d, 0x3c, 0xf2, 0xc3, 0x1a, 0x9c, 0xbe, 0xdb, 0xb9, 0x43, 0x5d,
-	0x9b, 0xfa, 0xd4, 0x5b, 0x32, 0xbc, 0xad, 0x3b, 0x8e, 0xe1, 0x36, 0xe4, 0xc2, 0x5c, 0x2f, 0x32,
-	0x23, 0x37, 0xd3, 0xe8, 0xc4, 0x1e, 0xcc, 0x00, 0x60, 0x16, 0x71, 0xb2, 0x0d, 0x13, 0x76, 0xd3,
-	0xb4, 0x77, 0xaa, 0x76, 0xd3, 0xa5, 0x9e, 0xc7, 0x07, 0x3d, 0xfe, 0xe8, 0xbb, 0x8a, 0x74, 0x66,
-	0x4d, 0xc1, 0xb3, 0x38, 0xbd, 0xbf, 0x57, 0x9e, 0x50, 0x4b, 0x30, 0x46, 0x47, 0xff, 0x47, 0x0d,
-	0x4e, 0x2d, 0x34, 0x5a, 0xa6, 0xc7, 0x38, 0xed, 0xba, 0xd5, 0x69, 0x9a, 0x3d, 0x6c, 0x7d, 0xf2,
-	0x1e, 0x18, 0xae, 0x3b, 0xf6, 0xa6, 0xd9, 0x94, 0xfd, 0x7c, 0xc3, 0xbc, 0xe0, 0x5c, 0xf3, 0x2a,
-	0xe7, 0xe2, 0xdd, 0x93, 0x1c, 0x6f, 0x1e, 0x8d, 0x7b, 0xcb, 0x01, 0x43, 0x5f, 0x84, 0xfd, 0xbd,
-	0xf2, 0x70, 0x85, 0x23, 0x40, 0x89, 0x88, 0x3c, 0x04, 0xa3, 0x0d, 0xd3, 0x13, 0x8b, 0x39, 0xc0,
-	0x17, 0x73, 0x62, 0x7f, 0xaf, 0x3c, 0xba, 0x24, 0xcb, 0x30, 0x84, 0x92, 0x15, 0x38, 0xc3, 0x66,
-	0x50, 0xb4, 0xab, 0xd1, 0xba, 0x4b, 0x7d, 0xd6, 0xb5, 0xd9, 0x41, 0xde, 0xdd, 0xd9, 0xfd, 0xbd,
-	0xf2, 0x99, 0x9b, 0x19, 0x70, 0xcc, 0x6c, 0xa5, 0x5f, 0x83, 0xd1, 0x05, 0x8b, 0xba, 0x8c, 0x21,
-	0x90, 0xc7, 0x61, 0x8a, 0xb6, 0x0c, 0xd3, 0x42, 0x5a, 0xa7, 0xe6, 0x36, 0x75, 0xbd, 0x59, 0xed,
-	0xca, 0xc0, 0x43, 0x63, 0x8b, 0x64, 0x7f, 0xaf, 0x3c, 0xb5, 0x1c, 0x83, 0x60, 0xa2, 0xa6, 0xfe,
-	0x61, 0x0d, 0xc6, 0x17, 0x3a, 0x0d, 0xd3, 0x17, 0xe3, 0x22, 0x2e, 0x8c, 0x1b, 0xec, 0xe7, 0xba,
-	0x63, 0x99, 0xf5, 0x5d, 0xb9, 0xb9, 0x9e, 0x2c, 0xf4, 0xb9, 0x45, 0x68, 0x16, 0x4f, 0xed, 0xef,
-	0x95, 0xc7, 0x95, 0x02, 0x54, 0x89, 0xe8, 0x5b, 0xa0, 0xc2, 0xc8, 0x7b, 0x61, 0x42, 0x0c, 0x77,
-	0xd5, 0x68, 0x23, 0xdd, 0x94, 0x7d, 0x78, 0x50, 0x59, 0xab, 0x80, 0xd0, 0xfc, 0xad, 0x3b, 0xcf,
-	0xd3, 0xba, 0x8f, 0x74, 0x93, 0xba, 0xd4, 0xae, 0x53, 0xb1, 0x6d, 0x2a, 0x4a, 0x63, 0x8c, 0xa1,
-	0xd2, 0x7f, 0x44, 0x83, 0x4b, 0x0b, 0x1d, 0x7f, 0xcb, 0x71, 0xcd, 0x17, 0xa9, 0x1b, 0x4d, 0x77,
-	0x88, 0x81, 0xbc, 0x13, 0xa6, 0x8c, 0xb0, 0xc2, 0x5a, 0xb4, 0x9d, 0xce, 0xc9, 0xed, 0x34, 0xb5,
-	0x10, 0x83, 0x62, 0xa2, 0x36, 0x79, 0x14, 0xc0, 0x8b, 0xd6, 0x96, 0xf3, 0x80, 0x45, 0x22, 0xdb,
-	0x82, 0xb2, 0xaa, 0x4a, 0x2d, 0xfd, 0xaf, 0xd8, 0x51, 0xb8, 0x6d, 0x98, 0x96, 0x71, 0xc7, 0xb4,
-	0x4c, 0x7f, 0xf7, 0x59, 0xc7, 0xa6, 0x3d, 0xec, 0xe6, 0xdb, 0x70, 0xbe, 0x63, 0x1b, 0xa2, 0x9d,
-	0x45, 0x57, 0xc5, 0xfe, 0xdd, 0xd8, 0x6d, 0x53, 0xc1, 0x25, 0xc7, 0x16, 0x2f, 0xec, 0xef, 0x95,
-	0xcf, 0xdf, 0xce, 0xae, 0x82, 0x79, 0x6d, 0xd9, 0xa9, 0xa7, 0x80, 0x9e, 0x72, 0xac, 0x4e, 0x4b,
-	0x62, 0x1d, 0xe0, 0x58, 0xf9, 0xa9, 0x77, 0x3b, 0xb3, 0x06, 0xe6, 0xb4, 0xd4, 0xbf, 0x50, 0x82,
-	0xe1, 0x45, 0xa3, 0x7e, 0xb7, 0xd3, 0x26, 0xaf, 0x87, 0xd1, 0xb6, 0xeb, 0x6c, 0x9b, 0x0d, 0xea,
-	0xca, 0xb1, 0x4d, 0xcb, 0xb1, 0x8d, 0xae, 0xcb, 0x72, 0x0c, 0x6b, 0x10, 0x13, 0xa6, 0x82, 0xff,
-	0x2b, 0x7d, 0x7c, 0xb9, 0xfc, 0x4b, 0x58, 0x8f, 0x21, 0xc2, 0x04, 0x62, 0xa2, 0xc3, 0xb0, 0x4b,
-	0x9b, 0xec, 0xa8, 0x1b, 0xe0, 0xdd, 0xe2, 0x5f, 0x3b, 0xf2, 0x12, 0x94, 0x10, 0xf2, 0x7e, 0x98,
-	0xaa, 0xbb, 0xb4, 0x41, 0x6d, 0xdf, 0x34, 0x2c, 0x8f, 0x6d, 0xce, 0xa1, 0xde, 0x37, 0x27, 0xef,
-	0x44, 0x25, 0xd6, 0x1c, 0x13, 0xe8, 0xf4, 0x2f, 0x95, 0x60, 0x42, 0x4c, 0xd4, 0x62, 0xa7, 0x7e,
-	0x97, 0xfa, 0xe4, 0x3b, 0x60, 0x94, 0xc9, 0x77, 0x0d, 0xc3, 0x37, 0xe4, 0x87, 0xf0, 0x2d, 0xb9,
-	0x43, 0xe7, 0xdf, 0x20, 0xab, 0x1d, 0x51, 0x5f, 0xa5, 0xbe, 0x11, 0xed, 0xbf, 0xa8, 0x0c, 0x43,
-	0xac, 0x64, 0x13, 0x06, 0xbd, 0x36, 0xad, 0xcb, 0x89, 0x5d, 0x2a, 0xf2, 0xa9, 0xab, 0x3d, 0xae,
-	0xb5, 0x69, 0x3d, 0xda, 0xae, 0xec, 0x17, 0x72, 0xfc, 0xc4, 0x86, 0x61, 0xcf, 0x37, 0xfc, 0x8e,
-	0xc7, 0xe7, 0x77, 0xfc, 0xd1, 0x6b, 0x7d, 0x53, 0xe2, 0xd8, 0x16, 0xa7, 0x24, 0xad, 0x61, 0xf1,
-	0x1b, 0x25, 0x15, 0xfd, 0x5f, 0x6b, 0x30, 0xad, 0x56, 0x5f, 0x31, 0x3d, 0x9f, 0x7c, 0x5b, 0x6a,
-	0x3a, 0xe7, 0x7b, 0x9b, 0x4e, 0xd6, 0x9a, 0x4f, 0x66, 0xb8, 0x5b, 0x83, 0x12, 0x65, 0x2a, 0x29,
-	0x0c, 0x99, 0x3e, 0x6d, 0x05, 0x52, 0xca, 0xbb, 0xfa, 0x1d, 0xe1, 0xe2, 0xa4, 0x24, 0x36, 0x54,
-	0x65, 0x68, 0x51, 0x60, 0xd7, 0xbf, 0x03, 0xce, 0xa8, 0xb5, 0x82, 0x7d, 0xcd, 0x58, 0x86, 0xbf,
-	0xdb, 0x4e, 0xb1, 0x0c, 0xf6, 0x09, 0x22, 0x87, 0x90, 0xd7, 0x85, 0x7b, 0x5c, 0x70, 0xa6, 0x70,
-	0xee, 0xe2, 0xfb, 0x5c, 0xff, 0x97, 0x03, 0xf1, 0xb9, 0x63, 0xcb, 0x48, 0xb6, 0x13, 0x5f, 0xee,
-	0xf8, 0xa3, 0x37, 0xfa, 0x1d, 0x60, 0xd0, 0xf5, 0x97, 0x0b, 0x0f, 0x78, 0x08, 0x46, 0x3d, 0x4a,
-	0x1b, 0xca, 0xb9, 0xcc, 0x4f, 0xf3, 0x9a, 0x2c, 0xc3, 0x10, 0x7a, 0xec, 0x9c, 0x80, 0xac, 0xc2,
-	0xa8, 0xb7, 0xe5, 0x38, 0xac, 0xd5, 0xec, 0x70, 0xef, 0xa8, 0x45, 0x7f, 0x65, 0x43, 0x0c, 0x51,
-	0xe8, 0x9f, 0x1b, 0x04, 0x92, 0xfe, 0x78, 0xd4, 0xb9, 0x15, 0x25, 0x72, 0x65, 0xfb, 0x99, 0x5b,
-	0xf9, 0x1d, 0x26, 0x10, 0x93, 0x17, 0x61, 0xd2, 0x32, 0x3c, 0xff, 0x56, 0x9b, 0xa9, 0x81, 0xc1,
-	0x16, 0x1c, 0x7f, 0x74, 0xa1, 0xc8, 0x1e, 0x5a, 0x51, 0x11, 0x2d, 0xce, 0xec, 0xef, 0x95, 0x27,
-	0x63, 0x45, 0x18, 0x27, 0x45, 0x9e, 0x87, 0x31, 0x56, 0xb0, 0xec, 0xba, 0x8e, 0x2b, 0xd9, 0xcf,
-	0x13, 0x45, 0xe9, 0x72, 0x24, 0x42, 0x2d, 0x0d, 0x7f, 0x62, 0x84, 0x9e, 0xbc, 0x1b, 0x88, 0x73,
-	0x87, 0x1b, 0x06, 0x1a, 0xd7, 0x85, 0xce, 0xcb, 0x06, 0xcb, 0x76, 0xd3, 0xc0, 0xe2, 0x9c, 0xdc,
-	0xe6, 0xe4, 0x56, 0xaa, 0x06, 0x66, 0xb4, 0x22, 0x77, 0x81, 0x84, 0x7a, 0xb3, 0x10, 0x1e, 0x0e,
-	0xd8, 0x69, 0x61, 0x25, 0xb9, 0x1d, 0xce, 0x31, 0x62, 0xd7, 0x53, 0x28, 0x30, 0x03, 0xad, 0xfe,
-	0x3b, 0x25, 0x18, 0x17, 0x5b, 0x44, 0xe8, 0x36, 0xc7, 0x7f, 0xf4, 0xd0, 0xd8, 0xd1, 0x53, 0x29,
-	0xce, 0x4d, 0x78, 0x87, 0x73, 0x4f, 0x9e, 0x56, 0xe2, 0xe4, 0x59, 0xee, 0x97, 0x50, 0xf7, 0x83,
-	0xe7, 0x4f, 0x35, 0x38, 0xa5, 0xd4, 0x3e, 0x81, 0x73, 0xa7, 0x11, 0x3f, 0x77, 0x9e, 0xec, 0x73,
-	0x7c, 0x39, 0xc7, 0xce, 0x6f, 0xc4, 0xc7, 0xc5, 0xcf, 0x84, 0x47, 0x01, 0xee, 0x70, 0x7e, 0xa2,
-	0x88, 0xca, 0xe1, 0x9a, 0x2f, 0x86, 0x10, 0x54, 0x6a, 0xc5, 0x98, 0x6c, 0xa9, 0x2b, 0x93, 0x55,
-	0x79, 0xe0, 0x40, 0xff, 0x3c, 0xf0, 0xdf, 0x0e, 0xc0, 0x4c, 0x6a, 0x19, 0xd3, 0x7c, 0x49, 0xfb,
-	0x06, 0xf1, 0xa5, 0xd2, 0x37, 0x82, 0x2f, 0x0d, 0x14, 0xe2, 0x4b, 0xbd, 0x9f, 0x93, 0x2e, 0x90,
-	0x96, 0xd9, 0x14, 0xcd, 0x6a, 0xbe, 0xe1, 0xfa, 0x1b, 0x66, 0x8b, 0x4a, 0x0e, 0xf6, 0xcd, 0xbd,
-	0x7d, 0x02, 0xac, 0x85, 0x60, 0x64, 0xab, 0x29, 0x4c, 0x98, 0x81, 0x5d, 0xff, 0xee, 0x12, 0x8c,
-	0x2c, 0x1a, 0x1e, 0xef, 0xe9, 0x07, 0x61, 0x42, 0xa2, 0xae, 0xb6, 0x8c, 0x26, 0xed, 0xc7, 0x5a,
-	0x22, 0x51, 0xae, 0x2a, 0xe8, 0x84, 0xc2, 0xa9, 0x96, 0x60, 0x8c, 0x1c, 0xd9, 0x85, 0xf1, 0x56,
-	0xa4, 0x5c, 0xc9, 0x25, 0xbe, 0xd6, 0x3f, 0x75, 0x86, 0x4d, 0x68, 0xd5, 0x4a, 0x01, 0xaa, 0xb4,
-	0xf4, 0xe7, 0xe0, 0x74, 0x46, 0x8f, 0x7b, 0xd0, 0x2b, 0x5f, 0x0b, 0x23, 0xdb, 0xd4, 0xf5, 0x22,
-	0x29, 0x71, 0x7c, 0x7f, 0xaf, 0x3c, 0xf2, 0x94, 0x28, 0xc2, 0x00, 0xa6, 0xbf, 0x95, 0x09, 0x14,
-	0xc9, 0x3e, 0xf5, 0x62, 0x7f, 0x1c, 0x02, 0xa8, 0x2c, 0xa0, 0xe3, 0x8b, 0xad, 0xf4, 0x24, 0x0c,
-	0xb5, 0xb7, 0x0c, 0x2f, 0x68, 0xf1, 0x70, 0xc0, 0x7a, 0xd6, 0x59, 0xe1, 0xfd, 0xbd, 0xf2, 0xac,
-	0x2a, 0x27, 0xc9, 0x46, 0x1c, 0x86, 0xa2, 0x1d, 0xdb, 0x61, 0x6c, 0x93, 0x57, 0x9c, 0x56, 0xdb,
-	0xa2, 0x0c, 0xca, 0x77, 0x58, 0xa9, 0xd8, 0x0e, 0x5b, 0x49, 0x61, 0xc2, 0x0c, 0xec, 0x01, 0xcd,
-	0xaa, 0x6d, 0xfa, 0xa6, 0x11, 0xd2, 0x1c, 0x28, 0x4e, 0x33, 0x8e, 0x09, 0x33, 0xb0, 0x93, 0x4f,
-	0x68, 0x30, 0x17, 0x2f, 0xbe, 0x66, 0xda, 0xa6, 0xb7, 0x45, 0x1b, 0x9c, 0xf8, 0xe0, 0xa1, 0x89,
-	0x5f, 0xde, 0xdf, 0x2b, 0xcf, 0xad, 0xe4, 0x62, 0xc4, 0x2e, 0xd4, 0xc8, 0xf7, 0x6b, 0x70, 0x21,
-	0x31, 0x2f, 0xae, 0xd9, 0x6c, 0x52, 0x57, 0xf6, 0xe6, 0xf0, 0x1f, 0x78, 0x79, 0x7f, 0xaf, 0x7c,
-	0x61, 0x25, 0x1f, 0x25, 0x76, 0xa3, 0x47, 0x7e, 0x5a, 0x83, 0x73, 0x6d, 0x6a, 0x37, 0x4c, 0xbb,
-	0xf9, 0xb4, 0xe3, 0xde, 0xa5, 0xae, 0x87, 0x8e, 0x65, 0x39, 0x1d, 0xdf, 0x9b, 0x1d, 0xe6, 0x67,
-	0x62, 0xb5, 0xc8, 0x37, 0xb7, 0x9e, 0x85, 0x71, 0xf1, 0xb2, 0xdc, 0xa2, 0xe7, 0x32, 0xc1, 0x1e,
-	0xe6, 0x74, 0x44, 0xff, 0x6d, 0x0d, 0x06, 0x2a, 0x58, 0x25, 0x8f, 0xc4, 0x3e, 0x91, 0xf3, 0xea,
-	0x27, 0x72, 0x7f, 0xaf, 0x3c, 0x52, 0xc1, 0xaa, 0xf2, 0x31, 0x7e, 0xbf, 0x06, 0x33, 0x75, 0xc7,
-	0xf6, 0x0d, 0x36, 0x77, 0x28, 0x64, 0xef, 0xe0, 0x9c, 0x2f, 0xa4, 0xab, 0x57, 0x12, 0xc8, 0x22,
-	0x5b, 0x7c, 0x12, 0xe2, 0x61, 0x9a, 0xb2, 0xfe, 0x79, 0x0d, 0xce, 0x54, 0x8c, 0xb6, 0xb4, 0x54,
-	0x2d, 0xd1, 0x4d, 0x93, 0x6d, 0x90, 0x5e, 0x2e, 0x1e, 0xc8, 0x16, 0x0c, 0x73, 0x63, 0xb8, 0xd7,
-	0x8f, 0xa9, 0x21, 0xa2, 0xfd, 0x14, 0xc7, 0x25, 0xcc, 0x34, 0xe2, 0x7f, 0x94, 0xf8, 0xf5, 0x27,
-	0x60, 0x3a, 0x59, 0x8f, 0x94, 0x03, 0x19, 0x49, 0xd8, 0x46, 0xc7, 0x92, 0xe2, 0xcd, 0xe3, 0xa3,
-	0xff, 0xc7, 0xe7, 0xca, 0xaf, 0xfa, 0xae, 0xbf, 0xb8, 0xf2, 0x2a, 0xfd, 0xab, 0x1a, 0x4c, 0x54,
-	0x2c, 0xa7, 0xd3, 0x58, 0x77, 0x9d, 0x4d, 0xd3, 0xa2, 0xaf, 0x0c, 0x23, 0x8c, 0xda, 0xe3, 0x3c,
-	0x51, 0x98, 0x1b, 0x45, 0xd4, 0x8a, 0xaf, 0x10, 0xa3, 0x88, 0xda, 0xe5, 0x1c, 0xe9, 0xf4, 0x7d,
-	0x70, 0x56, 0xad, 0x15, 0x59, 0x74, 0xaf, 0xc0, 0xe0, 0x5d, 0xd3, 0x6e, 0x24, 0x37, 0xe6, 0x4d,
-	0xd3, 0x6e, 0x20, 0x87, 0x84, 0x5b, 0xb7, 0x94, 0x7b, 0x66, 0xfd, 0xdd, 0x58, 0x7c, 0xda, 0xb8,
-	0xec, 0xfb, 0x10, 0x8c, 0xd6, 0x8d, 0xc5, 0x8e, 0xdd, 0xb0, 0xc2, 0x5d, 0xcf, 0xa6, 0xa0, 0xb2,
-	0x20, 0xca, 0x30, 0x84, 0x92, 0x17, 0x01, 0xa2, 0xcb, 0x93, 0x7e, 0x84, 0x80, 0xe8, 0x5e, 0xa6,
-	0x46, 0x7d, 0xdf, 0xb4, 0x9b, 0x5e, 0xb4, 0xaf, 0x22, 0x18, 0x2a, 0xd4, 0xc8, 0x07, 0x61, 0x52,
-	0x95, 0x48, 0x84, 0x15, 0xb7, 0xe0, 0x32, 0xc4, 0x44, 0x9f, 0xb3, 0x92, 0xf0, 0xa4, 0x5a, 0xea,
-	0x61, 0x9c, 0x1a, 0xd9, 0x0d, 0xe5, 0x2f, 0x61, 0x43, 0x1e, 0x2c, 0xae, 0xa1, 0xa8, 0xa2, 0xcf,
-	0x19, 0x49, 0x7c, 0x22, 0x66, 0xd3, 0x8e, 0x91, 0xca, 0xb0, 0x1b, 0x0d, 0x1d, 0x97, 0xdd, 0x88,
-	0xc2, 0x88, 0xb0, 0x9c, 0x05, 0xc7, 0xcd, 0xe3, 0x45, 0x06, 0x28, 0x8c, 0x70, 0xd1, 0x6d, 0xa0,
-	0xf8, 0xed, 0x61, 0x80, 0x9b, 0x6c, 0xc3, 0x04, 0x13, 0xac, 0x6b, 0xd4, 0xa2, 0x75, 0xdf, 0x71,
-	0x67, 0x47, 0x8a, 0xdf, 0xb6, 0xd5, 0x14, 0x3c, 0x42, 0x8a, 0x55, 0x4b, 0x30, 0x46, 0x27, 0x34,
-	0x2c, 0x8e, 0xe6, 0x1a, 0x16, 0x3b, 0x30, 0xbe, 0xad, 0xdc, 0x14, 0x8c, 0xf1, 0x49, 0x78, 0x67,
-	0x91, 0x8e, 0x45, 0xd7, 0x06, 0x8b, 0xa7, 0x25, 0xa1, 0x71, 0xf5, 0x8a, 0x41, 0xa5, 0x43, 0xee,
-	0xc0, 0xc8, 0x1d, 0x21, 0x83, 0xce, 0x02, 0x9f, 0x8b, 0x77, 0xf4, 0x21, 0x5a, 0x0b, 0x39, 0x57,
-	0xfe, 0xc0, 0x00, 0x31, 0x79, 0x0e, 0x86, 0x2d, 0xb3, 0x65, 0xfa, 0xde, 0xec, 0x38, 0x27, 0x51,
-	0x68, 0x69, 0x57, 0x38, 0x06, 0x71, 0x58, 0x89, 0xff, 0x51, 0x62, 0x25, 0x9f, 0xd2, 0xe0, 0xb4,
-	0xdc, 0x86, 0xe1, 0xa1, 0x65, 0x52, 0x6f, 0x76, 0x82, 0xcf, 0xe1, 0x8d, 0xfe, 0x0e, 0xc9, 0xe8,
-	0x80, 0x5e, 0xbc, 0x20, 0x67, 0xf3, 0xf4, 0x6a, 0x9a, 0x18, 0x66, 0xf5, 0x40, 0xff, 0xc4, 0x0c,
-	0xcc, 0x54, 0xac, 0x8e, 0xe7, 0x53, 0x77, 0x41, 0x3a, 0xda, 0x50, 0x97, 0x7c, 0x44, 0x83, 0x73,
-	0xfc, 0xdf, 0x25, 0xe7, 0x9e, 0xbd, 0x44, 0x2d, 0x63, 0x77, 0x61, 0x93, 0xd5, 0x68, 0x34, 0x0e,
-	0x77, 0x78, 0x2c, 0x75, 0xa4, 0x9a, 0xcc, 0x2f, 0x94, 0x6a, 0x99, 0x18, 0x31, 0x87, 0x12, 0xf9,
-	0x3e, 0x0d, 0x1e, 0xc8, 0x00, 0x2d, 0x51, 0x8b, 0xfa, 0x81, 0xf0, 0x7f, 0xd8, 0x7e, 0x5c, 0xda,
-	0xdf, 0x2b, 0x3f, 0x50, 0xcb, 0x43, 0x8a, 0xf9, 0xf4, 0xc8, 0x0f, 0x68, 0x30, 0x97, 0x01, 0xbd,
-	0x66, 0x98, 0x56, 0xc7, 0x0d, 0xf4, 0x82, 0xc3, 0x76, 0x87, 0x8b, 0xe7, 0xb5, 0x5c, 0xac, 0xd8,
-	0x85, 0x22, 0xf9, 0x10, 0x9c, 0x0d, 0xa1, 0xb7, 0x6d, 0x9b, 0xd2, 0x46, 0x4c, 0x4b, 0x38, 0x6c,
-	0x57, 0x1e, 0xd8, 0xdf, 0x2b, 0x9f, 0xad, 0x65, 0x21, 0xc4, 0x6c, 0x3a, 0xa4, 0x09, 0x97, 0x22,
-	0x80, 0x6f, 0x5a, 0xe6, 0x8b, 0x42, 0x91, 0xd9, 0x72, 0xa9, 0xb7, 0xe5, 0x58, 0x0d, 0xce, 0x8a,
-	0xb5, 0xc5, 0x57, 0xef, 0xef, 0x95, 0x2f, 0xd5, 0xba, 0x55, 0xc4, 0xee, 0x78, 0x48, 0x03, 0x26,
-	0xbc, 0xba, 0x61, 0x57, 0x6d, 0x9f, 0xba, 0xdb, 0x86, 0x25, 0x4d, 0xe5, 0x87, 0x1d, 0xa0, 0x60,
-	0x80, 0x0a, 0x1e, 0x8c, 0x61, 0x25, 0x6f, 0x83, 0x51, 0xba, 0xd3, 0x36, 0xec, 0x06, 0x15, 0x4c,
-	0x77, 0x6c, 0xf1, 0x22, 0x3b, 0xea, 0x97, 0x65, 0xd9, 0xfd, 0xbd, 0xf2, 0x44, 0xf0, 0xff, 0xaa,
-	0xd3, 0xa0, 0x18, 0xd6, 0x26, 0x1f, 0x80, 0x33, 0xdc, 0x13, 0xa8, 0x41, 0xf9, 0x11, 0xe2, 0x05,
-	0xba, 0xe2, 0x68, 0xa1, 0x7e, 0x72, 0x2f, 0x81, 0xd5, 0x0c, 0x7c, 0x98, 0x49, 0x85, 0x2d, 0x43,
-	0xcb, 0xd8, 0xb9, 0xee, 0x1a, 0x75, 0xba, 0xd9, 0xb1, 0x36, 0xa8, 0xdb, 0x32, 0x6d, 0x61, 0x2c,
-	0xa1, 0x75, 0xc7, 0x6e, 0x30, 0x46, 0xad, 0x3d, 0x34, 0x24, 0x96, 0x61, 0xb5, 0x5b, 0x45, 0xec,
-	0x8e, 0x87, 0xbc, 0x19, 0x26, 0xcc, 0xa6, 0xed, 0xb8, 0x74, 0xc3, 0x30, 0x6d, 0xdf, 0x9b, 0x05,
-	0x2e, 0x64, 0xf3, 0x69, 0xad, 0x2a, 0xe5, 0x18, 0xab, 0x45, 0xb6, 0x81, 0xd8, 0xf4, 0xde, 0xba,
-	0xd3, 0xe0, 0x5b, 0xe0, 0x76, 0x9b, 0x6f, 0x64, 0xc9, 0x66, 0x0f, 0x3b, 0x35, 0x5c, 0x95, 0x5e,
-	0x4b, 0x61, 0xc3, 0x0c, 0x0a, 0xe4, 0x1a, 0x90, 0x96, 0xb1, 0xb3, 0xdc, 0x6a, 0xfb, 0xbb, 0x8b,
-	0x1d, 0xeb, 0xae, 0xe4, 0x1a, 0x13, 0x7c, 0x2e, 0x84, 0xa1, 0x29, 0x05, 0xc5, 0x8c, 0x16, 0xc4,
-	0x80, 0x0b, 0x62, 0x3c, 0x4b, 0x06, 0x6d, 0x39, 0xb6, 0x47, 0x7d, 0x4f, 0xd9, 0xa4, 0xb3, 0x93,
-	0xdc, 0x1f, 0x84, 0x2b, 0xb6, 0xd5, 0xfc, 0x6a, 0xd8, 0x0d, 0x47, 0xdc, 0x23, 0x6e, 0xea, 0x00,
-	0x8f, 0xb8, 0xc7, 0x60, 0xd2, 0xf3, 0x0d, 0xd7, 0xef, 0xb4, 0xe5, 0x32, 0x9c, 0xe2, 0xcb, 0xc0,
-	0xed, 0x90, 0x35, 0x15, 0x80, 0xf1, 0x7a, 0x6c, 0xf9, 0x84, 0xf1, 0x5a, 0xb6, 0x9b, 0x8e, 0x96,
-	0xaf, 0xa6, 0x94, 0x63, 0xac, 0x16, 0xb9, 0x0d, 0xe7, 0x5b, 0xc6, 0x4e, 0xf8, 0xf9, 0xae, 0x1b,
-	0xae, 0x61, 0x59, 0xd4, 0x32, 0xbd, 0xd6, 0xec, 0x0c, 0xef, 0x29, 0x77, 0x40, 0x58, 0xcd, 0xae,
-	0x82, 0x79, 0x6d, 0xa5, 0x8b, 0xdc, 0x92, 0x6b, 0x98, 0x31, 0x94, 0x24, 0xe6, 0x22, 0x97, 0x04,
-	0x63, 0x56, 0x1b, 0xf2, 0x93, 0x1a, 0x94, 0xf9, 0xf9, 0x67, 0x58, 0xec, 0xe3, 0xb8, 0xee, 0x3a,
-	0x9d, 0xf6, 0xa2, 0x51, 0xbf, 0xeb, 0x6c, 0x6e, 0x06, 0x1b, 0x67, 0xf6, 0x74, 0xa1, 0xed, 0xf6,
-	0xe0, 0xfe, 0x5e, 0xb9, 0x5c, 0xed, 0x8e, 0x1a, 0x0f, 0xa2, 0x4d, 0x7e, 0x48, 0x83, 0x0b, 0xf2,
-	0xc3, 0xcd, 0xec, 0xdb, 0x99, 0x42, 0x7d, 0xe3, 0x3b, 0x6e, 0x35, 0x1f, 0x2d, 0x76, 0xa3, 0x49,
-	0x7e, 0x54, 0x83, 0x8b, 0x76, 0x02, 0x88, 0xd4, 0xa3, 0xdc, 0xb6, 0xea, 0x74, 0xfc, 0xd9, 0xb3,
-	0x85, 0x3a, 0x75, 0x65, 0x7f, 0xaf, 0x7c, 0x71, 0xad, 0x0b, 0x5e, 0xec, 0x4a, 0x55, 0xff, 0xaf,
-	0x83, 0x30, 0x9b, 0x12, 0x46, 0x02, 0x97, 0xc5, 0x03, 0x8f, 0x1b, 0xed, 0x88, 0x8e, 0x9b, 0x36,
-	0x5c, 0x09, 0x2b, 0x5c, 0x6f, 0x77, 0x32, 0x69, 0x95, 0x38, 0xad, 0xd7, 0xec, 0xef, 0x95, 0xaf,
-	0xd4, 0x0e, 0xa8, 0x8b, 0x07, 0x62, 0xcb, 0x3f, 0xca, 0x07, 0x4e, 0xe8, 0x28, 0xff, 0x00, 0x9c,
-	0x51, 0x00, 0x2e, 0x35, 0x1a, 0xbb, 0x7d, 0x88, 0x12, 0xfc, 0x04, 0xab, 0x65, 0xe0, 0xc3, 0x4c,
-	0x2a, 0xb9, 0xe7, 0xe7, 0xd0, 0x49, 0x9c, 0x9f, 0xfa, 0xde, 0x00, 0x8c, 0x55, 0x1c, 0xbb, 0x21,
-	0x4c, 0x5c, 0x6f, 0x8c, 0xf9, 0x57, 0x5c, 0x52, 0xd5, 0xa0, 0xfb, 0x7b, 0xe5, 0xc9, 0xb0, 0xa2,
-	0xa2, 0x17, 0xbd, 0x3d, 0xbc, 0x7a, 0x14, 0xc6, 0x85, 0x57, 0xc7, 0xef, 0x0c, 0xef, 0xef, 0x95,
-	0x4f, 0x85, 0xcd, 0xe2, 0xd7, 0x88, 0xec, 0x70, 0xb4, 0x0c, 0xcf, 0xdf, 0x70, 0x0d, 0xdb, 0x33,
-	0xfb, 0xb0, 0x31, 0x87, 0x77, 0x3b, 0x2b, 0x29, 0x6c, 0x98, 0x41, 0x81, 0x3c, 0x0f, 0x53, 0xac,
-	0xf4, 0x76, 0xbb, 0x61, 0xf8, 0xb4, 0xa0, 0x69, 0x39, 0xf4, 0x96, 0x5b, 0x89, 0x61, 0xc2, 0x04,
-	0x66, 0xe1, 0x8f, 0x62, 0x78, 0x8e, 0xcd, 0xd7, 0x33, 0xe6, 0x8f, 0xc2, 0x4a, 0x51, 0x42, 0xc9,
-	0xc3, 0x30, 0xd2, 0xa2, 0x9e, 0x67, 0x34, 0x29, 0x17, 0xf0, 0xc6, 0x22, 0x1d, 0x79, 0x55, 0x14,
-	0x63, 0x00, 0x27, 0xaf, 0x87, 0xa1, 0xba, 0xd3, 0xa0, 0xde, 0xec, 0x08, 0x3f, 0xc3, 0xd8, 0x71,
-	0x3e, 0x54, 0x61, 0x05, 0xf7, 0xf7, 0xca, 0x63, 0xfc, 0x26, 0x8c, 0xfd, 0x42, 0x51, 0x49, 0xff,
-	0x29, 0x0d, 0xa6, 0x93, 0x76, 0xcf, 0x1e, 0xfc, 0x68, 0x4e, 0xce, 0x25, 0x45, 0xff, 0x94, 0x06,
-	0x13, 0xac, 0x87, 0xae, 0x63, 0xad, 0x5b, 0x86, 0x4d, 0xc9, 0xf7, 0x6a, 0x30, 0xbd, 0x65, 0x36,
-	0xb7, 0x54, 0x8f, 0x41, 0xa9, 0x79, 0x15, 0xb2, 0x1b, 0xde, 0x48, 0xe0, 0x5a, 0x3c, 0xb3, 0xbf,
-	0x57, 0x9e, 0x4e, 0x96, 0x62, 0x8a, 0xa6, 0xfe, 0xd7, 0x25, 0x38, 0xaf, 0xf6, 0x6c, 0x21, 0x7a,
-	0x8c, 0x41, 0xfe, 0x54, 0x03, 0x68, 0x99, 0xf6, 0x82, 0x65, 0x39, 0xf7, 0xb8, 0x9b, 0x33, 0xd3,
-	0x65, 0xdf, 0x57, 0xd4, 0x5e, 0x9d, 0x41, 0x61, 0x7e, 0x35, 0xc4, 0x2e, 0xee, 0xac, 0x9f, 0x09,
-	0xec, 0x60, 0x11, 0xe0, 0xfe, 0x5e, 0xb9, 0x9c, 0x7e, 0x01, 0x32, 0x8f, 0xf2, 0x99, 0xc5, 0x8a,
-	0xe9, 0xf9, 0x1f, 0xf9, 0xab, 0xae, 0x55, 0xc4, 0xdd, 0x75, 0x34, 0x90, 0xb9, 0x16, 0x9c, 0x4a,
-	0x10, 0xce, 0x70, 0x01, 0x5f, 0x52, 0x5d, 0xc0, 0x0f, 0x60, 0x52, 0xf3, 0xc1, 0xa3, 0x8f, 0xf9,
-	0xf7, 0x74, 0x0c, 0xdb, 0x67, 0x33, 0xad, 0xb8, 0x8c, 0xff, 0x65, 0x09, 0xce, 0xc8, 0x09, 0xb0,
-	0x98, 0xb6, 0xd9, 0xb6, 0x9c, 0xdd, 0x16, 0xb5, 0x4f, 0xc2, 0x2d, 0x30, 0xf8, 0x08, 0x4a, 0xb9,
-	0x1f, 0x41, 0x2b, 0xf5, 0x11, 0x0c, 0x14, 0xf9, 0x08, 0x42, 0x5e, 0x71, 0x80, 0x8d, 0x0d, 0xe1,
-	0x9c, 0x69, 0xb3, 0x8e, 0x5e, 0xe7, 0x1b, 0x26, 0x72, 0xde, 0xe5, 0xfc, 0x69, 0x54, 0x98, 0x11,
-	0xaa, 0x99, 0x35, 0x30, 0xa7, 0xa5, 0xfe, 0xb7, 0x1a, 0xcc, 0x66, 0xcd, 0xef, 0x09, 0x98, 0xc5,
-	0x5b, 0x71, 0xb3, 0xf8, 0x8d, 0x3e, 0xbe, 0x8d, 0x58, 0xd7, 0x73, 0xcc, 0xe3, 0x7f, 0x53, 0x82,
-	0x73, 0x51, 0xf5, 0xaa, 0xed, 0xf9, 0x86, 0x65, 0x09, 0x81, 0xef, 0xf8, 0xf7, 0x52, 0x3b, 0x76,
-	0xbb, 0xb1, 0xd6, 0xdf, 0x50, 0xd5, 0xbe, 0xe7, 0xba, 0xfc, 0xec, 0x24, 0x5c, 0x7e, 0xd6, 0x8f,
-	0x90, 0x66, 0x77, 0xef, 0x9f, 0x7f, 0xaf, 0xc1, 0x5c, 0x76, 0xc3, 0x13, 0xd8, 0x54, 0x4e, 0x7c,
-	0x53, 0xbd, 0xfb, 0xe8, 0x46, 0x9d, 0xb3, 0xad, 0x7e, 0xb1, 0x94, 0x37, 0x5a, 0x7e, 0x45, 0xb2,
-	0x09, 0xa7, 0x5c, 0xda, 0x34, 0x3d, 0x5f, 0x2a, 0x1e, 0x87, 0xf3, 0xe6, 0x0f, 0xae, 0x46, 0x4f,
-	0x61, 0x1c, 0x07, 0x26, 0x91, 0x92, 0x35, 0x18, 0xf1, 0x28, 0x6d, 0x30, 0xfc, 0xa5, 0xde, 0xf1,
-	0x87, 0x42, 0x44, 0x4d, 0xb4, 0xc5, 0x00, 0x09, 0xf9, 0x36, 0x98, 0x6c, 0x84, 0x5f, 0xd4, 0x21,
-	0xbd, 0x8f, 0xb8, 0xb6, 0xbd, 0xa4, 0xb6, 0xc6, 0x38, 0x32, 0xfd, 0x1f, 0x34, 0xb8, 0xd8, 0x6d,
-	0x6f, 0x91, 0x17, 0x00, 0xea, 0x81, 0x54, 0xe8, 0xc9, 0xc3, 0xf3, 0x89, 0x82, 0x6b, 0x29, 0xb0,
-	0x44, 0x1f, 0x68, 0x58, 0xe4, 0xa1, 0x42, 0x24, 0xc3, 0x11, 0xb4, 0x74, 0x4c, 0x8e, 0xa0, 0xfa,
-	0x7f, 0xd0, 0x54, 0x56, 0xa4, 0xae, 0xed, 0x2b, 0x8d, 0x15, 0xa9, 0x7d, 0xcf, 0xbd, 0x72, 0xfd,
-	0x4a, 0x09, 0xae, 0x64, 0x37, 0x51, 0xce, 0xf3, 0x77, 0xc1, 0x70, 0x5b, 0xbc, 0xb8, 0x11, 0x8f,
-	0x0f, 0x1e, 0x62, 0x9c, 0x45, 0xbc, 0x87, 0xb9, 0xbf, 0x57, 0x9e, 0xcb, 0x62, 0xf4, 0xf2, 0x25,
-	0x8d, 0x6c, 0x47, 0xcc, 0xc4, 0xdd, 0x90, 0x10, 0xda, 0xdf, 0xd4, 0x23, 0x73, 0x31, 0xee, 0x50,
-	0xab, 0xe7, 0xeb, 0xa0, 0x0f, 0x6b, 0x30, 0x15, 0xdb, 0xd1, 0xde, 0xec, 0x10, 0xdf, 0xa3, 0x85,
-	0x7c, 0xe6, 0x62, 0x9f, 0x4a, 0x24, 0x0d, 0xc4, 0x8a, 0x3d, 0x4c, 0x10, 0x4c, 0xb0, 0x59, 0x75,
-	0x56, 0x5f, 0x71, 0x6c, 0x56, 0xed, 0x7c, 0x0e, 0x9b, 0xfd, 0x89, 0x52, 0xde, 0x68, 0x39, 0x9b,
-	0xbd, 0x07, 0x63, 0x81, 0x18, 0x19, 0xb0, 0x8b, 0x6b, 0xfd, 0xf6, 0x49, 0xa0, 0x5b, 0x9c, 0x91,
-	0xfd, 0x19, 0x0b, 0x4a, 0x3c, 0x8c, 0x68, 0x91, 0xef, 0xd1, 0x00, 0xa2, 0x85, 0x91, 0x1f, 0xd5,
-	0xc6, 0xd1, 0x4d, 0x87, 0x22, 0xd6, 0x4c, 0xb1, 0x4f, 0x5a, 0xd9, 0x14, 0x0a, 0x5d, 0xfd, 0x5f,
-	0x0d, 0x02, 0x49, 0xf7, 0xbd, 0xb7, 0x9b, 0xff, 0x03, 0x84, 0xdc, 0x36, 0x4c, 0xbb, 0x4c, 0x5a,
-	0xac, 0x9b, 0x16, 0x0d, 0x0c, 0x60, 0xc5, 0x2c, 0x1f, 0x5c, 0xad, 0xc2, 0x04, 0x2e, 0x4c, 0x61,
-	0x27, 0xaf, 0x85, 0x91, 0xb6, 0x6b, 0xb6, 0x0c, 0x77, 0x97, 0x2b, 0xc5, 0xa3, 0xe2, 0x5a, 0x72,
-	0x5d, 0x14, 0x61, 0x00, 0x23, 0x1f, 0x80, 0x31, 0xcb, 0xdc, 0xa4, 0xf5, 0xdd, 0xba, 0x45, 0xe5,
-	0xad, 0xc7, 0xad, 0xa3, 0x59, 0xf3, 0x95, 0x00, 0xad, 0x74, 0x26, 0x0d, 0x7e, 0x62, 0x44, 0x90,
-	0x54, 0xe1, 0xf4, 0x3d, 0xee, 0xde, 0x64, 0x51, 0xcf, 0xab, 0x75, 0xda, 0x6d, 0xc7, 0xf5, 0x69,
-	0x83, 0xdf, 0x8d, 0x8c, 0x0a, 0x1b, 0xed, 0xd3, 0x69, 0x30, 0x66, 0xb5, 0x21, 0x4f, 0x00, 0x18,
-	0x1d, 0xdf, 0x11, 0x4f, 0x5f, 0x67, 0x47, 0xb9, 0xd6, 0x7e, 0x89, 0x2d, 0xf6, 0x42, 0x58, 0x7a,
-	0x7f, 0xaf, 0x3c, 0x2e, 0x6d, 0x7f, 0x7c, 0x69, 0x94, 0x06, 0xe4, 0x7d, 0x70, 0xa6, 0x2e, 0x40,
-	0x15, 0xa7, 0xd5, 0x36, 0x7c, 0x53, 0x6a, 0xc4, 0x63, 0x1c, 0xd1, 0x37, 0xed, 0xef, 0x95, 0xcf,
-	0x54, 0x32, 0xe0, 0x49, 0x94, 0x99, 0x48, 0xf4, 0x4f, 0x94, 0xe0, 0x42, 0x97, 0x09, 0x22, 0xc8,
-	0x3e, 0x3c, 0xb9, 0x7e, 0x72, 0x9b, 0xbd, 0x59, 0x7c, 0x2c, 0xb2, 0xf0, 0xfe, 0x5e, 0xf9, 0xc1,
-	0x2e, 0x08, 0x6a, 0x6c, 0x9f, 0xd3, 0xe6, 0x2e, 0x46, 0x68, 0x48, 0x15, 0x86, 0x1b, 0xd1, 0x35,
-	0xe6, 0xd8, 0xe2, 0x1b, 0xd9, 0x51, 0x20, 0x2e, 0x1c, 0x7a, 0xc5, 0x26, 0x11, 0x90, 0x15, 0x18,
-	0x11, 0xee, 0xb1, 0x54, 0x1e, 0x2b, 0x8f, 0x72, 0x93, 0x89, 0x28, 0xea, 0x15, 0x59, 0x80, 0x42,
-	0xff, 0x7b, 0x0d, 0x46, 0x2a, 0x8e, 0x4b, 0x97, 0xd6, 0x6a, 0x64, 0x17, 0xc6, 0x95, 0xd8, 0x0b,
-	0x92, 0xc5, 0x16, 0xe4, 0x39, 0x1c, 0xa3, 0xa2, 0xda, 0x07, 0xaf, 0x45, 0xc3, 0x02, 0x54, 0x69,
-	0x91, 0x17, 0xd8, 0x9c, 0xdf, 0x73, 0x4d, 0x9f, 0x11, 0xee, 0xc7, 0x5f, 0x4a, 0x10, 0xc6, 0x00,
-	0x97, 0xd8, 0xed, 0xe1, 0x4f, 0x8c, 0xa8, 0xe8, 0xeb, 0x8c, 0xbd, 0x24, 0xbb, 0x49, 0x1e, 0x87,
-	0xc1, 0x96, 0xd3, 0x08, 0xd6, 0xfd, 0x75, 0x01, 0xf3, 0x58, 0x75, 0x1a, 0x6c, 0x6e, 0xcf, 0xa5,
-	0x5b, 0xf0, 0xab, 0x41, 0xde, 0x46, 0x5f, 0x83, 0xe9, 0x24, 0x7d, 0xf2, 0x38, 0x4c, 0xd5, 0x9d,
-	0x56, 0xcb, 0xb1, 0x6b, 0x9d, 0xcd, 0x4d, 0x73, 0x87, 0xc6, 0x9e, 0xf1, 0x56, 0x62, 0x10, 0x4c,
-	0xd4, 0xd4, 0x3f, 0xab, 0xc1, 0x00, 0x5b, 0x17, 0x1d, 0x86, 0x1b, 0x4e, 0xcb, 0x30, 0x6d, 0xd9,
-	0x2b, 0xee, 0x70, 0xb0, 0xc4, 0x4b, 0x50, 0x42, 0x48, 0x1b, 0xc6, 0x02, 0x89, 0xac, 0xaf, 0x17,
-	0x03, 0x4b, 0x6b, 0xb5, 0xf0, 0xfd, 0x56, 0x78, 0x4c, 0x04, 0x25, 0x1e, 0x46, 0x44, 0x74, 0x03,
-	0x66, 0x96, 0xd6, 0x6a, 0x55, 0xbb, 0x6e, 0x75, 0x1a, 0x74, 0x79, 0x87, 0xff, 0x61, 0x7c, 0xce,
-	0x14, 0x25, 0x72, 0x9c, 0x9c, 0xcf, 0xc9, 0x4a, 0x18, 0xc0, 0x58, 0x35, 0x2a, 0x5a, 0xc8, 0x57,
-	0xad, 0xbc, 0x9a, 0x44, 0x82, 0x01, 0x4c, 0xff, 0x6a, 0x09, 0xc6, 0x95, 0x0e, 0x11, 0x0b, 0x46,
-	0xc4, 0x70, 0xbd, 0x7e, 0x22, 0x17, 0xa4, 0x7a, 0x2d, 0xa8, 0x8b, 0x09, 0xf5, 0x30, 0x20, 0xa1,
-	0xf2, 0xec, 0x52, 0x17, 0x9e, 0x3d, 0x1f, 0x7b, 0x1c, 0x2c, 0x3e, 0xc9, 0xa9, 0xfc, 0x87, 0xc1,
-	0xe4, 0xa2, 0x3c, 0x9e, 0x84, 0x8b, 0xfd, 0x68, 0xe2, 0x68, 0xda, 0x84, 0xa1, 0x17, 0x1d, 0x9b,
-	0x7a, 0xd2, 0x16, 0x7e, 0x44, 0x03, 0xe4, 0x8e, 0x91, 0xcf, 0x32, 0xbc, 0x28, 0xd0, 0xeb, 0x3f,
-	0xad, 0x01, 0x2c, 0x19, 0xbe, 0x21, 0xbc, 0x70, 0x7a, 0x70, 0xf4, 0xbc, 0x18, 0x3b, 0x55, 0x47,
-	0x53, 0x6f, 0x10, 0x07, 0x3d, 0xf3, 0xc5, 0x60, 0xf8, 0xa1, 0xb4, 0x2e, 0xb0, 0xd7, 0xcc, 0x17,
-	0x29, 0x72, 0x38, 0x79, 0x04, 0xc6, 0xa8, 0x5d, 0x77, 0x77, 0xdb, 0xec, 0x60, 0x11, 0x26, 0x1e,
-	0xfe, 0x85, 0x2e, 0x07, 0x85, 0x18, 0xc1, 0xf5, 0x37, 0x42, 0x5c, 0xe5, 0xea, 0xc1, 0x0f, 0xfd,
-	0x1f, 0x35, 0x38, 0xbf, 0xd4, 0x31, 0xac, 0x85, 0x36, 0xdb, 0xa8, 0x86, 0x75, 0xcd, 0x11, 0xee,
-	0x1c, 0x4c, 0x0f, 0x79, 0x3d, 0x8c, 0x06, 0x42, 0x4e, 0xf2, 0x91, 0x72, 0xc0, 0x28, 0x31, 0xac,
-	0x41, 0x0c, 0x18, 0xf5, 0x02, 0xb1, 0xbb, 0xd4, 0x87, 0xd8, 0x1d, 0x90, 0x08, 0xc5, 0xee, 0x10,
-	0xad, 0x30, 0x7e, 0xf1, 0x05, 0xaa, 0x51, 0x77, 0xdb, 0xac, 0xd3, 0x85, 0x7a, 0xdd, 0xe9, 0xd8,
-	0xbe, 0x27, 0x83, 0x0e, 0x48, 0xe3, 0x57, 0x56, 0x0d, 0xcc, 0x69, 0xa9, 0x7f, 0x49, 0x83, 0xc1,
-	0xe5, 0x8d, 0xca, 0x12, 0xf9, 0x36, 0x18, 0x0c, 0x59, 0x46, 0x41, 0xaf, 0x2d, 0x86, 0x47, 0xd8,
-	0xe9, 0xc4, 0x7a, 0xaf, 0x32, 0x86, 0xc3, 0xb1, 0x92, 0x3b, 0x30, 0x4c, 0xb7, 0x29, 0xeb, 0x6a,
-	0xe9, 0x48, 0xf0, 0x73, 0x96, 0xb6, 0xcc, 0x31, 0xa2, 0xc4, 0xac, 0x7f, 0x52, 0x03, 0x88, 0xaa,
-	0x90, 0xef, 0xcc, 0x3a, 0x9d, 0x6e, 0x1e, 0xa1, 0xf5, 0xb9, 0xfb, 0x11, 0xa5, 0xff, 0xc6, 0x10,
-	0x3c, 0xc0, 0xba, 0x23, 0xb7, 0xaa, 0xe9, 0xd8, 0x37, 0xe9, 0xee, 0x3f, 0xbf, 0x78, 0xf8, 0xe7,
-	0x17, 0x0f, 0x47, 0xf8, 0xe2, 0xe1, 0x7d, 0xf0, 0x00, 0xdb, 0x72, 0x12, 0x4c, 0xb9, 0xfb, 0xd7,
-	0xba, 0x4b, 0xdb, 0x86, 0x4b, 0x1b, 0x5c, 0x1f, 0x18, 0x15, 0x0e, 0x6d, 0x0b, 0x79, 0x95, 0x30,
-	0xbf, 0xbd, 0xfe, 0x24, 0x4c, 0x47, 0x7b, 0x77, 0xc1, 0x47, 0xea, 0xf9, 0x8c, 0x1f, 0xc7, 0x95,
-	0xcc, 0xb1, 0x40, 0x62, 0x4a, 0x2b, 0x86, 0x71, 0x04, 0xf2, 0xab, 0x3c, 0x14, 0x82, 0xfb, 0x1a,
-	0x4c, 0x2f, 0xef, 0xb4, 0x4d, 0x97, 0x87, 0x92, 0x10, 0x4f, 0x8e, 0xc8, 0xc3, 0xd1, 0xcb, 0x24,
-	0x2d, 0x7e, 0x0d, 0x98, 0x7c, 0x9d, 0x44, 0x36, 0x61, 0x8a, 0xf2, 0xe6, 0x5c, 0x8d, 0x34, 0xfc,
-	0x22, 0xdf, 0x87, 0x88, 0x9f, 0x12, 0xc3, 0x82, 0x09, 0xac, 0xa4, 0x06, 0x53, 0x75, 0xcb, 0xf0,
-	0x3c, 0x73, 0xd3, 0xac, 0x47, 0x2f, 0xea, 0xc6, 0x16, 0x1f, 0xe1, 0x42, 0x5b, 0x0c, 0x72, 0x7f,
-	0xaf, 0x7c, 0x56, 0xf6, 0x33, 0x0e, 0xc0, 0x04, 0x0a, 0xfd, 0xd3, 0x25, 0x98, 0x5c, 0xde, 0x69,
-	0x3b, 0x5e, 0xc7, 0xa5, 0xbc, 0xea, 0x09, 0x18, 0xc6, 0x1e, 0x86, 0x91, 0x2d, 0xc3, 0x6e, 0x58,
-	0xd4, 0x95, 0xe7, 0x76, 0x38, 0xb7, 0x37, 0x44, 0x31, 0x06, 0x70, 0xf2, 0x12, 0x80, 0x57, 0xdf,
-	0xa2, 0x8d, 0x0e, 0xe7, 0xae, 0x03, 0xc5, 0xb9, 0x6b, 0x6c, 0x8c, 0xb5, 0x10, 0xa5, 0x94, 0x89,
-	0xc2, 0xdf, 0xa8, 0x90, 0xd3, 0xff, 0x4c, 0x83, 0x99, 0x58, 0xbb, 0x13, 0xb0, 0xf7, 0x6c, 0xc6,
-	0xed, 0x3d, 0x0b, 0x7d, 0x8f, 0x35, 0xc7, 0xcc, 0xf3, 0xb1, 0x12, 0x9c, 0xcf, 0x99, 0x93, 0x94,
-	0xef, 0xb7, 0x76, 0x42, 0xbe, 0xdf, 0x1d, 0x18, 0xf7, 0x1d, 0x4b, 0x3e, 0xfc, 0x0c, 0x66, 0xa0,
-	0xd0, 0x19, 0xbe, 0x11, 0xa2, 0x89, 0x3c, 0xbb, 0xa3, 0x32, 0x0f, 0x55, 0x3a, 0xfa, 0x6f, 0x6b,
-	0x30, 0x16, 0x9a, 0x95, 0x5f, 0x56, 0x37, 0xf2, 0xbd, 0x87, 0x7c, 0xd2, 0xff, 0xa0, 0x04, 0xe7,
-	0x42, 0xdc, 0x01, 0x9b, 0xab, 0xf9, 0x8c, 0x6f, 0x1c, 0x6c, 0x9b, 0xba, 0x18, 0x7b, 0x95, 0x32,
-	0x9a, 0x7e, 0xa4, 0xd9, 0xee, 0xb8, 0x6d, 0xc7, 0x0b, 0x04, 0x69, 0xa1, 0x71, 0x88, 0x22, 0x0c,
-	0x60, 0x64, 0x0d, 0x86, 0x3c, 0x46, 0x4f, 0x1e, 0x96, 0x87, 0x9c, 0x0d, 0xae, 0x0b, 0xf0, 0xfe,
-	0xa2, 0x40, 0x43, 0x5e, 0x52, 0x79, 0xf8, 0x50, 0x71, 0xeb, 0x27, 0x1b, 0x49, 0x23, 0x14, 0xa5,
-	0xa3, 0xbb, 0x93, 0x6e, 0xd6, 0x46, 0x7d, 0x05, 0xa6, 0xa5, 0x83, 0xb3, 0xd8, 0x36, 0x76, 0x9d,
-	0x92, 0xb7, 0xc5, 0x76, 0xc6, 0x6b, 0x12, 0x3e, 0x39, 0x67, 0x92, 0xf5, 0xa3, 0x1d, 0xa3, 0x7b,
-	0x30, 0x7a, 0x5d, 0x76, 0x92, 0xcc, 0x41, 0xc9, 0x0c, 0xd6, 0x02, 0x24, 0x8e, 0x52, 0x75, 0x09,
-	0x4b, 0x66, 0x0f, 0xaf, 0x83, 0xd4, 0x63, 0x69, 0xa0, 0xfb, 0xb1, 0xa4, 0x7f, 0xbd, 0x04, 0x67,
-	0x02, 0xaa, 0xc1, 0x18, 0x97, 0xe4, 0x75, 0xfb, 0x01, 0x5a, 0xd5, 0xc1, 0xb6, 0xca, 0x5b, 0x30,
-	0xc8, 0x19, 0x60, 0xa1, 0x6b, 0xf8, 0x10, 0x21, 0xeb, 0x0e, 0x72, 0x44, 0xe4, 0x03, 0x30, 0x6c,
-	0x31, 0x15, 0x25, 0x78, 0xb6, 0x53, 0xc8, 0xb2, 0x9b, 0x35, 0x5c, 0xa1, 0xf9, 0xc8, 0x68, 0x7b,
-	0xe1, 0x4d, 0xaa, 0x28, 0x44, 0x49, 0x73, 0xee, 0xed, 0x30, 0xae, 0x54, 0x3b, 0x54, 0xa8, 0xbd,
-	0xcf, 0x96, 0x60, 0xf6, 0x06, 0xb5, 0x5a, 0x99, 0xbe, 0x13, 0x65, 0x18, 0xaa, 0x6f, 0x19, 0xae,
-	0x88, 0xe2, 0x38, 0x21, 0x36, 0x79, 0x85, 0x15, 0xa0, 0x28, 0x67, 0x1a, 0x4b, 0xec, 0xa1, 0xe2,
-	0x3b, 0x95, 0x99, 0x8c, 0xc2, 0x7b, 0xbe, 0x3f, 0x8c, 0xff, 0x19, 0x0d, 0x3c, 0x56, 0x81, 0x1d,
-	0x2f, 0xef, 0xae, 0xdd, 0x5a, 0xcb, 0x7a, 0xa2, 0x48, 0x5e, 0x84, 0x49, 0xa7, 0x6e, 0x22, 0x6d,
-	0x3b, 0x9e, 0xe9, 0x3b, 0xee, 0xae, 0x5c, 0xb4, 0x42, 0x47, 0xcb, 0xad, 0x4a, 0x35, 0x42, 0x24,
-	0xee, 0x1f, 0x63, 0x45, 0x18, 0x27, 0xa5, 0x7f, 0x51, 0x83, 0xf1, 0x1b, 0xe6, 0x1d, 0xea, 0x0a,
-	0x1f, 0x6e, 0x6e, 0x62, 0x89, 0xc5, 0x23, 0x1c, 0xcf, 0x8a, 0x45, 0x48, 0x76, 0x60, 0x4c, 0x9e,
-	0xc3, 0xe1, 0x0b, 0xd4, 0xeb, 0xc5, 0x1c, 0x8e, 0x42, 0xd2, 0xf2, 0x7c, 0x53, 0x3e, 0xfc, 0xa0,
-	0xc4, 0xc3, 0x88, 0x98, 0xfe, 0x12, 0x9c, 0xce, 0x68, 0xc4, 0x16, 0x92, 0xbb, 0x31, 0xcb, 0x8f,
-	0x26, 0xe0, 0x56, 0x6c, 0x21, 0x79, 0x39, 0x79, 0x00, 0x06, 0xa8, 0xdd, 0x90, 0x5f, 0xcc, 0xc8,
-	0xfe, 0x5e, 0x79, 0x60, 0xd9, 0x6e, 0x20, 0x2b, 0x63, 0x4c, 0xdc, 0x72, 0x62, 0x12, 0x1b, 0x67,
-	0xe2, 0x2b, 0xb2, 0x0c, 0x43, 0x28, 0x77, 0x11, 0x4b, 0x7a, 0x43, 0x31, 0xd5, 0x64, 0x7a, 0x33,
-	0xc1, 0x5b, 0xfa, 0x71, 0xc2, 0x4a, 0xf2, 0xa9, 0xc5, 0x59, 0x39, 0x21, 0x29, 0x8e, 0x87, 0x29,
-	0xba, 0xfa, 0xaf, 0x0e, 0xc2, 0xa5, 0x1b, 0x8e, 0x6b, 0xbe, 0xe8, 0xd8, 0xbe, 0x61, 0xad, 0x3b,
-	0x8d, 0xc8, 0x41, 0x56, 0x1e, 0x59, 0x1f, 0xd5, 0xe0, 0x7c, 0xbd, 0xdd, 0x91, 0xfe, 0xca, 0xd2,
-	0xc7, 0x74, 0x9d, 0xba, 0xa6, 0x53, 0xf4, 0xd1, 0x0e, 0x77, 0xed, 0xae, 0xac, 0xdf, 0xce, 0x42,
-	0x89, 0x79, 0xb4, 0xf8, 0xdb, 0xa1, 0x86, 0x73, 0xcf, 0xe6, 0x9d, 0xab, 0xf9, 0x7c, 0x36, 0x5f,
-	0x8c, 0x16, 0xa1, 0xe0, 0xdb, 0xa1, 0xa5, 0x4c, 0x8c, 0x98, 0x43, 0x89, 0x7c, 0x08, 0xce, 0x4a,
-	0xbf, 0x6c, 0xa4, 0x46, 0xc3, 0xb4, 0xa9, 0xe7, 0x89, 0x87, 0x07, 0x7d, 0x3c, 0x8e, 0xa9, 0x66,
-	0x21, 0xc4, 0x6c, 0x3a, 0xe4, 0x39, 0x00, 0x6f, 0xd7, 0xae, 0xcb, 0xf9, 0x2f, 0xe6, 0xc9, 0x2a,
-	0x44, 0xe4, 0x10, 0x0b, 0x2a, 0x18, 0x99, 0xa2, 0xe5, 0x87, 0x9b, 0x72, 0x98, 0x7b, 0x23, 0x73,
-	0x45, 0x2b, 0xda, 0x43, 0x11, 0x5c, 0xff, 0xa4, 0x06, 0x53, 0x55, 0x7b, 0xdd, 0x32, 0xea, 0x54,
-	0x38, 0x66, 0x7a, 0xe4, 0x2a, 0x8c, 0x79, 0xe1, 0x95, 0x8e, 0xe0, 0x08, 0xd1, 0xf7, 0x19, 0x5e,
-	0xe6, 0x44, 0x75, 0xb8, 0xc7, 0xbe, 0x69, 0xcb, 0xc3, 0xee, 0x9a, 0xe3, 0x0a, 0x44, 0xf2, 0xbb,
-	0x13, 0x1e, 0xfb, 0x69, 0x30, 0x66, 0xb5, 0xd1, 0x7f, 0x41, 0x83, 0x33, 0xf1, 0xee, 0x48, 0x9f,
-	0x88, 0x1f, 0xd7, 0xe0, 0x4c, 0xec, 0x61, 0xbd, 0x04, 0xf7, 0x13, 0x8a, 0x6c, 0x3d, 0x03, 0x9f,
-	0xf0, 0x11, 0xce, 0x82, 0x60, 0x26, 0x7d, 0xfd, 0xff, 0xd1, 0x60, 0x44, 0x86, 0x25, 0x25, 0xaf,
-	0x4b, 0x98, 0xdf, 0xc3, 0xa3, 0x2d, 0x61, 0x82, 0xdf, 0xe5, 0x0e, 0x1e, 0xf2, 0x68, 0x92, 0xa7,
-	0x4c, 0x21, 0xfb, 0xad, 0x24, 0x1c, 0x9d, 0x73, 0x31, 0x47, 0x8f, 0xe0, 0x6e, 0x47, 0x21, 0xa6,
-	0x7f, 0x4e, 0x83, 0x99, 0x54, 0xab, 0x1e, 0xc4, 0xd1, 0x13, 0x74, 0x79, 0xfd, 0xca, 0x20, 0xdb,
-	0x92, 0x3e, 0xe3, 0xf7, 0x96, 0xb0, 0x8c, 0x9f, 0x80, 0xfe, 0xfb, 0x08, 0x8c, 0x99, 0xad, 0x56,
-	0xc7, 0xe7, 0xb7, 0x90, 0x43, 0x91, 0xb9, 0xb9, 0x1a, 0x14, 0x62, 0x04, 0x27, 0xb6, 0x94, 0xb4,
-	0xc4, 0x29, 0xb8, 0x52, 0x6c, 0xe5, 0xd4, 0x01, 0xce, 0x33, 0xa9, 0x48, 0x88, 0x43, 0x59, 0x82,
-	0xd8, 0xf7, 0x6a, 0x00, 0x9e, 0xef, 0x9a, 0x76, 0x93, 0x15, 0x4a, 0x69, 0x0c, 0x8f, 0x80, 0x6c,
-	0x2d, 0x44, 0x2a, 0x88, 0x47, 0xa1, 0x4a, 0x43, 0x00, 0x2a, 0x94, 0xc9, 0x82, 0x14, 0x42, 0xc5,
-	0x91, 0xf9, 0x86, 0x84, 0xb8, 0x7d, 0x29, 0xc3, 0x95, 0x56, 0x10, 0x8a, 0xa4, 0xd4, 0xb9, 0xc7,
-	0x60, 0x2c, 0xa4, 0x77, 0x90, 0x50, 0x37, 0xa1, 0x08, 0x75, 0x73, 0x4f, 0xc0, 0xa9, 0x44, 0x77,
-	0x0f, 0x25, 0x13, 0xfe, 0xb9, 0x06, 0x24, 0x3e, 0xfa, 0x13, 0xb0, 0x1c, 0x34, 0xe3, 0x96, 0x83,
-	0xc5, 0xfe, 0x97, 0x2c, 0xc7, 0x74, 0xf0, 0x73, 0x04, 0x78, 0xd4, 0xe6, 0x30, 0x8a, 0xb9, 0x3c,
-	0xf9, 0x99, 0xa0, 0x12, 0x45, 0x03, 0x90, 0x5f, 0x6e, 0x1f, 0x82, 0xca, 0xcd, 0x04, 0xae, 0x48,
-	0x50, 0x49, 0x42, 0x30, 0x45, 0x97, 0x7c, 0x5c, 0x83, 0x69, 0x23, 0x1e, 0xb5, 0x39, 0x98, 0x99,
-	0x42, 0xc1, 0xdf, 0x12, 0x11, 0xa0, 0xa3, 0xbe, 0x24, 0x00, 0x1e, 0xa6, 0xc8, 0x92, 0x37, 0xc3,
-	0x84, 0xd1, 0x36, 0x17, 0x3a, 0x0d, 0x93, 0x69, 0x9e, 0x41, 0x70, 0x5b, 0x6e, 0x0d, 0x59, 0x58,
-	0xaf, 0x86, 0xe5, 0x18, 0xab, 0x15, 0x86, 0x47, 0xae, 0x44, 0x9e, 0xc7, 0xfd, 0x84, 0x47, 0x96,
-	0x73, 0x18, 0x85, 0x47, 0x96, 0x53, 0xa7, 0x12, 0x21, 0x36, 0x80, 0x63, 0x36, 0xea, 0x92, 0xe4,
-	0x70, 0xf1, 0x4b, 0x94, 0x5b, 0xd5, 0xa5, 0x8a, 0xa4, 0xc8, 0xc5, 0x87, 0xe8, 0x37, 0x2a, 0x14,
-	0xc8, 0xa7, 0x34, 0x98, 0x94, 0xbc, 0x5b, 0xd2, 0x1c, 0xe1, 0x4b, 0xf4, 0x6c, 0xd1, 0xfd, 0x92,
-	0xd8, 0x93, 0xf3, 0xa8, 0x22, 0x17, 0x7c, 0x27, 0x0c, 0x26, 0x11, 0x83, 0x61, 0xbc, 0x1f, 0x5c,
-	0x06, 0xf0, 0x62, 0xb7, 0x58, 0xb2, 0x83, 0xa3, 0xc5, 0x65, 0x80, 0x5a, 0x06, 0x3e, 0xf9, 0x4a,
-	0x29, 0x03, 0x82, 0x99, 0xf4, 0x99, 0x5c, 0x7b, 0xea, 0x9e, 0xe1, 0xd7, 0xb7, 0x2a, 0x46, 0x7d,
-	0x8b, 0x5f, 0x62, 0x8a, 0xa7, 0xb5, 0x05, 0xf7, 0xf5, 0xd3, 0x71, 0x54, 0x8b, 0xa7, 0xf7, 0xf7,
-	0xca, 0xa7, 0x12, 0x85, 0x98, 0x24, 0x48, 0x1c, 0x18, 0x75, 0x65, 0xea, 0x02, 0x19, 0x0d, 0xa1,
-	0x58, 0xb4, 0xfe, 0x64, 0x1e, 0x04, 0xa1, 0x19, 0x05, 0xbf, 0x30, 0x24, 0x42, 0x9a, 0x70, 0x49,
-	0xe8, 0x86, 0x0b, 0xb6, 0x63, 0xef, 0xb6, 0x9c, 0x8e, 0xb7, 0xd0, 0xf1, 0xb7, 0xa8, 0xed, 0x07,
-	0xa6, 0xf0, 0x71, 0x7e, 0x8c, 0xf2, 0x57, 0x77, 0xcb, 0xdd, 0x2a, 0x62, 0x77, 0x3c, 0xe4, 0x19,
-	0x18, 0xe5, 0x17, 0x7d, 0x1b, 0x1b, 0x2b, 0xfc, 0x95, 0xee, 0xe1, 0xc5, 0x65, 0x3e, 0x84, 0x65,
-	0x89, 0x03, 0x43, 0x6c, 0xe4, 0x2e, 0x8c, 0x58, 0x22, 0xf7, 0x04, 0x7f, 0xad, 0x5b, 0x90, 0x29,
-	0x26, 0xf3, 0x58, 0x08, 0x05, 0x5a, 0xfe, 0xc0, 0x80, 0x02, 0x69, 0xc3, 0x95, 0x06, 0xdd, 0x34,
-	0x3a, 0x96, 0xbf, 0xe6, 0xf8, 0xc8, 0x9f, 0xb8, 0x85, 0x16, 0xcf, 0xe0, 0x41, 0xf6, 0x14, 0x8f,
-	0xc7, 0xc7, 0x1f, 0x0f, 0x2e, 0x1d, 0x50, 0x17, 0x0f, 0xc4, 0x46, 0x76, 0xe1, 0x41, 0x59, 0x87,
-	0xbf, 0xa9, 0xab, 0x6f, 0xb1, 0x59, 0x4e, 0x13, 0x3d, 0xc5, 0x89, 0x7e, 0xd3, 0xfe, 0x5e, 0xf9,
-	0xc1, 0xa5, 0x83, 0xab, 0x63, 0x2f, 0x38, 0xf9, 0x33, 0x25, 0x9a, 0xb8, 0x02, 0x9a, 0x9d, 0x2e,
-	0x3e, 0xc7, 0xc9, 0xeb, 0x24, 0xe1, 0x4f, 0x97, 0x2c, 0xc5, 0x14, 0x4d, 0xf2, 0x05, 0x0d, 0x66,
-	0x3d, 0xdf, 0xed, 0xd4, 0xfd, 0x8e, 0x4b, 0x1b, 0x89, 0x1d, 0x3a, 0xc3, 0x3b, 0x54, 0x48, 0x80,
-	0xab, 0xe5, 0xe0, 0xe4, 0xa1, 0x01, 0x66, 0xf3, 0xa0, 0x98, 0xdb, 0x17, 0xf2, 0x79, 0x0d, 0xce,
-	0xc7, 0x81, 0x4c, 0xa7, 0x17, 0xfd, 0x24, 0xc5, 0x2f, 0x59, 0x6a, 0xd9, 0x28, 0x85, 0x06, 0x9f,
-	0x03, 0xc4, 0xbc, 0x8e, 0x24, 0xaf, 0xd6, 0x4f, 0x9f, 0xf0, 0xd5, 0xfa, 0xdc, 0xbb, 0x80, 0xa4,
-	0x8f, 0x8f, 0x83, 0xe4, 0xc0, 0x51, 0x55, 0x0e, 0xfc, 0xcc, 0x10, 0x5c, 0x60, 0xa7, 0x52, 0xa4,
-	0xfd, 0xac, 0x1a, 0xb6, 0xd1, 0x7c, 0x79, 0x4a, 0x4c, 0x5f, 0xd4, 0xe0, 0xfc, 0x56, 0xb6, 0x69,
-	0x47, 0xea, 0x5f, 0xef, 0x29, 0x64, 0x82, 0xeb, 0x66, 0x2d, 0x12, 0x0c, 0xbb, 0x6b, 0x15, 0xcc,
-	0xeb, 0x14, 0x79, 0x17, 0x4c, 0xdb, 0x4e, 0x83, 0x56, 0xaa, 0x4b, 0xb8, 0x6a, 0x78, 0x77, 0x6b,
-	0x81, 0xa7, 0xcf, 0x90, 0xf8, 0x5e, 0xd7, 0x12, 0x30, 0x4c, 0xd5, 0x26, 0xdb, 0x40, 0xda, 0x4e,
-	0x63, 0x79, 0x5b, 0xe4, 0x68, 0xe9, 0xcf, 0xe7, 0x96, 0x7b, 0x1b, 0xac, 0xa7, 0xb0, 0x61, 0x06,
-	0x05, 0x6e, 0x9b, 0x62, 0x9d, 0x59, 0x75, 0x6c, 0xd3, 0x77, 0x5c, 0x1e, 0xec, 0xa2, 0x2f, 0x13,
-	0x0d, 0xb7, 0x4d, 0xad, 0x65, 0x62, 0xc4, 0x1c, 0x4a, 0xfa, 0x7f, 0xd1, 0xe0, 0x14, 0xdb, 0x16,
-	0xeb, 0xae, 0xb3, 0xb3, 0xfb, 0x72, 0xdc, 0x90, 0x0f, 0x4b, 0xa7, 0x47, 0x61, 0xdb, 0x39, 0xab,
-	0x38, 0x3c, 0x8e, 0xf1, 0x3e, 0x47, 0x3e, 0x8e, 0xaa, 0x59, 0x79, 0x20, 0xdf, 0xac, 0xac, 0x7f,
-	0xaa, 0x24, 0x34, 0x97, 0xc0, 0xac, 0xfb, 0xb2, 0xfc, 0x0e, 0x1f, 0x83, 0x49, 0x56, 0xb6, 0x6a,
-	0xec, 0xac, 0x2f, 0x3d, 0xe5, 0x58, 0xc1, 0x73, 0x6e, 0x6e, 0x6b, 0xbf, 0xa9, 0x02, 0x30, 0x5e,
-	0x8f, 0x3c, 0x0e, 0x23, 0x6d, 0x11, 0x33, 0x4e, 0xea, 0xcc, 0x57, 0x84, 0x67, 0x20, 0x2f, 0xba,
-	0xbf, 0x57, 0x9e, 0x89, 0xae, 0x78, 0x83, 0xc8, 0x75, 0x41, 0x03, 0xfd, 0x57, 0xcf, 0x01, 0x47,
-	0x6e, 0x51, 0xff, 0xe5, 0x38, 0x27, 0x6f, 0x84, 0xf1, 0x7a, 0xbb, 0x53, 0xb9, 0x56, 0x7b, 0x4f,
-	0xc7, 0xe1, 0xb6, 0x10, 0x9e, 0xca, 0x88, 0x71, 0xef, 0xca, 0xfa, 0xed, 0xa0, 0x18, 0xd5, 0x3a,
-	0x8c, 0x3b, 0xd4, 0xdb, 0x1d, 0xc9, 0x6f, 0xd7, 0xd5, 0x07, 0x2f, 0x9c, 0x3b, 0x54, 0xd6, 0x6f,
-	0xc7, 0x60, 0x98, 0xaa, 0x4d, 0x3e, 0x04, 0x13, 0x54, 0x7e, 0xb8, 0x37, 0x0c, 0xb7, 0x21, 0xf9,
-	0x42, 0xb5, 0xe8, 0xe0, 0xc3, 0xa9, 0x0d, 0xb8, 0x81, 0xd0, 0x00, 0x97, 0x15, 0x12, 0x18, 0x23,
-	0x48, 0xde, 0x07, 0x0f, 0x04, 0xbf, 0xd9, 0x2a, 0x3b, 0x8d, 0x24, 0xa3, 0x18, 0x12, 0x7e, 0x37,
-	0xcb, 0x79, 0x95, 0x30, 0xbf, 0x3d, 0xf9, 0x79, 0x0d, 0xce, 0x85, 0x50, 0xd3, 0x36, 0x5b, 0x9d,
-	0x16, 0xd2, 0xba, 0x65, 0x98, 0x2d, 0xa9, 0xf7, 0x3d, 0x7d, 0x64, 0x03, 0x8d, 0xa3, 0x17, 0xcc,
-	0x2a, 0x1b, 0x86, 0x39, 0x5d, 0x22, 0x9f, 0xd3, 0xe0, 0x4a, 0x00, 0x5a, 0x77, 0xa9, 0xe7, 0x75,
-	0x5c, 0x1a, 0x05, 0x13, 0x90, 0x53, 0x32, 0x52, 0x88, 0x77, 0x72, 0x01, 0x78, 0xf9, 0x00, 0xdc,
-	0x78, 0x20, 0x75, 0x75, 0xbb, 0xd4, 0x9c, 0x4d, 0x5f, 0x2a, 0x8a, 0xc7, 0xb5, 0x5d, 0x18, 0x09,
-	0x8c, 0x11, 0x24, 0xbf, 0xa0, 0xc1, 0x79, 0xb5, 0x40, 0xdd, 0x2d, 0x42, 0x43, 0x7c, 0xe6, 0xc8,
-	0x3a, 0x93, 0xc0, 0x2f, 0x24, 0xbc, 0x1c, 0x20, 0xe6, 0xf5, 0x8a, 0xb1, 0xed, 0x16, 0xdf, 0x98,
-	0x42, 0x8b, 0x1c, 0x12, 0x6c, 0x5b, 0xec, 0x55, 0x0f, 0x03, 0x18, 0x79, 0x33, 0x4c, 0xb4, 0x9d,
-	0xc6, 0xba, 0xd9, 0xf0, 0x78, 0x3c, 0x3b, 0xae, 0xeb, 0x0d, 0x88, 0xe9, 0x58, 0x77, 0x1a, 0xeb,
-	0xd5, 0x25, 0x51, 0x8e, 0xb1, 0x5a, 0x64, 0x1e, 0x60, 0xd3, 0x30, 0xad, 0xda, 0x3d, 0xa3, 0x7d,
-	0x2b, 0x08, 0x90, 0xc4, 0x6d, 0x11, 0xd7, 0xc2, 0x52, 0x54, 0x6a, 0xb0, 0xf5, 0x63, 0x7c, 0x07,
-	0xa9, 0x08, 0x41, 0xce, 0xd5, 0xa3, 0xa3, 0x58, 0xbf, 0x00, 0xa1, 0xe8, 0xf0, 0x4d, 0x85, 0x04,
-	0xc6, 0x08, 0x92, 0x8f, 0x6a, 0x30, 0xe5, 0xed, 0x7a, 0x3e, 0x6d, 0x85, 0x7d, 0x38, 0x75, 0xd4,
-	0x7d, 0xe0, 0x36, 0xf1, 0x5a, 0x8c, 0x08, 0x26, 0x88, 0xf2, 0x50, 0x53, 0x2d, 0xa3, 0x49, 0xaf,
-	0x57, 0x6e, 0x98, 0xcd, 0xad, 0x30, 0x3c, 0xcc, 0x3a, 0x75, 0xeb, 0xd4, 0xf6, 0xb9, 0x62, 0x35,
-	0x24, 0x43, 0x4d, 0xe5, 0x57, 0xc3, 0x6e, 0x38, 0xc8, 0x73, 0x30, 0x27, 0xc1, 0x2b, 0xce, 0xbd,
-	0x14, 0x05, 0x11, 0xd1, 0x89, 0x7b, 0x50, 0x56, 0x73, 0x6b, 0x61, 0x17, 0x0c, 0xa4, 0x0a, 0xa7,
-	0x3d, 0xea, 0xf2, 0x3b, 0x41, 0x11, 0x1d, 0x74, 0xbd, 0x63, 0x59, 0x1e, 0x57, 0x6d, 0xe4, 0x9b,
-	0xa1, 0x5a, 0x1a, 0x8c, 0x59, 0x6d, 0xc8, 0x13, 0xe1, 0xbb, 0xe2, 0x5d, 0x56, 0xf0, 0x9e, 0xf5,
-	0x1a, 0xd7, 0x44, 0x86, 0x84, 0xa5, 0x04, 0xe3, 0x20, 0x4c, 0xd6, 0x65, 0xa7, 0x79, 0x50, 0xb4,
-	0xd8, 0x71, 0x3d, 0x9f, 0xc7, 0x59, 0x1a, 0x12, 0xa7, 0x39, 0xaa, 0x00, 0x8c, 0xd7, 0x23, 0x8f,
-	0xc3, 0x94, 0x47, 0xeb, 0x75, 0xa7, 0xd5, 0x96, 0x7a, 0x32, 0x0f, 0x86, 0x34, 0x2a, 0x57, 0x30,
-	0x06, 0xc1, 0x44, 0x4d, 0xb2, 0x0b, 0xa7, 0xc3, 0x70, 0xca, 0x2b, 0x4e, 0x73, 0xd5, 0xd8, 0xe1,
-	0xc2, 0xf1, 0xb9, 0x22, 0x31, 0x22, 0xc4, 0x74, 0x55, 0xd2, 0xe8, 0x30, 0x8b, 0x06, 0x59, 0x81,
-	0x33, 0x89, 0xe2, 0x6b, 0xa6, 0x45, 0xbd, 0xd9, 0xf3, 0x7c, 0xd8, 0xdc, 0xd8, 0x55, 0xc9, 0x80,
-	0x63, 0x66, 0x2b, 0x72, 0x0b, 0xce, 0xb6, 0x5d, 0xc7, 0xa7, 0x75, 0xff, 0x26, 0x13, 0x08, 0x2c,
-	0x39, 0x40, 0x6f, 0x76, 0x96, 0xcf, 0x05, 0xbf, 0x0f, 0x5d, 0xcf, 0xaa, 0x80, 0xd9, 0xed, 0xc8,
-	0x67, 0x34, 0xb8, 0xec, 0xf9, 0x2e, 0x35, 0x5a, 0xa6, 0xdd, 0xac, 0x38, 0xb6, 0x4d, 0x39, 0x63,
-	0xaa, 0x36, 0xa2, 0x27, 0x77, 0x0f, 0x14, 0x3a, 0x45, 0xf4, 0xfd, 0xbd, 0xf2, 0xe5, 0x5a, 0x57,
-	0xcc, 0x78, 0x00, 0x65, 0xf2, 0x12, 0x40, 0x8b, 0xb6, 0x1c, 0x77, 0x97, 0x71, 0xa4, 0xd9, 0xb9,
-	0xe2, 0xfa, 0xee, 0x6a, 0x88, 0x45, 0x7c, 0xfe, 0xb1, 0x9b, 0xdc, 0x08, 0x88, 0x0a, 0x39, 0x36,
-	0xd5, 0x8c, 0xdf, 0xca, 0x88, 0x66, 0xca, 0x47, 0x73, 0x81, 0xaf, 0x1c, 0x9f, 0xea, 0xd5, 0xac,
-	0x0a, 0x98, 0xdd, 0x8e, 0x78, 0x30, 0xc3, 0xbf, 0x50, 0x79, 0x92, 0x5f, 0xaf, 0x2c, 0x34, 0xe9,
-	0xec, 0xc5, 0x42, 0x93, 0xcb, 0x64, 0xff, 0x99, 0x6a, 0x12, 0x19, 0xa6, 0xf1, 0x47, 0x44, 0x8d,
-	0x9d, 0x88, 0xe8, 0xa5, 0x7e, 0x89, 0x2a, 0xc8, 0x30, 0x8d, 0x5f, 0xdf, 0x2b, 0xc1, 0xd9, 0xcc,
-	0x53, 0x92, 0x31, 0x0f, 0x31, 0xc5, 0x0b, 0x41, 0xaa, 0x3a, 0x79, 0xed, 0xc9, 0x99, 0xc7, 0x6a,
-	0x1c, 0x84, 0xc9, 0xba, 0x4c, 0x86, 0xe5, 0xd4, 0xae, 0xd5, 0xa2, 0xf6, 0xa5, 0x48, 0x86, 0xad,
-	0x26, 0x60, 0x98, 0xaa, 0x4d, 0x2a, 0x72, 0x3e, 0xae, 0xd5, 0xaa, 0x4c, 0x0d, 0xf4, 0xae, 0xb9,
-	0x34, 0xd0, 0x0e, 0xa2, 0xf1, 0xa9, 0x40, 0x4c, 0xd7, 0x67, 0xa3, 0x60, 0x3f, 0xd4, 0x5e, 0x0c,
-	0x46, 0xa3, 0x58, 0x8b, 0x83, 0x30, 0x59, 0x37, 0xd0, 0xd3, 0x63, 0x5d, 0x18, 0x8a, 0x46, 0xb1,
-	0x96, 0x80, 0x61, 0xaa, 0xb6, 0xfe, 0x17, 0x83, 0xf0, 0x60, 0x0f, 0x92, 0x25, 0x69, 0x65, 0x4f,
-	0xf7, 0xe1, 0x79, 0x5e, 0x6f, 0xcb, 0xd3, 0xce, 0x59, 0x9e, 0xc3, 0xd3, 0xeb, 0x75, 0x39, 0xbd,
-	0xbc, 0xe5, 0x3c, 0x3c, 0xc9, 0xde, 0x97, 0xbf, 0x95, 0xbd, 0xfc, 0x05, 0x67, 0xf5, 0xc0, 0xed,
-	0xd2, 0xce, 0xd9, 0x2e, 0x05, 0x67, 0xb5, 0x87, 0xed, 0xf5, 0x97, 0x83, 0xf0, 0x9a, 0x5e, 0xa4,
-	0xdc, 0x82, 0xfb, 0x2b, 0x83, 0xb7, 0x1c, 0xeb, 0xfe, 0xca, 0x7b, 0x10, 0x7e, 0x8c, 0xfb, 0xab,
-	0x2b, 0xfb, 0x3c, 0x9e, 0xfd, 0x95, 0x37, 0xab, 0xc7, 0xb5, 0xbf, 0xf2, 0x66, 0xb5, 0x87, 0xfd,
-	0xf5, 0x77, 0xc9, 0xf3, 0x21, 0x14, 0xb5, 0xab, 0x30, 0x50, 0x6f, 0x77, 0x0a, 0x32, 0x29, 0xee,
-	0x65, 0x58, 0x59, 0xbf, 0x8d, 0x0c, 0x07, 0x41, 0x18, 0x16, 0xfb, 0xa7, 0x20, 0x0b, 0xe2, 0x9e,
-	0xa3, 0x62, 0x4b, 0xa2, 0xc4, 0xc4, 0xa6, 0x8a, 0xb6, 0xb7, 0x68, 0x8b, 0xba, 0x86, 0x55, 0xf3,
-	0x1d, 0xd7, 0x68, 0x16, 0xe5, 0x36, 0xe2, 0x06, 0x25, 0x81, 0x0b, 0x53, 0xd8, 0xd9, 0x84, 0xb4,
-	0xcd, 0x46, 0x41, 0xfe, 0xc2, 0x27, 0x64, 0xbd, 0xba, 0x84, 0x0c, 0x87, 0xfe, 0x1b, 0xa3, 0xa0,
-	0x24, 0x2a, 0x20, 0x9f, 0xd0, 0x60, 0xa6, 0x9e, 0x0c, 0xea, 0xd9, 0x8f, 0x3f, 0x54, 0x2a, 0x42,
-	0xa8, 0xd8, 0xf2, 0xa9, 0x62, 0x4c, 0x93, 0x25, 0xdf, 0xa5, 0x09, 0x23, 0x5f, 0x78, 0x9b, 0x27,
-	0xa7, 0xf5, 0xfa, 0x11, 0xdd, 0x7b, 0x47, 0xd6, 0xc2, 0xe8, 0x8a, 0x35, 0x4e, 0x90, 0x7c, 0x4e,
-	0x83, 0xb3, 0x77, 0xb3, 0xee, 0x26, 0xe4, 0xe4, 0xdf, 0x2a, 0xda, 0x95, 0x9c, 0xcb, 0x0e, 0x21,
-	0x41, 0x66, 0x56, 0xc0, 0xec, 0x8e, 0x84, 0xb3, 0x14, 0x9a, 0x6b, 0xe5, 0x77, 0x5a, 0x78, 0x96,
-	0x12, 0x76, 0xdf, 0x68, 0x96, 0x42, 0x00, 0xc6, 0x09, 0x92, 0x36, 0x8c, 0xdd, 0x0d, 0x6c, 0xe4,
-	0xd2, 0x2e, 0x56, 0x29, 0x4a, 0x5d, 0x31, 0xb4, 0x0b, 0x7f, 0xaf, 0xb0, 0x10, 0x23, 0x22, 0x64,
-	0x0b, 0x46, 0xee, 0x0a, 0x5e, 0x21, 0xed, 0x59, 0x0b, 0x7d, 0x6b, 0xff, 0xc2, 0xac, 0x22, 0x8b,
-	0x30, 0x40, 0xaf, 0xbe, 0x25, 0x18, 0x3d, 0xe0, 0x89, 0xdb, 0x67, 0x34, 0x38, 0xbb, 0x4d, 0x5d,
-	0xdf, 0xac, 0x27, 0x6f, 0x86, 0xc6, 0x8a, 0x5b, 0x28, 0x9e, 0xca, 0x42, 0x28, 0xb6, 0x49, 0x26,
-	0x08, 0xb3, 0xbb, 0x40, 0x9e, 0x82, 0x41, 0xea, 0xd7, 0x1b, 0x32, 0x98, 0xf7, 0xdb, 0x8a, 0xbe,
-	0xf9, 0x15, 0xef, 0x5e, 0xd8, 0x7f, 0xc8, 0xf1, 0xe9, 0x7f, 0xa3, 0x41, 0xca, 0x36, 0x4d, 0x7e,
-	0x50, 0x83, 0x89, 0x4d, 0x6a, 0xf8, 0x1d, 0x97, 0x5e, 0x97, 0x3e, 0xa1, 0x03, 0x0f, 0x8d, 0x3f,
-	0xfa, 0xd4, 0x51, 0x98, 0xc4, 0xe7, 0xaf, 0x29, 0x88, 0x85, 0xb3, 0x4a, 0x98, 0x7c, 0x44, 0x05,
-	0x61, 0xac, 0x07, 0x73, 0x4f, 0xc2, 0x4c, 0xaa, 0xe1, 0xa1, 0xae, 0x29, 0x7f, 0x5d, 0x83, 0xac,
-	0x34, 0xfc, 0xe4, 0x39, 0x18, 0x32, 0x1a, 0x8d, 0x30, 0x7d, 0xea, 0xdb, 0x8b, 0xf9, 0x4d, 0x35,
-	0xd4, 0x50, 0x43, 0xfc, 0x27, 0x0a, 0xb4, 0xe4, 0x1a, 0x10, 0x23, 0x76, 0x2f, 0xbd, 0x1a, 0xc5,
-	0xb8, 0xe0, 0xd7, 0x69, 0x0b, 0x29, 0x28, 0x66, 0xb4, 0xd0, 0x3f, 0xa6, 0x01, 0x49, 0xa7, 0xab,
-	0x21, 0x2e, 0x8c, 0xca, 0xfd, 0x1b, 0xac, 0xd2, 0x52, 0xc1, 0xd7, 0x74, 0xb1, 0xa7, 0xa1, 0x91,
-	0x13, 0x9e, 0x2c, 0xf0, 0x30, 0xa4, 0xa3, 0xff, 0x83, 0x06, 0x51, 0x4a, 0x44, 0xf2, 0x16, 0x18,
-	0x6f, 0x50, 0xaf, 0xee, 0x9a, 0x6d, 0x3f, 0x7a, 0x48, 0x1a, 0x3e, 0x48, 0x5b, 0x8a, 0x40, 0xa8,
-	0xd6, 0x23, 0x3a, 0x0c, 0xfb, 0x86, 0x77, 0xb7, 0xba, 0x24, 0x95, 0x3d, 0x7e, 0x34, 0x6f, 0xf0,
-	0x12, 0x94, 0x90, 0x28, 0xf6, 0xec, 0x40, 0x0f, 0xb1, 0x67, 0xc9, 0xe6, 0x11, 0x04, 0xda, 0x25,
-	0x07, 0x07, 0xd9, 0xd5, 0x7f, 0xb6, 0x04, 0xa7, 0x58, 0x95, 0x55, 0xc3, 0xb4, 0x7d, 0x6a, 0xf3,
-	0x67, 0x53, 0x05, 0x27, 0xa1, 0x09, 0x93, 0x7e, 0xec, 0xd5, 0xf3, 0xe1, 0x1f, 0xd5, 0x86, 0x9e,
-	0x5e, 0xf1, 0xb7, 0xce, 0x71, 0xbc, 0xe4, 0xed, 0xc1, 0xbb, 0x35, 0xa1, 0x16, 0x3f, 0x18, 0x6c,
-	0x55, 0xfe, 0x18, 0xed, 0xbe, 0x7c, 0x42, 0x1e, 0xe6, 0xd1, 0x8c, 0x3d, 0x51, 0x7b, 0x0c, 0x26,
-	0xe5, 0x0b, 0x09, 0x11, 0x44, 0x58, 0xaa, 0xc5, 0xfc, 0x58, 0xb9, 0xa6, 0x02, 0x30, 0x5e, 0x4f,
-	0xff, 0xe3, 0x12, 0xc4, 0xb3, 0x75, 0x16, 0x9d, 0xa5, 0x74, 0x04, 0xe5, 0xd2, 0xb1, 0x45, 0x50,
-	0x16, 0xe9, 0xf4, 0xb9, 0x3b, 0xb7, 0xbc, 0x67, 0x57, 0x53, 0x69, 0xf3, 0x72, 0x0c, 0x6b, 0x44,
-	0xd3, 0x3a, 0x78, 0xe8, 0x69, 0x7d, 0x8b, 0xf4, 0xfc, 0x1d, 0x8a, 0xc5, 0xb1, 0x0e, 0x3c, 0x7f,
-	0x67, 0x62, 0x0d, 0x95, 0x57, 0x76, 0x0b, 0x20, 0xf3, 0xdd, 0xb0, 0x75, 0x91, 0x31, 0xb6, 0xbd,
-	0x0d, 0xc7, 0x37, 0x2c, 0x3e, 0x9d, 0xd2, 0xe8, 0xba, 0xaa, 0x02, 0x30, 0x5e, 0x4f, 0x5f, 0x83,
-	0x57, 0xaf, 0x38, 0x46, 0x63, 0xd1, 0xb0, 0xd8, 0xd6, 0x75, 0xa5, 0x5b, 0x9e, 0xc7, 0x4f, 0xe6,
-	0x75, 0xd7, 0xf1, 0x9d, 0xba, 0x63, 0xb1, 0x73, 0xd3, 0x08, 0x83, 0x0d, 0xf3, 0x8b, 0xc8, 0xe0,
-	0xdc, 0x94, 0xc1, 0x7a, 0x31, 0x80, 0xeb, 0xbf, 0xa7, 0xc1, 0x88, 0xcc, 0x81, 0xd3, 0xc3, 0xc3,
-	0xd2, 0x4d, 0x18, 0xe2, 0xda, 0x51, 0x3f, 0x52, 0x29, 0xcf, 0x45, 0x1b, 0x4b, 0x9e, 0x25, 0xd2,
-	0xcf, 0xf1, 0x84, 0xa1, 0x02, 0x3d, 0xf7, 0x47, 0x75, 0xeb, 0x5b, 0xa6, 0x4f, 0xb9, 0xdb, 0x8d,
-	0xdc, 0xf8, 0xc2, 0x1f, 0x55, 0x29, 0xc7, 0x58, 0x2d, 0xfd, 0x3f, 0x0f, 0xc1, 0x95, 0x20, 0x9f,
-	0x4f, 0x52, 0x54, 0x0b, 0x79, 0xee, 0x6e, 0x98, 0x60, 0x88, 0x27, 0x48, 0x08, 0x6c, 0xaa, 0xc5,
-	0xb4, 0xe4, 0xf3, 0x4a, 0x0a, 0x21, 0x15, 0x1d, 0x66, 0xd1, 0x10, 0xe1, 0xdb, 0x79, 0xf1, 0x0d,
-	0x6a, 0x58, 0xfe, 0x56, 0x40, 0xbb, 0xd4, 0x4f, 0xf8, 0xf6, 0x34, 0x3e, 0xcc, 0xa4, 0xc2, 0x5d,
-	0x3a, 0x82, 0xc4, 0x46, 0x2e, 0x35, 0x54, 0x7f, 0x92, 0x3e, 0x9e, 0x1b, 0xad, 0x66, 0x62, 0xc4,
-	0x1c, 0x4a, 0xdc, 0xdc, 0x68, 0xec, 0x70, 0xeb, 0x05, 0x52, 0xdf, 0x35, 0x79, 0x12, 0xb4, 0xf0,
-	0xae, 0x62, 0x35, 0x0e, 0xc2, 0x64, 0x5d, 0xf2, 0x38, 0x4c, 0x71, 0x17, 0x99, 0x28, 0x1e, 0xe8,
-	0x50, 0x14, 0x15, 0x6a, 0x2d, 0x06, 0xc1, 0x44, 0x4d, 0xf2, 0xdd, 0x1a, 0x9c, 0x31, 0xd5, 0xc7,
-	0x34, 0xc1, 0xe8, 0x87, 0xfb, 0xc9, 0x2b, 0x21, 0xb6, 0x71, 0x06, 0x5a, 0xcc, 0x24, 0x46, 0x56,
-	0xe0, 0x8c, 0x7c, 0x11, 0x1d, 0xdf, 0x03, 0x22, 0x58, 0x1c, 0x5f, 0xd3, 0xa5, 0x0c, 0x38, 0x66,
-	0xb6, 0xd2, 0x3f, 0x5c, 0x82, 0x89, 0x43, 0x26, 0xb4, 0xed, 0x28, 0x32, 0x47, 0x1f, 0xef, 0x16,
-	0x55, 0xaa, 0x3d, 0x88, 0x1d, 0xe4, 0x19, 0x98, 0xea, 0xf0, 0x89, 0x08, 0x42, 0xa9, 0xc9, 0x6f,
-	0xfa, 0x5b, 0xd8, 0xca, 0xdd, 0x8e, 0x41, 0xee, 0xef, 0x95, 0xe7, 0x54, 0xf4, 0x71, 0x28, 0x26,
-	0xf0, 0xe8, 0xbf, 0x55, 0x02, 0xa2, 0x56, 0xbf, 0x66, 0x19, 0xdb, 0x8e, 0x4b, 0x3e, 0xaf, 0xc1,
-	0x44, 0x5d, 0x4d, 0x21, 0x26, 0x04, 0xac, 0x67, 0xfa, 0x1d, 0xac, 0x40, 0x3f, 0xaf, 0xe6, 0x06,
-	0x13, 0x82, 0x70, 0xf0, 0xdc, 0x7a, 0x42, 0x05, 0xdd, 0x4f, 0xfc, 0xc6, 0x58, 0x9f, 0xe6, 0x3e,
-	0xaa, 0xc1, 0x4c, 0x0a, 0x53, 0x86, 0x64, 0xfc, 0x6c, 0x3c, 0x88, 0xfa, 0x91, 0x24, 0x0b, 0x55,
-	0xe5, 0xeb, 0x9f, 0x1a, 0x82, 0xd3, 0x19, 0x2b, 0xca, 0x5d, 0x6c, 0x68, 0x42, 0xba, 0xec, 0xc7,
-	0xc5, 0x26, 0x25, 0xa9, 0x86, 0x2e, 0x36, 0x49, 0x08, 0xa6, 0xe8, 0x92, 0xa7, 0x60, 0xa0, 0xee,
-	0x9a, 0x72, 0xd3, 0x3e, 0x56, 0x68, 0x0a, 0xb0, 0xba, 0x38, 0x2e, 0x29, 0x0e, 0x54, 0xb0, 0x8a,
-	0x0c, 0x21, 0x3b, 0x8b, 0xd5, 0x63, 0x24, 0x10, 0x58, 0xf9, 0x59, 0xac, 0x9e, 0x36, 0x1e, 0xc6,
-	0xeb, 0x91, 0x67, 0x60, 0x56, 0x6a, 0xaa, 0x41, 0x24, 0x13, 0xc7, 0xf6, 0x7c, 0xc6, 0xf1, 0x7d,
-	0x29, 0x53, 0x70, 0xef, 0xd7, 0x9b, 0x39, 0x75, 0x30, 0xb7, 0x35, 0xf9, 0x4e, 0x98, 0x8a, 0x71,
-	0x8f, 0x20, 0xac, 0x59, 0xc1, 0x27, 0x33, 0x2a, 0x26, 0xc1, 0x2b, 0xe3, 0x65, 0x98, 0xa0, 0x46,
-	0xfe, 0x77, 0x0d, 0x66, 0xc2, 0x8d, 0xba, 0x2b, 0xb6, 0x7c, 0x90, 0xcd, 0xf1, 0xda, 0xd1, 0x7c,
-	0x41, 0x4a, 0xaa, 0xdd, 0x24, 0x21, 0x4c, 0xd3, 0xd6, 0x7f, 0x74, 0x18, 0xd4, 0xec, 0xde, 0x64,
-	0xb5, 0x1f, 0xbb, 0x64, 0xb4, 0x07, 0x02, 0xdb, 0xe4, 0x2a, 0x0c, 0x34, 0xdb, 0x9d, 0x82, 0x86,
-	0xc9, 0x10, 0xdd, 0x75, 0x86, 0xae, 0xd9, 0xee, 0x90, 0xa7, 0x42, 0x53, 0x67, 0x31, 0x63, 0x64,
-	0xf8, 0x54, 0x32, 0x61, 0xee, 0x0c, 0xd8, 0xfb, 0x60, 0x2e, 0x7b, 0x6f, 0xc1, 0x88, 0x27, 0xed,
-	0xa0, 0x43, 0xc5, 0xe3, 0x50, 0x2a, 0x33, 0x2d, 0xed, 0x9e, 0xc2, 0x42, 0x13, 0x98, 0x45, 0x03,
-	0x1a, 0x4c, 0x11, 0xec, 0xf0, 0x73, 0x49, 0x46, 0x59, 0xe2, 0x8a, 0xe0, 0x6d, 0x5e, 0x82, 0x12,
-	0x92, 0x12, 0xe6, 0x46, 0x7a, 0x11, 0xe6, 0xc8, 0x67, 0x93, 0xfc, 0x7b, 0x94, 0xef, 0xbe, 0xf7,
-	0xf4, 0x39, 0x9c, 0xff, 0xc5, 0x18, 0xf7, 0xff, 0x16, 0x1d, 0x7e, 0xca, 0x62, 0x91, 0x07, 0x61,
-	0x88, 0x47, 0x51, 0x92, 0x72, 0x40, 0x68, 0xdc, 0xe0, 0x71, 0x74, 0x50, 0xc0, 0x48, 0x4d, 0xc6,
-	0x1e, 0x2c, 0xb6, 0xe9, 0xb9, 0x6f, 0xa3, 0xa4, 0xa7, 0x04, 0x2a, 0xbc, 0x12, 0x7b, 0x13, 0x99,
-	0xa5, 0x43, 0xdc, 0x86, 0x91, 0x96, 0x69, 0x73, 0x77, 0x8f, 0x62, 0x46, 0x74, 0xe1, 0x82, 0x25,
-	0x50, 0x60, 0x80, 0x4b, 0xff, 0xcb, 0x12, 0x63, 0x10, 0x91, 0x52, 0xbf, 0x2b, 0x22, 0xe9, 0xca,
-	0xd7, 0xd7, 0x5a, 0x71, 0x23, 0xa0, 0x82, 0x74, 0x21, 0x44, 0x28, 0x1c, 0x15, 0xa2, 0xdf, 0xa8,
-	0x10, 0x63, 0xa4, 0x7d, 0xb3, 0x45, 0x9f, 0x36, 0xed, 0x86, 0x73, 0x4f, 0x4e, 0x6f, 0xbf, 0xa4,
-	0x37, 0x42, 0x84, 0x82, 0x74, 0xf4, 0x1b, 0x15, 0x62, 0xec, 0x48, 0xe2, 0xd9, 0x3c, 0x6c, 0x9e,
-	0x95, 0x59, 0xf6, 0x4d, 0xe4, 0x5c, 0x97, 0x7e, 0xc7, 0xfc, 0x48, 0xaa, 0xe4, 0xd4, 0xc1, 0xdc,
-	0xd6, 0xfa, 0xcf, 0x6b, 0x70, 0x36, 0x73, 0x2a, 0xc8, 0x75, 0x98, 0x89, 0xdc, 0x61, 0x55, 0x21,
-	0x61, 0x34, 0xe2, 0xf1, 0x37, 0x93, 0x15, 0x30, 0xdd, 0x46, 0xe4, 0xba, 0x4b, 0x09, 0x21, 0xd2,
-	0x97, 0x56, 0x55, 0xb5, 0x54, 0x30, 0x66, 0xb5, 0xd1, 0xdf, 0x17, 0xeb, 0x6c, 0x34, 0x59, 0xec,
-	0xcb, 0xb8, 0x43, 0x9b, 0xe1, 0x9b, 0xf4, 0xf0, 0xcb, 0x58, 0x64, 0x85, 0x28, 0x60, 0xe4, 0x92,
-	0x1a, 0x2a, 0x23, 0xe4, 0xee, 0x41, 0xb8, 0x0c, 0xfd, 0xd7, 0x35, 0x38, 0xbf, 0x6a, 0xd8, 0x1d,
-	0xc3, 0x12, 0x8f, 0xdf, 0xd7, 0x1d, 0xc7, 0x92, 0xd3, 0xd4, 0x2d, 0xf7, 0xbe, 0xf6, 0x72, 0xc9,
-	0xbd, 0xff, 0x7e, 0x38, 0x9f, 0xe3, 0x7f, 0x43, 0x96, 0x60, 0xc2, 0xbb, 0x67, 0xb4, 0x17, 0xe9,
-	0x96, 0xb1, 0x6d, 0xca, 0xc0, 0x5a, 0xc2, 0x4d, 0x7b, 0xa2, 0xa6, 0x94, 0xdf, 0x4f, 0xfc, 0xc6,
-	0x58, 0x2b, 0xdd, 0x07, 0x90, 0xee, 0xfc, 0xa6, 0xdd, 0x24, 0x9b, 0x30, 0x6a, 0x58, 0xd4, 0xf5,
-	0xa3, 0xe8, 0x93, 0xdf, 0x5a, 0xc8, 0x4e, 0x2b, 0x71, 0x88, 0xe7, 0x6b, 0xc1, 0x2f, 0x0c, 0x71,
-	0xeb, 0xff, 0x97, 0x06, 0xe7, 0xb2, 0x43, 0x29, 0xf5, 0xa0, 0x16, 0xb5, 0x60, 0xdc, 0x8d, 0x9a,
-	0xc9, 0x8f, 0xf6, 0xad, 0x6a, 0x8a, 0x0b, 0xe5, 0xe1, 0x0d, 0x53, 0x04, 0x2b, 0xae, 0xe3, 0x05,
-	0x3b, 0x37, 0x99, 0xf5, 0x22, 0xb4, 0x8a, 0x29, 0x3d, 0x41, 0x15, 0x3f, 0xcf, 0x40, 0xc3, 0xa8,
-	0x7b, 0x6d, 0xa3, 0x4e, 0x1b, 0x27, 0x9c, 0x5f, 0xff, 0x08, 0xd2, 0x3e, 0x64, 0xf7, 0xfd, 0x78,
-	0x33, 0xd0, 0xe4, 0xd0, 0x3c, 0x38, 0x03, 0x4d, 0x76, 0xc3, 0x57, 0x48, 0x6a, 0x84, 0xec, 0xce,
-	0xe7, 0x3c, 0x7c, 0xff, 0x8f, 0xc3, 0x79, 0xa3, 0x3d, 0x64, 0x92, 0xfe, 0xed, 0x63, 0x4c, 0xd2,
-	0x3f, 0xf5, 0xcf, 0x09, 0xfa, 0x33, 0x12, 0xf4, 0x27, 0x92, 0xc6, 0x0f, 0x9f, 0x50, 0xd2, 0xf8,
-	0x17, 0x60, 0xb8, 0x6d, 0xb8, 0xd4, 0x0e, 0xee, 0x72, 0xab, 0xc5, 0x1c, 0x0d, 0xa2, 0x8d, 0x16,
-	0x71, 0xc1, 0xf0, 0x93, 0x5c, 0xe7, 0x04, 0x50, 0x12, 0xca, 0x08, 0x9e, 0x32, 0x7a, 0x5c, 0xd1,
-	0x09, 0xa3, 0x74, 0xf5, 0x63, 0xc7, 0x91, 0xae, 0x5e, 0xff, 0x7b, 0x0d, 0x2e, 0x76, 0x63, 0x4b,
-	0xdc, 0x80, 0x52, 0x4f, 0x7c, 0x86, 0xfd, 0x18, 0x50, 0x52, 0xdc, 0x36, 0x34, 0xa0, 0x24, 0x21,
-	0x98, 0xa2, 0x4b, 0xde, 0x0d, 0xc4, 0xb9, 0x23, 0xfc, 0x7a, 0xae, 0x33, 0x1a, 0xe2, 0x35, 0x6d,
-	0x89, 0xbf, 0x55, 0x08, 0x13, 0x63, 0xde, 0x4a, 0xd5, 0xc0, 0x8c, 0x56, 0xfa, 0xaf, 0x96, 0x00,
-	0xd6, 0xa8, 0x7f, 0xcf, 0x71, 0xef, 0xb2, 0x33, 0xfe, 0x62, 0xec, 0xea, 0x60, 0xf4, 0x1b, 0x17,
-	0x8f, 0xf2, 0x22, 0x0c, 0xb6, 0x9d, 0x86, 0x27, 0xf5, 0x0f, 0xde, 0x11, 0xfe, 0x54, 0x83, 0x97,
-	0x92, 0x32, 0x0c, 0x71, 0xa7, 0x27, 0xa9, 0x40, 0xf3, 0x8b, 0x07, 0x7e, 0xb9, 0x82, 0xa2, 0x9c,
-	0x71, 0x48, 0x19, 0xd3, 0xc0, 0x93, 0x97, 0x3b, 0x13, 0x22, 0x08, 0xb9, 0x28, 0xc3, 0x10, 0x4a,
-	0x1e, 0x07, 0x30, 0xdb, 0xd7, 0x8c, 0x96, 0x69, 0x99, 0xf2, 0x73, 0x1d, 0xe3, 0x16, 0x71, 0xa8,
-	0xae, 0x07, 0xa5, 0xf7, 0xf7, 0xca, 0xa3, 0xf2, 0xd7, 0x2e, 0x2a, 0xb5, 0xf5, 0x2f, 0x6a, 0x30,
-	0x1d, 0x4d, 0x9e, 0xdc, 0x2a, 0x41, 0xcf, 0x45, 0x30, 0xe0, 0xdc, 0x9e, 0x8b, 0xb8, 0xff, 0xdd,
-	0x7b, 0x2e, 0x0c, 0x58, 0x79, 0x3d, 0x7f, 0x23, 0x8c, 0x53, 0x11, 0xf2, 0xa8, 0xba, 0x84, 0x82,
-	0xc7, 0x8d, 0x09, 0x75, 0x6e, 0x39, 0x2a, 0x46, 0xb5, 0x8e, 0xfe, 0x8f, 0x03, 0x30, 0xb1, 0xd6,
-	0x34, 0xed, 0x9d, 0x20, 0xb6, 0x53, 0x78, 0xf1, 0xae, 0x1d, 0xcf, 0xc5, 0xfb, 0x33, 0x30, 0x6b,
-	0xa9, 0xd7, 0x5c, 0x42, 0x70, 0x32, 0xec, 0x66, 0x38, 0x03, 0x5c, 0x8f, 0x59, 0xc9, 0xa9, 0x83,
-	0xb9, 0xad, 0x89, 0x0f, 0xc3, 0xf5, 0x20, 0x41, 0x63, 0xe1, 0x78, 0x45, 0xea, 0x5c, 0xcc, 0xab,
-	0xa1, 0x3b, 0x42, 0x9e, 0x27, 0xb7, 0xa7, 0xa4, 0x45, 0x3e, 0xa2, 0xc1, 0x59, 0xba, 0x23, 0x42,
-	0xd7, 0x6c, 0xb8, 0xc6, 0xe6, 0xa6, 0x59, 0x97, 0x2f, 0xfe, 0xc4, 0x4e, 0x5c, 0xd9, 0xdf, 0x2b,
-	0x9f, 0x5d, 0xce, 0xaa, 0x70, 0x7f, 0xaf, 0x7c, 0x35, 0x33, 0x92, 0x10, 0x5f, 0xcd, 0xcc, 0x26,
-	0x98, 0x4d, 0x6a, 0xee, 0xed, 0x30, 0x7e, 0x88, 0x77, 0xe2, 0xb1, 0x78, 0x41, 0xbf, 0x56, 0x82,
-	0x09, 0xb6, 0xdd, 0x56, 0x9c, 0xba, 0x61, 0x2d, 0xad, 0xd5, 0xc8, 0xc3, 0xc9, 0x30, 0x89, 0xe1,
-	0x15, 0x63, 0x2a, 0x54, 0xe2, 0x0a, 0x9c, 0xd9, 0x74, 0xdc, 0x3a, 0xdd, 0xa8, 0xac, 0x6f, 0x38,
-	0xd2, 0xf9, 0x6c, 0x69, 0xad, 0x26, 0xf5, 0x3a, 0x7e, 0xe5, 0x71, 0x2d, 0x03, 0x8e, 0x99, 0xad,
-	0xc8, 0x2d, 0x38, 0x1b, 0x95, 0xdf, 0x6e, 0x8b, 0x07, 0x0b, 0x0c, 0xdd, 0x40, 0xf4, 0xe0, 0xe2,
-	0x5a, 0x56, 0x05, 0xcc, 0x6e, 0x47, 0x0c, 0xb8, 0x20, 0xef, 0x56, 0xae, 0x39, 0xee, 0x3d, 0xc3,
-	0x6d, 0xc4, 0xd1, 0x0e, 0x46, 0x79, 0xeb, 0x97, 0xf2, 0xab, 0x61, 0x37, 0x1c, 0xfa, 0x3f, 0x68,
-	0x10, 0x0f, 0x42, 0x49, 0x1e, 0x80, 0x01, 0x57, 0xe6, 0xff, 0x93, 0xc1, 0x18, 0x99, 0x8a, 0xc0,
-	0xca, 0xc8, 0x3c, 0x80, 0x1b, 0x45, 0xc2, 0x2c, 0x45, 0x79, 0x31, 0x94, 0x18, 0x96, 0x4a, 0x0d,
-	0x86, 0xca, 0x37, 0x9a, 0x92, 0xe1, 0x71, 0x54, 0x1b, 0x46, 0x13, 0x59, 0x19, 0x4f, 0x80, 0x62,
-	0x36, 0xa9, 0x17, 0x98, 0xa3, 0x45, 0x02, 0x14, 0x5e, 0x82, 0x12, 0x42, 0x0c, 0x98, 0x6c, 0x77,
-	0x2c, 0x19, 0x4f, 0x89, 0xa9, 0x3e, 0xc2, 0x6c, 0xf8, 0x50, 0x56, 0x76, 0x3f, 0xbe, 0xfa, 0x99,
-	0x29, 0xfe, 0xd6, 0x55, 0x14, 0x18, 0xc7, 0xa8, 0xff, 0xc4, 0x30, 0x28, 0xe1, 0x75, 0x0e, 0x21,
-	0x85, 0xfe, 0x8c, 0x06, 0x67, 0xea, 0x96, 0x49, 0x6d, 0x3f, 0x11, 0xa9, 0x42, 0x1c, 0x1f, 0xb7,
-	0x0b, 0xc5, 0xfd, 0x69, 0x53, 0xbb, 0xba, 0x24, 0x9f, 0xb7, 0x54, 0x32, 0x90, 0xcb, 0x27, 0x40,
-	0x19, 0x10, 0xcc, 0xec, 0x0c, 0x1f, 0x0f, 0x2f, 0xaf, 0x2e, 0xa9, 0xd1, 0x33, 0x2b, 0xb2, 0x0c,
-	0x43, 0x28, 0xe3, 0xbc, 0x4d, 0xd7, 0xe9, 0xb4, 0xbd, 0x0a, 0x7f, 0xc5, 0x2a, 0x16, 0x85, 0x73,
-	0xde, 0xeb, 0x51, 0x31, 0xaa, 0x75, 0xc8, 0x9b, 0x61, 0x42, 0xfc, 0x5c, 0x77, 0xe9, 0xa6, 0xb9,
-	0x23, 0x0f, 0x25, 0x6e, 0x3c, 0xbd, 0xae, 0x94, 0x63, 0xac, 0x16, 0x8f, 0xdf, 0xe6, 0x79, 0x1d,
-	0xea, 0xde, 0xc6, 0x15, 0x99, 0x24, 0x5a, 0xc4, 0x6f, 0x0b, 0x0a, 0x31, 0x82, 0x93, 0x1f, 0xd6,
-	0x60, 0xca, 0xa5, 0x2f, 0x74, 0x4c, 0x97, 0x89, 0x30, 0x86, 0xd9, 0xf2, 0x64, 0x8c, 0x23, 0xec,
-	0x2f, 0xae, 0xd2, 0x3c, 0xc6, 0x90, 0x0a, 0x06, 0x19, 0xba, 0x72, 0xc4, 0x81, 0x98, 0xe8, 0x01,
-	0x9b, 0x2a, 0xcf, 0x6c, 0xda, 0xa6, 0xdd, 0x5c, 0xb0, 0x9a, 0x9e, 0xcc, 0x84, 0x25, 0x6c, 0x8e,
-	0x51, 0x31, 0xaa, 0x75, 0xc8, 0x63, 0x30, 0xd9, 0xf1, 0x18, 0xdb, 0x6b, 0x51, 0x31, 0xbf, 0x63,
-	0x91, 0xaf, 0xcb, 0x6d, 0x15, 0x80, 0xf1, 0x7a, 0xe4, 0x71, 0x98, 0x0a, 0x0a, 0xe4, 0x2c, 0x83,
-	0x48, 0xc7, 0xc2, 0xef, 0x26, 0x63, 0x10, 0x4c, 0xd4, 0x9c, 0x5b, 0x80, 0xd3, 0x19, 0xc3, 0x3c,
-	0x14, 0x6f, 0xfd, 0x1f, 0x1a, 0x9c, 0x15, 0x52, 0x57, 0x90, 0x5e, 0x3a, 0x48, 0x8e, 0x91, 0x9d,
-	0x67, 0x42, 0x3b, 0xd6, 0x3c, 0x13, 0xdf, 0x80, 0x7c, 0x1a, 0xfa, 0xcf, 0x95, 0xe0, 0xd5, 0x07,
-	0x7e, 0x97, 0xe4, 0x27, 0x35, 0x18, 0xa7, 0x3b, 0xbe, 0x6b, 0x84, 0x4f, 0xfd, 0xd9, 0x26, 0xdd,
-	0x3c, 0x16, 0x26, 0x30, 0xbf, 0x1c, 0x11, 0x12, 0x1b, 0x37, 0x54, 0xa5, 0x14, 0x08, 0xaa, 0xfd,
-	0x61, 0xdc, 0x56, 0xa4, 0x2b, 0x52, 0x9d, 0xe2, 0x24, 0x17, 0x94, 0x90, 0xb9, 0x77, 0xc2, 0x74,
-	0x12, 0xf3, 0xa1, 0xf6, 0xca, 0x17, 0x34, 0xc8, 0x0c, 0xc7, 0x49, 0x2a, 0x30, 0x63, 0x74, 0x7c,
-	0x27, 0x76, 0xaf, 0x27, 0x45, 0x49, 0xee, 0xf4, 0xbd, 0x90, 0x04, 0x62, 0xba, 0xbe, 0x30, 0xc0,
-	0xda, 0x1d, 0xc3, 0x8a, 0xa3, 0x11, 0x02, 0x97, 0x34, 0xc0, 0xa6, 0xc0, 0x98, 0xd5, 0x46, 0xff,
-	0x7f, 0x35, 0x38, 0x9b, 0x69, 0x96, 0xec, 0xc1, 0x16, 0x97, 0xbd, 0xed, 0x4b, 0xc7, 0xb9, 0xed,
-	0xf5, 0x5f, 0x29, 0xc1, 0xc8, 0xba, 0xeb, 0xb0, 0xa3, 0xed, 0x04, 0x2c, 0x70, 0x46, 0xcc, 0x02,
-	0x57, 0xc8, 0xbe, 0x20, 0x3b, 0x9b, 0x6b, 0x72, 0x33, 0x13, 0x26, 0xb7, 0x85, 0x7e, 0x88, 0x74,
-	0xb7, 0xb1, 0x7d, 0x59, 0x83, 0x71, 0x59, 0xf3, 0x04, 0x8c, 0x6a, 0xdf, 0x11, 0x37, 0xaa, 0xbd,
-	0xa3, 0x8f, 0x71, 0xe5, 0x58, 0xd1, 0x3e, 0xa3, 0xc1, 0xa4, 0xac, 0xb1, 0x4a, 0x5b, 0x77, 0xa8,
-	0x4b, 0xae, 0xc1, 0x88, 0xd7, 0xe1, 0x0b, 0x29, 0x07, 0x74, 0x41, 0x15, 0x8f, 0xdc, 0x3b, 0x46,
-	0x9d, 0x75, 0xbf, 0x26, 0xaa, 0x28, 0xa9, 0x94, 0x45, 0x01, 0x06, 0x8d, 0xd9, 0xde, 0x77, 0x1d,
-	0x2b, 0x15, 0xb6, 0x1e, 0x1d, 0x8b, 0x22, 0x87, 0x30, 0x3d, 0x8f, 0xfd, 0x0d, 0x74, 0x38, 0xae,
-	0xe7, 0x31, 0xb0, 0x87, 0xa2, 0x5c, 0xff, 0xe2, 0x50, 0x38, 0xd9, 0x5c, 0xa9, 0xbf, 0x01, 0x63,
-	0x75, 0x97, 0x1a, 0x3e, 0x6d, 0x2c, 0xee, 0xf6, 0xd2, 0x39, 0x2e, 0x07, 0x54, 0x82, 0x16, 0x18,
-	0x35, 0x66, 0x47, 0xae, 0xea, 0xe0, 0x59, 0x8a, 0xa4, 0x93, 0x5c, 0xe7, 0xce, 0x6f, 0x85, 0x21,
-	0xe7, 0x9e, 0x1d, 0x3e, 0x0e, 0xe9, 0x4a, 0x98, 0x0f, 0xe5, 0x16, 0xab, 0x8d, 0xa2, 0x91, 0x9a,
-	0xb6, 0x61, 0xb0, 0x4b, 0xda, 0x06, 0x0b, 0x46, 0x5a, 0x7c, 0x19, 0xfa, 0xca, 0xac, 0x1b, 0x5b,
-	0xd0, 0x68, 0x89, 0xc4, 0x6f, 0x0f, 0x03, 0x12, 0x4c, 0x74, 0xb2, 0x03, 0x8b, 0x8e, 0x2a, 0x3a,
-	0x85, 0x66, 0x1e, 0x8c, 0xe0, 0x64, 0x37, 0x9e, 0x0f, 0x64, 0xa4, 0xb8, 0x9d, 0x54, 0x76, 0x4f,
-	0x49, 0x01, 0x22, 0xa6, 0x3e, 0x2f, 0x27, 0x08, 0xf9, 0x82, 0x06, 0xe7, 0x1b, 0xd9, 0x19, 0xdb,
-	0xe4, 0x55, 0x79, 0xa1, 0x87, 0xd9, 0x39, 0x49, 0xe0, 0x16, 0xcb, 0x72, 0xc2, 0xf2, 0xb2, 0xc4,
-	0x61, 0x5e, 0x67, 0xf4, 0xef, 0x1b, 0x0c, 0xbf, 0x26, 0x69, 0xe9, 0xc8, 0xb6, 0x43, 0x69, 0x45,
-	0xec, 0x50, 0xe4, 0x4d, 0x41, 0xfe, 0x30, 0xb1, 0x5d, 0x2f, 0x25, 0xf3, 0x87, 0x4d, 0x48, 0xd2,
-	0xb1, 0x9c, 0x61, 0x1d, 0x38, 0xed, 0xf9, 0x86, 0x45, 0x6b, 0xa6, 0xbc, 0x1c, 0xf4, 0x7c, 0xa3,
-	0xd5, 0x2e, 0x90, 0xc0, 0x4b, 0x04, 0x6a, 0x48, 0xa3, 0xc2, 0x2c, 0xfc, 0xe4, 0x7b, 0x78, 0xf0,
-	0x3d, 0xc3, 0xe2, 0x97, 0xa7, 0x22, 0x89, 0x69, 0x44, 0xfc, 0xf0, 0xee, 0xee, 0x32, 0xb4, 0x5e,
-	0x36, 0x3e, 0xcc, 0xa5, 0x44, 0x5e, 0x82, 0xb3, 0xec, 0x00, 0x5c, 0xa8, 0xfb, 0xe6, 0xb6, 0xe9,
-	0xef, 0x46, 0x5d, 0x38, 0x7c, 0xd6, 0x2e, 0xae, 0x6d, 0xaf, 0x64, 0x21, 0xc3, 0x6c, 0x1a, 0xfa,
-	0xdf, 0x69, 0x40, 0xd2, 0x7b, 0x9d, 0x58, 0x30, 0xda, 0x08, 0x22, 0x27, 0x68, 0x47, 0x92, 0x55,
-	0x27, 0x3c, 0x42, 0xc2, 0x80, 0x0b, 0x21, 0x05, 0xe2, 0xc0, 0xd8, 0xbd, 0x2d, 0xd3, 0xa7, 0x96,
-	0xe9, 0xf9, 0x47, 0x94, 0xc4, 0x27, 0x8c, 0x09, 0xff, 0x74, 0x80, 0x18, 0x23, 0x1a, 0xfa, 0x27,
-	0x07, 0x61, 0x34, 0xcc, 0xc6, 0x79, 0xb0, 0x9b, 0x75, 0x07, 0x48, 0x5d, 0x09, 0x08, 0xd8, 0x8f,
-	0xcd, 0x94, 0xcb, 0x40, 0x95, 0x14, 0x32, 0xcc, 0x20, 0x40, 0x5e, 0x82, 0x33, 0xa6, 0xbd, 0xe9,
-	0x1a, 0x61, 0xb8, 0xc3, 0x4a, 0x60, 0x28, 0x2b, 0x40, 0x98, 0x6b, 0xd1, 0xd5, 0x0c, 0x74, 0x98,
-	0x49, 0x84, 0x50, 0x18, 0x11, 0x09, 0x91, 0x83, 0x5b, 0x97, 0x42, 0xb6, 0x7a, 0x21, 0x6b, 0x46,
-	0xec, 0x3d, 0x90, 0x3d, 0x03, 0xdc, 0x22, 0x38, 0xad, 0xf8, 0x3f, 0xb8, 0x90, 0x92, 0xfb, 0xbe,
-	0x52, 0x9c, 0x5e, 0x74, 0xb7, 0x25, 0x82, 0xd3, 0xc6, 0x0b, 0x31, 0x49, 0x50, 0xff, 0x7d, 0x0d,
-	0x86, 0x44, 0x0c, 0xb0, 0xe3, 0x17, 0x35, 0xdf, 0x1f, 0x13, 0x35, 0x9f, 0x28, 0x32, 0x48, 0xde,
-	0xd5, 0xdc, 0x94, 0xfe, 0xbf, 0xa7, 0xc1, 0x18, 0xaf, 0x71, 0x02, 0xb2, 0xdf, 0x73, 0x71, 0xd9,
-	0xef, 0xed, 0x85, 0x47, 0x93, 0x23, 0xf9, 0xfd, 0xfe, 0x80, 0x1c, 0x0b, 0x17, 0xad, 0xaa, 0x70,
-	0x5a, 0x3e, 0x8c, 0x5d, 0x31, 0x37, 0x29, 0xdb, 0xe2, 0x4b, 0xc6, 0xae, 0x27, 0x9f, 0x6a, 0x88,
-	0xa0, 0x33, 0x69, 0x30, 0x66, 0xb5, 0x21, 0xbf, 0xa6, 0x31, 0x21, 0xc6, 0x77, 0xcd, 0x7a, 0x5f,
-	0x97, 0xc1, 0x61, 0xdf, 0xe6, 0x57, 0x05, 0x32, 0xa1, 0x9b, 0xde, 0x8e, 0xa4, 0x19, 0x5e, 0x7a,
-	0x7f, 0xaf, 0x5c, 0xce, 0xb0, 0x19, 0x47, 0x69, 0xad, 0x3d, 0xff, 0x23, 0x7f, 0xd5, 0xb5, 0x0a,
-	0xd7, 0xc6, 0x82, 0x1e, 0x93, 0x1b, 0x30, 0xe4, 0xd5, 0x9d, 0x76, 0xf0, 0xb4, 0xfa, 0xc1, 0x2c,
-	0xdb, 0x60, 0xd2, 0x2c, 0x18, 0x4e, 0x70, 0x8d, 0xb5, 0x44, 0x81, 0x60, 0xee, 0x79, 0x98, 0x50,
-	0x7b, 0x9e, 0xa1, 0xfb, 0x2e, 0xc5, 0x3d, 0xe6, 0x0e, 0xe9, 0x1c, 0xa6, 0xea, 0xca, 0x7f, 0x32,
-	0x00, 0xc3, 0x48, 0x9b, 0x32, 0x65, 0xda, 0x01, 0x3a, 0xa7, 0x19, 0xe4, 0x0f, 0x2e, 0x15, 0x7f,
-	0x87, 0xa7, 0x26, 0xc5, 0x79, 0xd6, 0xb1, 0x95, 0x39, 0x50, 0x53, 0x08, 0x13, 0x3b, 0x4c, 0x24,
-	0x35, 0x50, 0xdc, 0xa1, 0x56, 0x0c, 0xac, 0x97, 0xd4, 0x51, 0xe4, 0x87, 0x34, 0x20, 0x46, 0xbd,
-	0x4e, 0x3d, 0x0f, 0xa9, 0xc7, 0xe6, 0x5e, 0x08, 0xab, 0x82, 0xcb, 0x16, 0x8b, 0x8a, 0x9d, 0xc4,
-	0x16, 0x89, 0x6d, 0x29, 0x90, 0x87, 0x19, 0xc4, 0xfb, 0x49, 0x67, 0xf5, 0x87, 0x1a, 0x4c, 0xc4,
-	0xb2, 0x85, 0xb5, 0x22, 0x5b, 0x7a, 0x71, 0x97, 0x9d, 0xe0, 0xf5, 0xd7, 0x85, 0x2e, 0x95, 0x84,
-	0x7d, 0xfe, 0x56, 0x98, 0xee, 0xe2, 0x68, 0x12, 0x8b, 0xe9, 0x9f, 0xd2, 0xe0, 0x5c, 0x30, 0xa0,
-	0x78, 0x5c, 0x73, 0xf2, 0x10, 0x8c, 0x1a, 0x6d, 0x93, 0x1b, 0x7a, 0x55, 0x53, 0xf9, 0xc2, 0x7a,
-	0x95, 0x97, 0x61, 0x08, 0x8d, 0x25, 0x69, 0x2e, 0x1d, 0x98, 0xa4, 0xf9, 0xb5, 0x4a, 0xda, 0xe9,
-	0xa1, 0x48, 0x76, 0x09, 0x09, 0x0b, 0x67, 0x4e, 0xfd, 0xad, 0x30, 0x56, 0xab, 0xdd, 0x10, 0x4b,
-	0x7a, 0x88, 0x1b, 0x1f, 0xfd, 0xe3, 0x03, 0x30, 0x29, 0x13, 0x34, 0x98, 0xdc, 0x04, 0x74, 0x02,
-	0xe7, 0xdc, 0x06, 0x8c, 0x79, 0xe1, 0x1d, 0x46, 0x29, 0x9f, 0x4f, 0x85, 0xd7, 0x12, 0xc9, 0x2c,
-	0x7b, 0xd1, 0xf5, 0x45, 0x84, 0x88, 0xdc, 0x84, 0xe1, 0x17, 0x18, 0xcf, 0x0d, 0xbe, 0xd5, 0x9e,
-	0x58, 0x5f, 0xf8, 0x21, 0x72, 0x76, 0xed, 0xa1, 0x44, 0x41, 0x3c, 0xfe, 0x3c, 0x91, 0x0b, 0x81,
-	0xfd, 0x84, 0xea, 0x8c, 0xcd, 0x6c, 0x98, 0x74, 0x7e, 0x42, 0xbe, 0x72, 0xe4, 0xbf, 0x30, 0x24,
-	0xc4, 0x53, 0x84, 0xc6, 0x5a, 0xbc, 0x42, 0x52, 0x84, 0xc6, 0xfa, 0x9c, 0x73, 0x5c, 0xbf, 0x1d,
-	0xce, 0x66, 0x4e, 0xc6, 0xc1, 0x22, 0xb6, 0xfe, 0xff, 0x95, 0x60, 0xb0, 0x46, 0x69, 0xe3, 0x04,
-	0x76, 0xe6, 0x73, 0x31, 0x09, 0xec, 0x5b, 0x0b, 0x27, 0x29, 0xcd, 0xb3, 0xf4, 0x6d, 0x26, 0x2c,
-	0x7d, 0xef, 0x2c, 0x4c, 0xa1, 0xbb, 0x99, 0xef, 0x6f, 0x4a, 0x30, 0xc2, 0xaa, 0x2d, 0xad, 0xd5,
-	0x48, 0x4b, 0xd9, 0xca, 0xa5, 0xe2, 0xe2, 0xb3, 0x44, 0x77, 0xd0, 0x26, 0x66, 0x5f, 0x8e, 0x29,
-	0x13, 0xbf, 0xc8, 0x41, 0x56, 0x8f, 0x80, 0x9c, 0x8c, 0x3d, 0xc1, 0x89, 0x06, 0x79, 0x65, 0x30,
-	0x24, 0x44, 0xee, 0x29, 0x3a, 0xe9, 0x60, 0x71, 0xdf, 0xdd, 0x6c, 0xa2, 0x5d, 0xd4, 0x53, 0xfd,
-	0xe3, 0x1a, 0x9c, 0x4a, 0xb4, 0xea, 0x41, 0x69, 0x3c, 0x16, 0x06, 0xa8, 0x7f, 0x5d, 0x63, 0xdf,
-	0x58, 0xc6, 0x08, 0x7a, 0xe8, 0x51, 0x94, 0x00, 0xac, 0xd4, 0x35, 0x01, 0xd8, 0x45, 0x18, 0x64,
-	0x92, 0x91, 0xea, 0xb3, 0xc3, 0x04, 0x26, 0xe4, 0xa5, 0xa4, 0x0e, 0x53, 0x75, 0x25, 0x07, 0x3c,
-	0xdd, 0x94, 0xbc, 0xb3, 0x27, 0x56, 0x1c, 0x5e, 0x37, 0x56, 0x62, 0x28, 0x30, 0x81, 0x52, 0xff,
-	0x5d, 0x0d, 0x46, 0xd9, 0x30, 0x4f, 0x80, 0x39, 0x7e, 0x7b, 0x9c, 0x39, 0xbe, 0xad, 0xe8, 0x9e,
-	0xca, 0xe1, 0x89, 0x7f, 0x5b, 0x02, 0x9e, 0xc1, 0x58, 0xfa, 0x17, 0x29, 0x9e, 0x43, 0x5a, 0x8e,
-	0xcf, 0xd3, 0x15, 0xe9, 0x78, 0x94, 0x30, 0x4a, 0x2b, 0xce, 0x47, 0xaf, 0x8f, 0xf9, 0x16, 0xc5,
-	0x84, 0x8b, 0x0c, 0xff, 0xa2, 0x17, 0x61, 0xd2, 0xdb, 0x72, 0x1c, 0x7f, 0x29, 0xfa, 0x78, 0x0a,
-	0x5f, 0x40, 0xf0, 0xc7, 0xe2, 0xc1, 0x50, 0xc4, 0x55, 0x6e, 0x4d, 0xc5, 0x8d, 0x71, 0x52, 0x64,
-	0x1e, 0xe0, 0x8e, 0xe5, 0xd4, 0xef, 0x0a, 0xd7, 0x26, 0xf1, 0x38, 0x98, 0x3b, 0x4f, 0x2c, 0x86,
-	0xa5, 0xa8, 0xd4, 0xe8, 0xcb, 0x8b, 0xeb, 0xeb, 0x9a, 0x98, 0xe9, 0x43, 0x7c, 0xa3, 0x27, 0xe8,
-	0x08, 0xf7, 0x3a, 0x18, 0x76, 0xb9, 0x8e, 0x20, 0x57, 0x2c, 0xfc, 0xf8, 0x84, 0xe6, 0x80, 0x12,
-	0xca, 0xb6, 0x87, 0x50, 0x7c, 0x06, 0xa3, 0x0b, 0x07, 0x55, 0x5d, 0xd1, 0x7f, 0x45, 0x0e, 0x33,
-	0x4c, 0x82, 0xdd, 0x86, 0x49, 0xae, 0x59, 0x24, 0xb2, 0x6f, 0xbf, 0xa9, 0xc7, 0x6f, 0x44, 0x6d,
-	0x1a, 0xb9, 0xe5, 0xc6, 0x8a, 0x31, 0x4e, 0x80, 0x3c, 0x06, 0x93, 0xc1, 0xe8, 0x84, 0x77, 0x6c,
-	0x29, 0x7a, 0xa1, 0xb9, 0xae, 0x02, 0x30, 0x5e, 0x4f, 0xff, 0x74, 0x09, 0x2e, 0x89, 0xbe, 0x73,
-	0xcb, 0xcb, 0x12, 0x6d, 0x53, 0xbb, 0x41, 0xed, 0xfa, 0x2e, 0x97, 0xb3, 0x1b, 0x4e, 0x93, 0xbc,
-	0x04, 0xc3, 0xf7, 0x28, 0x6d, 0x84, 0x57, 0x18, 0x4f, 0x17, 0xcf, 0x21, 0x9e, 0x43, 0xe2, 0x69,
-	0x8e, 0x5e, 0xdc, 0x06, 0x8b, 0xff, 0x51, 0x92, 0x64, 0xc4, 0xdb, 0xae, 0x73, 0x27, 0x14, 0x07,
-	0x8f, 0x9e, 0xf8, 0x3a, 0x47, 0x2f, 0x88, 0x8b, 0xff, 0x51, 0x92, 0xd4, 0xd7, 0xe1, 0xc1, 0x1e,
-	0x9a, 0x1e, 0x46, 0xec, 0x3f, 0x08, 0xa3, 0x18, 0xfd, 0x61, 0x30, 0xfe, 0x99, 0x06, 0xaf, 0x51,
-	0x50, 0x2e, 0xef, 0x30, 0x4d, 0xa4, 0x62, 0xb4, 0x8d, 0x3a, 0xd3, 0xf5, 0x79, 0x8c, 0xba, 0x43,
-	0x65, 0xed, 0xfd, 0xb8, 0x06, 0x23, 0xc2, 0x23, 0x2f, 0x60, 0xbf, 0xcf, 0xf5, 0x39, 0xe5, 0xb9,
-	0x5d, 0x0a, 0xb2, 0x99, 0x05, 0x63, 0x13, 0xbf, 0x3d, 0x0c, 0xe8, 0xeb, 0xff, 0x62, 0x08, 0xbe,
-	0xb9, 0x77, 0x44, 0xe4, 0xeb, 0x9a, 0x9a, 0x6d, 0x5c, 0xd8, 0xc8, 0x5b, 0xc7, 0xdb, 0xf9, 0xd0,
-	0x1a, 0x24, 0x0d, 0x0c, 0x4f, 0xa7, 0x12, 0x92, 0x1f, 0x91, 0xa1, 0x29, 0x1a, 0x18, 0xf9, 0xbf,
-	0x35, 0x98, 0x60, 0xc7, 0x52, 0xc8, 0x5c, 0xc4, 0x32, 0xb5, 0x8f, 0x79, 0xa4, 0x6b, 0x0a, 0xc9,
-	0x44, 0x5c, 0x2b, 0x15, 0x84, 0xb1, 0xbe, 0x91, 0xdb, 0xf1, 0xeb, 0x3f, 0xa1, 0x22, 0x5e, 0xce,
-	0x92, 0x4b, 0x0e, 0x93, 0xee, 0x7f, 0xce, 0x82, 0xa9, 0xf8, 0xcc, 0x1f, 0xa7, 0x99, 0x6c, 0xee,
-	0x49, 0x98, 0x49, 0x8d, 0xfe, 0x50, 0x06, 0x99, 0x2f, 0x0f, 0x43, 0x59, 0x99, 0xea, 0xac, 0xf0,
-	0x34, 0xe4, 0xb3, 0x1a, 0x8c, 0x1b, 0xb6, 0x2d, 0x1d, 0x9b, 0x82, 0xfd, 0xdb, 0xe8, 0x73, 0x55,
-	0xb3, 0x48, 0xcd, 0x2f, 0x44, 0x64, 0x12, 0x9e, 0x3b, 0x0a, 0x04, 0xd5, 0xde, 0x74, 0xf1, 0xce,
-	0x2d, 0x9d, 0x98, 0x77, 0x2e, 0xf9, 0x60, 0x70, 0x10, 0x0f, 0x14, 0x0f, 0x54, 0x71, 0xc0, 0xdc,
-	0xf0, 0x73, 0x3d, 0xc7, 0x2a, 0xf9, 0x03, 0x1a, 0x3f, 0x64, 0xa3, 0x28, 0x42, 0xf2, 0x4c, 0x2a,
-	0xe4, 0x64, 0x79, 0x60, 0x88, 0xa2, 0xf0, 0xec, 0x8e, 0x8a, 0x30, 0x4e, 0x9e, 0xfc, 0x98, 0x06,
-	0x13, 0x2f, 0x3a, 0xb6, 0x61, 0x49, 0x3f, 0x6b, 0x79, 0x4d, 0xf3, 0xfe, 0xe3, 0x99, 0x97, 0x90,
-	0x8c, 0xf0, 0xad, 0x54, 0x4b, 0x30, 0xd6, 0x0d, 0x9e, 0x31, 0x9f, 0xbf, 0xad, 0x1e, 0x8e, 0xe4,
-	0x69, 0xf5, 0x5d, 0xf5, 0xdc, 0x3b, 0x61, 0x3a, 0xb9, 0x07, 0x0f, 0xf5, 0x3d, 0x6d, 0xc0, 0x23,
-	0x87, 0xe8, 0x6f, 0x8f, 0x47, 0x9f, 0xfe, 0x5b, 0x83, 0xb1, 0xa3, 0x34, 0x77, 0x7b, 0xf4, 0x60,
-	0x2b, 0xff, 0x5c, 0xe2, 0x63, 0x16, 0x2c, 0xda, 0x3c, 0xae, 0x0d, 0x7b, 0xb4, 0x5f, 0xf4, 0xc0,
-	0xc9, 0x7d, 0xd1, 0x2f, 0xb7, 0x4f, 0xaa, 0xef, 0x9d, 0xb9, 0x28, 0x6c, 0x01, 0x72, 0xc1, 0xa2,
-	0x64, 0x4d, 0x3c, 0x50, 0xa7, 0xe9, 0x99, 0x41, 0xb8, 0x69, 0x45, 0xa4, 0x7b, 0x4a, 0x14, 0x63,
-	0x00, 0xd7, 0x57, 0x62, 0x87, 0xc5, 0x86, 0xd3, 0x76, 0x2c, 0xa7, 0xb9, 0xbb, 0x70, 0xcf, 0x70,
-	0x29, 0x3a, 0x1d, 0x5f, 0x62, 0xeb, 0x55, 0x40, 0xfc, 0xf0, 0x10, 0x5c, 0x51, 0xd0, 0x65, 0x46,
-	0xe5, 0x3c, 0xcc, 0x5b, 0x85, 0xcf, 0x25, 0x83, 0x67, 0x96, 0x8a, 0x3b, 0x99, 0x1e, 0xd4, 0xaf,
-	0x62, 0xc1, 0x34, 0xc9, 0x9e, 0x06, 0xd0, 0x32, 0x76, 0x64, 0x24, 0x37, 0x79, 0x5a, 0x34, 0x8e,
-	0xa5, 0x83, 0xab, 0x21, 0x19, 0xd1, 0xbd, 0x67, 0x02, 0x3b, 0x67, 0x04, 0x38, 0x22, 0x09, 0x50,
-	0x19, 0x51, 0xdf, 0xd1, 0x42, 0xe7, 0x5a, 0x70, 0x2a, 0xd1, 0xf3, 0x63, 0xbd, 0x67, 0xfc, 0xf2,
-	0x48, 0xa0, 0x20, 0xcb, 0x10, 0x73, 0xbf, 0xac, 0xc1, 0x03, 0x34, 0x4f, 0xe0, 0x94, 0xda, 0xf2,
-	0x33, 0xc7, 0x25, 0xd0, 0xca, 0x84, 0x5b, 0x79, 0x60, 0xcc, 0xef, 0x19, 0xd9, 0x05, 0xf0, 0xc2,
-	0x6f, 0xba, 0x9f, 0xb8, 0x15, 0x99, 0x4c, 0x42, 0xd8, 0x61, 0xa2, 0xdf, 0xa8, 0x10, 0x23, 0x3f,
-	0xad, 0xc1, 0x19, 0x2b, 0x83, 0xc3, 0x49, 0x8e, 0x59, 0x3b, 0x86, 0xb3, 0x45, 0x78, 0xa8, 0x64,
-	0x41, 0x30, 0xb3, 0x2b, 0xe4, 0x67, 0x73, 0x43, 0x0c, 0x0b, 0xc9, 0x64, 0xe3, 0x38, 0xbe, 0xc1,
-	0x02, 0xd1, 0x86, 0x3f, 0xad, 0x01, 0x69, 0xa4, 0x94, 0x6f, 0xe9, 0x9c, 0xf8, 0x9e, 0x23, 0x37,
-	0x31, 0x08, 0x17, 0xa3, 0x74, 0x39, 0x66, 0x74, 0x82, 0xaf, 0xb3, 0x9f, 0xc1, 0xf3, 0xe5, 0x13,
-	0xe0, 0x7e, 0xd7, 0x39, 0xeb, 0x38, 0x11, 0xeb, 0x9c, 0x05, 0xc1, 0xcc, 0xae, 0xe8, 0xbf, 0x33,
-	0x26, 0x6c, 0xc1, 0xdc, 0x07, 0xe4, 0x39, 0x18, 0xbe, 0x63, 0xd4, 0xef, 0xca, 0xfb, 0xd7, 0x82,
-	0x5e, 0x49, 0x8b, 0x1c, 0x83, 0xb0, 0xc2, 0x88, 0xff, 0x51, 0x62, 0x25, 0xcf, 0xc2, 0x40, 0xc3,
-	0x0e, 0x5e, 0xd8, 0xbf, 0xa3, 0x8f, 0xfb, 0x85, 0x28, 0x4e, 0xc9, 0xd2, 0x5a, 0x0d, 0x19, 0x52,
-	0x62, 0xc3, 0xa8, 0x2d, 0x4d, 0xa7, 0xd2, 0xba, 0xf5, 0xae, 0xa2, 0x04, 0x42, 0x13, 0x6c, 0x68,
-	0xf8, 0x0d, 0x4a, 0x30, 0xa4, 0xc1, 0xe8, 0x25, 0xee, 0x37, 0x0b, 0xd3, 0x0b, 0x6f, 0x84, 0xd4,
-	0x00, 0xae, 0xc9, 0x5b, 0x21, 0x0a, 0xc3, 0xbe, 0x61, 0xda, 0x7e, 0xf0, 0x5a, 0xfe, 0x89, 0xa2,
-	0xd4, 0x36, 0x18, 0x96, 0xc8, 0x42, 0xca, 0x7f, 0x7a, 0x28, 0x91, 0x93, 0x3b, 0x30, 0x2c, 0x5e,
-	0xcc, 0xcb, 0x4f, 0xa8, 0xf0, 0xfd, 0x9a, 0x78, 0x84, 0x2f, 0xb6, 0x81, 0xf8, 0x1f, 0x25, 0x66,
-	0xf2, 0x3c, 0x8c, 0x7a, 0x81, 0x3b, 0xda, 0x68, 0x7f, 0x53, 0x17, 0xfa, 0xa2, 0xc9, 0xf7, 0xbf,
-	0xd2, 0x09, 0x2d, 0xc4, 0x4f, 0xee, 0xc0, 0x88, 0x29, 0x55, 0xaa, 0xb1, 0xe2, 0xdb, 0x2e, 0x50,
-	0x97, 0xb8, 0xb6, 0x11, 0x68, 0x4a, 0x01, 0xe2, 0x3c, 0x9f, 0x13, 0xf8, 0x06, 0xfa, 0x9c, 0x90,
-	0x17, 0x00, 0x68, 0x60, 0x42, 0xf7, 0x66, 0xc7, 0x8b, 0x6f, 0x19, 0xc5, 0x10, 0x1f, 0x08, 0x44,
-	0x61, 0x91, 0x87, 0x0a, 0x11, 0xf2, 0x92, 0x6a, 0xb3, 0x9b, 0xe8, 0x2f, 0x08, 0x48, 0x3a, 0xac,
-	0x4d, 0x74, 0x75, 0x17, 0x9a, 0x8b, 0x14, 0x53, 0x9a, 0xfe, 0x65, 0x00, 0x88, 0xae, 0x75, 0xc9,
-	0x26, 0x8c, 0x06, 0x54, 0xfa, 0x09, 0xc3, 0x73, 0x5d, 0x82, 0xc5, 0xf6, 0x0a, 0x7e, 0x61, 0x88,
-	0x9b, 0x54, 0xb2, 0xc2, 0x41, 0x45, 0x19, 0x72, 0x7b, 0x0b, 0x05, 0xf5, 0x02, 0x40, 0x3d, 0x0a,
-	0xf2, 0x3a, 0x50, 0x7c, 0xad, 0xc2, 0x00, 0xb0, 0xd1, 0x5a, 0x29, 0x31, 0x62, 0x15, 0x22, 0x39,
-	0x5e, 0xe9, 0x83, 0x85, 0xbc, 0xd2, 0x9f, 0x80, 0x53, 0xd2, 0x0b, 0xb0, 0xca, 0x6f, 0x19, 0xfd,
-	0x5d, 0xf9, 0x70, 0x93, 0xfb, 0x87, 0x56, 0xe2, 0x20, 0x4c, 0xd6, 0x25, 0xbf, 0xa9, 0xc1, 0x68,
-	0x5d, 0x0a, 0x68, 0x92, 0xb7, 0xad, 0xf4, 0x77, 0xa9, 0x3f, 0x1f, 0xc8, 0x7b, 0x42, 0x90, 0x7f,
-	0x2a, 0xe0, 0xaa, 0x41, 0xf1, 0x11, 0x89, 0xf1, 0x61, 0xaf, 0xc9, 0x1f, 0x68, 0x30, 0x6e, 0x58,
-	0x96, 0x53, 0x37, 0x7c, 0x1e, 0x1e, 0x50, 0xbc, 0x28, 0xbd, 0xd5, 0xe7, 0x28, 0x16, 0x22, 0x8c,
-	0x62, 0x20, 0xef, 0x0d, 0x2d, 0x01, 0x11, 0xe4, 0x88, 0xc6, 0xa2, 0x76, 0x9f, 0xfc, 0x9f, 0x1a,
-	0xbc, 0x46, 0x3c, 0xe3, 0xad, 0x30, 0x99, 0x6b, 0xd3, 0xac, 0x1b, 0x3e, 0x15, 0x31, 0x4b, 0x83,
-	0x27, 0x64, 0xc2, 0x87, 0x7e, 0xf4, 0xd0, 0x3e, 0xf4, 0x0f, 0xed, 0xef, 0x95, 0x5f, 0x53, 0xe9,
-	0x01, 0x37, 0xf6, 0xd4, 0x03, 0xf2, 0x22, 0x4c, 0x5a, 0x6a, 0xfc, 0x71, 0xc9, 0xe4, 0x0b, 0x5d,
-	0xbf, 0xc6, 0x02, 0x99, 0x0b, 0x03, 0x43, 0xac, 0x08, 0xe3, 0xa4, 0xe6, 0xee, 0xc2, 0x64, 0x6c,
-	0xa3, 0x1d, 0xab, 0xe1, 0xda, 0x86, 0xe9, 0xe4, 0x7e, 0x38, 0x56, 0x3d, 0xef, 0x26, 0x8c, 0x85,
-	0xc2, 0x02, 0xb9, 0xa4, 0x10, 0x8a, 0x44, 0xaf, 0x9b, 0x74, 0x57, 0x50, 0x2d, 0xc7, 0x6c, 0x28,
-	0xc2, 0x48, 0xc8, 0xa3, 0x37, 0x4a, 0x84, 0xfa, 0x1f, 0xc9, 0x5b, 0xd5, 0x0d, 0xda, 0x6a, 0x5b,
-	0x86, 0x4f, 0x5f, 0xf9, 0x7e, 0x48, 0xfa, 0xbf, 0xd3, 0xc4, 0x79, 0x23, 0x44, 0x1b, 0x62, 0xc0,
-	0x78, 0x4b, 0x24, 0xbf, 0xe3, 0xb1, 0x1e, 0xb5, 0xe2, 0x51, 0x26, 0x57, 0x23, 0x34, 0xa8, 0xe2,
-	0x24, 0xf7, 0x60, 0x2c, 0x10, 0x06, 0x03, 0x4b, 0xcd, 0xb5, 0xfe, 0x84, 0xb3, 0x50, 0xee, 0x0c,
-	0x8f, 0xd6, 0xa0, 0xc4, 0xc3, 0x88, 0x96, 0x6e, 0x00, 0x49, 0xb7, 0x21, 0x0f, 0x47, 0xef, 0xd9,
-	0xb4, 0x78, 0xba, 0x9a, 0xd4, 0x9b, 0xb6, 0xc0, 0xc8, 0x5a, 0xca, 0x33, 0xb2, 0xea, 0xbf, 0x5d,
-	0x82, 0x33, 0x52, 0xf5, 0x5c, 0xa8, 0xd7, 0x9d, 0x8e, 0xed, 0x47, 0xcf, 0x8c, 0xc5, 0xdb, 0x7d,
-	0x49, 0x84, 0x8b, 0x93, 0xe2, 0x61, 0x3f, 0x4a, 0x08, 0xb9, 0x25, 0xac, 0x9f, 0x76, 0x63, 0xc3,
-	0xb9, 0x4b, 0xed, 0x88, 0x4b, 0xa8, 0x41, 0x32, 0x96, 0xb3, 0x2a, 0x60, 0x76, 0x3b, 0xb2, 0x0d,
-	0xa4, 0x65, 0xec, 0x24, 0xb1, 0x15, 0x4b, 0x82, 0xc6, 0xf5, 0xc5, 0xd5, 0x14, 0x36, 0xcc, 0xa0,
-	0xc0, 0x0e, 0x52, 0x26, 0xc9, 0xb5, 0x7d, 0xda, 0x10, 0x43, 0x0c, 0x9c, 0x3a, 0xf8, 0x41, 0xba,
-	0x10, 0x07, 0x61, 0xb2, 0xae, 0xfe, 0xd1, 0x61, 0x78, 0x20, 0x3e, 0x89, 0xec, 0x0b, 0x0d, 0x9e,
-	0xd7, 0x3f, 0x19, 0xbc, 0x1d, 0x13, 0x13, 0xf9, 0x70, 0xf2, 0xed, 0xd8, 0xac, 0xea, 0x34, 0x24,
-	0x1b, 0xc5, 0xde, 0x91, 0x7d, 0x03, 0xde, 0xca, 0xe7, 0x3c, 0x8e, 0x1e, 0x38, 0xd6, 0x98, 0x00,
-	0x9f, 0xd0, 0x60, 0x2e, 0x5e, 0x7c, 0xcd, 0xb4, 0x4d, 0x6f, 0x4b, 0xe6, 0x3d, 0x39, 0xfc, 0xd3,
-	0x35, 0x9e, 0x39, 0x79, 0x25, 0x17, 0x23, 0x76, 0xa1, 0x46, 0xbe, 0x5f, 0x83, 0x0b, 0x89, 0x79,
-	0x89, 0x65, 0x61, 0x39, 0xfc, 0x2b, 0x36, 0x1e, 0xdc, 0x65, 0x25, 0x1f, 0x25, 0x76, 0xa3, 0xd7,
-	0x2d, 0xe2, 0xe7, 0xf0, 0xcb, 0x25, 0xe2, 0xe7, 0x2f, 0x95, 0x60, 0x88, 0xfb, 0x4d, 0xbd, 0x32,
-	0x1e, 0x1c, 0xf1, 0xae, 0xe6, 0xfa, 0xbb, 0x36, 0x13, 0xfe, 0xae, 0x4f, 0x16, 0x27, 0xd1, 0xdd,
-	0xe1, 0xf5, 0xbd, 0x70, 0x8e, 0x57, 0x5b, 0x68, 0x70, 0x43, 0x9b, 0x47, 0x1b, 0x0b, 0x8d, 0x06,
-	0x57, 0x6f, 0x0f, 0xbe, 0x23, 0xbb, 0x04, 0x03, 0x1d, 0xd7, 0x4a, 0x86, 0x90, 0xbd, 0x8d, 0x2b,
-	0xc8, 0xca, 0xf5, 0x1f, 0x29, 0xc1, 0x34, 0xc7, 0xad, 0xb0, 0x18, 0xb2, 0x0d, 0xa3, 0xae, 0x64,
-	0x33, 0x72, 0x6d, 0x56, 0x0a, 0x0f, 0x2d, 0x83, 0x75, 0x09, 0x8d, 0x2d, 0xf8, 0x85, 0x21, 0x2d,
-	0xf2, 0xbd, 0x1a, 0x4c, 0x53, 0xbb, 0xee, 0xee, 0xf2, 0x47, 0xdd, 0x0b, 0x3e, 0xd3, 0x9a, 0xfb,
-	0x09, 0x58, 0xbd, 0x9c, 0xc0, 0x25, 0x73, 0x2b, 0x26, 0x4a, 0x31, 0x45, 0x53, 0xff, 0xf3, 0x21,
-	0x98, 0xcd, 0xeb, 0x3d, 0xf9, 0x61, 0x0d, 0xce, 0xd5, 0x23, 0xd1, 0x77, 0xa1, 0xe3, 0x6f, 0x39,
-	0x6e, 0x90, 0xda, 0xa1, 0xb0, 0x5d, 0xa6, 0xb2, 0x10, 0x4e, 0x0f, 0xcf, 0x67, 0x52, 0xc9, 0xa4,
-	0x80, 0x39, 0x94, 0xc9, 0x4b, 0x00, 0x9e, 0xb7, 0x75, 0x93, 0xee, 0xb6, 0x0d, 0x33, 0x70, 0x21,
-	0xbb, 0x59, 0x7c, 0x3f, 0xd6, 0x6e, 0x48, 0x54, 0x61, 0xa7, 0x84, 0xd1, 0x3c, 0x2a, 0x57, 0xc8,
-	0x91, 0x8f, 0x68, 0x30, 0xe9, 0xa8, 0x81, 0x63, 0xfa, 0x79, 0x55, 0x90, 0x19, 0x81, 0x46, 0x88,
-	0xfc, 0x71, 0x50, 0x9c, 0x24, 0x5b, 0x96, 0x19, 0x2f, 0x79, 0xc4, 0x4a, 0x26, 0xbc, 0x5a, 0x4c,
-	0x18, 0xcb, 0x39, 0xaf, 0x85, 0xf9, 0x20, 0x0d, 0x4e, 0x93, 0xe7, 0x9d, 0xa2, 0x7e, 0xbd, 0x11,
-	0xed, 0x39, 0xd6, 0xa9, 0xe1, 0xe2, 0x9d, 0x5a, 0xde, 0xa8, 0x2c, 0xc5, 0x90, 0xc5, 0x3b, 0x95,
-	0x06, 0xa7, 0xc9, 0xeb, 0x1f, 0x2e, 0xc1, 0x79, 0xbe, 0xcc, 0x37, 0x3b, 0x77, 0xa8, 0xf0, 0x02,
-	0xfb, 0x27, 0x17, 0xe9, 0xe7, 0xf7, 0x34, 0x18, 0xe3, 0x73, 0xf0, 0x0a, 0x79, 0x2c, 0xca, 0xfb,
-	0x9a, 0xe3, 0x69, 0xfd, 0xbb, 0x1a, 0xcc, 0xa4, 0x92, 0x59, 0xf5, 0xf4, 0xd4, 0xf0, 0xc4, 0x9c,
-	0x80, 0x5f, 0x1b, 0x25, 0xd0, 0x1c, 0x88, 0x22, 0x6c, 0x24, 0x93, 0x67, 0xea, 0x4f, 0xc3, 0x64,
-	0xcc, 0xd1, 0x5a, 0x89, 0x45, 0x99, 0x15, 0x45, 0x53, 0x0d, 0x35, 0x59, 0xea, 0x16, 0x24, 0x33,
-	0xda, 0xf2, 0x69, 0xce, 0xf6, 0x4f, 0x67, 0xcb, 0x13, 0xb9, 0xe5, 0x83, 0xfb, 0x24, 0x1e, 0xe1,
-	0xd2, 0xeb, 0xe7, 0x3e, 0x89, 0x87, 0xca, 0x94, 0x11, 0x69, 0xc5, 0xff, 0x28, 0xb1, 0x92, 0x77,
-	0xc5, 0xe3, 0xcd, 0xae, 0x45, 0x4a, 0xe6, 0x99, 0x64, 0x94, 0x58, 0xbe, 0x25, 0x53, 0xb5, 0x09,
-	0x8a, 0x1b, 0x29, 0x71, 0x96, 0x15, 0x4a, 0xb3, 0xb3, 0xb4, 0x56, 0x13, 0x81, 0x08, 0xc3, 0x9b,
-	0xa8, 0xb8, 0xe9, 0x7d, 0xf0, 0x24, 0x4c, 0xef, 0x2e, 0x8c, 0x6f, 0x99, 0x77, 0xa8, 0x6b, 0x0b,
-	0x79, 0x6a, 0xa8, 0xb8, 0xa8, 0x78, 0x23, 0x42, 0x23, 0xec, 0x11, 0x4a, 0x01, 0xaa, 0x44, 0x88,
-	0x1b, 0x8b, 0x9a, 0x3d, 0x5c, 0x5c, 0x2a, 0x89, 0x6c, 0xe4, 0xd1, 0x38, 0x73, 0x22, 0x66, 0xdb,
-	0x00, 0x76, 0x18, 0x4a, 0xb6, 0x9f, 0x1b, 0xaa, 0x28, 0x20, 0xad, 0x10, 0x3a, 0xa2, 0xdf, 0xa8,
-	0x50, 0x60, 0xf3, 0xda, 0x8a, 0x32, 0x2b, 0x48, 0x7b, 0xe7, 0x93, 0x7d, 0x66, 0xb7, 0x90, 0x76,
-	0x9e, 0xa8, 0x00, 0x55, 0x22, 0x6c, 0x8c, 0xad, 0x30, 0x9f, 0x80, 0xb4, 0x67, 0x16, 0x1a, 0x63,
-	0x94, 0x95, 0x40, 0x8c, 0x31, 0xfa, 0x8d, 0x0a, 0x05, 0xf2, 0xbc, 0x72, 0x91, 0x09, 0xc5, 0xad,
-	0x65, 0x3d, 0x5d, 0x62, 0xbe, 0x25, 0x32, 0x1a, 0x8d, 0xf3, 0xef, 0xf4, 0x82, 0x62, 0x30, 0xe2,
-	0x79, 0x16, 0x18, 0xef, 0x48, 0x19, 0x90, 0xa2, 0xe7, 0x1d, 0x13, 0x5d, 0x9f, 0x77, 0x54, 0x98,
-	0x74, 0xa6, 0x3c, 0x91, 0xe4, 0x0c, 0x61, 0x32, 0xba, 0x8d, 0xa9, 0x25, 0x81, 0x98, 0xae, 0x2f,
-	0x18, 0x3e, 0x6d, 0xf0, 0xb6, 0x53, 0x2a, 0xc3, 0x17, 0x65, 0x18, 0x42, 0xc9, 0x36, 0x4c, 0x78,
-	0xca, 0x5b, 0x91, 0xd9, 0x53, 0xfd, 0xde, 0x65, 0xca, 0x77, 0x22, 0xdc, 0x29, 0x53, 0x2d, 0xc1,
-	0x18, 0x9d, 0xf8, 0x45, 0xdb, 0xf4, 0xc9, 0x5e, 0xb4, 0x91, 0x4e, 0xdc, 0x0d, 0x7c, 0xe6, 0x48,
-	0x02, 0xca, 0x1c, 0xe8, 0x26, 0xce, 0x96, 0x96, 0xee, 0xb4, 0x1d, 0xaf, 0xe3, 0x52, 0xee, 0x7f,
-	0xca, 0x97, 0x87, 0x44, 0x4b, 0xbb, 0x9c, 0x04, 0x62, 0xba, 0x3e, 0xd7, 0xfd, 0xbc, 0x5d, 0xcf,
-	0xa7, 0x2d, 0x76, 0x6c, 0x39, 0x36, 0xb5, 0x7d, 0x6f, 0xf6, 0x74, 0x71, 0xdd, 0xaf, 0x96, 0xc0,
-	0x25, 0x8e, 0x9d, 0x64, 0x29, 0xa6, 0x68, 0xb2, 0x9d, 0xa3, 0x86, 0xa4, 0x99, 0x3d, 0x53, 0x7c,
-	0xe7, 0xa8, 0xe1, 0x6e, 0xc4, 0xce, 0x51, 0x4b, 0x30, 0x46, 0x87, 0x3c, 0x06, 0x93, 0x5e, 0x90,
-	0x4c, 0x9d, 0xcf, 0xe0, 0xd9, 0x28, 0x6a, 0x68, 0x4d, 0x05, 0x60, 0xbc, 0x1e, 0xf9, 0x10, 0x4c,
-	0xa8, 0x67, 0xe7, 0xec, 0xb9, 0xa3, 0x8e, 0x9f, 0x2f, 0x7a, 0xae, 0x82, 0x62, 0x04, 0x09, 0xc2,
-	0x39, 0xe5, 0x15, 0xa3, 0xfa, 0x7d, 0x9f, 0xe7, 0x43, 0x10, 0xfa, 0x6c, 0x66, 0x0d, 0xcc, 0x69,
-	0x49, 0x7e, 0x22, 0xfb, 0xde, 0x7e, 0x96, 0x6f, 0xe9, 0xf5, 0x23, 0xb9, 0xb7, 0x7f, 0xda, 0xf4,
-	0xb7, 0x6e, 0xb5, 0x45, 0x88, 0xb3, 0x43, 0x5e, 0xe1, 0xeb, 0x7f, 0xa2, 0x01, 0x84, 0x96, 0x9b,
-	0x93, 0xb8, 0x33, 0x69, 0xc4, 0x8c, 0x59, 0x8b, 0x7d, 0x59, 0x9a, 0x72, 0xd3, 0xa3, 0xe8, 0x5f,
-	0xd1, 0x60, 0x2a, 0xaa, 0x76, 0x02, 0xaa, 0x51, 0x3d, 0xae, 0x1a, 0xbd, 0xb3, 0xbf, 0x71, 0xe5,
-	0xe8, 0x47, 0xff, 0xbd, 0xa4, 0x8e, 0x8a, 0x4b, 0xbf, 0xdb, 0x31, 0x1f, 0x04, 0x46, 0xfa, 0x46,
-	0x3f, 0x3e, 0x08, 0x6a, 0x18, 0x90, 0x68, 0xbc, 0x19, 0x3e, 0x09, 0xdf, 0x19, 0x93, 0x3f, 0xfb,
-	0x08, 0xc0, 0x13, 0x0a, 0x9b, 0x01, 0x69, 0x31, 0x01, 0x07, 0x09, 0xa3, 0x2f, 0xa8, 0xc7, 0x53,
-	0x1f, 0x29, 0x4d, 0x62, 0x03, 0xee, 0xee, 0xfd, 0xf1, 0x4b, 0x33, 0x30, 0xae, 0x18, 0x39, 0x13,
-	0x1e, 0x15, 0xda, 0x49, 0x78, 0x54, 0xf8, 0x30, 0x5e, 0x0f, 0x73, 0x5a, 0x06, 0xd3, 0xde, 0x27,
-	0xcd, 0xf0, 0x58, 0x8c, 0xb2, 0x65, 0x7a, 0xa8, 0x92, 0x61, 0xc2, 0x5b, 0xb8, 0xc7, 0x06, 0x8e,
-	0xc0, 0xcf, 0xa5, 0xdb, 0xbe, 0x7a, 0x33, 0x40, 0x20, 0xff, 0xd3, 0x86, 0x0c, 0x15, 0x1f, 0xfa,
-	0x70, 0x57, 0xbd, 0x1b, 0x21, 0x0c, 0x95, 0x7a, 0xe9, 0x1b, 0xfa, 0xa1, 0x13, 0xbb, 0xa1, 0x67,
-	0xdb, 0xc0, 0x0a, 0xb2, 0xff, 0xf7, 0xe5, 0x37, 0xb7, 0x12, 0x60, 0x89, 0xb6, 0x41, 0x58, 0xe4,
-	0xa1, 0x42, 0x24, 0xc7, 0xb1, 0x66, 0xa4, 0x90, 0x63, 0x4d, 0x07, 0x4e, 0xbb, 0xd4, 0x77, 0x77,
-	0x2b, 0xbb, 0x75, 0x9e, 0x63, 0xc5, 0xf5, 0xb9, 0x06, 0x3f, 0x5a, 0x2c, 0x72, 0x23, 0xa6, 0x51,
-	0x61, 0x16, 0xfe, 0x98, 0x00, 0x3c, 0xd6, 0x55, 0x00, 0x7e, 0x0b, 0x8c, 0xfb, 0xb4, 0xbe, 0x65,
-	0x9b, 0x75, 0xc3, 0xaa, 0x2e, 0xc9, 0x40, 0xe2, 0x91, 0x2c, 0x17, 0x81, 0x50, 0xad, 0x47, 0x16,
-	0x61, 0xa0, 0x63, 0x36, 0xa4, 0x06, 0xf0, 0x2d, 0xe1, 0x75, 0x41, 0x75, 0xe9, 0xfe, 0x5e, 0xf9,
-	0xd5, 0x91, 0xa7, 0x4a, 0x38, 0xaa, 0xab, 0xed, 0xbb, 0xcd, 0xab, 0xfe, 0x6e, 0x9b, 0x7a, 0xf3,
-	0xb7, 0xab, 0x4b, 0xc8, 0x1a, 0x67, 0x39, 0x1d, 0x4d, 0x1c, 0xc2, 0xe9, 0xe8, 0xd3, 0x1a, 0x9c,
-	0x36, 0x92, 0x37, 0x1d, 0xd4, 0x9b, 0x9d, 0x2c, 0xce, 0x2d, 0xb3, 0x6f, 0x4f, 0x16, 0x2f, 0xc8,
-	0xf1, 0x9d, 0x5e, 0x48, 0x93, 0xc3, 0xac, 0x3e, 0x10, 0x17, 0x48, 0xcb, 0x6c, 0x86, 0x89, 0xf8,
-	0xe5, 0xaa, 0x4f, 0x15, 0xb3, 0xdb, 0xac, 0xa6, 0x30, 0x61, 0x06, 0x76, 0x72, 0x0f, 0xc6, 0x15,
-	0x21, 0x49, 0x6a, 0x32, 0x4b, 0x47, 0x71, 0x21, 0x23, 0xb4, 0x5d, 0xf5, 0x8e, 0x43, 0xa5, 0x14,
-	0xde, 0xb6, 0x2a, 0x66, 0x06, 0x79, 0xe3, 0xc8, 0x47, 0x3d, 0x5d, 0xfc, 0xb6, 0x35, 0x1b, 0x23,
-	0x76, 0xa1, 0xc6, 0xe3, 0x25, 0x32, 0xb0, 0xa2, 0x9b, 0xcf, 0xce, 0x14, 0x0f, 0xf8, 0xb2, 0x12,
-	0x47, 0x25, 0xb6, 0x66, 0xa2, 0x10, 0x93, 0x04, 0xc9, 0x35, 0x20, 0xf2, 0xae, 0x28, 0x52, 0xce,
-	0xbc, 0x59, 0xc2, 0x1d, 0x01, 0xf8, 0x92, 0x2e, 0xa7, 0xa0, 0x98, 0xd1, 0x82, 0xf8, 0x31, 0x5b,
-	0x49, 0x1f, 0x5a, 0x4e, 0x32, 0x79, 0x4f, 0x57, 0x8b, 0xc9, 0xf7, 0x68, 0xa9, 0x6c, 0xce, 0x42,
-	0xb9, 0xb9, 0xd1, 0x7f, 0x36, 0x67, 0x49, 0xbe, 0x97, 0x9c, 0xce, 0x9f, 0xd7, 0xe0, 0x7c, 0x2b,
-	0x3b, 0x6b, 0x25, 0xd7, 0x79, 0x0a, 0x5e, 0x5c, 0xe5, 0x24, 0xc2, 0xe4, 0x66, 0x8c, 0xbc, 0x2c,
-	0x99, 0x98, 0xd7, 0x11, 0xfd, 0x8f, 0x35, 0x69, 0x8a, 0x3e, 0x41, 0xbf, 0xa8, 0xe3, 0xbe, 0xb0,
-	0xd6, 0x9f, 0x86, 0xd9, 0x5a, 0x10, 0xed, 0xb4, 0x91, 0x48, 0x6a, 0xf0, 0x0e, 0x98, 0x14, 0x57,
-	0x41, 0xab, 0x46, 0x7b, 0x2d, 0xba, 0x37, 0x08, 0xe3, 0x62, 0x54, 0x54, 0x20, 0xc6, 0xeb, 0xea,
-	0x5f, 0xd3, 0xe0, 0x7c, 0x1c, 0xb3, 0xe3, 0x9a, 0x2f, 0xf6, 0x8f, 0x98, 0x7c, 0x4c, 0x83, 0xf1,
-	0xbb, 0xe1, 0x2d, 0x55, 0x20, 0xba, 0x15, 0x7a, 0x3b, 0x12, 0xf4, 0x8a, 0xba, 0xca, 0xb5, 0x57,
-	0x3a, 0x53, 0x66, 0x04, 0xf4, 0x50, 0x25, 0xad, 0xff, 0x27, 0x0d, 0x52, 0xe6, 0x03, 0x72, 0x07,
-	0x46, 0x18, 0x91, 0xa5, 0xb5, 0x9a, 0xdc, 0x13, 0xef, 0x28, 0x26, 0x55, 0x72, 0x14, 0xe2, 0x52,
-	0x44, 0xfe, 0xc0, 0x00, 0x31, 0xd9, 0x16, 0x81, 0x08, 0x82, 0x8c, 0x47, 0x72, 0x7b, 0x14, 0x12,
-	0xdb, 0xd5, 0xcc, 0x49, 0x42, 0xad, 0x57, 0x4b, 0x30, 0x46, 0x47, 0x5f, 0x01, 0x88, 0x4c, 0x3e,
-	0x7d, 0xfb, 0x19, 0xfe, 0xe6, 0x19, 0x38, 0xdb, 0xf7, 0xab, 0xc8, 0x8f, 0x68, 0x70, 0x8e, 0x6e,
-	0x9b, 0x75, 0x7f, 0x61, 0xd3, 0xa7, 0xee, 0xad, 0x5b, 0xab, 0x1b, 0x5b, 0x2e, 0xf5, 0xb6, 0x1c,
-	0xab, 0xd1, 0x8b, 0x57, 0x65, 0x86, 0x0b, 0x18, 0x37, 0x4d, 0x2c, 0x67, 0x62, 0xc4, 0x1c, 0x4a,
-	0xdc, 0xdc, 0xb5, 0x2d, 0x0c, 0x01, 0xc8, 0x74, 0xae, 0x8e, 0xeb, 0xf9, 0x32, 0x80, 0xa1, 0x30,
-	0x77, 0x25, 0x81, 0x98, 0xae, 0x9f, 0x44, 0xc2, 0xd3, 0xf9, 0x71, 0xb9, 0x5d, 0x4b, 0x23, 0xe1,
-	0x40, 0x4c, 0xd7, 0x57, 0x91, 0x88, 0x95, 0x62, 0x87, 0xe2, 0x50, 0x1a, 0x49, 0x08, 0xc4, 0x74,
-	0x7d, 0xd2, 0x80, 0x8b, 0x2e, 0xad, 0x3b, 0xad, 0x16, 0xb5, 0x1b, 0x7c, 0x52, 0x56, 0x0d, 0xb7,
-	0x69, 0xda, 0xd7, 0x5c, 0x83, 0x57, 0xe4, 0xb7, 0x07, 0x1a, 0xcf, 0xbc, 0x7b, 0x11, 0xbb, 0xd4,
-	0xc3, 0xae, 0x58, 0x48, 0x0b, 0x4e, 0x75, 0x38, 0xff, 0x77, 0x79, 0x80, 0xb3, 0x6d, 0xc3, 0x92,
-	0x57, 0x04, 0x87, 0x5d, 0x31, 0x7e, 0x50, 0xdf, 0x8e, 0xa3, 0xc2, 0x24, 0x6e, 0xb2, 0xcb, 0xc4,
-	0x73, 0xd9, 0x1d, 0x85, 0xe4, 0x68, 0x21, 0x92, 0x52, 0x44, 0x4f, 0xa1, 0xc3, 0x2c, 0x1a, 0xa4,
-	0x0a, 0xa7, 0x7d, 0xc3, 0x6d, 0x52, 0xbf, 0xb2, 0x7e, 0x7b, 0x9d, 0xba, 0x75, 0xc6, 0x63, 0x2d,
-	0x21, 0xad, 0x6b, 0x02, 0xd5, 0x46, 0x1a, 0x8c, 0x59, 0x6d, 0xc8, 0x87, 0xe0, 0xb5, 0xf1, 0x49,
-	0x5d, 0x71, 0xee, 0x51, 0x77, 0xd1, 0xe9, 0xd8, 0x8d, 0x38, 0x72, 0xe0, 0xc8, 0x1f, 0xde, 0xdf,
-	0x2b, 0xbf, 0x16, 0x7b, 0x69, 0x80, 0xbd, 0xe1, 0x4d, 0x77, 0xe0, 0x76, 0xbb, 0x9d, 0xd9, 0x81,
-	0xf1, 0xbc, 0x0e, 0xe4, 0x34, 0xc0, 0xde, 0xf0, 0x12, 0x84, 0x73, 0x62, 0x62, 0x44, 0x9e, 0x68,
-	0x85, 0xe2, 0x04, 0xa7, 0xc8, 0xbf, 0xdf, 0x8d, 0xcc, 0x1a, 0x98, 0xd3, 0x92, 0x9d, 0x29, 0x0f,
-	0xe5, 0x0d, 0x3f, 0x45, 0x66, 0x92, 0x93, 0x79, 0xfd, 0xfe, 0x5e, 0xf9, 0x21, 0xec, 0xb1, 0x0d,
-	0xf6, 0x8c, 0x3d, 0xa3, 0x2b, 0xd1, 0x44, 0xa4, 0xba, 0x32, 0x95, 0xd7, 0x95, 0xfc, 0x36, 0xd8,
-	0x33, 0x76, 0xf2, 0x7d, 0x1a, 0x3c, 0x50, 0x6f, 0x77, 0x6e, 0x98, 0x9e, 0xef, 0x34, 0x5d, 0xa3,
-	0xb5, 0x44, 0xeb, 0xc6, 0xee, 0x0d, 0xc3, 0xda, 0x5c, 0x31, 0x37, 0xa9, 0x54, 0x3a, 0x0e, 0xfb,
-	0xe1, 0xf0, 0x17, 0xc0, 0x95, 0xf5, 0xdb, 0xd9, 0x48, 0x31, 0x9f, 0x1e, 0xf9, 0x51, 0x0d, 0x2e,
-	0xb6, 0x78, 0x17, 0x73, 0x3a, 0x34, 0x5d, 0xa8, 0x43, 0x9c, 0x8b, 0xad, 0x76, 0xc1, 0x8b, 0x5d,
-	0xa9, 0xf2, 0x49, 0x12, 0x15, 0x16, 0x9a, 0x4d, 0x97, 0x36, 0x39, 0xd6, 0x90, 0xbb, 0xcc, 0x14,
-	0x9f, 0xa4, 0xd5, 0x3c, 0xa4, 0x98, 0x4f, 0x8f, 0x3c, 0x0f, 0x97, 0x73, 0x81, 0x15, 0xa7, 0x63,
-	0xfb, 0xfc, 0x12, 0x66, 0x60, 0x51, 0xdf, 0xdf, 0x2b, 0x5f, 0x5e, 0xed, 0x5a, 0x13, 0x0f, 0xc0,
-	0xc4, 0x83, 0xa0, 0xc4, 0xe2, 0x11, 0x9c, 0xe6, 0x92, 0xd8, 0xfb, 0x0a, 0xe5, 0x05, 0x3e, 0xc2,
-	0x20, 0x04, 0x5f, 0x89, 0x07, 0x21, 0x38, 0xc3, 0x7b, 0xf5, 0xde, 0xa3, 0xeb, 0xd5, 0x37, 0x24,
-	0xf2, 0x40, 0xec, 0x4c, 0x0e, 0x52, 0x6b, 0x09, 0x1d, 0x45, 0xac, 0xeb, 0x59, 0xbe, 0xae, 0xf1,
-	0x33, 0x39, 0xa3, 0x1e, 0x76, 0xc5, 0xf2, 0x8a, 0x8b, 0x6f, 0xf0, 0x69, 0x0d, 0xe4, 0x73, 0x55,
-	0x72, 0x31, 0xe6, 0xdc, 0x34, 0x9a, 0x70, 0x6c, 0x0a, 0x92, 0x00, 0x97, 0x32, 0x93, 0x00, 0xbf,
-	0x4e, 0x89, 0x39, 0x3d, 0x16, 0xa9, 0x64, 0x02, 0x73, 0x14, 0x74, 0x9a, 0x3c, 0x02, 0x63, 0xa1,
-	0xea, 0x2e, 0x4d, 0xaa, 0x3c, 0x01, 0x4f, 0xa4, 0xe3, 0x47, 0x70, 0xfd, 0x0f, 0x35, 0x80, 0x28,
-	0xb7, 0x35, 0x79, 0x30, 0x88, 0xcd, 0x23, 0x3a, 0x18, 0xde, 0x4b, 0xa8, 0xf1, 0x79, 0x0e, 0x7e,
-	0x7b, 0x41, 0x74, 0x18, 0xee, 0xf0, 0x6c, 0x9e, 0xf2, 0xbd, 0x04, 0x77, 0xb4, 0xb9, 0xcd, 0x4b,
-	0x50, 0x42, 0xc8, 0x6d, 0x18, 0x69, 0x99, 0x36, 0x7f, 0xda, 0x32, 0x58, 0xe8, 0x69, 0x0b, 0xd7,
-	0x3a, 0x56, 0x05, 0x0a, 0x0c, 0x70, 0xe9, 0xbf, 0xac, 0xc1, 0xa9, 0x78, 0x10, 0x70, 0x1e, 0xe1,
-	0x47, 0xc6, 0x86, 0x95, 0xb9, 0x07, 0x78, 0x53, 0x19, 0xf3, 0x12, 0x03, 0x58, 0xfc, 0x0e, 0xbc,
-	0x8f, 0x3b, 0x8e, 0xec, 0x58, 0xe4, 0x07, 0x5c, 0x37, 0xfc, 0xf8, 0x69, 0x18, 0x16, 0x9b, 0x9c,
-	0x69, 0x0b, 0x19, 0xb1, 0xbe, 0x6e, 0x16, 0x4f, 0xaf, 0x51, 0x24, 0x00, 0x90, 0x9a, 0x64, 0xb4,
-	0xd4, 0x35, 0xc9, 0x28, 0xc2, 0x40, 0xdd, 0x35, 0xfb, 0xf1, 0x77, 0xaa, 0x60, 0x55, 0xf8, 0x3b,
-	0x55, 0xb0, 0x8a, 0x0c, 0x19, 0xf1, 0x63, 0x8e, 0x40, 0x83, 0xc5, 0x0d, 0x4d, 0x62, 0x02, 0x14,
-	0x77, 0xa0, 0xa9, 0xae, 0xae, 0x40, 0x41, 0x62, 0x81, 0xa1, 0xe2, 0x6f, 0xa1, 0xe4, 0x94, 0xf7,
-	0x92, 0x58, 0x20, 0xf8, 0x90, 0x86, 0x73, 0x3f, 0xa4, 0x4d, 0x18, 0x91, 0x9f, 0x82, 0x54, 0x3b,
-	0xde, 0xd1, 0x47, 0x2a, 0x7d, 0x25, 0x69, 0x97, 0x28, 0xc0, 0x00, 0x39, 0xd3, 0x65, 0x5b, 0xc6,
-	0x8e, 0xd9, 0xea, 0xb4, 0xb8, 0xae, 0x31, 0xa4, 0x56, 0xe5, 0xc5, 0x18, 0xc0, 0x79, 0x55, 0xf1,
-	0x84, 0x8c, 0xeb, 0x06, 0x6a, 0x55, 0x51, 0x8c, 0x01, 0x9c, 0x3c, 0x0b, 0xa3, 0x2d, 0x63, 0xa7,
-	0xd6, 0x71, 0x9b, 0x54, 0xba, 0x01, 0xe5, 0x9b, 0x9e, 0x3a, 0xbe, 0x69, 0xcd, 0x9b, 0xb6, 0xef,
-	0xf9, 0xee, 0x7c, 0xd5, 0xf6, 0x6f, 0xb9, 0x35, 0x9f, 0xbb, 0x19, 0xf1, 0x5d, 0xb7, 0x2a, 0xb1,
-	0x60, 0x88, 0x8f, 0x58, 0x30, 0xd5, 0x32, 0x76, 0x6e, 0xdb, 0x86, 0xc8, 0x19, 0x21, 0x65, 0xf9,
-	0x22, 0x14, 0xb8, 0xed, 0x6f, 0x35, 0x86, 0x0b, 0x13, 0xb8, 0x33, 0x5c, 0x4e, 0x27, 0x8e, 0xcb,
-	0xe5, 0x74, 0x21, 0x0c, 0xca, 0x20, 0x2e, 0x0e, 0x1e, 0xc8, 0x0c, 0x87, 0xd8, 0x35, 0xe0, 0xc2,
-	0x73, 0x61, 0xc0, 0x85, 0xa9, 0xe2, 0x3e, 0x92, 0x5d, 0x82, 0x2d, 0x74, 0x60, 0xbc, 0x61, 0xf8,
-	0x86, 0x28, 0xf5, 0x66, 0x4f, 0x15, 0xbf, 0x03, 0x5f, 0x0a, 0xd1, 0x44, 0x2c, 0x29, 0x2a, 0xf3,
-	0x50, 0xa5, 0x43, 0x6e, 0xc1, 0x59, 0xf6, 0xb1, 0x5a, 0xd4, 0x8f, 0xaa, 0x70, 0xcb, 0xdc, 0x34,
-	0xff, 0x7e, 0xf8, 0xa3, 0xbc, 0x9b, 0x59, 0x15, 0x30, 0xbb, 0x5d, 0x14, 0xba, 0x77, 0x26, 0x3b,
-	0x74, 0x2f, 0xf9, 0x64, 0x96, 0x73, 0x0f, 0xe1, 0x73, 0xfa, 0xee, 0xe2, 0xbc, 0xa1, 0xb0, 0x8b,
-	0xcf, 0xff, 0xaf, 0xc1, 0xac, 0xdc, 0x65, 0xd2, 0x21, 0xc7, 0xa2, 0xee, 0xaa, 0x61, 0x1b, 0x4d,
-	0xea, 0x4a, 0x6b, 0xfc, 0x46, 0x1f, 0xfc, 0x21, 0x85, 0x33, 0x8c, 0x84, 0xf1, 0x9a, 0xfd, 0xbd,
-	0xf2, 0x95, 0x83, 0x6a, 0x61, 0x6e, 0xdf, 0x88, 0x0b, 0x23, 0xde, 0xae, 0x57, 0xf7, 0x2d, 0x4f,
-	0x4a, 0xba, 0xd7, 0xfb, 0xe0, 0xac, 0x35, 0x81, 0x49, 0xb0, 0xd6, 0x28, 0x55, 0xa4, 0x28, 0xc5,
-	0x80, 0x10, 0xf9, 0x21, 0x0d, 0x66, 0xe4, 0x15, 0x9d, 0x12, 0x69, 0xe8, 0x6c, 0xf1, 0x67, 0x41,
-	0x95, 0x24, 0xb2, 0xc0, 0x09, 0x87, 0xdb, 0xac, 0x52, 0x50, 0x4c, 0x53, 0x67, 0x87, 0x6a, 0xdb,
-	0x35, 0x1d, 0xd7, 0xf4, 0x77, 0xb9, 0xbb, 0xd3, 0x50, 0x10, 0xb0, 0x5f, 0x94, 0x61, 0x08, 0x25,
-	0x35, 0x98, 0x12, 0xb6, 0xa1, 0x9a, 0xef, 0x1a, 0x3e, 0x6d, 0xee, 0x4a, 0x9f, 0xa4, 0x47, 0x78,
-	0x4a, 0xe5, 0x18, 0xe4, 0xfe, 0x5e, 0xf9, 0xac, 0x5c, 0x9b, 0x38, 0x00, 0x13, 0x28, 0xc8, 0x07,
-	0x12, 0x2e, 0x62, 0xb3, 0xc5, 0xd3, 0x2d, 0x06, 0xf2, 0x78, 0xef, 0x8e, 0x62, 0xfd, 0x06, 0xcf,
-	0xeb, 0x23, 0xe5, 0xcd, 0xdc, 0xe3, 0x30, 0xa1, 0xee, 0x9a, 0x43, 0xc5, 0xec, 0xf3, 0x81, 0xa4,
-	0x07, 0x7b, 0xdc, 0x61, 0x8d, 0xf4, 0x9f, 0xd1, 0x60, 0x3a, 0x29, 0xbb, 0x90, 0x2d, 0x18, 0x91,
-	0x8c, 0x4c, 0x52, 0x5d, 0x28, 0xea, 0x1b, 0x6d, 0x51, 0xf9, 0x1a, 0x5a, 0x88, 0xc2, 0xb2, 0x08,
-	0x03, 0xf4, 0xea, 0xbb, 0x87, 0x52, 0x97, 0x77, 0x0f, 0x4f, 0xc0, 0xb9, 0x6c, 0x96, 0xc6, 0x14,
-	0x09, 0x83, 0xe9, 0x57, 0xd2, 0x34, 0x1e, 0x2a, 0x12, 0x5c, 0xe9, 0x42, 0x01, 0xd3, 0x3f, 0x08,
-	0xc9, 0x54, 0x6f, 0xe4, 0x79, 0x18, 0xf3, 0xbc, 0x2d, 0xe1, 0xcd, 0x26, 0x07, 0x59, 0xec, 0x42,
-	0x29, 0x48, 0xbb, 0x23, 0x74, 0x9f, 0xf0, 0x27, 0x46, 0xe8, 0x17, 0x9f, 0xf9, 0xd2, 0xd7, 0x2e,
-	0xbf, 0xea, 0x8f, 0xbe, 0x76, 0xf9, 0x55, 0x5f, 0xfd, 0xda, 0xe5, 0x57, 0x7d, 0xd7, 0xfe, 0x65,
-	0xed, 0x4b, 0xfb, 0x97, 0xb5, 0x3f, 0xda, 0xbf, 0xac, 0x7d, 0x75, 0xff, 0xb2, 0xf6, 0xd7, 0xfb,
-	0x97, 0xb5, 0x1f, 0xfc, 0x37, 0x97, 0x5f, 0xf5, 0xec, 0xa3, 0x11, 0xf5, 0xab, 0x01, 0xd1, 0xe8,
-	0x9f, 0xf6, 0xdd, 0x26, 0x53, 0x8d, 0xbd, 0x40, 0x37, 0xe6, 0xd4, 0xff, 0x67, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xd3, 0xf6, 0x77, 0x69, 0xfd, 0x28, 0x01, 0x00,
+	// 15335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7b, 0x70, 0x24, 0xc9,
+	0x59, 0x20, 0xee, 0x6a, 0xbd, 0x3f, 0x3d, 0x66, 0x94, 0xf3, 0xea, 0xd5, 0xcc, 0x8e, 0xc6, 0xb5,
+	0x6b, 0x7e, 0xbb, 0xd8, 0xd6, 0xb0, 0xeb, 0xe7, 0xae, 0x59, 0xaf, 0xa5, 0x96, 0x34, 0x23, 0x8f,
+	0x34, 0x23, 0x7f, 0x2d, 0xed, 0xac, 0x6d, 0x58, 0x53, 0xd3, 0x9d, 0x6a, 0x95, 0xa7, 0xbb, 0xaa,
+	0xb7, 0xaa, 0x7a, 0x46, 0x5a, 0xbf, 0xb0, 0x79, 0xfc, 0x6c, 0x83, 0xf9, 0xf1, 0xf6, 0xcf, 0x60,
+	0x02, 0x03, 0x01, 0xdc, 0xc1, 0x1d, 0xdc, 0x41, 0x70, 0x17, 0x40, 0x1c, 0x01, 0x44, 0x70, 0x36,
+	0x71, 0xf8, 0x8e, 0xc0, 0xc7, 0x9d, 0x09, 0x40, 0x60, 0x9d, 0x0f, 0x2e, 0xe2, 0x5e, 0xc4, 0x11,
+	0x71, 0x17, 0x31, 0x5c, 0x70, 0x17, 0xf9, 0xa8, 0xaa, 0xcc, 0x7a, 0xb4, 0x5a, 0xd5, 0x92, 0xbc,
+	0x1b, 0xf0, 0x97, 0xd4, 0xf9, 0x65, 0x7e, 0x5f, 0x66, 0x56, 0x3e, 0xbe, 0xfc, 0x9e, 0xb0, 0xd0,
+	0xb0, 0x83, 0xed, 0xce, 0x9d, 0xb9, 0x9a, 0xdb, 0xba, 0xda, 0xb0, 0xbc, 0x3a, 0x75, 0xa8, 0x17,
+	0xff, 0xd3, 0xbe, 0xdb, 0xb8, 0x6a, 0xb5, 0x6d, 0xff, 0x6a, 0xcd, 0xf5, 0xe8, 0xd5, 0x7b, 0x4f,
+	0xdc, 0xa1, 0x81, 0xf5, 0xc4, 0xd5, 0x06, 0x83, 0x59, 0x01, 0xad, 0xcf, 0xb5, 0x3d, 0x37, 0x70,
+	0xc9, 0x93, 0x31, 0x8e, 0xb9, 0xb0, 0x69, 0xfc, 0x4f, 0xfb, 0x6e, 0x63, 0x8e, 0xe1, 0x98, 0x63,
+	0x38, 0xe6, 0x24, 0x8e, 0x99, 0xd7, 0xab, 0x74, 0xdd, 0x86, 0x7b, 0x95, 0xa3, 0xba, 0xd3, 0xd9,
+	0xe2, 0xbf, 0xf8, 0x0f, 0xfe, 0x9f, 0x20, 0x31, 0xf3, 0xf8, 0xdd, 0xb7, 0xfa, 0x73, 0xb6, 0xcb,
+	0x3a, 0x73, 0xd5, 0xea, 0x04, 0xae, 0x5f, 0xb3, 0x9a, 0xb6, 0xd3, 0xb8, 0x7a, 0x2f, 0xd5, 0x9b,
+	0x19, 0x53, 0xa9, 0x2a, 0xbb, 0xdd, 0xb5, 0x8e, 0x77, 0xc7, 0xaa, 0x65, 0xd5, 0xb9, 0x1e, 0xd7,
+	0xa1, 0x3b, 0x01, 0x75, 0x7c, 0xdb, 0x75, 0xfc, 0xd7, 0xb3, 0x91, 0x50, 0xef, 0x9e, 0x3a, 0x37,
+	0x5a, 0x85, 0x2c, 0x4c, 0x6f, 0x8c, 0x31, 0xb5, 0xac, 0xda, 0xb6, 0xed, 0x50, 0x6f, 0x37, 0x6c,
+	0x7e, 0xd5, 0xa3, 0xbe, 0xdb, 0xf1, 0x6a, 0xf4, 0x50, 0xad, 0xfc, 0xab, 0x2d, 0x1a, 0x58, 0x59,
+	0xb4, 0xae, 0xe6, 0xb5, 0xf2, 0x3a, 0x4e, 0x60, 0xb7, 0xd2, 0x64, 0xde, 0x7c, 0x50, 0x03, 0xbf,
+	0xb6, 0x4d, 0x5b, 0x56, 0xaa, 0xdd, 0x1b, 0xf2, 0xda, 0x75, 0x02, 0xbb, 0x79, 0xd5, 0x76, 0x02,
+	0x3f, 0xf0, 0x92, 0x8d, 0xcc, 0x4f, 0x1a, 0x70, 0x7a, 0x7e, 0x7d, 0xa5, 0xca, 0x67, 0x70, 0xd5,
+	0x6d, 0x34, 0x6c, 0xa7, 0x41, 0x5e, 0x0b, 0x63, 0xf7, 0xa8, 0x77, 0xc7, 0xf5, 0xed, 0x60, 0xb7,
+	0x6c, 0x5c, 0x31, 0x1e, 0x1b, 0x5a, 0x98, 0xdc, 0xdf, 0x9b, 0x1d, 0x7b, 0x2e, 0x2c, 0xc4, 0x18,
+	0x4e, 0x56, 0xe0, 0xcc, 0x76, 0x10, 0xb4, 0xe7, 0x6b, 0x35, 0xea, 0xfb, 0x51, 0x8d, 0x72, 0x89,
+	0x37, 0xbb, 0xb0, 0xbf, 0x37, 0x7b, 0xe6, 0xfa, 0xc6, 0xc6, 0x7a, 0x02, 0x8c, 0x59, 0x6d, 0xcc,
+	0x5f, 0x32, 0x60, 0x3a, 0xea, 0x0c, 0xd2, 0x17, 0x3b, 0xd4, 0x0f, 0x7c, 0x82, 0x70, 0xbe, 0x65,
+	0xed, 0xdc, 0x74, 0x9d, 0xb5, 0x4e, 0x60, 0x05, 0xb6, 0xd3, 0x58, 0x71, 0xb6, 0x9a, 0x76, 0x63,
+	0x3b, 0x90, 0x5d, 0x9b, 0xd9, 0xdf, 0x9b, 0x3d, 0xbf, 0x96, 0x59, 0x03, 0x73, 0x5a, 0xb2, 0x4e,
+	0xb7, 0xac, 0x9d, 0x14, 0x42, 0xa5, 0xd3, 0x6b, 0x69, 0x30, 0x66, 0xb5, 0x31, 0xff, 0x55, 0x09,
+	0x26, 0xc5, 0x40, 0x64, 0x8f, 0xc9, 0xb7, 0xc0, 0x28, 0x5b, 0x0c, 0x75, 0x2b, 0xb0, 0x78, 0x17,
+	0xc7, 0x9f, 0xfc, 0x86, 0x39, 0xf1, 0x6d, 0xe6, 0xd4, 0x6f, 0x13, 0xef, 0x43, 0x56, 0x7b, 0xee,
+	0xde, 0x13, 0x73, 0xb7, 0xee, 0xbc, 0x9f, 0xd6, 0x82, 0x35, 0x1a, 0x58, 0x0b, 0xe4, 0x0b, 0x7b,
+	0xb3, 0xaf, 0xda, 0xdf, 0x9b, 0x85, 0xb8, 0x0c, 0x23, 0xac, 0xa4, 0x01, 0x83, 0x7e, 0x9b, 0xd6,
+	0x78, 0x7f, 0xc7, 0x9f, 0x5c, 0x9a, 0x3b, 0xfc, 0x76, 0x9f, 0xd3, 0xba, 0x5c, 0x6d, 0xd3, 0xda,
+	0xc2, 0x84, 0x24, 0x39, 0xc8, 0x7e, 0x21, 0x27, 0x40, 0x5c, 0x18, 0xf6, 0x03, 0x2b, 0xe8, 0xf8,
+	0xe5, 0x01, 0x4e, 0xea, 0x5a, 0xff, 0xa4, 0x38, 0xba, 0x85, 0x29, 0x49, 0x6c, 0x58, 0xfc, 0x46,
+	0x49, 0xc6, 0xfc, 0x23, 0xb6, 0x04, 0xd4, 0xfa, 0xab, 0xb6, 0x1f, 0x90, 0x6f, 0x4a, 0xcd, 0xe8,
+	0x5c, 0x6f, 0x33, 0xca, 0x5a, 0xf3, 0xf9, 0x3c, 0x2d, 0xe9, 0x8d, 0x86, 0x25, 0xca, 0x6c, 0x6e,
+	0xc1, 0x90, 0x1d, 0xd0, 0x96, 0x5f, 0x2e, 0x5d, 0x19, 0x78, 0x6c, 0xfc, 0xc9, 0xf9, 0xbe, 0xc7,
+	0xb8, 0x30, 0x29, 0xa9, 0x0d, 0xad, 0x30, 0xbc, 0x28, 0xd0, 0x9b, 0x1f, 0x2b, 0x25, 0xc6, 0xc6,
+	0x26, 0x9a, 0x2c, 0xc3, 0x88, 0xdf, 0xe1, 0x1f, 0x59, 0x0e, 0xed, 0xa2, 0x32, 0xb4, 0x39, 0x76,
+	0x16, 0xb2, 0x81, 0x54, 0x45, 0x95, 0x85, 0x53, 0x12, 0xf3, 0x88, 0x2c, 0xc0, 0xb0, 0x31, 0x99,
+	0x85, 0x21, 0xcf, 0x6d, 0x52, 0x31, 0x8a, 0xb1, 0x85, 0x31, 0x46, 0x1e, 0x59, 0x01, 0x8a, 0x72,
+	0x36, 0x89, 0xf5, 0x8e, 0x67, 0x05, 0xb6, 0xeb, 0x94, 0x07, 0x0e, 0x33, 0x89, 0x8b, 0xb2, 0x55,
+	0x3c, 0x89, 0x61, 0x09, 0x46, 0x18, 0xc9, 0xd7, 0xc1, 0xb0, 0x47, 0x2d, 0xdf, 0x75, 0xca, 0x83,
+	0x57, 0x8c, 0xc7, 0xc6, 0xe2, 0x0f, 0x8c, 0xbc, 0x14, 0x25, 0xd4, 0xfc, 0xbc, 0x01, 0x67, 0x32,
+	0x16, 0x04, 0x79, 0x0a, 0x86, 0xda, 0xdb, 0x96, 0x4f, 0xf9, 0x24, 0x8c, 0x2d, 0x3c, 0x12, 0xce,
+	0xe0, 0x3a, 0x2b, 0x7c, 0xb0, 0x37, 0x4b, 0xb4, 0x46, 0xbc, 0x14, 0x45, 0x0b, 0xd2, 0x81, 0x33,
+	0x74, 0xa7, 0x6d, 0x8b, 0x8e, 0x6c, 0xd8, 0x2d, 0xea, 0x07, 0x56, 0xab, 0x2d, 0x37, 0xc7, 0xd7,
+	0xf7, 0x36, 0x46, 0xd6, 0x4c, 0x6c, 0xfc, 0xa5, 0x34, 0x2a, 0xcc, 0xc2, 0x6f, 0xbe, 0x29, 0xfe,
+	0x9a, 0x7e, 0xe0, 0xd9, 0x35, 0x3e, 0x0d, 0x57, 0x60, 0xd0, 0xb1, 0x5a, 0xe1, 0x28, 0xa2, 0x2d,
+	0x75, 0xd3, 0x6a, 0x51, 0xe4, 0x10, 0xf3, 0x7f, 0x96, 0xe0, 0x52, 0xaa, 0xdd, 0x6d, 0x3b, 0xd8,
+	0xbe, 0xd5, 0x66, 0xff, 0xf9, 0xe4, 0x7b, 0x0c, 0x98, 0xb6, 0x92, 0x15, 0xca, 0x46, 0xff, 0x5b,
+	0x3d, 0x42, 0xb6, 0xf0, 0x90, 0xec, 0x57, 0x7a, 0x00, 0x98, 0x26, 0x4d, 0x3e, 0x6e, 0xc0, 0x88,
+	0x2b, 0x3a, 0x27, 0xb7, 0xc8, 0x37, 0x1f, 0x49, 0x37, 0x94, 0x41, 0xcf, 0xc9, 0xbf, 0x4b, 0x4e,
+	0xe0, 0xed, 0xc6, 0x8b, 0x5c, 0x96, 0x62, 0x48, 0x7e, 0xe6, 0x69, 0x98, 0x50, 0x6b, 0x92, 0xd3,
+	0x30, 0x70, 0x97, 0x8a, 0x3b, 0x6a, 0x0c, 0xd9, 0xbf, 0xe4, 0x2c, 0x0c, 0xdd, 0xb3, 0x9a, 0x1d,
+	0xca, 0x3f, 0xff, 0x18, 0x8a, 0x1f, 0x4f, 0x97, 0xde, 0x6a, 0x98, 0x4f, 0xc2, 0xd0, 0x7c, 0xbd,
+	0xee, 0x3a, 0xe4, 0x71, 0x18, 0xa1, 0x8e, 0x75, 0xa7, 0x49, 0xeb, 0xbc, 0xe1, 0x68, 0x4c, 0x6f,
+	0x49, 0x14, 0x63, 0x08, 0x37, 0x7f, 0xa8, 0x04, 0xc3, 0xbc, 0x91, 0x4f, 0xbe, 0xdf, 0x80, 0x33,
+	0x77, 0x3b, 0x77, 0xa8, 0xe7, 0xd0, 0x80, 0xfa, 0x8b, 0x96, 0xbf, 0x7d, 0xc7, 0xb5, 0xbc, 0x7a,
+	0xd9, 0x28, 0x7e, 0x30, 0xde, 0x48, 0xa3, 0x13, 0x6b, 0x30, 0x03, 0x80, 0x59, 0xc4, 0xc9, 0x3d,
+	0x98, 0x70, 0x1a, 0xb6, 0xb3, 0xb3, 0xe2, 0x34, 0x3c, 0xea, 0xfb, 0x72, 0xcd, 0xbf, 0xa3, 0x48,
+	0x67, 0x6e, 0x2a, 0x78, 0x16, 0x4e, 0xef, 0xef, 0xcd, 0x4e, 0xa8, 0x25, 0xa8, 0xd1, 0x31, 0xff,
+	0xd6, 0x80, 0x53, 0xf3, 0xf5, 0x96, 0xed, 0x33, 0x16, 0x6b, 0xbd, 0xd9, 0x69, 0xd8, 0x3d, 0x2c,
+	0x7d, 0xf2, 0x2e, 0x18, 0xae, 0xb9, 0xce, 0x96, 0xdd, 0x90, 0xfd, 0x7c, 0x7d, 0xee, 0xde, 0x94,
+	0xac, 0xce, 0x1c, 0x5a, 0xf7, 0x97, 0x42, 0x4e, 0x6e, 0x01, 0xd8, 0x71, 0x52, 0xe1, 0x08, 0x50,
+	0x22, 0x22, 0x8f, 0xc1, 0x68, 0xdd, 0xf6, 0xc5, 0xc7, 0x1c, 0xe0, 0x1f, 0x73, 0x82, 0x1f, 0x50,
+	0xb2, 0x0c, 0x23, 0x28, 0x59, 0x85, 0xb3, 0x6c, 0x06, 0x45, 0xbb, 0x2a, 0xad, 0x79, 0x34, 0x60,
+	0x5d, 0x93, 0xc7, 0x55, 0x79, 0x7f, 0x6f, 0xf6, 0xec, 0x8d, 0x0c, 0x38, 0x66, 0xb6, 0x32, 0x97,
+	0x61, 0x74, 0xbe, 0x49, 0x3d, 0xc6, 0x09, 0x90, 0xa7, 0x61, 0x8a, 0xb6, 0x2c, 0xbb, 0x89, 0xb4,
+	0x46, 0xed, 0x7b, 0xd4, 0xf3, 0xcb, 0x06, 0x3f, 0x82, 0xc9, 0xfe, 0xde, 0xec, 0xd4, 0x92, 0x06,
+	0xc1, 0x44, 0x4d, 0xf3, 0xa3, 0x06, 0x8c, 0xcf, 0x77, 0xea, 0x76, 0x20, 0xc6, 0x45, 0x3c, 0x18,
+	0xb7, 0xd8, 0xcf, 0x75, 0xb7, 0x69, 0xd7, 0x76, 0xe5, 0xe2, 0x7a, 0xb6, 0xd0, 0x76, 0x8b, 0xd1,
+	0x2c, 0x9c, 0xda, 0xdf, 0x9b, 0x1d, 0x57, 0x0a, 0x50, 0x25, 0x62, 0x6e, 0x83, 0x0a, 0x23, 0xef,
+	0x86, 0x09, 0x31, 0xdc, 0x35, 0xab, 0x8d, 0x74, 0x4b, 0xf6, 0xe1, 0x11, 0xf5, 0x56, 0x92, 0x84,
+	0x24, 0xc3, 0x82, 0x74, 0x8b, 0x7a, 0xd4, 0xa9, 0x51, 0xb1, 0x6c, 0x2a, 0x4a, 0x63, 0xd4, 0x50,
+	0x99, 0x3f, 0x60, 0xc0, 0xc3, 0xf3, 0x9d, 0x60, 0xdb, 0xf5, 0xec, 0x97, 0xa8, 0x17, 0x4f, 0x77,
+	0x84, 0x81, 0xbc, 0x1d, 0xa6, 0xac, 0xa8, 0xc2, 0xcd, 0x78, 0x39, 0x9d, 0x97, 0xcb, 0x69, 0x6a,
+	0x5e, 0x83, 0x62, 0xa2, 0x36, 0x79, 0x12, 0xc0, 0x8f, 0xbf, 0x2d, 0x3f, 0x03, 0x62, 0x5e, 0x4a,
+	0xf9, 0xaa, 0x4a, 0x2d, 0xf3, 0xcf, 0x18, 0x0f, 0x7c, 0xcf, 0xb2, 0x9b, 0xd6, 0x1d, 0xbb, 0x69,
+	0x07, 0xbb, 0xef, 0x71, 0x1d, 0xda, 0xc3, 0x6a, 0xde, 0x84, 0x0b, 0x1d, 0xc7, 0x12, 0xed, 0x9a,
+	0x74, 0x4d, 0xac, 0xdf, 0x8d, 0xdd, 0x76, 0x74, 0x05, 0x5f, 0xdc, 0xdf, 0x9b, 0xbd, 0xb0, 0x99,
+	0x5d, 0x05, 0xf3, 0xda, 0x32, 0x76, 0x57, 0x01, 0x3d, 0xe7, 0x36, 0x3b, 0x2d, 0x89, 0x75, 0x80,
+	0x63, 0xe5, 0xec, 0xee, 0x66, 0x66, 0x0d, 0xcc, 0x69, 0x69, 0xfe, 0x4c, 0x09, 0x86, 0x17, 0xac,
+	0xda, 0xdd, 0x4e, 0x9b, 0xbc, 0x0e, 0x46, 0xdb, 0x9e, 0x7b, 0xcf, 0xae, 0x53, 0x4f, 0x8e, 0x2d,
+	0xba, 0xd5, 0xd7, 0x65, 0x39, 0x46, 0x35, 0x88, 0x0d, 0x53, 0xe1, 0xff, 0x95, 0x3e, 0x76, 0x2e,
+	0xdf, 0x09, 0xeb, 0x1a, 0x22, 0x4c, 0x20, 0x26, 0x26, 0x63, 0x20, 0x1a, 0x21, 0x73, 0x32, 0x26,
+	0x76, 0x3b, 0xf2, 0x12, 0x94, 0x10, 0xf2, 0x3e, 0x98, 0xaa, 0x79, 0xb4, 0x4e, 0x9d, 0xc0, 0xb6,
+	0x9a, 0x3e, 0x5b, 0x9c, 0x43, 0xbd, 0x2f, 0x4e, 0xde, 0x89, 0x8a, 0xd6, 0x1c, 0x13, 0xe8, 0xcc,
+	0x2f, 0x94, 0x60, 0x42, 0x4c, 0xd4, 0x42, 0xa7, 0x76, 0x97, 0x9e, 0x04, 0x2f, 0xbf, 0xa5, 0xf1,
+	0xf2, 0x8b, 0x45, 0xb6, 0xba, 0xda, 0xe3, 0x5c, 0x56, 0xde, 0x49, 0xb0, 0xf2, 0xcb, 0x7d, 0x53,
+	0xea, 0xce, 0xc9, 0xff, 0x7b, 0x03, 0x4e, 0xab, 0xd5, 0x4f, 0x80, 0x91, 0xa7, 0x3a, 0x23, 0xff,
+	0x8e, 0x7e, 0x47, 0x98, 0xc3, 0xc7, 0x7f, 0x0b, 0x9c, 0x55, 0x6b, 0x85, 0xeb, 0x9a, 0x1d, 0x19,
+	0xc1, 0x6e, 0x3b, 0x75, 0x64, 0xb0, 0x2d, 0x88, 0x1c, 0x22, 0x98, 0x64, 0xbe, 0xc6, 0x4b, 0x49,
+	0x26, 0x59, 0x5d, 0xe7, 0xe6, 0xbf, 0x19, 0xd0, 0xe7, 0x8e, 0x3f, 0x14, 0xee, 0x25, 0x76, 0xee,
+	0xf8, 0x93, 0xd7, 0xfb, 0x1d, 0x60, 0xd8, 0xf5, 0x97, 0xcb, 0x19, 0xf0, 0x18, 0x8c, 0xfa, 0x94,
+	0xd6, 0x95, 0x7b, 0x99, 0xdf, 0xe6, 0x55, 0x59, 0x86, 0x11, 0xf4, 0xd8, 0x4f, 0x02, 0xb2, 0x06,
+	0xa3, 0xfe, 0xb6, 0xeb, 0xb2, 0x56, 0xe5, 0xe1, 0xde, 0x51, 0x8b, 0xfe, 0xca, 0x86, 0x18, 0xa1,
+	0x30, 0x3f, 0x37, 0x08, 0x24, 0xbd, 0x79, 0xd4, 0xb9, 0x15, 0x25, 0x65, 0xa3, 0xef, 0xb9, 0x95,
+	0xfb, 0x30, 0x81, 0x98, 0xbc, 0x04, 0x93, 0x4d, 0xcb, 0x0f, 0x6e, 0xb5, 0xa9, 0x7c, 0x03, 0x8a,
+	0xaf, 0x58, 0xe8, 0xb5, 0xbb, 0xaa, 0x22, 0x5a, 0x98, 0xde, 0xdf, 0x9b, 0x9d, 0xd4, 0x8a, 0x50,
+	0x27, 0x45, 0xde, 0x0f, 0x63, 0xac, 0x60, 0xc9, 0xf3, 0x5c, 0x4f, 0x1e, 0x3f, 0xcf, 0x14, 0xa5,
+	0xcb, 0x91, 0x08, 0x79, 0x54, 0xf4, 0x13, 0x63, 0xf4, 0xe4, 0x9d, 0x40, 0xdc, 0x3b, 0x5c, 0x22,
+	0x58, 0xbf, 0x46, 0x9d, 0x70, 0xb0, 0x6c, 0x35, 0x0d, 0x2c, 0xcc, 0xc8, 0x65, 0x4e, 0x6e, 0xa5,
+	0x6a, 0x60, 0x46, 0x2b, 0x72, 0x17, 0x48, 0x24, 0x30, 0x13, 0xcc, 0xc3, 0x01, 0x2b, 0x2d, 0xaa,
+	0x24, 0x97, 0xc3, 0x79, 0x46, 0xec, 0x5a, 0x0a, 0x05, 0x66, 0xa0, 0x35, 0x7f, 0xa7, 0x04, 0xe3,
+	0x62, 0x89, 0x88, 0xb7, 0xcd, 0xf1, 0x5f, 0x3d, 0x54, 0xbb, 0x7a, 0x2a, 0xc5, 0x4f, 0x13, 0xde,
+	0xe1, 0xdc, 0x9b, 0xa7, 0x95, 0xb8, 0x79, 0x96, 0xfa, 0x25, 0xd4, 0xfd, 0xe2, 0xf9, 0x43, 0x03,
+	0x4e, 0x29, 0xb5, 0x4f, 0xe0, 0xde, 0xa9, 0xeb, 0xf7, 0xce, 0xb3, 0x7d, 0x8e, 0x2f, 0xe7, 0xda,
+	0xf9, 0x0d, 0x7d, 0x5c, 0xfc, 0x4e, 0x78, 0x12, 0xe0, 0x0e, 0x3f, 0x4f, 0x14, 0x56, 0x39, 0xfa,
+	0xe6, 0x0b, 0x11, 0x04, 0x95, 0x5a, 0xda, 0x21, 0x5b, 0xea, 0x7a, 0xc8, 0xaa, 0x67, 0xe0, 0x40,
+	0xff, 0x67, 0xe0, 0x7f, 0x1c, 0x80, 0xe9, 0xd4, 0x67, 0x4c, 0x9f, 0x4b, 0xc6, 0xd7, 0xe8, 0x5c,
+	0x2a, 0x7d, 0x2d, 0xce, 0xa5, 0x81, 0x42, 0xe7, 0x52, 0xef, 0xf7, 0xa4, 0x07, 0xa4, 0x65, 0x37,
+	0x44, 0xb3, 0x6a, 0x60, 0x79, 0x01, 0x93, 0x5f, 0x95, 0x87, 0x0e, 0x2d, 0x1a, 0xe3, 0x07, 0xd9,
+	0x5a, 0x0a, 0x13, 0x66, 0x60, 0x37, 0xbf, 0xad, 0x04, 0x23, 0x0b, 0x96, 0xcf, 0x7b, 0xfa, 0x21,
+	0x98, 0x90, 0xa8, 0x57, 0x5a, 0x56, 0x83, 0xf6, 0x23, 0x2d, 0x91, 0x28, 0xd7, 0x14, 0x74, 0xe2,
+	0xc1, 0xa9, 0x96, 0xa0, 0x46, 0x8e, 0xec, 0xc2, 0x78, 0x2b, 0x7e, 0x5c, 0x95, 0x4b, 0xfd, 0x70,
+	0xbe, 0x2a, 0x75, 0x86, 0x4d, 0xbc, 0xaa, 0x95, 0x02, 0x54, 0x69, 0x99, 0x2f, 0xc0, 0x99, 0x8c,
+	0x1e, 0xf7, 0xf0, 0xae, 0x7c, 0x0d, 0x8c, 0x30, 0xd1, 0x40, 0xcc, 0x25, 0x8e, 0x33, 0xd1, 0xd4,
+	0x73, 0xa2, 0x08, 0x43, 0x98, 0xf9, 0x66, 0x20, 0x3a, 0x7e, 0x46, 0xb5, 0x17, 0xf9, 0xe3, 0x10,
+	0x40, 0x65, 0x1e, 0xdd, 0x40, 0x2c, 0xa5, 0x67, 0x75, 0xb9, 0xeb, 0xe3, 0x49, 0xb9, 0x6b, 0x59,
+	0xe5, 0x93, 0x64, 0x23, 0x4d, 0xfa, 0xea, 0x01, 0x61, 0x8b, 0xbc, 0xe2, 0xb6, 0xda, 0x4d, 0x1a,
+	0x4a, 0x48, 0xcb, 0xa5, 0x62, 0x2b, 0x6c, 0x35, 0x85, 0x09, 0x33, 0xb0, 0x87, 0x34, 0x57, 0x1c,
+	0x3b, 0xb0, 0x23, 0xa9, 0x6c, 0x79, 0xa0, 0x38, 0x4d, 0x1d, 0x13, 0x66, 0x60, 0x27, 0x9f, 0x34,
+	0x60, 0x46, 0x2f, 0x5e, 0xb6, 0x1d, 0xdb, 0xdf, 0xa6, 0xf5, 0x0d, 0x5b, 0x6e, 0xc3, 0xc3, 0x11,
+	0xbf, 0xbc, 0xbf, 0x37, 0x3b, 0xb3, 0x9a, 0x8b, 0x11, 0xbb, 0x50, 0x23, 0x9f, 0x32, 0xe0, 0x62,
+	0x62, 0x5e, 0x3c, 0xbb, 0xd1, 0xa0, 0x1e, 0xad, 0x17, 0xdc, 0xe0, 0xb3, 0xfb, 0x7b, 0xb3, 0x17,
+	0x57, 0xf3, 0x51, 0x62, 0x37, 0x7a, 0xe4, 0x27, 0x0c, 0x38, 0xdf, 0xa6, 0x4e, 0xdd, 0x76, 0x1a,
+	0xb7, 0x5d, 0xef, 0x2e, 0x93, 0x74, 0xb9, 0xcd, 0xa6, 0xdb, 0x09, 0xfc, 0xf2, 0x30, 0xbf, 0x13,
+	0x57, 0x8a, 0xec, 0xb9, 0xf5, 0x2c, 0x8c, 0x0b, 0x97, 0xe5, 0x12, 0x3d, 0x9f, 0x09, 0xf6, 0x31,
+	0xa7, 0x23, 0xe6, 0x6f, 0x1b, 0x30, 0x50, 0xc1, 0x15, 0xf2, 0x5a, 0x6d, 0x8b, 0x5c, 0x50, 0xb7,
+	0xc8, 0x83, 0xbd, 0xd9, 0x91, 0x0a, 0xae, 0x28, 0x9b, 0xf1, 0x53, 0x06, 0x4c, 0xd7, 0x5c, 0x27,
+	0xb0, 0xd8, 0xdc, 0xa1, 0xe0, 0xbd, 0xc3, 0x7b, 0xbe, 0xd0, 0x5b, 0xbd, 0x92, 0x40, 0x16, 0xcb,
+	0xe2, 0x93, 0x10, 0x1f, 0xd3, 0x94, 0xcd, 0x9f, 0x34, 0xe0, 0x6c, 0xc5, 0x6a, 0x4b, 0x49, 0xd5,
+	0x22, 0xdd, 0xb2, 0x1d, 0xbb, 0x37, 0xc5, 0x03, 0xd9, 0x86, 0x61, 0x2e, 0x0c, 0xf7, 0xfb, 0x11,
+	0x35, 0xc4, 0xb4, 0x9f, 0xe3, 0xb8, 0x84, 0x98, 0x46, 0xfc, 0x8f, 0x12, 0xbf, 0xf9, 0x0c, 0x9c,
+	0x4e, 0xd6, 0x63, 0xea, 0x29, 0xc1, 0x23, 0x19, 0xb1, 0x7a, 0x4a, 0x65, 0x6f, 0x9e, 0x1e, 0xfd,
+	0xff, 0x3f, 0x37, 0xfb, 0xaa, 0x6f, 0xfd, 0x93, 0x2b, 0xaf, 0x32, 0xbf, 0x6c, 0xc0, 0x44, 0xa5,
+	0xe9, 0x76, 0xea, 0xeb, 0x9e, 0xbb, 0x65, 0x37, 0xe9, 0x2b, 0x43, 0x08, 0xa3, 0xf6, 0x38, 0x8f,
+	0x15, 0xe6, 0x42, 0x11, 0xb5, 0xe2, 0x2b, 0x44, 0x28, 0xa2, 0x76, 0x39, 0x87, 0x3b, 0x7d, 0x2f,
+	0x9c, 0x53, 0x6b, 0xc5, 0x12, 0xdd, 0x2b, 0x30, 0x78, 0xd7, 0x76, 0xea, 0xc9, 0x85, 0x79, 0xc3,
+	0x76, 0xea, 0xc8, 0x21, 0xd1, 0xd2, 0x2d, 0xe5, 0xde, 0x59, 0x7f, 0x3d, 0xa6, 0x4f, 0x1b, 0xe7,
+	0x7d, 0x1f, 0x83, 0xd1, 0x9a, 0xb5, 0xd0, 0x71, 0xea, 0xcd, 0x68, 0xd5, 0xb3, 0x29, 0xa8, 0xcc,
+	0x8b, 0x32, 0x8c, 0xa0, 0xe4, 0x25, 0x80, 0x58, 0x79, 0xd2, 0x0f, 0x13, 0x10, 0xeb, 0x65, 0xaa,
+	0x34, 0x08, 0x6c, 0xa7, 0xe1, 0xc7, 0xeb, 0x2a, 0x86, 0xa1, 0x42, 0x8d, 0x7c, 0x08, 0x26, 0x55,
+	0x8e, 0x44, 0x48, 0x71, 0x0b, 0x7e, 0x06, 0x8d, 0xf5, 0x39, 0x27, 0x09, 0x4f, 0xaa, 0xa5, 0x3e,
+	0xea, 0xd4, 0xc8, 0x6e, 0xc4, 0x7f, 0x09, 0x19, 0xf2, 0x60, 0xf1, 0x17, 0x8a, 0xca, 0xfa, 0x9c,
+	0x95, 0xc4, 0x27, 0x34, 0x99, 0xb6, 0x46, 0x2a, 0x43, 0x6e, 0x34, 0x74, 0x5c, 0x72, 0x23, 0x0a,
+	0x23, 0x42, 0x72, 0x16, 0x5e, 0x37, 0x4f, 0x17, 0x19, 0xa0, 0x10, 0xc2, 0xc5, 0xda, 0x40, 0xf1,
+	0xdb, 0xc7, 0x10, 0x37, 0xd3, 0xb6, 0x31, 0xc6, 0xba, 0x4a, 0x9b, 0xb4, 0x16, 0xb8, 0x5e, 0x79,
+	0xa4, 0xb8, 0xb6, 0xad, 0xaa, 0xe0, 0x11, 0x5c, 0xac, 0x5a, 0x82, 0x1a, 0x9d, 0x48, 0xb0, 0x38,
+	0x9a, 0x2b, 0x58, 0xec, 0xc0, 0xf8, 0x3d, 0x45, 0x53, 0x30, 0xc6, 0x27, 0xe1, 0xed, 0x45, 0x3a,
+	0x16, 0xab, 0x0d, 0x16, 0xce, 0x48, 0x42, 0xe3, 0xaa, 0x8a, 0x41, 0xa5, 0x43, 0xee, 0xc0, 0xc8,
+	0x1d, 0xc1, 0x83, 0x96, 0x81, 0xcf, 0xc5, 0xdb, 0xfa, 0x60, 0xad, 0x05, 0x9f, 0x2b, 0x7f, 0x60,
+	0x88, 0x98, 0xbc, 0x00, 0xc3, 0x4d, 0xbb, 0x65, 0x07, 0x7e, 0x79, 0xfc, 0x8a, 0x51, 0xf4, 0xd3,
+	0xae, 0x72, 0x0c, 0xe2, 0xb2, 0x12, 0xff, 0xa3, 0xc4, 0x4a, 0x3e, 0x6d, 0xc0, 0x19, 0xb9, 0x0c,
+	0xa3, 0x4b, 0xcb, 0xa6, 0x7e, 0x79, 0xe2, 0xca, 0x40, 0x51, 0x11, 0x6b, 0xd6, 0x05, 0xbd, 0x70,
+	0x51, 0xce, 0xe6, 0x99, 0xb5, 0x34, 0x31, 0xcc, 0xea, 0x81, 0xf9, 0xc9, 0x69, 0x98, 0xae, 0x34,
+	0x3b, 0x7e, 0x40, 0xbd, 0x79, 0x69, 0x61, 0x47, 0x3d, 0xf2, 0x31, 0x03, 0xce, 0xf3, 0x7f, 0x17,
+	0xdd, 0xfb, 0xce, 0x22, 0x6d, 0x5a, 0xbb, 0xf3, 0x5b, 0xac, 0x46, 0xbd, 0x7e, 0xb8, 0xcb, 0x23,
+	0xb2, 0xea, 0xe0, 0x0a, 0xa5, 0x6a, 0x26, 0x46, 0xcc, 0xa1, 0x44, 0xbe, 0xcb, 0x80, 0x87, 0x32,
+	0x40, 0x8b, 0xb4, 0x49, 0x83, 0x90, 0xf9, 0x3f, 0x6c, 0x3f, 0x1e, 0xde, 0xdf, 0x9b, 0x7d, 0xa8,
+	0x9a, 0x87, 0x14, 0xf3, 0xe9, 0x31, 0x8b, 0x89, 0x99, 0x0c, 0xe8, 0xb2, 0x65, 0x37, 0x3b, 0x1e,
+	0x2d, 0x68, 0xec, 0xc2, 0xd9, 0xf3, 0x6a, 0x2e, 0x56, 0xec, 0x42, 0x91, 0x7c, 0x04, 0xce, 0x45,
+	0xd0, 0x4d, 0xc7, 0xa1, 0xb4, 0xae, 0xbd, 0x12, 0x0e, 0xdb, 0x95, 0x87, 0xf6, 0xf7, 0x66, 0xcf,
+	0x55, 0xb3, 0x10, 0x62, 0x36, 0x1d, 0xd2, 0x80, 0x87, 0x63, 0x40, 0x60, 0x37, 0xed, 0x97, 0xc4,
+	0x43, 0x66, 0xdb, 0xa3, 0xfe, 0xb6, 0xdb, 0xac, 0xf3, 0xa3, 0xd8, 0x58, 0x78, 0xf5, 0xfe, 0xde,
+	0xec, 0xc3, 0xd5, 0x6e, 0x15, 0xb1, 0x3b, 0x1e, 0x52, 0x87, 0x09, 0xbf, 0x66, 0x39, 0x2b, 0x4e,
+	0x40, 0xbd, 0x7b, 0x56, 0xb3, 0x3c, 0x5c, 0x68, 0x80, 0xe2, 0x00, 0x54, 0xf0, 0xa0, 0x86, 0x95,
+	0xbc, 0x15, 0x46, 0xe9, 0x4e, 0xdb, 0x72, 0xea, 0x54, 0x1c, 0xba, 0x63, 0x0b, 0x97, 0xd8, 0x55,
+	0xbf, 0x24, 0xcb, 0x1e, 0xec, 0xcd, 0x4e, 0x84, 0xff, 0xaf, 0xb9, 0x75, 0x8a, 0x51, 0x6d, 0xf2,
+	0x41, 0x38, 0xcb, 0x4d, 0x00, 0xeb, 0x94, 0x5f, 0x21, 0x7e, 0xf8, 0x56, 0x1c, 0x2d, 0xd4, 0x4f,
+	0x6e, 0x25, 0xb0, 0x96, 0x81, 0x0f, 0x33, 0xa9, 0xb0, 0xcf, 0xd0, 0xb2, 0x76, 0xae, 0x79, 0x56,
+	0x8d, 0x6e, 0x75, 0x9a, 0x1b, 0xd4, 0x6b, 0xd9, 0x8e, 0x10, 0x96, 0x30, 0xc5, 0x77, 0x9d, 0x1d,
+	0xd4, 0xcc, 0xe0, 0x90, 0x7f, 0x86, 0xb5, 0x6e, 0x15, 0xb1, 0x3b, 0x1e, 0xf2, 0x46, 0x98, 0xb0,
+	0x1b, 0x8e, 0xeb, 0xd1, 0x0d, 0xcb, 0x76, 0x02, 0xbf, 0x0c, 0x9c, 0xc9, 0xe6, 0xd3, 0xba, 0xa2,
+	0x94, 0xa3, 0x56, 0x8b, 0xdc, 0x03, 0xe2, 0xd0, 0xfb, 0xeb, 0x6e, 0x9d, 0x2f, 0x81, 0xcd, 0x36,
+	0x5f, 0xc8, 0xe5, 0xf1, 0x42, 0x53, 0xc3, 0x9f, 0xd2, 0x37, 0x53, 0xd8, 0x30, 0x83, 0x02, 0x59,
+	0x06, 0xd2, 0xb2, 0x76, 0x96, 0x5a, 0xed, 0x60, 0x77, 0xa1, 0xd3, 0xbc, 0x2b, 0x4f, 0x8d, 0x09,
+	0x3e, 0x17, 0x42, 0xd0, 0x94, 0x82, 0x62, 0x46, 0x0b, 0x62, 0xc1, 0x45, 0x31, 0x9e, 0x45, 0x8b,
+	0xb6, 0x5c, 0xc7, 0xa7, 0x81, 0xaf, 0x2c, 0xd2, 0xf2, 0x24, 0xb7, 0x07, 0xe1, 0x0f, 0xdb, 0x95,
+	0xfc, 0x6a, 0xd8, 0x0d, 0x87, 0x6e, 0x0a, 0x3b, 0x75, 0x80, 0x29, 0xec, 0x5b, 0x60, 0xd2, 0x0f,
+	0x2c, 0x2f, 0xe8, 0xb4, 0xe5, 0x67, 0x38, 0xc5, 0x3f, 0x03, 0x97, 0x43, 0x56, 0x55, 0x00, 0xea,
+	0xf5, 0xd8, 0xe7, 0x13, 0xc2, 0x6b, 0xd9, 0xee, 0x74, 0xfc, 0xf9, 0xaa, 0x4a, 0x39, 0x6a, 0xb5,
+	0x98, 0x01, 0x42, 0xcb, 0xda, 0x89, 0xb6, 0xef, 0xba, 0xe5, 0x59, 0xcd, 0x26, 0x6d, 0xda, 0x7e,
+	0xab, 0x3c, 0xcd, 0x7b, 0xca, 0x0d, 0x10, 0xd6, 0xb2, 0xab, 0x60, 0x5e, 0x5b, 0x69, 0x1b, 0xbb,
+	0xe8, 0x59, 0xb6, 0x86, 0x92, 0x68, 0xb6, 0xb1, 0x49, 0x30, 0x66, 0xb5, 0x21, 0x3f, 0x66, 0xc0,
+	0x2c, 0xbf, 0xff, 0xac, 0x26, 0xdb, 0x1c, 0xd7, 0x3c, 0xb7, 0xd3, 0x66, 0x12, 0x60, 0x77, 0x6b,
+	0x2b, 0x5c, 0x38, 0xe5, 0x33, 0x85, 0x96, 0xdb, 0x23, 0xfb, 0x7b, 0xb3, 0xb3, 0x2b, 0xdd, 0x51,
+	0xe3, 0x41, 0xb4, 0xc9, 0xf7, 0x19, 0x70, 0x51, 0x6e, 0xdc, 0xcc, 0xbe, 0x9d, 0x2d, 0xd4, 0x37,
+	0xbe, 0xe2, 0xd6, 0xf2, 0xd1, 0x62, 0x37, 0x9a, 0xe4, 0x07, 0x0d, 0xb8, 0xe4, 0x24, 0x80, 0x48,
+	0x7d, 0xca, 0x65, 0xab, 0x6e, 0x27, 0x28, 0x9f, 0x2b, 0xd4, 0xa9, 0x2b, 0xfb, 0x7b, 0xb3, 0x97,
+	0x6e, 0x76, 0xc1, 0x8b, 0x5d, 0xa9, 0x9a, 0xff, 0x63, 0x10, 0xca, 0x29, 0x66, 0x24, 0x34, 0x59,
+	0x3c, 0xf0, 0xba, 0x31, 0x8e, 0xe8, 0xba, 0x69, 0xc3, 0x95, 0xa8, 0xc2, 0xb5, 0x76, 0x27, 0x93,
+	0x56, 0x89, 0xd3, 0x7a, 0x74, 0x7f, 0x6f, 0xf6, 0x4a, 0xf5, 0x80, 0xba, 0x78, 0x20, 0xb6, 0xfc,
+	0xab, 0x7c, 0xe0, 0x84, 0xae, 0xf2, 0x0f, 0xc2, 0x59, 0x05, 0xe0, 0x51, 0xab, 0xbe, 0xdb, 0x07,
+	0x2b, 0xc1, 0x6f, 0xb0, 0x6a, 0x06, 0x3e, 0xcc, 0xa4, 0x92, 0x7b, 0x7f, 0x0e, 0x9d, 0xc4, 0xfd,
+	0x69, 0xee, 0x0d, 0xc0, 0x58, 0xc5, 0x75, 0xea, 0x42, 0xc4, 0xf5, 0x84, 0x66, 0x5f, 0xf1, 0xb0,
+	0xfa, 0x0c, 0x7a, 0xb0, 0x37, 0x3b, 0x19, 0x55, 0x54, 0xde, 0x45, 0x4f, 0x45, 0xaa, 0x47, 0x21,
+	0x5c, 0x78, 0xb5, 0xae, 0x33, 0x7c, 0xb0, 0x37, 0x7b, 0x2a, 0x6a, 0xa6, 0xab, 0x11, 0xd9, 0xe5,
+	0xc8, 0x24, 0x9e, 0x1b, 0x9e, 0xe5, 0xf8, 0x76, 0x1f, 0x32, 0xe6, 0x48, 0xb7, 0xb3, 0x9a, 0xc2,
+	0x86, 0x19, 0x14, 0xc8, 0xfb, 0x61, 0x8a, 0x95, 0x6e, 0xb6, 0xeb, 0x56, 0x40, 0x0b, 0x8a, 0x96,
+	0x23, 0x6b, 0xb9, 0x55, 0x0d, 0x13, 0x26, 0x30, 0x2b, 0x46, 0xdb, 0x43, 0xdd, 0x8c, 0xb6, 0x99,
+	0xc5, 0x6c, 0x8b, 0xfa, 0x3e, 0x53, 0xe0, 0x0c, 0xf3, 0x8a, 0xd1, 0x1b, 0x79, 0x4d, 0x14, 0x63,
+	0x08, 0x27, 0xaf, 0x83, 0xa1, 0x9a, 0x5b, 0xa7, 0x7e, 0x79, 0x84, 0xdf, 0x61, 0xec, 0x3a, 0x1f,
+	0xaa, 0xb0, 0x82, 0x07, 0x7b, 0xb3, 0x63, 0x5c, 0x13, 0xc6, 0x7e, 0xa1, 0xa8, 0x64, 0xfe, 0x38,
+	0x93, 0x87, 0x25, 0x84, 0x9c, 0x3d, 0xd8, 0xd1, 0x9c, 0x9c, 0x49, 0x8a, 0xf9, 0x69, 0x26, 0x8c,
+	0x74, 0x9d, 0xc0, 0x73, 0x9b, 0xeb, 0x4d, 0xcb, 0xa1, 0xe4, 0x3b, 0x0d, 0x38, 0xbd, 0x6d, 0x37,
+	0xb6, 0x55, 0x8b, 0xc1, 0xb2, 0x51, 0x5c, 0x6e, 0x78, 0x3d, 0x81, 0x6b, 0xe1, 0xec, 0xfe, 0xde,
+	0xec, 0xe9, 0x64, 0x29, 0xa6, 0x68, 0x9a, 0x7f, 0x5e, 0x82, 0x0b, 0x6a, 0xcf, 0xe6, 0x63, 0x2f,
+	0x2c, 0xf2, 0x87, 0x06, 0x40, 0xcb, 0x76, 0xe6, 0x9b, 0x4d, 0xf7, 0x3e, 0x37, 0x73, 0x66, 0x6f,
+	0xd9, 0xf7, 0x16, 0x95, 0x57, 0x67, 0x50, 0x98, 0x5b, 0x8b, 0xb0, 0x0b, 0x9d, 0xf5, 0xf3, 0xa1,
+	0x1c, 0x2c, 0x06, 0x3c, 0xd8, 0x9b, 0x9d, 0x4d, 0xbb, 0x7e, 0xcd, 0xa1, 0xf4, 0xaf, 0x62, 0xb2,
+	0xca, 0x8f, 0xfd, 0x59, 0xd7, 0x2a, 0x42, 0x77, 0x1d, 0x0f, 0x64, 0xa6, 0x05, 0xa7, 0x12, 0x84,
+	0x33, 0x4c, 0xc0, 0x17, 0x55, 0x13, 0xf0, 0x03, 0x0e, 0xa9, 0xb9, 0xd0, 0xdb, 0x6b, 0xee, 0x5d,
+	0x1d, 0xcb, 0x09, 0xd8, 0x4c, 0x2b, 0x26, 0xe3, 0x7f, 0x5a, 0x82, 0xb3, 0x72, 0x02, 0x9a, 0xec,
+	0xb5, 0xd9, 0x6e, 0xba, 0xbb, 0x2d, 0xea, 0x9c, 0x84, 0x59, 0x60, 0xb8, 0x09, 0x4a, 0xb9, 0x9b,
+	0xa0, 0x95, 0xda, 0x04, 0x03, 0x45, 0x36, 0x41, 0x74, 0x56, 0x1c, 0x20, 0x63, 0x43, 0x38, 0x6f,
+	0x3b, 0xac, 0xa3, 0xd7, 0xf8, 0x82, 0x89, 0x8d, 0x77, 0xf9, 0xf9, 0x34, 0x2a, 0xc4, 0x08, 0x2b,
+	0x99, 0x35, 0x30, 0xa7, 0xa5, 0xf9, 0x97, 0x06, 0x94, 0xb3, 0xe6, 0xf7, 0x04, 0xc4, 0xe2, 0x2d,
+	0x5d, 0x2c, 0x7e, 0xbd, 0x8f, 0xbd, 0xa1, 0x75, 0x3d, 0x47, 0x3c, 0xfe, 0x17, 0x25, 0x38, 0x1f,
+	0x57, 0x5f, 0x71, 0xfc, 0xc0, 0x6a, 0x36, 0x05, 0xc3, 0x77, 0xfc, 0x6b, 0xa9, 0xad, 0x69, 0x37,
+	0x6e, 0xf6, 0x37, 0x54, 0xb5, 0xef, 0xb9, 0x26, 0x3f, 0x3b, 0x09, 0x93, 0x9f, 0xf5, 0x23, 0xa4,
+	0xd9, 0xdd, 0xfa, 0xe7, 0x3f, 0x1b, 0x30, 0x93, 0xdd, 0xf0, 0x04, 0x16, 0x95, 0xab, 0x2f, 0xaa,
+	0x77, 0x1e, 0xdd, 0xa8, 0x73, 0x96, 0xd5, 0x2f, 0x95, 0xf2, 0x46, 0xcb, 0x55, 0x24, 0x5b, 0x70,
+	0xca, 0xa3, 0x0d, 0xdb, 0x0f, 0xe4, 0xc3, 0xe3, 0x70, 0xd6, 0xfc, 0xa1, 0x6a, 0xf4, 0x14, 0xea,
+	0x38, 0x30, 0x89, 0x94, 0xdc, 0x84, 0x11, 0x26, 0xb0, 0x66, 0xf8, 0x4b, 0xbd, 0xe3, 0x8f, 0x7d,
+	0xd9, 0x44, 0x5b, 0x0c, 0x91, 0x90, 0x6f, 0x82, 0xc9, 0x7a, 0xb4, 0xa3, 0x0e, 0x69, 0x7d, 0xc4,
+	0x5f, 0xdb, 0x8b, 0x6a, 0x6b, 0xd4, 0x91, 0x99, 0xff, 0xdb, 0x80, 0x4b, 0xdd, 0xd6, 0x16, 0x79,
+	0x11, 0xa0, 0x16, 0x72, 0x85, 0xbe, 0xbc, 0x3c, 0x9f, 0x29, 0xf8, 0x2d, 0x05, 0x96, 0x78, 0x83,
+	0x46, 0x45, 0x3e, 0x2a, 0x44, 0x32, 0x0c, 0x41, 0x4b, 0xc7, 0x64, 0x08, 0x6a, 0xfe, 0x17, 0x43,
+	0x3d, 0x8a, 0xd4, 0x6f, 0xfb, 0x4a, 0x3b, 0x8a, 0xd4, 0xbe, 0xe7, 0xaa, 0x5c, 0xbf, 0x54, 0x82,
+	0x2b, 0xd9, 0x4d, 0x94, 0xfb, 0xfc, 0x1d, 0x30, 0xdc, 0x16, 0x1e, 0x37, 0xc2, 0xf9, 0xe0, 0x31,
+	0x76, 0xb2, 0x08, 0x7f, 0x98, 0x07, 0x7b, 0xb3, 0x33, 0x59, 0x07, 0xbd, 0x80, 0xa2, 0x6c, 0x47,
+	0xec, 0x84, 0x6e, 0x48, 0x30, 0xed, 0x6f, 0xe8, 0xf1, 0x70, 0xb1, 0xee, 0xd0, 0x66, 0xcf, 0xea,
+	0xa0, 0x8f, 0x1a, 0x30, 0xa5, 0xad, 0x68, 0xbf, 0x3c, 0x54, 0xdc, 0x73, 0x55, 0xdb, 0x2a, 0x31,
+	0x37, 0xa0, 0x15, 0xfb, 0x98, 0x20, 0x98, 0x38, 0x66, 0xd5, 0x59, 0x7d, 0xc5, 0x1d, 0xb3, 0x6a,
+	0xe7, 0x73, 0x8e, 0xd9, 0xcf, 0x96, 0xf2, 0x46, 0xcb, 0x8f, 0xd9, 0xfb, 0x30, 0x16, 0xb2, 0x91,
+	0xe1, 0x71, 0xb1, 0xdc, 0x6f, 0x9f, 0x04, 0xba, 0x85, 0x69, 0xd9, 0x9f, 0xb1, 0xb0, 0xc4, 0xc7,
+	0x98, 0x16, 0xf9, 0x76, 0x03, 0x20, 0xfe, 0x30, 0x72, 0x53, 0x6d, 0x1c, 0xdd, 0x74, 0x28, 0x6c,
+	0xcd, 0x14, 0xdb, 0xd2, 0xf1, 0x6f, 0x54, 0xe8, 0x9a, 0xff, 0x6e, 0x10, 0x48, 0xba, 0xef, 0xbd,
+	0x69, 0xfe, 0x0f, 0x60, 0x72, 0xdb, 0x70, 0xda, 0x63, 0xdc, 0x62, 0xcd, 0x6e, 0xd2, 0x50, 0x00,
+	0x56, 0x4c, 0xf2, 0xc1, 0x9f, 0x55, 0x98, 0xc0, 0x85, 0x29, 0xec, 0xcc, 0xfc, 0xae, 0xed, 0xd9,
+	0x2d, 0xcb, 0xdb, 0xe5, 0x8f, 0xe2, 0x51, 0xa1, 0x96, 0x5c, 0x17, 0x45, 0x18, 0xc2, 0xc8, 0x07,
+	0x61, 0xac, 0x69, 0x6f, 0xd1, 0xda, 0x6e, 0xad, 0x49, 0xa5, 0xd6, 0xe3, 0xd6, 0xd1, 0x7c, 0xf3,
+	0xd5, 0x10, 0xad, 0x34, 0x26, 0x0d, 0x7f, 0x62, 0x4c, 0x90, 0xc9, 0x68, 0xef, 0x73, 0xf3, 0xa6,
+	0x26, 0xf5, 0xfd, 0x6a, 0xa7, 0xdd, 0x76, 0xbd, 0x80, 0xd6, 0xb9, 0x6e, 0x64, 0x54, 0xc8, 0x68,
+	0x6f, 0xa7, 0xc1, 0x98, 0xd5, 0x86, 0x3c, 0x03, 0x60, 0x75, 0x02, 0x57, 0xb8, 0xbe, 0x96, 0x47,
+	0xf9, 0xab, 0x9d, 0xa9, 0xe2, 0x60, 0x3e, 0x2a, 0x7d, 0xb0, 0x37, 0x3b, 0x2e, 0x65, 0x7f, 0xfc,
+	0xd3, 0x28, 0x0d, 0xc8, 0x7b, 0xe1, 0x6c, 0x4d, 0x80, 0x98, 0x6d, 0x98, 0x15, 0xd8, 0xf2, 0x45,
+	0x3c, 0xc6, 0x11, 0xfd, 0x3f, 0x4c, 0xe0, 0x53, 0xc9, 0x80, 0x27, 0x51, 0x66, 0x22, 0x31, 0x3f,
+	0x59, 0x82, 0x8b, 0x5d, 0x26, 0x88, 0x20, 0x8c, 0x45, 0xdf, 0x4f, 0x2e, 0xb3, 0x37, 0x8a, 0xcd,
+	0x22, 0x0b, 0x1f, 0xec, 0xcd, 0x3e, 0xd2, 0x05, 0x41, 0x95, 0xad, 0x73, 0xda, 0xd8, 0xc5, 0x18,
+	0x0d, 0x59, 0x81, 0xe1, 0x7a, 0xac, 0xc6, 0x1c, 0x5b, 0x78, 0x82, 0x5d, 0x05, 0x42, 0xe1, 0xd0,
+	0x2b, 0x36, 0x89, 0x80, 0xac, 0xc2, 0x88, 0x30, 0x8f, 0xa5, 0xf2, 0x5a, 0x79, 0x92, 0x8b, 0x4c,
+	0x44, 0x51, 0xaf, 0xc8, 0x42, 0x14, 0xe6, 0xff, 0x32, 0x60, 0xa4, 0xc2, 0x14, 0x15, 0x37, 0xab,
+	0xcc, 0xae, 0x55, 0x09, 0xba, 0x22, 0x8f, 0xd8, 0x82, 0x67, 0x0e, 0xc7, 0xa8, 0x3c, 0xed, 0x43,
+	0x6f, 0xd1, 0xa8, 0x00, 0x55, 0x5a, 0xe4, 0x45, 0x36, 0xe7, 0xf7, 0x3d, 0x3b, 0x60, 0x84, 0xfb,
+	0xb1, 0x97, 0x12, 0x84, 0x31, 0xc4, 0x25, 0x56, 0x7b, 0xf4, 0x13, 0x63, 0x2a, 0xe6, 0x3a, 0x10,
+	0x59, 0x5b, 0x95, 0x71, 0x3c, 0x0d, 0x83, 0x2d, 0xb7, 0x1e, 0x7e, 0xf7, 0xaf, 0x0b, 0x0f, 0x0f,
+	0xa6, 0x00, 0x7c, 0xb0, 0x37, 0x7b, 0x3e, 0xdd, 0x82, 0x41, 0x90, 0xb7, 0x31, 0x6f, 0xc2, 0x69,
+	0x09, 0x8f, 0x08, 0x32, 0x37, 0xde, 0x9a, 0xdb, 0x6a, 0xb9, 0x4e, 0xb5, 0xb3, 0xb5, 0x65, 0xef,
+	0x50, 0xcd, 0x8d, 0xb7, 0xa2, 0x41, 0x30, 0x51, 0xd3, 0xfc, 0x51, 0x03, 0x06, 0xd8, 0x77, 0x31,
+	0x61, 0xb8, 0xee, 0xb6, 0x2c, 0xdb, 0x91, 0xbd, 0xe2, 0x06, 0x07, 0x8b, 0xbc, 0x04, 0x25, 0x84,
+	0xb4, 0x61, 0x2c, 0xe4, 0xc8, 0xfa, 0xf2, 0x18, 0x58, 0xbc, 0x59, 0x8d, 0xfc, 0xb7, 0xa2, 0x6b,
+	0x22, 0x2c, 0xf1, 0x31, 0x26, 0x62, 0x5a, 0x30, 0xbd, 0x78, 0xb3, 0xba, 0xe2, 0xd4, 0x9a, 0x9d,
+	0x3a, 0x5d, 0xda, 0xe1, 0x7f, 0xd8, 0x39, 0x67, 0x8b, 0x12, 0x39, 0x4e, 0x7e, 0xce, 0xc9, 0x4a,
+	0x18, 0xc2, 0x58, 0x35, 0x2a, 0x5a, 0x94, 0x4b, 0x71, 0x35, 0x89, 0x04, 0x43, 0x98, 0xf9, 0xe5,
+	0x12, 0x8c, 0x2b, 0x1d, 0x22, 0x4d, 0x18, 0x11, 0xc3, 0xf5, 0xfb, 0x89, 0x5c, 0x90, 0xea, 0xb5,
+	0xa0, 0x2e, 0x26, 0xd4, 0xc7, 0x90, 0x84, 0x7a, 0x66, 0x97, 0xba, 0x9c, 0xd9, 0x73, 0x9a, 0x73,
+	0xb0, 0xd8, 0x92, 0x53, 0xf9, 0x8e, 0xc1, 0xe4, 0x92, 0xbc, 0x9e, 0x84, 0x89, 0xfd, 0x68, 0xe2,
+	0x6a, 0xda, 0x82, 0xa1, 0x97, 0x5c, 0x87, 0xfa, 0xe5, 0xa1, 0xa3, 0x1c, 0x20, 0x37, 0x8c, 0x64,
+	0x1e, 0xc8, 0x3e, 0x0a, 0xf4, 0xe6, 0x4f, 0x18, 0x00, 0x8b, 0x56, 0x60, 0x09, 0x2b, 0x9c, 0x1e,
+	0x0c, 0x3d, 0x2f, 0x69, 0xb7, 0xea, 0x68, 0xca, 0x07, 0x71, 0xd0, 0xb7, 0x5f, 0x0a, 0x87, 0x1f,
+	0x71, 0xeb, 0x02, 0x7b, 0xd5, 0x7e, 0x89, 0x22, 0x87, 0x33, 0xcd, 0x27, 0x75, 0x6a, 0xde, 0x6e,
+	0x9b, 0x5d, 0x2c, 0x42, 0xc4, 0xc3, 0x77, 0xe8, 0x52, 0x58, 0x88, 0x31, 0xdc, 0x7c, 0x02, 0xf4,
+	0x27, 0x57, 0x0f, 0x76, 0xe8, 0x7f, 0x6b, 0xc0, 0x85, 0xc5, 0x8e, 0xd5, 0x9c, 0x6f, 0xb3, 0x85,
+	0x6a, 0x35, 0x97, 0x5d, 0x61, 0xce, 0xc1, 0xde, 0x21, 0xaf, 0x83, 0xd1, 0x90, 0xc9, 0x49, 0x3a,
+	0x29, 0x87, 0x07, 0x25, 0x46, 0x35, 0x88, 0xc5, 0xbc, 0x21, 0x24, 0xdb, 0x5d, 0xea, 0x83, 0xed,
+	0x0e, 0x49, 0x84, 0x25, 0x18, 0xa1, 0x15, 0xc2, 0x2f, 0xfe, 0x81, 0x58, 0x70, 0x22, 0xbb, 0x46,
+	0xe7, 0x6b, 0x35, 0xb7, 0xc3, 0x54, 0xb5, 0x03, 0xaa, 0xf0, 0x2b, 0xab, 0x06, 0xe6, 0xb4, 0x34,
+	0xbf, 0x60, 0xc0, 0xe0, 0xd2, 0x46, 0x65, 0x91, 0x7c, 0x13, 0x0c, 0x46, 0x47, 0x46, 0x41, 0xab,
+	0x2d, 0x86, 0x47, 0xc8, 0xe9, 0xc4, 0xf7, 0x5e, 0x63, 0x07, 0x0e, 0xc7, 0x4a, 0xee, 0xc0, 0x30,
+	0xbd, 0x47, 0x59, 0x57, 0x4b, 0x47, 0x82, 0x9f, 0x1f, 0x69, 0x4b, 0x1c, 0x23, 0x4a, 0xcc, 0xe6,
+	0x77, 0x1b, 0x00, 0x71, 0x15, 0xf2, 0xe1, 0xac, 0xdb, 0xe9, 0xc6, 0x11, 0x4a, 0x9f, 0xbb, 0x5f,
+	0x51, 0xe6, 0x6f, 0x0c, 0xc1, 0x43, 0xac, 0x3b, 0x72, 0xa9, 0xda, 0xae, 0x73, 0x83, 0xee, 0xfe,
+	0xbd, 0xc7, 0xc3, 0xdf, 0x7b, 0x3c, 0x1c, 0xa1, 0xc7, 0xc3, 0x7b, 0xe1, 0x21, 0xb6, 0xe4, 0x24,
+	0x98, 0x72, 0xf3, 0xaf, 0x75, 0x8f, 0xb6, 0x2d, 0x8f, 0xd6, 0xf9, 0x7b, 0x60, 0x54, 0x18, 0xb4,
+	0xcd, 0xe7, 0x55, 0xc2, 0xfc, 0xf6, 0xe6, 0xb3, 0x70, 0x3a, 0x5e, 0xbb, 0xf3, 0x01, 0x52, 0x3f,
+	0x60, 0xe7, 0xb1, 0xfe, 0xc8, 0x1c, 0x0b, 0x39, 0xa6, 0xf4, 0xc3, 0x50, 0x47, 0x20, 0x77, 0xe5,
+	0xa1, 0x10, 0x3c, 0x30, 0xe0, 0xb4, 0x88, 0x84, 0xc4, 0x42, 0x49, 0x08, 0x97, 0x23, 0xa6, 0x06,
+	0x0c, 0x3d, 0x93, 0x0c, 0x5d, 0x0d, 0x98, 0xf4, 0x4e, 0x22, 0x5b, 0x30, 0x15, 0xc7, 0x4c, 0x5a,
+	0xb4, 0x82, 0x22, 0xfb, 0x43, 0xc4, 0x4f, 0xd1, 0xb0, 0x60, 0x02, 0x2b, 0xa9, 0xc2, 0x54, 0xad,
+	0x69, 0xf9, 0xbe, 0xbd, 0x65, 0xd7, 0x62, 0x8f, 0xba, 0xb1, 0x85, 0xd7, 0x72, 0xa6, 0x4d, 0x83,
+	0x3c, 0xd8, 0x9b, 0x3d, 0x27, 0xfb, 0xa9, 0x03, 0x30, 0x81, 0xc2, 0xfc, 0x4c, 0x09, 0x26, 0x97,
+	0x76, 0xda, 0xae, 0xdf, 0xf1, 0x28, 0xaf, 0x7a, 0x02, 0x82, 0xb1, 0xc7, 0x61, 0x64, 0xdb, 0x62,
+	0xd6, 0xea, 0x5e, 0xb9, 0xa4, 0xcf, 0xed, 0x75, 0x51, 0x8c, 0x21, 0x9c, 0x7c, 0x00, 0x80, 0x85,
+	0x00, 0xac, 0x77, 0xf8, 0xe9, 0x3a, 0x50, 0xfc, 0x74, 0xd5, 0xc6, 0x58, 0x8d, 0x50, 0x4a, 0x9e,
+	0x28, 0xfa, 0x8d, 0x0a, 0x39, 0x1e, 0xa0, 0x4d, 0x6b, 0xf7, 0x0a, 0x09, 0xd0, 0xa6, 0xf5, 0x39,
+	0x47, 0xcc, 0xf3, 0xf1, 0x12, 0x5c, 0xc8, 0x99, 0x93, 0x94, 0xed, 0xb7, 0x71, 0x42, 0xb6, 0xdf,
+	0x1d, 0x18, 0x0f, 0xdc, 0xa6, 0x74, 0xfc, 0x0c, 0x67, 0xa0, 0xd0, 0x1d, 0xbe, 0x11, 0xa1, 0x89,
+	0x2d, 0xbb, 0xe3, 0x32, 0x1f, 0x55, 0x3a, 0xcc, 0x59, 0x6a, 0x2c, 0x12, 0x2b, 0xbf, 0xac, 0x34,
+	0xf2, 0xbd, 0x87, 0x7c, 0x32, 0x7f, 0xaf, 0x04, 0xe7, 0x23, 0xdc, 0xe1, 0x31, 0xc7, 0xa4, 0xe0,
+	0xbd, 0xc8, 0xa6, 0x2e, 0x69, 0x5e, 0x29, 0xa3, 0x69, 0x27, 0xcd, 0x76, 0xc7, 0x6b, 0xbb, 0x7e,
+	0xc8, 0x48, 0x8b, 0x17, 0x87, 0x28, 0xc2, 0x10, 0x46, 0x6e, 0xc2, 0x90, 0xcf, 0xe8, 0x95, 0x07,
+	0x8b, 0xcc, 0x06, 0x7f, 0x0b, 0xf0, 0xfe, 0xa2, 0x40, 0x43, 0x3e, 0xa0, 0x9e, 0xe1, 0x43, 0xc5,
+	0xa5, 0x9f, 0x6c, 0x24, 0xf5, 0x88, 0x95, 0x8e, 0x75, 0x27, 0xdd, 0xa4, 0x8d, 0xe6, 0x2a, 0x9c,
+	0x96, 0x06, 0xce, 0x62, 0xd9, 0x30, 0xef, 0x9e, 0xb7, 0x6a, 0x2b, 0xe3, 0xd1, 0x84, 0x4d, 0xce,
+	0xd9, 0x64, 0xfd, 0x78, 0xc5, 0x98, 0x3e, 0x8c, 0x5e, 0x93, 0x9d, 0x24, 0x33, 0x50, 0xb2, 0xc3,
+	0x6f, 0x01, 0x12, 0x47, 0x69, 0x65, 0x11, 0x4b, 0x76, 0x0f, 0xde, 0x41, 0xea, 0xb5, 0x34, 0xd0,
+	0xfd, 0x5a, 0x32, 0xbf, 0x5a, 0x82, 0xb3, 0x21, 0xd5, 0x70, 0x8c, 0x8b, 0x52, 0xdd, 0x7e, 0xc0,
+	0xab, 0xea, 0x60, 0x59, 0xe5, 0x2d, 0x18, 0xe4, 0x07, 0x60, 0x21, 0x35, 0x7c, 0x84, 0x90, 0x75,
+	0x07, 0x39, 0x22, 0xf2, 0x41, 0x18, 0x6e, 0xb2, 0x27, 0x4a, 0xe8, 0xb6, 0x53, 0x48, 0xb2, 0x9b,
+	0x35, 0x5c, 0xf1, 0xf2, 0x91, 0xd1, 0xf6, 0x22, 0x4d, 0xaa, 0x28, 0x44, 0x49, 0x73, 0xe6, 0x29,
+	0x18, 0x57, 0xaa, 0x1d, 0x2a, 0xd4, 0xde, 0x8f, 0x96, 0xa0, 0x7c, 0x9d, 0x36, 0x5b, 0x99, 0xb6,
+	0x13, 0xb3, 0x30, 0x54, 0xdb, 0xb6, 0x3c, 0x11, 0xee, 0x72, 0x42, 0x2c, 0xf2, 0x0a, 0x2b, 0x40,
+	0x51, 0xce, 0x5e, 0x2c, 0x9a, 0xa3, 0xe2, 0xdb, 0x95, 0x99, 0x8c, 0xe3, 0xfa, 0xbe, 0x2f, 0x0a,
+	0xfc, 0x1b, 0x0f, 0x5c, 0xab, 0xc0, 0xae, 0x97, 0x77, 0x56, 0x6f, 0xdd, 0xcc, 0x72, 0x51, 0x64,
+	0x51, 0x07, 0xdc, 0x9a, 0x8d, 0xb4, 0xed, 0xfa, 0x76, 0xe0, 0x7a, 0xbb, 0xf2, 0xa3, 0x15, 0xba,
+	0x5a, 0x6e, 0x55, 0x56, 0x62, 0x44, 0x42, 0xff, 0xa8, 0x15, 0xa1, 0x4e, 0xca, 0xfc, 0x05, 0x03,
+	0xc6, 0xaf, 0xdb, 0x77, 0xa8, 0x27, 0x6c, 0xb8, 0xb9, 0x88, 0x45, 0x8b, 0x47, 0x38, 0x9e, 0x15,
+	0x8b, 0x90, 0xec, 0xc0, 0x98, 0xbc, 0x87, 0x23, 0x0f, 0xd4, 0x6b, 0xc5, 0x0c, 0x8e, 0x22, 0xd2,
+	0xf2, 0x7e, 0x53, 0x36, 0x7e, 0x58, 0xe2, 0x63, 0x4c, 0xcc, 0xfc, 0x00, 0x9c, 0xc9, 0x68, 0xc4,
+	0x3e, 0x24, 0x37, 0x63, 0x96, 0x9b, 0x26, 0x3c, 0xad, 0xd8, 0x87, 0xe4, 0xe5, 0xe4, 0x21, 0x18,
+	0xa0, 0x4e, 0x5d, 0xee, 0x98, 0x91, 0xfd, 0xbd, 0xd9, 0x81, 0x25, 0xa7, 0x8e, 0xac, 0x8c, 0x1d,
+	0xe2, 0x4d, 0x57, 0xe3, 0xd8, 0xf8, 0x21, 0xbe, 0x2a, 0xcb, 0x30, 0x82, 0x72, 0x13, 0xb1, 0xa4,
+	0x35, 0x14, 0x7b, 0x9a, 0x9c, 0xde, 0x4a, 0x9c, 0x2d, 0xfd, 0x18, 0x61, 0x25, 0xcf, 0xa9, 0x85,
+	0xb2, 0x9c, 0x90, 0xd4, 0x89, 0x87, 0x29, 0xba, 0xe6, 0xaf, 0x0d, 0xc2, 0xc3, 0xd7, 0x59, 0x0c,
+	0x3a, 0xd7, 0x09, 0xac, 0xe6, 0xba, 0x5b, 0x8f, 0x0d, 0x64, 0xe5, 0x95, 0xf5, 0x1d, 0x06, 0x5c,
+	0xa8, 0xb5, 0x3b, 0xd2, 0x5e, 0x59, 0xda, 0x98, 0xae, 0x53, 0xcf, 0x76, 0x8b, 0x3a, 0xed, 0x70,
+	0xd3, 0xee, 0xca, 0xfa, 0x66, 0x16, 0x4a, 0xcc, 0xa3, 0xc5, 0x7d, 0x87, 0xea, 0xee, 0x7d, 0x87,
+	0x77, 0xae, 0x1a, 0xf0, 0xd9, 0x7c, 0xa9, 0x9f, 0x88, 0xb0, 0x5c, 0xee, 0xb1, 0x98, 0x89, 0x11,
+	0x73, 0x28, 0x31, 0x8b, 0x5a, 0x69, 0x97, 0x8d, 0xd4, 0xaa, 0xdb, 0x0e, 0xf5, 0x7d, 0xe1, 0x78,
+	0xd0, 0x87, 0x73, 0xcc, 0x4a, 0x16, 0x42, 0xcc, 0xa6, 0x43, 0x5e, 0x00, 0xf0, 0x77, 0x9d, 0x9a,
+	0x9c, 0xff, 0x62, 0x96, 0xac, 0x82, 0x45, 0x8e, 0xb0, 0xa0, 0x82, 0x91, 0x3d, 0xb4, 0x82, 0x68,
+	0x51, 0x0e, 0x73, 0x6b, 0x64, 0xfe, 0xd0, 0x8a, 0xd7, 0x50, 0x0c, 0x67, 0xa2, 0x93, 0xa9, 0x15,
+	0x67, 0xbd, 0x69, 0xd5, 0xa8, 0x30, 0xcc, 0xf4, 0xc9, 0x55, 0x18, 0xf3, 0x23, 0x95, 0x8e, 0x38,
+	0x11, 0xe2, 0xfd, 0x19, 0x02, 0x30, 0xae, 0xc3, 0x2d, 0xf6, 0x6d, 0x47, 0x5e, 0x76, 0xcb, 0xae,
+	0x27, 0x10, 0xc9, 0x7d, 0x27, 0x2c, 0xf6, 0xd3, 0x60, 0xcc, 0x6a, 0x63, 0xfe, 0xa2, 0x01, 0x67,
+	0xf5, 0xee, 0x48, 0x9b, 0x88, 0x1f, 0x36, 0xe0, 0xac, 0xe6, 0x58, 0x2f, 0xc1, 0xfd, 0x84, 0x22,
+	0x5b, 0xcf, 0xc0, 0x27, 0x6c, 0x84, 0xb3, 0x20, 0x98, 0x49, 0xdf, 0xfc, 0x47, 0x06, 0x8c, 0xc8,
+	0xb0, 0xa4, 0xcc, 0x9e, 0x55, 0x13, 0xbf, 0x47, 0x57, 0x5b, 0x42, 0x04, 0xbf, 0xcb, 0x0d, 0x3c,
+	0xe4, 0xd5, 0xd4, 0x4f, 0x14, 0x6d, 0x49, 0x38, 0xbe, 0xe7, 0x34, 0x43, 0x0f, 0x59, 0x86, 0x0a,
+	0x31, 0xf3, 0x73, 0x06, 0x4c, 0xa7, 0x5a, 0xf5, 0xc0, 0x8e, 0x9e, 0xa0, 0xc9, 0xeb, 0x97, 0x06,
+	0xd9, 0x92, 0x0c, 0xd8, 0x79, 0xdf, 0x14, 0x92, 0xf1, 0x13, 0x78, 0xff, 0xbe, 0x16, 0xc6, 0xec,
+	0x56, 0xab, 0x13, 0x70, 0x2d, 0xe4, 0x50, 0x2c, 0x6e, 0x5e, 0x09, 0x0b, 0x31, 0x86, 0x13, 0x47,
+	0x72, 0x5a, 0xe2, 0x16, 0x5c, 0x2d, 0xf6, 0xe5, 0xd4, 0x01, 0xce, 0x31, 0xae, 0x48, 0xb0, 0x43,
+	0x59, 0x8c, 0xd8, 0x77, 0x1a, 0x00, 0x7e, 0xe0, 0xd9, 0x4e, 0x83, 0x15, 0x4a, 0x6e, 0x0c, 0x8f,
+	0x80, 0x6c, 0x35, 0x42, 0x2a, 0x88, 0xc7, 0xa1, 0x4a, 0x23, 0x00, 0x2a, 0x94, 0xc9, 0xbc, 0x64,
+	0x42, 0xc5, 0x95, 0xf9, 0xfa, 0x04, 0xbb, 0xfd, 0x70, 0x86, 0x29, 0xad, 0x20, 0x14, 0x73, 0xa9,
+	0x33, 0x6f, 0x81, 0xb1, 0x88, 0xde, 0x41, 0x4c, 0xdd, 0x84, 0xc2, 0xd4, 0xcd, 0x3c, 0x03, 0xa7,
+	0x12, 0xdd, 0x3d, 0x14, 0x4f, 0xf8, 0xc7, 0x06, 0x10, 0x7d, 0xf4, 0x27, 0x20, 0x39, 0x68, 0xe8,
+	0x92, 0x83, 0x85, 0xfe, 0x3f, 0x59, 0x8e, 0xe8, 0xe0, 0x67, 0x09, 0xf0, 0xa8, 0xcd, 0x51, 0xfa,
+	0x02, 0x79, 0xf3, 0x33, 0x46, 0x25, 0x8e, 0x06, 0x20, 0x77, 0x6e, 0x1f, 0x8c, 0xca, 0x8d, 0x04,
+	0xae, 0x98, 0x51, 0x49, 0x42, 0x30, 0x45, 0x97, 0x7c, 0xc2, 0x80, 0xd3, 0x96, 0x1e, 0xb5, 0x39,
+	0x9c, 0x99, 0x42, 0xc1, 0xdf, 0x12, 0x11, 0xa0, 0xe3, 0xbe, 0x24, 0x00, 0x3e, 0xa6, 0xc8, 0x32,
+	0x97, 0x37, 0xab, 0x6d, 0xb3, 0xb8, 0xc3, 0xec, 0xe5, 0x19, 0x06, 0xb7, 0xe5, 0xd2, 0x90, 0xf9,
+	0xf5, 0x95, 0xa8, 0x1c, 0xb5, 0x5a, 0x51, 0x78, 0xe4, 0x4a, 0x6c, 0x79, 0xdc, 0x4f, 0x78, 0x64,
+	0x39, 0x87, 0x71, 0x78, 0x64, 0x39, 0x75, 0x2a, 0x11, 0xe2, 0x00, 0xb8, 0x76, 0xbd, 0x26, 0x49,
+	0x0e, 0x17, 0x57, 0xa2, 0xdc, 0x5a, 0x59, 0xac, 0x48, 0x8a, 0x9c, 0x7d, 0x88, 0x7f, 0xa3, 0x42,
+	0x81, 0x39, 0xa4, 0x4f, 0xca, 0xb3, 0x5b, 0xd2, 0x1c, 0xe1, 0x9f, 0xe8, 0x3d, 0x45, 0xd7, 0x4b,
+	0x62, 0x4d, 0xce, 0xa1, 0x8a, 0x5c, 0x9c, 0x3b, 0x51, 0x30, 0x09, 0x0d, 0x86, 0x7a, 0x3f, 0x38,
+	0x0f, 0xe0, 0x6b, 0x5a, 0x2c, 0xd9, 0xc1, 0xd1, 0xe2, 0x3c, 0x40, 0x35, 0x03, 0x9f, 0xf4, 0x52,
+	0xca, 0x80, 0x60, 0x26, 0x7d, 0xc6, 0xd7, 0x9e, 0xba, 0x6f, 0x05, 0xb5, 0xed, 0x8a, 0x55, 0xdb,
+	0xe6, 0x4a, 0x4c, 0xe1, 0x5a, 0x5b, 0x70, 0x5d, 0xdf, 0xd6, 0x51, 0x2d, 0x9c, 0x61, 0x46, 0xb0,
+	0x89, 0x42, 0x4c, 0x12, 0x24, 0x2e, 0x53, 0x5a, 0x8a, 0x9c, 0x25, 0x65, 0x28, 0xce, 0x52, 0xa4,
+	0x12, 0xa0, 0x88, 0x97, 0x51, 0xf8, 0x0b, 0x23, 0x22, 0xcc, 0xeb, 0x4e, 0xbc, 0x0d, 0xe7, 0x1d,
+	0xd7, 0xd9, 0x6d, 0xb9, 0x1d, 0x9f, 0x05, 0xc7, 0xa6, 0x4e, 0x10, 0x8a, 0xc2, 0xc7, 0xf9, 0x35,
+	0xca, 0xbd, 0xee, 0x96, 0xba, 0x55, 0xc4, 0xee, 0x78, 0xc8, 0xf3, 0x30, 0xca, 0x15, 0x7d, 0x1b,
+	0x1b, 0xab, 0xe5, 0x89, 0xc3, 0x9c, 0xd1, 0x11, 0xbb, 0xcc, 0x87, 0xb0, 0x24, 0x71, 0x60, 0x84,
+	0x8d, 0xdc, 0x85, 0x91, 0xa6, 0x48, 0x3a, 0x53, 0x9e, 0x2c, 0x7e, 0x28, 0x26, 0x13, 0xd8, 0x88,
+	0x07, 0xb4, 0xfc, 0x81, 0x21, 0x05, 0xe6, 0x3c, 0x58, 0xa7, 0x5b, 0x56, 0xa7, 0x19, 0xdc, 0x74,
+	0x03, 0xe4, 0x2e, 0x6e, 0x91, 0xc4, 0x33, 0x74, 0xc8, 0x9e, 0xe2, 0xf1, 0xf8, 0xb8, 0xf3, 0xe0,
+	0xe2, 0x01, 0x75, 0xf1, 0x40, 0x6c, 0x64, 0x17, 0x1e, 0x91, 0x75, 0xb8, 0x4f, 0x5d, 0x6d, 0x9b,
+	0xcd, 0x72, 0x9a, 0xe8, 0x29, 0x4e, 0x94, 0xd9, 0x4a, 0x3d, 0xb2, 0x78, 0x70, 0x75, 0xec, 0x05,
+	0x27, 0x77, 0x53, 0xa2, 0x09, 0x15, 0x50, 0xf9, 0x74, 0xf1, 0x39, 0x4e, 0xaa, 0x93, 0x84, 0x3d,
+	0x5d, 0xb2, 0x14, 0x53, 0x34, 0xc9, 0xcf, 0x18, 0x50, 0xf6, 0x03, 0xaf, 0x53, 0x0b, 0x3a, 0x1e,
+	0xad, 0x27, 0x56, 0xe8, 0xf4, 0x15, 0xa3, 0x28, 0x03, 0x57, 0xcd, 0xc1, 0xc9, 0x43, 0x03, 0x94,
+	0xf3, 0xa0, 0x98, 0xdb, 0x17, 0xf2, 0x93, 0x06, 0x5c, 0xd0, 0x81, 0xec, 0x4d, 0x2f, 0xfa, 0x49,
+	0x8a, 0x2b, 0x59, 0xaa, 0xd9, 0x28, 0xc5, 0x0b, 0x3e, 0x07, 0x88, 0x79, 0x1d, 0x49, 0xaa, 0xd6,
+	0xcf, 0x9c, 0xb0, 0x6a, 0x7d, 0xe6, 0x1d, 0x40, 0xd2, 0xd7, 0xc7, 0x41, 0x7c, 0xe0, 0xa8, 0xca,
+	0x07, 0xfe, 0xc8, 0x10, 0x5c, 0x64, 0xb7, 0x52, 0xfc, 0xfa, 0x59, 0xb3, 0x1c, 0xab, 0xf1, 0xf2,
+	0xe4, 0x98, 0x7e, 0xc1, 0x80, 0x0b, 0xdb, 0xd9, 0xa2, 0x1d, 0xf9, 0xfe, 0x7a, 0x57, 0x21, 0x11,
+	0x5c, 0x37, 0x69, 0x91, 0x38, 0xb0, 0xbb, 0x56, 0xc1, 0xbc, 0x4e, 0x91, 0x77, 0xc0, 0x69, 0xe6,
+	0xc6, 0x5d, 0x59, 0x59, 0xc4, 0x35, 0xcb, 0xbf, 0x5b, 0x0d, 0x2d, 0x7d, 0x86, 0xc4, 0x7e, 0xbd,
+	0x99, 0x80, 0x61, 0xaa, 0x36, 0xf3, 0x7b, 0x6d, 0xbb, 0xf5, 0xa5, 0x7b, 0x22, 0x47, 0x4b, 0x7f,
+	0x36, 0xb7, 0xdc, 0xda, 0x60, 0x3d, 0x85, 0x0d, 0x33, 0x28, 0x70, 0xd9, 0x14, 0xeb, 0xcc, 0x9a,
+	0xeb, 0xd8, 0x81, 0xeb, 0xf1, 0x60, 0x17, 0x7d, 0x89, 0x68, 0xb8, 0x6c, 0xea, 0x66, 0x26, 0x46,
+	0xcc, 0xa1, 0x64, 0xfe, 0x95, 0x01, 0xa7, 0xd8, 0xb2, 0x58, 0xf7, 0xdc, 0x9d, 0xdd, 0x97, 0xe3,
+	0x82, 0x7c, 0x5c, 0x1a, 0x3d, 0x0a, 0xd9, 0xce, 0x39, 0xc5, 0xe0, 0x71, 0x8c, 0xf7, 0x39, 0xb6,
+	0x71, 0x54, 0xc5, 0xca, 0x03, 0xf9, 0x62, 0x65, 0xf3, 0xd3, 0x25, 0xf1, 0x72, 0x09, 0xc5, 0xba,
+	0x2f, 0xcb, 0x7d, 0xf8, 0x16, 0x98, 0x64, 0x65, 0x6b, 0xd6, 0xce, 0xfa, 0xe2, 0x73, 0x6e, 0x33,
+	0x74, 0xe7, 0xe6, 0xb2, 0xf6, 0x1b, 0x2a, 0x00, 0xf5, 0x7a, 0xe4, 0x69, 0x66, 0x19, 0xc8, 0x63,
+	0xc6, 0xc9, 0x37, 0xf3, 0x15, 0x61, 0x19, 0xc8, 0x8b, 0x1e, 0xec, 0xcd, 0x4e, 0xc7, 0x2a, 0x5e,
+	0x59, 0x88, 0x61, 0x03, 0xf3, 0xd7, 0xce, 0x03, 0x47, 0xde, 0xa4, 0xc1, 0xcb, 0x71, 0x4e, 0x9e,
+	0x80, 0xf1, 0x5a, 0xbb, 0x53, 0x59, 0xae, 0xbe, 0xab, 0xe3, 0x72, 0x59, 0x08, 0x4f, 0x65, 0xc4,
+	0x4e, 0xef, 0xca, 0xfa, 0x66, 0x58, 0x8c, 0x6a, 0x1d, 0x76, 0x3a, 0xd4, 0xda, 0x1d, 0x79, 0xde,
+	0xae, 0xab, 0x0e, 0x2f, 0xfc, 0x74, 0xa8, 0xac, 0x6f, 0x6a, 0x30, 0x4c, 0xd5, 0x26, 0x1f, 0x81,
+	0x09, 0x2a, 0x37, 0xee, 0x75, 0x96, 0xfd, 0x48, 0x9c, 0x0b, 0x2b, 0x45, 0x07, 0x1f, 0x4d, 0x6d,
+	0x78, 0x1a, 0x88, 0x17, 0xe0, 0x92, 0x42, 0x02, 0x35, 0x82, 0xcc, 0xee, 0x26, 0xfc, 0xcd, 0xbe,
+	0xb2, 0x5b, 0x4f, 0x1e, 0x14, 0x43, 0xc2, 0xee, 0x66, 0x29, 0xaf, 0x12, 0xe6, 0xb7, 0x27, 0x3f,
+	0x6f, 0xc0, 0xf9, 0x08, 0x6a, 0x3b, 0x76, 0xab, 0xd3, 0x42, 0x5a, 0x6b, 0x5a, 0x76, 0x4b, 0xbe,
+	0xfb, 0x6e, 0x1f, 0xd9, 0x40, 0x75, 0xf4, 0xe2, 0xb0, 0xca, 0x86, 0x61, 0x4e, 0x97, 0xc8, 0xe7,
+	0x0c, 0xb8, 0x12, 0x82, 0xd6, 0x3d, 0xea, 0xfb, 0x4c, 0x2b, 0x11, 0x05, 0x13, 0x90, 0x53, 0x32,
+	0x52, 0xe8, 0xec, 0xe4, 0x0c, 0xf0, 0xd2, 0x01, 0xb8, 0xf1, 0x40, 0xea, 0xea, 0x72, 0xa9, 0xba,
+	0x5b, 0x41, 0x79, 0xf4, 0x58, 0x97, 0x0b, 0x23, 0x81, 0x1a, 0x41, 0xf2, 0x8b, 0x06, 0x5c, 0x50,
+	0x0b, 0xd4, 0xd5, 0x22, 0x5e, 0x88, 0xcf, 0x1f, 0x59, 0x67, 0x12, 0xf8, 0x05, 0x87, 0x97, 0x03,
+	0xc4, 0xbc, 0x5e, 0xb1, 0x63, 0xbb, 0xc5, 0x17, 0xa6, 0x78, 0x45, 0x0e, 0x89, 0x63, 0x5b, 0xac,
+	0x55, 0x1f, 0x43, 0x18, 0x93, 0x9f, 0xb4, 0xdd, 0xfa, 0xba, 0x5d, 0xf7, 0x79, 0x3c, 0x3b, 0xfe,
+	0xd6, 0x1b, 0x10, 0xd3, 0xb1, 0xee, 0xd6, 0xd7, 0x57, 0x16, 0x45, 0x39, 0x6a, 0xb5, 0x98, 0x05,
+	0x34, 0x53, 0x5f, 0x55, 0xef, 0x5b, 0xed, 0x5b, 0x61, 0x80, 0x24, 0x2e, 0x8b, 0x58, 0x8e, 0x4a,
+	0x51, 0xa9, 0xc1, 0xbe, 0x1f, 0x3b, 0x77, 0x90, 0x8a, 0x10, 0xe4, 0xe5, 0xa9, 0x23, 0xfa, 0x7e,
+	0x21, 0x42, 0xd1, 0xe1, 0x1b, 0x0a, 0x09, 0xd4, 0x08, 0x32, 0xcd, 0xd9, 0x94, 0xbf, 0xeb, 0x07,
+	0xb4, 0x15, 0xf5, 0xe1, 0xd4, 0x51, 0xf7, 0x81, 0xcb, 0xc4, 0xab, 0x1a, 0x11, 0x4c, 0x10, 0xe5,
+	0xa1, 0xa6, 0x5a, 0x56, 0x83, 0x5e, 0xab, 0x30, 0x5d, 0x64, 0x14, 0x1e, 0x66, 0x9d, 0x7a, 0x35,
+	0xe6, 0x79, 0x75, 0x9a, 0x7f, 0x29, 0x11, 0x6a, 0x2a, 0xbf, 0x1a, 0x76, 0xc3, 0x41, 0x5e, 0x80,
+	0x19, 0x09, 0x5e, 0x75, 0xef, 0xa7, 0x28, 0x88, 0x88, 0x4e, 0xdc, 0x82, 0x72, 0x25, 0xb7, 0x16,
+	0x76, 0xc1, 0xc0, 0xb4, 0x44, 0x3e, 0xf5, 0xb8, 0x4e, 0x50, 0x44, 0x07, 0x5d, 0xef, 0x34, 0x9b,
+	0x7e, 0x99, 0xc4, 0x3e, 0x43, 0xd5, 0x34, 0x18, 0xb3, 0xda, 0x90, 0x67, 0x22, 0xbf, 0xe2, 0x5d,
+	0x56, 0xf0, 0xae, 0xf5, 0x2a, 0x7f, 0x89, 0x0c, 0x09, 0x49, 0x09, 0xea, 0x20, 0x4c, 0xd6, 0x65,
+	0xb7, 0x79, 0x58, 0xb4, 0xd0, 0xf1, 0xfc, 0x80, 0xc7, 0x59, 0x1a, 0x12, 0xb7, 0x39, 0xaa, 0x00,
+	0xd4, 0xeb, 0x31, 0x17, 0x0d, 0x9f, 0xd6, 0x6a, 0x6e, 0xab, 0x2d, 0xdf, 0xc9, 0x3c, 0x18, 0xd2,
+	0xa8, 0xfc, 0x82, 0x1a, 0x04, 0x13, 0x35, 0xc9, 0x2e, 0x9c, 0x89, 0xc2, 0x29, 0xaf, 0xba, 0x0d,
+	0x16, 0x16, 0x8b, 0x31, 0xc7, 0xe7, 0x8b, 0xc4, 0x88, 0x10, 0xd3, 0x55, 0x49, 0xa3, 0xc3, 0x2c,
+	0x1a, 0x2c, 0xf5, 0x5c, 0xa2, 0x78, 0xd9, 0x66, 0x4a, 0xfc, 0x0b, 0x7c, 0xd8, 0x5c, 0xd8, 0x55,
+	0xc9, 0x80, 0x63, 0x66, 0x2b, 0x72, 0x0b, 0xce, 0xb5, 0x3d, 0x37, 0xa0, 0xb5, 0xe0, 0x06, 0xf5,
+	0x1c, 0xda, 0x94, 0x03, 0xf4, 0xcb, 0x65, 0x3e, 0x17, 0x5c, 0x1f, 0xba, 0x9e, 0x55, 0x01, 0xb3,
+	0xdb, 0x91, 0x1f, 0x31, 0xe0, 0xb2, 0x1f, 0x78, 0xd4, 0x6a, 0xd9, 0x4e, 0xa3, 0xe2, 0x3a, 0x0e,
+	0xe5, 0x07, 0xd3, 0x4a, 0x3d, 0x76, 0xb9, 0x7b, 0xa8, 0xd0, 0x2d, 0x62, 0xee, 0xef, 0xcd, 0x5e,
+	0xae, 0x76, 0xc5, 0x8c, 0x07, 0x50, 0x66, 0xc6, 0x8e, 0x2d, 0xda, 0x72, 0xbd, 0x5d, 0x76, 0x22,
+	0x95, 0x67, 0x8a, 0xbf, 0x77, 0xd7, 0x22, 0x2c, 0x62, 0xfb, 0x6b, 0x9a, 0xdc, 0x18, 0x88, 0x0a,
+	0x39, 0x36, 0xd5, 0xec, 0xbc, 0x95, 0x11, 0xcd, 0x94, 0x4d, 0x73, 0x91, 0x7f, 0x39, 0x3e, 0xd5,
+	0x6b, 0x59, 0x15, 0x30, 0xbb, 0x1d, 0xf1, 0x61, 0x9a, 0xef, 0x50, 0x79, 0x93, 0x5f, 0xab, 0xcc,
+	0x37, 0x68, 0xf9, 0x52, 0xa1, 0xc9, 0x65, 0xbc, 0xff, 0xf4, 0x4a, 0x12, 0x19, 0xa6, 0xf1, 0xc7,
+	0x44, 0xad, 0x9d, 0x98, 0xe8, 0xc3, 0xfd, 0x12, 0xb5, 0x76, 0x52, 0x44, 0x95, 0x22, 0x73, 0xaf,
+	0x04, 0xe7, 0x32, 0x6f, 0x49, 0x76, 0x78, 0x88, 0x29, 0x9e, 0x0f, 0x53, 0xd5, 0x49, 0xb5, 0x27,
+	0x3f, 0x3c, 0xd6, 0x74, 0x10, 0x26, 0xeb, 0x32, 0x1e, 0x96, 0x53, 0x5b, 0xae, 0xc6, 0xed, 0x4b,
+	0x31, 0x0f, 0xbb, 0x92, 0x80, 0x61, 0xaa, 0x36, 0xa9, 0xc8, 0xf9, 0x58, 0xae, 0xae, 0xb0, 0x67,
+	0xa0, 0xbf, 0xec, 0xd1, 0xf0, 0x75, 0x10, 0x8f, 0x4f, 0x05, 0x62, 0xba, 0x3e, 0x1b, 0x05, 0xfb,
+	0xa1, 0xf6, 0x62, 0x30, 0x1e, 0xc5, 0x4d, 0x1d, 0x84, 0xc9, 0xba, 0xe1, 0x3b, 0x5d, 0xeb, 0xc2,
+	0x50, 0x3c, 0x8a, 0x9b, 0x09, 0x18, 0xa6, 0x6a, 0x9b, 0x7f, 0x32, 0x08, 0x8f, 0xf4, 0xc0, 0x59,
+	0x92, 0x56, 0xf6, 0x74, 0x1f, 0xfe, 0xcc, 0xeb, 0xed, 0xf3, 0xb4, 0x73, 0x3e, 0xcf, 0xe1, 0xe9,
+	0xf5, 0xfa, 0x39, 0xfd, 0xbc, 0xcf, 0x79, 0x78, 0x92, 0xbd, 0x7f, 0xfe, 0x56, 0xf6, 0xe7, 0x2f,
+	0x38, 0xab, 0x07, 0x2e, 0x97, 0x76, 0xce, 0x72, 0x29, 0x38, 0xab, 0x3d, 0x2c, 0xaf, 0x3f, 0x1d,
+	0x84, 0x47, 0x7b, 0xe1, 0x72, 0x0b, 0xae, 0xaf, 0x8c, 0xb3, 0xe5, 0x58, 0xd7, 0x57, 0x9e, 0x43,
+	0xf8, 0x31, 0xae, 0xaf, 0xae, 0xc7, 0xe7, 0xf1, 0xac, 0xaf, 0xbc, 0x59, 0x3d, 0xae, 0xf5, 0x95,
+	0x37, 0xab, 0x3d, 0xac, 0xaf, 0xbf, 0x4e, 0xde, 0x0f, 0x11, 0xab, 0xbd, 0x02, 0x03, 0xb5, 0x76,
+	0xa7, 0xe0, 0x21, 0xc5, 0xad, 0x0c, 0x2b, 0xeb, 0x9b, 0xc8, 0x70, 0x10, 0x84, 0x61, 0xb1, 0x7e,
+	0x0a, 0x1e, 0x41, 0xdc, 0x72, 0x54, 0x2c, 0x49, 0x94, 0x98, 0xd8, 0x54, 0xd1, 0xf6, 0x36, 0x6d,
+	0x51, 0xcf, 0x6a, 0x56, 0x03, 0xd7, 0xb3, 0x1a, 0x45, 0x4f, 0x1b, 0xa1, 0x41, 0x49, 0xe0, 0xc2,
+	0x14, 0x76, 0x36, 0x21, 0x6d, 0xbb, 0x5e, 0x1e, 0x2c, 0x3e, 0x21, 0xeb, 0x2b, 0x8b, 0xc8, 0x70,
+	0x98, 0xbf, 0x31, 0x0a, 0x4a, 0xa2, 0x02, 0x26, 0xcf, 0x9a, 0xae, 0x25, 0x83, 0x7a, 0xf6, 0x63,
+	0x0f, 0x95, 0x8a, 0x10, 0x2a, 0x96, 0x7c, 0xaa, 0x18, 0xd3, 0x64, 0xc9, 0xb7, 0x1a, 0x42, 0xc8,
+	0x17, 0x69, 0xf3, 0xe4, 0xb4, 0x5e, 0x3b, 0x22, 0xbd, 0x77, 0x2c, 0x2d, 0x8c, 0x00, 0xa8, 0x13,
+	0x64, 0x12, 0x95, 0x73, 0x77, 0xb3, 0x74, 0x13, 0xe5, 0xc1, 0xe2, 0x11, 0x1e, 0xba, 0x28, 0x3b,
+	0x04, 0x07, 0x99, 0x59, 0x01, 0xb3, 0x3b, 0x12, 0xcd, 0x52, 0x24, 0xae, 0x2d, 0x0f, 0xf5, 0x37,
+	0x4b, 0x09, 0xb9, 0x6f, 0x3c, 0x4b, 0x11, 0x00, 0x75, 0x82, 0xcc, 0x81, 0xfd, 0x6e, 0x28, 0x23,
+	0x2f, 0x0f, 0x17, 0x57, 0xb3, 0x27, 0x04, 0xed, 0xc2, 0xde, 0x2b, 0x2a, 0xc4, 0x98, 0x08, 0xd9,
+	0x86, 0x91, 0xbb, 0xe2, 0xac, 0x28, 0x8f, 0x14, 0xb7, 0xd3, 0xd6, 0x8e, 0x1b, 0x21, 0x56, 0x91,
+	0x45, 0x18, 0xa2, 0x57, 0x7d, 0x09, 0x46, 0x0f, 0x70, 0x71, 0xfb, 0x11, 0x03, 0xce, 0xdd, 0xa3,
+	0x5e, 0x60, 0xd7, 0x92, 0x9a, 0xa1, 0xb1, 0xe2, 0x12, 0x8a, 0xe7, 0xb2, 0x10, 0x8a, 0x65, 0x92,
+	0x09, 0xc2, 0xec, 0x2e, 0x90, 0xe7, 0x60, 0x90, 0x06, 0xb5, 0xba, 0x0c, 0xe6, 0xfd, 0xd6, 0xa2,
+	0x3e, 0xbf, 0xc2, 0xef, 0x85, 0xfd, 0x87, 0x1c, 0x9f, 0xf9, 0x17, 0x06, 0xa4, 0x64, 0xd3, 0xe4,
+	0x7b, 0x0d, 0x98, 0xd8, 0xa2, 0x56, 0xd0, 0xf1, 0xe8, 0x35, 0x69, 0x13, 0xca, 0x0c, 0x56, 0x9e,
+	0x3b, 0x0a, 0x91, 0xf8, 0xdc, 0xb2, 0x82, 0x58, 0x18, 0xab, 0x44, 0xc9, 0x47, 0x54, 0x10, 0x6a,
+	0x3d, 0x98, 0x79, 0x16, 0xa6, 0x53, 0x0d, 0x0f, 0xa5, 0xa6, 0xfc, 0x17, 0x06, 0x64, 0xa5, 0xe1,
+	0x27, 0x2f, 0xc0, 0x90, 0x55, 0xaf, 0x47, 0xe9, 0x53, 0x9f, 0x2a, 0x66, 0x37, 0x55, 0x57, 0x43,
+	0x0d, 0xf1, 0x9f, 0x28, 0xd0, 0xb2, 0xd8, 0xe8, 0x96, 0xa6, 0x97, 0x5e, 0x8b, 0x63, 0x5c, 0x70,
+	0x75, 0xda, 0x7c, 0x0a, 0x8a, 0x19, 0x2d, 0xcc, 0x8f, 0x1b, 0x40, 0xd2, 0xe9, 0x6a, 0x88, 0x07,
+	0xa3, 0x72, 0xfd, 0x86, 0x5f, 0x69, 0xb1, 0xa0, 0x37, 0x9d, 0xe6, 0x1a, 0x1a, 0x1b, 0xe1, 0xc9,
+	0x02, 0x1f, 0x23, 0x3a, 0x2c, 0xde, 0x5a, 0x9c, 0x12, 0x91, 0xbc, 0x09, 0xc6, 0xeb, 0xd4, 0xaf,
+	0x79, 0x76, 0x3b, 0x88, 0x1d, 0x49, 0x23, 0x87, 0xb4, 0xc5, 0x18, 0x84, 0x6a, 0x3d, 0x16, 0x59,
+	0x23, 0xb0, 0xfc, 0xbb, 0x2b, 0x8b, 0xf2, 0xb1, 0xc7, 0xaf, 0xe6, 0x0d, 0x5e, 0x82, 0x12, 0x12,
+	0xc7, 0x9e, 0x1d, 0xe8, 0x21, 0xf6, 0x2c, 0x73, 0x51, 0xed, 0x3b, 0xd0, 0x2e, 0x39, 0x38, 0xc8,
+	0xae, 0xf9, 0xd3, 0x25, 0x38, 0xc5, 0xaa, 0x30, 0x9f, 0xfc, 0x80, 0x3a, 0xdc, 0x6d, 0xaa, 0xe0,
+	0x24, 0x34, 0x60, 0x32, 0xd0, 0xbc, 0x9e, 0x0f, 0xef, 0x54, 0x1b, 0x59, 0x7a, 0xe9, 0xbe, 0xce,
+	0x3a, 0x5e, 0xf2, 0x54, 0xe8, 0xb7, 0x26, 0x9e, 0xc5, 0x8f, 0x84, 0x4b, 0x95, 0x3b, 0xa3, 0x3d,
+	0x90, 0x2e, 0xe4, 0x51, 0x1e, 0x4d, 0xcd, 0x45, 0xed, 0x2d, 0x30, 0x29, 0x3d, 0x24, 0x44, 0x10,
+	0x61, 0xf9, 0x2c, 0xe6, 0xd7, 0xca, 0xb2, 0x0a, 0x40, 0xbd, 0x9e, 0xf9, 0x07, 0x25, 0xd0, 0xb3,
+	0x75, 0x16, 0x9d, 0xa5, 0x74, 0x04, 0xe5, 0xd2, 0xb1, 0x45, 0x50, 0x16, 0xe9, 0xf4, 0xb9, 0x39,
+	0xb7, 0xd4, 0xb3, 0xab, 0xa9, 0xb4, 0x79, 0x39, 0x46, 0x35, 0xe2, 0x69, 0x1d, 0x3c, 0xf4, 0xb4,
+	0xbe, 0x49, 0x5a, 0xfe, 0x0e, 0x69, 0x71, 0xac, 0x43, 0xcb, 0xdf, 0x69, 0xad, 0xa1, 0xe2, 0x65,
+	0x37, 0x0f, 0x32, 0xdf, 0x0d, 0xfb, 0x2e, 0x32, 0xc6, 0xb6, 0xbf, 0xe1, 0x06, 0x56, 0xb3, 0x6c,
+	0xc4, 0x42, 0xd7, 0x35, 0x15, 0x80, 0x7a, 0x3d, 0xf3, 0x26, 0xbc, 0x7a, 0xd5, 0xb5, 0xea, 0x0b,
+	0x56, 0x93, 0x2d, 0x5d, 0x4f, 0x9a, 0xe5, 0xf9, 0xfc, 0x66, 0x66, 0x72, 0x46, 0xb7, 0xe6, 0x36,
+	0xd9, 0xbd, 0x69, 0x45, 0xc1, 0x86, 0xb9, 0x22, 0x32, 0xbc, 0x37, 0x65, 0xb0, 0x5e, 0x0c, 0xe1,
+	0xe6, 0xef, 0x1a, 0x30, 0x22, 0x73, 0xe0, 0xf4, 0xe0, 0x58, 0xca, 0x7c, 0x7f, 0x79, 0xe6, 0xd0,
+	0x3e, 0xb8, 0x52, 0x9e, 0x8b, 0x56, 0x4b, 0x9e, 0x25, 0xd2, 0xcf, 0xb1, 0x7f, 0x51, 0xa0, 0xe7,
+	0xf6, 0xa8, 0x5e, 0x6d, 0xdb, 0x0e, 0x28, 0x37, 0xbb, 0x91, 0x0b, 0x5f, 0xd8, 0xa3, 0x2a, 0xe5,
+	0xa8, 0xd5, 0x32, 0xff, 0xfb, 0x10, 0x5c, 0x91, 0x88, 0x53, 0xac, 0x5a, 0x74, 0xe6, 0xee, 0x46,
+	0x09, 0x86, 0x78, 0x82, 0x84, 0x50, 0xa6, 0x5a, 0xec, 0x95, 0x7c, 0x41, 0x49, 0x21, 0xa4, 0xa2,
+	0xc3, 0x2c, 0x1a, 0x22, 0x7c, 0x3b, 0x2f, 0xbe, 0x4e, 0xad, 0x66, 0xb0, 0x1d, 0xd2, 0x2e, 0xf5,
+	0x13, 0xbe, 0x3d, 0x8d, 0x0f, 0x33, 0xa9, 0x70, 0x93, 0x0e, 0x09, 0xa8, 0x78, 0xd4, 0x52, 0xed,
+	0x49, 0xfa, 0x70, 0x37, 0x5a, 0xcb, 0xc4, 0x88, 0x39, 0x94, 0xb8, 0xb8, 0xd1, 0xda, 0xe1, 0xd2,
+	0x0b, 0xa4, 0x81, 0x67, 0xf3, 0x24, 0x68, 0x91, 0xae, 0x62, 0x4d, 0x07, 0x61, 0xb2, 0x2e, 0x53,
+	0x39, 0x70, 0x13, 0x99, 0x38, 0x1e, 0xe8, 0x50, 0x1c, 0x15, 0xea, 0xa6, 0x06, 0xc1, 0x44, 0x4d,
+	0xf2, 0x6d, 0x06, 0x9c, 0xb5, 0x55, 0x67, 0x9a, 0x70, 0xf4, 0xc3, 0xfd, 0xe4, 0x95, 0x10, 0xcb,
+	0x38, 0x03, 0x2d, 0x66, 0x12, 0x63, 0xda, 0x07, 0xe9, 0x11, 0xad, 0xaf, 0x01, 0x11, 0x2c, 0x8e,
+	0x7f, 0xd3, 0xc5, 0x0c, 0x38, 0x66, 0xb6, 0x32, 0x3f, 0x5a, 0x82, 0x89, 0x43, 0x26, 0xb4, 0xed,
+	0x28, 0x3c, 0x47, 0x1f, 0x7e, 0x8b, 0x2a, 0xd5, 0x1e, 0xd8, 0x0e, 0xf2, 0x3c, 0x4c, 0x75, 0xf8,
+	0x44, 0x84, 0xa1, 0xd4, 0xe4, 0x9e, 0xfe, 0x06, 0xf6, 0xe5, 0x36, 0x35, 0x08, 0x8b, 0xf1, 0xa9,
+	0xa2, 0xd7, 0xa1, 0x98, 0xc0, 0x63, 0xfe, 0x56, 0x09, 0x88, 0x5a, 0x7d, 0xb9, 0x69, 0xdd, 0x73,
+	0x3d, 0x66, 0x40, 0x38, 0x51, 0x53, 0x53, 0x88, 0x09, 0x06, 0xeb, 0xf9, 0x7e, 0x07, 0x2b, 0xd0,
+	0xcf, 0xa9, 0xb9, 0xc1, 0x04, 0x23, 0x1c, 0xba, 0x5b, 0x4f, 0xa8, 0xa0, 0x07, 0x89, 0xdf, 0xa8,
+	0xf5, 0x69, 0xe6, 0x3b, 0x0c, 0x98, 0x4e, 0x61, 0xca, 0xe0, 0x8c, 0xdf, 0xa3, 0x07, 0x51, 0x3f,
+	0x92, 0x64, 0xa1, 0x2a, 0x7f, 0xfd, 0xe3, 0x43, 0x70, 0x26, 0xe3, 0x8b, 0x72, 0x13, 0x1b, 0x9a,
+	0xe0, 0x2e, 0xfb, 0x31, 0xb1, 0x49, 0x71, 0xaa, 0x91, 0x89, 0x4d, 0x12, 0x82, 0x29, 0xba, 0xe4,
+	0x39, 0x18, 0xa8, 0x79, 0xb6, 0x5c, 0xb4, 0x6f, 0x29, 0x34, 0x05, 0xb8, 0xb2, 0x30, 0x2e, 0x29,
+	0xb2, 0xec, 0xb3, 0xc8, 0x10, 0xb2, 0xbb, 0x58, 0xbd, 0x46, 0x42, 0x86, 0x95, 0xdf, 0xc5, 0xea,
+	0x6d, 0xe3, 0xa3, 0x5e, 0x8f, 0x3c, 0x0f, 0x65, 0xf9, 0x52, 0x95, 0x5d, 0xac, 0xb8, 0x8e, 0x1f,
+	0xb0, 0x13, 0x3f, 0x90, 0x3c, 0x05, 0xb7, 0x7e, 0xbd, 0x91, 0x53, 0x07, 0x73, 0x5b, 0x93, 0x0f,
+	0xc3, 0x94, 0x76, 0x7a, 0x84, 0x61, 0xcd, 0x0a, 0xba, 0xcc, 0xa8, 0x98, 0xc4, 0x59, 0xa9, 0x97,
+	0x61, 0x82, 0x1a, 0xf9, 0xff, 0x98, 0x28, 0x2a, 0x5a, 0x2f, 0x62, 0xc9, 0x87, 0xd9, 0x1c, 0x97,
+	0x8f, 0x66, 0x07, 0x29, 0xa9, 0x76, 0x93, 0x84, 0x30, 0x4d, 0xdb, 0xfc, 0xab, 0x61, 0x50, 0xb3,
+	0x7b, 0x93, 0xb5, 0x7e, 0xe4, 0x92, 0xf1, 0x1a, 0x08, 0x65, 0x93, 0x6b, 0x30, 0xd0, 0x68, 0x77,
+	0xca, 0xa5, 0xfe, 0xd0, 0x5d, 0x63, 0xe8, 0x1a, 0xed, 0x0e, 0x79, 0x2e, 0x12, 0x75, 0x16, 0x13,
+	0x46, 0x46, 0xae, 0x92, 0x09, 0x71, 0x67, 0x78, 0xbc, 0x0f, 0xe6, 0x1e, 0xef, 0x2d, 0x18, 0xf1,
+	0xa5, 0x1c, 0x74, 0xa8, 0x78, 0x1c, 0x4a, 0x65, 0xa6, 0xa5, 0xdc, 0x53, 0x48, 0x68, 0xe4, 0x0f,
+	0x0c, 0x69, 0xb0, 0x87, 0x60, 0x87, 0xdf, 0x4b, 0x32, 0xca, 0x12, 0x7f, 0x08, 0x6e, 0xf2, 0x12,
+	0x94, 0x90, 0x14, 0x33, 0x37, 0xd2, 0x0b, 0x33, 0x47, 0x7e, 0x34, 0x79, 0x7e, 0x8f, 0x5e, 0x19,
+	0x28, 0x6a, 0xe1, 0xab, 0x0c, 0xe7, 0x68, 0x0e, 0x6e, 0x76, 0x20, 0xb7, 0x3d, 0xbb, 0x46, 0xcb,
+	0x63, 0xc5, 0x05, 0x0f, 0xeb, 0x0c, 0x81, 0x60, 0x7e, 0xf9, 0xbf, 0x28, 0x50, 0xbe, 0x6c, 0x2e,
+	0x85, 0xff, 0x37, 0xbe, 0x58, 0x95, 0x85, 0x40, 0x1e, 0x81, 0x21, 0x1e, 0xa1, 0x49, 0xf2, 0x18,
+	0x91, 0xe0, 0x84, 0xc7, 0xe8, 0x41, 0x01, 0x23, 0x55, 0x19, 0xd7, 0xb0, 0xd8, 0x86, 0xe2, 0x76,
+	0x93, 0x92, 0x9e, 0x12, 0x04, 0xf1, 0x8a, 0xe6, 0x6f, 0x99, 0xf5, 0x3e, 0xd9, 0x64, 0x31, 0x5e,
+	0x1d, 0xd6, 0xa4, 0xa0, 0x80, 0x5e, 0x98, 0x77, 0x09, 0x14, 0x18, 0xe2, 0x62, 0x99, 0x47, 0xc6,
+	0x55, 0x81, 0xc1, 0xae, 0x88, 0xd2, 0x2b, 0x3d, 0xbb, 0x8d, 0xe2, 0x02, 0x46, 0x05, 0xe9, 0x7c,
+	0x84, 0x50, 0x18, 0x41, 0xc4, 0xbf, 0x51, 0x21, 0xc6, 0x48, 0x07, 0x76, 0x8b, 0xde, 0xb6, 0x9d,
+	0xba, 0x7b, 0xbf, 0x5c, 0x3a, 0x12, 0xd2, 0x1b, 0x11, 0x42, 0x41, 0x3a, 0xfe, 0x8d, 0x0a, 0x31,
+	0x76, 0xdd, 0xf1, 0x4c, 0x21, 0x0e, 0xcf, 0xf8, 0x2c, 0xfb, 0x26, 0xf2, 0xb9, 0x4b, 0x9b, 0x66,
+	0x7e, 0xdd, 0x55, 0x72, 0xea, 0x60, 0x6e, 0x6b, 0xf3, 0xe7, 0x0d, 0x38, 0x97, 0x39, 0x15, 0xe4,
+	0x1a, 0x4c, 0xc7, 0xa6, 0xb6, 0x2a, 0x03, 0x32, 0x1a, 0xdf, 0x1f, 0x37, 0x92, 0x15, 0x30, 0xdd,
+	0x46, 0xe4, 0xd1, 0x4b, 0x31, 0x38, 0xd2, 0x4e, 0x57, 0x7d, 0xc6, 0xa9, 0x60, 0xcc, 0x6a, 0x63,
+	0xbe, 0x57, 0xeb, 0x6c, 0x3c, 0x59, 0x6c, 0x67, 0xdc, 0xa1, 0x0d, 0xdb, 0x49, 0xee, 0x8c, 0x05,
+	0x56, 0x88, 0x02, 0x46, 0x1e, 0x56, 0xc3, 0x70, 0x44, 0x37, 0x47, 0x18, 0x8a, 0x83, 0x49, 0x3a,
+	0x2f, 0xac, 0x59, 0x4e, 0xc7, 0x6a, 0x0a, 0xc7, 0xfa, 0x75, 0xd7, 0x6d, 0xca, 0x69, 0xea, 0x96,
+	0xd7, 0xdf, 0x78, 0xb9, 0xe4, 0xf5, 0x7f, 0x1f, 0x5c, 0xc8, 0xb1, 0xed, 0x21, 0x8b, 0x30, 0xe1,
+	0xdf, 0xb7, 0xda, 0x0b, 0x74, 0xdb, 0xba, 0x67, 0xcb, 0xa0, 0x5d, 0xc2, 0x04, 0x7c, 0xa2, 0xaa,
+	0x94, 0x3f, 0x48, 0xfc, 0x46, 0xad, 0x95, 0x19, 0x00, 0x48, 0x57, 0x01, 0xe6, 0x45, 0xb6, 0x05,
+	0xa3, 0x56, 0x93, 0x7a, 0x41, 0x1c, 0xd9, 0xf2, 0x1b, 0x0b, 0xc9, 0x80, 0x25, 0x0e, 0xe1, 0x1a,
+	0x17, 0xfe, 0xc2, 0x08, 0xb7, 0xf9, 0x0f, 0x0d, 0x38, 0x9f, 0x1d, 0xa6, 0xa9, 0x87, 0x27, 0x57,
+	0x0b, 0xc6, 0xbd, 0xb8, 0x99, 0xdc, 0xb4, 0x6f, 0x56, 0x4e, 0xa6, 0x39, 0xc5, 0xa9, 0x87, 0x3d,
+	0x32, 0x2b, 0x9e, 0xeb, 0x87, 0x2b, 0x37, 0x99, 0x51, 0x23, 0x92, 0xb8, 0x29, 0x3d, 0x41, 0x15,
+	0x3f, 0xcf, 0x6e, 0xc3, 0xa8, 0xfb, 0x6d, 0xab, 0x46, 0xeb, 0x27, 0x9c, 0xbb, 0xff, 0x08, 0x52,
+	0x4a, 0x64, 0xf7, 0xfd, 0x78, 0xb3, 0xdb, 0xe4, 0xd0, 0x3c, 0x38, 0xbb, 0x4d, 0x76, 0xc3, 0x57,
+	0x48, 0xda, 0x85, 0xec, 0xce, 0xe7, 0x38, 0xd5, 0xff, 0xd7, 0xe1, 0xbc, 0xd1, 0xb2, 0x8f, 0xc1,
+	0x62, 0x08, 0x85, 0x29, 0xfe, 0xc3, 0xad, 0xc0, 0x7a, 0x5e, 0x99, 0x17, 0x65, 0x18, 0x41, 0xc9,
+	0x3d, 0x50, 0x52, 0xf2, 0x97, 0x4b, 0xc5, 0xb9, 0xd4, 0xb4, 0x46, 0x45, 0xdc, 0x5f, 0x7f, 0x9f,
+	0xfc, 0x3f, 0x91, 0xfc, 0x3f, 0x91, 0x90, 0x7e, 0xf8, 0x84, 0x12, 0xd2, 0xbf, 0x08, 0xc3, 0x6d,
+	0xcb, 0x63, 0xf6, 0xd2, 0x23, 0xc5, 0xf9, 0x14, 0x75, 0xa1, 0xc5, 0xa7, 0x60, 0xb4, 0x25, 0xd7,
+	0x39, 0x01, 0x94, 0x84, 0x32, 0x02, 0xb3, 0x8c, 0x1e, 0x57, 0xe4, 0xc3, 0x38, 0x15, 0xfe, 0xd8,
+	0x71, 0xa4, 0xc2, 0x67, 0x19, 0x06, 0x2e, 0x75, 0x3b, 0x96, 0xb8, 0x70, 0xa6, 0x96, 0xd8, 0x86,
+	0xfd, 0x08, 0x67, 0x52, 0xa7, 0x6d, 0x24, 0x9c, 0x49, 0x42, 0x30, 0x45, 0x97, 0xbc, 0x13, 0x88,
+	0x7b, 0x47, 0xd8, 0x0c, 0x5d, 0x63, 0x34, 0x84, 0xa7, 0x6e, 0x89, 0xfb, 0x41, 0x44, 0x49, 0x37,
+	0x6f, 0xa5, 0x6a, 0x60, 0x46, 0x2b, 0xf3, 0xd7, 0x4a, 0x00, 0x37, 0x69, 0xc0, 0xf2, 0x63, 0xb0,
+	0x3b, 0xfe, 0x92, 0xa6, 0x96, 0x18, 0xfd, 0xda, 0xc5, 0xba, 0xbc, 0x04, 0x83, 0x6d, 0xb7, 0x2e,
+	0xee, 0x19, 0xd9, 0x11, 0xee, 0x06, 0xc2, 0x4b, 0x59, 0x00, 0x36, 0x6e, 0x50, 0x25, 0x1f, 0xe7,
+	0xfc, 0x5d, 0xc7, 0x15, 0x37, 0x28, 0xca, 0xd9, 0x09, 0x29, 0xe3, 0x25, 0xf8, 0xe5, 0xa1, 0xf8,
+	0x84, 0x0c, 0x55, 0x38, 0x18, 0x41, 0xc9, 0xd3, 0x00, 0x76, 0x7b, 0xd9, 0x6a, 0xd9, 0x4d, 0x5b,
+	0x6e, 0xd7, 0x31, 0x2e, 0x6d, 0x87, 0x95, 0xf5, 0xb0, 0xf4, 0xc1, 0xde, 0xec, 0xa8, 0xfc, 0xb5,
+	0x8b, 0x4a, 0x6d, 0x16, 0xcf, 0xee, 0x74, 0x3c, 0x79, 0x72, 0xa9, 0x84, 0x3d, 0x17, 0x81, 0x86,
+	0x73, 0x7b, 0x2e, 0x72, 0x0a, 0x74, 0xef, 0xb9, 0x10, 0x8e, 0xe5, 0xf5, 0xfc, 0x09, 0x18, 0xa7,
+	0x22, 0x9c, 0xd2, 0xca, 0x22, 0x8a, 0x33, 0x6e, 0x4c, 0x3c, 0xe7, 0x96, 0xe2, 0x62, 0x54, 0xeb,
+	0x98, 0x7f, 0x3b, 0x00, 0x13, 0x37, 0x1b, 0xb6, 0xb3, 0x13, 0xc6, 0x8d, 0x8a, 0x94, 0xfa, 0xc6,
+	0xf1, 0x28, 0xf5, 0x9f, 0x87, 0x72, 0x53, 0x55, 0xa1, 0x09, 0xc6, 0xc9, 0x72, 0x1a, 0xd1, 0x0c,
+	0xf0, 0x77, 0xcc, 0x6a, 0x4e, 0x1d, 0xcc, 0x6d, 0x4d, 0x02, 0x18, 0xae, 0x85, 0xc9, 0x1f, 0x0b,
+	0xc7, 0x42, 0x52, 0xe7, 0x62, 0x4e, 0x0d, 0x0b, 0x12, 0x9d, 0x79, 0x72, 0x79, 0x4a, 0x5a, 0x4c,
+	0xb1, 0x73, 0x8e, 0xee, 0x88, 0xb0, 0x38, 0x1b, 0x9e, 0xb5, 0xb5, 0x65, 0xd7, 0xa4, 0x37, 0xa1,
+	0x58, 0x89, 0xab, 0xcc, 0x5e, 0x65, 0x29, 0xab, 0xc2, 0x83, 0xbd, 0xd9, 0xab, 0x99, 0x51, 0x8a,
+	0xf8, 0xd7, 0xcc, 0x6c, 0x82, 0xd9, 0xa4, 0x58, 0x7c, 0xca, 0x43, 0xf8, 0xa0, 0x6b, 0xb1, 0x88,
+	0x7e, 0xbd, 0x04, 0x13, 0x6c, 0xb9, 0xb1, 0x70, 0x83, 0x4d, 0x96, 0xb7, 0xe3, 0xf1, 0x64, 0x08,
+	0xc6, 0x48, 0x7d, 0x99, 0x0a, 0xc3, 0xb8, 0x0a, 0x67, 0xb7, 0x5c, 0xaf, 0x46, 0x37, 0x2a, 0xeb,
+	0x1b, 0xae, 0x34, 0x6c, 0x5b, 0xbc, 0x59, 0x2d, 0x97, 0x62, 0x75, 0xca, 0x72, 0x06, 0x1c, 0x33,
+	0x5b, 0x31, 0x0f, 0x83, 0xb8, 0x7c, 0xb3, 0x2d, 0x9c, 0x21, 0x18, 0xba, 0x81, 0xd8, 0x99, 0x63,
+	0x39, 0xab, 0x02, 0x66, 0xb7, 0x63, 0x8e, 0x4a, 0x52, 0x6f, 0xb3, 0xec, 0x7a, 0xf7, 0x2d, 0xaf,
+	0xae, 0xa3, 0x1d, 0x8c, 0x73, 0xe2, 0x2f, 0xe6, 0x57, 0xc3, 0x6e, 0x38, 0x98, 0x3d, 0x87, 0x1e,
+	0xe0, 0x92, 0x05, 0x7a, 0xf4, 0x64, 0x6e, 0x41, 0x19, 0xe8, 0x91, 0x3d, 0x11, 0x58, 0x19, 0xf3,
+	0x38, 0xf3, 0xa2, 0x8a, 0xf2, 0x0d, 0xca, 0x59, 0xa6, 0xb8, 0x39, 0x82, 0xa7, 0xa1, 0x0a, 0xac,
+	0x46, 0x79, 0x20, 0x46, 0xb5, 0x61, 0x35, 0x90, 0x95, 0xf1, 0xe4, 0x2a, 0x76, 0x83, 0xfa, 0xa1,
+	0xa8, 0x5b, 0x24, 0x57, 0xe1, 0x25, 0x28, 0x21, 0xc4, 0x82, 0xc9, 0x76, 0xa7, 0x29, 0x63, 0x35,
+	0xb1, 0xa7, 0x8f, 0x10, 0x49, 0x3e, 0x96, 0x95, 0x39, 0x90, 0x7f, 0xfd, 0xcc, 0xf4, 0x81, 0xeb,
+	0x2a, 0x0a, 0xd4, 0x31, 0x9a, 0x9f, 0x1d, 0x06, 0x25, 0x74, 0xcf, 0x21, 0xb8, 0xd0, 0x9f, 0x32,
+	0x58, 0xae, 0x24, 0x9b, 0x3a, 0x41, 0x22, 0x0a, 0x86, 0xb8, 0x3e, 0x36, 0x0b, 0xc5, 0x14, 0x6a,
+	0x53, 0x67, 0x65, 0x51, 0xba, 0xce, 0x54, 0x32, 0x90, 0x4b, 0xf7, 0xa2, 0x0c, 0x08, 0x66, 0x76,
+	0x86, 0x8f, 0x87, 0x97, 0xaf, 0x2c, 0xaa, 0x91, 0x39, 0x2b, 0xb2, 0x0c, 0x23, 0x28, 0x3b, 0x79,
+	0x1b, 0x9e, 0xdb, 0x69, 0xfb, 0x15, 0xee, 0x21, 0x2b, 0x3e, 0x0a, 0x3f, 0x79, 0xaf, 0xc5, 0xc5,
+	0xa8, 0xd6, 0x61, 0x82, 0x59, 0xf1, 0x73, 0xdd, 0xa3, 0x5b, 0xf6, 0x4e, 0x79, 0x28, 0x16, 0xcc,
+	0x5e, 0x53, 0xca, 0x51, 0xab, 0xc5, 0x63, 0xc3, 0xf9, 0x7e, 0x87, 0x7a, 0x9b, 0xb8, 0x2a, 0x13,
+	0x50, 0x8b, 0xd8, 0x70, 0x61, 0x21, 0xc6, 0x70, 0xf2, 0xfd, 0x06, 0x4c, 0xb1, 0x10, 0x39, 0xb6,
+	0xc7, 0x58, 0x18, 0xcb, 0x6e, 0xf9, 0xe5, 0x91, 0xe2, 0xf1, 0xda, 0xe2, 0x0f, 0x3d, 0x87, 0x1a,
+	0x52, 0x71, 0x40, 0x46, 0x66, 0x22, 0x3a, 0x10, 0x13, 0x3d, 0x60, 0x53, 0xe5, 0xdb, 0x0d, 0xc7,
+	0x76, 0x1a, 0xf3, 0xcd, 0x86, 0x2f, 0xb3, 0x6c, 0x09, 0x99, 0x63, 0x5c, 0x8c, 0x6a, 0x1d, 0xa6,
+	0x23, 0xea, 0xf8, 0xec, 0xd8, 0x6b, 0x51, 0x31, 0xbf, 0x63, 0xb1, 0x1d, 0xcd, 0xa6, 0x0a, 0x40,
+	0xbd, 0x1e, 0xd3, 0x58, 0x87, 0x05, 0x72, 0x96, 0x81, 0xb7, 0xe4, 0xfc, 0xc6, 0xa6, 0x06, 0xc1,
+	0x44, 0xcd, 0x99, 0x79, 0x38, 0x93, 0x31, 0xcc, 0x43, 0x9d, 0xad, 0xff, 0xc7, 0x80, 0x73, 0x82,
+	0xeb, 0x0a, 0x53, 0x57, 0x87, 0x89, 0x37, 0xb2, 0x73, 0x58, 0x18, 0xc7, 0x9a, 0xc3, 0xe2, 0x6b,
+	0x90, 0xab, 0xc3, 0xfc, 0xd9, 0x12, 0xbc, 0xfa, 0xc0, 0x7d, 0x49, 0x7e, 0xcc, 0x80, 0x71, 0xba,
+	0x13, 0x78, 0x56, 0x14, 0x46, 0x80, 0x2d, 0xd2, 0xad, 0x63, 0x39, 0x04, 0xe6, 0x96, 0x62, 0x42,
+	0x62, 0xe1, 0x46, 0x4f, 0x29, 0x05, 0x82, 0x6a, 0x7f, 0xd8, 0x69, 0x2b, 0x52, 0x21, 0xa9, 0x06,
+	0x77, 0xf2, 0x14, 0x94, 0x90, 0x99, 0xb7, 0xb3, 0x24, 0x11, 0x3a, 0xe6, 0x43, 0xad, 0x95, 0x9f,
+	0x31, 0x20, 0x33, 0xd4, 0x27, 0x73, 0xd1, 0x62, 0x02, 0x2a, 0x4d, 0x67, 0x28, 0x59, 0x49, 0x6e,
+	0x50, 0x3e, 0x9f, 0x04, 0x62, 0xba, 0xbe, 0x10, 0xc0, 0x32, 0xb9, 0xa6, 0x8e, 0x46, 0x30, 0x5c,
+	0x52, 0x00, 0x9b, 0x02, 0x63, 0x56, 0x1b, 0xf3, 0x1f, 0x1b, 0x70, 0x2e, 0x53, 0x2c, 0xd9, 0x83,
+	0x2c, 0x2e, 0x7b, 0xd9, 0x97, 0x8e, 0x73, 0xd9, 0x9b, 0x9f, 0x32, 0x40, 0x68, 0x78, 0x98, 0x81,
+	0x5a, 0xad, 0xe3, 0xb1, 0xbb, 0x6d, 0x37, 0x99, 0x4a, 0xa9, 0x22, 0xcb, 0x31, 0xaa, 0xc1, 0xb4,
+	0x88, 0xdb, 0x6e, 0xc7, 0x6b, 0x16, 0x75, 0x98, 0x88, 0x18, 0xc6, 0xeb, 0x1c, 0x0b, 0x4a, 0x6c,
+	0xe6, 0xaf, 0x96, 0x80, 0x05, 0xdd, 0x60, 0x57, 0xed, 0x09, 0x48, 0x04, 0x2d, 0x4d, 0x22, 0xf8,
+	0x6c, 0x31, 0x4d, 0x19, 0xef, 0x6c, 0xae, 0x08, 0xd0, 0x4e, 0x88, 0x00, 0xe7, 0xfb, 0x21, 0xd2,
+	0x5d, 0xe6, 0xf7, 0x59, 0x03, 0xa6, 0x65, 0xcd, 0x8a, 0xeb, 0x07, 0x0b, 0x9d, 0x7a, 0x83, 0x06,
+	0x87, 0xfc, 0xae, 0xef, 0x86, 0x91, 0x96, 0xeb, 0x04, 0xdb, 0x85, 0x3f, 0x6c, 0xc4, 0x0f, 0xaf,
+	0x09, 0x34, 0x18, 0xe2, 0x33, 0xbf, 0x68, 0xc0, 0xb8, 0xec, 0xde, 0x09, 0xc8, 0x20, 0xbf, 0x45,
+	0x97, 0x41, 0xbe, 0xad, 0x8f, 0x69, 0xcf, 0x11, 0x3a, 0x7e, 0x5f, 0x09, 0x26, 0x65, 0x8d, 0x35,
+	0xda, 0xba, 0x43, 0x3d, 0xb2, 0x0c, 0x23, 0x7e, 0x87, 0xaf, 0x33, 0x39, 0xa0, 0x8b, 0xca, 0x80,
+	0xe6, 0xbc, 0x3b, 0x56, 0x8d, 0x75, 0xbf, 0x2a, 0xaa, 0xc4, 0x33, 0x25, 0x0b, 0x30, 0x6c, 0xcc,
+	0x8e, 0x0a, 0xcf, 0x6d, 0xa6, 0x32, 0x08, 0xa0, 0xdb, 0xa4, 0xc8, 0x21, 0xec, 0x59, 0xcc, 0xfe,
+	0x86, 0x4f, 0x5e, 0xfe, 0x2c, 0x66, 0x60, 0x1f, 0x45, 0x39, 0xe9, 0xc0, 0x99, 0x38, 0x01, 0x0e,
+	0xdb, 0xe9, 0x7e, 0x60, 0xb5, 0xda, 0x05, 0x0c, 0x97, 0xf9, 0xf1, 0xb7, 0x94, 0x46, 0x85, 0x59,
+	0xf8, 0xcd, 0x9f, 0x1e, 0x8e, 0xbe, 0x31, 0x17, 0xbd, 0x5c, 0x87, 0xb1, 0x9a, 0x47, 0xad, 0x80,
+	0xd6, 0x17, 0x76, 0x7b, 0x99, 0x13, 0xce, 0xad, 0x55, 0xc2, 0x16, 0x18, 0x37, 0x66, 0x8c, 0x91,
+	0x6a, 0xe2, 0x5b, 0x8a, 0x79, 0xc8, 0x5c, 0xf3, 0xde, 0x6f, 0x84, 0x21, 0xf7, 0xbe, 0x13, 0xb9,
+	0x07, 0x75, 0x25, 0xcc, 0x67, 0xf0, 0x16, 0xab, 0x8d, 0xa2, 0x91, 0x9a, 0xb8, 0x63, 0xb0, 0x4b,
+	0xe2, 0x8e, 0x26, 0x8c, 0xb4, 0xf8, 0xd7, 0xef, 0x2b, 0xb7, 0xb2, 0xb6, 0x8e, 0x94, 0x3d, 0x24,
+	0x30, 0x63, 0x48, 0x82, 0x31, 0xb8, 0x4e, 0x28, 0x77, 0x53, 0x19, 0xdc, 0x48, 0x18, 0x87, 0x31,
+	0x9c, 0xe5, 0xfe, 0x54, 0x33, 0xc2, 0x8c, 0x14, 0x97, 0x66, 0xcb, 0xee, 0x29, 0x49, 0x60, 0xc4,
+	0xd4, 0xe7, 0x65, 0x85, 0x61, 0xb1, 0xfc, 0x2e, 0xd4, 0xb3, 0x73, 0xf6, 0x49, 0x63, 0x89, 0x42,
+	0xae, 0xf9, 0x39, 0x69, 0x00, 0x17, 0x66, 0xe5, 0x84, 0xe5, 0xe5, 0x09, 0xc4, 0xbc, 0xce, 0x90,
+	0x0e, 0x0b, 0xf0, 0x1d, 0x9e, 0x95, 0xe5, 0xb1, 0xe2, 0xa6, 0xc3, 0xa9, 0x83, 0x57, 0x3c, 0x5e,
+	0xe3, 0xdf, 0xa8, 0x10, 0x32, 0xbf, 0x6b, 0x30, 0x3a, 0x3b, 0xa4, 0x18, 0x2c, 0x5b, 0x48, 0x69,
+	0x14, 0x11, 0x52, 0x92, 0x37, 0x84, 0x89, 0xeb, 0xc4, 0x2e, 0x79, 0x38, 0x99, 0xb8, 0x6e, 0x42,
+	0x92, 0xd6, 0x92, 0xd5, 0x75, 0xe0, 0x8c, 0x1f, 0xb0, 0x18, 0xfc, 0xb6, 0xd4, 0x1c, 0x8b, 0x13,
+	0x63, 0xa0, 0xd8, 0x89, 0x51, 0x4d, 0xa3, 0xc2, 0x2c, 0xfc, 0x2c, 0x33, 0x75, 0x99, 0x97, 0x33,
+	0x4e, 0x8d, 0x7f, 0x16, 0xda, 0xcf, 0x71, 0x25, 0x63, 0x3a, 0x66, 0xe3, 0xc3, 0x5c, 0x4a, 0xe4,
+	0x03, 0x70, 0x8e, 0x71, 0x47, 0xf3, 0xb5, 0xc0, 0xbe, 0x67, 0x07, 0xbb, 0x71, 0x17, 0x0e, 0x9f,
+	0x2e, 0x8e, 0x8b, 0x62, 0x56, 0xb3, 0x90, 0x61, 0x36, 0x0d, 0xf3, 0xaf, 0x0d, 0x20, 0xe9, 0x2d,
+	0x46, 0x9a, 0x30, 0x5a, 0x0f, 0x43, 0x76, 0x18, 0x47, 0x92, 0xce, 0x29, 0xba, 0x30, 0xa3, 0x48,
+	0x1f, 0x11, 0x05, 0xe2, 0xc2, 0xd8, 0xfd, 0x6d, 0x3b, 0xa0, 0x4d, 0xdb, 0x0f, 0x8e, 0x28, 0x7b,
+	0x54, 0x94, 0x8c, 0xe0, 0x76, 0x88, 0x18, 0x63, 0x1a, 0xe6, 0x77, 0x0f, 0xc2, 0x68, 0x94, 0x06,
+	0xf6, 0x60, 0xfb, 0xfe, 0x0e, 0x90, 0x9a, 0x12, 0x89, 0xb2, 0x1f, 0x81, 0x3a, 0x67, 0x90, 0x2b,
+	0x29, 0x64, 0x98, 0x41, 0x80, 0x7c, 0x80, 0x59, 0x66, 0x6f, 0x79, 0x56, 0x14, 0x67, 0xb3, 0x12,
+	0x4a, 0x51, 0x0b, 0x10, 0xe6, 0x22, 0x96, 0x95, 0x0c, 0x74, 0x98, 0x49, 0x84, 0x50, 0x18, 0x11,
+	0x99, 0xb8, 0x43, 0x95, 0x5c, 0x21, 0x45, 0x8e, 0x78, 0x88, 0xc4, 0xb7, 0x4a, 0xf8, 0x30, 0x09,
+	0x71, 0x8b, 0xa8, 0xc8, 0xe2, 0xff, 0x50, 0x5b, 0x59, 0x1e, 0x2a, 0xee, 0xae, 0x79, 0x5b, 0x47,
+	0x25, 0xa3, 0x22, 0xeb, 0x85, 0x98, 0x24, 0x68, 0xfe, 0x72, 0x09, 0x86, 0x44, 0xf0, 0xb9, 0xe3,
+	0xe7, 0xfb, 0xdf, 0xa7, 0xf1, 0xfd, 0xcf, 0x14, 0x19, 0x24, 0xef, 0x6a, 0x2e, 0xd7, 0xdf, 0x48,
+	0x70, 0xfd, 0xcf, 0x16, 0x27, 0xd1, 0x9d, 0xe7, 0xff, 0x5d, 0x03, 0xc6, 0x78, 0xbd, 0x13, 0x60,
+	0xa9, 0x5f, 0xd0, 0x59, 0xea, 0xa7, 0x0a, 0x8f, 0x29, 0x87, 0xa1, 0xfe, 0xb9, 0x41, 0x39, 0x16,
+	0xce, 0x3a, 0xae, 0xc0, 0x19, 0xe9, 0xfa, 0xcd, 0x52, 0x9d, 0xb3, 0xbd, 0xb4, 0x68, 0xed, 0xfa,
+	0xd2, 0x19, 0x49, 0x84, 0x55, 0x4a, 0x83, 0x31, 0xab, 0x0d, 0xf9, 0x75, 0x83, 0x31, 0x69, 0x81,
+	0x67, 0xd7, 0xfa, 0x32, 0x49, 0x88, 0xfa, 0x36, 0xb7, 0x26, 0x90, 0x09, 0x09, 0xc9, 0x66, 0xcc,
+	0xad, 0xf1, 0xd2, 0x07, 0x7b, 0xb3, 0xb3, 0x19, 0x9a, 0x8b, 0x38, 0x71, 0xbb, 0x1f, 0x7c, 0xec,
+	0xcf, 0xba, 0x56, 0xe1, 0x32, 0x81, 0xb0, 0xc7, 0xe4, 0x3a, 0x0c, 0xf9, 0x35, 0xb7, 0x1d, 0x06,
+	0x0f, 0x78, 0x24, 0x4b, 0x42, 0x9d, 0x14, 0x4e, 0x47, 0x13, 0x5c, 0x65, 0x2d, 0x51, 0x20, 0x60,
+	0x81, 0xc3, 0xee, 0x5b, 0x1e, 0x13, 0x1c, 0x26, 0x63, 0x7e, 0x31, 0x9b, 0xdc, 0xc1, 0x38, 0x70,
+	0xd8, 0xed, 0xdc, 0x5a, 0xd8, 0x05, 0xc3, 0xcc, 0xfb, 0x61, 0x42, 0x9d, 0x99, 0x0c, 0x09, 0xcf,
+	0xa2, 0x6e, 0x17, 0x7a, 0xc8, 0xc7, 0xa5, 0x2a, 0x11, 0xfa, 0xfc, 0x20, 0x8c, 0x2b, 0x1b, 0x84,
+	0xfc, 0x53, 0x03, 0x06, 0xb7, 0x59, 0xa0, 0xc9, 0x3e, 0xcc, 0xcd, 0x14, 0x7c, 0x73, 0x2c, 0x86,
+	0xa4, 0xf8, 0xbe, 0x18, 0xee, 0x6f, 0x56, 0x74, 0x44, 0x1f, 0x97, 0x77, 0x95, 0xf7, 0xb9, 0xe3,
+	0xd3, 0x7a, 0xb9, 0x74, 0x34, 0x7d, 0xde, 0xf4, 0x69, 0xb2, 0xcf, 0xac, 0xe8, 0xa8, 0xfa, 0xcc,
+	0xba, 0x3a, 0xd3, 0x80, 0xb1, 0x68, 0x6a, 0x8e, 0xf3, 0x03, 0x33, 0x42, 0xd1, 0x78, 0x8e, 0x75,
+	0x25, 0xfd, 0xf3, 0x41, 0x18, 0x46, 0xda, 0x90, 0xe9, 0x2b, 0x0f, 0x90, 0xd1, 0xd9, 0x61, 0x2e,
+	0xf7, 0x52, 0x71, 0x9f, 0x68, 0x35, 0x41, 0x19, 0x4b, 0xe0, 0x1e, 0xef, 0x56, 0x35, 0x9d, 0x3b,
+	0x71, 0xa2, 0xa4, 0x7e, 0x03, 0xc5, 0x9d, 0x1b, 0xc4, 0xc0, 0x7a, 0x49, 0xe3, 0x47, 0xbe, 0xcf,
+	0x00, 0x62, 0xd5, 0x6a, 0xcc, 0x11, 0x95, 0xfa, 0x6c, 0x17, 0x8b, 0x67, 0xa3, 0x60, 0x3c, 0x8a,
+	0x65, 0x28, 0x48, 0x62, 0x8b, 0x5f, 0x32, 0x29, 0x90, 0x8f, 0x19, 0xc4, 0x89, 0x05, 0xe3, 0xdc,
+	0xf0, 0x7c, 0xd9, 0xe2, 0xb9, 0x54, 0x87, 0x8a, 0x9b, 0x6c, 0xaf, 0xc7, 0x68, 0x50, 0xc5, 0xd9,
+	0x4f, 0xf6, 0xc2, 0x7f, 0x6d, 0xc0, 0x84, 0x96, 0x1c, 0xb2, 0x15, 0xab, 0x37, 0x8b, 0x5b, 0x51,
+	0x86, 0xce, 0xbe, 0x17, 0xbb, 0x54, 0x12, 0x2a, 0xd3, 0x5b, 0x51, 0x76, 0xa3, 0xa3, 0xc9, 0x23,
+	0x69, 0x7e, 0xda, 0x80, 0xf3, 0xe1, 0x80, 0xf4, 0x34, 0x16, 0x4c, 0xdb, 0x67, 0xb5, 0x6d, 0xae,
+	0x7b, 0x53, 0xb5, 0x97, 0xf3, 0xeb, 0x2b, 0xbc, 0x0c, 0x23, 0xa8, 0x96, 0x93, 0xbf, 0x74, 0x60,
+	0x4e, 0xfe, 0xd7, 0x48, 0x6b, 0x7c, 0xe1, 0x13, 0x1d, 0xbd, 0x18, 0x22, 0xc2, 0xc2, 0xbe, 0xde,
+	0x7c, 0x33, 0x8c, 0x55, 0xab, 0xd7, 0xc5, 0xaa, 0x39, 0x84, 0x12, 0xde, 0xfc, 0xc4, 0x00, 0x4c,
+	0xca, 0x7c, 0x3c, 0x36, 0x97, 0xca, 0x9f, 0x00, 0x77, 0xb9, 0x01, 0x63, 0x7e, 0xa4, 0x56, 0x2e,
+	0xe5, 0x5f, 0xda, 0x91, 0xa6, 0x38, 0x99, 0x54, 0x35, 0x02, 0x60, 0x8c, 0x88, 0xdc, 0x80, 0xe1,
+	0x17, 0xd9, 0x59, 0x1f, 0x1e, 0x07, 0x3d, 0xf1, 0x01, 0xd1, 0x5e, 0xe7, 0xd7, 0x84, 0x8f, 0x12,
+	0x05, 0xf1, 0xb9, 0x37, 0x3a, 0x7f, 0x7a, 0xf5, 0x13, 0x99, 0x59, 0x9b, 0xd9, 0xf0, 0x2d, 0x27,
+	0x16, 0x46, 0xf8, 0x0b, 0x23, 0x42, 0x3c, 0x23, 0xb4, 0xd6, 0xe2, 0x15, 0x92, 0x11, 0x5a, 0xeb,
+	0x73, 0x0e, 0xef, 0xfa, 0x14, 0x9c, 0xcb, 0x9c, 0x8c, 0x83, 0x1f, 0xb6, 0xe6, 0x3f, 0x29, 0xc1,
+	0x20, 0xcb, 0xeb, 0x7c, 0x02, 0x2b, 0xf3, 0x05, 0xed, 0xdd, 0xf3, 0x8d, 0x85, 0x73, 0x52, 0xe7,
+	0x3d, 0x7b, 0xb6, 0x12, 0xcf, 0x9e, 0xb7, 0x17, 0xa6, 0xd0, 0xfd, 0xd5, 0xf3, 0x17, 0x25, 0x18,
+	0x61, 0xd5, 0x98, 0x1d, 0x4b, 0x4b, 0x59, 0xca, 0xa5, 0xe2, 0x8f, 0x56, 0x89, 0xee, 0xa0, 0x45,
+	0xcc, 0x76, 0x8e, 0x2d, 0xf3, 0x7c, 0x95, 0x07, 0xfa, 0xd9, 0x39, 0x1a, 0x39, 0x19, 0x6a, 0x88,
+	0x13, 0x0d, 0xd3, 0x88, 0x61, 0x44, 0x88, 0xdc, 0x57, 0x24, 0x41, 0x83, 0x57, 0x06, 0x8e, 0x96,
+	0x68, 0x17, 0xa1, 0x90, 0xf9, 0x09, 0x03, 0x4e, 0x25, 0x5a, 0xf5, 0x20, 0xaa, 0x39, 0x96, 0x03,
+	0xd0, 0xfc, 0xaa, 0x01, 0xe7, 0x12, 0x7d, 0x91, 0x62, 0x92, 0x83, 0x7b, 0x14, 0xe7, 0x7b, 0x2c,
+	0x75, 0xcd, 0xf7, 0x78, 0x09, 0x06, 0x19, 0xf3, 0xa5, 0x9a, 0x51, 0x32, 0x9e, 0x0c, 0x79, 0x29,
+	0xa9, 0xc1, 0x54, 0xcd, 0xa3, 0x75, 0xea, 0x04, 0xb6, 0xd5, 0xf4, 0xd9, 0xe0, 0x06, 0x7b, 0x7f,
+	0x92, 0x45, 0x16, 0x20, 0x15, 0x0d, 0x05, 0x26, 0x50, 0x9a, 0x9f, 0x37, 0x60, 0x94, 0x0d, 0xf3,
+	0x04, 0x0e, 0xc7, 0x6f, 0xd6, 0x0f, 0xc7, 0xb7, 0x16, 0x5d, 0x53, 0x39, 0x67, 0xe2, 0x5f, 0x96,
+	0x80, 0x27, 0xac, 0x97, 0x26, 0x9f, 0x8a, 0x31, 0xa7, 0x91, 0x63, 0x86, 0x7a, 0x45, 0xda, 0x82,
+	0x26, 0x14, 0x5f, 0x8a, 0x3d, 0xe8, 0xeb, 0x34, 0x73, 0x4f, 0x8d, 0xb9, 0xc8, 0x30, 0xf9, 0x7c,
+	0x09, 0x26, 0x7d, 0x16, 0xd2, 0x63, 0x31, 0xde, 0x3c, 0x85, 0x75, 0xb0, 0x3c, 0x36, 0x48, 0x38,
+	0x14, 0x61, 0x5d, 0x53, 0x55, 0x71, 0xa3, 0x4e, 0x8a, 0xd9, 0xb3, 0xdd, 0x69, 0xba, 0xb5, 0xbb,
+	0xc2, 0xda, 0x54, 0xc4, 0x82, 0xe0, 0x2a, 0x81, 0x85, 0xa8, 0x14, 0x95, 0x1a, 0x7d, 0x19, 0xd6,
+	0x7e, 0xd5, 0x10, 0x33, 0x7d, 0x88, 0x3d, 0x7a, 0x82, 0xb6, 0xc9, 0x5f, 0x07, 0xc3, 0x1e, 0x7f,
+	0x86, 0x94, 0x07, 0xf4, 0xcd, 0x27, 0x1e, 0x27, 0x28, 0xa1, 0x6c, 0x79, 0x88, 0xb7, 0xd5, 0x60,
+	0xac, 0xd4, 0x54, 0x5f, 0x44, 0xe6, 0xaf, 0xca, 0x61, 0x56, 0x69, 0x93, 0x32, 0xde, 0x9d, 0xb4,
+	0x61, 0x92, 0x3f, 0x5e, 0xc2, 0x02, 0xb9, 0x47, 0xde, 0xd0, 0xe3, 0x1e, 0x51, 0x9b, 0xc6, 0x9e,
+	0x12, 0x5a, 0x31, 0xea, 0x04, 0x98, 0xb1, 0x55, 0x38, 0x3a, 0xe1, 0xb0, 0x50, 0x8a, 0x1d, 0xf2,
+	0xd7, 0x55, 0x00, 0xea, 0xf5, 0xcc, 0xcf, 0x94, 0xe0, 0x61, 0xd1, 0x77, 0x2e, 0xef, 0x5c, 0xa4,
+	0xcc, 0x49, 0x8d, 0xa9, 0xdc, 0x39, 0x9f, 0x5d, 0x77, 0x99, 0xa4, 0x79, 0xf8, 0x3e, 0xa5, 0xf5,
+	0x48, 0x5f, 0x79, 0xbb, 0xf0, 0xe5, 0x99, 0x47, 0xe2, 0x36, 0x47, 0x2f, 0x0c, 0x74, 0xc4, 0xff,
+	0x28, 0x49, 0x32, 0xe2, 0x6d, 0xcf, 0xbd, 0x13, 0xb1, 0x83, 0x47, 0x4f, 0x7c, 0x9d, 0xa3, 0x17,
+	0xc4, 0xc5, 0xff, 0x28, 0x49, 0x9a, 0xeb, 0xf0, 0x48, 0x0f, 0x4d, 0x0f, 0xc3, 0xf6, 0x1f, 0x84,
+	0x51, 0x8c, 0xfe, 0x30, 0x18, 0xff, 0xc8, 0x80, 0x47, 0x15, 0x94, 0x4b, 0x3b, 0xec, 0x25, 0xc2,
+	0x5c, 0x94, 0x6b, 0x4c, 0x9c, 0xc0, 0x43, 0x92, 0x1e, 0x2a, 0x49, 0xfb, 0x27, 0x0c, 0x18, 0x11,
+	0x46, 0xd2, 0xe1, 0xf1, 0xfb, 0x42, 0x9f, 0x53, 0x9e, 0xdb, 0xa5, 0x30, 0x79, 0x65, 0x38, 0x36,
+	0xf1, 0xdb, 0xc7, 0x90, 0xbe, 0xf9, 0x2f, 0x87, 0xe0, 0xeb, 0x7b, 0x47, 0x44, 0xbe, 0x6a, 0xc0,
+	0x58, 0xf8, 0x7e, 0x0b, 0x35, 0x53, 0xad, 0xe3, 0xed, 0x7c, 0x24, 0x89, 0x92, 0x32, 0x8c, 0xdb,
+	0x21, 0xa7, 0x10, 0x95, 0x1f, 0x91, 0x90, 0x2b, 0x1e, 0x18, 0xf9, 0x39, 0x03, 0x26, 0xd8, 0xb5,
+	0x14, 0x1d, 0x2e, 0xe2, 0x33, 0xb5, 0x8f, 0x79, 0xa4, 0x37, 0x15, 0x92, 0x89, 0x30, 0x86, 0x2a,
+	0x08, 0xb5, 0xbe, 0x91, 0x4d, 0x5d, 0xd7, 0x2f, 0x9e, 0x88, 0x97, 0xb3, 0xf8, 0x12, 0x45, 0x3f,
+	0x17, 0xd9, 0xf6, 0xe5, 0xe9, 0xf1, 0x67, 0x9a, 0x30, 0xa5, 0xcf, 0xfc, 0xb1, 0x8a, 0xfc, 0x9e,
+	0x85, 0xe9, 0xd4, 0xe8, 0x0f, 0x25, 0x90, 0xf9, 0xe2, 0x30, 0xcc, 0x2a, 0x53, 0x9d, 0x15, 0x8d,
+	0x8c, 0x05, 0x6f, 0x18, 0xb7, 0x1c, 0x47, 0xda, 0x9a, 0x86, 0xeb, 0xb7, 0xde, 0xe7, 0x57, 0xcd,
+	0x22, 0x35, 0x37, 0x1f, 0x93, 0x49, 0x18, 0x53, 0x2a, 0x10, 0x54, 0x7b, 0xd3, 0xc5, 0x61, 0xa2,
+	0x74, 0x62, 0x0e, 0x13, 0xe4, 0x43, 0xe1, 0x45, 0x3c, 0x50, 0x3c, 0x2e, 0xd1, 0x01, 0x73, 0xc3,
+	0xef, 0xf5, 0x1c, 0xc1, 0xe7, 0xf7, 0x18, 0xfc, 0x92, 0x8d, 0x83, 0xc6, 0x95, 0x07, 0x8b, 0xdb,
+	0xbd, 0x1f, 0x18, 0x91, 0x2e, 0xba, 0xbb, 0xe3, 0x22, 0xd4, 0xc9, 0x93, 0x1f, 0x32, 0x60, 0xe2,
+	0x25, 0xd7, 0xb1, 0x9a, 0xd2, 0xf5, 0x45, 0xca, 0x21, 0xdf, 0x77, 0x3c, 0xf3, 0x12, 0x91, 0x11,
+	0xe6, 0xee, 0x6a, 0x09, 0x6a, 0xdd, 0x60, 0x0c, 0x93, 0x08, 0x77, 0x31, 0x1c, 0xf3, 0xd3, 0x6a,
+	0xa8, 0x0b, 0x66, 0x76, 0x9b, 0x5c, 0x83, 0x87, 0xda, 0x4f, 0x1b, 0xf0, 0xda, 0x43, 0xf4, 0xb7,
+	0xc7, 0xab, 0xcf, 0xfc, 0xad, 0x41, 0x78, 0xf4, 0x60, 0xb4, 0xe2, 0x39, 0x70, 0x80, 0x38, 0xfe,
+	0x73, 0x89, 0xcd, 0x2c, 0x8e, 0x68, 0xfb, 0xb8, 0x16, 0xec, 0xd1, 0xee, 0xe8, 0x81, 0x93, 0xdb,
+	0xd1, 0x2f, 0xb7, 0x2d, 0xd5, 0xf7, 0xca, 0x5c, 0x80, 0x73, 0xca, 0x07, 0x8b, 0x73, 0xf3, 0xf1,
+	0xb8, 0xcc, 0xb6, 0x6f, 0x87, 0xd9, 0x05, 0x14, 0x96, 0xee, 0x39, 0x51, 0x8c, 0x21, 0xdc, 0x5c,
+	0xd5, 0x2e, 0x8b, 0x0d, 0xb7, 0xed, 0x36, 0xdd, 0xc6, 0xee, 0xfc, 0x7d, 0xcb, 0xa3, 0xe8, 0x76,
+	0x02, 0x89, 0xad, 0x57, 0x06, 0xf1, 0xa3, 0x43, 0x70, 0x45, 0x41, 0x97, 0x19, 0x84, 0xf9, 0x10,
+	0xf8, 0xd8, 0xd2, 0xd6, 0x63, 0x25, 0x97, 0x8a, 0xdb, 0xfd, 0x1f, 0xd4, 0xaf, 0x62, 0xb1, 0x93,
+	0xc9, 0x9e, 0x01, 0xd0, 0xb2, 0x76, 0x64, 0xe0, 0xce, 0xf2, 0xc0, 0x91, 0xdc, 0xa4, 0xd9, 0x1d,
+	0x5c, 0x8b, 0xc8, 0x88, 0xee, 0x3d, 0x1f, 0xca, 0x39, 0x63, 0xc0, 0x11, 0x71, 0x80, 0xca, 0x88,
+	0xfa, 0x0e, 0x0e, 0x3d, 0xd3, 0x82, 0x53, 0x89, 0x9e, 0x1f, 0xab, 0x2a, 0xf3, 0x8b, 0x23, 0x30,
+	0xa1, 0x4c, 0xa5, 0x4f, 0x7e, 0xc5, 0x80, 0x87, 0x68, 0x1e, 0xc3, 0x29, 0x5f, 0xcb, 0xcf, 0x1f,
+	0x17, 0x43, 0x2b, 0xf3, 0x2b, 0xe6, 0x81, 0x31, 0xbf, 0x67, 0x2c, 0x94, 0x90, 0x1f, 0xed, 0xe9,
+	0x7e, 0x42, 0x09, 0x65, 0x1e, 0x12, 0x42, 0x0e, 0x13, 0xff, 0x46, 0x85, 0x18, 0x8b, 0x64, 0x73,
+	0xb6, 0x99, 0x71, 0xc2, 0xc9, 0x13, 0xb3, 0x7a, 0x0c, 0x77, 0x8b, 0xb0, 0x0b, 0xcb, 0x82, 0x60,
+	0x66, 0x57, 0xc8, 0x4f, 0xe7, 0x46, 0x94, 0x17, 0x9c, 0xc9, 0xc6, 0x71, 0xec, 0xc1, 0x02, 0xc1,
+	0xe5, 0x3f, 0x63, 0x00, 0xa9, 0xa7, 0x1e, 0xdf, 0xd2, 0x12, 0xf9, 0x5d, 0x47, 0x2e, 0x62, 0x10,
+	0x86, 0x7d, 0xe9, 0x72, 0xcc, 0xe8, 0x04, 0xff, 0xce, 0x41, 0xc6, 0x99, 0x5f, 0x1e, 0x3d, 0x92,
+	0xef, 0x9c, 0x75, 0x9d, 0x88, 0xef, 0x9c, 0x05, 0xc1, 0xcc, 0xae, 0x98, 0xbf, 0x33, 0x26, 0x64,
+	0xc1, 0xdc, 0x20, 0xea, 0x05, 0x18, 0xbe, 0x63, 0xd5, 0xee, 0x4a, 0xfd, 0x6b, 0x41, 0x5b, 0xc0,
+	0x05, 0x8e, 0x41, 0x48, 0x61, 0xc4, 0xff, 0x28, 0xb1, 0x92, 0xf7, 0xc0, 0x40, 0xdd, 0x09, 0x83,
+	0x9e, 0xbc, 0xad, 0x0f, 0xfd, 0x42, 0x1c, 0x3a, 0x8a, 0x79, 0x08, 0x33, 0xa4, 0xc4, 0x81, 0x51,
+	0x47, 0x8a, 0x4e, 0xa5, 0x74, 0xeb, 0x1d, 0x45, 0x09, 0x44, 0x22, 0xd8, 0x48, 0xf0, 0x1b, 0x96,
+	0x60, 0x44, 0x83, 0xd1, 0x4b, 0xe8, 0x37, 0x0b, 0xd3, 0x8b, 0x34, 0x42, 0x6a, 0xbc, 0xee, 0xa4,
+	0x56, 0x88, 0xb2, 0xa0, 0xf3, 0xb6, 0x13, 0x84, 0x01, 0x4c, 0x9e, 0x29, 0x4a, 0x6d, 0x83, 0x61,
+	0x89, 0x25, 0xa4, 0xfc, 0xa7, 0x8f, 0x12, 0x39, 0xb9, 0x03, 0xc3, 0x22, 0x88, 0x49, 0x79, 0xa4,
+	0x3f, 0xfd, 0x9a, 0x88, 0x8b, 0x22, 0x96, 0x81, 0xf8, 0x1f, 0x25, 0x66, 0xf2, 0x7e, 0x26, 0x61,
+	0x97, 0x46, 0xa0, 0xa3, 0xfd, 0x4d, 0x5d, 0x64, 0x01, 0x2a, 0x43, 0x32, 0x88, 0x5f, 0x18, 0xe1,
+	0x27, 0x77, 0x60, 0xc4, 0x96, 0x4f, 0xaa, 0xb1, 0xe2, 0xcb, 0x2e, 0x7c, 0x2e, 0xf1, 0xd7, 0x86,
+	0xfc, 0x81, 0x21, 0xe2, 0x3c, 0xb3, 0x16, 0xf8, 0x5a, 0x9a, 0xb5, 0xbc, 0x08, 0x40, 0x43, 0x11,
+	0xba, 0x5f, 0x1e, 0x2f, 0xbe, 0x64, 0x14, 0x41, 0x7c, 0xc8, 0x10, 0x45, 0x45, 0x3e, 0x2a, 0x44,
+	0xc8, 0x07, 0x54, 0x99, 0xdd, 0x44, 0x7f, 0x71, 0x99, 0xd2, 0x91, 0xc6, 0x62, 0xd5, 0x5d, 0x08,
+	0xf2, 0x15, 0x51, 0x9a, 0xf9, 0x45, 0x00, 0x88, 0xd5, 0xba, 0x2c, 0x32, 0x5a, 0x48, 0xa5, 0x9f,
+	0xc8, 0x68, 0xd7, 0x24, 0x58, 0x2c, 0xaf, 0xf0, 0x17, 0x46, 0xb8, 0x99, 0x73, 0x68, 0x3a, 0x42,
+	0x5f, 0x9c, 0x10, 0xbd, 0xb7, 0xe8, 0x7c, 0x2f, 0x32, 0x0f, 0x91, 0x28, 0xa6, 0xf7, 0x40, 0xf1,
+	0x6f, 0x15, 0xc5, 0xfb, 0x8e, 0xbf, 0x55, 0x54, 0xe4, 0xa3, 0x42, 0x24, 0xc7, 0x17, 0x64, 0xb0,
+	0x90, 0x2f, 0xc8, 0x33, 0x70, 0x4a, 0x9a, 0xc4, 0xae, 0x70, 0x2d, 0x63, 0xb0, 0x2b, 0x7d, 0xe9,
+	0xb9, 0x55, 0x76, 0x45, 0x07, 0x61, 0xb2, 0x2e, 0xf9, 0x4d, 0x83, 0x45, 0x2d, 0x10, 0x0c, 0x5a,
+	0x79, 0xb8, 0x78, 0xe4, 0x90, 0xf8, 0xeb, 0xcf, 0x85, 0xfc, 0x9e, 0x60, 0xe4, 0x9f, 0x8b, 0x9c,
+	0x11, 0x65, 0xf1, 0x11, 0xb1, 0xf1, 0x51, 0xaf, 0xc9, 0xef, 0x31, 0x19, 0x41, 0xb3, 0xe9, 0xd6,
+	0xac, 0x80, 0x47, 0x83, 0x15, 0x4e, 0xfe, 0xb7, 0xfa, 0x1c, 0xc5, 0x7c, 0x8c, 0x51, 0x0c, 0xe4,
+	0xdd, 0x91, 0x24, 0x20, 0x86, 0x1c, 0xd1, 0x58, 0xd4, 0xee, 0x93, 0x7f, 0x60, 0xc0, 0xa3, 0x22,
+	0xb2, 0x42, 0x85, 0x7a, 0x81, 0xbd, 0x65, 0xd7, 0xac, 0x80, 0x66, 0x38, 0xe8, 0x95, 0x47, 0x0f,
+	0xed, 0xb9, 0xf2, 0xd8, 0xfe, 0xde, 0xec, 0xa3, 0x95, 0x1e, 0x70, 0x63, 0x4f, 0x3d, 0x60, 0xea,
+	0xd7, 0xa6, 0x9a, 0x6e, 0xa2, 0x3c, 0x56, 0x5c, 0xfd, 0xaa, 0xe5, 0xad, 0x10, 0x02, 0x06, 0xad,
+	0x08, 0x75, 0x52, 0x33, 0x77, 0x61, 0x52, 0x5b, 0x68, 0xc7, 0x2a, 0xb8, 0x76, 0xe0, 0x74, 0x72,
+	0x3d, 0x1c, 0xeb, 0x3b, 0xef, 0x06, 0x8c, 0x45, 0xcc, 0x02, 0x79, 0x58, 0x21, 0x14, 0xb3, 0x5e,
+	0x37, 0xe8, 0xae, 0xa0, 0x3a, 0xab, 0xc9, 0x50, 0x84, 0x90, 0x90, 0x07, 0xd4, 0x95, 0x08, 0xcd,
+	0xdf, 0x97, 0x5a, 0xd5, 0x0d, 0xda, 0x6a, 0x37, 0xad, 0x80, 0xbe, 0xf2, 0xed, 0x90, 0xcc, 0xff,
+	0x64, 0x88, 0xfb, 0x46, 0xb0, 0x36, 0xcc, 0x8a, 0xb4, 0x25, 0x72, 0x9d, 0xf2, 0xf0, 0xbb, 0x46,
+	0x71, 0x2b, 0xd2, 0xb5, 0x18, 0x0d, 0xaa, 0x38, 0xc9, 0x7d, 0x18, 0x0b, 0x99, 0xc1, 0x50, 0x52,
+	0xb3, 0xdc, 0x1f, 0x73, 0x16, 0xf1, 0x9d, 0xd1, 0xd5, 0x1a, 0x96, 0xf8, 0x18, 0xd3, 0x32, 0x2d,
+	0x20, 0xe9, 0x36, 0x4c, 0xce, 0x14, 0x3a, 0xaf, 0x1a, 0x7a, 0x76, 0xb2, 0x94, 0x03, 0x6b, 0x28,
+	0x64, 0x2d, 0xe5, 0x09, 0x59, 0xcd, 0xdf, 0x2e, 0xc1, 0x59, 0xf9, 0xf4, 0x9c, 0xaf, 0xd5, 0xdc,
+	0x8e, 0x13, 0xc4, 0x91, 0x1f, 0x44, 0x38, 0x15, 0x49, 0x84, 0xb3, 0x93, 0x22, 0xd6, 0x0a, 0x4a,
+	0x08, 0x8b, 0x5b, 0xc4, 0xb9, 0x90, 0xfa, 0x86, 0x7b, 0x97, 0x3a, 0xf1, 0x29, 0xa1, 0xc6, 0x2d,
+	0x5a, 0xca, 0xaa, 0x80, 0xd9, 0xed, 0xc8, 0x3d, 0x20, 0x2d, 0x6b, 0x27, 0x89, 0xad, 0x58, 0xce,
+	0x4b, 0xfe, 0x5e, 0x5c, 0x4b, 0x61, 0xc3, 0x0c, 0x0a, 0xec, 0x22, 0x65, 0x9c, 0x5c, 0x3b, 0xa0,
+	0x75, 0x31, 0xc4, 0xd0, 0xa8, 0x83, 0x5f, 0xa4, 0xf3, 0x3a, 0x08, 0x93, 0x75, 0xcd, 0xef, 0x18,
+	0x86, 0x87, 0xf4, 0x49, 0x64, 0x3b, 0x34, 0x8c, 0x78, 0xf2, 0x6c, 0xe8, 0xb1, 0x29, 0x26, 0xf2,
+	0xf1, 0xa4, 0xc7, 0x66, 0x59, 0x35, 0x1a, 0x92, 0x8d, 0x34, 0xef, 0xcd, 0xaf, 0x41, 0xf8, 0x92,
+	0x9c, 0x78, 0x15, 0x03, 0xc7, 0x1a, 0xa6, 0xe5, 0x93, 0x06, 0xcc, 0xe8, 0xc5, 0xcb, 0xb6, 0x63,
+	0xfb, 0xdb, 0x32, 0xcd, 0xd5, 0xe1, 0x1d, 0x46, 0xb9, 0xbf, 0xcb, 0x6a, 0x2e, 0x46, 0xec, 0x42,
+	0x8d, 0x7c, 0xca, 0x80, 0x8b, 0x89, 0x79, 0xd1, 0x92, 0x6e, 0x1d, 0xde, 0x77, 0x94, 0xc7, 0xdb,
+	0x5a, 0xcd, 0x47, 0x89, 0xdd, 0xe8, 0x75, 0x0b, 0xc2, 0x3c, 0xfc, 0x72, 0x09, 0xc2, 0xcc, 0xdc,
+	0xfc, 0xb8, 0xdd, 0xd4, 0x2b, 0xc3, 0xcd, 0x8f, 0x77, 0xf5, 0x78, 0xdd, 0xfc, 0x04, 0x89, 0xee,
+	0x06, 0xaf, 0xef, 0x86, 0xf3, 0xbc, 0xda, 0x7c, 0x9d, 0x0b, 0xda, 0x7c, 0x5a, 0x9f, 0xaf, 0xd7,
+	0xf9, 0xf3, 0xf6, 0x60, 0x1d, 0xd9, 0xc3, 0x30, 0xd0, 0xf1, 0x9a, 0xc9, 0xa8, 0xde, 0x2c, 0x18,
+	0x16, 0x2b, 0x37, 0xff, 0x66, 0x00, 0xa6, 0x39, 0x6e, 0xe6, 0xaa, 0xbe, 0xe4, 0x07, 0x76, 0x8b,
+	0xf1, 0x00, 0x87, 0x8b, 0x1a, 0x72, 0x17, 0x26, 0x45, 0xfc, 0x16, 0x79, 0x3f, 0x16, 0x8c, 0x1d,
+	0x12, 0x99, 0x60, 0x5d, 0x57, 0x91, 0xa1, 0x8e, 0x5b, 0x21, 0x26, 0xd2, 0xc8, 0x97, 0x07, 0x8e,
+	0x84, 0x98, 0xb5, 0xa3, 0x11, 0x13, 0x3f, 0x89, 0x03, 0x53, 0x32, 0x7e, 0x49, 0x38, 0xb4, 0x62,
+	0xc1, 0xfb, 0x23, 0xeb, 0xcf, 0x35, 0x0d, 0x1b, 0x26, 0xb0, 0xab, 0xf4, 0xe4, 0xe8, 0x86, 0x8e,
+	0x86, 0x9e, 0x1c, 0x5e, 0x02, 0xbb, 0xf9, 0x03, 0x25, 0x38, 0x2d, 0xbe, 0x7e, 0x7c, 0xc1, 0x90,
+	0x7b, 0x30, 0xea, 0xc9, 0x4b, 0x46, 0xee, 0xcc, 0xd5, 0xc2, 0x0b, 0x3b, 0xe3, 0xe2, 0x12, 0xef,
+	0xf5, 0xf0, 0x17, 0x46, 0xb4, 0xc8, 0x77, 0xb2, 0x94, 0x3e, 0x4e, 0xcd, 0xdb, 0xe5, 0xf1, 0x3b,
+	0xe6, 0x03, 0x26, 0x33, 0xe9, 0x27, 0x83, 0xc4, 0x52, 0x02, 0x97, 0x4c, 0xa4, 0x9c, 0x28, 0xc5,
+	0x14, 0x4d, 0xf3, 0x8f, 0x87, 0xa0, 0x9c, 0xd7, 0x7b, 0x16, 0x37, 0xee, 0x7c, 0x2d, 0x7e, 0xf8,
+	0xb0, 0x00, 0x5a, 0xae, 0x17, 0xe6, 0x71, 0x2a, 0x2c, 0x95, 0xab, 0xcc, 0x47, 0xd3, 0xc3, 0x93,
+	0x97, 0x55, 0x32, 0x29, 0x60, 0x0e, 0x65, 0xf2, 0x01, 0x00, 0xdf, 0xdf, 0xbe, 0x41, 0x77, 0xdb,
+	0x96, 0x1d, 0x1a, 0x10, 0xde, 0x28, 0x7e, 0x1a, 0x55, 0xaf, 0x4b, 0x54, 0x51, 0xa7, 0x84, 0xca,
+	0x24, 0x2e, 0x57, 0xc8, 0x31, 0x15, 0xf7, 0xa4, 0xab, 0x46, 0x72, 0xeb, 0xc7, 0xa7, 0x24, 0x33,
+	0x24, 0x9c, 0x78, 0xf0, 0xe9, 0x20, 0x9d, 0x24, 0xfb, 0x2c, 0xd3, 0x7e, 0x92, 0xc1, 0x92, 0xbb,
+	0x67, 0xad, 0xd0, 0x4c, 0xe4, 0x71, 0x6b, 0x42, 0x78, 0x94, 0x06, 0xa7, 0xc9, 0xf3, 0x4e, 0xd1,
+	0xa0, 0x56, 0x8f, 0xd7, 0x1c, 0xeb, 0xd4, 0x70, 0xf1, 0x4e, 0xb1, 0x0c, 0xbb, 0x1a, 0x32, 0xbd,
+	0x53, 0x69, 0x70, 0x9a, 0x3c, 0xcb, 0xcc, 0x76, 0x81, 0x7f, 0x66, 0x26, 0xff, 0x12, 0x36, 0x80,
+	0x7f, 0xe7, 0x42, 0xef, 0x31, 0xbf, 0x79, 0x3e, 0x07, 0xaf, 0x10, 0xbf, 0x79, 0xde, 0xd7, 0x1c,
+	0x3b, 0xfb, 0xcf, 0x1b, 0xf2, 0x06, 0x3f, 0x64, 0xba, 0xbd, 0x13, 0x34, 0x01, 0x7f, 0x4d, 0x9c,
+	0x2d, 0x7b, 0x20, 0x0e, 0xa6, 0x94, 0xcc, 0x94, 0x6d, 0xde, 0x86, 0x49, 0xcd, 0xcc, 0x5e, 0x09,
+	0x0e, 0x9d, 0x15, 0xd6, 0x5a, 0x8d, 0xfd, 0x5c, 0xea, 0x16, 0xb5, 0x3a, 0x5e, 0xf2, 0xe9, 0x93,
+	0xed, 0xef, 0xce, 0x92, 0x27, 0x72, 0xc9, 0x87, 0xda, 0x44, 0x1e, 0x72, 0xda, 0xef, 0x47, 0x9b,
+	0xc8, 0x63, 0x57, 0xcb, 0x10, 0xf1, 0xe2, 0x7f, 0x94, 0x58, 0xc9, 0x3b, 0xf4, 0x00, 0xf0, 0x37,
+	0x63, 0x11, 0xc3, 0xd9, 0x64, 0xd8, 0x76, 0xbe, 0x24, 0x53, 0xb5, 0x09, 0x0a, 0x7d, 0xa4, 0xb8,
+	0xcb, 0x0a, 0xe5, 0xd4, 0x63, 0xba, 0xc8, 0x11, 0x4d, 0x0f, 0xa9, 0x2b, 0x5e, 0x06, 0x4f, 0x42,
+	0xf1, 0xe2, 0xc1, 0xf8, 0xb6, 0x7d, 0x87, 0x7a, 0x8e, 0xe0, 0xa7, 0x86, 0x8a, 0x3f, 0x14, 0xae,
+	0xc7, 0x68, 0x84, 0x34, 0x4a, 0x29, 0x40, 0x95, 0x08, 0xf1, 0xb4, 0x34, 0x16, 0xc3, 0xc5, 0xb9,
+	0x92, 0x58, 0x43, 0x12, 0x8f, 0x33, 0x27, 0x85, 0x85, 0x03, 0xe0, 0x44, 0xb1, 0xdd, 0xfb, 0xd1,
+	0x4f, 0xc6, 0x11, 0xe2, 0x05, 0xd3, 0x11, 0xff, 0x46, 0x85, 0x02, 0x9b, 0xd7, 0x56, 0x9c, 0xea,
+	0xa8, 0x3c, 0x5a, 0x7c, 0x5e, 0x95, 0x8c, 0x49, 0x52, 0xca, 0x17, 0x17, 0xa0, 0x4a, 0x84, 0x8d,
+	0xb1, 0x15, 0x25, 0xf8, 0x29, 0x8f, 0x15, 0x1f, 0x63, 0x9c, 0x26, 0x48, 0x8c, 0x31, 0xfe, 0x8d,
+	0x0a, 0x05, 0xa6, 0x8b, 0x8d, 0xd4, 0xd8, 0x50, 0x5c, 0x56, 0xda, 0x93, 0x0a, 0xfb, 0x4d, 0xb1,
+	0xc8, 0x70, 0x9c, 0xef, 0xd3, 0x8b, 0x8a, 0xb8, 0x90, 0x27, 0x3e, 0x62, 0x67, 0x47, 0x4a, 0x7c,
+	0x18, 0x3b, 0xf7, 0x4c, 0x74, 0x75, 0xee, 0xa9, 0xc0, 0xb4, 0x70, 0xe5, 0x93, 0x0e, 0xb2, 0xfc,
+	0x40, 0x98, 0x8c, 0x75, 0x71, 0xd5, 0x24, 0x10, 0xd3, 0xf5, 0xc5, 0x81, 0x4f, 0xeb, 0xbc, 0xed,
+	0x94, 0x7a, 0xe0, 0x8b, 0x32, 0x8c, 0xa0, 0xe4, 0x1e, 0x4c, 0xf8, 0x8a, 0xa7, 0x50, 0xf9, 0x54,
+	0xbf, 0x9a, 0x6c, 0x81, 0x47, 0x98, 0xe4, 0xaa, 0x25, 0xa8, 0xd1, 0xd1, 0xd5, 0xac, 0xa7, 0x4f,
+	0x56, 0xcd, 0xca, 0x72, 0xa9, 0xa8, 0x4e, 0x00, 0xd3, 0x47, 0x12, 0xc4, 0xeb, 0x40, 0x27, 0x01,
+	0xf6, 0x69, 0xe9, 0x4e, 0xdb, 0xf5, 0x3b, 0x1e, 0xe5, 0xd6, 0xc7, 0xfc, 0xf3, 0x90, 0xf8, 0xd3,
+	0x2e, 0x25, 0x81, 0x98, 0xae, 0xcf, 0xdf, 0x7e, 0xfe, 0xae, 0x1f, 0xd0, 0x16, 0xbb, 0xb6, 0x5c,
+	0x87, 0x32, 0x63, 0x8a, 0x33, 0xc5, 0xdf, 0x7e, 0xd5, 0x04, 0x2e, 0x71, 0xed, 0x24, 0x4b, 0x31,
+	0x45, 0x93, 0xad, 0x1c, 0x35, 0x0c, 0x58, 0xf9, 0x6c, 0xf1, 0x95, 0xa3, 0x86, 0x18, 0x13, 0x2b,
+	0x47, 0x2d, 0x41, 0x8d, 0x0e, 0xf3, 0x2c, 0x93, 0x56, 0x68, 0xd4, 0xe3, 0x33, 0x78, 0x2e, 0x0e,
+	0xe3, 0x5d, 0x55, 0x01, 0xa8, 0xd7, 0x23, 0x1f, 0x81, 0x09, 0xf5, 0xee, 0x2c, 0x9f, 0x3f, 0xea,
+	0x84, 0x36, 0xa2, 0xe7, 0x2a, 0x48, 0x23, 0x48, 0x10, 0xce, 0x2b, 0x3e, 0xac, 0xea, 0xfe, 0xbe,
+	0xc0, 0x87, 0x20, 0xde, 0xb3, 0x99, 0x35, 0x30, 0xa7, 0x25, 0xf9, 0x6c, 0xb6, 0xd5, 0x46, 0xf9,
+	0xca, 0x40, 0xd1, 0x34, 0x5a, 0x29, 0xd3, 0x8c, 0xdb, 0x76, 0xb0, 0x7d, 0x8b, 0x3f, 0x8a, 0xfc,
+	0xc3, 0x1a, 0x70, 0x98, 0xff, 0x96, 0x29, 0x98, 0x42, 0xb9, 0xdd, 0x49, 0x68, 0xcc, 0xea, 0x9a,
+	0x28, 0x73, 0xa1, 0x2f, 0x39, 0x63, 0x6e, 0xbe, 0x32, 0xf3, 0x4b, 0x06, 0x4c, 0xc5, 0xd5, 0x4e,
+	0xe0, 0x69, 0x54, 0xd3, 0x9f, 0x46, 0x6f, 0xef, 0x6f, 0x5c, 0x39, 0xef, 0xa3, 0xbf, 0x29, 0xa9,
+	0xa3, 0xe2, 0xdc, 0xef, 0x3d, 0xcd, 0x02, 0x85, 0x91, 0xbe, 0xde, 0x8f, 0x05, 0x8a, 0x1a, 0x04,
+	0x26, 0x1e, 0x6f, 0x86, 0x45, 0xca, 0x87, 0x35, 0xfe, 0xb3, 0x8f, 0x58, 0x64, 0x11, 0xb3, 0x19,
+	0x92, 0x16, 0x13, 0x70, 0x10, 0x33, 0xfa, 0xa2, 0x7a, 0x3d, 0xf5, 0x91, 0x63, 0x4c, 0x1b, 0x70,
+	0x77, 0xdb, 0x9f, 0x5f, 0x26, 0x30, 0xae, 0x88, 0xb8, 0x13, 0xf6, 0x34, 0xc6, 0x49, 0xd8, 0xd3,
+	0x04, 0x30, 0x5e, 0x8b, 0x12, 0x58, 0x87, 0xd3, 0xde, 0x27, 0xcd, 0xe8, 0x5a, 0x8c, 0x53, 0x63,
+	0xfb, 0xa8, 0x92, 0x61, 0xcc, 0x5b, 0xb4, 0xc6, 0x06, 0x8e, 0xc0, 0xca, 0xa9, 0xdb, 0xba, 0x7a,
+	0x23, 0x40, 0xc8, 0xff, 0xd3, 0xba, 0xcc, 0xdd, 0x12, 0x59, 0xf0, 0xaf, 0xf8, 0xd7, 0x23, 0x18,
+	0x2a, 0xf5, 0xd2, 0xf6, 0x19, 0x43, 0x27, 0x66, 0x9f, 0xc1, 0x96, 0x01, 0x2b, 0x58, 0xf2, 0xbc,
+	0x38, 0x7d, 0xf7, 0x33, 0x45, 0x09, 0x73, 0x2c, 0xf1, 0x32, 0x88, 0x8a, 0x7c, 0x54, 0x88, 0xe4,
+	0x98, 0x55, 0x8d, 0x14, 0x32, 0xab, 0xea, 0xc0, 0x19, 0x8f, 0x06, 0xde, 0x6e, 0x65, 0xb7, 0xc6,
+	0x93, 0x9e, 0x79, 0x01, 0x7f, 0xc1, 0x8f, 0x16, 0x8b, 0x96, 0x8b, 0x69, 0x54, 0x98, 0x85, 0x5f,
+	0x63, 0x80, 0xc7, 0xba, 0x32, 0xc0, 0x6f, 0x82, 0xf1, 0x80, 0xd6, 0xb6, 0x1d, 0x66, 0x94, 0xbd,
+	0xb2, 0x28, 0x33, 0x7b, 0xc4, 0xbc, 0x5c, 0x0c, 0x42, 0xb5, 0x1e, 0x59, 0x80, 0x81, 0x8e, 0x5d,
+	0x97, 0x2f, 0x80, 0x6f, 0x88, 0x94, 0x45, 0x2b, 0x8b, 0x0f, 0xf6, 0x66, 0x5f, 0x1d, 0xdb, 0x29,
+	0x45, 0xa3, 0xba, 0xda, 0xbe, 0xdb, 0xb8, 0x1a, 0x30, 0x0f, 0xf7, 0xb9, 0xcd, 0x95, 0x45, 0x64,
+	0x8d, 0xb3, 0x4c, 0xce, 0x26, 0x0e, 0x61, 0x72, 0xf6, 0x19, 0x03, 0xce, 0x58, 0x49, 0x3d, 0x17,
+	0xf5, 0xcb, 0x93, 0xc5, 0x4f, 0xcb, 0x6c, 0xdd, 0xd9, 0xc2, 0x45, 0x39, 0xbe, 0x33, 0xf3, 0x69,
+	0x72, 0x98, 0xd5, 0x07, 0x26, 0xb7, 0x69, 0xd9, 0x0d, 0xb1, 0x06, 0xe2, 0xaf, 0x3e, 0x55, 0x4c,
+	0x6e, 0xb3, 0x96, 0xc2, 0x84, 0x19, 0xd8, 0xc9, 0x7d, 0x18, 0x57, 0x98, 0xa4, 0xf2, 0xa9, 0x3e,
+	0x78, 0xe2, 0x84, 0x4a, 0x43, 0xbc, 0x76, 0x95, 0x02, 0x54, 0x29, 0x45, 0xba, 0x76, 0x45, 0xcc,
+	0x20, 0xf5, 0xcd, 0x7c, 0xd4, 0xa7, 0x8b, 0xeb, 0xda, 0xb3, 0x31, 0x62, 0x17, 0x6a, 0x3c, 0x46,
+	0x2d, 0x03, 0x2b, 0x6f, 0xf3, 0xf2, 0x74, 0xf1, 0x70, 0x3f, 0xab, 0x3a, 0x2a, 0xb1, 0x34, 0x13,
+	0x85, 0x98, 0x24, 0x48, 0x96, 0x81, 0x48, 0x5d, 0x51, 0xfc, 0x38, 0xf3, 0xcb, 0x84, 0x9b, 0x81,
+	0xf0, 0x4f, 0xba, 0x94, 0x82, 0x62, 0x46, 0x0b, 0x12, 0x68, 0xb2, 0x92, 0x3e, 0x5e, 0x39, 0xc9,
+	0x6c, 0x7a, 0x5d, 0x25, 0x26, 0xdf, 0x6e, 0xc0, 0x94, 0xad, 0x66, 0x2b, 0xf1, 0xe5, 0xe3, 0xe6,
+	0x7a, 0x31, 0xab, 0x6b, 0x15, 0x93, 0x24, 0xcf, 0x65, 0xc1, 0x3a, 0x04, 0x13, 0x34, 0xc9, 0x4f,
+	0x1a, 0x70, 0xa1, 0x95, 0x9d, 0x46, 0xba, 0x7c, 0xae, 0xb8, 0xe2, 0x2a, 0x27, 0x33, 0x35, 0x17,
+	0x63, 0xe4, 0xa5, 0xad, 0xc6, 0xbc, 0x8e, 0x90, 0x0f, 0xc3, 0x24, 0x95, 0xba, 0xf0, 0x3a, 0x53,
+	0x8c, 0xcb, 0x57, 0xd5, 0x52, 0xf1, 0x6d, 0xa7, 0x68, 0xd7, 0xc5, 0xf5, 0xb8, 0xa4, 0xe2, 0x47,
+	0x9d, 0x9c, 0xf9, 0x07, 0x86, 0x14, 0x85, 0x9f, 0xa0, 0x55, 0xde, 0x71, 0x9b, 0x4b, 0x98, 0xb7,
+	0xa1, 0x5c, 0x0d, 0x23, 0x5c, 0xd7, 0x13, 0x59, 0x8e, 0xde, 0x06, 0x93, 0x42, 0x15, 0xb5, 0x66,
+	0xb5, 0x6f, 0xc6, 0x7a, 0x8b, 0x48, 0x4b, 0x5f, 0x51, 0x81, 0xa8, 0xd7, 0x35, 0xbf, 0x62, 0xc0,
+	0x05, 0x1d, 0xb3, 0xeb, 0xd9, 0x2f, 0xf5, 0x8f, 0x98, 0x7c, 0xdc, 0x80, 0xf1, 0xbb, 0x91, 0x96,
+	0x2c, 0x64, 0x1d, 0x0b, 0x79, 0x2e, 0x85, 0xbd, 0xa2, 0x9e, 0xa2, 0x76, 0x4b, 0xa7, 0xce, 0x8e,
+	0x81, 0x3e, 0xaa, 0xa4, 0xcd, 0xff, 0x66, 0x40, 0x4a, 0x7c, 0xc1, 0x1c, 0x28, 0x18, 0x11, 0x96,
+	0xb0, 0xcf, 0x28, 0xee, 0x40, 0x51, 0x11, 0x28, 0x84, 0x52, 0x46, 0xfe, 0xc0, 0x10, 0x31, 0x13,
+	0x88, 0x38, 0x4a, 0x0a, 0x44, 0xb9, 0x3c, 0x0a, 0x3d, 0x1b, 0xd4, 0x54, 0x8a, 0x42, 0xac, 0xa0,
+	0x96, 0xa0, 0x46, 0xc7, 0x5c, 0x05, 0x88, 0x45, 0x4e, 0x7d, 0x5b, 0xb9, 0xfe, 0xe6, 0x59, 0x38,
+	0xd7, 0xb7, 0x4f, 0xee, 0xc7, 0x0c, 0x38, 0x4f, 0xef, 0xd9, 0xb5, 0x60, 0x7e, 0x2b, 0xa0, 0xde,
+	0xad, 0x5b, 0x6b, 0x51, 0x14, 0xe4, 0x5e, 0x2c, 0x5e, 0x32, 0x0c, 0x10, 0xb9, 0x68, 0x64, 0x29,
+	0x13, 0x23, 0xe6, 0x50, 0xe2, 0xe2, 0xb6, 0x7b, 0x42, 0x10, 0x81, 0xec, 0x30, 0xe9, 0x78, 0x7e,
+	0x20, 0xc3, 0x67, 0x0a, 0x71, 0x5b, 0x12, 0x88, 0xe9, 0xfa, 0x49, 0x24, 0x3c, 0xbf, 0x2f, 0x7f,
+	0x37, 0x18, 0x69, 0x24, 0x1c, 0x88, 0xe9, 0xfa, 0x2a, 0x12, 0xf1, 0xa5, 0xd8, 0xa5, 0x3c, 0x94,
+	0x46, 0x12, 0x01, 0x31, 0x5d, 0x9f, 0xd4, 0xe1, 0x92, 0x47, 0x6b, 0x6e, 0xab, 0x45, 0x9d, 0x3a,
+	0x9f, 0x94, 0x35, 0xcb, 0x6b, 0xd8, 0xce, 0xb2, 0x67, 0xf1, 0x8a, 0x5c, 0x7b, 0x61, 0xf0, 0x54,
+	0xfc, 0x97, 0xb0, 0x4b, 0x3d, 0xec, 0x8a, 0x85, 0xb4, 0xe0, 0x54, 0x87, 0xdf, 0x3f, 0x1e, 0x0f,
+	0xaf, 0x77, 0xcf, 0x6a, 0x96, 0x47, 0x0a, 0x7d, 0x31, 0xce, 0x28, 0x6c, 0xea, 0xa8, 0x30, 0x89,
+	0x9b, 0xec, 0xc2, 0x99, 0xa8, 0x3b, 0x0a, 0xc9, 0xd1, 0x42, 0x24, 0xe5, 0x13, 0x21, 0x85, 0x0e,
+	0xb3, 0x68, 0xb0, 0xb8, 0xe9, 0x81, 0xe5, 0x35, 0x68, 0x50, 0x59, 0xdf, 0x94, 0xb1, 0xb9, 0xed,
+	0xa6, 0x78, 0x2d, 0x18, 0x02, 0xd5, 0x46, 0x1a, 0x8c, 0x59, 0x6d, 0xc8, 0x47, 0xe0, 0x35, 0xfa,
+	0xa4, 0xae, 0xba, 0xf7, 0xa9, 0xb7, 0xe0, 0x76, 0x9c, 0xba, 0x8e, 0x1c, 0x38, 0xf2, 0xc7, 0xf7,
+	0xf7, 0x66, 0x5f, 0x83, 0xbd, 0x34, 0xc0, 0xde, 0xf0, 0xa6, 0x3b, 0xb0, 0xd9, 0x6e, 0x67, 0x76,
+	0x60, 0x3c, 0xaf, 0x03, 0x39, 0x0d, 0xb0, 0x37, 0xbc, 0x4c, 0xb4, 0x29, 0x26, 0x66, 0x8d, 0xb6,
+	0x5c, 0x6f, 0x57, 0xa1, 0x38, 0xc1, 0x29, 0xf2, 0xfd, 0xbb, 0x91, 0x59, 0x03, 0x73, 0x5a, 0xb2,
+	0x3b, 0xe5, 0xb1, 0xbc, 0xe1, 0xa7, 0xc8, 0x4c, 0x72, 0x32, 0xaf, 0xdb, 0xdf, 0x9b, 0x7d, 0x0c,
+	0x7b, 0x6c, 0x83, 0x3d, 0x63, 0xcf, 0xe8, 0x4a, 0x3c, 0x11, 0xa9, 0xae, 0x4c, 0xe5, 0x75, 0x25,
+	0xbf, 0x0d, 0xf6, 0x8c, 0x9d, 0x7c, 0x97, 0x01, 0x0f, 0xd5, 0xda, 0x9d, 0xeb, 0xb6, 0x1f, 0xb8,
+	0x0d, 0xcf, 0x6a, 0x2d, 0xd2, 0x9a, 0xb5, 0x7b, 0xdd, 0x6a, 0x6e, 0xb1, 0x48, 0xfe, 0xe5, 0x53,
+	0x85, 0x36, 0x0e, 0xf7, 0x3f, 0xaf, 0xac, 0x6f, 0x66, 0x23, 0xc5, 0x7c, 0x7a, 0xe4, 0x07, 0x0d,
+	0xb8, 0xd4, 0xe2, 0x5d, 0xcc, 0xe9, 0xd0, 0xe9, 0x42, 0x1d, 0xe2, 0xa7, 0xd8, 0x5a, 0x17, 0xbc,
+	0xd8, 0x95, 0x2a, 0x9f, 0x24, 0x51, 0x61, 0xbe, 0xd1, 0xf0, 0x68, 0x83, 0x63, 0x8d, 0x4e, 0x97,
+	0xe9, 0xe2, 0x93, 0xb4, 0x96, 0x87, 0x14, 0xf3, 0xe9, 0x91, 0xf7, 0xc3, 0xe5, 0x5c, 0x60, 0x85,
+	0x59, 0x40, 0x71, 0x25, 0xd0, 0xc0, 0x82, 0xb9, 0xbf, 0x37, 0x7b, 0x79, 0xad, 0x6b, 0x4d, 0x3c,
+	0x00, 0x13, 0x0f, 0xc1, 0xa3, 0x45, 0xc3, 0x38, 0xc3, 0x39, 0xb1, 0xf7, 0x16, 0xe1, 0x42, 0x8e,
+	0x32, 0x04, 0xc6, 0x97, 0xf4, 0x10, 0x18, 0x67, 0x79, 0xaf, 0xde, 0x7d, 0x74, 0xbd, 0xfa, 0x9a,
+	0xc4, 0xbd, 0xd0, 0xee, 0xe4, 0x30, 0xd7, 0xa6, 0x78, 0x23, 0x89, 0xef, 0x7a, 0x8e, 0x7f, 0x57,
+	0xfd, 0x4e, 0xce, 0xa8, 0x87, 0x5d, 0xb1, 0xbc, 0xe2, 0xa2, 0x6b, 0x7c, 0xc6, 0x00, 0xe9, 0x2c,
+	0xcd, 0xec, 0x92, 0x14, 0xe3, 0xaa, 0xd1, 0x84, 0x61, 0xd5, 0x25, 0x19, 0x7d, 0xb3, 0x14, 0x43,
+	0xb5, 0x58, 0xb4, 0x71, 0xc4, 0xf3, 0xb1, 0xf8, 0x49, 0x26, 0x30, 0xc7, 0x21, 0xcf, 0x59, 0xae,
+	0xb7, 0x48, 0x74, 0x20, 0x45, 0xba, 0x3c, 0xd7, 0x5b, 0x2c, 0x63, 0x88, 0xe1, 0xe6, 0xcf, 0x95,
+	0x40, 0x62, 0x60, 0x94, 0xc8, 0x23, 0x61, 0x64, 0x28, 0xd1, 0xc1, 0x48, 0x2f, 0xa2, 0x46, 0x87,
+	0x3a, 0xd8, 0xf3, 0x87, 0x39, 0xf8, 0x74, 0x78, 0x7a, 0x6f, 0xe9, 0xad, 0xc3, 0x0d, 0x7d, 0x36,
+	0x79, 0x09, 0x4a, 0x08, 0xd9, 0x84, 0x91, 0x96, 0xed, 0x70, 0xc7, 0xaa, 0x62, 0xa6, 0xd1, 0xfc,
+	0xd5, 0xb1, 0x26, 0x50, 0x60, 0x88, 0x8b, 0xbc, 0x07, 0x86, 0x78, 0x94, 0x7e, 0x29, 0x93, 0x7e,
+	0xaa, 0x98, 0xdd, 0x83, 0x5d, 0xa3, 0xe2, 0x2d, 0xc0, 0xff, 0x45, 0x81, 0xd2, 0xfc, 0x15, 0x03,
+	0x4e, 0xe9, 0xe1, 0xed, 0x79, 0xec, 0x2a, 0x19, 0xf5, 0x58, 0xa6, 0x98, 0xe1, 0xdd, 0x92, 0xd1,
+	0x5c, 0x31, 0x84, 0xe9, 0xfa, 0xfd, 0x3e, 0xf4, 0x37, 0xd9, 0x51, 0xf6, 0x0f, 0x50, 0xa5, 0xfc,
+	0xf0, 0x19, 0x18, 0x16, 0x1b, 0x88, 0xbd, 0x44, 0x32, 0xa2, 0xd8, 0xdd, 0x28, 0x9e, 0xae, 0xa9,
+	0x48, 0x68, 0x2b, 0x35, 0xa3, 0x79, 0xa9, 0x6b, 0x46, 0x73, 0x84, 0x81, 0x9a, 0x67, 0xf7, 0x63,
+	0xcb, 0x55, 0xc1, 0x15, 0x61, 0xcb, 0x55, 0xc1, 0x15, 0x64, 0xc8, 0x98, 0x10, 0x4d, 0x31, 0x72,
+	0x1a, 0x2c, 0x2e, 0x44, 0x13, 0x13, 0xa0, 0x98, 0x3a, 0x4d, 0x75, 0x35, 0x73, 0x0a, 0xb3, 0x72,
+	0x0c, 0x15, 0xf7, 0xf2, 0x93, 0x53, 0xde, 0x4b, 0x56, 0x8e, 0x70, 0x93, 0x0e, 0xe7, 0x6e, 0xd2,
+	0x2d, 0x18, 0x91, 0xdb, 0xac, 0x3c, 0x52, 0x5c, 0x06, 0x20, 0x6d, 0x47, 0x95, 0xdc, 0x93, 0xa2,
+	0x00, 0x43, 0xe4, 0xec, 0x9d, 0xdc, 0x92, 0x3e, 0x09, 0xa3, 0x7c, 0x87, 0x28, 0x55, 0x79, 0x31,
+	0x86, 0x70, 0x5e, 0x55, 0xba, 0x4b, 0x8c, 0x25, 0xaa, 0x8a, 0x62, 0x0c, 0xe1, 0xe4, 0x3d, 0x30,
+	0xda, 0xb2, 0x76, 0xaa, 0x1d, 0xaf, 0x41, 0xcb, 0x70, 0x80, 0x58, 0xab, 0x13, 0xd8, 0xcd, 0x39,
+	0xa6, 0x5b, 0x0b, 0xbc, 0xb9, 0x15, 0x27, 0xb8, 0xe5, 0x55, 0x03, 0x6e, 0x42, 0xc5, 0x57, 0xdd,
+	0x9a, 0xc4, 0x82, 0x11, 0x3e, 0xd2, 0x84, 0xa9, 0x96, 0xb5, 0xb3, 0xe9, 0x58, 0x22, 0xe1, 0x8a,
+	0x7c, 0x27, 0x14, 0xa1, 0xc0, 0xe5, 0x9a, 0x6b, 0x1a, 0x2e, 0x4c, 0xe0, 0xce, 0x30, 0xa7, 0x9d,
+	0x38, 0x2e, 0x73, 0xda, 0xf9, 0x28, 0xdc, 0x88, 0x50, 0x8a, 0x3c, 0x94, 0x19, 0xe8, 0xb3, 0x6b,
+	0x28, 0x91, 0x17, 0xa2, 0x50, 0x22, 0x53, 0xc5, 0xed, 0x3f, 0xbb, 0x84, 0x11, 0xe9, 0xc0, 0x78,
+	0xdd, 0x0a, 0x2c, 0x51, 0xca, 0xb4, 0x16, 0x85, 0xf5, 0xfb, 0x8b, 0x11, 0x9a, 0xf8, 0x48, 0x8a,
+	0xcb, 0x7c, 0x54, 0xe9, 0x30, 0x77, 0x53, 0xb6, 0x59, 0x9b, 0x34, 0x88, 0xab, 0x70, 0xa9, 0xdf,
+	0x69, 0xbe, 0x7f, 0xb8, 0xbb, 0xe9, 0x8d, 0xac, 0x0a, 0x98, 0xdd, 0x2e, 0x0e, 0x4a, 0x3d, 0x9d,
+	0x1d, 0x94, 0x9a, 0x7c, 0x77, 0x96, 0xe1, 0x12, 0xb9, 0x62, 0x14, 0xbd, 0x19, 0xc4, 0xd9, 0x50,
+	0xd8, 0x7c, 0xe9, 0x9f, 0x19, 0x50, 0x96, 0xab, 0x4c, 0x1a, 0x1b, 0x35, 0xa9, 0xb7, 0x66, 0x39,
+	0x56, 0x83, 0x7a, 0xe5, 0x33, 0xc5, 0xa3, 0x43, 0xad, 0xe5, 0xe0, 0x8c, 0x62, 0xbc, 0x3c, 0xba,
+	0xbf, 0x37, 0x7b, 0xe5, 0xa0, 0x5a, 0x98, 0xdb, 0x37, 0xe2, 0xc1, 0x88, 0xbf, 0xeb, 0xd7, 0x82,
+	0xa6, 0x2f, 0xb9, 0xe8, 0x6b, 0x7d, 0x9c, 0xac, 0x55, 0x81, 0x49, 0x1c, 0xad, 0x71, 0xa2, 0x65,
+	0x51, 0x8a, 0x21, 0x21, 0x16, 0x1b, 0x66, 0x5a, 0xaa, 0x1f, 0x95, 0x18, 0x5a, 0xe7, 0x8a, 0xbb,
+	0x3c, 0x55, 0x92, 0xc8, 0x42, 0x03, 0x23, 0x2e, 0x0f, 0x4b, 0x41, 0x31, 0x4d, 0x9d, 0x5d, 0xaa,
+	0x6d, 0xcf, 0x76, 0x3d, 0xa6, 0x36, 0x3d, 0xcf, 0x0f, 0x4f, 0x99, 0x8a, 0x42, 0x94, 0x61, 0x04,
+	0x25, 0x55, 0x98, 0x12, 0x72, 0xa7, 0x6a, 0xe0, 0x59, 0x01, 0x6d, 0xec, 0x4a, 0x7b, 0xab, 0xd7,
+	0xb2, 0x13, 0x64, 0x53, 0x83, 0x3c, 0xd8, 0x9b, 0x3d, 0x27, 0xbf, 0x8d, 0x0e, 0xc0, 0x04, 0x0a,
+	0xf2, 0xc1, 0x84, 0xf9, 0x5b, 0xb9, 0x78, 0xd6, 0xe0, 0x90, 0xd7, 0xef, 0xdd, 0x08, 0xae, 0xdf,
+	0xb0, 0x90, 0x7d, 0x24, 0x73, 0x9a, 0x79, 0x1a, 0x26, 0xd4, 0x55, 0x73, 0x98, 0xb6, 0x66, 0x00,
+	0x24, 0x3d, 0xd8, 0xe3, 0x0e, 0xd8, 0x65, 0xfe, 0x94, 0x01, 0xa7, 0x93, 0xbc, 0x0b, 0xd9, 0x86,
+	0x11, 0x79, 0x90, 0x95, 0x8d, 0xe2, 0xd6, 0x1c, 0xf2, 0x88, 0x94, 0x91, 0xc3, 0x39, 0x2b, 0x2c,
+	0x8b, 0x30, 0x44, 0xaf, 0xfa, 0x74, 0x94, 0xba, 0xf8, 0x74, 0x3c, 0x03, 0xe7, 0xb3, 0x8f, 0x34,
+	0xf6, 0x48, 0x61, 0x81, 0x4d, 0xee, 0x4b, 0xb1, 0x7b, 0xf4, 0x48, 0xe1, 0x0f, 0x3a, 0x14, 0x30,
+	0xf3, 0x43, 0x90, 0x4c, 0x1d, 0x4a, 0xde, 0x0f, 0x63, 0xbe, 0xbf, 0x2d, 0x2c, 0xf5, 0xca, 0x46,
+	0x1f, 0xca, 0xaa, 0x30, 0xa1, 0x94, 0x78, 0x57, 0x45, 0x3f, 0x31, 0x46, 0xbf, 0xf0, 0xfc, 0x17,
+	0xbe, 0x72, 0xf9, 0x55, 0xbf, 0xff, 0x95, 0xcb, 0xaf, 0xfa, 0xf2, 0x57, 0x2e, 0xbf, 0xea, 0x5b,
+	0xf7, 0x2f, 0x1b, 0x5f, 0xd8, 0xbf, 0x6c, 0xfc, 0xfe, 0xfe, 0x65, 0xe3, 0xcb, 0xfb, 0x97, 0x8d,
+	0x3f, 0xdf, 0xbf, 0x6c, 0x7c, 0xef, 0x7f, 0xb8, 0xfc, 0xaa, 0xf7, 0x3c, 0x19, 0x53, 0xbf, 0x1a,
+	0x12, 0x8d, 0xff, 0x61, 0x26, 0x12, 0x8c, 0x7a, 0xf8, 0xee, 0xe6, 0xd4, 0xff, 0xef, 0x00, 0x4f,
+	0x66, 0x0c, 0x4b, 0xbf, 0x35, 0x01, 0x00,
 }
 
 func (m *APIServerLogging) Marshal() (dAtA []byte, err error) {
@@ -6997,6 +7259,203 @@ func (m *APIServerRequests) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AccessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessRequestList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessRequestList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessRequestList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessRequestSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessRequestSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessRequestSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Subject.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessRequestStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessRequestStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessRequestStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationTimestamp != nil {
+		{
+			size, err := m.ExpirationTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *AccessRestriction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13150,6 +13609,18 @@ func (m *MachineType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Price != nil {
+		{
+			size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Capabilities) > 0 {
 		keysForCapabilities := make([]string, 0, len(m.Capabilities))
 		for k := range m.Capabilities {
@@ -14455,6 +14926,44 @@ func (m *PendingWorkersRollout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Price) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Price) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Price) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Hourly.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Currency)
+	copy(dAtA[i:], m.Currency)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Currency)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Project) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14508,6 +15017,44 @@ func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProjectCostBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectCostBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectCostBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Monthly.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Currency)
+	copy(dAtA[i:], m.Currency)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Currency)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ProjectList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14575,6 +15122,18 @@ func (m *ProjectMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpirationTimestamp != nil {
+		{
+			size, err := m.ExpirationTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Roles) > 0 {
 		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Roles[iNdEx])
@@ -14622,6 +15181,18 @@ func (m *ProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CostBudget != nil {
+		{
+			size, err := m.CostBudget.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.DualApprovalForDeletion) > 0 {
 		for iNdEx := len(m.DualApprovalForDeletion) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -14926,6 +15497,16 @@ func (m *Quota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -15016,6 +15597,11 @@ func (m *QuotaSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WarningThresholdPercentage != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.WarningThresholdPercentage))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -15063,6 +15649,87 @@ func (m *QuotaSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuotaStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Used) > 0 {
+		keysForUsed := make([]string, 0, len(m.Used))
+		for k := range m.Used {
+			keysForUsed = append(keysForUsed, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+		for iNdEx := len(keysForUsed) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Used[k8s_io_api_core_v1.ResourceName(keysForUsed[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForUsed[iNdEx])
+			copy(dAtA[i:], keysForUsed[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsed[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Hard) > 0 {
+		keysForHard := make([]string, 0, len(m.Hard))
+		for k := range m.Hard {
+			keysForHard = append(keysForHard, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
+		for iNdEx := len(keysForHard) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hard[k8s_io_api_core_v1.ResourceName(keysForHard[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHard[iNdEx])
+			copy(dAtA[i:], keysForHard[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHard[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Region) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15083,6 +15750,18 @@ func (m *Region) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PriceFactor != nil {
+		{
+			size, err := m.PriceFactor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.AccessRestrictions) > 0 {
 		for iNdEx := len(m.AccessRestrictions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -17209,6 +17888,74 @@ func (m *ShootAdvertisedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ShootCostEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShootCostEstimate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShootCostEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MonthlyMaximum.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.MonthlyMinimum.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.HourlyMaximum.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.HourlyMinimum.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Currency)
+	copy(dAtA[i:], m.Currency)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Currency)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ShootCredentials) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -18030,6 +18777,20 @@ func (m *ShootStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EstimatedCost != nil {
+		{
+			size, err := m.EstimatedCost.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
 	if m.ManualWorkerPoolRollout != nil {
 		{
 			size, err := m.ManualWorkerPoolRollout.MarshalToSizedBuffer(dAtA[:i])
@@ -18770,6 +19531,18 @@ func (m *VolumeType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Price != nil {
+		{
+			size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.MinSize != nil {
 		{
 			size, err := m.MinSize.MarshalToSizedBuffer(dAtA[:i])
@@ -19348,6 +20121,74 @@ func (m *APIServerRequests) Size() (n int) {
 	return n
 }
 
+func (m *AccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *AccessRequestList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccessRequestSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Subject.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Duration.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *AccessRequestStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ExpirationTimestamp != nil {
+		l = m.ExpirationTimestamp.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *AccessRestriction) Size() (n int) {
 	if m == nil {
 		return 0
@@ -21685,6 +22526,10 @@ func (m *MachineType) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -22172,6 +23017,19 @@ func (m *PendingWorkersRollout) Size() (n int) {
 	return n
 }
 
+func (m *Price) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Currency)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Hourly.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Project) Size() (n int) {
 	if m == nil {
 		return 0
@@ -22187,6 +23045,19 @@ func (m *Project) Size() (n int) {
 	return n
 }
 
+func (m *ProjectCostBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Currency)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Monthly.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ProjectList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -22220,6 +23091,10 @@ func (m *ProjectMember) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.ExpirationTimestamp != nil {
+		l = m.ExpirationTimestamp.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -22265,6 +23140,10 @@ func (m *ProjectSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.CostBudget != nil {
+		l = m.CostBudget.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -22352,6 +23231,8 @@ func (m *Quota) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -22392,6 +23273,36 @@ func (m *QuotaSpec) Size() (n int) {
 	}
 	l = m.Scope.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.WarningThresholdPercentage != nil {
+		n += 1 + sovGenerated(uint64(*m.WarningThresholdPercentage))
+	}
+	return n
+}
+
+func (m *QuotaStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hard) > 0 {
+		for k, v := range m.Hard {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Used) > 0 {
+		for k, v := range m.Used {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -22423,6 +23334,10 @@ func (m *Region) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.PriceFactor != nil {
+		l = m.PriceFactor.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -23177,6 +24092,25 @@ func (m *ShootAdvertisedAddress) Size() (n int) {
 	return n
 }
 
+func (m *ShootCostEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Currency)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.HourlyMinimum.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.HourlyMaximum.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.MonthlyMinimum.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.MonthlyMaximum.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ShootCredentials) Size() (n int) {
 	if m == nil {
 		return 0
@@ -23562,6 +24496,10 @@ func (m *ShootStatus) Size() (n int) {
 		l = m.ManualWorkerPoolRollout.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.EstimatedCost != nil {
+		l = m.EstimatedCost.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -23764,6 +24702,10 @@ func (m *VolumeType) Size() (n int) {
 		l = m.MinSize.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -23978,6 +24920,58 @@ func (this *APIServerRequests) String() string {
 	}, "")
 	return s
 }
+func (this *AccessRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccessRequest{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "AccessRequestSpec", "AccessRequestSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "AccessRequestStatus", "AccessRequestStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccessRequestList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]AccessRequest{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "AccessRequest", "AccessRequest", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&AccessRequestList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccessRequestSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccessRequestSpec{`,
+		`Subject:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Subject), "Subject", "v11.Subject", 1), `&`, ``, 1) + `,`,
+		`Roles:` + fmt.Sprintf("%v", this.Roles) + `,`,
+		`Duration:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "v1.Duration", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccessRequestStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccessRequestStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`ExpirationTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationTimestamp), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *AccessRestriction) String() string {
 	if this == nil {
 		return "nil"
@@ -23993,7 +24987,7 @@ func (this *AccessRestrictionWithOptions) String() string {
 		return "nil"
 	}
 	keysForOptions := make([]string, 0, len(this.Options))
-	for k := range this.Options {
+	for k, _ := range this.Options {
 		keysForOptions = append(keysForOptions, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForOptions)
@@ -24068,7 +25062,7 @@ func (this *AuditPolicy) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&AuditPolicy{`,
-		`ConfigMapRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapRef), "ObjectReference", "v1.ObjectReference", 1) + `,`,
+		`ConfigMapRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapRef), "ObjectReference", "v12.ObjectReference", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24104,7 +25098,7 @@ func (this *Backup) String() string {
 		`Provider:` + fmt.Sprintf("%v", this.Provider) + `,`,
 		`ProviderConfig:` + strings.Replace(fmt.Sprintf("%v", this.ProviderConfig), "RawExtension", "runtime.RawExtension", 1) + `,`,
 		`Region:` + valueToStringGenerated(this.Region) + `,`,
-		`CredentialsRef:` + strings.Replace(fmt.Sprintf("%v", this.CredentialsRef), "ObjectReference", "v1.ObjectReference", 1) + `,`,
+		`CredentialsRef:` + strings.Replace(fmt.Sprintf("%v", this.CredentialsRef), "ObjectReference", "v12.ObjectReference", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24114,7 +25108,7 @@ func (this *BackupBucket) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&BackupBucket{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "BackupBucketSpec", "BackupBucketSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "BackupBucketStatus", "BackupBucketStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -24131,7 +25125,7 @@ func (this *BackupBucketList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&BackupBucketList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -24156,8 +25150,8 @@ func (this *BackupBucketSpec) String() string {
 		`Provider:` + strings.Replace(strings.Replace(this.Provider.String(), "BackupBucketProvider", "BackupBucketProvider", 1), `&`, ``, 1) + `,`,
 		`ProviderConfig:` + strings.Replace(fmt.Sprintf("%v", this.ProviderConfig), "RawExtension", "runtime.RawExtension", 1) + `,`,
 		`SeedName:` + valueToStringGenerated(this.SeedName) + `,`,
-		`CredentialsRef:` + strings.Replace(fmt.Sprintf("%v", this.CredentialsRef), "ObjectReference", "v1.ObjectReference", 1) + `,`,
-		`ShootRef:` + strings.Replace(fmt.Sprintf("%v", this.ShootRef), "ObjectReference", "v1.ObjectReference", 1) + `,`,
+		`CredentialsRef:` + strings.Replace(fmt.Sprintf("%v", this.CredentialsRef), "ObjectReference", "v12.ObjectReference", 1) + `,`,
+		`ShootRef:` + strings.Replace(fmt.Sprintf("%v", this.ShootRef), "ObjectReference", "v12.ObjectReference", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24171,7 +25165,7 @@ func (this *BackupBucketStatus) String() string {
 		`LastOperation:` + strings.Replace(this.LastOperation.String(), "LastOperation", "LastOperation", 1) + `,`,
 		`LastError:` + strings.Replace(this.LastError.String(), "LastError", "LastError", 1) + `,`,
 		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
-		`GeneratedSecretRef:` + strings.Replace(fmt.Sprintf("%v", this.GeneratedSecretRef), "SecretReference", "v1.SecretReference", 1) + `,`,
+		`GeneratedSecretRef:` + strings.Replace(fmt.Sprintf("%v", this.GeneratedSecretRef), "SecretReference", "v12.SecretReference", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24181,7 +25175,7 @@ func (this *BackupEntry) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&BackupEntry{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "BackupEntrySpec", "BackupEntrySpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "BackupEntryStatus", "BackupEntryStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -24198,7 +25192,7 @@ func (this *BackupEntryList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&BackupEntryList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -24211,7 +25205,7 @@ func (this *BackupEntrySpec) String() string {
 	s := strings.Join([]string{`&BackupEntrySpec{`,
 		`BucketName:` + fmt.Sprintf("%v", this.BucketName) + `,`,
 		`SeedName:` + valueToStringGenerated(this.SeedName) + `,`,
-		`ShootRef:` + strings.Replace(fmt.Sprintf("%v", this.ShootRef), "ObjectReference", "v1.ObjectReference", 1) + `,`,
+		`ShootRef:` + strings.Replace(fmt.Sprintf("%v", this.ShootRef), "ObjectReference", "v12.ObjectReference", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24225,7 +25219,7 @@ func (this *BackupEntryStatus) String() string {
 		`LastError:` + strings.Replace(this.LastError.String(), "LastError", "LastError", 1) + `,`,
 		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
 		`SeedName:` + valueToStringGenerated(this.SeedName) + `,`,
-		`MigrationStartTime:` + strings.Replace(fmt.Sprintf("%v", this.MigrationStartTime), "Time", "v11.Time", 1) + `,`,
+		`MigrationStartTime:` + strings.Replace(fmt.Sprintf("%v", this.MigrationStartTime), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24273,10 +25267,10 @@ func (this *CARotation) String() string {
 	repeatedStringForPendingWorkersRollouts += "}"
 	s := strings.Join([]string{`&CARotation{`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v11.Time", 1) + `,`,
-		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v11.Time", 1) + `,`,
-		`LastInitiationFinishedTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationFinishedTime), "Time", "v11.Time", 1) + `,`,
-		`LastCompletionTriggeredTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTriggeredTime), "Time", "v11.Time", 1) + `,`,
+		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v1.Time", 1) + `,`,
+		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v1.Time", 1) + `,`,
+		`LastInitiationFinishedTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationFinishedTime), "Time", "v1.Time", 1) + `,`,
+		`LastCompletionTriggeredTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTriggeredTime), "Time", "v1.Time", 1) + `,`,
 		`PendingWorkersRollouts:` + repeatedStringForPendingWorkersRollouts + `,`,
 		`}`,
 	}, "")
@@ -24314,7 +25308,7 @@ func (this *CloudProfile) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&CloudProfile{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "CloudProfileSpec", "CloudProfileSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -24330,7 +25324,7 @@ func (this *CloudProfileList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&CloudProfileList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -24398,17 +25392,17 @@ func (this *ClusterAutoscaler) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ClusterAutoscaler{`,
-		`ScaleDownDelayAfterAdd:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownDelayAfterAdd), "Duration", "v11.Duration", 1) + `,`,
-		`ScaleDownDelayAfterDelete:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownDelayAfterDelete), "Duration", "v11.Duration", 1) + `,`,
-		`ScaleDownDelayAfterFailure:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownDelayAfterFailure), "Duration", "v11.Duration", 1) + `,`,
-		`ScaleDownUnneededTime:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownUnneededTime), "Duration", "v11.Duration", 1) + `,`,
+		`ScaleDownDelayAfterAdd:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownDelayAfterAdd), "Duration", "v1.Duration", 1) + `,`,
+		`ScaleDownDelayAfterDelete:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownDelayAfterDelete), "Duration", "v1.Duration", 1) + `,`,
+		`ScaleDownDelayAfterFailure:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownDelayAfterFailure), "Duration", "v1.Duration", 1) + `,`,
+		`ScaleDownUnneededTime:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownUnneededTime), "Duration", "v1.Duration", 1) + `,`,
 		`ScaleDownUtilizationThreshold:` + valueToStringGenerated(this.ScaleDownUtilizationThreshold) + `,`,
-		`ScanInterval:` + strings.Replace(fmt.Sprintf("%v", this.ScanInterval), "Duration", "v11.Duration", 1) + `,`,
+		`ScanInterval:` + strings.Replace(fmt.Sprintf("%v", this.ScanInterval), "Duration", "v1.Duration", 1) + `,`,
 		`Expander:` + valueToStringGenerated(this.Expander) + `,`,
-		`MaxNodeProvisionTime:` + strings.Replace(fmt.Sprintf("%v", this.MaxNodeProvisionTime), "Duration", "v11.Duration", 1) + `,`,
+		`MaxNodeProvisionTime:` + strings.Replace(fmt.Sprintf("%v", this.MaxNodeProvisionTime), "Duration", "v1.Duration", 1) + `,`,
 		`MaxGracefulTerminationSeconds:` + valueToStringGenerated(this.MaxGracefulTerminationSeconds) + `,`,
 		`IgnoreTaints:` + fmt.Sprintf("%v", this.IgnoreTaints) + `,`,
-		`NewPodScaleUpDelay:` + strings.Replace(fmt.Sprintf("%v", this.NewPodScaleUpDelay), "Duration", "v11.Duration", 1) + `,`,
+		`NewPodScaleUpDelay:` + strings.Replace(fmt.Sprintf("%v", this.NewPodScaleUpDelay), "Duration", "v1.Duration", 1) + `,`,
 		`MaxEmptyBulkDelete:` + valueToStringGenerated(this.MaxEmptyBulkDelete) + `,`,
 		`IgnoreDaemonsetsUtilization:` + valueToStringGenerated(this.IgnoreDaemonsetsUtilization) + `,`,
 		`Verbosity:` + valueToStringGenerated(this.Verbosity) + `,`,
@@ -24416,9 +25410,9 @@ func (this *ClusterAutoscaler) String() string {
 		`StatusTaints:` + fmt.Sprintf("%v", this.StatusTaints) + `,`,
 		`MaxScaleDownParallelism:` + valueToStringGenerated(this.MaxScaleDownParallelism) + `,`,
 		`MaxDrainParallelism:` + valueToStringGenerated(this.MaxDrainParallelism) + `,`,
-		`InitialNodeGroupBackoffDuration:` + strings.Replace(fmt.Sprintf("%v", this.InitialNodeGroupBackoffDuration), "Duration", "v11.Duration", 1) + `,`,
-		`MaxNodeGroupBackoffDuration:` + strings.Replace(fmt.Sprintf("%v", this.MaxNodeGroupBackoffDuration), "Duration", "v11.Duration", 1) + `,`,
-		`NodeGroupBackoffResetTimeout:` + strings.Replace(fmt.Sprintf("%v", this.NodeGroupBackoffResetTimeout), "Duration", "v11.Duration", 1) + `,`,
+		`InitialNodeGroupBackoffDuration:` + strings.Replace(fmt.Sprintf("%v", this.InitialNodeGroupBackoffDuration), "Duration", "v1.Duration", 1) + `,`,
+		`MaxNodeGroupBackoffDuration:` + strings.Replace(fmt.Sprintf("%v", this.MaxNodeGroupBackoffDuration), "Duration", "v1.Duration", 1) + `,`,
+		`NodeGroupBackoffResetTimeout:` + strings.Replace(fmt.Sprintf("%v", this.NodeGroupBackoffResetTimeout), "Duration", "v1.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24430,9 +25424,9 @@ func (this *ClusterAutoscalerOptions) String() string {
 	s := strings.Join([]string{`&ClusterAutoscalerOptions{`,
 		`ScaleDownUtilizationThreshold:` + valueToStringGenerated(this.ScaleDownUtilizationThreshold) + `,`,
 		`ScaleDownGpuUtilizationThreshold:` + valueToStringGenerated(this.ScaleDownGpuUtilizationThreshold) + `,`,
-		`ScaleDownUnneededTime:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownUnneededTime), "Duration", "v11.Duration", 1) + `,`,
-		`ScaleDownUnreadyTime:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownUnreadyTime), "Duration", "v11.Duration", 1) + `,`,
-		`MaxNodeProvisionTime:` + strings.Replace(fmt.Sprintf("%v", this.MaxNodeProvisionTime), "Duration", "v11.Duration", 1) + `,`,
+		`ScaleDownUnneededTime:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownUnneededTime), "Duration", "v1.Duration", 1) + `,`,
+		`ScaleDownUnreadyTime:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownUnreadyTime), "Duration", "v1.Duration", 1) + `,`,
+		`MaxNodeProvisionTime:` + strings.Replace(fmt.Sprintf("%v", this.MaxNodeProvisionTime), "Duration", "v1.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24444,8 +25438,8 @@ func (this *Condition) String() string {
 	s := strings.Join([]string{`&Condition{`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
-		`LastUpdateTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`LastUpdateTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`Codes:` + fmt.Sprintf("%v", this.Codes) + `,`,
@@ -24479,7 +25473,7 @@ func (this *ControlPlaneAutoscaling) String() string {
 		return "nil"
 	}
 	keysForMinAllowed := make([]string, 0, len(this.MinAllowed))
-	for k := range this.MinAllowed {
+	for k, _ := range this.MinAllowed {
 		keysForMinAllowed = append(keysForMinAllowed, string(k))
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForMinAllowed)
@@ -24499,7 +25493,7 @@ func (this *ControllerDeployment) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ControllerDeployment{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`ProviderConfig:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ProviderConfig), "RawExtension", "runtime.RawExtension", 1), `&`, ``, 1) + `,`,
 		`InjectGardenKubeconfig:` + valueToStringGenerated(this.InjectGardenKubeconfig) + `,`,
@@ -24517,7 +25511,7 @@ func (this *ControllerDeploymentList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ControllerDeploymentList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -24528,7 +25522,7 @@ func (this *ControllerInstallation) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ControllerInstallation{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ControllerInstallationSpec", "ControllerInstallationSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ControllerInstallationStatus", "ControllerInstallationStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -24545,7 +25539,7 @@ func (this *ControllerInstallationList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ControllerInstallationList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -24556,9 +25550,9 @@ func (this *ControllerInstallationSpec) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ControllerInstallationSpec{`,
-		`RegistrationRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.RegistrationRef), "ObjectReference", "v1.ObjectReference", 1), `&`, ``, 1) + `,`,
-		`SeedRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SeedRef), "ObjectReference", "v1.ObjectReference", 1), `&`, ``, 1) + `,`,
-		`DeploymentRef:` + strings.Replace(fmt.Sprintf("%v", this.DeploymentRef), "ObjectReference", "v1.ObjectReference", 1) + `,`,
+		`RegistrationRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.RegistrationRef), "ObjectReference", "v12.ObjectReference", 1), `&`, ``, 1) + `,`,
+		`SeedRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SeedRef), "ObjectReference", "v12.ObjectReference", 1), `&`, ``, 1) + `,`,
+		`DeploymentRef:` + strings.Replace(fmt.Sprintf("%v", this.DeploymentRef), "ObjectReference", "v12.ObjectReference", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24584,7 +25578,7 @@ func (this *ControllerRegistration) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ControllerRegistration{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ControllerRegistrationSpec", "ControllerRegistrationSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -24601,7 +25595,7 @@ func (this *ControllerRegistrationDeployment) String() string {
 	repeatedStringForDeploymentRefs += "}"
 	s := strings.Join([]string{`&ControllerRegistrationDeployment{`,
 		`Policy:` + valueToStringGenerated(this.Policy) + `,`,
-		`SeedSelector:` + strings.Replace(fmt.Sprintf("%v", this.SeedSelector), "LabelSelector", "v11.LabelSelector", 1) + `,`,
+		`SeedSelector:` + strings.Replace(fmt.Sprintf("%v", this.SeedSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
 		`DeploymentRefs:` + repeatedStringForDeploymentRefs + `,`,
 		`}`,
 	}, "")
@@ -24617,7 +25611,7 @@ func (this *ControllerRegistrationList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ControllerRegistrationList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -24646,7 +25640,7 @@ func (this *ControllerResource) String() string {
 	s := strings.Join([]string{`&ControllerResource{`,
 		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ReconcileTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ReconcileTimeout), "Duration", "v11.Duration", 1) + `,`,
+		`ReconcileTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ReconcileTimeout), "Duration", "v1.Duration", 1) + `,`,
 		`Primary:` + valueToStringGenerated(this.Primary) + `,`,
 		`Lifecycle:` + strings.Replace(this.Lifecycle.String(), "ControllerResourceLifecycle", "ControllerResourceLifecycle", 1) + `,`,
 		`WorkerlessSupported:` + valueToStringGenerated(this.WorkerlessSupported) + `,`,
@@ -24769,7 +25763,7 @@ func (this *DualApprovalForDeletion) String() string {
 	}
 	s := strings.Join([]string{`&DualApprovalForDeletion{`,
 		`Resource:` + fmt.Sprintf("%v", this.Resource) + `,`,
-		`Selector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v11.LabelSelector", 1), `&`, ``, 1) + `,`,
+		`Selector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1), `&`, ``, 1) + `,`,
 		`IncludeServiceAccounts:` + valueToStringGenerated(this.IncludeServiceAccounts) + `,`,
 		`}`,
 	}, "")
@@ -24802,10 +25796,10 @@ func (this *ETCDEncryptionKeyRotation) String() string {
 	}
 	s := strings.Join([]string{`&ETCDEncryptionKeyRotation{`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v11.Time", 1) + `,`,
-		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v11.Time", 1) + `,`,
-		`LastInitiationFinishedTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationFinishedTime), "Time", "v11.Time", 1) + `,`,
-		`LastCompletionTriggeredTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTriggeredTime), "Time", "v11.Time", 1) + `,`,
+		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v1.Time", 1) + `,`,
+		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v1.Time", 1) + `,`,
+		`LastInitiationFinishedTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationFinishedTime), "Time", "v1.Time", 1) + `,`,
+		`LastCompletionTriggeredTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTriggeredTime), "Time", "v1.Time", 1) + `,`,
 		`AutoCompleteAfterPrepared:` + valueToStringGenerated(this.AutoCompleteAfterPrepared) + `,`,
 		`}`,
 	}, "")
@@ -24837,7 +25831,7 @@ func (this *ExpirableVersion) String() string {
 	}
 	s := strings.Join([]string{`&ExpirableVersion{`,
 		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`ExpirationDate:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationDate), "Time", "v11.Time", 1) + `,`,
+		`ExpirationDate:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationDate), "Time", "v1.Time", 1) + `,`,
 		`Classification:` + valueToStringGenerated(this.Classification) + `,`,
 		`}`,
 	}, "")
@@ -24848,7 +25842,7 @@ func (this *ExposureClass) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ExposureClass{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Handler:` + fmt.Sprintf("%v", this.Handler) + `,`,
 		`Scheduling:` + strings.Replace(this.Scheduling.String(), "ExposureClassScheduling", "ExposureClassScheduling", 1) + `,`,
 		`}`,
@@ -24865,7 +25859,7 @@ func (this *ExposureClassList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ExposureClassList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -24945,7 +25939,7 @@ func (this *GardenerResourceData) String() string {
 		return "nil"
 	}
 	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
+	for k, _ := range this.Labels {
 		keysForLabels = append(keysForLabels, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
@@ -24969,7 +25963,7 @@ func (this *HelmControllerDeployment) String() string {
 	}
 	s := strings.Join([]string{`&HelmControllerDeployment{`,
 		`Chart:` + valueToStringGenerated(this.Chart) + `,`,
-		`Values:` + strings.Replace(fmt.Sprintf("%v", this.Values), "JSON", "v12.JSON", 1) + `,`,
+		`Values:` + strings.Replace(fmt.Sprintf("%v", this.Values), "JSON", "v13.JSON", 1) + `,`,
 		`OCIRepository:` + strings.Replace(this.OCIRepository.String(), "OCIRepository", "OCIRepository", 1) + `,`,
 		`}`,
 	}, "")
@@ -25018,10 +26012,10 @@ func (this *HorizontalPodAutoscalerConfig) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&HorizontalPodAutoscalerConfig{`,
-		`CPUInitializationPeriod:` + strings.Replace(fmt.Sprintf("%v", this.CPUInitializationPeriod), "Duration", "v11.Duration", 1) + `,`,
-		`DownscaleStabilization:` + strings.Replace(fmt.Sprintf("%v", this.DownscaleStabilization), "Duration", "v11.Duration", 1) + `,`,
-		`InitialReadinessDelay:` + strings.Replace(fmt.Sprintf("%v", this.InitialReadinessDelay), "Duration", "v11.Duration", 1) + `,`,
-		`SyncPeriod:` + strings.Replace(fmt.Sprintf("%v", this.SyncPeriod), "Duration", "v11.Duration", 1) + `,`,
+		`CPUInitializationPeriod:` + strings.Replace(fmt.Sprintf("%v", this.CPUInitializationPeriod), "Duration", "v1.Duration", 1) + `,`,
+		`DownscaleStabilization:` + strings.Replace(fmt.Sprintf("%v", this.DownscaleStabilization), "Duration", "v1.Duration", 1) + `,`,
+		`InitialReadinessDelay:` + strings.Replace(fmt.Sprintf("%v", this.InitialReadinessDelay), "Duration", "v1.Duration", 1) + `,`,
+		`SyncPeriod:` + strings.Replace(fmt.Sprintf("%v", this.SyncPeriod), "Duration", "v1.Duration", 1) + `,`,
 		`Tolerance:` + valueToStringGenerated(this.Tolerance) + `,`,
 		`}`,
 	}, "")
@@ -25075,7 +26069,7 @@ func (this *InternalSecret) String() string {
 		return "nil"
 	}
 	keysForData := make([]string, 0, len(this.Data))
-	for k := range this.Data {
+	for k, _ := range this.Data {
 		keysForData = append(keysForData, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForData)
@@ -25085,7 +26079,7 @@ func (this *InternalSecret) String() string {
 	}
 	mapStringForData += "}"
 	keysForStringData := make([]string, 0, len(this.StringData))
-	for k := range this.StringData {
+	for k, _ := range this.StringData {
 		keysForStringData = append(keysForStringData, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForStringData)
@@ -25095,7 +26089,7 @@ func (this *InternalSecret) String() string {
 	}
 	mapStringForStringData += "}"
 	s := strings.Join([]string{`&InternalSecret{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Data:` + mapStringForData + `,`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`StringData:` + mapStringForStringData + `,`,
@@ -25114,7 +26108,7 @@ func (this *InternalSecretList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&InternalSecretList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -25130,7 +26124,7 @@ func (this *KubeAPIServerConfig) String() string {
 	}
 	repeatedStringForAdmissionPlugins += "}"
 	keysForRuntimeConfig := make([]string, 0, len(this.RuntimeConfig))
-	for k := range this.RuntimeConfig {
+	for k, _ := range this.RuntimeConfig {
 		keysForRuntimeConfig = append(keysForRuntimeConfig, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForRuntimeConfig)
@@ -25150,7 +26144,7 @@ func (this *KubeAPIServerConfig) String() string {
 		`WatchCacheSizes:` + strings.Replace(this.WatchCacheSizes.String(), "WatchCacheSizes", "WatchCacheSizes", 1) + `,`,
 		`Requests:` + strings.Replace(this.Requests.String(), "APIServerRequests", "APIServerRequests", 1) + `,`,
 		`EnableAnonymousAuthentication:` + valueToStringGenerated(this.EnableAnonymousAuthentication) + `,`,
-		`EventTTL:` + strings.Replace(fmt.Sprintf("%v", this.EventTTL), "Duration", "v11.Duration", 1) + `,`,
+		`EventTTL:` + strings.Replace(fmt.Sprintf("%v", this.EventTTL), "Duration", "v1.Duration", 1) + `,`,
 		`Logging:` + strings.Replace(this.Logging.String(), "APIServerLogging", "APIServerLogging", 1) + `,`,
 		`DefaultNotReadyTolerationSeconds:` + valueToStringGenerated(this.DefaultNotReadyTolerationSeconds) + `,`,
 		`DefaultUnreachableTolerationSeconds:` + valueToStringGenerated(this.DefaultUnreachableTolerationSeconds) + `,`,
@@ -25170,8 +26164,8 @@ func (this *KubeControllerManagerConfig) String() string {
 		`KubernetesConfig:` + strings.Replace(strings.Replace(this.KubernetesConfig.String(), "KubernetesConfig", "KubernetesConfig", 1), `&`, ``, 1) + `,`,
 		`HorizontalPodAutoscalerConfig:` + strings.Replace(this.HorizontalPodAutoscalerConfig.String(), "HorizontalPodAutoscalerConfig", "HorizontalPodAutoscalerConfig", 1) + `,`,
 		`NodeCIDRMaskSize:` + valueToStringGenerated(this.NodeCIDRMaskSize) + `,`,
-		`PodEvictionTimeout:` + strings.Replace(fmt.Sprintf("%v", this.PodEvictionTimeout), "Duration", "v11.Duration", 1) + `,`,
-		`NodeMonitorGracePeriod:` + strings.Replace(fmt.Sprintf("%v", this.NodeMonitorGracePeriod), "Duration", "v11.Duration", 1) + `,`,
+		`PodEvictionTimeout:` + strings.Replace(fmt.Sprintf("%v", this.PodEvictionTimeout), "Duration", "v1.Duration", 1) + `,`,
+		`NodeMonitorGracePeriod:` + strings.Replace(fmt.Sprintf("%v", this.NodeMonitorGracePeriod), "Duration", "v1.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25211,7 +26205,7 @@ func (this *KubeletConfig) String() string {
 		`EvictionHard:` + strings.Replace(this.EvictionHard.String(), "KubeletConfigEviction", "KubeletConfigEviction", 1) + `,`,
 		`EvictionMaxPodGracePeriod:` + valueToStringGenerated(this.EvictionMaxPodGracePeriod) + `,`,
 		`EvictionMinimumReclaim:` + strings.Replace(this.EvictionMinimumReclaim.String(), "KubeletConfigEvictionMinimumReclaim", "KubeletConfigEvictionMinimumReclaim", 1) + `,`,
-		`EvictionPressureTransitionPeriod:` + strings.Replace(fmt.Sprintf("%v", this.EvictionPressureTransitionPeriod), "Duration", "v11.Duration", 1) + `,`,
+		`EvictionPressureTransitionPeriod:` + strings.Replace(fmt.Sprintf("%v", this.EvictionPressureTransitionPeriod), "Duration", "v1.Duration", 1) + `,`,
 		`EvictionSoft:` + strings.Replace(this.EvictionSoft.String(), "KubeletConfigEviction", "KubeletConfigEviction", 1) + `,`,
 		`EvictionSoftGracePeriod:` + strings.Replace(this.EvictionSoftGracePeriod.String(), "KubeletConfigEvictionSoftGracePeriod", "KubeletConfigEvictionSoftGracePeriod", 1) + `,`,
 		`MaxPods:` + valueToStringGenerated(this.MaxPods) + `,`,
@@ -25228,11 +26222,11 @@ func (this *KubeletConfig) String() string {
 		`ContainerLogMaxSize:` + strings.Replace(fmt.Sprintf("%v", this.ContainerLogMaxSize), "Quantity", "resource.Quantity", 1) + `,`,
 		`ContainerLogMaxFiles:` + valueToStringGenerated(this.ContainerLogMaxFiles) + `,`,
 		`ProtectKernelDefaults:` + valueToStringGenerated(this.ProtectKernelDefaults) + `,`,
-		`StreamingConnectionIdleTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StreamingConnectionIdleTimeout), "Duration", "v11.Duration", 1) + `,`,
+		`StreamingConnectionIdleTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StreamingConnectionIdleTimeout), "Duration", "v1.Duration", 1) + `,`,
 		`MemorySwap:` + strings.Replace(this.MemorySwap.String(), "MemorySwapConfiguration", "MemorySwapConfiguration", 1) + `,`,
 		`MaxParallelImagePulls:` + valueToStringGenerated(this.MaxParallelImagePulls) + `,`,
-		`ImageMinimumGCAge:` + strings.Replace(fmt.Sprintf("%v", this.ImageMinimumGCAge), "Duration", "v11.Duration", 1) + `,`,
-		`ImageMaximumGCAge:` + strings.Replace(fmt.Sprintf("%v", this.ImageMaximumGCAge), "Duration", "v11.Duration", 1) + `,`,
+		`ImageMinimumGCAge:` + strings.Replace(fmt.Sprintf("%v", this.ImageMinimumGCAge), "Duration", "v1.Duration", 1) + `,`,
+		`ImageMaximumGCAge:` + strings.Replace(fmt.Sprintf("%v", this.ImageMaximumGCAge), "Duration", "v1.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25270,11 +26264,11 @@ func (this *KubeletConfigEvictionSoftGracePeriod) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&KubeletConfigEvictionSoftGracePeriod{`,
-		`MemoryAvailable:` + strings.Replace(fmt.Sprintf("%v", this.MemoryAvailable), "Duration", "v11.Duration", 1) + `,`,
-		`ImageFSAvailable:` + strings.Replace(fmt.Sprintf("%v", this.ImageFSAvailable), "Duration", "v11.Duration", 1) + `,`,
-		`ImageFSInodesFree:` + strings.Replace(fmt.Sprintf("%v", this.ImageFSInodesFree), "Duration", "v11.Duration", 1) + `,`,
-		`NodeFSAvailable:` + strings.Replace(fmt.Sprintf("%v", this.NodeFSAvailable), "Duration", "v11.Duration", 1) + `,`,
-		`NodeFSInodesFree:` + strings.Replace(fmt.Sprintf("%v", this.NodeFSInodesFree), "Duration", "v11.Duration", 1) + `,`,
+		`MemoryAvailable:` + strings.Replace(fmt.Sprintf("%v", this.MemoryAvailable), "Duration", "v1.Duration", 1) + `,`,
+		`ImageFSAvailable:` + strings.Replace(fmt.Sprintf("%v", this.ImageFSAvailable), "Duration", "v1.Duration", 1) + `,`,
+		`ImageFSInodesFree:` + strings.Replace(fmt.Sprintf("%v", this.ImageFSInodesFree), "Duration", "v1.Duration", 1) + `,`,
+		`NodeFSAvailable:` + strings.Replace(fmt.Sprintf("%v", this.NodeFSAvailable), "Duration", "v1.Duration", 1) + `,`,
+		`NodeFSInodesFree:` + strings.Replace(fmt.Sprintf("%v", this.NodeFSInodesFree), "Duration", "v1.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25315,7 +26309,7 @@ func (this *KubernetesConfig) String() string {
 		return "nil"
 	}
 	keysForFeatureGates := make([]string, 0, len(this.FeatureGates))
-	for k := range this.FeatureGates {
+	for k, _ := range this.FeatureGates {
 		keysForFeatureGates = append(keysForFeatureGates, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForFeatureGates)
@@ -25364,7 +26358,7 @@ func (this *LastError) String() string {
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
 		`TaskID:` + valueToStringGenerated(this.TaskID) + `,`,
 		`Codes:` + fmt.Sprintf("%v", this.Codes) + `,`,
-		`LastUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v11.Time", 1) + `,`,
+		`LastUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25375,7 +26369,7 @@ func (this *LastMaintenance) String() string {
 	}
 	s := strings.Join([]string{`&LastMaintenance{`,
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
-		`TriggeredTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.TriggeredTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`TriggeredTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.TriggeredTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`State:` + fmt.Sprintf("%v", this.State) + `,`,
 		`FailureReason:` + valueToStringGenerated(this.FailureReason) + `,`,
 		`}`,
@@ -25388,7 +26382,7 @@ func (this *LastOperation) String() string {
 	}
 	s := strings.Join([]string{`&LastOperation{`,
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
-		`LastUpdateTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`LastUpdateTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
 		`State:` + fmt.Sprintf("%v", this.State) + `,`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
@@ -25433,12 +26427,12 @@ func (this *MachineControllerManagerSettings) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&MachineControllerManagerSettings{`,
-		`MachineDrainTimeout:` + strings.Replace(fmt.Sprintf("%v", this.MachineDrainTimeout), "Duration", "v11.Duration", 1) + `,`,
-		`MachineHealthTimeout:` + strings.Replace(fmt.Sprintf("%v", this.MachineHealthTimeout), "Duration", "v11.Duration", 1) + `,`,
-		`MachineCreationTimeout:` + strings.Replace(fmt.Sprintf("%v", this.MachineCreationTimeout), "Duration", "v11.Duration", 1) + `,`,
+		`MachineDrainTimeout:` + strings.Replace(fmt.Sprintf("%v", this.MachineDrainTimeout), "Duration", "v1.Duration", 1) + `,`,
+		`MachineHealthTimeout:` + strings.Replace(fmt.Sprintf("%v", this.MachineHealthTimeout), "Duration", "v1.Duration", 1) + `,`,
+		`MachineCreationTimeout:` + strings.Replace(fmt.Sprintf("%v", this.MachineCreationTimeout), "Duration", "v1.Duration", 1) + `,`,
 		`MaxEvictRetries:` + valueToStringGenerated(this.MaxEvictRetries) + `,`,
 		`NodeConditions:` + fmt.Sprintf("%v", this.NodeConditions) + `,`,
-		`MachineInPlaceUpdateTimeout:` + strings.Replace(fmt.Sprintf("%v", this.MachineInPlaceUpdateTimeout), "Duration", "v11.Duration", 1) + `,`,
+		`MachineInPlaceUpdateTimeout:` + strings.Replace(fmt.Sprintf("%v", this.MachineInPlaceUpdateTimeout), "Duration", "v1.Duration", 1) + `,`,
 		`DisableHealthTimeout:` + valueToStringGenerated(this.DisableHealthTimeout) + `,`,
 		`}`,
 	}, "")
@@ -25466,7 +26460,7 @@ func (this *MachineImageFlavor) String() string {
 		return "nil"
 	}
 	keysForCapabilities := make([]string, 0, len(this.Capabilities))
-	for k := range this.Capabilities {
+	for k, _ := range this.Capabilities {
 		keysForCapabilities = append(keysForCapabilities, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForCapabilities)
@@ -25511,7 +26505,7 @@ func (this *MachineType) String() string {
 		return "nil"
 	}
 	keysForCapabilities := make([]string, 0, len(this.Capabilities))
-	for k := range this.Capabilities {
+	for k, _ := range this.Capabilities {
 		keysForCapabilities = append(keysForCapabilities, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForCapabilities)
@@ -25529,6 +26523,7 @@ func (this *MachineType) String() string {
 		`Usable:` + valueToStringGenerated(this.Usable) + `,`,
 		`Architecture:` + valueToStringGenerated(this.Architecture) + `,`,
 		`Capabilities:` + mapStringForCapabilities + `,`,
+		`Price:` + strings.Replace(this.Price.String(), "Price", "Price", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25621,7 +26616,7 @@ func (this *NamedResourceReference) String() string {
 	}
 	s := strings.Join([]string{`&NamedResourceReference{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`ResourceRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ResourceRef), "CrossVersionObjectReference", "v13.CrossVersionObjectReference", 1), `&`, ``, 1) + `,`,
+		`ResourceRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ResourceRef), "CrossVersionObjectReference", "v14.CrossVersionObjectReference", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25631,7 +26626,7 @@ func (this *NamespacedCloudProfile) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&NamespacedCloudProfile{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "NamespacedCloudProfileSpec", "NamespacedCloudProfileSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "NamespacedCloudProfileStatus", "NamespacedCloudProfileStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -25648,7 +26643,7 @@ func (this *NamespacedCloudProfileList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&NamespacedCloudProfileList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -25730,7 +26725,7 @@ func (this *NginxIngress) String() string {
 		return "nil"
 	}
 	keysForConfig := make([]string, 0, len(this.Config))
-	for k := range this.Config {
+	for k, _ := range this.Config {
 		keysForConfig = append(keysForConfig, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForConfig)
@@ -25770,7 +26765,7 @@ func (this *OCIRepository) String() string {
 		`Repository:` + valueToStringGenerated(this.Repository) + `,`,
 		`Tag:` + valueToStringGenerated(this.Tag) + `,`,
 		`Digest:` + valueToStringGenerated(this.Digest) + `,`,
-		`PullSecretRef:` + strings.Replace(fmt.Sprintf("%v", this.PullSecretRef), "LocalObjectReference", "v1.LocalObjectReference", 1) + `,`,
+		`PullSecretRef:` + strings.Replace(fmt.Sprintf("%v", this.PullSecretRef), "LocalObjectReference", "v12.LocalObjectReference", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25780,7 +26775,7 @@ func (this *OIDCConfig) String() string {
 		return "nil"
 	}
 	keysForRequiredClaims := make([]string, 0, len(this.RequiredClaims))
-	for k := range this.RequiredClaims {
+	for k, _ := range this.RequiredClaims {
 		keysForRequiredClaims = append(keysForRequiredClaims, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForRequiredClaims)
@@ -25809,8 +26804,8 @@ func (this *ObservabilityRotation) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ObservabilityRotation{`,
-		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v11.Time", 1) + `,`,
-		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v11.Time", 1) + `,`,
+		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v1.Time", 1) + `,`,
+		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25820,7 +26815,7 @@ func (this *OpenIDConnectClientAuthentication) String() string {
 		return "nil"
 	}
 	keysForExtraConfig := make([]string, 0, len(this.ExtraConfig))
-	for k := range this.ExtraConfig {
+	for k, _ := range this.ExtraConfig {
 		keysForExtraConfig = append(keysForExtraConfig, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForExtraConfig)
@@ -25853,7 +26848,18 @@ func (this *PendingWorkersRollout) String() string {
 	}
 	s := strings.Join([]string{`&PendingWorkersRollout{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v11.Time", 1) + `,`,
+		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Price) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Price{`,
+		`Currency:` + fmt.Sprintf("%v", this.Currency) + `,`,
+		`Hourly:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Hourly), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25863,13 +26869,24 @@ func (this *Project) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&Project{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ProjectSpec", "ProjectSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ProjectStatus", "ProjectStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
+func (this *ProjectCostBudget) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectCostBudget{`,
+		`Currency:` + fmt.Sprintf("%v", this.Currency) + `,`,
+		`Monthly:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Monthly), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ProjectList) String() string {
 	if this == nil {
 		return "nil"
@@ -25880,7 +26897,7 @@ func (this *ProjectList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ProjectList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -25891,9 +26908,10 @@ func (this *ProjectMember) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ProjectMember{`,
-		`Subject:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Subject), "Subject", "v14.Subject", 1), `&`, ``, 1) + `,`,
+		`Subject:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Subject), "Subject", "v11.Subject", 1), `&`, ``, 1) + `,`,
 		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
 		`Roles:` + fmt.Sprintf("%v", this.Roles) + `,`,
+		`ExpirationTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationTimestamp), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25913,14 +26931,15 @@ func (this *ProjectSpec) String() string {
 	}
 	repeatedStringForDualApprovalForDeletion += "}"
 	s := strings.Join([]string{`&ProjectSpec{`,
-		`CreatedBy:` + strings.Replace(fmt.Sprintf("%v", this.CreatedBy), "Subject", "v14.Subject", 1) + `,`,
+		`CreatedBy:` + strings.Replace(fmt.Sprintf("%v", this.CreatedBy), "Subject", "v11.Subject", 1) + `,`,
 		`Description:` + valueToStringGenerated(this.Description) + `,`,
-		`Owner:` + strings.Replace(fmt.Sprintf("%v", this.Owner), "Subject", "v14.Subject", 1) + `,`,
+		`Owner:` + strings.Replace(fmt.Sprintf("%v", this.Owner), "Subject", "v11.Subject", 1) + `,`,
 		`Purpose:` + valueToStringGenerated(this.Purpose) + `,`,
 		`Members:` + repeatedStringForMembers + `,`,
 		`Namespace:` + valueToStringGenerated(this.Namespace) + `,`,
 		`Tolerations:` + strings.Replace(this.Tolerations.String(), "ProjectTolerations", "ProjectTolerations", 1) + `,`,
 		`DualApprovalForDeletion:` + repeatedStringForDualApprovalForDeletion + `,`,
+		`CostBudget:` + strings.Replace(this.CostBudget.String(), "ProjectCostBudget", "ProjectCostBudget", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25932,9 +26951,9 @@ func (this *ProjectStatus) String() string {
 	s := strings.Join([]string{`&ProjectStatus{`,
 		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`StaleSinceTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.StaleSinceTimestamp), "Time", "v11.Time", 1) + `,`,
-		`StaleAutoDeleteTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.StaleAutoDeleteTimestamp), "Time", "v11.Time", 1) + `,`,
-		`LastActivityTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.LastActivityTimestamp), "Time", "v11.Time", 1) + `,`,
+		`StaleSinceTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.StaleSinceTimestamp), "Time", "v1.Time", 1) + `,`,
+		`StaleAutoDeleteTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.StaleAutoDeleteTimestamp), "Time", "v1.Time", 1) + `,`,
+		`LastActivityTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.LastActivityTimestamp), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25984,8 +27003,9 @@ func (this *Quota) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&Quota{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "QuotaSpec", "QuotaSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "QuotaStatus", "QuotaStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26000,7 +27020,7 @@ func (this *QuotaList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&QuotaList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -26011,7 +27031,7 @@ func (this *QuotaSpec) String() string {
 		return "nil"
 	}
 	keysForMetrics := make([]string, 0, len(this.Metrics))
-	for k := range this.Metrics {
+	for k, _ := range this.Metrics {
 		keysForMetrics = append(keysForMetrics, string(k))
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForMetrics)
@@ -26023,7 +27043,39 @@ func (this *QuotaSpec) String() string {
 	s := strings.Join([]string{`&QuotaSpec{`,
 		`ClusterLifetimeDays:` + valueToStringGenerated(this.ClusterLifetimeDays) + `,`,
 		`Metrics:` + mapStringForMetrics + `,`,
-		`Scope:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Scope), "ObjectReference", "v1.ObjectReference", 1), `&`, ``, 1) + `,`,
+		`Scope:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Scope), "ObjectReference", "v12.ObjectReference", 1), `&`, ``, 1) + `,`,
+		`WarningThresholdPercentage:` + valueToStringGenerated(this.WarningThresholdPercentage) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuotaStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHard := make([]string, 0, len(this.Hard))
+	for k, _ := range this.Hard {
+		keysForHard = append(keysForHard, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
+	mapStringForHard := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForHard {
+		mapStringForHard += fmt.Sprintf("%v: %v,", k, this.Hard[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForHard += "}"
+	keysForUsed := make([]string, 0, len(this.Used))
+	for k, _ := range this.Used {
+		keysForUsed = append(keysForUsed, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+	mapStringForUsed := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForUsed {
+		mapStringForUsed += fmt.Sprintf("%v: %v,", k, this.Used[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForUsed += "}"
+	s := strings.Join([]string{`&QuotaStatus{`,
+		`Hard:` + mapStringForHard + `,`,
+		`Used:` + mapStringForUsed + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26043,7 +27095,7 @@ func (this *Region) String() string {
 	}
 	repeatedStringForAccessRestrictions += "}"
 	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
+	for k, _ := range this.Labels {
 		keysForLabels = append(keysForLabels, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
@@ -26057,6 +27109,7 @@ func (this *Region) String() string {
 		`Zones:` + repeatedStringForZones + `,`,
 		`Labels:` + mapStringForLabels + `,`,
 		`AccessRestrictions:` + repeatedStringForAccessRestrictions + `,`,
+		`PriceFactor:` + strings.Replace(fmt.Sprintf("%v", this.PriceFactor), "Quantity", "resource.Quantity", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26066,7 +27119,7 @@ func (this *ResourceData) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ResourceData{`,
-		`CrossVersionObjectReference:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CrossVersionObjectReference), "CrossVersionObjectReference", "v13.CrossVersionObjectReference", 1), `&`, ``, 1) + `,`,
+		`CrossVersionObjectReference:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CrossVersionObjectReference), "CrossVersionObjectReference", "v14.CrossVersionObjectReference", 1), `&`, ``, 1) + `,`,
 		`Data:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Data), "RawExtension", "runtime.RawExtension", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -26104,8 +27157,8 @@ func (this *SecretBinding) String() string {
 	}
 	repeatedStringForQuotas += "}"
 	s := strings.Join([]string{`&SecretBinding{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`SecretRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretRef), "SecretReference", "v1.SecretReference", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`SecretRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretRef), "SecretReference", "v12.SecretReference", 1), `&`, ``, 1) + `,`,
 		`Quotas:` + repeatedStringForQuotas + `,`,
 		`Provider:` + strings.Replace(this.Provider.String(), "SecretBindingProvider", "SecretBindingProvider", 1) + `,`,
 		`}`,
@@ -26122,7 +27175,7 @@ func (this *SecretBindingList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&SecretBindingList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -26143,7 +27196,7 @@ func (this *Seed) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&Seed{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "SeedSpec", "SeedSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "SeedStatus", "SeedStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -26173,7 +27226,7 @@ func (this *SeedDNSProvider) String() string {
 	}
 	s := strings.Join([]string{`&SeedDNSProvider{`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`SecretRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretRef), "SecretReference", "v1.SecretReference", 1), `&`, ``, 1) + `,`,
+		`SecretRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretRef), "SecretReference", "v12.SecretReference", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26186,7 +27239,7 @@ func (this *SeedDNSProviderConfig) String() string {
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
 		`Zone:` + valueToStringGenerated(this.Zone) + `,`,
-		`CredentialsRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CredentialsRef), "ObjectReference", "v1.ObjectReference", 1), `&`, ``, 1) + `,`,
+		`CredentialsRef:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CredentialsRef), "ObjectReference", "v12.ObjectReference", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26201,7 +27254,7 @@ func (this *SeedList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&SeedList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -26240,7 +27293,7 @@ func (this *SeedSelector) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&SeedSelector{`,
-		`LabelSelector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LabelSelector), "LabelSelector", "v11.LabelSelector", 1), `&`, ``, 1) + `,`,
+		`LabelSelector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LabelSelector), "LabelSelector", "v1.LabelSelector", 1), `&`, ``, 1) + `,`,
 		`ProviderTypes:` + fmt.Sprintf("%v", this.ProviderTypes) + `,`,
 		`}`,
 	}, "")
@@ -26303,7 +27356,7 @@ func (this *SeedSettingExcessCapacityReservationConfig) String() string {
 	}
 	repeatedStringForTolerations += "}"
 	keysForResources := make([]string, 0, len(this.Resources))
-	for k := range this.Resources {
+	for k, _ := range this.Resources {
 		keysForResources = append(keysForResources, string(k))
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForResources)
@@ -26313,7 +27366,7 @@ func (this *SeedSettingExcessCapacityReservationConfig) String() string {
 	}
 	mapStringForResources += "}"
 	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
-	for k := range this.NodeSelector {
+	for k, _ := range this.NodeSelector {
 		keysForNodeSelector = append(keysForNodeSelector, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
@@ -26340,7 +27393,7 @@ func (this *SeedSettingLoadBalancerServices) String() string {
 	}
 	repeatedStringForZones += "}"
 	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
+	for k, _ := range this.Annotations {
 		keysForAnnotations = append(keysForAnnotations, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
@@ -26375,7 +27428,7 @@ func (this *SeedSettingLoadBalancerServicesZones) String() string {
 		return "nil"
 	}
 	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
+	for k, _ := range this.Annotations {
 		keysForAnnotations = append(keysForAnnotations, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
@@ -26418,7 +27471,7 @@ func (this *SeedSettingVerticalPodAutoscaler) String() string {
 		return "nil"
 	}
 	keysForFeatureGates := make([]string, 0, len(this.FeatureGates))
-	for k := range this.FeatureGates {
+	for k, _ := range this.FeatureGates {
 		keysForFeatureGates = append(keysForFeatureGates, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForFeatureGates)
@@ -26428,7 +27481,7 @@ func (this *SeedSettingVerticalPodAutoscaler) String() string {
 	}
 	mapStringForFeatureGates += "}"
 	keysForMaxAllowed := make([]string, 0, len(this.MaxAllowed))
-	for k := range this.MaxAllowed {
+	for k, _ := range this.MaxAllowed {
 		keysForMaxAllowed = append(keysForMaxAllowed, string(k))
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForMaxAllowed)
@@ -26510,7 +27563,7 @@ func (this *SeedStatus) String() string {
 	}
 	repeatedStringForConditions += "}"
 	keysForCapacity := make([]string, 0, len(this.Capacity))
-	for k := range this.Capacity {
+	for k, _ := range this.Capacity {
 		keysForCapacity = append(keysForCapacity, string(k))
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForCapacity)
@@ -26520,7 +27573,7 @@ func (this *SeedStatus) String() string {
 	}
 	mapStringForCapacity += "}"
 	keysForAllocatable := make([]string, 0, len(this.Allocatable))
-	for k := range this.Allocatable {
+	for k, _ := range this.Allocatable {
 		keysForAllocatable = append(keysForAllocatable, string(k))
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForAllocatable)
@@ -26537,7 +27590,7 @@ func (this *SeedStatus) String() string {
 		`ClusterIdentity:` + valueToStringGenerated(this.ClusterIdentity) + `,`,
 		`Capacity:` + mapStringForCapacity + `,`,
 		`Allocatable:` + mapStringForAllocatable + `,`,
-		`ClientCertificateExpirationTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.ClientCertificateExpirationTimestamp), "Time", "v11.Time", 1) + `,`,
+		`ClientCertificateExpirationTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.ClientCertificateExpirationTimestamp), "Time", "v1.Time", 1) + `,`,
 		`LastOperation:` + strings.Replace(this.LastOperation.String(), "LastOperation", "LastOperation", 1) + `,`,
 		`}`,
 	}, "")
@@ -26559,7 +27612,7 @@ func (this *SeedTemplate) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&SeedTemplate{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "SeedSpec", "SeedSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -26599,7 +27652,7 @@ func (this *ServiceAccountConfig) String() string {
 	s := strings.Join([]string{`&ServiceAccountConfig{`,
 		`Issuer:` + valueToStringGenerated(this.Issuer) + `,`,
 		`ExtendTokenExpiration:` + valueToStringGenerated(this.ExtendTokenExpiration) + `,`,
-		`MaxTokenExpiration:` + strings.Replace(fmt.Sprintf("%v", this.MaxTokenExpiration), "Duration", "v11.Duration", 1) + `,`,
+		`MaxTokenExpiration:` + strings.Replace(fmt.Sprintf("%v", this.MaxTokenExpiration), "Duration", "v1.Duration", 1) + `,`,
 		`AcceptedIssuers:` + fmt.Sprintf("%v", this.AcceptedIssuers) + `,`,
 		`}`,
 	}, "")
@@ -26616,10 +27669,10 @@ func (this *ServiceAccountKeyRotation) String() string {
 	repeatedStringForPendingWorkersRollouts += "}"
 	s := strings.Join([]string{`&ServiceAccountKeyRotation{`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v11.Time", 1) + `,`,
-		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v11.Time", 1) + `,`,
-		`LastInitiationFinishedTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationFinishedTime), "Time", "v11.Time", 1) + `,`,
-		`LastCompletionTriggeredTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTriggeredTime), "Time", "v11.Time", 1) + `,`,
+		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v1.Time", 1) + `,`,
+		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v1.Time", 1) + `,`,
+		`LastInitiationFinishedTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationFinishedTime), "Time", "v1.Time", 1) + `,`,
+		`LastCompletionTriggeredTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTriggeredTime), "Time", "v1.Time", 1) + `,`,
 		`PendingWorkersRollouts:` + repeatedStringForPendingWorkersRollouts + `,`,
 		`}`,
 	}, "")
@@ -26630,7 +27683,7 @@ func (this *Shoot) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&Shoot{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ShootSpec", "ShootSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ShootStatus", "ShootStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -26648,6 +27701,20 @@ func (this *ShootAdvertisedAddress) String() string {
 	}, "")
 	return s
 }
+func (this *ShootCostEstimate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShootCostEstimate{`,
+		`Currency:` + fmt.Sprintf("%v", this.Currency) + `,`,
+		`HourlyMinimum:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.HourlyMinimum), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
+		`HourlyMaximum:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.HourlyMaximum), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
+		`MonthlyMinimum:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.MonthlyMinimum), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
+		`MonthlyMaximum:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.MonthlyMaximum), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ShootCredentials) String() string {
 	if this == nil {
 		return "nil"
@@ -26678,8 +27745,8 @@ func (this *ShootKubeconfigRotation) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ShootKubeconfigRotation{`,
-		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v11.Time", 1) + `,`,
-		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v11.Time", 1) + `,`,
+		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v1.Time", 1) + `,`,
+		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26694,7 +27761,7 @@ func (this *ShootList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ShootList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -26728,8 +27795,8 @@ func (this *ShootSSHKeypairRotation) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ShootSSHKeypairRotation{`,
-		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v11.Time", 1) + `,`,
-		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v11.Time", 1) + `,`,
+		`LastInitiationTime:` + strings.Replace(fmt.Sprintf("%v", this.LastInitiationTime), "Time", "v1.Time", 1) + `,`,
+		`LastCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionTime), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26792,7 +27859,7 @@ func (this *ShootState) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ShootState{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ShootStateSpec", "ShootStateSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -26808,7 +27875,7 @@ func (this *ShootStateList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ShootStateList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -26873,20 +27940,21 @@ func (this *ShootStatus) String() string {
 		`LastOperation:` + strings.Replace(this.LastOperation.String(), "LastOperation", "LastOperation", 1) + `,`,
 		`LastErrors:` + repeatedStringForLastErrors + `,`,
 		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
-		`RetryCycleStartTime:` + strings.Replace(fmt.Sprintf("%v", this.RetryCycleStartTime), "Time", "v11.Time", 1) + `,`,
+		`RetryCycleStartTime:` + strings.Replace(fmt.Sprintf("%v", this.RetryCycleStartTime), "Time", "v1.Time", 1) + `,`,
 		`SeedName:` + valueToStringGenerated(this.SeedName) + `,`,
 		`TechnicalID:` + fmt.Sprintf("%v", this.TechnicalID) + `,`,
 		`UID:` + fmt.Sprintf("%v", this.UID) + `,`,
 		`ClusterIdentity:` + valueToStringGenerated(this.ClusterIdentity) + `,`,
 		`AdvertisedAddresses:` + repeatedStringForAdvertisedAddresses + `,`,
-		`MigrationStartTime:` + strings.Replace(fmt.Sprintf("%v", this.MigrationStartTime), "Time", "v11.Time", 1) + `,`,
+		`MigrationStartTime:` + strings.Replace(fmt.Sprintf("%v", this.MigrationStartTime), "Time", "v1.Time", 1) + `,`,
 		`Credentials:` + strings.Replace(this.Credentials.String(), "ShootCredentials", "ShootCredentials", 1) + `,`,
-		`LastHibernationTriggerTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHibernationTriggerTime), "Time", "v11.Time", 1) + `,`,
+		`LastHibernationTriggerTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHibernationTriggerTime), "Time", "v1.Time", 1) + `,`,
 		`LastMaintenance:` + strings.Replace(this.LastMaintenance.String(), "LastMaintenance", "LastMaintenance", 1) + `,`,
 		`EncryptedResources:` + fmt.Sprintf("%v", this.EncryptedResources) + `,`,
 		`Networking:` + strings.Replace(this.Networking.String(), "NetworkingStatus", "NetworkingStatus", 1) + `,`,
 		`InPlaceUpdates:` + strings.Replace(this.InPlaceUpdates.String(), "InPlaceUpdatesStatus", "InPlaceUpdatesStatus", 1) + `,`,
 		`ManualWorkerPoolRollout:` + strings.Replace(this.ManualWorkerPoolRollout.String(), "ManualWorkerPoolRollout", "ManualWorkerPoolRollout", 1) + `,`,
+		`EstimatedCost:` + strings.Replace(this.EstimatedCost.String(), "ShootCostEstimate", "ShootCostEstimate", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26896,7 +27964,7 @@ func (this *ShootTemplate) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ShootTemplate{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ShootSpec", "ShootSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -26955,7 +28023,7 @@ func (this *VerticalPodAutoscaler) String() string {
 		return "nil"
 	}
 	keysForFeatureGates := make([]string, 0, len(this.FeatureGates))
-	for k := range this.FeatureGates {
+	for k, _ := range this.FeatureGates {
 		keysForFeatureGates = append(keysForFeatureGates, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForFeatureGates)
@@ -26965,7 +28033,7 @@ func (this *VerticalPodAutoscaler) String() string {
 	}
 	mapStringForFeatureGates += "}"
 	keysForMaxAllowed := make([]string, 0, len(this.MaxAllowed))
-	for k := range this.MaxAllowed {
+	for k, _ := range this.MaxAllowed {
 		keysForMaxAllowed = append(keysForMaxAllowed, string(k))
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForMaxAllowed)
@@ -26976,22 +28044,22 @@ func (this *VerticalPodAutoscaler) String() string {
 	mapStringForMaxAllowed += "}"
 	s := strings.Join([]string{`&VerticalPodAutoscaler{`,
 		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
-		`EvictAfterOOMThreshold:` + strings.Replace(fmt.Sprintf("%v", this.EvictAfterOOMThreshold), "Duration", "v11.Duration", 1) + `,`,
+		`EvictAfterOOMThreshold:` + strings.Replace(fmt.Sprintf("%v", this.EvictAfterOOMThreshold), "Duration", "v1.Duration", 1) + `,`,
 		`EvictionRateBurst:` + valueToStringGenerated(this.EvictionRateBurst) + `,`,
 		`EvictionRateLimit:` + valueToStringGenerated(this.EvictionRateLimit) + `,`,
 		`EvictionTolerance:` + valueToStringGenerated(this.EvictionTolerance) + `,`,
 		`RecommendationMarginFraction:` + valueToStringGenerated(this.RecommendationMarginFraction) + `,`,
-		`UpdaterInterval:` + strings.Replace(fmt.Sprintf("%v", this.UpdaterInterval), "Duration", "v11.Duration", 1) + `,`,
-		`RecommenderInterval:` + strings.Replace(fmt.Sprintf("%v", this.RecommenderInterval), "Duration", "v11.Duration", 1) + `,`,
+		`UpdaterInterval:` + strings.Replace(fmt.Sprintf("%v", this.UpdaterInterval), "Duration", "v1.Duration", 1) + `,`,
+		`RecommenderInterval:` + strings.Replace(fmt.Sprintf("%v", this.RecommenderInterval), "Duration", "v1.Duration", 1) + `,`,
 		`TargetCPUPercentile:` + valueToStringGenerated(this.TargetCPUPercentile) + `,`,
 		`RecommendationLowerBoundCPUPercentile:` + valueToStringGenerated(this.RecommendationLowerBoundCPUPercentile) + `,`,
 		`RecommendationUpperBoundCPUPercentile:` + valueToStringGenerated(this.RecommendationUpperBoundCPUPercentile) + `,`,
 		`TargetMemoryPercentile:` + valueToStringGenerated(this.TargetMemoryPercentile) + `,`,
 		`RecommendationLowerBoundMemoryPercentile:` + valueToStringGenerated(this.RecommendationLowerBoundMemoryPercentile) + `,`,
 		`RecommendationUpperBoundMemoryPercentile:` + valueToStringGenerated(this.RecommendationUpperBoundMemoryPercentile) + `,`,
-		`CPUHistogramDecayHalfLife:` + strings.Replace(fmt.Sprintf("%v", this.CPUHistogramDecayHalfLife), "Duration", "v11.Duration", 1) + `,`,
-		`MemoryHistogramDecayHalfLife:` + strings.Replace(fmt.Sprintf("%v", this.MemoryHistogramDecayHalfLife), "Duration", "v11.Duration", 1) + `,`,
-		`MemoryAggregationInterval:` + strings.Replace(fmt.Sprintf("%v", this.MemoryAggregationInterval), "Duration", "v11.Duration", 1) + `,`,
+		`CPUHistogramDecayHalfLife:` + strings.Replace(fmt.Sprintf("%v", this.CPUHistogramDecayHalfLife), "Duration", "v1.Duration", 1) + `,`,
+		`MemoryHistogramDecayHalfLife:` + strings.Replace(fmt.Sprintf("%v", this.MemoryHistogramDecayHalfLife), "Duration", "v1.Duration", 1) + `,`,
+		`MemoryAggregationInterval:` + strings.Replace(fmt.Sprintf("%v", this.MemoryAggregationInterval), "Duration", "v1.Duration", 1) + `,`,
 		`MemoryAggregationIntervalCount:` + valueToStringGenerated(this.MemoryAggregationIntervalCount) + `,`,
 		`FeatureGates:` + mapStringForFeatureGates + `,`,
 		`MaxAllowed:` + mapStringForMaxAllowed + `,`,
@@ -27022,6 +28090,7 @@ func (this *VolumeType) String() string {
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Usable:` + valueToStringGenerated(this.Usable) + `,`,
 		`MinSize:` + strings.Replace(fmt.Sprintf("%v", this.MinSize), "Quantity", "resource.Quantity", 1) + `,`,
+		`Price:` + strings.Replace(this.Price.String(), "Price", "Price", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -27057,7 +28126,7 @@ func (this *Worker) String() string {
 	}
 	repeatedStringForDataVolumes += "}"
 	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
+	for k, _ := range this.Annotations {
 		keysForAnnotations = append(keysForAnnotations, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
@@ -27067,7 +28136,7 @@ func (this *Worker) String() string {
 	}
 	mapStringForAnnotations += "}"
 	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
+	for k, _ := range this.Labels {
 		keysForLabels = append(keysForLabels, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
@@ -27077,7 +28146,7 @@ func (this *Worker) String() string {
 	}
 	mapStringForLabels += "}"
 	keysForSysctls := make([]string, 0, len(this.Sysctls))
-	for k := range this.Sysctls {
+	for k, _ := range this.Sysctls {
 		keysForSysctls = append(keysForSysctls, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForSysctls)
@@ -27344,7 +28413,7 @@ func (m *APIServerRequests) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccessRestriction) Unmarshal(dAtA []byte) error {
+func (m *AccessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27367,17 +28436,17 @@ func (m *AccessRestriction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessRestriction: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessRestriction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27387,77 +28456,28 @@ func (m *AccessRestriction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AccessRestrictionWithOptions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AccessRestrictionWithOptions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessRestrictionWithOptions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessRestriction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27484,13 +28504,13 @@ func (m *AccessRestrictionWithOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AccessRestriction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27517,103 +28537,716 @@ func (m *AccessRestrictionWithOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Options == nil {
-				m.Options = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Options[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessRequestList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessRequestList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessRequestList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, AccessRequest{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessRequestSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessRequestSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessRequestSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Subject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessRequestStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessRequestStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessRequestStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = AccessRequestPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTimestamp == nil {
+				m.ExpirationTimestamp = &v1.Time{}
+			}
+			if err := m.ExpirationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessRestriction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessRestriction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessRestriction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessRestrictionWithOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessRestrictionWithOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessRestrictionWithOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessRestriction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccessRestriction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Options[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28227,7 +29860,7 @@ func (m *AuditPolicy) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ConfigMapRef == nil {
-				m.ConfigMapRef = &v1.ObjectReference{}
+				m.ConfigMapRef = &v12.ObjectReference{}
 			}
 			if err := m.ConfigMapRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -28674,7 +30307,7 @@ func (m *Backup) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.CredentialsRef == nil {
-				m.CredentialsRef = &v1.ObjectReference{}
+				m.CredentialsRef = &v12.ObjectReference{}
 			}
 			if err := m.CredentialsRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -29242,7 +30875,7 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.CredentialsRef == nil {
-				m.CredentialsRef = &v1.ObjectReference{}
+				m.CredentialsRef = &v12.ObjectReference{}
 			}
 			if err := m.CredentialsRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -29278,7 +30911,7 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ShootRef == nil {
-				m.ShootRef = &v1.ObjectReference{}
+				m.ShootRef = &v12.ObjectReference{}
 			}
 			if err := m.ShootRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -29491,7 +31124,7 @@ func (m *BackupBucketStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.GeneratedSecretRef == nil {
-				m.GeneratedSecretRef = &v1.SecretReference{}
+				m.GeneratedSecretRef = &v12.SecretReference{}
 			}
 			if err := m.GeneratedSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -29908,7 +31541,7 @@ func (m *BackupEntrySpec) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ShootRef == nil {
-				m.ShootRef = &v1.ObjectReference{}
+				m.ShootRef = &v12.ObjectReference{}
 			}
 			if err := m.ShootRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -30118,7 +31751,7 @@ func (m *BackupEntryStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MigrationStartTime == nil {
-				m.MigrationStartTime = &v11.Time{}
+				m.MigrationStartTime = &v1.Time{}
 			}
 			if err := m.MigrationStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -30555,7 +32188,7 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
+				m.LastCompletionTime = &v1.Time{}
 			}
 			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -30591,7 +32224,7 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
+				m.LastInitiationTime = &v1.Time{}
 			}
 			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -30627,7 +32260,7 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastInitiationFinishedTime == nil {
-				m.LastInitiationFinishedTime = &v11.Time{}
+				m.LastInitiationFinishedTime = &v1.Time{}
 			}
 			if err := m.LastInitiationFinishedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -30663,7 +32296,7 @@ func (m *CARotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastCompletionTriggeredTime == nil {
-				m.LastCompletionTriggeredTime = &v11.Time{}
+				m.LastCompletionTriggeredTime = &v1.Time{}
 			}
 			if err := m.LastCompletionTriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -31908,7 +33541,7 @@ func (m *ClusterAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ScaleDownDelayAfterAdd == nil {
-				m.ScaleDownDelayAfterAdd = &v11.Duration{}
+				m.ScaleDownDelayAfterAdd = &v1.Duration{}
 			}
 			if err := m.ScaleDownDelayAfterAdd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -31944,7 +33577,7 @@ func (m *ClusterAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ScaleDownDelayAfterDelete == nil {
-				m.ScaleDownDelayAfterDelete = &v11.Duration{}
+				m.ScaleDownDelayAfterDelete = &v1.Duration{}
 			}
 			if err := m.ScaleDownDelayAfterDelete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -31980,7 +33613,7 @@ func (m *ClusterAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ScaleDownDelayAfterFailure == nil {
-				m.ScaleDownDelayAfterFailure = &v11.Duration{}
+				m.ScaleDownDelayAfterFailure = &v1.Duration{}
 			}
 			if err := m.ScaleDownDelayAfterFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -32016,7 +33649,7 @@ func (m *ClusterAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ScaleDownUnneededTime == nil {
-				m.ScaleDownUnneededTime = &v11.Duration{}
+				m.ScaleDownUnneededTime = &v1.Duration{}
 			}
 			if err := m.ScaleDownUnneededTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -32064,7 +33697,7 @@ func (m *ClusterAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ScanInterval == nil {
-				m.ScanInterval = &v11.Duration{}
+				m.ScanInterval = &v1.Duration{}
 			}
 			if err := m.ScanInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -32133,7 +33766,7 @@ func (m *ClusterAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MaxNodeProvisionTime == nil {
-				m.MaxNodeProvisionTime = &v11.Duration{}
+				m.MaxNodeProvisionTime = &v1.Duration{}
 			}
 			if err := m.MaxNodeProvisionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -32221,7 +33854,7 @@ func (m *ClusterAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.NewPodScaleUpDelay == nil {
-				m.NewPodScaleUpDelay = &v11.Duration{}
+				m.NewPodScaleUpDelay = &v1.Duration{}
 			}
 			if err := m.NewPodScaleUpDelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -32422,7 +34055,7 @@ func (m *ClusterAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.InitialNodeGroupBackoffDuration == nil {
-				m.InitialNodeGroupBackoffDuration = &v11.Duration{}
+				m.InitialNodeGroupBackoffDuration = &v1.Duration{}
 			}
 			if err := m.InitialNodeGroupBackoffDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -32458,7 +34091,7 @@ func (m *ClusterAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MaxNodeGroupBackoffDuration == nil {
-				m.MaxNodeGroupBackoffDuration = &v11.Duration{}
+				m.MaxNodeGroupBackoffDuration = &v1.Duration{}
 			}
 			if err := m.MaxNodeGroupBackoffDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -32494,7 +34127,7 @@ func (m *ClusterAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.NodeGroupBackoffResetTimeout == nil {
-				m.NodeGroupBackoffResetTimeout = &v11.Duration{}
+				m.NodeGroupBackoffResetTimeout = &v1.Duration{}
 			}
 			if err := m.NodeGroupBackoffResetTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -32604,7 +34237,7 @@ func (m *ClusterAutoscalerOptions) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ScaleDownUnneededTime == nil {
-				m.ScaleDownUnneededTime = &v11.Duration{}
+				m.ScaleDownUnneededTime = &v1.Duration{}
 			}
 			if err := m.ScaleDownUnneededTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -32640,7 +34273,7 @@ func (m *ClusterAutoscalerOptions) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ScaleDownUnreadyTime == nil {
-				m.ScaleDownUnreadyTime = &v11.Duration{}
+				m.ScaleDownUnreadyTime = &v1.Duration{}
 			}
 			if err := m.ScaleDownUnreadyTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -32676,7 +34309,7 @@ func (m *ClusterAutoscalerOptions) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MaxNodeProvisionTime == nil {
-				m.MaxNodeProvisionTime = &v11.Duration{}
+				m.MaxNodeProvisionTime = &v1.Duration{}
 			}
 			if err := m.MaxNodeProvisionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -34039,7 +35672,7 @@ func (m *ControllerInstallationSpec) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.DeploymentRef == nil {
-				m.DeploymentRef = &v1.ObjectReference{}
+				m.DeploymentRef = &v12.ObjectReference{}
 			}
 			if err := m.DeploymentRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -34394,7 +36027,7 @@ func (m *ControllerRegistrationDeployment) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SeedSelector == nil {
-				m.SeedSelector = &v11.LabelSelector{}
+				m.SeedSelector = &v1.LabelSelector{}
 			}
 			if err := m.SeedSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -34815,7 +36448,7 @@ func (m *ControllerResource) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ReconcileTimeout == nil {
-				m.ReconcileTimeout = &v11.Duration{}
+				m.ReconcileTimeout = &v1.Duration{}
 			}
 			if err := m.ReconcileTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -36544,7 +38177,7 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
+				m.LastCompletionTime = &v1.Time{}
 			}
 			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -36580,7 +38213,7 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
+				m.LastInitiationTime = &v1.Time{}
 			}
 			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -36616,7 +38249,7 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastInitiationFinishedTime == nil {
-				m.LastInitiationFinishedTime = &v11.Time{}
+				m.LastInitiationFinishedTime = &v1.Time{}
 			}
 			if err := m.LastInitiationFinishedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -36652,7 +38285,7 @@ func (m *ETCDEncryptionKeyRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastCompletionTriggeredTime == nil {
-				m.LastCompletionTriggeredTime = &v11.Time{}
+				m.LastCompletionTriggeredTime = &v1.Time{}
 			}
 			if err := m.LastCompletionTriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -36955,7 +38588,7 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ExpirationDate == nil {
-				m.ExpirationDate = &v11.Time{}
+				m.ExpirationDate = &v1.Time{}
 			}
 			if err := m.ExpirationDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -38355,7 +39988,7 @@ func (m *HelmControllerDeployment) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Values == nil {
-				m.Values = &v12.JSON{}
+				m.Values = &v13.JSON{}
 			}
 			if err := m.Values.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -38814,7 +40447,7 @@ func (m *HorizontalPodAutoscalerConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.CPUInitializationPeriod == nil {
-				m.CPUInitializationPeriod = &v11.Duration{}
+				m.CPUInitializationPeriod = &v1.Duration{}
 			}
 			if err := m.CPUInitializationPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -38850,7 +40483,7 @@ func (m *HorizontalPodAutoscalerConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.DownscaleStabilization == nil {
-				m.DownscaleStabilization = &v11.Duration{}
+				m.DownscaleStabilization = &v1.Duration{}
 			}
 			if err := m.DownscaleStabilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -38886,7 +40519,7 @@ func (m *HorizontalPodAutoscalerConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.InitialReadinessDelay == nil {
-				m.InitialReadinessDelay = &v11.Duration{}
+				m.InitialReadinessDelay = &v1.Duration{}
 			}
 			if err := m.InitialReadinessDelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -38922,7 +40555,7 @@ func (m *HorizontalPodAutoscalerConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SyncPeriod == nil {
-				m.SyncPeriod = &v11.Duration{}
+				m.SyncPeriod = &v1.Duration{}
 			}
 			if err := m.SyncPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -40365,7 +41998,7 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.EventTTL == nil {
-				m.EventTTL = &v11.Duration{}
+				m.EventTTL = &v1.Duration{}
 			}
 			if err := m.EventTTL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -40760,7 +42393,7 @@ func (m *KubeControllerManagerConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.PodEvictionTimeout == nil {
-				m.PodEvictionTimeout = &v11.Duration{}
+				m.PodEvictionTimeout = &v1.Duration{}
 			}
 			if err := m.PodEvictionTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -40796,7 +42429,7 @@ func (m *KubeControllerManagerConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.NodeMonitorGracePeriod == nil {
-				m.NodeMonitorGracePeriod = &v11.Duration{}
+				m.NodeMonitorGracePeriod = &v1.Duration{}
 			}
 			if err := m.NodeMonitorGracePeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -41347,7 +42980,7 @@ func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.EvictionPressureTransitionPeriod == nil {
-				m.EvictionPressureTransitionPeriod = &v11.Duration{}
+				m.EvictionPressureTransitionPeriod = &v1.Duration{}
 			}
 			if err := m.EvictionPressureTransitionPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -41787,7 +43420,7 @@ func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.StreamingConnectionIdleTimeout == nil {
-				m.StreamingConnectionIdleTimeout = &v11.Duration{}
+				m.StreamingConnectionIdleTimeout = &v1.Duration{}
 			}
 			if err := m.StreamingConnectionIdleTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -41879,7 +43512,7 @@ func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ImageMinimumGCAge == nil {
-				m.ImageMinimumGCAge = &v11.Duration{}
+				m.ImageMinimumGCAge = &v1.Duration{}
 			}
 			if err := m.ImageMinimumGCAge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -41915,7 +43548,7 @@ func (m *KubeletConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ImageMaximumGCAge == nil {
-				m.ImageMaximumGCAge = &v11.Duration{}
+				m.ImageMaximumGCAge = &v1.Duration{}
 			}
 			if err := m.ImageMaximumGCAge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -42446,7 +44079,7 @@ func (m *KubeletConfigEvictionSoftGracePeriod) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MemoryAvailable == nil {
-				m.MemoryAvailable = &v11.Duration{}
+				m.MemoryAvailable = &v1.Duration{}
 			}
 			if err := m.MemoryAvailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -42482,7 +44115,7 @@ func (m *KubeletConfigEvictionSoftGracePeriod) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ImageFSAvailable == nil {
-				m.ImageFSAvailable = &v11.Duration{}
+				m.ImageFSAvailable = &v1.Duration{}
 			}
 			if err := m.ImageFSAvailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -42518,7 +44151,7 @@ func (m *KubeletConfigEvictionSoftGracePeriod) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ImageFSInodesFree == nil {
-				m.ImageFSInodesFree = &v11.Duration{}
+				m.ImageFSInodesFree = &v1.Duration{}
 			}
 			if err := m.ImageFSInodesFree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -42554,7 +44187,7 @@ func (m *KubeletConfigEvictionSoftGracePeriod) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.NodeFSAvailable == nil {
-				m.NodeFSAvailable = &v11.Duration{}
+				m.NodeFSAvailable = &v1.Duration{}
 			}
 			if err := m.NodeFSAvailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -42590,7 +44223,7 @@ func (m *KubeletConfigEvictionSoftGracePeriod) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.NodeFSInodesFree == nil {
-				m.NodeFSInodesFree = &v11.Duration{}
+				m.NodeFSInodesFree = &v1.Duration{}
 			}
 			if err := m.NodeFSInodesFree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -43702,7 +45335,7 @@ func (m *LastError) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastUpdateTime == nil {
-				m.LastUpdateTime = &v11.Time{}
+				m.LastUpdateTime = &v1.Time{}
 			}
 			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -44457,7 +46090,7 @@ func (m *MachineControllerManagerSettings) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MachineDrainTimeout == nil {
-				m.MachineDrainTimeout = &v11.Duration{}
+				m.MachineDrainTimeout = &v1.Duration{}
 			}
 			if err := m.MachineDrainTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -44493,7 +46126,7 @@ func (m *MachineControllerManagerSettings) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MachineHealthTimeout == nil {
-				m.MachineHealthTimeout = &v11.Duration{}
+				m.MachineHealthTimeout = &v1.Duration{}
 			}
 			if err := m.MachineHealthTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -44529,7 +46162,7 @@ func (m *MachineControllerManagerSettings) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MachineCreationTimeout == nil {
-				m.MachineCreationTimeout = &v11.Duration{}
+				m.MachineCreationTimeout = &v1.Duration{}
 			}
 			if err := m.MachineCreationTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -44617,7 +46250,7 @@ func (m *MachineControllerManagerSettings) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MachineInPlaceUpdateTimeout == nil {
-				m.MachineInPlaceUpdateTimeout = &v11.Duration{}
+				m.MachineInPlaceUpdateTimeout = &v1.Duration{}
 			}
 			if err := m.MachineInPlaceUpdateTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -45624,6 +47257,42 @@ func (m *MachineType) Unmarshal(dAtA []byte) error {
 			}
 			m.Capabilities[mapkey] = *mapvalue
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Price == nil {
+				m.Price = &Price{}
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -48268,7 +49937,7 @@ func (m *OCIRepository) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.PullSecretRef == nil {
-				m.PullSecretRef = &v1.LocalObjectReference{}
+				m.PullSecretRef = &v12.LocalObjectReference{}
 			}
 			if err := m.PullSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -48830,7 +50499,7 @@ func (m *ObservabilityRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
+				m.LastInitiationTime = &v1.Time{}
 			}
 			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -48866,7 +50535,7 @@ func (m *ObservabilityRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
+				m.LastCompletionTime = &v1.Time{}
 			}
 			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -49162,11 +50831,93 @@ func (m *PendingWorkerUpdates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AutoInPlaceUpdate = append(m.AutoInPlaceUpdate, string(dAtA[iNdEx:postIndex]))
+			m.AutoInPlaceUpdate = append(m.AutoInPlaceUpdate, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManualInPlaceUpdate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManualInPlaceUpdate = append(m.ManualInPlaceUpdate, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingWorkersRollout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingWorkersRollout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingWorkersRollout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManualInPlaceUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49194,7 +50945,43 @@ func (m *PendingWorkerUpdates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ManualInPlaceUpdate = append(m.ManualInPlaceUpdate, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastInitiationTime == nil {
+				m.LastInitiationTime = &v1.Time{}
+			}
+			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -49217,7 +51004,7 @@ func (m *PendingWorkerUpdates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PendingWorkersRollout) Unmarshal(dAtA []byte) error {
+func (m *Price) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49240,15 +51027,15 @@ func (m *PendingWorkersRollout) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PendingWorkersRollout: wiretype end group for non-group")
+			return fmt.Errorf("proto: Price: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingWorkersRollout: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Price: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49276,11 +51063,11 @@ func (m *PendingWorkersRollout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Currency = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hourly", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -49307,10 +51094,7 @@ func (m *PendingWorkersRollout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
-			}
-			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Hourly.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -49484,6 +51268,121 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProjectCostBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectCostBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectCostBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Monthly", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Monthly.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ProjectList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -49727,6 +51626,42 @@ func (m *ProjectMember) Unmarshal(dAtA []byte) error {
 			}
 			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTimestamp == nil {
+				m.ExpirationTimestamp = &v1.Time{}
+			}
+			if err := m.ExpirationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -49807,7 +51742,7 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.CreatedBy == nil {
-				m.CreatedBy = &v14.Subject{}
+				m.CreatedBy = &v11.Subject{}
 			}
 			if err := m.CreatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -49876,7 +51811,7 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Owner == nil {
-				m.Owner = &v14.Subject{}
+				m.Owner = &v11.Subject{}
 			}
 			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -50052,6 +51987,42 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CostBudget == nil {
+				m.CostBudget = &ProjectCostBudget{}
+			}
+			if err := m.CostBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -50183,7 +52154,7 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.StaleSinceTimestamp == nil {
-				m.StaleSinceTimestamp = &v11.Time{}
+				m.StaleSinceTimestamp = &v1.Time{}
 			}
 			if err := m.StaleSinceTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -50219,7 +52190,7 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.StaleAutoDeleteTimestamp == nil {
-				m.StaleAutoDeleteTimestamp = &v11.Time{}
+				m.StaleAutoDeleteTimestamp = &v1.Time{}
 			}
 			if err := m.StaleAutoDeleteTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -50255,7 +52226,7 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastActivityTimestamp == nil {
-				m.LastActivityTimestamp = &v11.Time{}
+				m.LastActivityTimestamp = &v1.Time{}
 			}
 			if err := m.LastActivityTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -50719,6 +52690,39 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -50831,84 +52835,445 @@ func (m *QuotaList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Quota{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, Quota{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterLifetimeDays", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClusterLifetimeDays = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metrics == nil {
+				m.Metrics = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metrics[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarningThresholdPercentage", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WarningThresholdPercentage = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hard == nil {
+				m.Hard = make(k8s_io_api_core_v1.ResourceList)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuotaSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuotaSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterLifetimeDays", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			m.ClusterLifetimeDays = &v
+			m.Hard[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -50935,8 +53300,8 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metrics == nil {
-				m.Metrics = make(k8s_io_api_core_v1.ResourceList)
+			if m.Used == nil {
+				m.Used = make(k8s_io_api_core_v1.ResourceList)
 			}
 			var mapkey k8s_io_api_core_v1.ResourceName
 			mapvalue := &resource.Quantity{}
@@ -51033,40 +53398,7 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Metrics[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Used[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -51345,6 +53677,42 @@ func (m *Region) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceFactor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriceFactor == nil {
+				m.PriceFactor = &resource.Quantity{}
+			}
+			if err := m.PriceFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -51810,7 +54178,7 @@ func (m *SecretBinding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quotas = append(m.Quotas, v1.ObjectReference{})
+			m.Quotas = append(m.Quotas, v12.ObjectReference{})
 			if err := m.Quotas[len(m.Quotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -54013,7 +56381,7 @@ func (m *SeedSettingExcessCapacityReservationConfig) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			m.Tolerations = append(m.Tolerations, v12.Toleration{})
 			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -56358,7 +58726,7 @@ func (m *SeedStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ClientCertificateExpirationTimestamp == nil {
-				m.ClientCertificateExpirationTimestamp = &v11.Time{}
+				m.ClientCertificateExpirationTimestamp = &v1.Time{}
 			}
 			if err := m.ClientCertificateExpirationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -56999,7 +59367,7 @@ func (m *ServiceAccountConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MaxTokenExpiration == nil {
-				m.MaxTokenExpiration = &v11.Duration{}
+				m.MaxTokenExpiration = &v1.Duration{}
 			}
 			if err := m.MaxTokenExpiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -57149,7 +59517,7 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
+				m.LastCompletionTime = &v1.Time{}
 			}
 			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -57185,7 +59553,7 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
+				m.LastInitiationTime = &v1.Time{}
 			}
 			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -57221,7 +59589,7 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastInitiationFinishedTime == nil {
-				m.LastInitiationFinishedTime = &v11.Time{}
+				m.LastInitiationFinishedTime = &v1.Time{}
 			}
 			if err := m.LastInitiationFinishedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -57257,7 +59625,7 @@ func (m *ServiceAccountKeyRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastCompletionTriggeredTime == nil {
-				m.LastCompletionTriggeredTime = &v11.Time{}
+				m.LastCompletionTriggeredTime = &v1.Time{}
 			}
 			if err := m.LastCompletionTriggeredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -57376,15 +59744,131 @@ func (m *Shoot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShootAdvertisedAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShootAdvertisedAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShootAdvertisedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -57394,30 +59878,29 @@ func (m *Shoot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -57427,24 +59910,23 @@ func (m *Shoot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -57467,7 +59949,7 @@ func (m *Shoot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShootAdvertisedAddress) Unmarshal(dAtA []byte) error {
+func (m *ShootCostEstimate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -57490,15 +59972,15 @@ func (m *ShootAdvertisedAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShootAdvertisedAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootCostEstimate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShootAdvertisedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootCostEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -57526,13 +60008,13 @@ func (m *ShootAdvertisedAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Currency = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HourlyMinimum", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -57542,23 +60024,123 @@ func (m *ShootAdvertisedAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if err := m.HourlyMinimum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HourlyMaximum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HourlyMaximum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthlyMinimum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MonthlyMinimum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthlyMaximum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MonthlyMaximum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -57992,7 +60574,7 @@ func (m *ShootKubeconfigRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
+				m.LastInitiationTime = &v1.Time{}
 			}
 			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -58028,7 +60610,7 @@ func (m *ShootKubeconfigRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
+				m.LastCompletionTime = &v1.Time{}
 			}
 			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -58498,7 +61080,7 @@ func (m *ShootSSHKeypairRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
+				m.LastInitiationTime = &v1.Time{}
 			}
 			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -58534,7 +61116,7 @@ func (m *ShootSSHKeypairRotation) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
+				m.LastCompletionTime = &v1.Time{}
 			}
 			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -60090,7 +62672,7 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.RetryCycleStartTime == nil {
-				m.RetryCycleStartTime = &v11.Time{}
+				m.RetryCycleStartTime = &v1.Time{}
 			}
 			if err := m.RetryCycleStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -60290,7 +62872,7 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MigrationStartTime == nil {
-				m.MigrationStartTime = &v11.Time{}
+				m.MigrationStartTime = &v1.Time{}
 			}
 			if err := m.MigrationStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -60362,7 +62944,7 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastHibernationTriggerTime == nil {
-				m.LastHibernationTriggerTime = &v11.Time{}
+				m.LastHibernationTriggerTime = &v1.Time{}
 			}
 			if err := m.LastHibernationTriggerTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -60544,6 +63126,42 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedCost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EstimatedCost == nil {
+				m.EstimatedCost = &ShootCostEstimate{}
+			}
+			if err := m.EstimatedCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -61195,7 +63813,7 @@ func (m *VerticalPodAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.EvictAfterOOMThreshold == nil {
-				m.EvictAfterOOMThreshold = &v11.Duration{}
+				m.EvictAfterOOMThreshold = &v1.Duration{}
 			}
 			if err := m.EvictAfterOOMThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -61287,7 +63905,7 @@ func (m *VerticalPodAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.UpdaterInterval == nil {
-				m.UpdaterInterval = &v11.Duration{}
+				m.UpdaterInterval = &v1.Duration{}
 			}
 			if err := m.UpdaterInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -61323,7 +63941,7 @@ func (m *VerticalPodAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.RecommenderInterval == nil {
-				m.RecommenderInterval = &v11.Duration{}
+				m.RecommenderInterval = &v1.Duration{}
 			}
 			if err := m.RecommenderInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -61431,7 +64049,7 @@ func (m *VerticalPodAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.CPUHistogramDecayHalfLife == nil {
-				m.CPUHistogramDecayHalfLife = &v11.Duration{}
+				m.CPUHistogramDecayHalfLife = &v1.Duration{}
 			}
 			if err := m.CPUHistogramDecayHalfLife.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -61467,7 +64085,7 @@ func (m *VerticalPodAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MemoryHistogramDecayHalfLife == nil {
-				m.MemoryHistogramDecayHalfLife = &v11.Duration{}
+				m.MemoryHistogramDecayHalfLife = &v1.Duration{}
 			}
 			if err := m.MemoryHistogramDecayHalfLife.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -61503,7 +64121,7 @@ func (m *VerticalPodAutoscaler) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.MemoryAggregationInterval == nil {
-				m.MemoryAggregationInterval = &v11.Duration{}
+				m.MemoryAggregationInterval = &v1.Duration{}
 			}
 			if err := m.MemoryAggregationInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -62133,6 +64751,42 @@ func (m *VolumeType) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Price == nil {
+				m.Price = &Price{}
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -62886,7 +65540,7 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Taints = append(m.Taints, v1.Taint{})
+			m.Taints = append(m.Taints, v12.Taint{})
 			if err := m.Taints[len(m.Taints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
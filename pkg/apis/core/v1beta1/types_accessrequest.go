@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessRequest is a request for a time-bound membership in a Project. The membership is granted once the request has
+// been approved by the owner of the Project.
+type AccessRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec contains the specification of the AccessRequest.
+	Spec AccessRequestSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status contains the most recently observed status of the AccessRequest.
+	// +optional
+	Status AccessRequestStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessRequestList is a collection of AccessRequests.
+type AccessRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Items is the list of AccessRequests.
+	Items []AccessRequest `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// AccessRequestSpec is the specification of an AccessRequest.
+type AccessRequestSpec struct {
+	// Subject is the user, group, or service account which requests the membership.
+	Subject rbacv1.Subject `json:"subject" protobuf:"bytes,1,opt,name=subject"`
+	// Roles is the list of roles the subject requests.
+	Roles []string `json:"roles" protobuf:"bytes,2,rep,name=roles"`
+	// Duration is the duration of the membership after the request has been approved.
+	Duration metav1.Duration `json:"duration" protobuf:"bytes,3,opt,name=duration"`
+	// Reason is the justification of the request.
+	Reason string `json:"reason" protobuf:"bytes,4,opt,name=reason"`
+}
+
+// AccessRequestStatus holds the most recently observed status of an AccessRequest.
+type AccessRequestStatus struct {
+	// Phase is the current phase of the AccessRequest.
+	// +optional
+	Phase AccessRequestPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=AccessRequestPhase"`
+	// ExpirationTimestamp is the time at which the granted membership expires.
+	// +optional
+	ExpirationTimestamp *metav1.Time `json:"expirationTimestamp,omitempty" protobuf:"bytes,2,opt,name=expirationTimestamp"`
+}
+
+// AccessRequestPhase is a label for the condition of an AccessRequest at the current time.
+type AccessRequestPhase string
+
+const (
+	// AccessRequestPending indicates that the AccessRequest has not been approved yet.
+	AccessRequestPending AccessRequestPhase = "Pending"
+	// AccessRequestGranted indicates that the AccessRequest has been approved and the membership has been granted.
+	AccessRequestGranted AccessRequestPhase = "Granted"
+	// AccessRequestExpired indicates that the granted membership has expired.
+	AccessRequestExpired AccessRequestPhase = "Expired"
+)
@@ -194,6 +194,9 @@ type MachineType struct {
 	// Capabilities contains the machine type capabilities.
 	// +optional
 	Capabilities Capabilities `json:"capabilities,omitempty" protobuf:"bytes,8,rep,name=capabilities,casttype=Capabilities"`
+	// Price is the price of a machine of this type. It is used for estimating the cost of Shoots.
+	// +optional
+	Price *Price `json:"price,omitempty" protobuf:"bytes,9,opt,name=price"`
 }
 
 // MachineTypeStorage is the amount of storage associated with the root volume of this machine type.
@@ -228,6 +231,10 @@ type Region struct {
 	// AccessRestrictions describe a list of access restrictions that can be used for Shoots using this region.
 	// +optional
 	AccessRestrictions []AccessRestriction `json:"accessRestrictions,omitempty" protobuf:"bytes,4,rep,name=accessRestrictions"`
+	// PriceFactor is multiplied with the prices of machine and volume types for Shoots in this region, e.g. `1.2`.
+	// Defaults to 1 if not specified.
+	// +optional
+	PriceFactor *resource.Quantity `json:"priceFactor,omitempty" protobuf:"bytes,5,opt,name=priceFactor"`
 }
 
 // AvailabilityZone is an availability zone.
@@ -254,6 +261,17 @@ type VolumeType struct {
 	// MinSize is the minimal supported storage size.
 	// +optional
 	MinSize *resource.Quantity `json:"minSize,omitempty" protobuf:"bytes,4,opt,name=minSize"`
+	// Price is the price of one GiB of a volume of this type. It is used for estimating the cost of Shoots.
+	// +optional
+	Price *Price `json:"price,omitempty" protobuf:"bytes,5,opt,name=price"`
+}
+
+// Price is the price of a resource.
+type Price struct {
+	// Currency is the ISO 4217 code of the currency, e.g. `EUR` or `USD`.
+	Currency string `json:"currency" protobuf:"bytes,1,opt,name=currency"`
+	// Hourly is the price per hour.
+	Hourly resource.Quantity `json:"hourly" protobuf:"bytes,2,opt,name=hourly"`
 }
 
 // Bastion contains the bastions creation info
@@ -6,6 +6,7 @@ package v1beta1
 
 import (
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -79,6 +80,10 @@ type ProjectSpec struct {
 	// DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 	// +optional
 	DualApprovalForDeletion []DualApprovalForDeletion `json:"dualApprovalForDeletion,omitempty" protobuf:"bytes,8,opt,name=dualApprovalForDeletion"`
+	// CostBudget is the budget for the estimated cost of all Shoots in this project. Changes to Shoots which increase
+	// the estimated cost beyond the budget are admitted with a warning.
+	// +optional
+	CostBudget *ProjectCostBudget `json:"costBudget,omitempty" protobuf:"bytes,9,opt,name=costBudget"`
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -114,6 +119,10 @@ type ProjectMember struct {
 	// Roles represents the list of roles of this member.
 	// +optional
 	Roles []string `json:"roles,omitempty" protobuf:"bytes,3,rep,name=roles"`
+	// ExpirationTimestamp is the time after which the membership expires. Expired members are automatically removed from
+	// the project, i.e., their access is revoked. If not set, the membership does not expire.
+	// +optional
+	ExpirationTimestamp *metav1.Time `json:"expirationTimestamp,omitempty" protobuf:"bytes,4,opt,name=expirationTimestamp"`
 }
 
 // ProjectTolerations contains the tolerations for taints on seed clusters.
@@ -152,6 +161,14 @@ type DualApprovalForDeletion struct {
 	IncludeServiceAccounts *bool `json:"includeServiceAccounts,omitempty" protobuf:"varint,3,opt,name=includeServiceAccounts"`
 }
 
+// ProjectCostBudget is the budget for the estimated cost of all Shoots in a project.
+type ProjectCostBudget struct {
+	// Currency is the ISO 4217 code of the currency of the budget.
+	Currency string `json:"currency" protobuf:"bytes,1,opt,name=currency"`
+	// Monthly is the monthly budget.
+	Monthly resource.Quantity `json:"monthly" protobuf:"bytes,2,opt,name=monthly"`
+}
+
 const (
 	// ProjectMemberAdmin is a const for a role that provides full admin access.
 	ProjectMemberAdmin = "admin"
@@ -190,4 +207,9 @@ const (
 	ProjectEventNamespaceDeletionFailed = "NamespaceDeletionFailed"
 	// ProjectEventNamespaceMarkedForDeletion indicates that the namespace has been successfully marked for deletion.
 	ProjectEventNamespaceMarkedForDeletion = "NamespaceMarkedForDeletion"
+	// ProjectEventMembershipExpired indicates that an expired member has been removed from the project.
+	ProjectEventMembershipExpired = "MembershipExpired"
+	// ProjectEventAccessRequestGranted indicates that a member has been added to the project due to an approved
+	// AccessRequest.
+	ProjectEventAccessRequestGranted = "AccessRequestGranted"
 )
@@ -22,6 +22,9 @@ type Quota struct {
 	// Spec defines the Quota constraints.
 	// +optional
 	Spec QuotaSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// Status contains the most recently observed usage of the Quota.
+	// +optional
+	Status QuotaStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -46,4 +49,23 @@ type QuotaSpec struct {
 	Metrics corev1.ResourceList `json:"metrics" protobuf:"bytes,2,rep,name=metrics,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
 	// Scope is the scope of the Quota object, either 'project', 'secret' or 'workloadidentity'. This field is immutable.
 	Scope corev1.ObjectReference `json:"scope" protobuf:"bytes,3,opt,name=scope"` // TODO: When graduating the API to v1 consider reworking this field as described in https://github.com/gardener/gardener/issues/9773#issuecomment-2293340267
+	// WarningThresholdPercentage is the percentage of the limits in Metrics above which Shoot creations and updates
+	// result in warnings and events are recorded for the Quota.
+	// +optional
+	WarningThresholdPercentage *int32 `json:"warningThresholdPercentage,omitempty" protobuf:"varint,4,opt,name=warningThresholdPercentage"`
+}
+
+// QuotaStatus holds the most recently observed usage of a Quota.
+type QuotaStatus struct {
+	// Hard is the set of enforced limits for each metric.
+	// +optional
+	Hard corev1.ResourceList `json:"hard,omitempty" protobuf:"bytes,1,rep,name=hard,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// Used is the amount of resources allocated by the Shoots bound to the Quota for each metric.
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty" protobuf:"bytes,2,rep,name=used,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
 }
+
+const (
+	// QuotaEventWarningThresholdExceeded indicates that the usage of a Quota exceeds its warning threshold.
+	QuotaEventWarningThresholdExceeded = "WarningThresholdExceeded"
+)
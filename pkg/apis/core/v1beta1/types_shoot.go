@@ -228,6 +228,9 @@ type ShootStatus struct {
 	// ManualWorkerPoolRollout contains information about the worker pool rollout progress.
 	// +optional
 	ManualWorkerPoolRollout *ManualWorkerPoolRollout `json:"manualWorkerPoolRollout,omitempty" protobuf:"bytes,21,opt,name=manualWorkerPoolRollout"`
+	// EstimatedCost contains the estimated cost of the Shoot based on the prices specified in the CloudProfile.
+	// +optional
+	EstimatedCost *ShootCostEstimate `json:"estimatedCost,omitempty" protobuf:"bytes,22,opt,name=estimatedCost"`
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -352,6 +355,21 @@ type ManualWorkerPoolRollout struct {
 	PendingWorkersRollouts []PendingWorkersRollout `json:"pendingWorkersRollouts,omitempty" protobuf:"bytes,1,rep,name=pendingWorkersRollouts"`
 }
 
+// ShootCostEstimate contains the estimated cost of a Shoot. The minimum is computed with the minimum number of worker
+// nodes, the maximum with the maximum number of worker nodes. Monthly values take the hibernation schedules into account.
+type ShootCostEstimate struct {
+	// Currency is the ISO 4217 code of the currency of the estimated cost.
+	Currency string `json:"currency" protobuf:"bytes,1,opt,name=currency"`
+	// HourlyMinimum is the estimated hourly cost of the Shoot while it is awake with the minimum number of worker nodes.
+	HourlyMinimum resource.Quantity `json:"hourlyMinimum" protobuf:"bytes,2,opt,name=hourlyMinimum"`
+	// HourlyMaximum is the estimated hourly cost of the Shoot while it is awake with the maximum number of worker nodes.
+	HourlyMaximum resource.Quantity `json:"hourlyMaximum" protobuf:"bytes,3,opt,name=hourlyMaximum"`
+	// MonthlyMinimum is the estimated monthly cost of the Shoot with the minimum number of worker nodes.
+	MonthlyMinimum resource.Quantity `json:"monthlyMinimum" protobuf:"bytes,4,opt,name=monthlyMinimum"`
+	// MonthlyMaximum is the estimated monthly cost of the Shoot with the maximum number of worker nodes.
+	MonthlyMaximum resource.Quantity `json:"monthlyMaximum" protobuf:"bytes,5,opt,name=monthlyMaximum"`
+}
+
 // ShootKubeconfigRotation contains information about the kubeconfig credential rotation.
 type ShootKubeconfigRotation struct {
 	// LastInitiationTime is the most recent time when the kubeconfig credential rotation was initiated.
@@ -2040,6 +2058,10 @@ const (
 	ShootEventSchedulingSuccessful = "SchedulingSuccessful"
 	// ShootEventSchedulingFailed indicates that a scheduling decision failed.
 	ShootEventSchedulingFailed = "SchedulingFailed"
+	// ShootEventSeedRebalancingRecommended indicates that a better seed for the shoot's control plane was found.
+	ShootEventSeedRebalancingRecommended = "SeedRebalancingRecommended"
+	// ShootEventSeedRebalancingTriggered indicates that the control plane migration to a better seed was triggered.
+	ShootEventSeedRebalancingTriggered = "SeedRebalancingTriggered"
 )
 
 const (
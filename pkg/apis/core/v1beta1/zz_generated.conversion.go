@@ -13,11 +13,11 @@ import (
 	unsafe "unsafe"
 
 	core "github.com/gardener/gardener/pkg/apis/core"
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	types "k8s.io/apimachinery/pkg/types"
@@ -51,6 +51,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AccessRequest)(nil), (*core.AccessRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AccessRequest_To_core_AccessRequest(a.(*AccessRequest), b.(*core.AccessRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AccessRequest)(nil), (*AccessRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AccessRequest_To_v1beta1_AccessRequest(a.(*core.AccessRequest), b.(*AccessRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessRequestList)(nil), (*core.AccessRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AccessRequestList_To_core_AccessRequestList(a.(*AccessRequestList), b.(*core.AccessRequestList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AccessRequestList)(nil), (*AccessRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AccessRequestList_To_v1beta1_AccessRequestList(a.(*core.AccessRequestList), b.(*AccessRequestList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessRequestSpec)(nil), (*core.AccessRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AccessRequestSpec_To_core_AccessRequestSpec(a.(*AccessRequestSpec), b.(*core.AccessRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AccessRequestSpec)(nil), (*AccessRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AccessRequestSpec_To_v1beta1_AccessRequestSpec(a.(*core.AccessRequestSpec), b.(*AccessRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessRequestStatus)(nil), (*core.AccessRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AccessRequestStatus_To_core_AccessRequestStatus(a.(*AccessRequestStatus), b.(*core.AccessRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AccessRequestStatus)(nil), (*AccessRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AccessRequestStatus_To_v1beta1_AccessRequestStatus(a.(*core.AccessRequestStatus), b.(*AccessRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AccessRestriction)(nil), (*core.AccessRestriction)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AccessRestriction_To_core_AccessRestriction(a.(*AccessRestriction), b.(*core.AccessRestriction), scope)
 	}); err != nil {
@@ -1306,6 +1346,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Price)(nil), (*core.Price)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Price_To_core_Price(a.(*Price), b.(*core.Price), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.Price)(nil), (*Price)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_Price_To_v1beta1_Price(a.(*core.Price), b.(*Price), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Project)(nil), (*core.Project)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Project_To_core_Project(a.(*Project), b.(*core.Project), scope)
 	}); err != nil {
@@ -1316,6 +1366,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectCostBudget)(nil), (*core.ProjectCostBudget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectCostBudget_To_core_ProjectCostBudget(a.(*ProjectCostBudget), b.(*core.ProjectCostBudget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectCostBudget)(nil), (*ProjectCostBudget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectCostBudget_To_v1beta1_ProjectCostBudget(a.(*core.ProjectCostBudget), b.(*ProjectCostBudget), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectList)(nil), (*core.ProjectList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ProjectList_To_core_ProjectList(a.(*ProjectList), b.(*core.ProjectList), scope)
 	}); err != nil {
@@ -1386,6 +1446,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QuotaStatus)(nil), (*core.QuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(a.(*QuotaStatus), b.(*core.QuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.QuotaStatus)(nil), (*QuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(a.(*core.QuotaStatus), b.(*QuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Region)(nil), (*core.Region)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Region_To_core_Region(a.(*Region), b.(*core.Region), scope)
 	}); err != nil {
@@ -1756,6 +1826,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootCostEstimate)(nil), (*core.ShootCostEstimate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootCostEstimate_To_core_ShootCostEstimate(a.(*ShootCostEstimate), b.(*core.ShootCostEstimate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootCostEstimate)(nil), (*ShootCostEstimate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootCostEstimate_To_v1beta1_ShootCostEstimate(a.(*core.ShootCostEstimate), b.(*ShootCostEstimate), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootCredentials)(nil), (*core.ShootCredentials)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootCredentials_To_core_ShootCredentials(a.(*ShootCredentials), b.(*core.ShootCredentials), scope)
 	}); err != nil {
@@ -2108,6 +2188,108 @@ func Convert_core_APIServerRequests_To_v1beta1_APIServerRequests(in *core.APISer
 	return autoConvert_core_APIServerRequests_To_v1beta1_APIServerRequests(in, out, s)
 }
 
+func autoConvert_v1beta1_AccessRequest_To_core_AccessRequest(in *AccessRequest, out *core.AccessRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_AccessRequestSpec_To_core_AccessRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_AccessRequestStatus_To_core_AccessRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_AccessRequest_To_core_AccessRequest is an autogenerated conversion function.
+func Convert_v1beta1_AccessRequest_To_core_AccessRequest(in *AccessRequest, out *core.AccessRequest, s conversion.Scope) error {
+	return autoConvert_v1beta1_AccessRequest_To_core_AccessRequest(in, out, s)
+}
+
+func autoConvert_core_AccessRequest_To_v1beta1_AccessRequest(in *core.AccessRequest, out *AccessRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_AccessRequestSpec_To_v1beta1_AccessRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_AccessRequestStatus_To_v1beta1_AccessRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_AccessRequest_To_v1beta1_AccessRequest is an autogenerated conversion function.
+func Convert_core_AccessRequest_To_v1beta1_AccessRequest(in *core.AccessRequest, out *AccessRequest, s conversion.Scope) error {
+	return autoConvert_core_AccessRequest_To_v1beta1_AccessRequest(in, out, s)
+}
+
+func autoConvert_v1beta1_AccessRequestList_To_core_AccessRequestList(in *AccessRequestList, out *core.AccessRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.AccessRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_AccessRequestList_To_core_AccessRequestList is an autogenerated conversion function.
+func Convert_v1beta1_AccessRequestList_To_core_AccessRequestList(in *AccessRequestList, out *core.AccessRequestList, s conversion.Scope) error {
+	return autoConvert_v1beta1_AccessRequestList_To_core_AccessRequestList(in, out, s)
+}
+
+func autoConvert_core_AccessRequestList_To_v1beta1_AccessRequestList(in *core.AccessRequestList, out *AccessRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]AccessRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_AccessRequestList_To_v1beta1_AccessRequestList is an autogenerated conversion function.
+func Convert_core_AccessRequestList_To_v1beta1_AccessRequestList(in *core.AccessRequestList, out *AccessRequestList, s conversion.Scope) error {
+	return autoConvert_core_AccessRequestList_To_v1beta1_AccessRequestList(in, out, s)
+}
+
+func autoConvert_v1beta1_AccessRequestSpec_To_core_AccessRequestSpec(in *AccessRequestSpec, out *core.AccessRequestSpec, s conversion.Scope) error {
+	out.Subject = in.Subject
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	out.Duration = in.Duration
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_v1beta1_AccessRequestSpec_To_core_AccessRequestSpec is an autogenerated conversion function.
+func Convert_v1beta1_AccessRequestSpec_To_core_AccessRequestSpec(in *AccessRequestSpec, out *core.AccessRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_AccessRequestSpec_To_core_AccessRequestSpec(in, out, s)
+}
+
+func autoConvert_core_AccessRequestSpec_To_v1beta1_AccessRequestSpec(in *core.AccessRequestSpec, out *AccessRequestSpec, s conversion.Scope) error {
+	out.Subject = in.Subject
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	out.Duration = in.Duration
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_core_AccessRequestSpec_To_v1beta1_AccessRequestSpec is an autogenerated conversion function.
+func Convert_core_AccessRequestSpec_To_v1beta1_AccessRequestSpec(in *core.AccessRequestSpec, out *AccessRequestSpec, s conversion.Scope) error {
+	return autoConvert_core_AccessRequestSpec_To_v1beta1_AccessRequestSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_AccessRequestStatus_To_core_AccessRequestStatus(in *AccessRequestStatus, out *core.AccessRequestStatus, s conversion.Scope) error {
+	out.Phase = core.AccessRequestPhase(in.Phase)
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	return nil
+}
+
+// Convert_v1beta1_AccessRequestStatus_To_core_AccessRequestStatus is an autogenerated conversion function.
+func Convert_v1beta1_AccessRequestStatus_To_core_AccessRequestStatus(in *AccessRequestStatus, out *core.AccessRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_AccessRequestStatus_To_core_AccessRequestStatus(in, out, s)
+}
+
+func autoConvert_core_AccessRequestStatus_To_v1beta1_AccessRequestStatus(in *core.AccessRequestStatus, out *AccessRequestStatus, s conversion.Scope) error {
+	out.Phase = AccessRequestPhase(in.Phase)
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	return nil
+}
+
+// Convert_core_AccessRequestStatus_To_v1beta1_AccessRequestStatus is an autogenerated conversion function.
+func Convert_core_AccessRequestStatus_To_v1beta1_AccessRequestStatus(in *core.AccessRequestStatus, out *AccessRequestStatus, s conversion.Scope) error {
+	return autoConvert_core_AccessRequestStatus_To_v1beta1_AccessRequestStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_AccessRestriction_To_core_AccessRestriction(in *AccessRestriction, out *core.AccessRestriction, s conversion.Scope) error {
 	out.Name = in.Name
 	return nil
@@ -2263,7 +2445,7 @@ func Convert_core_AuditConfig_To_v1beta1_AuditConfig(in *core.AuditConfig, out *
 }
 
 func autoConvert_v1beta1_AuditPolicy_To_core_AuditPolicy(in *AuditPolicy, out *core.AuditPolicy, s conversion.Scope) error {
-	out.ConfigMapRef = (*v1.ObjectReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.ConfigMapRef = (*corev1.ObjectReference)(unsafe.Pointer(in.ConfigMapRef))
 	return nil
 }
 
@@ -2273,7 +2455,7 @@ func Convert_v1beta1_AuditPolicy_To_core_AuditPolicy(in *AuditPolicy, out *core.
 }
 
 func autoConvert_core_AuditPolicy_To_v1beta1_AuditPolicy(in *core.AuditPolicy, out *AuditPolicy, s conversion.Scope) error {
-	out.ConfigMapRef = (*v1.ObjectReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.ConfigMapRef = (*corev1.ObjectReference)(unsafe.Pointer(in.ConfigMapRef))
 	return nil
 }
 
@@ -2332,7 +2514,7 @@ func autoConvert_v1beta1_Backup_To_core_Backup(in *Backup, out *core.Backup, s c
 	out.Provider = in.Provider
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
 	out.Region = (*string)(unsafe.Pointer(in.Region))
-	out.CredentialsRef = (*v1.ObjectReference)(unsafe.Pointer(in.CredentialsRef))
+	out.CredentialsRef = (*corev1.ObjectReference)(unsafe.Pointer(in.CredentialsRef))
 	return nil
 }
 
@@ -2345,7 +2527,7 @@ func autoConvert_core_Backup_To_v1beta1_Backup(in *core.Backup, out *Backup, s c
 	out.Provider = in.Provider
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
 	out.Region = (*string)(unsafe.Pointer(in.Region))
-	out.CredentialsRef = (*v1.ObjectReference)(unsafe.Pointer(in.CredentialsRef))
+	out.CredentialsRef = (*corev1.ObjectReference)(unsafe.Pointer(in.CredentialsRef))
 	return nil
 }
 
@@ -2436,8 +2618,8 @@ func autoConvert_v1beta1_BackupBucketSpec_To_core_BackupBucketSpec(in *BackupBuc
 	}
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
-	out.CredentialsRef = (*v1.ObjectReference)(unsafe.Pointer(in.CredentialsRef))
-	out.ShootRef = (*v1.ObjectReference)(unsafe.Pointer(in.ShootRef))
+	out.CredentialsRef = (*corev1.ObjectReference)(unsafe.Pointer(in.CredentialsRef))
+	out.ShootRef = (*corev1.ObjectReference)(unsafe.Pointer(in.ShootRef))
 	return nil
 }
 
@@ -2452,8 +2634,8 @@ func autoConvert_core_BackupBucketSpec_To_v1beta1_BackupBucketSpec(in *core.Back
 	}
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
-	out.CredentialsRef = (*v1.ObjectReference)(unsafe.Pointer(in.CredentialsRef))
-	out.ShootRef = (*v1.ObjectReference)(unsafe.Pointer(in.ShootRef))
+	out.CredentialsRef = (*corev1.ObjectReference)(unsafe.Pointer(in.CredentialsRef))
+	out.ShootRef = (*corev1.ObjectReference)(unsafe.Pointer(in.ShootRef))
 	return nil
 }
 
@@ -2467,7 +2649,7 @@ func autoConvert_v1beta1_BackupBucketStatus_To_core_BackupBucketStatus(in *Backu
 	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
 	out.LastError = (*core.LastError)(unsafe.Pointer(in.LastError))
 	out.ObservedGeneration = in.ObservedGeneration
-	out.GeneratedSecretRef = (*v1.SecretReference)(unsafe.Pointer(in.GeneratedSecretRef))
+	out.GeneratedSecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.GeneratedSecretRef))
 	return nil
 }
 
@@ -2481,7 +2663,7 @@ func autoConvert_core_BackupBucketStatus_To_v1beta1_BackupBucketStatus(in *core.
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
 	out.LastError = (*LastError)(unsafe.Pointer(in.LastError))
 	out.ObservedGeneration = in.ObservedGeneration
-	out.GeneratedSecretRef = (*v1.SecretReference)(unsafe.Pointer(in.GeneratedSecretRef))
+	out.GeneratedSecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.GeneratedSecretRef))
 	return nil
 }
 
@@ -2547,7 +2729,7 @@ func Convert_core_BackupEntryList_To_v1beta1_BackupEntryList(in *core.BackupEntr
 func autoConvert_v1beta1_BackupEntrySpec_To_core_BackupEntrySpec(in *BackupEntrySpec, out *core.BackupEntrySpec, s conversion.Scope) error {
 	out.BucketName = in.BucketName
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
-	out.ShootRef = (*v1.ObjectReference)(unsafe.Pointer(in.ShootRef))
+	out.ShootRef = (*corev1.ObjectReference)(unsafe.Pointer(in.ShootRef))
 	return nil
 }
 
@@ -2559,7 +2741,7 @@ func Convert_v1beta1_BackupEntrySpec_To_core_BackupEntrySpec(in *BackupEntrySpec
 func autoConvert_core_BackupEntrySpec_To_v1beta1_BackupEntrySpec(in *core.BackupEntrySpec, out *BackupEntrySpec, s conversion.Scope) error {
 	out.BucketName = in.BucketName
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
-	out.ShootRef = (*v1.ObjectReference)(unsafe.Pointer(in.ShootRef))
+	out.ShootRef = (*corev1.ObjectReference)(unsafe.Pointer(in.ShootRef))
 	return nil
 }
 
@@ -2573,7 +2755,7 @@ func autoConvert_v1beta1_BackupEntryStatus_To_core_BackupEntryStatus(in *BackupE
 	out.LastError = (*core.LastError)(unsafe.Pointer(in.LastError))
 	out.ObservedGeneration = in.ObservedGeneration
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
-	out.MigrationStartTime = (*metav1.Time)(unsafe.Pointer(in.MigrationStartTime))
+	out.MigrationStartTime = (*v1.Time)(unsafe.Pointer(in.MigrationStartTime))
 	return nil
 }
 
@@ -2587,7 +2769,7 @@ func autoConvert_core_BackupEntryStatus_To_v1beta1_BackupEntryStatus(in *core.Ba
 	out.LastError = (*LastError)(unsafe.Pointer(in.LastError))
 	out.ObservedGeneration = in.ObservedGeneration
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
-	out.MigrationStartTime = (*metav1.Time)(unsafe.Pointer(in.MigrationStartTime))
+	out.MigrationStartTime = (*v1.Time)(unsafe.Pointer(in.MigrationStartTime))
 	return nil
 }
 
@@ -2662,10 +2844,10 @@ func Convert_core_BastionMachineType_To_v1beta1_BastionMachineType(in *core.Bast
 
 func autoConvert_v1beta1_CARotation_To_core_CARotation(in *CARotation, out *core.CARotation, s conversion.Scope) error {
 	out.Phase = core.CredentialsRotationPhase(in.Phase)
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastInitiationFinishedTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
-	out.LastCompletionTriggeredTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastInitiationFinishedTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
+	out.LastCompletionTriggeredTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
 	out.PendingWorkersRollouts = *(*[]core.PendingWorkersRollout)(unsafe.Pointer(&in.PendingWorkersRollouts))
 	return nil
 }
@@ -2677,10 +2859,10 @@ func Convert_v1beta1_CARotation_To_core_CARotation(in *CARotation, out *core.CAR
 
 func autoConvert_core_CARotation_To_v1beta1_CARotation(in *core.CARotation, out *CARotation, s conversion.Scope) error {
 	out.Phase = CredentialsRotationPhase(in.Phase)
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastInitiationFinishedTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
-	out.LastCompletionTriggeredTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastInitiationFinishedTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
+	out.LastCompletionTriggeredTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
 	out.PendingWorkersRollouts = *(*[]PendingWorkersRollout)(unsafe.Pointer(&in.PendingWorkersRollouts))
 	return nil
 }
@@ -2851,17 +3033,17 @@ func Convert_core_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in *core.CloudPro
 }
 
 func autoConvert_v1beta1_ClusterAutoscaler_To_core_ClusterAutoscaler(in *ClusterAutoscaler, out *core.ClusterAutoscaler, s conversion.Scope) error {
-	out.ScaleDownDelayAfterAdd = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterAdd))
-	out.ScaleDownDelayAfterDelete = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
-	out.ScaleDownDelayAfterFailure = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterFailure))
-	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
+	out.ScaleDownDelayAfterAdd = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterAdd))
+	out.ScaleDownDelayAfterDelete = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
+	out.ScaleDownDelayAfterFailure = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterFailure))
+	out.ScaleDownUnneededTime = (*v1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
 	out.ScaleDownUtilizationThreshold = (*float64)(unsafe.Pointer(in.ScaleDownUtilizationThreshold))
-	out.ScanInterval = (*metav1.Duration)(unsafe.Pointer(in.ScanInterval))
+	out.ScanInterval = (*v1.Duration)(unsafe.Pointer(in.ScanInterval))
 	out.Expander = (*core.ExpanderMode)(unsafe.Pointer(in.Expander))
-	out.MaxNodeProvisionTime = (*metav1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
+	out.MaxNodeProvisionTime = (*v1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
 	out.MaxGracefulTerminationSeconds = (*int32)(unsafe.Pointer(in.MaxGracefulTerminationSeconds))
 	out.IgnoreTaints = *(*[]string)(unsafe.Pointer(&in.IgnoreTaints))
-	out.NewPodScaleUpDelay = (*metav1.Duration)(unsafe.Pointer(in.NewPodScaleUpDelay))
+	out.NewPodScaleUpDelay = (*v1.Duration)(unsafe.Pointer(in.NewPodScaleUpDelay))
 	out.MaxEmptyBulkDelete = (*int32)(unsafe.Pointer(in.MaxEmptyBulkDelete))
 	out.IgnoreDaemonsetsUtilization = (*bool)(unsafe.Pointer(in.IgnoreDaemonsetsUtilization))
 	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
@@ -2869,9 +3051,9 @@ func autoConvert_v1beta1_ClusterAutoscaler_To_core_ClusterAutoscaler(in *Cluster
 	out.StatusTaints = *(*[]string)(unsafe.Pointer(&in.StatusTaints))
 	out.MaxScaleDownParallelism = (*int32)(unsafe.Pointer(in.MaxScaleDownParallelism))
 	out.MaxDrainParallelism = (*int32)(unsafe.Pointer(in.MaxDrainParallelism))
-	out.InitialNodeGroupBackoffDuration = (*metav1.Duration)(unsafe.Pointer(in.InitialNodeGroupBackoffDuration))
-	out.MaxNodeGroupBackoffDuration = (*metav1.Duration)(unsafe.Pointer(in.MaxNodeGroupBackoffDuration))
-	out.NodeGroupBackoffResetTimeout = (*metav1.Duration)(unsafe.Pointer(in.NodeGroupBackoffResetTimeout))
+	out.InitialNodeGroupBackoffDuration = (*v1.Duration)(unsafe.Pointer(in.InitialNodeGroupBackoffDuration))
+	out.MaxNodeGroupBackoffDuration = (*v1.Duration)(unsafe.Pointer(in.MaxNodeGroupBackoffDuration))
+	out.NodeGroupBackoffResetTimeout = (*v1.Duration)(unsafe.Pointer(in.NodeGroupBackoffResetTimeout))
 	return nil
 }
 
@@ -2881,22 +3063,22 @@ func Convert_v1beta1_ClusterAutoscaler_To_core_ClusterAutoscaler(in *ClusterAuto
 }
 
 func autoConvert_core_ClusterAutoscaler_To_v1beta1_ClusterAutoscaler(in *core.ClusterAutoscaler, out *ClusterAutoscaler, s conversion.Scope) error {
-	out.ScaleDownDelayAfterAdd = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterAdd))
-	out.ScaleDownDelayAfterDelete = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
-	out.ScaleDownDelayAfterFailure = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterFailure))
-	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
+	out.ScaleDownDelayAfterAdd = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterAdd))
+	out.ScaleDownDelayAfterDelete = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
+	out.ScaleDownDelayAfterFailure = (*v1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterFailure))
+	out.ScaleDownUnneededTime = (*v1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
 	out.ScaleDownUtilizationThreshold = (*float64)(unsafe.Pointer(in.ScaleDownUtilizationThreshold))
-	out.ScanInterval = (*metav1.Duration)(unsafe.Pointer(in.ScanInterval))
+	out.ScanInterval = (*v1.Duration)(unsafe.Pointer(in.ScanInterval))
 	out.Expander = (*ExpanderMode)(unsafe.Pointer(in.Expander))
-	out.MaxNodeProvisionTime = (*metav1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
-	out.InitialNodeGroupBackoffDuration = (*metav1.Duration)(unsafe.Pointer(in.InitialNodeGroupBackoffDuration))
-	out.MaxNodeGroupBackoffDuration = (*metav1.Duration)(unsafe.Pointer(in.MaxNodeGroupBackoffDuration))
-	out.NodeGroupBackoffResetTimeout = (*metav1.Duration)(unsafe.Pointer(in.NodeGroupBackoffResetTimeout))
+	out.MaxNodeProvisionTime = (*v1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
+	out.InitialNodeGroupBackoffDuration = (*v1.Duration)(unsafe.Pointer(in.InitialNodeGroupBackoffDuration))
+	out.MaxNodeGroupBackoffDuration = (*v1.Duration)(unsafe.Pointer(in.MaxNodeGroupBackoffDuration))
+	out.NodeGroupBackoffResetTimeout = (*v1.Duration)(unsafe.Pointer(in.NodeGroupBackoffResetTimeout))
 	out.MaxGracefulTerminationSeconds = (*int32)(unsafe.Pointer(in.MaxGracefulTerminationSeconds))
 	out.StartupTaints = *(*[]string)(unsafe.Pointer(&in.StartupTaints))
 	out.StatusTaints = *(*[]string)(unsafe.Pointer(&in.StatusTaints))
 	out.IgnoreTaints = *(*[]string)(unsafe.Pointer(&in.IgnoreTaints))
-	out.NewPodScaleUpDelay = (*metav1.Duration)(unsafe.Pointer(in.NewPodScaleUpDelay))
+	out.NewPodScaleUpDelay = (*v1.Duration)(unsafe.Pointer(in.NewPodScaleUpDelay))
 	out.MaxEmptyBulkDelete = (*int32)(unsafe.Pointer(in.MaxEmptyBulkDelete))
 	out.MaxScaleDownParallelism = (*int32)(unsafe.Pointer(in.MaxScaleDownParallelism))
 	out.MaxDrainParallelism = (*int32)(unsafe.Pointer(in.MaxDrainParallelism))
@@ -2913,9 +3095,9 @@ func Convert_core_ClusterAutoscaler_To_v1beta1_ClusterAutoscaler(in *core.Cluste
 func autoConvert_v1beta1_ClusterAutoscalerOptions_To_core_ClusterAutoscalerOptions(in *ClusterAutoscalerOptions, out *core.ClusterAutoscalerOptions, s conversion.Scope) error {
 	out.ScaleDownUtilizationThreshold = (*float64)(unsafe.Pointer(in.ScaleDownUtilizationThreshold))
 	out.ScaleDownGpuUtilizationThreshold = (*float64)(unsafe.Pointer(in.ScaleDownGpuUtilizationThreshold))
-	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
-	out.ScaleDownUnreadyTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnreadyTime))
-	out.MaxNodeProvisionTime = (*metav1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
+	out.ScaleDownUnneededTime = (*v1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
+	out.ScaleDownUnreadyTime = (*v1.Duration)(unsafe.Pointer(in.ScaleDownUnreadyTime))
+	out.MaxNodeProvisionTime = (*v1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
 	return nil
 }
 
@@ -2927,9 +3109,9 @@ func Convert_v1beta1_ClusterAutoscalerOptions_To_core_ClusterAutoscalerOptions(i
 func autoConvert_core_ClusterAutoscalerOptions_To_v1beta1_ClusterAutoscalerOptions(in *core.ClusterAutoscalerOptions, out *ClusterAutoscalerOptions, s conversion.Scope) error {
 	out.ScaleDownUtilizationThreshold = (*float64)(unsafe.Pointer(in.ScaleDownUtilizationThreshold))
 	out.ScaleDownGpuUtilizationThreshold = (*float64)(unsafe.Pointer(in.ScaleDownGpuUtilizationThreshold))
-	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
-	out.ScaleDownUnreadyTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnreadyTime))
-	out.MaxNodeProvisionTime = (*metav1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
+	out.ScaleDownUnneededTime = (*v1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
+	out.ScaleDownUnreadyTime = (*v1.Duration)(unsafe.Pointer(in.ScaleDownUnreadyTime))
+	out.MaxNodeProvisionTime = (*v1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
 	return nil
 }
 
@@ -3013,7 +3195,7 @@ func Convert_core_ControlPlane_To_v1beta1_ControlPlane(in *core.ControlPlane, ou
 }
 
 func autoConvert_v1beta1_ControlPlaneAutoscaling_To_core_ControlPlaneAutoscaling(in *ControlPlaneAutoscaling, out *core.ControlPlaneAutoscaling, s conversion.Scope) error {
-	out.MinAllowed = *(*v1.ResourceList)(unsafe.Pointer(&in.MinAllowed))
+	out.MinAllowed = *(*corev1.ResourceList)(unsafe.Pointer(&in.MinAllowed))
 	return nil
 }
 
@@ -3023,7 +3205,7 @@ func Convert_v1beta1_ControlPlaneAutoscaling_To_core_ControlPlaneAutoscaling(in
 }
 
 func autoConvert_core_ControlPlaneAutoscaling_To_v1beta1_ControlPlaneAutoscaling(in *core.ControlPlaneAutoscaling, out *ControlPlaneAutoscaling, s conversion.Scope) error {
-	out.MinAllowed = *(*v1.ResourceList)(unsafe.Pointer(&in.MinAllowed))
+	out.MinAllowed = *(*corev1.ResourceList)(unsafe.Pointer(&in.MinAllowed))
 	return nil
 }
 
@@ -3152,7 +3334,7 @@ func Convert_core_ControllerInstallationList_To_v1beta1_ControllerInstallationLi
 func autoConvert_v1beta1_ControllerInstallationSpec_To_core_ControllerInstallationSpec(in *ControllerInstallationSpec, out *core.ControllerInstallationSpec, s conversion.Scope) error {
 	out.RegistrationRef = in.RegistrationRef
 	out.SeedRef = in.SeedRef
-	out.DeploymentRef = (*v1.ObjectReference)(unsafe.Pointer(in.DeploymentRef))
+	out.DeploymentRef = (*corev1.ObjectReference)(unsafe.Pointer(in.DeploymentRef))
 	return nil
 }
 
@@ -3164,7 +3346,7 @@ func Convert_v1beta1_ControllerInstallationSpec_To_core_ControllerInstallationSp
 func autoConvert_core_ControllerInstallationSpec_To_v1beta1_ControllerInstallationSpec(in *core.ControllerInstallationSpec, out *ControllerInstallationSpec, s conversion.Scope) error {
 	out.RegistrationRef = in.RegistrationRef
 	out.SeedRef = in.SeedRef
-	out.DeploymentRef = (*v1.ObjectReference)(unsafe.Pointer(in.DeploymentRef))
+	out.DeploymentRef = (*corev1.ObjectReference)(unsafe.Pointer(in.DeploymentRef))
 	return nil
 }
 
@@ -3223,7 +3405,7 @@ func Convert_core_ControllerRegistration_To_v1beta1_ControllerRegistration(in *c
 
 func autoConvert_v1beta1_ControllerRegistrationDeployment_To_core_ControllerRegistrationDeployment(in *ControllerRegistrationDeployment, out *core.ControllerRegistrationDeployment, s conversion.Scope) error {
 	out.Policy = (*core.ControllerDeploymentPolicy)(unsafe.Pointer(in.Policy))
-	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.SeedSelector = (*v1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
 	out.DeploymentRefs = *(*[]core.DeploymentRef)(unsafe.Pointer(&in.DeploymentRefs))
 	return nil
 }
@@ -3235,7 +3417,7 @@ func Convert_v1beta1_ControllerRegistrationDeployment_To_core_ControllerRegistra
 
 func autoConvert_core_ControllerRegistrationDeployment_To_v1beta1_ControllerRegistrationDeployment(in *core.ControllerRegistrationDeployment, out *ControllerRegistrationDeployment, s conversion.Scope) error {
 	out.Policy = (*ControllerDeploymentPolicy)(unsafe.Pointer(in.Policy))
-	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.SeedSelector = (*v1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
 	out.DeploymentRefs = *(*[]DeploymentRef)(unsafe.Pointer(&in.DeploymentRefs))
 	return nil
 }
@@ -3292,7 +3474,7 @@ func Convert_core_ControllerRegistrationSpec_To_v1beta1_ControllerRegistrationSp
 func autoConvert_v1beta1_ControllerResource_To_core_ControllerResource(in *ControllerResource, out *core.ControllerResource, s conversion.Scope) error {
 	out.Kind = in.Kind
 	out.Type = in.Type
-	out.ReconcileTimeout = (*metav1.Duration)(unsafe.Pointer(in.ReconcileTimeout))
+	out.ReconcileTimeout = (*v1.Duration)(unsafe.Pointer(in.ReconcileTimeout))
 	out.Primary = (*bool)(unsafe.Pointer(in.Primary))
 	out.Lifecycle = (*core.ControllerResourceLifecycle)(unsafe.Pointer(in.Lifecycle))
 	out.WorkerlessSupported = (*bool)(unsafe.Pointer(in.WorkerlessSupported))
@@ -3309,7 +3491,7 @@ func Convert_v1beta1_ControllerResource_To_core_ControllerResource(in *Controlle
 func autoConvert_core_ControllerResource_To_v1beta1_ControllerResource(in *core.ControllerResource, out *ControllerResource, s conversion.Scope) error {
 	out.Kind = in.Kind
 	out.Type = in.Type
-	out.ReconcileTimeout = (*metav1.Duration)(unsafe.Pointer(in.ReconcileTimeout))
+	out.ReconcileTimeout = (*v1.Duration)(unsafe.Pointer(in.ReconcileTimeout))
 	out.Primary = (*bool)(unsafe.Pointer(in.Primary))
 	out.Lifecycle = (*ControllerResourceLifecycle)(unsafe.Pointer(in.Lifecycle))
 	out.WorkerlessSupported = (*bool)(unsafe.Pointer(in.WorkerlessSupported))
@@ -3595,10 +3777,10 @@ func Convert_core_ETCDConfig_To_v1beta1_ETCDConfig(in *core.ETCDConfig, out *ETC
 
 func autoConvert_v1beta1_ETCDEncryptionKeyRotation_To_core_ETCDEncryptionKeyRotation(in *ETCDEncryptionKeyRotation, out *core.ETCDEncryptionKeyRotation, s conversion.Scope) error {
 	out.Phase = core.CredentialsRotationPhase(in.Phase)
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastInitiationFinishedTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
-	out.LastCompletionTriggeredTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastInitiationFinishedTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
+	out.LastCompletionTriggeredTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
 	out.AutoCompleteAfterPrepared = (*bool)(unsafe.Pointer(in.AutoCompleteAfterPrepared))
 	return nil
 }
@@ -3610,10 +3792,10 @@ func Convert_v1beta1_ETCDEncryptionKeyRotation_To_core_ETCDEncryptionKeyRotation
 
 func autoConvert_core_ETCDEncryptionKeyRotation_To_v1beta1_ETCDEncryptionKeyRotation(in *core.ETCDEncryptionKeyRotation, out *ETCDEncryptionKeyRotation, s conversion.Scope) error {
 	out.Phase = CredentialsRotationPhase(in.Phase)
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastInitiationFinishedTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
-	out.LastCompletionTriggeredTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastInitiationFinishedTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
+	out.LastCompletionTriggeredTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
 	out.AutoCompleteAfterPrepared = (*bool)(unsafe.Pointer(in.AutoCompleteAfterPrepared))
 	return nil
 }
@@ -3665,7 +3847,7 @@ func Convert_core_EncryptionConfig_To_v1beta1_EncryptionConfig(in *core.Encrypti
 
 func autoConvert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(in *ExpirableVersion, out *core.ExpirableVersion, s conversion.Scope) error {
 	out.Version = in.Version
-	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
+	out.ExpirationDate = (*v1.Time)(unsafe.Pointer(in.ExpirationDate))
 	out.Classification = (*core.VersionClassification)(unsafe.Pointer(in.Classification))
 	return nil
 }
@@ -3677,7 +3859,7 @@ func Convert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(in *ExpirableVers
 
 func autoConvert_core_ExpirableVersion_To_v1beta1_ExpirableVersion(in *core.ExpirableVersion, out *ExpirableVersion, s conversion.Scope) error {
 	out.Version = in.Version
-	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
+	out.ExpirationDate = (*v1.Time)(unsafe.Pointer(in.ExpirationDate))
 	out.Classification = (*VersionClassification)(unsafe.Pointer(in.Classification))
 	return nil
 }
@@ -3962,10 +4144,10 @@ func Convert_core_HighAvailability_To_v1beta1_HighAvailability(in *core.HighAvai
 }
 
 func autoConvert_v1beta1_HorizontalPodAutoscalerConfig_To_core_HorizontalPodAutoscalerConfig(in *HorizontalPodAutoscalerConfig, out *core.HorizontalPodAutoscalerConfig, s conversion.Scope) error {
-	out.CPUInitializationPeriod = (*metav1.Duration)(unsafe.Pointer(in.CPUInitializationPeriod))
-	out.DownscaleStabilization = (*metav1.Duration)(unsafe.Pointer(in.DownscaleStabilization))
-	out.InitialReadinessDelay = (*metav1.Duration)(unsafe.Pointer(in.InitialReadinessDelay))
-	out.SyncPeriod = (*metav1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.CPUInitializationPeriod = (*v1.Duration)(unsafe.Pointer(in.CPUInitializationPeriod))
+	out.DownscaleStabilization = (*v1.Duration)(unsafe.Pointer(in.DownscaleStabilization))
+	out.InitialReadinessDelay = (*v1.Duration)(unsafe.Pointer(in.InitialReadinessDelay))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.Tolerance = (*float64)(unsafe.Pointer(in.Tolerance))
 	return nil
 }
@@ -3976,10 +4158,10 @@ func Convert_v1beta1_HorizontalPodAutoscalerConfig_To_core_HorizontalPodAutoscal
 }
 
 func autoConvert_core_HorizontalPodAutoscalerConfig_To_v1beta1_HorizontalPodAutoscalerConfig(in *core.HorizontalPodAutoscalerConfig, out *HorizontalPodAutoscalerConfig, s conversion.Scope) error {
-	out.CPUInitializationPeriod = (*metav1.Duration)(unsafe.Pointer(in.CPUInitializationPeriod))
-	out.DownscaleStabilization = (*metav1.Duration)(unsafe.Pointer(in.DownscaleStabilization))
-	out.InitialReadinessDelay = (*metav1.Duration)(unsafe.Pointer(in.InitialReadinessDelay))
-	out.SyncPeriod = (*metav1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.CPUInitializationPeriod = (*v1.Duration)(unsafe.Pointer(in.CPUInitializationPeriod))
+	out.DownscaleStabilization = (*v1.Duration)(unsafe.Pointer(in.DownscaleStabilization))
+	out.InitialReadinessDelay = (*v1.Duration)(unsafe.Pointer(in.InitialReadinessDelay))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.Tolerance = (*float64)(unsafe.Pointer(in.Tolerance))
 	return nil
 }
@@ -4084,7 +4266,7 @@ func autoConvert_v1beta1_InternalSecret_To_core_InternalSecret(in *InternalSecre
 	out.Immutable = (*bool)(unsafe.Pointer(in.Immutable))
 	out.Data = *(*map[string][]byte)(unsafe.Pointer(&in.Data))
 	// INFO: in.StringData opted out of conversion generation
-	out.Type = v1.SecretType(in.Type)
+	out.Type = corev1.SecretType(in.Type)
 	return nil
 }
 
@@ -4092,7 +4274,7 @@ func autoConvert_core_InternalSecret_To_v1beta1_InternalSecret(in *core.Internal
 	out.ObjectMeta = in.ObjectMeta
 	out.Immutable = (*bool)(unsafe.Pointer(in.Immutable))
 	out.Data = *(*map[string][]byte)(unsafe.Pointer(&in.Data))
-	out.Type = v1.SecretType(in.Type)
+	out.Type = corev1.SecretType(in.Type)
 	return nil
 }
 
@@ -4166,7 +4348,7 @@ func autoConvert_v1beta1_KubeAPIServerConfig_To_core_KubeAPIServerConfig(in *Kub
 	out.WatchCacheSizes = (*core.WatchCacheSizes)(unsafe.Pointer(in.WatchCacheSizes))
 	out.Requests = (*core.APIServerRequests)(unsafe.Pointer(in.Requests))
 	out.EnableAnonymousAuthentication = (*bool)(unsafe.Pointer(in.EnableAnonymousAuthentication))
-	out.EventTTL = (*metav1.Duration)(unsafe.Pointer(in.EventTTL))
+	out.EventTTL = (*v1.Duration)(unsafe.Pointer(in.EventTTL))
 	out.Logging = (*core.APIServerLogging)(unsafe.Pointer(in.Logging))
 	out.DefaultNotReadyTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultNotReadyTolerationSeconds))
 	out.DefaultUnreachableTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultUnreachableTolerationSeconds))
@@ -4205,7 +4387,7 @@ func autoConvert_core_KubeAPIServerConfig_To_v1beta1_KubeAPIServerConfig(in *cor
 	out.WatchCacheSizes = (*WatchCacheSizes)(unsafe.Pointer(in.WatchCacheSizes))
 	out.Requests = (*APIServerRequests)(unsafe.Pointer(in.Requests))
 	out.EnableAnonymousAuthentication = (*bool)(unsafe.Pointer(in.EnableAnonymousAuthentication))
-	out.EventTTL = (*metav1.Duration)(unsafe.Pointer(in.EventTTL))
+	out.EventTTL = (*v1.Duration)(unsafe.Pointer(in.EventTTL))
 	out.Logging = (*APIServerLogging)(unsafe.Pointer(in.Logging))
 	out.DefaultNotReadyTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultNotReadyTolerationSeconds))
 	out.DefaultUnreachableTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultUnreachableTolerationSeconds))
@@ -4227,8 +4409,8 @@ func autoConvert_v1beta1_KubeControllerManagerConfig_To_core_KubeControllerManag
 	}
 	out.HorizontalPodAutoscalerConfig = (*core.HorizontalPodAutoscalerConfig)(unsafe.Pointer(in.HorizontalPodAutoscalerConfig))
 	out.NodeCIDRMaskSize = (*int32)(unsafe.Pointer(in.NodeCIDRMaskSize))
-	out.PodEvictionTimeout = (*metav1.Duration)(unsafe.Pointer(in.PodEvictionTimeout))
-	out.NodeMonitorGracePeriod = (*metav1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
+	out.PodEvictionTimeout = (*v1.Duration)(unsafe.Pointer(in.PodEvictionTimeout))
+	out.NodeMonitorGracePeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
 	return nil
 }
 
@@ -4243,8 +4425,8 @@ func autoConvert_core_KubeControllerManagerConfig_To_v1beta1_KubeControllerManag
 	}
 	out.HorizontalPodAutoscalerConfig = (*HorizontalPodAutoscalerConfig)(unsafe.Pointer(in.HorizontalPodAutoscalerConfig))
 	out.NodeCIDRMaskSize = (*int32)(unsafe.Pointer(in.NodeCIDRMaskSize))
-	out.PodEvictionTimeout = (*metav1.Duration)(unsafe.Pointer(in.PodEvictionTimeout))
-	out.NodeMonitorGracePeriod = (*metav1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
+	out.PodEvictionTimeout = (*v1.Duration)(unsafe.Pointer(in.PodEvictionTimeout))
+	out.NodeMonitorGracePeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
 	return nil
 }
 
@@ -4318,7 +4500,7 @@ func autoConvert_v1beta1_KubeletConfig_To_core_KubeletConfig(in *KubeletConfig,
 	out.EvictionHard = (*core.KubeletConfigEviction)(unsafe.Pointer(in.EvictionHard))
 	out.EvictionMaxPodGracePeriod = (*int32)(unsafe.Pointer(in.EvictionMaxPodGracePeriod))
 	out.EvictionMinimumReclaim = (*core.KubeletConfigEvictionMinimumReclaim)(unsafe.Pointer(in.EvictionMinimumReclaim))
-	out.EvictionPressureTransitionPeriod = (*metav1.Duration)(unsafe.Pointer(in.EvictionPressureTransitionPeriod))
+	out.EvictionPressureTransitionPeriod = (*v1.Duration)(unsafe.Pointer(in.EvictionPressureTransitionPeriod))
 	out.EvictionSoft = (*core.KubeletConfigEviction)(unsafe.Pointer(in.EvictionSoft))
 	out.EvictionSoftGracePeriod = (*core.KubeletConfigEvictionSoftGracePeriod)(unsafe.Pointer(in.EvictionSoftGracePeriod))
 	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
@@ -4335,11 +4517,11 @@ func autoConvert_v1beta1_KubeletConfig_To_core_KubeletConfig(in *KubeletConfig,
 	out.ContainerLogMaxSize = (*resource.Quantity)(unsafe.Pointer(in.ContainerLogMaxSize))
 	out.ContainerLogMaxFiles = (*int32)(unsafe.Pointer(in.ContainerLogMaxFiles))
 	out.ProtectKernelDefaults = (*bool)(unsafe.Pointer(in.ProtectKernelDefaults))
-	out.StreamingConnectionIdleTimeout = (*metav1.Duration)(unsafe.Pointer(in.StreamingConnectionIdleTimeout))
+	out.StreamingConnectionIdleTimeout = (*v1.Duration)(unsafe.Pointer(in.StreamingConnectionIdleTimeout))
 	out.MemorySwap = (*core.MemorySwapConfiguration)(unsafe.Pointer(in.MemorySwap))
 	out.MaxParallelImagePulls = (*int32)(unsafe.Pointer(in.MaxParallelImagePulls))
-	out.ImageMinimumGCAge = (*metav1.Duration)(unsafe.Pointer(in.ImageMinimumGCAge))
-	out.ImageMaximumGCAge = (*metav1.Duration)(unsafe.Pointer(in.ImageMaximumGCAge))
+	out.ImageMinimumGCAge = (*v1.Duration)(unsafe.Pointer(in.ImageMinimumGCAge))
+	out.ImageMaximumGCAge = (*v1.Duration)(unsafe.Pointer(in.ImageMaximumGCAge))
 	return nil
 }
 
@@ -4359,7 +4541,7 @@ func autoConvert_core_KubeletConfig_To_v1beta1_KubeletConfig(in *core.KubeletCon
 	out.EvictionHard = (*KubeletConfigEviction)(unsafe.Pointer(in.EvictionHard))
 	out.EvictionMaxPodGracePeriod = (*int32)(unsafe.Pointer(in.EvictionMaxPodGracePeriod))
 	out.EvictionMinimumReclaim = (*KubeletConfigEvictionMinimumReclaim)(unsafe.Pointer(in.EvictionMinimumReclaim))
-	out.EvictionPressureTransitionPeriod = (*metav1.Duration)(unsafe.Pointer(in.EvictionPressureTransitionPeriod))
+	out.EvictionPressureTransitionPeriod = (*v1.Duration)(unsafe.Pointer(in.EvictionPressureTransitionPeriod))
 	out.EvictionSoft = (*KubeletConfigEviction)(unsafe.Pointer(in.EvictionSoft))
 	out.EvictionSoftGracePeriod = (*KubeletConfigEvictionSoftGracePeriod)(unsafe.Pointer(in.EvictionSoftGracePeriod))
 	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
@@ -4367,8 +4549,8 @@ func autoConvert_core_KubeletConfig_To_v1beta1_KubeletConfig(in *core.KubeletCon
 	out.FailSwapOn = (*bool)(unsafe.Pointer(in.FailSwapOn))
 	out.KubeReserved = (*KubeletConfigReserved)(unsafe.Pointer(in.KubeReserved))
 	out.SystemReserved = (*KubeletConfigReserved)(unsafe.Pointer(in.SystemReserved))
-	out.ImageMinimumGCAge = (*metav1.Duration)(unsafe.Pointer(in.ImageMinimumGCAge))
-	out.ImageMaximumGCAge = (*metav1.Duration)(unsafe.Pointer(in.ImageMaximumGCAge))
+	out.ImageMinimumGCAge = (*v1.Duration)(unsafe.Pointer(in.ImageMinimumGCAge))
+	out.ImageMaximumGCAge = (*v1.Duration)(unsafe.Pointer(in.ImageMaximumGCAge))
 	out.ImageGCHighThresholdPercent = (*int32)(unsafe.Pointer(in.ImageGCHighThresholdPercent))
 	out.ImageGCLowThresholdPercent = (*int32)(unsafe.Pointer(in.ImageGCLowThresholdPercent))
 	out.SerializeImagePulls = (*bool)(unsafe.Pointer(in.SerializeImagePulls))
@@ -4376,7 +4558,7 @@ func autoConvert_core_KubeletConfig_To_v1beta1_KubeletConfig(in *core.KubeletCon
 	out.RegistryBurst = (*int32)(unsafe.Pointer(in.RegistryBurst))
 	out.SeccompDefault = (*bool)(unsafe.Pointer(in.SeccompDefault))
 	out.ProtectKernelDefaults = (*bool)(unsafe.Pointer(in.ProtectKernelDefaults))
-	out.StreamingConnectionIdleTimeout = (*metav1.Duration)(unsafe.Pointer(in.StreamingConnectionIdleTimeout))
+	out.StreamingConnectionIdleTimeout = (*v1.Duration)(unsafe.Pointer(in.StreamingConnectionIdleTimeout))
 	out.MemorySwap = (*MemorySwapConfiguration)(unsafe.Pointer(in.MemorySwap))
 	out.MaxParallelImagePulls = (*int32)(unsafe.Pointer(in.MaxParallelImagePulls))
 	return nil
@@ -4444,11 +4626,11 @@ func Convert_core_KubeletConfigEvictionMinimumReclaim_To_v1beta1_KubeletConfigEv
 }
 
 func autoConvert_v1beta1_KubeletConfigEvictionSoftGracePeriod_To_core_KubeletConfigEvictionSoftGracePeriod(in *KubeletConfigEvictionSoftGracePeriod, out *core.KubeletConfigEvictionSoftGracePeriod, s conversion.Scope) error {
-	out.MemoryAvailable = (*metav1.Duration)(unsafe.Pointer(in.MemoryAvailable))
-	out.ImageFSAvailable = (*metav1.Duration)(unsafe.Pointer(in.ImageFSAvailable))
-	out.ImageFSInodesFree = (*metav1.Duration)(unsafe.Pointer(in.ImageFSInodesFree))
-	out.NodeFSAvailable = (*metav1.Duration)(unsafe.Pointer(in.NodeFSAvailable))
-	out.NodeFSInodesFree = (*metav1.Duration)(unsafe.Pointer(in.NodeFSInodesFree))
+	out.MemoryAvailable = (*v1.Duration)(unsafe.Pointer(in.MemoryAvailable))
+	out.ImageFSAvailable = (*v1.Duration)(unsafe.Pointer(in.ImageFSAvailable))
+	out.ImageFSInodesFree = (*v1.Duration)(unsafe.Pointer(in.ImageFSInodesFree))
+	out.NodeFSAvailable = (*v1.Duration)(unsafe.Pointer(in.NodeFSAvailable))
+	out.NodeFSInodesFree = (*v1.Duration)(unsafe.Pointer(in.NodeFSInodesFree))
 	return nil
 }
 
@@ -4458,11 +4640,11 @@ func Convert_v1beta1_KubeletConfigEvictionSoftGracePeriod_To_core_KubeletConfigE
 }
 
 func autoConvert_core_KubeletConfigEvictionSoftGracePeriod_To_v1beta1_KubeletConfigEvictionSoftGracePeriod(in *core.KubeletConfigEvictionSoftGracePeriod, out *KubeletConfigEvictionSoftGracePeriod, s conversion.Scope) error {
-	out.MemoryAvailable = (*metav1.Duration)(unsafe.Pointer(in.MemoryAvailable))
-	out.ImageFSAvailable = (*metav1.Duration)(unsafe.Pointer(in.ImageFSAvailable))
-	out.ImageFSInodesFree = (*metav1.Duration)(unsafe.Pointer(in.ImageFSInodesFree))
-	out.NodeFSAvailable = (*metav1.Duration)(unsafe.Pointer(in.NodeFSAvailable))
-	out.NodeFSInodesFree = (*metav1.Duration)(unsafe.Pointer(in.NodeFSInodesFree))
+	out.MemoryAvailable = (*v1.Duration)(unsafe.Pointer(in.MemoryAvailable))
+	out.ImageFSAvailable = (*v1.Duration)(unsafe.Pointer(in.ImageFSAvailable))
+	out.ImageFSInodesFree = (*v1.Duration)(unsafe.Pointer(in.ImageFSInodesFree))
+	out.NodeFSAvailable = (*v1.Duration)(unsafe.Pointer(in.NodeFSAvailable))
+	out.NodeFSInodesFree = (*v1.Duration)(unsafe.Pointer(in.NodeFSInodesFree))
 	return nil
 }
 
@@ -4651,7 +4833,7 @@ func autoConvert_v1beta1_LastError_To_core_LastError(in *LastError, out *core.La
 	out.Description = in.Description
 	out.TaskID = (*string)(unsafe.Pointer(in.TaskID))
 	out.Codes = *(*[]core.ErrorCode)(unsafe.Pointer(&in.Codes))
-	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.LastUpdateTime = (*v1.Time)(unsafe.Pointer(in.LastUpdateTime))
 	return nil
 }
 
@@ -4664,7 +4846,7 @@ func autoConvert_core_LastError_To_v1beta1_LastError(in *core.LastError, out *La
 	out.Description = in.Description
 	out.TaskID = (*string)(unsafe.Pointer(in.TaskID))
 	out.Codes = *(*[]ErrorCode)(unsafe.Pointer(&in.Codes))
-	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.LastUpdateTime = (*v1.Time)(unsafe.Pointer(in.LastUpdateTime))
 	return nil
 }
 
@@ -4808,12 +4990,12 @@ func Convert_core_Machine_To_v1beta1_Machine(in *core.Machine, out *Machine, s c
 }
 
 func autoConvert_v1beta1_MachineControllerManagerSettings_To_core_MachineControllerManagerSettings(in *MachineControllerManagerSettings, out *core.MachineControllerManagerSettings, s conversion.Scope) error {
-	out.MachineDrainTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
-	out.MachineHealthTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineHealthTimeout))
-	out.MachineCreationTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
+	out.MachineDrainTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
+	out.MachineHealthTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineHealthTimeout))
+	out.MachineCreationTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
 	out.MaxEvictRetries = (*int32)(unsafe.Pointer(in.MaxEvictRetries))
 	out.NodeConditions = *(*[]string)(unsafe.Pointer(&in.NodeConditions))
-	out.MachineInPlaceUpdateTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineInPlaceUpdateTimeout))
+	out.MachineInPlaceUpdateTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineInPlaceUpdateTimeout))
 	out.DisableHealthTimeout = (*bool)(unsafe.Pointer(in.DisableHealthTimeout))
 	return nil
 }
@@ -4824,12 +5006,12 @@ func Convert_v1beta1_MachineControllerManagerSettings_To_core_MachineControllerM
 }
 
 func autoConvert_core_MachineControllerManagerSettings_To_v1beta1_MachineControllerManagerSettings(in *core.MachineControllerManagerSettings, out *MachineControllerManagerSettings, s conversion.Scope) error {
-	out.MachineDrainTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
-	out.MachineHealthTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineHealthTimeout))
-	out.MachineCreationTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
+	out.MachineDrainTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
+	out.MachineHealthTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineHealthTimeout))
+	out.MachineCreationTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
 	out.MaxEvictRetries = (*int32)(unsafe.Pointer(in.MaxEvictRetries))
 	out.NodeConditions = *(*[]string)(unsafe.Pointer(&in.NodeConditions))
-	out.MachineInPlaceUpdateTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineInPlaceUpdateTimeout))
+	out.MachineInPlaceUpdateTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineInPlaceUpdateTimeout))
 	out.DisableHealthTimeout = (*bool)(unsafe.Pointer(in.DisableHealthTimeout))
 	return nil
 }
@@ -4926,6 +5108,7 @@ func autoConvert_v1beta1_MachineType_To_core_MachineType(in *MachineType, out *c
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
 	out.Capabilities = *(*core.Capabilities)(unsafe.Pointer(&in.Capabilities))
+	out.Price = (*core.Price)(unsafe.Pointer(in.Price))
 	return nil
 }
 
@@ -4943,6 +5126,7 @@ func autoConvert_core_MachineType_To_v1beta1_MachineType(in *core.MachineType, o
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
 	out.Capabilities = *(*Capabilities)(unsafe.Pointer(&in.Capabilities))
+	out.Price = (*Price)(unsafe.Pointer(in.Price))
 	return nil
 }
 
@@ -5307,7 +5491,7 @@ func autoConvert_v1beta1_NginxIngress_To_core_NginxIngress(in *NginxIngress, out
 	}
 	out.LoadBalancerSourceRanges = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSourceRanges))
 	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
-	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
+	out.ExternalTrafficPolicy = (*corev1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
 	return nil
 }
 
@@ -5322,7 +5506,7 @@ func autoConvert_core_NginxIngress_To_v1beta1_NginxIngress(in *core.NginxIngress
 	}
 	out.LoadBalancerSourceRanges = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSourceRanges))
 	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
-	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
+	out.ExternalTrafficPolicy = (*corev1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
 	return nil
 }
 
@@ -5362,7 +5546,7 @@ func autoConvert_v1beta1_OCIRepository_To_core_OCIRepository(in *OCIRepository,
 	out.Repository = (*string)(unsafe.Pointer(in.Repository))
 	out.Tag = (*string)(unsafe.Pointer(in.Tag))
 	out.Digest = (*string)(unsafe.Pointer(in.Digest))
-	out.PullSecretRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.PullSecretRef))
+	out.PullSecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.PullSecretRef))
 	return nil
 }
 
@@ -5376,7 +5560,7 @@ func autoConvert_core_OCIRepository_To_v1beta1_OCIRepository(in *core.OCIReposit
 	out.Repository = (*string)(unsafe.Pointer(in.Repository))
 	out.Tag = (*string)(unsafe.Pointer(in.Tag))
 	out.Digest = (*string)(unsafe.Pointer(in.Digest))
-	out.PullSecretRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.PullSecretRef))
+	out.PullSecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.PullSecretRef))
 	return nil
 }
 
@@ -5424,8 +5608,8 @@ func Convert_core_OIDCConfig_To_v1beta1_OIDCConfig(in *core.OIDCConfig, out *OID
 }
 
 func autoConvert_v1beta1_ObservabilityRotation_To_core_ObservabilityRotation(in *ObservabilityRotation, out *core.ObservabilityRotation, s conversion.Scope) error {
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
 	return nil
 }
 
@@ -5435,8 +5619,8 @@ func Convert_v1beta1_ObservabilityRotation_To_core_ObservabilityRotation(in *Obs
 }
 
 func autoConvert_core_ObservabilityRotation_To_v1beta1_ObservabilityRotation(in *core.ObservabilityRotation, out *ObservabilityRotation, s conversion.Scope) error {
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
 	return nil
 }
 
@@ -5491,7 +5675,7 @@ func Convert_core_PendingWorkerUpdates_To_v1beta1_PendingWorkerUpdates(in *core.
 
 func autoConvert_v1beta1_PendingWorkersRollout_To_core_PendingWorkersRollout(in *PendingWorkersRollout, out *core.PendingWorkersRollout, s conversion.Scope) error {
 	out.Name = in.Name
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
 	return nil
 }
 
@@ -5502,7 +5686,7 @@ func Convert_v1beta1_PendingWorkersRollout_To_core_PendingWorkersRollout(in *Pen
 
 func autoConvert_core_PendingWorkersRollout_To_v1beta1_PendingWorkersRollout(in *core.PendingWorkersRollout, out *PendingWorkersRollout, s conversion.Scope) error {
 	out.Name = in.Name
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
 	return nil
 }
 
@@ -5511,6 +5695,28 @@ func Convert_core_PendingWorkersRollout_To_v1beta1_PendingWorkersRollout(in *cor
 	return autoConvert_core_PendingWorkersRollout_To_v1beta1_PendingWorkersRollout(in, out, s)
 }
 
+func autoConvert_v1beta1_Price_To_core_Price(in *Price, out *core.Price, s conversion.Scope) error {
+	out.Currency = in.Currency
+	out.Hourly = in.Hourly
+	return nil
+}
+
+// Convert_v1beta1_Price_To_core_Price is an autogenerated conversion function.
+func Convert_v1beta1_Price_To_core_Price(in *Price, out *core.Price, s conversion.Scope) error {
+	return autoConvert_v1beta1_Price_To_core_Price(in, out, s)
+}
+
+func autoConvert_core_Price_To_v1beta1_Price(in *core.Price, out *Price, s conversion.Scope) error {
+	out.Currency = in.Currency
+	out.Hourly = in.Hourly
+	return nil
+}
+
+// Convert_core_Price_To_v1beta1_Price is an autogenerated conversion function.
+func Convert_core_Price_To_v1beta1_Price(in *core.Price, out *Price, s conversion.Scope) error {
+	return autoConvert_core_Price_To_v1beta1_Price(in, out, s)
+}
+
 func autoConvert_v1beta1_Project_To_core_Project(in *Project, out *core.Project, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_ProjectSpec_To_core_ProjectSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -5543,6 +5749,28 @@ func Convert_core_Project_To_v1beta1_Project(in *core.Project, out *Project, s c
 	return autoConvert_core_Project_To_v1beta1_Project(in, out, s)
 }
 
+func autoConvert_v1beta1_ProjectCostBudget_To_core_ProjectCostBudget(in *ProjectCostBudget, out *core.ProjectCostBudget, s conversion.Scope) error {
+	out.Currency = in.Currency
+	out.Monthly = in.Monthly
+	return nil
+}
+
+// Convert_v1beta1_ProjectCostBudget_To_core_ProjectCostBudget is an autogenerated conversion function.
+func Convert_v1beta1_ProjectCostBudget_To_core_ProjectCostBudget(in *ProjectCostBudget, out *core.ProjectCostBudget, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectCostBudget_To_core_ProjectCostBudget(in, out, s)
+}
+
+func autoConvert_core_ProjectCostBudget_To_v1beta1_ProjectCostBudget(in *core.ProjectCostBudget, out *ProjectCostBudget, s conversion.Scope) error {
+	out.Currency = in.Currency
+	out.Monthly = in.Monthly
+	return nil
+}
+
+// Convert_core_ProjectCostBudget_To_v1beta1_ProjectCostBudget is an autogenerated conversion function.
+func Convert_core_ProjectCostBudget_To_v1beta1_ProjectCostBudget(in *core.ProjectCostBudget, out *ProjectCostBudget, s conversion.Scope) error {
+	return autoConvert_core_ProjectCostBudget_To_v1beta1_ProjectCostBudget(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectList_To_core_ProjectList(in *ProjectList, out *core.ProjectList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -5589,12 +5817,14 @@ func autoConvert_v1beta1_ProjectMember_To_core_ProjectMember(in *ProjectMember,
 	out.Subject = in.Subject
 	// WARNING: in.Role requires manual conversion: does not exist in peer-type
 	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
 	return nil
 }
 
 func autoConvert_core_ProjectMember_To_v1beta1_ProjectMember(in *core.ProjectMember, out *ProjectMember, s conversion.Scope) error {
 	out.Subject = in.Subject
 	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
 	return nil
 }
 
@@ -5617,6 +5847,7 @@ func autoConvert_v1beta1_ProjectSpec_To_core_ProjectSpec(in *ProjectSpec, out *c
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*core.ProjectTolerations)(unsafe.Pointer(in.Tolerations))
 	out.DualApprovalForDeletion = *(*[]core.DualApprovalForDeletion)(unsafe.Pointer(&in.DualApprovalForDeletion))
+	out.CostBudget = (*core.ProjectCostBudget)(unsafe.Pointer(in.CostBudget))
 	return nil
 }
 
@@ -5639,15 +5870,16 @@ func autoConvert_core_ProjectSpec_To_v1beta1_ProjectSpec(in *core.ProjectSpec, o
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*ProjectTolerations)(unsafe.Pointer(in.Tolerations))
 	out.DualApprovalForDeletion = *(*[]DualApprovalForDeletion)(unsafe.Pointer(&in.DualApprovalForDeletion))
+	out.CostBudget = (*ProjectCostBudget)(unsafe.Pointer(in.CostBudget))
 	return nil
 }
 
 func autoConvert_v1beta1_ProjectStatus_To_core_ProjectStatus(in *ProjectStatus, out *core.ProjectStatus, s conversion.Scope) error {
 	out.ObservedGeneration = in.ObservedGeneration
 	out.Phase = core.ProjectPhase(in.Phase)
-	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
-	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
-	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.StaleSinceTimestamp = (*v1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
+	out.StaleAutoDeleteTimestamp = (*v1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
+	out.LastActivityTimestamp = (*v1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
 	return nil
 }
 
@@ -5659,9 +5891,9 @@ func Convert_v1beta1_ProjectStatus_To_core_ProjectStatus(in *ProjectStatus, out
 func autoConvert_core_ProjectStatus_To_v1beta1_ProjectStatus(in *core.ProjectStatus, out *ProjectStatus, s conversion.Scope) error {
 	out.ObservedGeneration = in.ObservedGeneration
 	out.Phase = ProjectPhase(in.Phase)
-	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
-	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
-	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.StaleSinceTimestamp = (*v1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
+	out.StaleAutoDeleteTimestamp = (*v1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
+	out.LastActivityTimestamp = (*v1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
 	return nil
 }
 
@@ -5745,6 +5977,9 @@ func autoConvert_v1beta1_Quota_To_core_Quota(in *Quota, out *core.Quota, s conve
 	if err := Convert_v1beta1_QuotaSpec_To_core_QuotaSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5758,6 +5993,9 @@ func autoConvert_core_Quota_To_v1beta1_Quota(in *core.Quota, out *Quota, s conve
 	if err := Convert_core_QuotaSpec_To_v1beta1_QuotaSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5790,8 +6028,9 @@ func Convert_core_QuotaList_To_v1beta1_QuotaList(in *core.QuotaList, out *QuotaL
 
 func autoConvert_v1beta1_QuotaSpec_To_core_QuotaSpec(in *QuotaSpec, out *core.QuotaSpec, s conversion.Scope) error {
 	out.ClusterLifetimeDays = (*int32)(unsafe.Pointer(in.ClusterLifetimeDays))
-	out.Metrics = *(*v1.ResourceList)(unsafe.Pointer(&in.Metrics))
+	out.Metrics = *(*corev1.ResourceList)(unsafe.Pointer(&in.Metrics))
 	out.Scope = in.Scope
+	out.WarningThresholdPercentage = (*int32)(unsafe.Pointer(in.WarningThresholdPercentage))
 	return nil
 }
 
@@ -5802,8 +6041,9 @@ func Convert_v1beta1_QuotaSpec_To_core_QuotaSpec(in *QuotaSpec, out *core.QuotaS
 
 func autoConvert_core_QuotaSpec_To_v1beta1_QuotaSpec(in *core.QuotaSpec, out *QuotaSpec, s conversion.Scope) error {
 	out.ClusterLifetimeDays = (*int32)(unsafe.Pointer(in.ClusterLifetimeDays))
-	out.Metrics = *(*v1.ResourceList)(unsafe.Pointer(&in.Metrics))
+	out.Metrics = *(*corev1.ResourceList)(unsafe.Pointer(&in.Metrics))
 	out.Scope = in.Scope
+	out.WarningThresholdPercentage = (*int32)(unsafe.Pointer(in.WarningThresholdPercentage))
 	return nil
 }
 
@@ -5812,11 +6052,34 @@ func Convert_core_QuotaSpec_To_v1beta1_QuotaSpec(in *core.QuotaSpec, out *QuotaS
 	return autoConvert_core_QuotaSpec_To_v1beta1_QuotaSpec(in, out, s)
 }
 
+func autoConvert_v1beta1_QuotaStatus_To_core_QuotaStatus(in *QuotaStatus, out *core.QuotaStatus, s conversion.Scope) error {
+	out.Hard = *(*corev1.ResourceList)(unsafe.Pointer(&in.Hard))
+	out.Used = *(*corev1.ResourceList)(unsafe.Pointer(&in.Used))
+	return nil
+}
+
+// Convert_v1beta1_QuotaStatus_To_core_QuotaStatus is an autogenerated conversion function.
+func Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(in *QuotaStatus, out *core.QuotaStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_QuotaStatus_To_core_QuotaStatus(in, out, s)
+}
+
+func autoConvert_core_QuotaStatus_To_v1beta1_QuotaStatus(in *core.QuotaStatus, out *QuotaStatus, s conversion.Scope) error {
+	out.Hard = *(*corev1.ResourceList)(unsafe.Pointer(&in.Hard))
+	out.Used = *(*corev1.ResourceList)(unsafe.Pointer(&in.Used))
+	return nil
+}
+
+// Convert_core_QuotaStatus_To_v1beta1_QuotaStatus is an autogenerated conversion function.
+func Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(in *core.QuotaStatus, out *QuotaStatus, s conversion.Scope) error {
+	return autoConvert_core_QuotaStatus_To_v1beta1_QuotaStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_Region_To_core_Region(in *Region, out *core.Region, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Zones = *(*[]core.AvailabilityZone)(unsafe.Pointer(&in.Zones))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
 	out.AccessRestrictions = *(*[]core.AccessRestriction)(unsafe.Pointer(&in.AccessRestrictions))
+	out.PriceFactor = (*resource.Quantity)(unsafe.Pointer(in.PriceFactor))
 	return nil
 }
 
@@ -5830,6 +6093,7 @@ func autoConvert_core_Region_To_v1beta1_Region(in *core.Region, out *Region, s c
 	out.Zones = *(*[]AvailabilityZone)(unsafe.Pointer(&in.Zones))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
 	out.AccessRestrictions = *(*[]AccessRestriction)(unsafe.Pointer(&in.AccessRestrictions))
+	out.PriceFactor = (*resource.Quantity)(unsafe.Pointer(in.PriceFactor))
 	return nil
 }
 
@@ -5907,7 +6171,7 @@ func Convert_core_SSHAccess_To_v1beta1_SSHAccess(in *core.SSHAccess, out *SSHAcc
 func autoConvert_v1beta1_SecretBinding_To_core_SecretBinding(in *SecretBinding, out *core.SecretBinding, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.SecretRef = in.SecretRef
-	out.Quotas = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.Quotas))
+	out.Quotas = *(*[]corev1.ObjectReference)(unsafe.Pointer(&in.Quotas))
 	out.Provider = (*core.SecretBindingProvider)(unsafe.Pointer(in.Provider))
 	return nil
 }
@@ -5920,7 +6184,7 @@ func Convert_v1beta1_SecretBinding_To_core_SecretBinding(in *SecretBinding, out
 func autoConvert_core_SecretBinding_To_v1beta1_SecretBinding(in *core.SecretBinding, out *SecretBinding, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.SecretRef = in.SecretRef
-	out.Quotas = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.Quotas))
+	out.Quotas = *(*[]corev1.ObjectReference)(unsafe.Pointer(&in.Quotas))
 	out.Provider = (*SecretBindingProvider)(unsafe.Pointer(in.Provider))
 	return nil
 }
@@ -6281,9 +6545,9 @@ func Convert_core_SeedSettingExcessCapacityReservation_To_v1beta1_SeedSettingExc
 }
 
 func autoConvert_v1beta1_SeedSettingExcessCapacityReservationConfig_To_core_SeedSettingExcessCapacityReservationConfig(in *SeedSettingExcessCapacityReservationConfig, out *core.SeedSettingExcessCapacityReservationConfig, s conversion.Scope) error {
-	out.Resources = *(*v1.ResourceList)(unsafe.Pointer(&in.Resources))
+	out.Resources = *(*corev1.ResourceList)(unsafe.Pointer(&in.Resources))
 	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
-	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.Tolerations = *(*[]corev1.Toleration)(unsafe.Pointer(&in.Tolerations))
 	return nil
 }
 
@@ -6293,9 +6557,9 @@ func Convert_v1beta1_SeedSettingExcessCapacityReservationConfig_To_core_SeedSett
 }
 
 func autoConvert_core_SeedSettingExcessCapacityReservationConfig_To_v1beta1_SeedSettingExcessCapacityReservationConfig(in *core.SeedSettingExcessCapacityReservationConfig, out *SeedSettingExcessCapacityReservationConfig, s conversion.Scope) error {
-	out.Resources = *(*v1.ResourceList)(unsafe.Pointer(&in.Resources))
+	out.Resources = *(*corev1.ResourceList)(unsafe.Pointer(&in.Resources))
 	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
-	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.Tolerations = *(*[]corev1.Toleration)(unsafe.Pointer(&in.Tolerations))
 	return nil
 }
 
@@ -6306,7 +6570,7 @@ func Convert_core_SeedSettingExcessCapacityReservationConfig_To_v1beta1_SeedSett
 
 func autoConvert_v1beta1_SeedSettingLoadBalancerServices_To_core_SeedSettingLoadBalancerServices(in *SeedSettingLoadBalancerServices, out *core.SeedSettingLoadBalancerServices, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
-	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
+	out.ExternalTrafficPolicy = (*corev1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
 	out.Zones = *(*[]core.SeedSettingLoadBalancerServicesZones)(unsafe.Pointer(&in.Zones))
 	out.ProxyProtocol = (*core.LoadBalancerServicesProxyProtocol)(unsafe.Pointer(in.ProxyProtocol))
 	out.ZonalIngress = (*core.SeedSettingLoadBalancerServicesZonalIngress)(unsafe.Pointer(in.ZonalIngress))
@@ -6321,7 +6585,7 @@ func Convert_v1beta1_SeedSettingLoadBalancerServices_To_core_SeedSettingLoadBala
 
 func autoConvert_core_SeedSettingLoadBalancerServices_To_v1beta1_SeedSettingLoadBalancerServices(in *core.SeedSettingLoadBalancerServices, out *SeedSettingLoadBalancerServices, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
-	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
+	out.ExternalTrafficPolicy = (*corev1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
 	out.Zones = *(*[]SeedSettingLoadBalancerServicesZones)(unsafe.Pointer(&in.Zones))
 	out.ProxyProtocol = (*LoadBalancerServicesProxyProtocol)(unsafe.Pointer(in.ProxyProtocol))
 	out.ZonalIngress = (*SeedSettingLoadBalancerServicesZonalIngress)(unsafe.Pointer(in.ZonalIngress))
@@ -6357,7 +6621,7 @@ func Convert_core_SeedSettingLoadBalancerServicesZonalIngress_To_v1beta1_SeedSet
 func autoConvert_v1beta1_SeedSettingLoadBalancerServicesZones_To_core_SeedSettingLoadBalancerServicesZones(in *SeedSettingLoadBalancerServicesZones, out *core.SeedSettingLoadBalancerServicesZones, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
-	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
+	out.ExternalTrafficPolicy = (*corev1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
 	out.ProxyProtocol = (*core.LoadBalancerServicesProxyProtocol)(unsafe.Pointer(in.ProxyProtocol))
 	return nil
 }
@@ -6370,7 +6634,7 @@ func Convert_v1beta1_SeedSettingLoadBalancerServicesZones_To_core_SeedSettingLoa
 func autoConvert_core_SeedSettingLoadBalancerServicesZones_To_v1beta1_SeedSettingLoadBalancerServicesZones(in *core.SeedSettingLoadBalancerServicesZones, out *SeedSettingLoadBalancerServicesZones, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
-	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
+	out.ExternalTrafficPolicy = (*corev1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
 	out.ProxyProtocol = (*LoadBalancerServicesProxyProtocol)(unsafe.Pointer(in.ProxyProtocol))
 	return nil
 }
@@ -6423,7 +6687,7 @@ func Convert_core_SeedSettingTopologyAwareRouting_To_v1beta1_SeedSettingTopology
 func autoConvert_v1beta1_SeedSettingVerticalPodAutoscaler_To_core_SeedSettingVerticalPodAutoscaler(in *SeedSettingVerticalPodAutoscaler, out *core.SeedSettingVerticalPodAutoscaler, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
-	out.MaxAllowed = *(*v1.ResourceList)(unsafe.Pointer(&in.MaxAllowed))
+	out.MaxAllowed = *(*corev1.ResourceList)(unsafe.Pointer(&in.MaxAllowed))
 	return nil
 }
 
@@ -6435,7 +6699,7 @@ func Convert_v1beta1_SeedSettingVerticalPodAutoscaler_To_core_SeedSettingVertica
 func autoConvert_core_SeedSettingVerticalPodAutoscaler_To_v1beta1_SeedSettingVerticalPodAutoscaler(in *core.SeedSettingVerticalPodAutoscaler, out *SeedSettingVerticalPodAutoscaler, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
-	out.MaxAllowed = *(*v1.ResourceList)(unsafe.Pointer(&in.MaxAllowed))
+	out.MaxAllowed = *(*corev1.ResourceList)(unsafe.Pointer(&in.MaxAllowed))
 	return nil
 }
 
@@ -6532,9 +6796,9 @@ func autoConvert_v1beta1_SeedStatus_To_core_SeedStatus(in *SeedStatus, out *core
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
 	out.ObservedGeneration = in.ObservedGeneration
 	out.ClusterIdentity = (*string)(unsafe.Pointer(in.ClusterIdentity))
-	out.Capacity = *(*v1.ResourceList)(unsafe.Pointer(&in.Capacity))
-	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
-	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
+	out.Capacity = *(*corev1.ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.Allocatable = *(*corev1.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.ClientCertificateExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
 	return nil
 }
@@ -6550,9 +6814,9 @@ func autoConvert_core_SeedStatus_To_v1beta1_SeedStatus(in *core.SeedStatus, out
 	out.Conditions = *(*[]Condition)(unsafe.Pointer(&in.Conditions))
 	out.ObservedGeneration = in.ObservedGeneration
 	out.ClusterIdentity = (*string)(unsafe.Pointer(in.ClusterIdentity))
-	out.Capacity = *(*v1.ResourceList)(unsafe.Pointer(&in.Capacity))
-	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
-	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
+	out.Capacity = *(*corev1.ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.Allocatable = *(*corev1.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.ClientCertificateExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
 	return nil
 }
@@ -6657,7 +6921,7 @@ func Convert_core_SeedVolumeProvider_To_v1beta1_SeedVolumeProvider(in *core.Seed
 func autoConvert_v1beta1_ServiceAccountConfig_To_core_ServiceAccountConfig(in *ServiceAccountConfig, out *core.ServiceAccountConfig, s conversion.Scope) error {
 	out.Issuer = (*string)(unsafe.Pointer(in.Issuer))
 	out.ExtendTokenExpiration = (*bool)(unsafe.Pointer(in.ExtendTokenExpiration))
-	out.MaxTokenExpiration = (*metav1.Duration)(unsafe.Pointer(in.MaxTokenExpiration))
+	out.MaxTokenExpiration = (*v1.Duration)(unsafe.Pointer(in.MaxTokenExpiration))
 	out.AcceptedIssuers = *(*[]string)(unsafe.Pointer(&in.AcceptedIssuers))
 	return nil
 }
@@ -6670,7 +6934,7 @@ func Convert_v1beta1_ServiceAccountConfig_To_core_ServiceAccountConfig(in *Servi
 func autoConvert_core_ServiceAccountConfig_To_v1beta1_ServiceAccountConfig(in *core.ServiceAccountConfig, out *ServiceAccountConfig, s conversion.Scope) error {
 	out.Issuer = (*string)(unsafe.Pointer(in.Issuer))
 	out.ExtendTokenExpiration = (*bool)(unsafe.Pointer(in.ExtendTokenExpiration))
-	out.MaxTokenExpiration = (*metav1.Duration)(unsafe.Pointer(in.MaxTokenExpiration))
+	out.MaxTokenExpiration = (*v1.Duration)(unsafe.Pointer(in.MaxTokenExpiration))
 	out.AcceptedIssuers = *(*[]string)(unsafe.Pointer(&in.AcceptedIssuers))
 	return nil
 }
@@ -6682,10 +6946,10 @@ func Convert_core_ServiceAccountConfig_To_v1beta1_ServiceAccountConfig(in *core.
 
 func autoConvert_v1beta1_ServiceAccountKeyRotation_To_core_ServiceAccountKeyRotation(in *ServiceAccountKeyRotation, out *core.ServiceAccountKeyRotation, s conversion.Scope) error {
 	out.Phase = core.CredentialsRotationPhase(in.Phase)
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastInitiationFinishedTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
-	out.LastCompletionTriggeredTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastInitiationFinishedTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
+	out.LastCompletionTriggeredTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
 	out.PendingWorkersRollouts = *(*[]core.PendingWorkersRollout)(unsafe.Pointer(&in.PendingWorkersRollouts))
 	return nil
 }
@@ -6697,10 +6961,10 @@ func Convert_v1beta1_ServiceAccountKeyRotation_To_core_ServiceAccountKeyRotation
 
 func autoConvert_core_ServiceAccountKeyRotation_To_v1beta1_ServiceAccountKeyRotation(in *core.ServiceAccountKeyRotation, out *ServiceAccountKeyRotation, s conversion.Scope) error {
 	out.Phase = CredentialsRotationPhase(in.Phase)
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastInitiationFinishedTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
-	out.LastCompletionTriggeredTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastInitiationFinishedTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationFinishedTime))
+	out.LastCompletionTriggeredTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTriggeredTime))
 	out.PendingWorkersRollouts = *(*[]PendingWorkersRollout)(unsafe.Pointer(&in.PendingWorkersRollouts))
 	return nil
 }
@@ -6764,6 +7028,34 @@ func Convert_core_ShootAdvertisedAddress_To_v1beta1_ShootAdvertisedAddress(in *c
 	return autoConvert_core_ShootAdvertisedAddress_To_v1beta1_ShootAdvertisedAddress(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootCostEstimate_To_core_ShootCostEstimate(in *ShootCostEstimate, out *core.ShootCostEstimate, s conversion.Scope) error {
+	out.Currency = in.Currency
+	out.HourlyMinimum = in.HourlyMinimum
+	out.HourlyMaximum = in.HourlyMaximum
+	out.MonthlyMinimum = in.MonthlyMinimum
+	out.MonthlyMaximum = in.MonthlyMaximum
+	return nil
+}
+
+// Convert_v1beta1_ShootCostEstimate_To_core_ShootCostEstimate is an autogenerated conversion function.
+func Convert_v1beta1_ShootCostEstimate_To_core_ShootCostEstimate(in *ShootCostEstimate, out *core.ShootCostEstimate, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootCostEstimate_To_core_ShootCostEstimate(in, out, s)
+}
+
+func autoConvert_core_ShootCostEstimate_To_v1beta1_ShootCostEstimate(in *core.ShootCostEstimate, out *ShootCostEstimate, s conversion.Scope) error {
+	out.Currency = in.Currency
+	out.HourlyMinimum = in.HourlyMinimum
+	out.HourlyMaximum = in.HourlyMaximum
+	out.MonthlyMinimum = in.MonthlyMinimum
+	out.MonthlyMaximum = in.MonthlyMaximum
+	return nil
+}
+
+// Convert_core_ShootCostEstimate_To_v1beta1_ShootCostEstimate is an autogenerated conversion function.
+func Convert_core_ShootCostEstimate_To_v1beta1_ShootCostEstimate(in *core.ShootCostEstimate, out *ShootCostEstimate, s conversion.Scope) error {
+	return autoConvert_core_ShootCostEstimate_To_v1beta1_ShootCostEstimate(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootCredentials_To_core_ShootCredentials(in *ShootCredentials, out *core.ShootCredentials, s conversion.Scope) error {
 	out.Rotation = (*core.ShootCredentialsRotation)(unsafe.Pointer(in.Rotation))
 	out.EncryptionAtRest = (*core.EncryptionAtRest)(unsafe.Pointer(in.EncryptionAtRest))
@@ -6815,8 +7107,8 @@ func Convert_core_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation(i
 }
 
 func autoConvert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(in *ShootKubeconfigRotation, out *core.ShootKubeconfigRotation, s conversion.Scope) error {
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
 	return nil
 }
 
@@ -6826,8 +7118,8 @@ func Convert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(in
 }
 
 func autoConvert_core_ShootKubeconfigRotation_To_v1beta1_ShootKubeconfigRotation(in *core.ShootKubeconfigRotation, out *ShootKubeconfigRotation, s conversion.Scope) error {
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
 	return nil
 }
 
@@ -6881,7 +7173,7 @@ func Convert_core_ShootList_To_v1beta1_ShootList(in *core.ShootList, out *ShootL
 func autoConvert_v1beta1_ShootMachineImage_To_core_ShootMachineImage(in *ShootMachineImage, out *core.ShootMachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
-	if err := metav1.Convert_Pointer_string_To_string(&in.Version, &out.Version, s); err != nil {
+	if err := v1.Convert_Pointer_string_To_string(&in.Version, &out.Version, s); err != nil {
 		return err
 	}
 	return nil
@@ -6895,7 +7187,7 @@ func Convert_v1beta1_ShootMachineImage_To_core_ShootMachineImage(in *ShootMachin
 func autoConvert_core_ShootMachineImage_To_v1beta1_ShootMachineImage(in *core.ShootMachineImage, out *ShootMachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
-	if err := metav1.Convert_string_To_Pointer_string(&in.Version, &out.Version, s); err != nil {
+	if err := v1.Convert_string_To_Pointer_string(&in.Version, &out.Version, s); err != nil {
 		return err
 	}
 	return nil
@@ -6929,8 +7221,8 @@ func Convert_core_ShootNetworks_To_v1beta1_ShootNetworks(in *core.ShootNetworks,
 }
 
 func autoConvert_v1beta1_ShootSSHKeypairRotation_To_core_ShootSSHKeypairRotation(in *ShootSSHKeypairRotation, out *core.ShootSSHKeypairRotation, s conversion.Scope) error {
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
 	return nil
 }
 
@@ -6940,8 +7232,8 @@ func Convert_v1beta1_ShootSSHKeypairRotation_To_core_ShootSSHKeypairRotation(in
 }
 
 func autoConvert_core_ShootSSHKeypairRotation_To_v1beta1_ShootSSHKeypairRotation(in *core.ShootSSHKeypairRotation, out *ShootSSHKeypairRotation, s conversion.Scope) error {
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.LastInitiationTime = (*v1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*v1.Time)(unsafe.Pointer(in.LastCompletionTime))
 	return nil
 }
 
@@ -7106,20 +7398,21 @@ func autoConvert_v1beta1_ShootStatus_To_core_ShootStatus(in *ShootStatus, out *c
 	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
 	out.LastErrors = *(*[]core.LastError)(unsafe.Pointer(&in.LastErrors))
 	out.ObservedGeneration = in.ObservedGeneration
-	out.RetryCycleStartTime = (*metav1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
+	out.RetryCycleStartTime = (*v1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
 	out.TechnicalID = in.TechnicalID
 	out.UID = types.UID(in.UID)
 	out.ClusterIdentity = (*string)(unsafe.Pointer(in.ClusterIdentity))
 	out.AdvertisedAddresses = *(*[]core.ShootAdvertisedAddress)(unsafe.Pointer(&in.AdvertisedAddresses))
-	out.MigrationStartTime = (*metav1.Time)(unsafe.Pointer(in.MigrationStartTime))
+	out.MigrationStartTime = (*v1.Time)(unsafe.Pointer(in.MigrationStartTime))
 	out.Credentials = (*core.ShootCredentials)(unsafe.Pointer(in.Credentials))
-	out.LastHibernationTriggerTime = (*metav1.Time)(unsafe.Pointer(in.LastHibernationTriggerTime))
+	out.LastHibernationTriggerTime = (*v1.Time)(unsafe.Pointer(in.LastHibernationTriggerTime))
 	out.LastMaintenance = (*core.LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
 	out.Networking = (*core.NetworkingStatus)(unsafe.Pointer(in.Networking))
 	out.InPlaceUpdates = (*core.InPlaceUpdatesStatus)(unsafe.Pointer(in.InPlaceUpdates))
 	out.ManualWorkerPoolRollout = (*core.ManualWorkerPoolRollout)(unsafe.Pointer(in.ManualWorkerPoolRollout))
+	out.EstimatedCost = (*core.ShootCostEstimate)(unsafe.Pointer(in.EstimatedCost))
 	return nil
 }
 
@@ -7135,23 +7428,24 @@ func autoConvert_core_ShootStatus_To_v1beta1_ShootStatus(in *core.ShootStatus, o
 		return err
 	}
 	out.IsHibernated = in.IsHibernated
-	out.LastHibernationTriggerTime = (*metav1.Time)(unsafe.Pointer(in.LastHibernationTriggerTime))
+	out.LastHibernationTriggerTime = (*v1.Time)(unsafe.Pointer(in.LastHibernationTriggerTime))
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
 	out.LastErrors = *(*[]LastError)(unsafe.Pointer(&in.LastErrors))
 	out.ObservedGeneration = in.ObservedGeneration
-	out.RetryCycleStartTime = (*metav1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
+	out.RetryCycleStartTime = (*v1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
 	out.TechnicalID = in.TechnicalID
 	out.UID = types.UID(in.UID)
 	out.ClusterIdentity = (*string)(unsafe.Pointer(in.ClusterIdentity))
 	out.AdvertisedAddresses = *(*[]ShootAdvertisedAddress)(unsafe.Pointer(&in.AdvertisedAddresses))
-	out.MigrationStartTime = (*metav1.Time)(unsafe.Pointer(in.MigrationStartTime))
+	out.MigrationStartTime = (*v1.Time)(unsafe.Pointer(in.MigrationStartTime))
 	out.Credentials = (*ShootCredentials)(unsafe.Pointer(in.Credentials))
 	out.LastMaintenance = (*LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
 	out.Networking = (*NetworkingStatus)(unsafe.Pointer(in.Networking))
 	out.InPlaceUpdates = (*InPlaceUpdatesStatus)(unsafe.Pointer(in.InPlaceUpdates))
 	out.ManualWorkerPoolRollout = (*ManualWorkerPoolRollout)(unsafe.Pointer(in.ManualWorkerPoolRollout))
+	out.EstimatedCost = (*ShootCostEstimate)(unsafe.Pointer(in.EstimatedCost))
 	return nil
 }
 
@@ -7274,25 +7568,25 @@ func Convert_core_Toleration_To_v1beta1_Toleration(in *core.Toleration, out *Tol
 
 func autoConvert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(in *VerticalPodAutoscaler, out *core.VerticalPodAutoscaler, s conversion.Scope) error {
 	out.Enabled = in.Enabled
-	out.EvictAfterOOMThreshold = (*metav1.Duration)(unsafe.Pointer(in.EvictAfterOOMThreshold))
+	out.EvictAfterOOMThreshold = (*v1.Duration)(unsafe.Pointer(in.EvictAfterOOMThreshold))
 	out.EvictionRateBurst = (*int32)(unsafe.Pointer(in.EvictionRateBurst))
 	out.EvictionRateLimit = (*float64)(unsafe.Pointer(in.EvictionRateLimit))
 	out.EvictionTolerance = (*float64)(unsafe.Pointer(in.EvictionTolerance))
 	out.RecommendationMarginFraction = (*float64)(unsafe.Pointer(in.RecommendationMarginFraction))
-	out.UpdaterInterval = (*metav1.Duration)(unsafe.Pointer(in.UpdaterInterval))
-	out.RecommenderInterval = (*metav1.Duration)(unsafe.Pointer(in.RecommenderInterval))
+	out.UpdaterInterval = (*v1.Duration)(unsafe.Pointer(in.UpdaterInterval))
+	out.RecommenderInterval = (*v1.Duration)(unsafe.Pointer(in.RecommenderInterval))
 	out.TargetCPUPercentile = (*float64)(unsafe.Pointer(in.TargetCPUPercentile))
 	out.RecommendationLowerBoundCPUPercentile = (*float64)(unsafe.Pointer(in.RecommendationLowerBoundCPUPercentile))
 	out.RecommendationUpperBoundCPUPercentile = (*float64)(unsafe.Pointer(in.RecommendationUpperBoundCPUPercentile))
 	out.TargetMemoryPercentile = (*float64)(unsafe.Pointer(in.TargetMemoryPercentile))
 	out.RecommendationLowerBoundMemoryPercentile = (*float64)(unsafe.Pointer(in.RecommendationLowerBoundMemoryPercentile))
 	out.RecommendationUpperBoundMemoryPercentile = (*float64)(unsafe.Pointer(in.RecommendationUpperBoundMemoryPercentile))
-	out.CPUHistogramDecayHalfLife = (*metav1.Duration)(unsafe.Pointer(in.CPUHistogramDecayHalfLife))
-	out.MemoryHistogramDecayHalfLife = (*metav1.Duration)(unsafe.Pointer(in.MemoryHistogramDecayHalfLife))
-	out.MemoryAggregationInterval = (*metav1.Duration)(unsafe.Pointer(in.MemoryAggregationInterval))
+	out.CPUHistogramDecayHalfLife = (*v1.Duration)(unsafe.Pointer(in.CPUHistogramDecayHalfLife))
+	out.MemoryHistogramDecayHalfLife = (*v1.Duration)(unsafe.Pointer(in.MemoryHistogramDecayHalfLife))
+	out.MemoryAggregationInterval = (*v1.Duration)(unsafe.Pointer(in.MemoryAggregationInterval))
 	out.MemoryAggregationIntervalCount = (*int64)(unsafe.Pointer(in.MemoryAggregationIntervalCount))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
-	out.MaxAllowed = *(*v1.ResourceList)(unsafe.Pointer(&in.MaxAllowed))
+	out.MaxAllowed = *(*corev1.ResourceList)(unsafe.Pointer(&in.MaxAllowed))
 	out.RecommenderUpdateWorkerCount = (*int64)(unsafe.Pointer(in.RecommenderUpdateWorkerCount))
 	return nil
 }
@@ -7304,25 +7598,25 @@ func Convert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(in *Ver
 
 func autoConvert_core_VerticalPodAutoscaler_To_v1beta1_VerticalPodAutoscaler(in *core.VerticalPodAutoscaler, out *VerticalPodAutoscaler, s conversion.Scope) error {
 	out.Enabled = in.Enabled
-	out.EvictAfterOOMThreshold = (*metav1.Duration)(unsafe.Pointer(in.EvictAfterOOMThreshold))
+	out.EvictAfterOOMThreshold = (*v1.Duration)(unsafe.Pointer(in.EvictAfterOOMThreshold))
 	out.EvictionRateBurst = (*int32)(unsafe.Pointer(in.EvictionRateBurst))
 	out.EvictionRateLimit = (*float64)(unsafe.Pointer(in.EvictionRateLimit))
 	out.EvictionTolerance = (*float64)(unsafe.Pointer(in.EvictionTolerance))
 	out.RecommendationMarginFraction = (*float64)(unsafe.Pointer(in.RecommendationMarginFraction))
-	out.UpdaterInterval = (*metav1.Duration)(unsafe.Pointer(in.UpdaterInterval))
-	out.RecommenderInterval = (*metav1.Duration)(unsafe.Pointer(in.RecommenderInterval))
+	out.UpdaterInterval = (*v1.Duration)(unsafe.Pointer(in.UpdaterInterval))
+	out.RecommenderInterval = (*v1.Duration)(unsafe.Pointer(in.RecommenderInterval))
 	out.TargetCPUPercentile = (*float64)(unsafe.Pointer(in.TargetCPUPercentile))
 	out.RecommendationLowerBoundCPUPercentile = (*float64)(unsafe.Pointer(in.RecommendationLowerBoundCPUPercentile))
 	out.RecommendationUpperBoundCPUPercentile = (*float64)(unsafe.Pointer(in.RecommendationUpperBoundCPUPercentile))
 	out.TargetMemoryPercentile = (*float64)(unsafe.Pointer(in.TargetMemoryPercentile))
 	out.RecommendationLowerBoundMemoryPercentile = (*float64)(unsafe.Pointer(in.RecommendationLowerBoundMemoryPercentile))
 	out.RecommendationUpperBoundMemoryPercentile = (*float64)(unsafe.Pointer(in.RecommendationUpperBoundMemoryPercentile))
-	out.CPUHistogramDecayHalfLife = (*metav1.Duration)(unsafe.Pointer(in.CPUHistogramDecayHalfLife))
-	out.MemoryHistogramDecayHalfLife = (*metav1.Duration)(unsafe.Pointer(in.MemoryHistogramDecayHalfLife))
-	out.MemoryAggregationInterval = (*metav1.Duration)(unsafe.Pointer(in.MemoryAggregationInterval))
+	out.CPUHistogramDecayHalfLife = (*v1.Duration)(unsafe.Pointer(in.CPUHistogramDecayHalfLife))
+	out.MemoryHistogramDecayHalfLife = (*v1.Duration)(unsafe.Pointer(in.MemoryHistogramDecayHalfLife))
+	out.MemoryAggregationInterval = (*v1.Duration)(unsafe.Pointer(in.MemoryAggregationInterval))
 	out.MemoryAggregationIntervalCount = (*int64)(unsafe.Pointer(in.MemoryAggregationIntervalCount))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
-	out.MaxAllowed = *(*v1.ResourceList)(unsafe.Pointer(&in.MaxAllowed))
+	out.MaxAllowed = *(*corev1.ResourceList)(unsafe.Pointer(&in.MaxAllowed))
 	out.RecommenderUpdateWorkerCount = (*int64)(unsafe.Pointer(in.RecommenderUpdateWorkerCount))
 	return nil
 }
@@ -7363,6 +7657,7 @@ func autoConvert_v1beta1_VolumeType_To_core_VolumeType(in *VolumeType, out *core
 	out.Name = in.Name
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.MinSize = (*resource.Quantity)(unsafe.Pointer(in.MinSize))
+	out.Price = (*core.Price)(unsafe.Pointer(in.Price))
 	return nil
 }
 
@@ -7376,6 +7671,7 @@ func autoConvert_core_VolumeType_To_v1beta1_VolumeType(in *core.VolumeType, out
 	out.Name = in.Name
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.MinSize = (*resource.Quantity)(unsafe.Pointer(in.MinSize))
+	out.Price = (*Price)(unsafe.Pointer(in.Price))
 	return nil
 }
 
@@ -7429,7 +7725,7 @@ func autoConvert_v1beta1_Worker_To_core_Worker(in *Worker, out *core.Worker, s c
 	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
 	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
-	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
 	out.Volume = (*core.Volume)(unsafe.Pointer(in.Volume))
 	out.DataVolumes = *(*[]core.DataVolume)(unsafe.Pointer(&in.DataVolumes))
 	out.KubeletDataVolumeName = (*string)(unsafe.Pointer(in.KubeletDataVolumeName))
@@ -7473,7 +7769,7 @@ func autoConvert_core_Worker_To_v1beta1_Worker(in *core.Worker, out *Worker, s c
 	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
 	out.SystemComponents = (*WorkerSystemComponents)(unsafe.Pointer(in.SystemComponents))
-	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
 	out.Volume = (*Volume)(unsafe.Pointer(in.Volume))
 	out.DataVolumes = *(*[]DataVolume)(unsafe.Pointer(&in.DataVolumes))
 	out.KubeletDataVolumeName = (*string)(unsafe.Pointer(in.KubeletDataVolumeName))
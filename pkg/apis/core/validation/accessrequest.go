@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+var availableAccessRequestPhases = sets.New(
+	string(core.AccessRequestPending),
+	string(core.AccessRequestGranted),
+	string(core.AccessRequestExpired),
+)
+
+// ValidateAccessRequest validates an AccessRequest object.
+func ValidateAccessRequest(accessRequest *core.AccessRequest) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&accessRequest.ObjectMeta, true, ValidateName, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateAccessRequestSpec(&accessRequest.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateAccessRequestUpdate validates an AccessRequest object before an update.
+func ValidateAccessRequestUpdate(newAccessRequest, oldAccessRequest *core.AccessRequest) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newAccessRequest.ObjectMeta, &oldAccessRequest.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newAccessRequest.Spec, oldAccessRequest.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, ValidateAccessRequest(newAccessRequest)...)
+
+	return allErrs
+}
+
+// ValidateAccessRequestSpec validates the specification of an AccessRequest object.
+func ValidateAccessRequestSpec(spec *core.AccessRequestSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateSubject(spec.Subject, fldPath.Child("subject"))...)
+
+	if len(spec.Roles) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("roles"), "must request at least one role"))
+	}
+	allErrs = append(allErrs, validateProjectMemberRoles(spec.Roles, fldPath.Child("roles"))...)
+	for i, role := range spec.Roles {
+		if role == core.ProjectMemberOwner {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("roles").Index(i), fmt.Sprintf("the %q role cannot be requested", core.ProjectMemberOwner)))
+		}
+	}
+
+	if spec.Duration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("duration"), spec.Duration.Duration.String(), "must be greater than 0"))
+	}
+
+	if len(spec.Reason) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("reason"), "must provide a reason for the request"))
+	}
+
+	return allErrs
+}
+
+// ValidateAccessRequestStatusUpdate validates the status field of an AccessRequest object.
+func ValidateAccessRequestStatusUpdate(newAccessRequest, oldAccessRequest *core.AccessRequest) field.ErrorList {
+	allErrs := field.ErrorList{}
+	fldPath := field.NewPath("status")
+
+	if phase := newAccessRequest.Status.Phase; len(phase) > 0 && !availableAccessRequestPhases.Has(string(phase)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("phase"), phase, sets.List(availableAccessRequestPhases)))
+	}
+	if len(oldAccessRequest.Status.Phase) > 0 && len(newAccessRequest.Status.Phase) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("phase"), newAccessRequest.Status.Phase, "phase cannot be updated to an empty string"))
+	}
+
+	if newAccessRequest.Status.Phase == core.AccessRequestGranted && newAccessRequest.Status.ExpirationTimestamp == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("expirationTimestamp"), "must be set when the access request is granted"))
+	}
+	if oldAccessRequest.Status.ExpirationTimestamp != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newAccessRequest.Status.ExpirationTimestamp, oldAccessRequest.Status.ExpirationTimestamp, fldPath.Child("expirationTimestamp"))...)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+var _ = Describe("AccessRequest Validation Tests", func() {
+	var accessRequest *core.AccessRequest
+
+	BeforeEach(func() {
+		accessRequest = &core.AccessRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "request-1",
+				Namespace: "garden-dev",
+			},
+			Spec: core.AccessRequestSpec{
+				Subject: rbacv1.Subject{
+					APIGroup: rbacv1.GroupName,
+					Kind:     rbacv1.UserKind,
+					Name:     "alice",
+				},
+				Roles:    []string{core.ProjectMemberAdmin},
+				Duration: metav1.Duration{Duration: 4 * time.Hour},
+				Reason:   "incident #42",
+			},
+		}
+	})
+
+	Describe("#ValidateAccessRequest", func() {
+		It("should not return any errors", func() {
+			Expect(ValidateAccessRequest(accessRequest)).To(BeEmpty())
+		})
+
+		It("should forbid an AccessRequest with empty metadata", func() {
+			accessRequest.ObjectMeta = metav1.ObjectMeta{}
+
+			Expect(ValidateAccessRequest(accessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("metadata.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("metadata.namespace"),
+				})),
+			))
+		})
+
+		It("should forbid an invalid subject", func() {
+			accessRequest.Spec.Subject = rbacv1.Subject{Kind: "Foo"}
+
+			Expect(ValidateAccessRequest(accessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.subject.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.subject.kind"),
+				})),
+			))
+		})
+
+		It("should require at least one role", func() {
+			accessRequest.Spec.Roles = nil
+
+			Expect(ValidateAccessRequest(accessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.roles"),
+				})),
+			))
+		})
+
+		It("should forbid unsupported, duplicate and owner roles", func() {
+			accessRequest.Spec.Roles = []string{core.ProjectMemberViewer, core.ProjectMemberViewer, "foo", core.ProjectMemberOwner}
+
+			Expect(ValidateAccessRequest(accessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.roles[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.roles[2]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.roles[3]"),
+				})),
+			))
+		})
+
+		It("should require a positive duration and a reason", func() {
+			accessRequest.Spec.Duration = metav1.Duration{}
+			accessRequest.Spec.Reason = ""
+
+			Expect(ValidateAccessRequest(accessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.duration"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.reason"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateAccessRequestUpdate", func() {
+		It("should allow updating the metadata", func() {
+			newAccessRequest := accessRequest.DeepCopy()
+			newAccessRequest.ResourceVersion = "1"
+			newAccessRequest.Annotations = map[string]string{"foo": "bar"}
+
+			Expect(ValidateAccessRequestUpdate(newAccessRequest, accessRequest)).To(BeEmpty())
+		})
+
+		It("should forbid updating the spec", func() {
+			newAccessRequest := accessRequest.DeepCopy()
+			newAccessRequest.ResourceVersion = "1"
+			newAccessRequest.Spec.Duration = metav1.Duration{Duration: 24 * time.Hour}
+
+			Expect(ValidateAccessRequestUpdate(newAccessRequest, accessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateAccessRequestStatusUpdate", func() {
+		var expirationTimestamp = metav1.NewTime(time.Unix(1700000000, 0))
+
+		It("should allow granting the request", func() {
+			newAccessRequest := accessRequest.DeepCopy()
+			newAccessRequest.Status = core.AccessRequestStatus{Phase: core.AccessRequestGranted, ExpirationTimestamp: &expirationTimestamp}
+
+			Expect(ValidateAccessRequestStatusUpdate(newAccessRequest, accessRequest)).To(BeEmpty())
+		})
+
+		It("should forbid unsupported phases", func() {
+			newAccessRequest := accessRequest.DeepCopy()
+			newAccessRequest.Status.Phase = "Foo"
+
+			Expect(ValidateAccessRequestStatusUpdate(newAccessRequest, accessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("status.phase"),
+				})),
+			))
+		})
+
+		It("should forbid resetting the phase", func() {
+			accessRequest.Status.Phase = core.AccessRequestPending
+			newAccessRequest := accessRequest.DeepCopy()
+			newAccessRequest.Status.Phase = ""
+
+			Expect(ValidateAccessRequestStatusUpdate(newAccessRequest, accessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.phase"),
+				})),
+			))
+		})
+
+		It("should require the expiration timestamp when the request is granted", func() {
+			newAccessRequest := accessRequest.DeepCopy()
+			newAccessRequest.Status.Phase = core.AccessRequestGranted
+
+			Expect(ValidateAccessRequestStatusUpdate(newAccessRequest, accessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.expirationTimestamp"),
+				})),
+			))
+		})
+
+		It("should forbid changing the expiration timestamp", func() {
+			accessRequest.Status = core.AccessRequestStatus{Phase: core.AccessRequestGranted, ExpirationTimestamp: &expirationTimestamp}
+			newAccessRequest := accessRequest.DeepCopy()
+			newAccessRequest.Status.ExpirationTimestamp = &metav1.Time{Time: expirationTimestamp.Add(time.Hour)}
+
+			Expect(ValidateAccessRequestStatusUpdate(newAccessRequest, accessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.expirationTimestamp"),
+				})),
+			))
+		})
+	})
+})
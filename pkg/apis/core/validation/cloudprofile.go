@@ -22,6 +22,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/utils"
+	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 )
 
 var (
@@ -288,6 +289,10 @@ func validateCloudProfileRegions(regions []core.Region, fldPath *field.Path) fie
 		}
 
 		allErrs = append(allErrs, metav1validation.ValidateLabels(region.Labels, labelsPath)...)
+
+		if region.PriceFactor != nil {
+			allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("priceFactor", *region.PriceFactor, idxPath.Child("priceFactor"))...)
+		}
 	}
 
 	return allErrs
@@ -6,6 +6,7 @@ package validation_test
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -1003,6 +1004,29 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 					errorList := ValidateCloudProfile(cloudProfile)
 					Expect(errorList).To(BeEmpty())
 				})
+
+				It("should allow machine types with valid prices", func() {
+					cloudProfile.Spec.MachineTypes = slices.Clone(machineTypesConstraint)
+					cloudProfile.Spec.MachineTypes[0].Price = &core.Price{Currency: "EUR", Hourly: resource.MustParse("0.12")}
+
+					errorList := ValidateCloudProfile(cloudProfile)
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should forbid machine types with invalid prices", func() {
+					cloudProfile.Spec.MachineTypes = slices.Clone(machineTypesConstraint)
+					cloudProfile.Spec.MachineTypes[0].Price = &core.Price{Currency: "euro", Hourly: resource.MustParse("-1")}
+
+					errorList := ValidateCloudProfile(cloudProfile)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.machineTypes[0].price.currency"),
+					})), PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.machineTypes[0].price.hourly"),
+					}))))
+				})
 			})
 
 			Context("regions validation", func() {
@@ -1072,6 +1096,17 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 						})),
 					))
 				})
+
+				It("should forbid negative price factors", func() {
+					cloudProfile.Spec.Regions[0].PriceFactor = ptr.To(resource.MustParse("-1.2"))
+
+					errorList := ValidateCloudProfile(cloudProfile)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.regions[0].priceFactor"),
+					}))))
+				})
 			})
 
 			Context("volume types validation", func() {
@@ -1106,6 +1141,18 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 					})),
 					))
 				})
+
+				It("should forbid volume types with prices without currency", func() {
+					cloudProfile.Spec.VolumeTypes = slices.Clone(volumeTypesConstraint)
+					cloudProfile.Spec.VolumeTypes[0].Price = &core.Price{Hourly: resource.MustParse("0.0001")}
+
+					errorList := ValidateCloudProfile(cloudProfile)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.volumeTypes[0].price.currency"),
+					}))))
+				})
 			})
 
 			Context("bastion validation", func() {
@@ -24,6 +24,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
+	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 )
 
 type projectValidationOptions struct {
@@ -130,6 +131,10 @@ func ValidateProjectSpec(projectSpec *core.ProjectSpec, opts projectValidationOp
 				} else {
 					ownerFound = true
 				}
+
+				if member.ExpirationTimestamp != nil {
+					allErrs = append(allErrs, field.Forbidden(idxPath.Child("expirationTimestamp"), "the membership of the member having the owner role must not expire"))
+				}
 			}
 		}
 	}
@@ -155,6 +160,11 @@ func ValidateProjectSpec(projectSpec *core.ProjectSpec, opts projectValidationOp
 
 	allErrs = append(allErrs, validateDualApprovalForDeletion(projectSpec.DualApprovalForDeletion, fldPath.Child("dualApprovalForDeletion"))...)
 
+	if projectSpec.CostBudget != nil {
+		allErrs = append(allErrs, validateCurrency(projectSpec.CostBudget.Currency, fldPath.Child("costBudget", "currency"))...)
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("monthly", projectSpec.CostBudget.Monthly, fldPath.Child("costBudget", "monthly"))...)
+	}
+
 	return allErrs
 }
 
@@ -244,10 +254,17 @@ func ValidateProjectMember(member core.ProjectMember, fldPath *field.Path) field
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, ValidateSubject(member.Subject, fldPath)...)
+	allErrs = append(allErrs, validateProjectMemberRoles(member.Roles, fldPath.Child("roles"))...)
+
+	return allErrs
+}
+
+func validateProjectMemberRoles(roles []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
 
-	foundRoles := make(sets.Set[string], len(member.Roles))
-	for i, role := range member.Roles {
-		rolesPath := fldPath.Child("roles").Index(i)
+	foundRoles := make(sets.Set[string], len(roles))
+	for i, role := range roles {
+		rolesPath := fldPath.Index(i)
 
 		if foundRoles.Has(role) {
 			allErrs = append(allErrs, field.Duplicate(rolesPath, role))
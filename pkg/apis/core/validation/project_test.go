@@ -13,6 +13,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
@@ -408,6 +409,24 @@ var _ = Describe("Project Validation Tests", func() {
 			}))))
 		})
 
+		It("should allow memberships with an expiration timestamp", func() {
+			project.Spec.Members[1].ExpirationTimestamp = &metav1.Time{}
+
+			Expect(ValidateProject(project)).To(BeEmpty())
+		})
+
+		It("should not allow an expiration timestamp for the member having the owner role", func() {
+			project.Spec.Members[0].Roles = append(project.Spec.Members[0].Roles, core.ProjectMemberOwner)
+			project.Spec.Members[0].ExpirationTimestamp = &metav1.Time{}
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.members[0].expirationTimestamp"),
+			}))))
+		})
+
 		DescribeTable("subject validation",
 			func(apiGroup, kind, name, namespace string, expectType field.ErrorType, field string) {
 				subject := rbacv1.Subject{
@@ -625,6 +644,29 @@ var _ = Describe("Project Validation Tests", func() {
 			})
 		})
 
+		Context("cost budget", func() {
+			It("should allow valid cost budgets", func() {
+				project.Spec.CostBudget = &core.ProjectCostBudget{Currency: "EUR", Monthly: resource.MustParse("1000")}
+
+				Expect(ValidateProject(project)).To(BeEmpty())
+			})
+
+			It("should forbid invalid cost budgets", func() {
+				project.Spec.CostBudget = &core.ProjectCostBudget{Monthly: resource.MustParse("-1")}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.costBudget.currency"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.costBudget.monthly"),
+					})),
+				))
+			})
+		})
+
 		DescribeTable("namespace immutability",
 			func(old, new *string, matcher gomegatypes.GomegaMatcher) {
 				project.Spec.Namespace = old
@@ -34,6 +34,16 @@ func ValidateQuotaUpdate(newQuota, oldQuota *core.Quota) field.ErrorList {
 	return allErrs
 }
 
+// ValidateQuotaStatusUpdate validates the status field of a Quota object.
+func ValidateQuotaStatusUpdate(newQuota, _ *core.Quota) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateQuotaResourceList(newQuota.Status.Hard, field.NewPath("status", "hard"))...)
+	allErrs = append(allErrs, validateQuotaResourceList(newQuota.Status.Used, field.NewPath("status", "used"))...)
+
+	return allErrs
+}
+
 // ValidateQuotaSpec validates the specification of a Quota object.
 func ValidateQuotaSpec(quotaSpec *core.QuotaSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -47,9 +57,20 @@ func ValidateQuotaSpec(quotaSpec *core.QuotaSpec, fldPath *field.Path) field.Err
 		allErrs = append(allErrs, field.NotSupported(fldPath.Child("scope"), scopeRef.GroupVersionKind().String(), []string{helper.ProjectGVK.String(), helper.SecretGVK.String(), helper.WorkloadIdentityGVK.String()}))
 	}
 
-	metricsFldPath := fldPath.Child("metrics")
-	for k, v := range quotaSpec.Metrics {
-		keyPath := metricsFldPath.Key(string(k))
+	allErrs = append(allErrs, validateQuotaResourceList(quotaSpec.Metrics, fldPath.Child("metrics"))...)
+
+	if quotaSpec.WarningThresholdPercentage != nil && (*quotaSpec.WarningThresholdPercentage < 1 || *quotaSpec.WarningThresholdPercentage > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("warningThresholdPercentage"), *quotaSpec.WarningThresholdPercentage, "must be between 1 and 100"))
+	}
+
+	return allErrs
+}
+
+func validateQuotaResourceList(resources corev1.ResourceList, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for k, v := range resources {
+		keyPath := fldPath.Key(string(k))
 		if !isValidQuotaMetric(k) {
 			allErrs = append(allErrs, field.Invalid(keyPath, v.String(), fmt.Sprintf("%s is no supported quota metric", string(k))))
 		}
@@ -151,5 +151,59 @@ var _ = Describe("Quota Validation Tests ", func() {
 
 			Expect(errorList).To(BeEmpty())
 		})
+
+		DescribeTable("warning threshold percentage", func(warningThresholdPercentage int32, matcher gomegatypes.GomegaMatcher) {
+			quota.Spec.WarningThresholdPercentage = &warningThresholdPercentage
+
+			Expect(ValidateQuota(quota)).To(matcher)
+		},
+			Entry("should allow 1 percent", int32(1), BeEmpty()),
+			Entry("should allow 100 percent", int32(100), BeEmpty()),
+			Entry("should forbid 0 percent", int32(0), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.warningThresholdPercentage"),
+			})))),
+			Entry("should forbid more than 100 percent", int32(101), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.warningThresholdPercentage"),
+			})))),
+		)
+	})
+
+	Describe("#ValidateQuotaStatusUpdate", func() {
+		var quota *core.Quota
+
+		BeforeEach(func() {
+			quota = &core.Quota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "quota-1",
+					Namespace: "my-namespace",
+				},
+				Status: core.QuotaStatus{
+					Hard: corev1.ResourceList{"cpu": resource.MustParse("200")},
+					Used: corev1.ResourceList{"cpu": resource.MustParse("20")},
+				},
+			}
+		})
+
+		It("should not return any errors", func() {
+			Expect(ValidateQuotaStatusUpdate(quota, quota)).To(BeEmpty())
+		})
+
+		It("should forbid unsupported metrics and negative quantities", func() {
+			quota.Status.Hard["foo"] = resource.MustParse("1")
+			quota.Status.Used["cpu"] = resource.MustParse("-1")
+
+			Expect(ValidateQuotaStatusUpdate(quota, quota)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.hard[foo]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.used[cpu]"),
+				})),
+			))
+		})
 	})
 })
@@ -340,6 +340,9 @@ func ValidateMachineType(machineType core.MachineType, names sets.Set[string], c
 	if machineType.Storage != nil {
 		allErrs = append(allErrs, validateMachineTypeStorage(*machineType.Storage, idxPath.Child("storage"))...)
 	}
+	if machineType.Price != nil {
+		allErrs = append(allErrs, validatePrice(*machineType.Price, idxPath.Child("price"))...)
+	}
 	return allErrs
 }
 
@@ -374,11 +377,39 @@ func validateVolumeTypes(volumeTypes []core.VolumeType, fldPath *field.Path) fie
 		if volumeType.MinSize != nil {
 			allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("minSize", *volumeType.MinSize, idxPath.Child("minSize"))...)
 		}
+
+		if volumeType.Price != nil {
+			allErrs = append(allErrs, validatePrice(*volumeType.Price, idxPath.Child("price"))...)
+		}
 	}
 
 	return allErrs
 }
 
+var currencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// validateCurrency validates that the given currency is an ISO 4217 currency code.
+func validateCurrency(currency string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(currency) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "must provide a currency"))
+	} else if !currencyRegex.MatchString(currency) {
+		allErrs = append(allErrs, field.Invalid(fldPath, currency, "must be an ISO 4217 currency code consisting of three upper-case letters"))
+	}
+
+	return allErrs
+}
+
+func validatePrice(price core.Price, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateCurrency(price.Currency, fldPath.Child("currency"))...)
+	allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("hourly", price.Hourly, fldPath.Child("hourly"))...)
+
+	return allErrs
+}
+
 func validateMachineImageVersionFlavors(machineImageVersion core.MachineImageVersion, capabilities core.Capabilities, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -76,4 +76,9 @@ type BastionStatus struct {
 	// ObservedGeneration is the most recent generation observed for this Bastion. It corresponds to the
 	// Bastion's generation, which is updated on mutation by the API Server.
 	ObservedGeneration *int64
+	// SSHCertificate is the OpenSSH user certificate issued for the SSHPublicKey. It is signed by the SSH certificate
+	// authority of the referenced Shoot, which is trusted by the bastion host and the Shoot's nodes, and it is valid until
+	// the ExpirationTimestamp of the Bastion at the time of issuance. The certificate is renewed when the
+	// ExpirationTimestamp is advanced. It is only set if an SSH certificate authority exists for the Shoot.
+	SSHCertificate *string
 }
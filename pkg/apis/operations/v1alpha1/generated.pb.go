@@ -188,57 +188,59 @@ func init() {
 }
 
 var fileDescriptor_a8b335fad1255a79 = []byte{
-	// 799 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0xc7, 0xe3, 0xdd, 0x6c, 0x77, 0x3b, 0x9b, 0x42, 0x35, 0xad, 0x96, 0x68, 0x0f, 0xce, 0x92,
-	0x0b, 0x11, 0x12, 0x63, 0x52, 0x55, 0xa8, 0x3d, 0x70, 0x19, 0x44, 0xd9, 0x88, 0xa5, 0x1b, 0x4d,
-	0x2a, 0x0e, 0x08, 0x09, 0x26, 0xf6, 0x5b, 0x67, 0x48, 0xec, 0x31, 0x9e, 0x49, 0x20, 0x1c, 0x10,
-	0x1f, 0x01, 0x3e, 0x15, 0x7b, 0xec, 0x81, 0x43, 0x4f, 0x11, 0x6b, 0x3e, 0x06, 0x17, 0xe4, 0xf1,
-	0x24, 0x76, 0x48, 0x2a, 0x42, 0xdb, 0xdb, 0xcc, 0x9b, 0xf7, 0x7e, 0xff, 0x37, 0xef, 0x3d, 0x8f,
-	0x8c, 0x7a, 0xa1, 0xd0, 0xa3, 0xe9, 0x90, 0xf8, 0x32, 0xf2, 0x42, 0x9e, 0x06, 0x10, 0x43, 0x5a,
-	0x2e, 0x92, 0x71, 0xe8, 0xf1, 0x44, 0x28, 0x4f, 0x26, 0x90, 0x72, 0x2d, 0x64, 0xac, 0xbc, 0x59,
-	0x97, 0x4f, 0x92, 0x11, 0xef, 0x7a, 0x61, 0xee, 0xc2, 0x35, 0x04, 0x24, 0x49, 0xa5, 0x96, 0xf8,
-	0x71, 0x89, 0x22, 0x4b, 0x42, 0xb9, 0x48, 0xc6, 0x21, 0xc9, 0x51, 0xa4, 0x44, 0x91, 0x25, 0xea,
-	0x94, 0xee, 0x96, 0x85, 0x2f, 0x53, 0xf0, 0x66, 0xdd, 0x21, 0xe8, 0x4d, 0xf9, 0xd3, 0x0f, 0xaa,
-	0x0c, 0x19, 0x4a, 0xcf, 0x98, 0x87, 0xd3, 0x2b, 0xb3, 0x33, 0x1b, 0xb3, 0xb2, 0xee, 0xed, 0xf1,
-	0x23, 0x45, 0x84, 0xcc, 0xc1, 0x4b, 0xee, 0x06, 0xb2, 0x53, 0xf1, 0x89, 0x41, 0xff, 0x20, 0xd3,
-	0xb1, 0x88, 0xc3, 0x6d, 0x9e, 0x0f, 0x4b, 0xcf, 0x88, 0xfb, 0x23, 0x11, 0x43, 0x3a, 0x2f, 0xf3,
-	0x8e, 0x40, 0xf3, 0x6d, 0x51, 0xde, 0xcb, 0xa2, 0xd2, 0x69, 0xac, 0x45, 0x04, 0x1b, 0x01, 0x1f,
-	0xfd, 0x57, 0x80, 0xf2, 0x47, 0x10, 0xf1, 0x7f, 0xc7, 0xb5, 0x7f, 0xdf, 0x43, 0x87, 0x94, 0xab,
-	0xbc, 0xea, 0xf8, 0x5b, 0x74, 0x94, 0xe7, 0x13, 0x70, 0xcd, 0x9b, 0xce, 0x99, 0xd3, 0x39, 0x7e,
-	0xf0, 0x21, 0x29, 0xb0, 0xa4, 0x8a, 0x2d, 0x1b, 0x96, 0x7b, 0x93, 0x59, 0x97, 0x5c, 0x0e, 0xbf,
-	0x03, 0x5f, 0x7f, 0x01, 0x9a, 0x53, 0x7c, 0xbd, 0x68, 0xd5, 0xb2, 0x45, 0x0b, 0x95, 0x36, 0xb6,
-	0xa2, 0xe2, 0x11, 0xaa, 0xab, 0x04, 0xfc, 0xe6, 0x9e, 0xa1, 0x3f, 0x21, 0xaf, 0x3c, 0x17, 0xc4,
-	0xe6, 0x3c, 0x48, 0xc0, 0xa7, 0x0d, 0xab, 0x59, 0xcf, 0x77, 0xcc, 0x28, 0xe0, 0x04, 0xdd, 0x52,
-	0x9a, 0xeb, 0xa9, 0x6a, 0xee, 0x1b, 0xad, 0xf3, 0x37, 0xa0, 0x65, 0x78, 0xf4, 0x2d, 0xab, 0x76,
-	0xab, 0xd8, 0x33, 0xab, 0xd3, 0x0e, 0xd0, 0x7d, 0xeb, 0xd8, 0x8b, 0xc3, 0x14, 0x94, 0xea, 0xcb,
-	0x89, 0xf0, 0xe7, 0xf8, 0x02, 0x1d, 0x8a, 0x84, 0x4e, 0xa4, 0x3f, 0xb6, 0x45, 0x7d, 0xb7, 0x52,
-	0x54, 0x52, 0x0e, 0x4f, 0x5e, 0xc8, 0x5e, 0xdf, 0x38, 0xd2, 0xb7, 0xad, 0xc6, 0xa1, 0x35, 0xb0,
-	0x25, 0xa2, 0xfd, 0x87, 0x83, 0x8e, 0xad, 0xcc, 0x85, 0x50, 0x1a, 0x7f, 0xbd, 0xd1, 0x33, 0xb2,
-	0x5b, 0xcf, 0xf2, 0x68, 0xd3, 0xb1, 0xbb, 0x56, 0xeb, 0x68, 0x69, 0xa9, 0xf4, 0x2b, 0x44, 0x07,
-	0x42, 0x43, 0xa4, 0x9a, 0x7b, 0x67, 0xfb, 0x9d, 0xe3, 0x07, 0xf4, 0xf5, 0x8b, 0x48, 0xef, 0x58,
-	0xb9, 0x83, 0x5e, 0x0e, 0x66, 0x05, 0xbf, 0xfd, 0xf7, 0xde, 0xea, 0x5a, 0x79, 0x13, 0xf1, 0x97,
-	0xe8, 0x48, 0x8d, 0xa4, 0xd4, 0x0c, 0xae, 0xec, 0xb5, 0x3a, 0xd5, 0xaa, 0xe5, 0x9f, 0xa5, 0xb9,
-	0x84, 0xf4, 0xf9, 0xa4, 0x98, 0x34, 0x06, 0x57, 0x90, 0x42, 0xec, 0x43, 0x79, 0xa1, 0x81, 0x25,
-	0xb0, 0x15, 0x0b, 0x77, 0xd0, 0x91, 0x02, 0x08, 0x9e, 0xf2, 0x08, 0xcc, 0x10, 0xde, 0xa6, 0x0d,
-	0xe3, 0x69, 0x6d, 0x6c, 0x75, 0x8a, 0x1f, 0xa2, 0x46, 0x92, 0xca, 0x99, 0x08, 0x20, 0x7d, 0x36,
-	0x4f, 0xc0, 0x8c, 0xd1, 0x6d, 0x7a, 0x37, 0x5b, 0xb4, 0x1a, 0xfd, 0x8a, 0x9d, 0xad, 0x79, 0xe1,
-	0x47, 0xa8, 0xa1, 0xd4, 0xa8, 0x3f, 0x1d, 0x4e, 0x84, 0xff, 0x39, 0xcc, 0x9b, 0x75, 0x13, 0x75,
-	0xdf, 0x66, 0xd4, 0x18, 0x0c, 0xce, 0x57, 0x67, 0x6c, 0xcd, 0x13, 0xff, 0x84, 0x0e, 0x45, 0x31,
-	0x37, 0xcd, 0x03, 0x53, 0xec, 0xcb, 0xd7, 0x2f, 0xf6, 0xda, 0x20, 0x56, 0x86, 0xaa, 0x30, 0xb3,
-	0xa5, 0x60, 0xfb, 0xb7, 0x3a, 0xba, 0xb3, 0x36, 0xe4, 0xf8, 0x69, 0x99, 0x4d, 0x51, 0xfe, 0xf7,
-	0xb6, 0x97, 0x9f, 0x07, 0x94, 0x4f, 0x78, 0xec, 0x43, 0x6a, 0xa1, 0xf4, 0x78, 0x9b, 0x02, 0xfe,
-	0x1e, 0x21, 0x5f, 0xc6, 0x81, 0x30, 0x79, 0xda, 0x69, 0xfa, 0x78, 0xc7, 0x0b, 0x5a, 0x35, 0xf3,
-	0xb6, 0x93, 0x4f, 0x96, 0x94, 0xf2, 0xa5, 0x59, 0x99, 0x14, 0xab, 0x88, 0xe0, 0x9f, 0xd1, 0xc9,
-	0x84, 0x2b, 0x7d, 0x0e, 0x3c, 0xd5, 0x43, 0xe0, 0xfa, 0x99, 0x88, 0x40, 0x69, 0x1e, 0x25, 0xf6,
-	0x45, 0x78, 0x7f, 0xb7, 0xef, 0x24, 0x0f, 0xa3, 0xa7, 0xd9, 0xa2, 0x75, 0x72, 0xb1, 0x95, 0xc6,
-	0x5e, 0xa2, 0x82, 0xa7, 0xe8, 0x1e, 0xfc, 0x98, 0x88, 0xa2, 0x37, 0xa5, 0x78, 0xfd, 0x7f, 0x8b,
-	0xbf, 0x93, 0x2d, 0x5a, 0xf7, 0x3e, 0xdd, 0x44, 0xb1, 0x6d, 0x7c, 0xfc, 0x04, 0x61, 0x39, 0x54,
-	0x90, 0xce, 0x20, 0xf8, 0xac, 0x78, 0xeb, 0x85, 0x8c, 0x9b, 0x07, 0x67, 0x4e, 0x67, 0x9f, 0x9e,
-	0x64, 0x8b, 0x16, 0xbe, 0xdc, 0x38, 0x65, 0x5b, 0x22, 0xe8, 0x37, 0xd7, 0x37, 0x6e, 0xed, 0xf9,
-	0x8d, 0x5b, 0x7b, 0x71, 0xe3, 0xd6, 0x7e, 0xc9, 0x5c, 0xe7, 0x3a, 0x73, 0x9d, 0xe7, 0x99, 0xeb,
-	0xbc, 0xc8, 0x5c, 0xe7, 0xcf, 0xcc, 0x75, 0x7e, 0xfd, 0xcb, 0xad, 0x7d, 0xf5, 0xf8, 0x95, 0x7f,
-	0x12, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x0d, 0xea, 0x1f, 0x49, 0x60, 0x08, 0x00, 0x00,
+	// 820 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xbd, 0x89, 0xf3, 0xa3, 0x13, 0xb7, 0x54, 0xd3, 0x2a, 0x58, 0x39, 0xac, 0x83, 0x0f,
+	0x60, 0x21, 0x31, 0x26, 0x55, 0x85, 0xda, 0x03, 0x97, 0xa9, 0x28, 0x89, 0x08, 0x4d, 0x34, 0xa9,
+	0x38, 0x20, 0x24, 0x18, 0xef, 0xbe, 0xec, 0x0e, 0xf6, 0xee, 0x2c, 0x33, 0x63, 0x43, 0x38, 0x20,
+	0xfe, 0x04, 0xfe, 0x1d, 0xfe, 0x02, 0x72, 0xec, 0x81, 0x43, 0x4f, 0x16, 0x59, 0xfe, 0x0c, 0x2e,
+	0x68, 0x67, 0xc7, 0xde, 0x4d, 0xed, 0x0a, 0xd3, 0xf4, 0x36, 0xf3, 0xe6, 0xbd, 0xcf, 0xf7, 0xcd,
+	0x7b, 0x6f, 0x47, 0x8b, 0x8e, 0x22, 0x61, 0xe2, 0xf1, 0x80, 0x04, 0x32, 0xe9, 0x47, 0x5c, 0x85,
+	0x90, 0x82, 0xaa, 0x16, 0xd9, 0x30, 0xea, 0xf3, 0x4c, 0xe8, 0xbe, 0xcc, 0x40, 0x71, 0x23, 0x64,
+	0xaa, 0xfb, 0x93, 0x03, 0x3e, 0xca, 0x62, 0x7e, 0xd0, 0x8f, 0x0a, 0x17, 0x6e, 0x20, 0x24, 0x99,
+	0x92, 0x46, 0xe2, 0xc7, 0x15, 0x8a, 0xcc, 0x08, 0xd5, 0x22, 0x1b, 0x46, 0xa4, 0x40, 0x91, 0x0a,
+	0x45, 0x66, 0xa8, 0x3d, 0xba, 0x5a, 0x16, 0x81, 0x54, 0xd0, 0x9f, 0x1c, 0x0c, 0xc0, 0x2c, 0xca,
+	0xef, 0x7d, 0x54, 0x67, 0xc8, 0x48, 0xf6, 0xad, 0x79, 0x30, 0x3e, 0xb7, 0x3b, 0xbb, 0xb1, 0x2b,
+	0xe7, 0xde, 0x1d, 0x3e, 0xd2, 0x44, 0xc8, 0x02, 0x3c, 0xe3, 0x2e, 0x20, 0x7b, 0x35, 0x9f, 0x14,
+	0xcc, 0x8f, 0x52, 0x0d, 0x45, 0x1a, 0x2d, 0xf3, 0x7c, 0x58, 0x79, 0x26, 0x3c, 0x88, 0x45, 0x0a,
+	0xea, 0xa2, 0xca, 0x3b, 0x01, 0xc3, 0x97, 0x45, 0xf5, 0x5f, 0x17, 0xa5, 0xc6, 0xa9, 0x11, 0x09,
+	0x2c, 0x04, 0x7c, 0xf2, 0x5f, 0x01, 0x3a, 0x88, 0x21, 0xe1, 0xaf, 0xc6, 0x75, 0xff, 0x58, 0x43,
+	0x5b, 0x94, 0xeb, 0xa2, 0xea, 0xf8, 0x3b, 0xb4, 0x5d, 0xe4, 0x13, 0x72, 0xc3, 0xdb, 0xde, 0xbe,
+	0xd7, 0xdb, 0x79, 0xf0, 0x31, 0x29, 0xb1, 0xa4, 0x8e, 0xad, 0x1a, 0x56, 0x78, 0x93, 0xc9, 0x01,
+	0x39, 0x19, 0x7c, 0x0f, 0x81, 0xf9, 0x12, 0x0c, 0xa7, 0xf8, 0x72, 0xda, 0x69, 0xe4, 0xd3, 0x0e,
+	0xaa, 0x6c, 0x6c, 0x4e, 0xc5, 0x31, 0x6a, 0xea, 0x0c, 0x82, 0xf6, 0x9a, 0xa5, 0x3f, 0x25, 0x6f,
+	0x3c, 0x17, 0xc4, 0xe5, 0x7c, 0x96, 0x41, 0x40, 0x5b, 0x4e, 0xb3, 0x59, 0xec, 0x98, 0x55, 0xc0,
+	0x19, 0xda, 0xd4, 0x86, 0x9b, 0xb1, 0x6e, 0xaf, 0x5b, 0xad, 0xc3, 0xb7, 0xa0, 0x65, 0x79, 0xf4,
+	0x8e, 0x53, 0xdb, 0x2c, 0xf7, 0xcc, 0xe9, 0x74, 0x43, 0x74, 0xdf, 0x39, 0x1e, 0xa5, 0x91, 0x02,
+	0xad, 0x4f, 0xe5, 0x48, 0x04, 0x17, 0xf8, 0x18, 0x6d, 0x89, 0x8c, 0x8e, 0x64, 0x30, 0x74, 0x45,
+	0x7d, 0xaf, 0x56, 0x54, 0x52, 0x0d, 0x4f, 0x51, 0xc8, 0xa3, 0x53, 0xeb, 0x48, 0xdf, 0x71, 0x1a,
+	0x5b, 0xce, 0xc0, 0x66, 0x88, 0xee, 0x9f, 0x1e, 0xda, 0x71, 0x32, 0xc7, 0x42, 0x1b, 0xfc, 0xcd,
+	0x42, 0xcf, 0xc8, 0x6a, 0x3d, 0x2b, 0xa2, 0x6d, 0xc7, 0xee, 0x3a, 0xad, 0xed, 0x99, 0xa5, 0xd6,
+	0xaf, 0x08, 0x6d, 0x08, 0x03, 0x89, 0x6e, 0xaf, 0xed, 0xaf, 0xf7, 0x76, 0x1e, 0xd0, 0x9b, 0x17,
+	0x91, 0xde, 0x76, 0x72, 0x1b, 0x47, 0x05, 0x98, 0x95, 0xfc, 0xee, 0x3f, 0x6b, 0xf3, 0x6b, 0x15,
+	0x4d, 0xc4, 0x5f, 0xa1, 0x6d, 0x1d, 0x4b, 0x69, 0x18, 0x9c, 0xbb, 0x6b, 0xf5, 0xea, 0x55, 0x2b,
+	0x3e, 0x4b, 0x7b, 0x09, 0x19, 0xf0, 0x51, 0x39, 0x69, 0x0c, 0xce, 0x41, 0x41, 0x1a, 0x40, 0x75,
+	0xa1, 0x33, 0x47, 0x60, 0x73, 0x16, 0xee, 0xa1, 0x6d, 0x0d, 0x10, 0x3e, 0xe3, 0x09, 0xd8, 0x21,
+	0xbc, 0x45, 0x5b, 0xd6, 0xd3, 0xd9, 0xd8, 0xfc, 0x14, 0x3f, 0x44, 0xad, 0x4c, 0xc9, 0x89, 0x08,
+	0x41, 0x3d, 0xbf, 0xc8, 0xc0, 0x8e, 0xd1, 0x2d, 0x7a, 0x37, 0x9f, 0x76, 0x5a, 0xa7, 0x35, 0x3b,
+	0xbb, 0xe6, 0x85, 0x1f, 0xa1, 0x96, 0xd6, 0xf1, 0xe9, 0x78, 0x30, 0x12, 0xc1, 0x17, 0x70, 0xd1,
+	0x6e, 0xda, 0xa8, 0xfb, 0x2e, 0xa3, 0xd6, 0xd9, 0xd9, 0xe1, 0xfc, 0x8c, 0x5d, 0xf3, 0xc4, 0x3f,
+	0xa3, 0x2d, 0x51, 0xce, 0x4d, 0x7b, 0xc3, 0x16, 0xfb, 0xe4, 0xe6, 0xc5, 0xbe, 0x36, 0x88, 0xb5,
+	0xa1, 0x2a, 0xcd, 0x6c, 0x26, 0xd8, 0xfd, 0xbd, 0x89, 0x6e, 0x5f, 0x1b, 0x72, 0xfc, 0xac, 0xca,
+	0xa6, 0x2c, 0xff, 0x07, 0xcb, 0xcb, 0xcf, 0x43, 0xca, 0x47, 0x3c, 0x0d, 0x40, 0x39, 0x28, 0xdd,
+	0x59, 0xa6, 0x80, 0x7f, 0x40, 0x28, 0x90, 0x69, 0x28, 0x6c, 0x9e, 0x6e, 0x9a, 0x3e, 0x5d, 0xf1,
+	0x82, 0x4e, 0xcd, 0xbe, 0xed, 0xe4, 0xc9, 0x8c, 0x52, 0xbd, 0x34, 0x73, 0x93, 0x66, 0x35, 0x11,
+	0xfc, 0x0b, 0xda, 0x1d, 0x71, 0x6d, 0x0e, 0x81, 0x2b, 0x33, 0x00, 0x6e, 0x9e, 0x8b, 0x04, 0xb4,
+	0xe1, 0x49, 0xe6, 0x5e, 0x84, 0x0f, 0x57, 0xfb, 0x4e, 0x8a, 0x30, 0xba, 0x97, 0x4f, 0x3b, 0xbb,
+	0xc7, 0x4b, 0x69, 0xec, 0x35, 0x2a, 0x78, 0x8c, 0xee, 0xc1, 0x4f, 0x99, 0x28, 0x7b, 0x53, 0x89,
+	0x37, 0xff, 0xb7, 0xf8, 0xbb, 0xf9, 0xb4, 0x73, 0xef, 0xb3, 0x45, 0x14, 0x5b, 0xc6, 0xc7, 0x4f,
+	0x11, 0x96, 0x03, 0x0d, 0x6a, 0x02, 0xe1, 0xe7, 0xe5, 0x5b, 0x2f, 0x64, 0xda, 0xde, 0xd8, 0xf7,
+	0x7a, 0xeb, 0x74, 0x37, 0x9f, 0x76, 0xf0, 0xc9, 0xc2, 0x29, 0x5b, 0x12, 0x81, 0xdf, 0x47, 0x77,
+	0xb4, 0x8e, 0x9f, 0x80, 0x32, 0xe2, 0x5c, 0x04, 0xdc, 0x40, 0x7b, 0xb3, 0x98, 0x65, 0xf6, 0x8a,
+	0x95, 0x7e, 0x7b, 0x79, 0xe5, 0x37, 0x5e, 0x5c, 0xf9, 0x8d, 0x97, 0x57, 0x7e, 0xe3, 0xd7, 0xdc,
+	0xf7, 0x2e, 0x73, 0xdf, 0x7b, 0x91, 0xfb, 0xde, 0xcb, 0xdc, 0xf7, 0xfe, 0xca, 0x7d, 0xef, 0xb7,
+	0xbf, 0xfd, 0xc6, 0xd7, 0x8f, 0xdf, 0xf8, 0x67, 0xe2, 0xdf, 0x01, 0x00, 0xda, 0xde, 0xd2, 0x03,
+	0x88, 0x08, 0x00, 0x00,
 }
 
 func (m *Bastion) Marshal() (dAtA []byte, err error) {
@@ -460,6 +462,13 @@ func (m *BastionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SSHCertificate != nil {
+		i -= len(*m.SSHCertificate)
+		copy(dAtA[i:], *m.SSHCertificate)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.SSHCertificate)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.ObservedGeneration != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.ObservedGeneration))
 		i--
@@ -626,6 +635,10 @@ func (m *BastionStatus) Size() (n int) {
 	if m.ObservedGeneration != nil {
 		n += 1 + sovGenerated(uint64(*m.ObservedGeneration))
 	}
+	if m.SSHCertificate != nil {
+		l = len(*m.SSHCertificate)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -707,6 +720,7 @@ func (this *BastionStatus) String() string {
 		`LastHeartbeatTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatTimestamp), "Time", "v1.Time", 1) + `,`,
 		`ExpirationTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationTimestamp), "Time", "v1.Time", 1) + `,`,
 		`ObservedGeneration:` + valueToStringGenerated(this.ObservedGeneration) + `,`,
+		`SSHCertificate:` + valueToStringGenerated(this.SSHCertificate) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1474,6 +1488,39 @@ func (m *BastionStatus) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ObservedGeneration = &v
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHCertificate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SSHCertificate = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
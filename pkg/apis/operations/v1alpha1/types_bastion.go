@@ -93,4 +93,10 @@ type BastionStatus struct {
 	// Bastion's generation, which is updated on mutation by the API Server.
 	// +optional
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty" protobuf:"varint,5,opt,name=observedGeneration"`
+	// SSHCertificate is the OpenSSH user certificate issued for the SSHPublicKey. It is signed by the SSH certificate
+	// authority of the referenced Shoot, which is trusted by the bastion host and the Shoot's nodes, and it is valid until
+	// the ExpirationTimestamp of the Bastion at the time of issuance. The certificate is renewed when the
+	// ExpirationTimestamp is advanced. It is only set if an SSH certificate authority exists for the Shoot.
+	// +optional
+	SSHCertificate *string `json:"sshCertificate,omitempty" protobuf:"bytes,6,opt,name=sshCertificate"`
 }
@@ -189,6 +189,7 @@ func autoConvert_v1alpha1_BastionStatus_To_operations_BastionStatus(in *BastionS
 	out.LastHeartbeatTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
 	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
 	out.ObservedGeneration = (*int64)(unsafe.Pointer(in.ObservedGeneration))
+	out.SSHCertificate = (*string)(unsafe.Pointer(in.SSHCertificate))
 	return nil
 }
 
@@ -203,6 +204,7 @@ func autoConvert_operations_BastionStatus_To_v1alpha1_BastionStatus(in *operatio
 	out.LastHeartbeatTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
 	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
 	out.ObservedGeneration = (*int64)(unsafe.Pointer(in.ObservedGeneration))
+	out.SSHCertificate = (*string)(unsafe.Pointer(in.SSHCertificate))
 	return nil
 }
 
@@ -155,6 +155,11 @@ func (in *BastionStatus) DeepCopyInto(out *BastionStatus) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.SSHCertificate != nil {
+		in, out := &in.SSHCertificate, &out.SSHCertificate
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
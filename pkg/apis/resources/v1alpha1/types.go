@@ -24,6 +24,10 @@ const (
 	// true then the controller will not delete the object in case it is removed from the ManagedResource or the
 	// ManagedResource itself is deleted.
 	KeepObject = "resources.gardener.cloud/keep-object"
+	// Preview is an annotation on a ManagedResource that dictates whether the changes to the target cluster should only
+	// be previewed. If set to true then the controller computes the changes via server-side dry-run requests and
+	// reports them in the status instead of applying them.
+	Preview = "resources.gardener.cloud/preview"
 	// Mode is a constant for an annotation on a resource managed by a ManagedResource. It indicates the
 	// mode that should be used to reconcile the resource.
 	Mode = "resources.gardener.cloud/mode"
@@ -31,6 +35,10 @@ const (
 	// Reconciliation in ignore mode removes the resource from the ManagedResource status and does not
 	// perform any action on the cluster.
 	ModeIgnore = "Ignore"
+	// ApplyWave is a constant for an annotation on a resource managed by a ManagedResource. Its integer value
+	// specifies the wave in which the resource is applied (defaults to 0). The resources of a wave are only applied once
+	// all resources of the previous waves are healthy, and they are deleted in reverse order.
+	ApplyWave = "resources.gardener.cloud/apply-wave"
 	// PreserveReplicas is a constant for an annotation on a resource managed by a ManagedResource. If set to
 	// true then the controller will keep the `spec.replicas` field's value during updates to the resource.
 	PreserveReplicas = "resources.gardener.cloud/preserve-replicas"
@@ -270,8 +278,50 @@ type ManagedResourceStatus struct {
 	// SecretsDataChecksum is the checksum of referenced secrets data.
 	// +optional
 	SecretsDataChecksum *string `json:"secretsDataChecksum,omitempty"`
+	// Preview contains the changes which would be applied to the target cluster. It is only set if the ManagedResource
+	// is annotated with `resources.gardener.cloud/preview=true`.
+	// +optional
+	Preview *ManagedResourcePreview `json:"preview,omitempty"`
+}
+
+// ManagedResourcePreview contains the changes which would be applied to the target cluster.
+type ManagedResourcePreview struct {
+	// LastUpdateTime is the time when the preview was computed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+	// SecretsDataChecksum is the checksum of the referenced secrets data the preview was computed for.
+	SecretsDataChecksum string `json:"secretsDataChecksum"`
+	// Changes is a list of objects which would be created, updated, or deleted.
+	// +optional
+	Changes []ObjectChange `json:"changes,omitempty"`
+	// Error describes why the changes could not be computed for all objects, e.g. because a dry-run request was
+	// rejected by the target cluster.
+	// +optional
+	Error *string `json:"error,omitempty"`
+}
+
+// ObjectChange describes a change to an object in the target cluster.
+type ObjectChange struct {
+	corev1.ObjectReference `json:",inline"`
+
+	// Operation is the operation which would be performed for the object.
+	Operation ObjectOperation `json:"operation"`
+	// Fields is a list of paths of the fields which would be changed by an update.
+	// +optional
+	Fields []string `json:"fields,omitempty"`
 }
 
+// ObjectOperation is an operation performed for an object in the target cluster.
+type ObjectOperation string
+
+const (
+	// ObjectOperationCreate indicates that the object would be created.
+	ObjectOperationCreate ObjectOperation = "Create"
+	// ObjectOperationUpdate indicates that the object would be updated.
+	ObjectOperationUpdate ObjectOperation = "Update"
+	// ObjectOperationDelete indicates that the object would be deleted.
+	ObjectOperationDelete ObjectOperation = "Delete"
+)
+
 // ObjectReference is a reference to another object.
 type ObjectReference struct {
 	corev1.ObjectReference `json:",inline"`
@@ -289,6 +339,9 @@ const (
 	ResourcesHealthy gardencorev1beta1.ConditionType = "ResourcesHealthy"
 	// ResourcesProgressing is a condition type that indicates whether some resources are still progressing to be rolled out.
 	ResourcesProgressing gardencorev1beta1.ConditionType = "ResourcesProgressing"
+	// ResourcesDrifted is a condition type that indicates whether some resources were modified out-of-band and had to be
+	// reverted to their desired state during the last reconciliation.
+	ResourcesDrifted gardencorev1beta1.ConditionType = "ResourcesDrifted"
 )
 
 // These are well-known reasons for Conditions.
@@ -305,6 +358,9 @@ const (
 	// ConditionApplyProgressing indicates that the `ResourcesApplied` condition is `Progressing`,
 	// because the resources are currently being reconciled.
 	ConditionApplyProgressing = "ApplyProgressing"
+	// ConditionApplyWavePending indicates that the `ResourcesApplied` condition is `Progressing`,
+	// because the objects of an apply wave are not yet healthy and the subsequent waves are not yet applied.
+	ConditionApplyWavePending = "ApplyWavePending"
 	// ConditionDeletionFailed indicates that the `ResourcesApplied` condition is `False`,
 	// because deleting the resources failed.
 	ConditionDeletionFailed = "DeletionFailed"
@@ -317,6 +373,12 @@ const (
 	// ConditionManagedResourceIgnored indicates that the ManagedResource's conditions are not checked,
 	// because the ManagedResource is marked to be ignored.
 	ConditionManagedResourceIgnored = "ManagedResourceIgnored"
+	// ConditionDriftDetected indicates that the `ResourcesDrifted` condition is `True`,
+	// because some resources were modified out-of-band and had to be reverted during the last reconciliation.
+	ConditionDriftDetected = "DriftDetected"
+	// ConditionNoDriftDetected indicates that the `ResourcesDrifted` condition is `False`,
+	// because no resources were modified out-of-band since the previous reconciliation.
+	ConditionNoDriftDetected = "NoDriftDetected"
 	// ConditionChecksPending indicates that the `ResourcesProgressing` condition is `Unknown`,
 	// because the condition checks have not been completely executed yet for the current set of resources.
 	ConditionChecksPending = "ChecksPending"
@@ -57,6 +57,28 @@ type ManagedSeedSetSpec struct {
 	// RevisionHistoryLimit is the maximum number of revisions that will be maintained
 	// in the ManagedSeedSet's revision history. Defaults to 10. This field is immutable.
 	RevisionHistoryLimit *int32
+	// Autoscaling configures the automatic scaling of the number of replicas based on the utilization of their seeds.
+	// If set, Replicas is managed by the controller and kept between MinReplicas and MaxReplicas.
+	Autoscaling *ManagedSeedSetAutoscaling
+}
+
+// ManagedSeedSetAutoscaling contains the configuration for automatically scaling the number of replicas of a
+// ManagedSeedSet. The utilization of the replicas is the ratio of the number of shoots scheduled on their seeds and the
+// number of shoots allocatable by their seeds. Seeds which do not limit the number of allocatable shoots are not taken
+// into account.
+type ManagedSeedSetAutoscaling struct {
+	// MinReplicas is the lower limit for the number of replicas. Defaults to 1.
+	MinReplicas *int32
+	// MaxReplicas is the upper limit for the number of replicas.
+	MaxReplicas int32
+	// ScaleUpThresholdPercentage is the utilization in percent above which a replica is added. Defaults to 80.
+	ScaleUpThresholdPercentage *int32
+	// ScaleDownThresholdPercentage is the utilization in percent which must not be exceeded by the remaining replicas
+	// after removing a replica. The shoots scheduled on the seed of the removed replica are migrated to the seeds of
+	// the remaining replicas. Defaults to 50.
+	ScaleDownThresholdPercentage *int32
+	// ScaleDownDelay is the minimum duration after the last scaling operation before a replica is removed. Defaults to 1h.
+	ScaleDownDelay *metav1.Duration
 }
 
 // UpdateStrategy specifies the strategy that the ManagedSeedSet
@@ -118,6 +140,8 @@ type ManagedSeedSetStatus struct {
 	// PendingReplica, if not empty, indicates the replica that is currently pending creation, update, or deletion.
 	// This replica is in a state that requires the controller to wait for it to change before advancing to the next replica.
 	PendingReplica *PendingReplica
+	// LastScaleTime is the last time the number of replicas was changed due to autoscaling.
+	LastScaleTime *metav1.Time
 }
 
 // PendingReplicaReason is a string enumeration type that enumerates all possible reasons for a replica to be pending.
@@ -140,6 +164,9 @@ const (
 	SeedNotReadyReason PendingReplicaReason = "SeedNotReady"
 	// ShootNotHealthyReason indicates that the replica's shoot is not healthy.
 	ShootNotHealthyReason PendingReplicaReason = "ShootNotHealthy"
+	// SeedDrainingReason indicates that the shoots scheduled on the replica's seed are migrated to other seeds before
+	// the replica is deleted.
+	SeedDrainingReason PendingReplicaReason = "SeedDraining"
 )
 
 // PendingReplica contains information about a replica that is currently pending creation, update, or deletion.
@@ -8,4 +8,8 @@ const (
 	// AnnotationProtectFromDeletion is a constant for an annotation on a replica of a ManagedSeedSet
 	// (either ManagedSeed or Shoot) to protect it from deletion..
 	AnnotationProtectFromDeletion = "seedmanagement.gardener.cloud/protect-from-deletion"
+
+	// SeedTaintDraining is a constant for a taint key on the seed of a replica of a ManagedSeedSet which is drained
+	// before the replica is deleted. It prevents the scheduler from placing new shoots on the seed.
+	SeedTaintDraining = "seedmanagement.gardener.cloud/draining"
 )
@@ -5,6 +5,9 @@
 package v1alpha1
 
 import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
 
@@ -42,3 +45,22 @@ func SetDefaults_RollingUpdateStrategy(obj *RollingUpdateStrategy) {
 		obj.Partition = ptr.To[int32](0)
 	}
 }
+
+// SetDefaults_ManagedSeedSetAutoscaling sets default values for ManagedSeedSetAutoscaling objects.
+func SetDefaults_ManagedSeedSetAutoscaling(obj *ManagedSeedSetAutoscaling) {
+	if obj.MinReplicas == nil {
+		obj.MinReplicas = ptr.To[int32](1)
+	}
+
+	if obj.ScaleUpThresholdPercentage == nil {
+		obj.ScaleUpThresholdPercentage = ptr.To[int32](80)
+	}
+
+	if obj.ScaleDownThresholdPercentage == nil {
+		obj.ScaleDownThresholdPercentage = ptr.To[int32](50)
+	}
+
+	if obj.ScaleDownDelay == nil {
+		obj.ScaleDownDelay = &metav1.Duration{Duration: time.Hour}
+	}
+}
@@ -5,8 +5,11 @@
 package v1alpha1_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	. "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
@@ -88,4 +91,33 @@ var _ = Describe("Defaults", func() {
 			}))
 		})
 	})
+
+	Describe("ManagedSeedSetAutoscaling defaulting", func() {
+		It("should default minReplicas, thresholds and scaleDownDelay", func() {
+			obj.Spec.Autoscaling = &ManagedSeedSetAutoscaling{MaxReplicas: 3}
+			SetObjectDefaults_ManagedSeedSet(obj)
+
+			Expect(obj.Spec.Autoscaling).To(Equal(&ManagedSeedSetAutoscaling{
+				MinReplicas:                  ptr.To[int32](1),
+				MaxReplicas:                  3,
+				ScaleUpThresholdPercentage:   ptr.To[int32](80),
+				ScaleDownThresholdPercentage: ptr.To[int32](50),
+				ScaleDownDelay:               &metav1.Duration{Duration: time.Hour},
+			}))
+		})
+
+		It("should not overwrite the already set values for ManagedSeedSetAutoscaling", func() {
+			obj.Spec.Autoscaling = &ManagedSeedSetAutoscaling{
+				MinReplicas:                  ptr.To[int32](2),
+				MaxReplicas:                  5,
+				ScaleUpThresholdPercentage:   ptr.To[int32](90),
+				ScaleDownThresholdPercentage: ptr.To[int32](30),
+				ScaleDownDelay:               &metav1.Duration{Duration: time.Minute},
+			}
+			expected := obj.Spec.Autoscaling.DeepCopy()
+			SetObjectDefaults_ManagedSeedSet(obj)
+
+			Expect(obj.Spec.Autoscaling).To(Equal(expected))
+		})
+	})
 })
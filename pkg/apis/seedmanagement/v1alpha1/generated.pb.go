@@ -18,6 +18,7 @@ import (
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
 	v11 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	math "math"
 	math_bits "math/bits"
@@ -372,10 +373,38 @@ func (m *ManagedSeedSet) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ManagedSeedSet proto.InternalMessageInfo
 
+func (m *ManagedSeedSetAutoscaling) Reset()      { *m = ManagedSeedSetAutoscaling{} }
+func (*ManagedSeedSetAutoscaling) ProtoMessage() {}
+func (*ManagedSeedSetAutoscaling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d64c05a219673fe5, []int{12}
+}
+func (m *ManagedSeedSetAutoscaling) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManagedSeedSetAutoscaling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ManagedSeedSetAutoscaling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManagedSeedSetAutoscaling.Merge(m, src)
+}
+func (m *ManagedSeedSetAutoscaling) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManagedSeedSetAutoscaling) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManagedSeedSetAutoscaling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManagedSeedSetAutoscaling proto.InternalMessageInfo
+
 func (m *ManagedSeedSetList) Reset()      { *m = ManagedSeedSetList{} }
 func (*ManagedSeedSetList) ProtoMessage() {}
 func (*ManagedSeedSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{12}
+	return fileDescriptor_d64c05a219673fe5, []int{13}
 }
 func (m *ManagedSeedSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +432,7 @@ var xxx_messageInfo_ManagedSeedSetList proto.InternalMessageInfo
 func (m *ManagedSeedSetSpec) Reset()      { *m = ManagedSeedSetSpec{} }
 func (*ManagedSeedSetSpec) ProtoMessage() {}
 func (*ManagedSeedSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{13}
+	return fileDescriptor_d64c05a219673fe5, []int{14}
 }
 func (m *ManagedSeedSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -431,7 +460,7 @@ var xxx_messageInfo_ManagedSeedSetSpec proto.InternalMessageInfo
 func (m *ManagedSeedSetStatus) Reset()      { *m = ManagedSeedSetStatus{} }
 func (*ManagedSeedSetStatus) ProtoMessage() {}
 func (*ManagedSeedSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{14}
+	return fileDescriptor_d64c05a219673fe5, []int{15}
 }
 func (m *ManagedSeedSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +488,7 @@ var xxx_messageInfo_ManagedSeedSetStatus proto.InternalMessageInfo
 func (m *ManagedSeedSpec) Reset()      { *m = ManagedSeedSpec{} }
 func (*ManagedSeedSpec) ProtoMessage() {}
 func (*ManagedSeedSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{15}
+	return fileDescriptor_d64c05a219673fe5, []int{16}
 }
 func (m *ManagedSeedSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +516,7 @@ var xxx_messageInfo_ManagedSeedSpec proto.InternalMessageInfo
 func (m *ManagedSeedStatus) Reset()      { *m = ManagedSeedStatus{} }
 func (*ManagedSeedStatus) ProtoMessage() {}
 func (*ManagedSeedStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{16}
+	return fileDescriptor_d64c05a219673fe5, []int{17}
 }
 func (m *ManagedSeedStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +544,7 @@ var xxx_messageInfo_ManagedSeedStatus proto.InternalMessageInfo
 func (m *ManagedSeedTemplate) Reset()      { *m = ManagedSeedTemplate{} }
 func (*ManagedSeedTemplate) ProtoMessage() {}
 func (*ManagedSeedTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{17}
+	return fileDescriptor_d64c05a219673fe5, []int{18}
 }
 func (m *ManagedSeedTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +572,7 @@ var xxx_messageInfo_ManagedSeedTemplate proto.InternalMessageInfo
 func (m *PendingReplica) Reset()      { *m = PendingReplica{} }
 func (*PendingReplica) ProtoMessage() {}
 func (*PendingReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{18}
+	return fileDescriptor_d64c05a219673fe5, []int{19}
 }
 func (m *PendingReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +600,7 @@ var xxx_messageInfo_PendingReplica proto.InternalMessageInfo
 func (m *RollingUpdateStrategy) Reset()      { *m = RollingUpdateStrategy{} }
 func (*RollingUpdateStrategy) ProtoMessage() {}
 func (*RollingUpdateStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{19}
+	return fileDescriptor_d64c05a219673fe5, []int{20}
 }
 func (m *RollingUpdateStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +628,7 @@ var xxx_messageInfo_RollingUpdateStrategy proto.InternalMessageInfo
 func (m *Shoot) Reset()      { *m = Shoot{} }
 func (*Shoot) ProtoMessage() {}
 func (*Shoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{20}
+	return fileDescriptor_d64c05a219673fe5, []int{21}
 }
 func (m *Shoot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,7 +656,7 @@ var xxx_messageInfo_Shoot proto.InternalMessageInfo
 func (m *UpdateStrategy) Reset()      { *m = UpdateStrategy{} }
 func (*UpdateStrategy) ProtoMessage() {}
 func (*UpdateStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{21}
+	return fileDescriptor_d64c05a219673fe5, []int{22}
 }
 func (m *UpdateStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,6 +696,7 @@ func init() {
 	proto.RegisterType((*ManagedSeed)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ManagedSeed")
 	proto.RegisterType((*ManagedSeedList)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ManagedSeedList")
 	proto.RegisterType((*ManagedSeedSet)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ManagedSeedSet")
+	proto.RegisterType((*ManagedSeedSetAutoscaling)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ManagedSeedSetAutoscaling")
 	proto.RegisterType((*ManagedSeedSetList)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ManagedSeedSetList")
 	proto.RegisterType((*ManagedSeedSetSpec)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ManagedSeedSetSpec")
 	proto.RegisterType((*ManagedSeedSetStatus)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ManagedSeedSetStatus")
@@ -684,131 +714,140 @@ func init() {
 }
 
 var fileDescriptor_d64c05a219673fe5 = []byte{
-	// 1980 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdd, 0x6f, 0x1c, 0x49,
-	0x11, 0xf7, 0xd8, 0x5e, 0xdb, 0x53, 0x8e, 0xed, 0xb8, 0xed, 0x4b, 0xf6, 0x8c, 0xb2, 0x1b, 0x46,
-	0x02, 0x99, 0x8f, 0x9b, 0x25, 0xe1, 0x84, 0xc2, 0x71, 0x3e, 0xc9, 0xe3, 0x84, 0x24, 0x87, 0x1d,
-	0x9b, 0x5e, 0x3b, 0x48, 0x88, 0x07, 0x7a, 0x67, 0xdb, 0xeb, 0xc1, 0xf3, 0x75, 0x33, 0xbd, 0x9b,
-	0xac, 0x90, 0xe0, 0xc4, 0x1b, 0x27, 0x21, 0x21, 0xfe, 0x03, 0x84, 0xc4, 0xdf, 0x92, 0xc7, 0x08,
-	0x81, 0x74, 0x02, 0xb4, 0xba, 0x2c, 0x08, 0x01, 0xff, 0x00, 0x22, 0x0f, 0x08, 0x75, 0x4f, 0xcf,
-	0xe7, 0xce, 0x26, 0x4e, 0xbc, 0xb1, 0x04, 0x6f, 0x3b, 0xf5, 0xf1, 0xab, 0xea, 0xea, 0xea, 0xae,
-	0xaa, 0x5e, 0xd8, 0xeb, 0x58, 0xec, 0xa4, 0xdb, 0xd2, 0x4d, 0xcf, 0x69, 0x74, 0x48, 0xd0, 0xa6,
-	0x2e, 0x0d, 0xd2, 0x1f, 0xfe, 0x69, 0xa7, 0x41, 0x7c, 0x2b, 0x6c, 0x84, 0x94, 0xb6, 0x1d, 0xe2,
-	0x92, 0x0e, 0x75, 0xa8, 0xcb, 0x1a, 0xbd, 0x1b, 0xc4, 0xf6, 0x4f, 0xc8, 0x8d, 0x46, 0x87, 0x8b,
-	0x11, 0x46, 0xdb, 0xba, 0x1f, 0x78, 0xcc, 0x43, 0x5b, 0x29, 0x9c, 0x1e, 0xa3, 0xa4, 0x3f, 0xfc,
-	0xd3, 0x8e, 0xce, 0xe1, 0xf4, 0x3c, 0x9c, 0x1e, 0xc3, 0x6d, 0x6c, 0x9d, 0xcd, 0x1b, 0xd3, 0x0b,
-	0x68, 0xa3, 0x37, 0x62, 0x7d, 0xc3, 0x78, 0x25, 0xf5, 0x16, 0x65, 0xa3, 0x2b, 0xd8, 0x78, 0x27,
-	0x8b, 0xe1, 0x75, 0xbc, 0x86, 0x20, 0xb7, 0xba, 0xc7, 0xe2, 0x4b, 0x7c, 0x88, 0x5f, 0x52, 0x5c,
-	0x3b, 0xbd, 0x15, 0xea, 0x96, 0xc7, 0x81, 0xc7, 0xba, 0xf5, 0x6e, 0x2a, 0xe3, 0x10, 0xf3, 0xc4,
-	0x72, 0x69, 0xd0, 0x4f, 0xbd, 0x71, 0x28, 0x23, 0x65, 0x5a, 0x8d, 0x71, 0x5a, 0x41, 0xd7, 0x65,
-	0x96, 0x43, 0x47, 0x14, 0xbe, 0xf1, 0x32, 0x85, 0xd0, 0x3c, 0xa1, 0x0e, 0x29, 0xea, 0x69, 0x7f,
-	0x98, 0x06, 0xf5, 0xae, 0x08, 0x92, 0x4d, 0x19, 0xfa, 0x21, 0x2c, 0x70, 0x8f, 0xda, 0x84, 0x91,
-	0xaa, 0x72, 0x5d, 0xd9, 0x5c, 0xbc, 0xf9, 0x35, 0x3d, 0x02, 0xd6, 0xb3, 0xc0, 0xe9, 0x5e, 0x72,
-	0x69, 0xbd, 0x77, 0x43, 0xdf, 0x6f, 0xfd, 0x88, 0x9a, 0x6c, 0x8f, 0x32, 0x62, 0xa0, 0x27, 0x83,
-	0xfa, 0xd4, 0x70, 0x50, 0x87, 0x94, 0x86, 0x13, 0x54, 0xe4, 0xc2, 0x6c, 0xe8, 0x53, 0xb3, 0x3a,
-	0x2d, 0xd0, 0x77, 0xf5, 0x73, 0xa5, 0x8c, 0x9e, 0x78, 0xde, 0xf4, 0xa9, 0x69, 0x5c, 0x92, 0x96,
-	0x67, 0xf9, 0x17, 0x16, 0x76, 0x50, 0x0f, 0xe6, 0x42, 0x46, 0x58, 0x37, 0xac, 0xce, 0x08, 0x8b,
-	0x0f, 0x26, 0x66, 0x51, 0xa0, 0x1a, 0xcb, 0xd2, 0xe6, 0x5c, 0xf4, 0x8d, 0xa5, 0x35, 0xed, 0x6f,
-	0xd3, 0xb0, 0x92, 0xc8, 0xee, 0x78, 0xee, 0xb1, 0xd5, 0x41, 0x3f, 0x53, 0x00, 0xda, 0xd4, 0xb7,
-	0xbd, 0x3e, 0xc7, 0x94, 0x01, 0xc6, 0x93, 0x72, 0xe8, 0x76, 0x82, 0x6c, 0x2c, 0xf3, 0xf0, 0xa7,
-	0xdf, 0x38, 0x63, 0x15, 0x1d, 0xc1, 0x9c, 0x29, 0xdc, 0x91, 0x5b, 0xf0, 0xce, 0xd8, 0x0d, 0x96,
-	0x99, 0xa3, 0x63, 0xf2, 0xe8, 0xce, 0x63, 0x46, 0xdd, 0xd0, 0xf2, 0xdc, 0x74, 0xbd, 0xd1, 0x9a,
-	0xb0, 0x04, 0x43, 0xb7, 0x40, 0x6d, 0x79, 0x1e, 0x0b, 0x59, 0x40, 0x7c, 0x11, 0x6a, 0xd5, 0xd8,
-	0x18, 0x0e, 0xea, 0xaa, 0x11, 0x13, 0x9f, 0x67, 0x3f, 0x70, 0x2a, 0x8c, 0xb6, 0x60, 0xc5, 0xa1,
-	0x41, 0x87, 0x7e, 0xcf, 0x62, 0x27, 0x07, 0x24, 0xe0, 0x91, 0x99, 0xbd, 0xae, 0x6c, 0x2e, 0x18,
-	0x6b, 0xc3, 0x41, 0x7d, 0x65, 0x2f, 0xcf, 0xc2, 0x45, 0x59, 0xed, 0x5f, 0x0b, 0xb0, 0x56, 0x12,
-	0x03, 0xf4, 0x2e, 0x5c, 0x0a, 0xa8, 0x6f, 0x5b, 0x26, 0xd9, 0xf1, 0xba, 0x32, 0xda, 0x15, 0xe3,
-	0xf2, 0x70, 0x50, 0xbf, 0x84, 0x33, 0x74, 0x9c, 0x93, 0x42, 0xbb, 0xb0, 0x1e, 0xd0, 0x9e, 0xc5,
-	0x97, 0x7a, 0xcf, 0x0a, 0x99, 0x17, 0xf4, 0x77, 0x2d, 0xc7, 0x62, 0x22, 0x56, 0x15, 0xa3, 0x3a,
-	0x1c, 0xd4, 0xd7, 0x71, 0x09, 0x1f, 0x97, 0x6a, 0xa1, 0x6f, 0x03, 0x0a, 0x69, 0xd0, 0xb3, 0x4c,
-	0xba, 0x6d, 0x9a, 0x1c, 0xff, 0x01, 0x71, 0xa8, 0x8c, 0xce, 0x95, 0xe1, 0xa0, 0x8e, 0x9a, 0x23,
-	0x5c, 0x5c, 0xa2, 0x81, 0x28, 0x54, 0x2c, 0x87, 0x74, 0xa8, 0x08, 0xcc, 0xe2, 0xcd, 0xdb, 0xe7,
-	0x4c, 0x99, 0xfb, 0x1c, 0xcb, 0x50, 0x87, 0x83, 0x7a, 0x45, 0xfc, 0xc4, 0x11, 0x3a, 0x3a, 0x02,
-	0x35, 0xa0, 0xa1, 0xd7, 0x0d, 0x4c, 0x1a, 0x56, 0x2b, 0xc2, 0xd4, 0x66, 0x26, 0x3b, 0x74, 0x7e,
-	0xc5, 0xf1, 0xc3, 0x8e, 0xa5, 0x10, 0xa6, 0x1f, 0x75, 0xad, 0x40, 0x80, 0x87, 0xc6, 0x12, 0xdf,
-	0xed, 0x98, 0x13, 0xe2, 0x14, 0x09, 0xfd, 0x4a, 0x01, 0xd5, 0xf7, 0xda, 0xbb, 0xa4, 0x45, 0xed,
-	0xb0, 0x3a, 0x77, 0x7d, 0x66, 0x73, 0xf1, 0x26, 0x99, 0x7c, 0xd6, 0xeb, 0x07, 0xb1, 0x8d, 0x3b,
-	0x2e, 0x0b, 0xfa, 0xc6, 0xaa, 0xcc, 0x54, 0x35, 0xa1, 0xe3, 0xd4, 0x0d, 0xf4, 0x5b, 0x05, 0x96,
-	0x7d, 0xaf, 0xbd, 0xed, 0xba, 0x1e, 0x23, 0xcc, 0xf2, 0xdc, 0xb0, 0x3a, 0x2f, 0x3c, 0x3b, 0x7e,
-	0x33, 0x9e, 0x65, 0x0c, 0x45, 0xee, 0x5d, 0x91, 0xee, 0x2d, 0xe7, 0x99, 0xb8, 0xe0, 0x15, 0x32,
-	0x61, 0x95, 0xb4, 0xdb, 0x16, 0xff, 0x20, 0xf6, 0x43, 0xcf, 0xee, 0x3a, 0x34, 0xac, 0x2e, 0x08,
-	0x57, 0x37, 0xca, 0x36, 0x27, 0x12, 0x31, 0xde, 0x96, 0xf0, 0xab, 0xdb, 0x45, 0x65, 0x3c, 0x8a,
-	0x87, 0x1e, 0xc1, 0x95, 0x22, 0x71, 0x8f, 0x67, 0x5f, 0x58, 0x55, 0x85, 0xa5, 0xfa, 0x78, 0x4b,
-	0x42, 0xce, 0xa8, 0x49, 0x73, 0x57, 0xb6, 0x4b, 0x61, 0xf0, 0x18, 0x78, 0xf4, 0x4d, 0x98, 0xa1,
-	0x6e, 0xaf, 0x0a, 0xe3, 0xd7, 0x73, 0xc7, 0xed, 0x3d, 0x24, 0x81, 0xb1, 0x28, 0x0d, 0xcc, 0xdc,
-	0x71, 0x7b, 0x98, 0xeb, 0x6c, 0xbc, 0x0f, 0xcb, 0xf9, 0x1d, 0x47, 0x97, 0x61, 0xe6, 0x94, 0xf6,
-	0xc5, 0x49, 0x57, 0x31, 0xff, 0x89, 0xd6, 0xa1, 0xd2, 0x23, 0x76, 0x97, 0x8a, 0xf3, 0xab, 0xe2,
-	0xe8, 0xe3, 0xbd, 0xe9, 0x5b, 0xca, 0xc6, 0x36, 0xac, 0x95, 0xec, 0xca, 0xab, 0x40, 0x68, 0x9f,
-	0x28, 0xb0, 0x94, 0xec, 0xf6, 0x3d, 0x6a, 0x3b, 0xa8, 0x0f, 0x4b, 0x9e, 0x69, 0x61, 0xea, 0x7b,
-	0xa1, 0xc5, 0x6f, 0x01, 0x79, 0xc5, 0xbf, 0x7f, 0xc6, 0x94, 0x8a, 0x97, 0xbc, 0xbf, 0x73, 0x3f,
-	0xc5, 0x30, 0xde, 0x92, 0x2b, 0x5f, 0xca, 0x91, 0x71, 0xde, 0x92, 0xf6, 0xe7, 0xac, 0x33, 0xbb,
-	0x56, 0xc8, 0xd0, 0x0f, 0x46, 0x6a, 0xb9, 0x7e, 0xb6, 0x5a, 0xce, 0xb5, 0x45, 0x25, 0xbf, 0x2c,
-	0x2d, 0x2f, 0xc4, 0x94, 0x4c, 0x1d, 0x77, 0xa0, 0x62, 0x31, 0xea, 0x84, 0xd5, 0x69, 0xb1, 0x75,
-	0xf7, 0x26, 0x75, 0x6a, 0x8c, 0x25, 0x69, 0xb4, 0x72, 0x9f, 0xc3, 0xe3, 0xc8, 0x8a, 0xf6, 0xd7,
-	0x19, 0xb8, 0x9a, 0x96, 0x5e, 0x6a, 0x1f, 0x67, 0x6e, 0xfa, 0x5f, 0x2b, 0xb0, 0xd6, 0x19, 0x3d,
-	0x75, 0x6f, 0xb0, 0xbe, 0x7e, 0x4e, 0xfa, 0x58, 0x56, 0x78, 0x70, 0x99, 0x2f, 0xbc, 0xed, 0x39,
-	0xa1, 0xb6, 0x33, 0xe9, 0xb6, 0x87, 0x67, 0x5d, 0xda, 0xf6, 0xf0, 0x2f, 0x2c, 0xec, 0xf0, 0x3a,
-	0x26, 0xee, 0xf4, 0x87, 0xd4, 0x64, 0x5e, 0xb0, 0xdf, 0xa3, 0xc1, 0xa3, 0xc0, 0x62, 0x71, 0xed,
-	0x11, 0x75, 0xec, 0x7e, 0x09, 0x1f, 0x97, 0x6a, 0xa1, 0x0e, 0x5c, 0x33, 0x3d, 0xc7, 0xf7, 0x5c,
-	0xea, 0xb2, 0x32, 0x35, 0x51, 0x97, 0x54, 0xe3, 0xf3, 0xc3, 0x41, 0xfd, 0xda, 0xce, 0x8b, 0x04,
-	0xf1, 0x8b, 0x71, 0xb4, 0xbf, 0x4f, 0x67, 0xb2, 0x98, 0x77, 0x71, 0xe8, 0x93, 0xb2, 0x9e, 0xe9,
-	0xe1, 0xc4, 0x9a, 0xb8, 0x5c, 0x26, 0xa5, 0xad, 0xeb, 0xc5, 0xf6, 0x4e, 0x21, 0xac, 0x9d, 0x76,
-	0x5b, 0x34, 0xfa, 0x6a, 0x52, 0x33, 0xa0, 0x0c, 0xd3, 0x63, 0xd9, 0xb0, 0x96, 0x56, 0xe0, 0x5d,
-	0xcf, 0x24, 0x76, 0xd4, 0x5f, 0x63, 0x7a, 0x4c, 0x03, 0xea, 0x9a, 0xd4, 0xb8, 0xca, 0x33, 0xf2,
-	0x3b, 0xa3, 0x40, 0xb8, 0x0c, 0x5d, 0x7b, 0xaa, 0x64, 0x1a, 0xd4, 0xa8, 0x79, 0x45, 0x1f, 0x01,
-	0x98, 0x9e, 0x1b, 0x5d, 0xd4, 0x61, 0x55, 0x11, 0x27, 0x7b, 0xeb, 0xd5, 0x2e, 0x2f, 0x31, 0x57,
-	0xe9, 0x3b, 0x31, 0x4a, 0x1a, 0xd2, 0x84, 0x14, 0xe2, 0x8c, 0x11, 0xf4, 0x21, 0x20, 0xaf, 0xc5,
-	0x5b, 0x1e, 0xda, 0xbe, 0x1b, 0x8d, 0x26, 0x96, 0xe7, 0x8a, 0xf0, 0xce, 0x18, 0x1b, 0x52, 0x17,
-	0xed, 0x8f, 0x48, 0xe0, 0x12, 0x2d, 0xed, 0x37, 0x0a, 0x44, 0x0d, 0x0d, 0xd2, 0x01, 0x82, 0xfc,
-	0x2d, 0xac, 0x46, 0x4d, 0x71, 0xe6, 0x02, 0xcd, 0x48, 0xa0, 0xb7, 0x61, 0x86, 0x91, 0x68, 0x57,
-	0x55, 0x63, 0x9e, 0x97, 0x99, 0x43, 0xd2, 0xc1, 0x9c, 0x86, 0xf6, 0x01, 0xfc, 0xae, 0x6d, 0x1f,
-	0x78, 0xb6, 0x65, 0xf6, 0xe5, 0xf9, 0x69, 0x70, 0xa8, 0x83, 0x84, 0xfa, 0x7c, 0x50, 0xbf, 0x36,
-	0x3a, 0x09, 0xea, 0xa9, 0x00, 0xce, 0x40, 0x68, 0x7f, 0x9a, 0x86, 0xc5, 0x3d, 0x91, 0x94, 0xed,
-	0x26, 0xa5, 0xed, 0x0b, 0x98, 0xb9, 0xfc, 0xdc, 0xcc, 0x75, 0xde, 0x09, 0x28, 0xe3, 0xfb, 0xd8,
-	0xa9, 0xeb, 0x71, 0x61, 0xea, 0x3a, 0x98, 0xa0, 0xcd, 0x17, 0xcf, 0x5d, 0x9f, 0x29, 0xb0, 0x92,
-	0x91, 0xbe, 0x80, 0x4a, 0xe8, 0xe5, 0x2b, 0xe1, 0x87, 0x93, 0x5b, 0xea, 0xb8, 0x5a, 0x38, 0x0d,
-	0xcb, 0xd9, 0x80, 0x5c, 0xc8, 0xdc, 0x1e, 0xe6, 0x72, 0xe8, 0xbb, 0x13, 0xdc, 0xcf, 0x17, 0x0c,
-	0xef, 0x3f, 0x2e, 0xa4, 0x51, 0x73, 0xb2, 0x66, 0x5f, 0x32, 0xc1, 0x2b, 0x80, 0xf2, 0x0a, 0x17,
-	0x90, 0x4c, 0x41, 0x3e, 0x99, 0xf6, 0x26, 0xba, 0xe0, 0x31, 0xf9, 0xf4, 0x9f, 0xd9, 0xe2, 0x42,
-	0x45, 0xe5, 0xdd, 0x84, 0x05, 0x39, 0x1a, 0x87, 0x72, 0x78, 0xbe, 0xc4, 0x9d, 0x96, 0xc3, 0x73,
-	0x88, 0x13, 0x2e, 0x22, 0xb0, 0x10, 0x52, 0x5b, 0x94, 0x72, 0x99, 0x1f, 0x5f, 0x3f, 0x63, 0x48,
-	0x78, 0xf3, 0xde, 0x94, 0xaa, 0x69, 0x5c, 0x62, 0x0a, 0x4e, 0x60, 0xd1, 0xc7, 0x0a, 0x2c, 0x30,
-	0xea, 0xf8, 0x36, 0x91, 0x4d, 0xcc, 0xf9, 0x1b, 0xbb, 0xcc, 0x92, 0x0f, 0x25, 0x72, 0xea, 0x42,
-	0x4c, 0xc1, 0x89, 0x55, 0xf4, 0x13, 0x58, 0x0a, 0x4f, 0x3c, 0x8f, 0xc5, 0x2c, 0x39, 0x8c, 0x6f,
-	0xbf, 0x4e, 0x7d, 0x6c, 0x66, 0x81, 0xd2, 0x0e, 0x3f, 0x47, 0xc6, 0x79, 0x73, 0xe8, 0xe7, 0x0a,
-	0x2c, 0x77, 0xfd, 0x36, 0x61, 0xb4, 0xc9, 0x02, 0xc2, 0x68, 0xa7, 0x2f, 0x67, 0xf4, 0xf3, 0x26,
-	0xc9, 0x51, 0x0e, 0xd4, 0x40, 0x7c, 0x28, 0xcd, 0xd3, 0x70, 0xc1, 0xf0, 0xd8, 0x67, 0x92, 0xb9,
-	0xd7, 0x79, 0x26, 0xd1, 0xfe, 0x38, 0x07, 0xeb, 0x65, 0x47, 0x73, 0x4c, 0x73, 0xa0, 0xbc, 0x4e,
-	0x73, 0x80, 0xbe, 0x9a, 0x49, 0xe7, 0xe8, 0x35, 0x27, 0xd9, 0xec, 0x92, 0x94, 0xfe, 0x16, 0x2c,
-	0x05, 0x94, 0xb4, 0xfb, 0x31, 0x4b, 0xe4, 0x5c, 0x25, 0xdd, 0x29, 0x9c, 0x65, 0xe2, 0xbc, 0x2c,
-	0xba, 0x0b, 0xab, 0x2e, 0x7d, 0xcc, 0xe4, 0xf7, 0x83, 0xae, 0xd3, 0xa2, 0x81, 0xc8, 0x96, 0x4a,
-	0x3a, 0x96, 0x3f, 0x28, 0x0a, 0xe0, 0x51, 0x1d, 0xb4, 0x0d, 0x2b, 0x66, 0x37, 0x10, 0xef, 0x5e,
-	0xb1, 0x1f, 0x15, 0x01, 0x73, 0x55, 0xc2, 0xac, 0xec, 0xe4, 0xd9, 0xb8, 0x28, 0xcf, 0x21, 0xa2,
-	0xbd, 0x6b, 0x27, 0x10, 0x73, 0x79, 0x88, 0xa3, 0x3c, 0x1b, 0x17, 0xe5, 0x73, 0x5e, 0x44, 0xbb,
-	0x57, 0x9d, 0x17, 0x6d, 0xd0, 0xa8, 0x17, 0x11, 0x1b, 0x17, 0xe5, 0xd1, 0x07, 0x71, 0xea, 0x26,
-	0x08, 0x0b, 0xd1, 0x23, 0x58, 0xfc, 0x08, 0x72, 0x94, 0xe3, 0xe2, 0x82, 0x34, 0x7a, 0x0f, 0x96,
-	0x4d, 0xcf, 0xb6, 0xc5, 0x47, 0xf4, 0x9c, 0xa7, 0x8a, 0x45, 0x88, 0x5c, 0xdd, 0xc9, 0x71, 0x70,
-	0x41, 0xb2, 0xd0, 0xd4, 0xc2, 0x45, 0x34, 0xb5, 0xfc, 0xa8, 0xfa, 0xd4, 0x6d, 0x5b, 0x6e, 0x47,
-	0x46, 0xb1, 0xba, 0x38, 0x91, 0xa3, 0x7a, 0x90, 0x03, 0x8d, 0x96, 0x9f, 0xa7, 0xe1, 0x82, 0x61,
-	0xed, 0xdf, 0xf9, 0x86, 0x48, 0x5c, 0xed, 0x14, 0x2a, 0xe2, 0x6e, 0x91, 0x05, 0xec, 0xbc, 0xef,
-	0x89, 0xe2, 0xda, 0x8a, 0xde, 0x13, 0xc5, 0x4f, 0x1c, 0xa1, 0xa3, 0x9f, 0x82, 0x9a, 0xcc, 0xc2,
-	0x93, 0x7e, 0x7e, 0x8f, 0x46, 0xa8, 0xf4, 0x91, 0x2f, 0x61, 0xe0, 0xd4, 0xa6, 0xf6, 0x3b, 0x05,
-	0x56, 0x47, 0x5a, 0xc7, 0xff, 0xf5, 0x29, 0xe7, 0x1f, 0x0a, 0xac, 0x95, 0xd4, 0xae, 0xff, 0xc7,
-	0x39, 0x42, 0xfb, 0xa7, 0x02, 0x85, 0xfc, 0x46, 0xd7, 0x61, 0xd6, 0x25, 0x0e, 0x95, 0x43, 0x5d,
-	0xa2, 0x24, 0xde, 0xce, 0x05, 0x07, 0x7d, 0x00, 0x73, 0x01, 0x25, 0xa1, 0x0c, 0xb0, 0x6a, 0x7c,
-	0x31, 0x6e, 0xf0, 0xb0, 0xa0, 0x3e, 0x1f, 0xd4, 0xd7, 0x0b, 0x67, 0x46, 0xd0, 0xb1, 0xd4, 0x42,
-	0xfb, 0x50, 0x09, 0x2d, 0xd7, 0x8c, 0xfb, 0x8c, 0x2f, 0x9f, 0x2d, 0x8a, 0x87, 0x96, 0x43, 0xd3,
-	0x06, 0xab, 0xc9, 0x01, 0x70, 0x84, 0x83, 0xbe, 0x00, 0xf3, 0x01, 0x65, 0x81, 0x45, 0x43, 0x59,
-	0x05, 0x16, 0x87, 0x83, 0xfa, 0x3c, 0x8e, 0x48, 0x38, 0xe6, 0x69, 0xb7, 0xe1, 0x2d, 0xcc, 0xaf,
-	0x2e, 0xb7, 0x93, 0xaf, 0xbe, 0xe8, 0x2b, 0xa0, 0xfa, 0x24, 0x60, 0x56, 0x52, 0xfd, 0x2a, 0xd1,
-	0x6b, 0xfb, 0x41, 0x4c, 0xc4, 0x29, 0x5f, 0xfb, 0x12, 0x44, 0x87, 0xf0, 0xe5, 0x81, 0xd2, 0x7e,
-	0xaf, 0x40, 0xa1, 0xd0, 0xa3, 0x9b, 0x30, 0xcb, 0xfa, 0x7e, 0xac, 0x54, 0xe3, 0x0a, 0x87, 0x7d,
-	0x9f, 0x3e, 0x1f, 0xd4, 0x51, 0x5e, 0x92, 0x53, 0xb1, 0x90, 0x45, 0xbf, 0x50, 0x60, 0x29, 0xc8,
-	0x3a, 0x2e, 0x13, 0xe4, 0xf0, 0x9c, 0x09, 0x52, 0x1a, 0x0c, 0x63, 0x55, 0x94, 0xdf, 0x2c, 0x0b,
-	0xe7, 0xad, 0x1b, 0xe6, 0x93, 0x67, 0xb5, 0xa9, 0xa7, 0xcf, 0x6a, 0x53, 0x9f, 0x3e, 0xab, 0x4d,
-	0x7d, 0x3c, 0xac, 0x29, 0x4f, 0x86, 0x35, 0xe5, 0xe9, 0xb0, 0xa6, 0x7c, 0x3a, 0xac, 0x29, 0x9f,
-	0x0d, 0x6b, 0xca, 0x2f, 0xff, 0x52, 0x9b, 0xfa, 0xfe, 0xd6, 0xb9, 0xfe, 0xfb, 0xfe, 0x6f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x13, 0x58, 0xd0, 0xd5, 0x3b, 0x1f, 0x00, 0x00,
+	// 2118 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x5b, 0x6f, 0x1c, 0x49,
+	0x15, 0x76, 0xcf, 0x78, 0x6c, 0xf7, 0xf1, 0x2d, 0x2e, 0x7b, 0x93, 0x89, 0x21, 0x33, 0xa6, 0x25,
+	0x90, 0xb9, 0x6c, 0x9b, 0x84, 0x15, 0x0a, 0xcb, 0x3a, 0x92, 0xdb, 0x0e, 0x49, 0x16, 0x3b, 0x36,
+	0x35, 0xb6, 0x41, 0x88, 0x07, 0xca, 0x3d, 0xe5, 0x71, 0xe3, 0xbe, 0x6d, 0x77, 0xcd, 0x24, 0x03,
+	0x12, 0xac, 0x78, 0x63, 0x25, 0x24, 0xc4, 0x3f, 0x40, 0x48, 0x3c, 0xf1, 0x0f, 0xf8, 0x03, 0x79,
+	0x8c, 0x10, 0x48, 0x2b, 0x81, 0x46, 0x9b, 0x01, 0xad, 0x80, 0x3f, 0x80, 0xf0, 0x13, 0xaa, 0xea,
+	0x7b, 0x4f, 0x8f, 0xd7, 0x8e, 0x67, 0x2d, 0xc1, 0x5b, 0xd7, 0xa9, 0x73, 0xbe, 0x73, 0xaa, 0xea,
+	0xd4, 0xb9, 0xd4, 0x0c, 0xec, 0xb4, 0x0c, 0x76, 0xd2, 0x3e, 0x52, 0x75, 0xc7, 0x5a, 0x6b, 0x11,
+	0xaf, 0x49, 0x6d, 0xea, 0x25, 0x1f, 0xee, 0x69, 0x6b, 0x8d, 0xb8, 0x86, 0xbf, 0xe6, 0x53, 0xda,
+	0xb4, 0x88, 0x4d, 0x5a, 0xd4, 0xa2, 0x36, 0x5b, 0xeb, 0xdc, 0x25, 0xa6, 0x7b, 0x42, 0xee, 0xae,
+	0xb5, 0x38, 0x1b, 0x61, 0xb4, 0xa9, 0xba, 0x9e, 0xc3, 0x1c, 0xb4, 0x9e, 0xc0, 0xa9, 0x11, 0x4a,
+	0xf2, 0xe1, 0x9e, 0xb6, 0x54, 0x0e, 0xa7, 0x66, 0xe1, 0xd4, 0x08, 0x6e, 0x79, 0xfd, 0x62, 0xd6,
+	0xe8, 0x8e, 0x47, 0xd7, 0x3a, 0x03, 0xda, 0x97, 0xb5, 0x4b, 0x89, 0x1f, 0x51, 0x36, 0xb8, 0x82,
+	0xe5, 0x37, 0xd3, 0x18, 0x4e, 0xcb, 0x59, 0x13, 0xe4, 0xa3, 0xf6, 0xb1, 0x18, 0x89, 0x81, 0xf8,
+	0x0a, 0xd9, 0x95, 0xd3, 0xfb, 0xbe, 0x6a, 0x38, 0x1c, 0x78, 0xa8, 0x59, 0x6f, 0x25, 0x3c, 0x16,
+	0xd1, 0x4f, 0x0c, 0x9b, 0x7a, 0xdd, 0xc4, 0x1a, 0x8b, 0x32, 0x52, 0x24, 0xb5, 0x36, 0x4c, 0xca,
+	0x6b, 0xdb, 0xcc, 0xb0, 0xe8, 0x80, 0xc0, 0xd7, 0x3f, 0x49, 0xc0, 0xd7, 0x4f, 0xa8, 0x45, 0xf2,
+	0x72, 0xca, 0x9f, 0x4b, 0x20, 0x3f, 0x12, 0x9b, 0x64, 0x52, 0x86, 0x7e, 0x08, 0x53, 0xdc, 0xa2,
+	0x26, 0x61, 0xa4, 0x2a, 0xad, 0x48, 0xab, 0xd3, 0xf7, 0xbe, 0xaa, 0x06, 0xc0, 0x6a, 0x1a, 0x38,
+	0x39, 0x4b, 0xce, 0xad, 0x76, 0xee, 0xaa, 0xbb, 0x47, 0x3f, 0xa2, 0x3a, 0xdb, 0xa1, 0x8c, 0x68,
+	0xe8, 0x45, 0xaf, 0x3e, 0xd6, 0xef, 0xd5, 0x21, 0xa1, 0xe1, 0x18, 0x15, 0xd9, 0x30, 0xee, 0xbb,
+	0x54, 0xaf, 0x96, 0x04, 0xfa, 0xb6, 0x7a, 0x25, 0x97, 0x51, 0x63, 0xcb, 0x1b, 0x2e, 0xd5, 0xb5,
+	0x99, 0x50, 0xf3, 0x38, 0x1f, 0x61, 0xa1, 0x07, 0x75, 0x60, 0xc2, 0x67, 0x84, 0xb5, 0xfd, 0x6a,
+	0x59, 0x68, 0x7c, 0x3a, 0x32, 0x8d, 0x02, 0x55, 0x9b, 0x0b, 0x75, 0x4e, 0x04, 0x63, 0x1c, 0x6a,
+	0x53, 0x3e, 0x2e, 0xc1, 0x7c, 0xcc, 0xbb, 0xe9, 0xd8, 0xc7, 0x46, 0x0b, 0xfd, 0x5c, 0x02, 0x68,
+	0x52, 0xd7, 0x74, 0xba, 0x1c, 0x33, 0xdc, 0x60, 0x3c, 0x2a, 0x83, 0xb6, 0x62, 0x64, 0x6d, 0x8e,
+	0x6f, 0x7f, 0x32, 0xc6, 0x29, 0xad, 0xe8, 0x00, 0x26, 0x74, 0x61, 0x4e, 0x78, 0x04, 0x6f, 0x0e,
+	0x3d, 0xe0, 0xd0, 0x73, 0x54, 0x4c, 0x9e, 0x3d, 0x7c, 0xce, 0xa8, 0xed, 0x1b, 0x8e, 0x9d, 0xac,
+	0x37, 0x58, 0x13, 0x0e, 0xc1, 0xd0, 0x7d, 0x90, 0x8f, 0x1c, 0x87, 0xf9, 0xcc, 0x23, 0xae, 0xd8,
+	0x6a, 0x59, 0x5b, 0xee, 0xf7, 0xea, 0xb2, 0x16, 0x11, 0xcf, 0xd2, 0x03, 0x9c, 0x30, 0xa3, 0x75,
+	0x98, 0xb7, 0xa8, 0xd7, 0xa2, 0xdf, 0x35, 0xd8, 0xc9, 0x1e, 0xf1, 0xf8, 0xce, 0x8c, 0xaf, 0x48,
+	0xab, 0x53, 0xda, 0x62, 0xbf, 0x57, 0x9f, 0xdf, 0xc9, 0x4e, 0xe1, 0x3c, 0xaf, 0xf2, 0xef, 0x29,
+	0x58, 0x2c, 0xd8, 0x03, 0xf4, 0x16, 0xcc, 0x78, 0xd4, 0x35, 0x0d, 0x9d, 0x6c, 0x3a, 0xed, 0x70,
+	0xb7, 0x2b, 0xda, 0x8d, 0x7e, 0xaf, 0x3e, 0x83, 0x53, 0x74, 0x9c, 0xe1, 0x42, 0xdb, 0xb0, 0xe4,
+	0xd1, 0x8e, 0xc1, 0x97, 0xfa, 0xd8, 0xf0, 0x99, 0xe3, 0x75, 0xb7, 0x0d, 0xcb, 0x60, 0x62, 0xaf,
+	0x2a, 0x5a, 0xb5, 0xdf, 0xab, 0x2f, 0xe1, 0x82, 0x79, 0x5c, 0x28, 0x85, 0xbe, 0x05, 0xc8, 0xa7,
+	0x5e, 0xc7, 0xd0, 0xe9, 0x86, 0xae, 0x73, 0xfc, 0xa7, 0xc4, 0xa2, 0xe1, 0xee, 0xdc, 0xec, 0xf7,
+	0xea, 0xa8, 0x31, 0x30, 0x8b, 0x0b, 0x24, 0x10, 0x85, 0x8a, 0x61, 0x91, 0x16, 0x15, 0x1b, 0x33,
+	0x7d, 0x6f, 0xeb, 0x8a, 0x2e, 0xf3, 0x84, 0x63, 0x69, 0x72, 0xbf, 0x57, 0xaf, 0x88, 0x4f, 0x1c,
+	0xa0, 0xa3, 0x03, 0x90, 0x3d, 0xea, 0x3b, 0x6d, 0x4f, 0xa7, 0x7e, 0xb5, 0x22, 0x54, 0xad, 0xa6,
+	0xbc, 0x43, 0xe5, 0x21, 0x8e, 0x5f, 0x76, 0x1c, 0x32, 0x61, 0xfa, 0x5e, 0xdb, 0xf0, 0x04, 0xb8,
+	0xaf, 0xcd, 0xf2, 0xd3, 0x8e, 0x66, 0x7c, 0x9c, 0x20, 0xa1, 0x5f, 0x4b, 0x20, 0xbb, 0x4e, 0x73,
+	0x9b, 0x1c, 0x51, 0xd3, 0xaf, 0x4e, 0xac, 0x94, 0x57, 0xa7, 0xef, 0x91, 0xd1, 0x7b, 0xbd, 0xba,
+	0x17, 0xe9, 0x78, 0x68, 0x33, 0xaf, 0xab, 0x2d, 0x84, 0x9e, 0x2a, 0xc7, 0x74, 0x9c, 0x98, 0x81,
+	0x7e, 0x27, 0xc1, 0x9c, 0xeb, 0x34, 0x37, 0x6c, 0xdb, 0x61, 0x84, 0x19, 0x8e, 0xed, 0x57, 0x27,
+	0x85, 0x65, 0xc7, 0x9f, 0x8e, 0x65, 0x29, 0x45, 0x81, 0x79, 0x37, 0x43, 0xf3, 0xe6, 0xb2, 0x93,
+	0x38, 0x67, 0x15, 0xd2, 0x61, 0x81, 0x34, 0x9b, 0x06, 0x1f, 0x10, 0xf3, 0xd0, 0x31, 0xdb, 0x16,
+	0xf5, 0xab, 0x53, 0xc2, 0xd4, 0xe5, 0xa2, 0xc3, 0x09, 0x58, 0xb4, 0xdb, 0x21, 0xfc, 0xc2, 0x46,
+	0x5e, 0x18, 0x0f, 0xe2, 0xa1, 0x67, 0x70, 0x33, 0x4f, 0xdc, 0xe1, 0xde, 0xe7, 0x57, 0x65, 0xa1,
+	0xa9, 0x3e, 0x5c, 0x93, 0xe0, 0xd3, 0x6a, 0xa1, 0xba, 0x9b, 0x1b, 0x85, 0x30, 0x78, 0x08, 0x3c,
+	0xfa, 0x06, 0x94, 0xa9, 0xdd, 0xa9, 0xc2, 0xf0, 0xf5, 0x3c, 0xb4, 0x3b, 0x87, 0xc4, 0xd3, 0xa6,
+	0x43, 0x05, 0xe5, 0x87, 0x76, 0x07, 0x73, 0x99, 0xe5, 0x77, 0x60, 0x2e, 0x7b, 0xe2, 0xe8, 0x06,
+	0x94, 0x4f, 0x69, 0x57, 0xdc, 0x74, 0x19, 0xf3, 0x4f, 0xb4, 0x04, 0x95, 0x0e, 0x31, 0xdb, 0x54,
+	0xdc, 0x5f, 0x19, 0x07, 0x83, 0xb7, 0x4b, 0xf7, 0xa5, 0xe5, 0x0d, 0x58, 0x2c, 0x38, 0x95, 0xcb,
+	0x40, 0x28, 0x1f, 0x48, 0x30, 0x1b, 0x9f, 0xf6, 0x63, 0x6a, 0x5a, 0xa8, 0x0b, 0xb3, 0x8e, 0x6e,
+	0x60, 0xea, 0x3a, 0xbe, 0xc1, 0xa3, 0x40, 0x18, 0xe2, 0xdf, 0xb9, 0xa0, 0x4b, 0x45, 0x4b, 0xde,
+	0xdd, 0x7c, 0x92, 0x60, 0x68, 0x6f, 0x84, 0x2b, 0x9f, 0xcd, 0x90, 0x71, 0x56, 0x93, 0xf2, 0xd7,
+	0xb4, 0x31, 0xdb, 0x86, 0xcf, 0xd0, 0x0f, 0x06, 0x72, 0xb9, 0x7a, 0xb1, 0x5c, 0xce, 0xa5, 0x45,
+	0x26, 0xbf, 0x11, 0x6a, 0x9e, 0x8a, 0x28, 0xa9, 0x3c, 0x6e, 0x41, 0xc5, 0x60, 0xd4, 0xf2, 0xab,
+	0x25, 0x71, 0x74, 0x8f, 0x47, 0x75, 0x6b, 0xb4, 0xd9, 0x50, 0x69, 0xe5, 0x09, 0x87, 0xc7, 0x81,
+	0x16, 0xe5, 0xef, 0x65, 0xb8, 0x95, 0xa4, 0x5e, 0x6a, 0x1e, 0xa7, 0x22, 0xfd, 0x6f, 0x24, 0x58,
+	0x6c, 0x0d, 0xde, 0xba, 0x4f, 0x31, 0xbf, 0x7e, 0x26, 0xb4, 0xb1, 0x28, 0xf1, 0xe0, 0x22, 0x5b,
+	0x78, 0xd9, 0x73, 0x42, 0x4d, 0x6b, 0xd4, 0x65, 0x0f, 0xf7, 0xba, 0xa4, 0xec, 0xe1, 0x23, 0x2c,
+	0xf4, 0xf0, 0x3c, 0x26, 0x62, 0xfa, 0x21, 0xd5, 0x99, 0xe3, 0xed, 0x76, 0xa8, 0xf7, 0xcc, 0x33,
+	0x58, 0x94, 0x7b, 0x44, 0x1e, 0x7b, 0x52, 0x30, 0x8f, 0x0b, 0xa5, 0x50, 0x0b, 0xee, 0xe8, 0x8e,
+	0xe5, 0x3a, 0x36, 0xb5, 0x59, 0x91, 0x98, 0xc8, 0x4b, 0xb2, 0xf6, 0xb9, 0x7e, 0xaf, 0x7e, 0x67,
+	0xf3, 0x3c, 0x46, 0x7c, 0x3e, 0x8e, 0xf2, 0x8f, 0x52, 0xca, 0x8b, 0x79, 0x15, 0x87, 0x3e, 0x28,
+	0xaa, 0x99, 0x0e, 0x47, 0x56, 0xc4, 0x65, 0x3c, 0x29, 0x29, 0x5d, 0xaf, 0xb7, 0x76, 0xf2, 0x61,
+	0xf1, 0xb4, 0x7d, 0x44, 0x83, 0x51, 0x83, 0xea, 0x1e, 0x65, 0x98, 0x1e, 0x57, 0xcb, 0xc3, 0x33,
+	0xf0, 0xb6, 0xa3, 0x13, 0x33, 0xa8, 0xaf, 0x31, 0x3d, 0xa6, 0x1e, 0xb5, 0x75, 0xaa, 0xdd, 0xe2,
+	0x1e, 0xf9, 0xed, 0x41, 0x20, 0x5c, 0x84, 0xae, 0xbc, 0x94, 0x52, 0x05, 0x6a, 0x50, 0xbc, 0xa2,
+	0xf7, 0x00, 0x74, 0xc7, 0x0e, 0x02, 0xb5, 0x5f, 0x95, 0xc4, 0xcd, 0x5e, 0xbf, 0x5c, 0xf0, 0x12,
+	0x7d, 0x95, 0xba, 0x19, 0xa1, 0x24, 0x5b, 0x1a, 0x93, 0x7c, 0x9c, 0x52, 0x82, 0xde, 0x05, 0xe4,
+	0x1c, 0xf1, 0x92, 0x87, 0x36, 0x1f, 0x05, 0xad, 0x89, 0xe1, 0xd8, 0x62, 0x7b, 0xcb, 0xda, 0x72,
+	0x28, 0x8b, 0x76, 0x07, 0x38, 0x70, 0x81, 0x94, 0xf2, 0x5b, 0x09, 0x82, 0x82, 0x06, 0xa9, 0x00,
+	0x5e, 0x36, 0x0a, 0xcb, 0x41, 0x51, 0x9c, 0x0a, 0xa0, 0x29, 0x0e, 0x74, 0x1b, 0xca, 0x8c, 0x04,
+	0xa7, 0x2a, 0x6b, 0x93, 0x3c, 0xcd, 0xec, 0x93, 0x16, 0xe6, 0x34, 0xb4, 0x0b, 0xe0, 0xb6, 0x4d,
+	0x73, 0xcf, 0x31, 0x0d, 0xbd, 0x1b, 0xde, 0x9f, 0x35, 0x0e, 0xb5, 0x17, 0x53, 0xcf, 0x7a, 0xf5,
+	0x3b, 0x83, 0x9d, 0xa0, 0x9a, 0x30, 0xe0, 0x14, 0x84, 0xf2, 0x97, 0x12, 0x4c, 0xef, 0x08, 0xa7,
+	0x6c, 0x36, 0x28, 0x6d, 0x5e, 0x43, 0xcf, 0xe5, 0x66, 0x7a, 0xae, 0xab, 0x76, 0x40, 0x29, 0xdb,
+	0x87, 0x76, 0x5d, 0xcf, 0x73, 0x5d, 0xd7, 0xde, 0x08, 0x75, 0x9e, 0xdf, 0x77, 0x7d, 0x24, 0xc1,
+	0x7c, 0x8a, 0xfb, 0x1a, 0x32, 0xa1, 0x93, 0xcd, 0x84, 0xef, 0x8e, 0x6e, 0xa9, 0xc3, 0x72, 0x61,
+	0x09, 0xe6, 0xd2, 0x1b, 0x72, 0x2d, 0x7d, 0xbb, 0x9f, 0xf1, 0xa1, 0xef, 0x8c, 0xf0, 0x3c, 0xcf,
+	0x69, 0xde, 0x7f, 0x92, 0x73, 0xa3, 0xc6, 0x68, 0xd5, 0x9e, 0xef, 0x49, 0x7f, 0x28, 0xc1, 0xed,
+	0xac, 0xc0, 0x46, 0x9b, 0x39, 0xbe, 0x4e, 0x4c, 0xc3, 0x6e, 0xa1, 0x15, 0x98, 0xb6, 0x0c, 0x3b,
+	0xec, 0x24, 0xfd, 0xa0, 0xbb, 0xc4, 0x69, 0x92, 0xe0, 0x20, 0xcf, 0x63, 0x8e, 0x52, 0xc8, 0x91,
+	0x90, 0xd0, 0x03, 0x58, 0xe6, 0x70, 0xf4, 0xc0, 0xdd, 0x3f, 0xf1, 0xa8, 0x7f, 0xe2, 0x98, 0xcd,
+	0x3d, 0xea, 0xe9, 0xd4, 0x66, 0xbc, 0xd7, 0x2b, 0x0b, 0x81, 0x73, 0x38, 0x90, 0x06, 0x9f, 0x15,
+	0xb3, 0x5b, 0xce, 0x33, 0xbb, 0x08, 0x61, 0x5c, 0x20, 0x9c, 0xcb, 0x83, 0x0e, 0x61, 0x2e, 0x9e,
+	0xdf, 0xa2, 0x26, 0xe9, 0x56, 0x2b, 0x97, 0xb9, 0x21, 0x5b, 0xed, 0x30, 0x1e, 0xe7, 0x50, 0x94,
+	0x8f, 0x25, 0x40, 0xd9, 0xdd, 0xbb, 0x86, 0xab, 0xe8, 0x65, 0xaf, 0xe2, 0xce, 0x48, 0xdd, 0x65,
+	0xc8, 0x6d, 0x3c, 0xab, 0xe4, 0x17, 0x2a, 0xea, 0x96, 0x55, 0x98, 0xf2, 0x32, 0xce, 0xa1, 0xcd,
+	0x70, 0xa3, 0xa3, 0xb3, 0xc7, 0xf1, 0x2c, 0x22, 0x30, 0xe5, 0x53, 0x53, 0x14, 0x42, 0xe1, 0xed,
+	0xfa, 0xda, 0x05, 0xb7, 0x84, 0xb7, 0x3e, 0x8d, 0x50, 0x34, 0xd9, 0x97, 0x88, 0x82, 0x63, 0x58,
+	0xf4, 0xbe, 0x04, 0x53, 0x8c, 0x5a, 0xae, 0x49, 0xc2, 0x12, 0xf0, 0xea, 0x65, 0x71, 0x6a, 0xc9,
+	0xfb, 0x21, 0x72, 0x62, 0x42, 0x44, 0xc1, 0xb1, 0x56, 0xf4, 0x53, 0x98, 0xf5, 0x4f, 0x1c, 0x87,
+	0x45, 0x53, 0xe1, 0x53, 0xc6, 0xc6, 0xeb, 0x54, 0x17, 0x8d, 0x34, 0x50, 0xd2, 0x1f, 0x65, 0xc8,
+	0x38, 0xab, 0x0e, 0xfd, 0x42, 0x82, 0xb9, 0xb6, 0xdb, 0x24, 0x8c, 0x36, 0x98, 0x47, 0x18, 0x6d,
+	0x45, 0x8e, 0x7e, 0x55, 0x27, 0x39, 0xc8, 0x80, 0x6a, 0x88, 0xb7, 0xf4, 0x59, 0x1a, 0xce, 0x29,
+	0x1e, 0xfa, 0xc8, 0x34, 0xf1, 0x5a, 0x8f, 0x4c, 0x3f, 0x86, 0x69, 0x92, 0x04, 0xa6, 0xea, 0xa4,
+	0x58, 0xd5, 0xf7, 0x46, 0xea, 0xfa, 0xa9, 0xc0, 0x87, 0xd3, 0xca, 0x94, 0xdf, 0x4f, 0xc2, 0x52,
+	0x51, 0x50, 0x1d, 0x52, 0xd6, 0x49, 0xaf, 0x53, 0xd6, 0xa1, 0xaf, 0xa4, 0xae, 0x52, 0xf0, 0x0e,
+	0x17, 0x3b, 0x5a, 0xc1, 0x75, 0xfa, 0x26, 0xcc, 0x7a, 0x94, 0x34, 0xbb, 0x71, 0xe0, 0x15, 0x71,
+	0x34, 0xf1, 0x12, 0x9c, 0x9e, 0xc4, 0x59, 0x5e, 0xf4, 0x08, 0x16, 0x6c, 0xfa, 0x9c, 0x85, 0xe3,
+	0xa7, 0x6d, 0xeb, 0x88, 0x7a, 0x41, 0x18, 0x4d, 0x1e, 0x54, 0x9e, 0xe6, 0x19, 0xf0, 0xa0, 0x0c,
+	0xda, 0x80, 0x79, 0xbd, 0xed, 0x89, 0x17, 0xcb, 0xc8, 0x8e, 0x8a, 0x80, 0xb9, 0x15, 0xc2, 0xcc,
+	0x6f, 0x66, 0xa7, 0x71, 0x9e, 0x9f, 0x43, 0x04, 0x7e, 0xd3, 0x8c, 0x21, 0x26, 0xb2, 0x10, 0x07,
+	0xd9, 0x69, 0x9c, 0xe7, 0xcf, 0x58, 0x11, 0x78, 0x8e, 0x70, 0x0f, 0xb9, 0xc0, 0x8a, 0x60, 0x1a,
+	0xe7, 0xf9, 0xd1, 0x83, 0xe8, 0xda, 0xc4, 0x08, 0x53, 0xc1, 0xf3, 0x65, 0xf4, 0x7c, 0x75, 0x90,
+	0x99, 0xc5, 0x39, 0x6e, 0xf4, 0x36, 0xcc, 0xe9, 0x8e, 0x69, 0x8a, 0x41, 0xf0, 0x10, 0x2b, 0x8b,
+	0x45, 0x88, 0x7b, 0xb2, 0x99, 0x99, 0xc1, 0x39, 0xce, 0x5c, 0x3b, 0x02, 0xd7, 0xd1, 0x8e, 0xf0,
+	0x30, 0xe1, 0x52, 0xbb, 0xc9, 0x3d, 0x3d, 0xd8, 0xc5, 0xea, 0xf4, 0x48, 0xc2, 0xc4, 0x5e, 0x06,
+	0x34, 0x58, 0x7e, 0x96, 0x86, 0x73, 0x8a, 0xd1, 0x1e, 0xcc, 0x9a, 0xc4, 0x67, 0x0d, 0x9e, 0x58,
+	0xf7, 0x0d, 0x8b, 0x56, 0x67, 0x84, 0x25, 0x5f, 0xba, 0x58, 0x76, 0xe0, 0x12, 0x38, 0x0b, 0xa0,
+	0xfc, 0x27, 0x5b, 0x1c, 0x8b, 0x44, 0x45, 0xa1, 0x22, 0x22, 0x65, 0x55, 0x1a, 0xc9, 0xdb, 0xb2,
+	0x08, 0xc2, 0xc1, 0xdb, 0xb2, 0xf8, 0xc4, 0x01, 0x3a, 0xfa, 0x19, 0xc8, 0xf1, 0xbb, 0xc8, 0xa8,
+	0x7f, 0x8a, 0x09, 0xda, 0xe9, 0xe4, 0xc1, 0x37, 0x9e, 0xc0, 0x89, 0x4e, 0xe5, 0x8f, 0x12, 0x2c,
+	0x0c, 0xb4, 0x11, 0xff, 0xeb, 0x1d, 0xef, 0x3f, 0x25, 0x58, 0x2c, 0xc8, 0xc4, 0xff, 0x8f, 0x3d,
+	0xa5, 0xf2, 0x2f, 0x09, 0x72, 0x37, 0x06, 0xad, 0xc0, 0xb8, 0xcd, 0x7f, 0x51, 0x09, 0x1a, 0xfc,
+	0x58, 0x48, 0xfc, 0x8e, 0x22, 0x66, 0xd0, 0x03, 0x98, 0xf0, 0x28, 0xf1, 0xc3, 0x0d, 0x96, 0xb5,
+	0x2f, 0x44, 0xc5, 0x3e, 0x16, 0xd4, 0xb3, 0x5e, 0x7d, 0x29, 0x77, 0x0b, 0x05, 0x1d, 0x87, 0x52,
+	0x68, 0x17, 0x2a, 0xbe, 0x61, 0xeb, 0x51, 0xd5, 0x74, 0x89, 0xbb, 0x97, 0x94, 0x8b, 0x0d, 0x0e,
+	0x80, 0x03, 0x1c, 0xf4, 0x79, 0x98, 0xf4, 0x28, 0xf3, 0x0c, 0xea, 0x87, 0x79, 0x65, 0xba, 0xdf,
+	0xab, 0x4f, 0xe2, 0x80, 0x84, 0xa3, 0x39, 0x65, 0x0b, 0xde, 0xc0, 0x3c, 0x18, 0xda, 0xad, 0x6c,
+	0x2d, 0x81, 0xbe, 0x0c, 0xb2, 0x4b, 0x3c, 0x66, 0xc4, 0xf9, 0xb4, 0x12, 0xfc, 0xf2, 0xb2, 0x17,
+	0x11, 0x71, 0x32, 0xaf, 0x7c, 0x11, 0x82, 0x4b, 0xf8, 0xc9, 0x1b, 0xa5, 0xfc, 0x49, 0x82, 0x5c,
+	0xd9, 0x82, 0xee, 0xc1, 0x38, 0xeb, 0xba, 0x91, 0x50, 0x8d, 0x0b, 0xec, 0x77, 0x5d, 0x7a, 0xd6,
+	0xab, 0xa3, 0x2c, 0x27, 0xa7, 0x62, 0xc1, 0x8b, 0x7e, 0x29, 0xc1, 0xac, 0x97, 0x36, 0x3c, 0x74,
+	0x90, 0xfd, 0x2b, 0x3a, 0x48, 0xe1, 0x66, 0x68, 0x0b, 0x22, 0xa1, 0xa7, 0xa7, 0x70, 0x56, 0xbb,
+	0xa6, 0xbf, 0x78, 0x55, 0x1b, 0x7b, 0xf9, 0xaa, 0x36, 0xf6, 0xe1, 0xab, 0xda, 0xd8, 0xfb, 0xfd,
+	0x9a, 0xf4, 0xa2, 0x5f, 0x93, 0x5e, 0xf6, 0x6b, 0xd2, 0x87, 0xfd, 0x9a, 0xf4, 0x51, 0xbf, 0x26,
+	0xfd, 0xea, 0x6f, 0xb5, 0xb1, 0xef, 0xaf, 0x5f, 0xe9, 0x7f, 0x10, 0xff, 0x1d, 0x00, 0xf6, 0xb1,
+	0xb4, 0x6b, 0x47, 0x21, 0x00, 0x00,
 }
 
 func (m *Gardenlet) Marshal() (dAtA []byte, err error) {
@@ -1509,6 +1548,59 @@ func (m *ManagedSeedSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ManagedSeedSetAutoscaling) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManagedSeedSetAutoscaling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManagedSeedSetAutoscaling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScaleDownDelay != nil {
+		{
+			size, err := m.ScaleDownDelay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ScaleDownThresholdPercentage != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ScaleDownThresholdPercentage))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ScaleUpThresholdPercentage != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ScaleUpThresholdPercentage))
+		i--
+		dAtA[i] = 0x18
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxReplicas))
+	i--
+	dAtA[i] = 0x10
+	if m.MinReplicas != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MinReplicas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ManagedSeedSetList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1576,6 +1668,18 @@ func (m *ManagedSeedSetSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Autoscaling != nil {
+		{
+			size, err := m.Autoscaling.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.RevisionHistoryLimit != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.RevisionHistoryLimit))
 		i--
@@ -1651,6 +1755,18 @@ func (m *ManagedSeedSetStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastScaleTime != nil {
+		{
+			size, err := m.LastScaleTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.PendingReplica != nil {
 		{
 			size, err := m.PendingReplica.MarshalToSizedBuffer(dAtA[:i])
@@ -2246,6 +2362,29 @@ func (m *ManagedSeedSet) Size() (n int) {
 	return n
 }
 
+func (m *ManagedSeedSetAutoscaling) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinReplicas != nil {
+		n += 1 + sovGenerated(uint64(*m.MinReplicas))
+	}
+	n += 1 + sovGenerated(uint64(m.MaxReplicas))
+	if m.ScaleUpThresholdPercentage != nil {
+		n += 1 + sovGenerated(uint64(*m.ScaleUpThresholdPercentage))
+	}
+	if m.ScaleDownThresholdPercentage != nil {
+		n += 1 + sovGenerated(uint64(*m.ScaleDownThresholdPercentage))
+	}
+	if m.ScaleDownDelay != nil {
+		l = m.ScaleDownDelay.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ManagedSeedSetList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2285,6 +2424,10 @@ func (m *ManagedSeedSetSpec) Size() (n int) {
 	if m.RevisionHistoryLimit != nil {
 		n += 1 + sovGenerated(uint64(*m.RevisionHistoryLimit))
 	}
+	if m.Autoscaling != nil {
+		l = m.Autoscaling.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -2317,6 +2460,10 @@ func (m *ManagedSeedSetStatus) Size() (n int) {
 		l = m.PendingReplica.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.LastScaleTime != nil {
+		l = m.LastScaleTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -2626,6 +2773,20 @@ func (this *ManagedSeedSet) String() string {
 	}, "")
 	return s
 }
+func (this *ManagedSeedSetAutoscaling) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ManagedSeedSetAutoscaling{`,
+		`MinReplicas:` + valueToStringGenerated(this.MinReplicas) + `,`,
+		`MaxReplicas:` + fmt.Sprintf("%v", this.MaxReplicas) + `,`,
+		`ScaleUpThresholdPercentage:` + valueToStringGenerated(this.ScaleUpThresholdPercentage) + `,`,
+		`ScaleDownThresholdPercentage:` + valueToStringGenerated(this.ScaleDownThresholdPercentage) + `,`,
+		`ScaleDownDelay:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownDelay), "Duration", "v1.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ManagedSeedSetList) String() string {
 	if this == nil {
 		return "nil"
@@ -2653,6 +2814,7 @@ func (this *ManagedSeedSetSpec) String() string {
 		`ShootTemplate:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ShootTemplate), "ShootTemplate", "v1beta1.ShootTemplate", 1), `&`, ``, 1) + `,`,
 		`UpdateStrategy:` + strings.Replace(this.UpdateStrategy.String(), "UpdateStrategy", "UpdateStrategy", 1) + `,`,
 		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
+		`Autoscaling:` + strings.Replace(this.Autoscaling.String(), "ManagedSeedSetAutoscaling", "ManagedSeedSetAutoscaling", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2678,6 +2840,7 @@ func (this *ManagedSeedSetStatus) String() string {
 		`CollisionCount:` + valueToStringGenerated(this.CollisionCount) + `,`,
 		`Conditions:` + repeatedStringForConditions + `,`,
 		`PendingReplica:` + strings.Replace(this.PendingReplica.String(), "PendingReplica", "PendingReplica", 1) + `,`,
+		`LastScaleTime:` + strings.Replace(fmt.Sprintf("%v", this.LastScaleTime), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4846,6 +5009,171 @@ func (m *ManagedSeedSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ManagedSeedSetAutoscaling) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManagedSeedSetAutoscaling: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManagedSeedSetAutoscaling: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReplicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinReplicas = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReplicas", wireType)
+			}
+			m.MaxReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleUpThresholdPercentage", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ScaleUpThresholdPercentage = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownThresholdPercentage", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ScaleDownThresholdPercentage = &v
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScaleDownDelay == nil {
+				m.ScaleDownDelay = &v1.Duration{}
+			}
+			if err := m.ScaleDownDelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ManagedSeedSetList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5167,6 +5495,42 @@ func (m *ManagedSeedSetSpec) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RevisionHistoryLimit = &v
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Autoscaling == nil {
+				m.Autoscaling = &ManagedSeedSetAutoscaling{}
+			}
+			if err := m.Autoscaling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -5485,6 +5849,42 @@ func (m *ManagedSeedSetStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScaleTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastScaleTime == nil {
+				m.LastScaleTime = &v1.Time{}
+			}
+			if err := m.LastScaleTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -64,6 +64,33 @@ type ManagedSeedSetSpec struct {
 	// in the ManagedSeedSet's revision history. Defaults to 10. This field is immutable.
 	// +optional
 	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty" protobuf:"varint,6,opt,name=revisionHistoryLimit"`
+	// Autoscaling configures the automatic scaling of the number of replicas based on the utilization of their seeds.
+	// If set, Replicas is managed by the controller and kept between MinReplicas and MaxReplicas.
+	// +optional
+	Autoscaling *ManagedSeedSetAutoscaling `json:"autoscaling,omitempty" protobuf:"bytes,7,opt,name=autoscaling"`
+}
+
+// ManagedSeedSetAutoscaling contains the configuration for automatically scaling the number of replicas of a
+// ManagedSeedSet. The utilization of the replicas is the ratio of the number of shoots scheduled on their seeds and the
+// number of shoots allocatable by their seeds. Seeds which do not limit the number of allocatable shoots are not taken
+// into account.
+type ManagedSeedSetAutoscaling struct {
+	// MinReplicas is the lower limit for the number of replicas. Defaults to 1.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty" protobuf:"varint,1,opt,name=minReplicas"`
+	// MaxReplicas is the upper limit for the number of replicas.
+	MaxReplicas int32 `json:"maxReplicas" protobuf:"varint,2,opt,name=maxReplicas"`
+	// ScaleUpThresholdPercentage is the utilization in percent above which a replica is added. Defaults to 80.
+	// +optional
+	ScaleUpThresholdPercentage *int32 `json:"scaleUpThresholdPercentage,omitempty" protobuf:"varint,3,opt,name=scaleUpThresholdPercentage"`
+	// ScaleDownThresholdPercentage is the utilization in percent which must not be exceeded by the remaining replicas
+	// after removing a replica. The shoots scheduled on the seed of the removed replica are migrated to the seeds of
+	// the remaining replicas. Defaults to 50.
+	// +optional
+	ScaleDownThresholdPercentage *int32 `json:"scaleDownThresholdPercentage,omitempty" protobuf:"varint,4,opt,name=scaleDownThresholdPercentage"`
+	// ScaleDownDelay is the minimum duration after the last scaling operation before a replica is removed. Defaults to 1h.
+	// +optional
+	ScaleDownDelay *metav1.Duration `json:"scaleDownDelay,omitempty" protobuf:"bytes,5,opt,name=scaleDownDelay"`
 }
 
 // UpdateStrategy specifies the strategy that the ManagedSeedSet
@@ -133,6 +160,9 @@ type ManagedSeedSetStatus struct {
 	// This replica is in a state that requires the controller to wait for it to change before advancing to the next replica.
 	// +optional
 	PendingReplica *PendingReplica `json:"pendingReplica,omitempty" protobuf:"bytes,11,opt,name=pendingReplica"`
+	// LastScaleTime is the last time the number of replicas was changed due to autoscaling.
+	// +optional
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty" protobuf:"bytes,12,opt,name=lastScaleTime"`
 }
 
 // PendingReplicaReason is a string enumeration type that enumerates all possible reasons for a replica to be pending.
@@ -155,6 +185,9 @@ const (
 	SeedNotReadyReason PendingReplicaReason = "SeedNotReady"
 	// ShootNotHealthyReason indicates that the replica's shoot is not healthy.
 	ShootNotHealthyReason PendingReplicaReason = "ShootNotHealthy"
+	// SeedDrainingReason indicates that the shoots scheduled on the replica's seed are migrated to other seeds before
+	// the replica is deleted.
+	SeedDrainingReason PendingReplicaReason = "SeedDraining"
 )
 
 // PendingReplica contains information about a replica that is currently pending creation, update, or deletion.
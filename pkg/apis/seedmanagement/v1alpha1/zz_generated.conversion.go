@@ -17,6 +17,7 @@ import (
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	seedmanagement "github.com/gardener/gardener/pkg/apis/seedmanagement"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -128,6 +129,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ManagedSeedSetAutoscaling)(nil), (*seedmanagement.ManagedSeedSetAutoscaling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ManagedSeedSetAutoscaling_To_seedmanagement_ManagedSeedSetAutoscaling(a.(*ManagedSeedSetAutoscaling), b.(*seedmanagement.ManagedSeedSetAutoscaling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*seedmanagement.ManagedSeedSetAutoscaling)(nil), (*ManagedSeedSetAutoscaling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_seedmanagement_ManagedSeedSetAutoscaling_To_v1alpha1_ManagedSeedSetAutoscaling(a.(*seedmanagement.ManagedSeedSetAutoscaling), b.(*ManagedSeedSetAutoscaling), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ManagedSeedSetList)(nil), (*seedmanagement.ManagedSeedSetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ManagedSeedSetList_To_seedmanagement_ManagedSeedSetList(a.(*ManagedSeedSetList), b.(*seedmanagement.ManagedSeedSetList), scope)
 	}); err != nil {
@@ -645,6 +656,34 @@ func Convert_seedmanagement_ManagedSeedSet_To_v1alpha1_ManagedSeedSet(in *seedma
 	return autoConvert_seedmanagement_ManagedSeedSet_To_v1alpha1_ManagedSeedSet(in, out, s)
 }
 
+func autoConvert_v1alpha1_ManagedSeedSetAutoscaling_To_seedmanagement_ManagedSeedSetAutoscaling(in *ManagedSeedSetAutoscaling, out *seedmanagement.ManagedSeedSetAutoscaling, s conversion.Scope) error {
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = in.MaxReplicas
+	out.ScaleUpThresholdPercentage = (*int32)(unsafe.Pointer(in.ScaleUpThresholdPercentage))
+	out.ScaleDownThresholdPercentage = (*int32)(unsafe.Pointer(in.ScaleDownThresholdPercentage))
+	out.ScaleDownDelay = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelay))
+	return nil
+}
+
+// Convert_v1alpha1_ManagedSeedSetAutoscaling_To_seedmanagement_ManagedSeedSetAutoscaling is an autogenerated conversion function.
+func Convert_v1alpha1_ManagedSeedSetAutoscaling_To_seedmanagement_ManagedSeedSetAutoscaling(in *ManagedSeedSetAutoscaling, out *seedmanagement.ManagedSeedSetAutoscaling, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ManagedSeedSetAutoscaling_To_seedmanagement_ManagedSeedSetAutoscaling(in, out, s)
+}
+
+func autoConvert_seedmanagement_ManagedSeedSetAutoscaling_To_v1alpha1_ManagedSeedSetAutoscaling(in *seedmanagement.ManagedSeedSetAutoscaling, out *ManagedSeedSetAutoscaling, s conversion.Scope) error {
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = in.MaxReplicas
+	out.ScaleUpThresholdPercentage = (*int32)(unsafe.Pointer(in.ScaleUpThresholdPercentage))
+	out.ScaleDownThresholdPercentage = (*int32)(unsafe.Pointer(in.ScaleDownThresholdPercentage))
+	out.ScaleDownDelay = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelay))
+	return nil
+}
+
+// Convert_seedmanagement_ManagedSeedSetAutoscaling_To_v1alpha1_ManagedSeedSetAutoscaling is an autogenerated conversion function.
+func Convert_seedmanagement_ManagedSeedSetAutoscaling_To_v1alpha1_ManagedSeedSetAutoscaling(in *seedmanagement.ManagedSeedSetAutoscaling, out *ManagedSeedSetAutoscaling, s conversion.Scope) error {
+	return autoConvert_seedmanagement_ManagedSeedSetAutoscaling_To_v1alpha1_ManagedSeedSetAutoscaling(in, out, s)
+}
+
 func autoConvert_v1alpha1_ManagedSeedSetList_To_seedmanagement_ManagedSeedSetList(in *ManagedSeedSetList, out *seedmanagement.ManagedSeedSetList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -698,6 +737,7 @@ func autoConvert_v1alpha1_ManagedSeedSetSpec_To_seedmanagement_ManagedSeedSetSpe
 	}
 	out.UpdateStrategy = (*seedmanagement.UpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.Autoscaling = (*seedmanagement.ManagedSeedSetAutoscaling)(unsafe.Pointer(in.Autoscaling))
 	return nil
 }
 
@@ -717,6 +757,7 @@ func autoConvert_seedmanagement_ManagedSeedSetSpec_To_v1alpha1_ManagedSeedSetSpe
 	}
 	out.UpdateStrategy = (*UpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.Autoscaling = (*ManagedSeedSetAutoscaling)(unsafe.Pointer(in.Autoscaling))
 	return nil
 }
 
@@ -737,6 +778,7 @@ func autoConvert_v1alpha1_ManagedSeedSetStatus_To_seedmanagement_ManagedSeedSetS
 	out.CollisionCount = (*int32)(unsafe.Pointer(in.CollisionCount))
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
 	out.PendingReplica = (*seedmanagement.PendingReplica)(unsafe.Pointer(in.PendingReplica))
+	out.LastScaleTime = (*metav1.Time)(unsafe.Pointer(in.LastScaleTime))
 	return nil
 }
 
@@ -757,6 +799,7 @@ func autoConvert_seedmanagement_ManagedSeedSetStatus_To_v1alpha1_ManagedSeedSetS
 	out.CollisionCount = (*int32)(unsafe.Pointer(in.CollisionCount))
 	out.Conditions = *(*[]v1beta1.Condition)(unsafe.Pointer(&in.Conditions))
 	out.PendingReplica = (*PendingReplica)(unsafe.Pointer(in.PendingReplica))
+	out.LastScaleTime = (*metav1.Time)(unsafe.Pointer(in.LastScaleTime))
 	return nil
 }
 
@@ -241,6 +241,9 @@ func SetObjectDefaults_ManagedSeedSet(in *ManagedSeedSet) {
 			SetDefaults_RollingUpdateStrategy(in.Spec.UpdateStrategy.RollingUpdate)
 		}
 	}
+	if in.Spec.Autoscaling != nil {
+		SetDefaults_ManagedSeedSetAutoscaling(in.Spec.Autoscaling)
+	}
 }
 
 func SetObjectDefaults_ManagedSeedSetList(in *ManagedSeedSetList) {
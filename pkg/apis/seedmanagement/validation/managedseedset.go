@@ -104,6 +104,45 @@ func ValidateManagedSeedSetSpec(spec *seedmanagement.ManagedSeedSetSpec, fldPath
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*spec.RevisionHistoryLimit), fldPath.Child("revisionHistoryLimit"))...)
 	}
 
+	if spec.Autoscaling != nil {
+		allErrs = append(allErrs, validateAutoscaling(spec.Autoscaling, fldPath.Child("autoscaling"))...)
+	}
+
+	return allErrs
+}
+
+func validateAutoscaling(autoscaling *seedmanagement.ManagedSeedSetAutoscaling, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// Ensure there is at least one replica which the shoots of drained seeds can be migrated to
+	minReplicas := ptr.Deref(autoscaling.MinReplicas, 1)
+	if minReplicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), minReplicas, "must be at least 1"))
+	}
+	if autoscaling.MaxReplicas < minReplicas {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), autoscaling.MaxReplicas, "must not be less than minReplicas"))
+	}
+
+	for _, threshold := range []struct {
+		value *int32
+		path  *field.Path
+	}{
+		{autoscaling.ScaleUpThresholdPercentage, fldPath.Child("scaleUpThresholdPercentage")},
+		{autoscaling.ScaleDownThresholdPercentage, fldPath.Child("scaleDownThresholdPercentage")},
+	} {
+		if threshold.value != nil && (*threshold.value < 1 || *threshold.value > 100) {
+			allErrs = append(allErrs, field.Invalid(threshold.path, *threshold.value, "must be between 1 and 100"))
+		}
+	}
+	if autoscaling.ScaleUpThresholdPercentage != nil && autoscaling.ScaleDownThresholdPercentage != nil &&
+		*autoscaling.ScaleDownThresholdPercentage >= *autoscaling.ScaleUpThresholdPercentage {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownThresholdPercentage"), *autoscaling.ScaleDownThresholdPercentage, "must be less than scaleUpThresholdPercentage"))
+	}
+
+	if autoscaling.ScaleDownDelay != nil && autoscaling.ScaleDownDelay.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownDelay"), autoscaling.ScaleDownDelay.Duration.String(), "must not be negative"))
+	}
+
 	return allErrs
 }
 
@@ -265,6 +304,7 @@ func validatePendingReplica(pendingReplica *seedmanagement.PendingReplica, name
 		string(seedmanagement.ManagedSeedDeletingReason),
 		string(seedmanagement.SeedNotReadyReason),
 		string(seedmanagement.ShootNotHealthyReason),
+		string(seedmanagement.SeedDrainingReason),
 	}
 	if !slices.Contains(validValues, string(pendingReplica.Reason)) {
 		allErrs = append(allErrs, field.NotSupported(fldPath.Child("reason"), pendingReplica.Reason, validValues))
@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -194,6 +196,71 @@ var _ = Describe("ManagedSeedSet Validation Tests", func() {
 			))
 		})
 
+		It("should allow valid autoscaling settings", func() {
+			managedSeedSet.Spec.Autoscaling = &seedmanagement.ManagedSeedSetAutoscaling{
+				MinReplicas:                  ptr.To[int32](1),
+				MaxReplicas:                  3,
+				ScaleUpThresholdPercentage:   ptr.To[int32](80),
+				ScaleDownThresholdPercentage: ptr.To[int32](50),
+				ScaleDownDelay:               &metav1.Duration{Duration: time.Hour},
+			}
+
+			Expect(ValidateManagedSeedSet(managedSeedSet)).To(BeEmpty())
+		})
+
+		It("should forbid invalid autoscaling settings", func() {
+			managedSeedSet.Spec.Autoscaling = &seedmanagement.ManagedSeedSetAutoscaling{
+				MinReplicas:                  ptr.To[int32](0),
+				MaxReplicas:                  -1,
+				ScaleUpThresholdPercentage:   ptr.To[int32](101),
+				ScaleDownThresholdPercentage: ptr.To[int32](0),
+				ScaleDownDelay:               &metav1.Duration{Duration: -time.Minute},
+			}
+
+			errorList := ValidateManagedSeedSet(managedSeedSet)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.autoscaling.minReplicas"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.autoscaling.maxReplicas"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.autoscaling.scaleUpThresholdPercentage"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.autoscaling.scaleDownThresholdPercentage"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.autoscaling.scaleDownDelay"),
+				})),
+			))
+		})
+
+		It("should forbid a scale down threshold which is not less than the scale up threshold", func() {
+			managedSeedSet.Spec.Autoscaling = &seedmanagement.ManagedSeedSetAutoscaling{
+				MaxReplicas:                  3,
+				ScaleUpThresholdPercentage:   ptr.To[int32](60),
+				ScaleDownThresholdPercentage: ptr.To[int32](60),
+			}
+
+			errorList := ValidateManagedSeedSet(managedSeedSet)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.autoscaling.scaleDownThresholdPercentage"),
+					"Detail": Equal("must be less than scaleUpThresholdPercentage"),
+				})),
+			))
+		})
+
 		It("should forbid empty selector", func() {
 			managedSeedSet.Spec.Selector = metav1.LabelSelector{}
 
@@ -12,6 +12,7 @@ package seedmanagement
 import (
 	core "github.com/gardener/gardener/pkg/apis/core"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -399,6 +400,42 @@ func (in *ManagedSeedSet) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedSeedSetAutoscaling) DeepCopyInto(out *ManagedSeedSetAutoscaling) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScaleUpThresholdPercentage != nil {
+		in, out := &in.ScaleUpThresholdPercentage, &out.ScaleUpThresholdPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScaleDownThresholdPercentage != nil {
+		in, out := &in.ScaleDownThresholdPercentage, &out.ScaleDownThresholdPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScaleDownDelay != nil {
+		in, out := &in.ScaleDownDelay, &out.ScaleDownDelay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedSeedSetAutoscaling.
+func (in *ManagedSeedSetAutoscaling) DeepCopy() *ManagedSeedSetAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedSeedSetAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedSeedSetList) DeepCopyInto(out *ManagedSeedSetList) {
 	*out = *in
@@ -453,6 +490,11 @@ func (in *ManagedSeedSetSpec) DeepCopyInto(out *ManagedSeedSetSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(ManagedSeedSetAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -486,6 +528,10 @@ func (in *ManagedSeedSetStatus) DeepCopyInto(out *ManagedSeedSetStatus) {
 		*out = new(PendingReplica)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
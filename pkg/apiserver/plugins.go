@@ -8,6 +8,7 @@ import (
 	"k8s.io/apiserver/pkg/admission"
 	"k8s.io/apiserver/pkg/admission/plugin/resourcequota"
 
+	accessrequestapproval "github.com/gardener/gardener/plugin/pkg/accessrequest/approval"
 	backupbucketvalidator "github.com/gardener/gardener/plugin/pkg/backupbucket/validator"
 	bastionvalidator "github.com/gardener/gardener/plugin/pkg/bastion/validator"
 	controllerregistrationresources "github.com/gardener/gardener/plugin/pkg/controllerregistration/resources"
@@ -60,6 +61,7 @@ func RegisterAllAdmissionPlugins(plugins *admission.Plugins) {
 	controllerregistrationresources.Register(plugins)
 	namespacedcloudprofilevalidator.Register(plugins)
 	projectmutator.Register(plugins)
+	accessrequestapproval.Register(plugins)
 	openidconnectpreset.Register(plugins)
 	clusteropenidconnectpreset.Register(plugins)
 	customverbauthorizer.Register(plugins)
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metatable "k8s.io/apimachinery/pkg/api/meta/table"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+var swaggerMetadataDescriptions = metav1.ObjectMeta{}.SwaggerDoc()
+
+type convertor struct {
+	headers []metav1beta1.TableColumnDefinition
+}
+
+func newTableConvertor() rest.TableConvertor {
+	return &convertor{
+		headers: []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["name"]},
+			{Name: "Subject", Type: "string", Description: "The subject which requests the membership."},
+			{Name: "Roles", Type: "string", Description: "The requested roles."},
+			{Name: "Duration", Type: "string", Description: "The duration of the membership."},
+			{Name: "Phase", Type: "string", Description: "The current phase of the request."},
+			{Name: "Expiration", Type: "date", Description: "The time at which the granted membership expires."},
+			{Name: "Age", Type: "date", Description: swaggerMetadataDescriptions["creationTimestamp"]},
+		},
+	}
+}
+
+// ConvertToTable converts the output to a table.
+func (c *convertor) ConvertToTable(_ context.Context, obj runtime.Object, _ runtime.Object) (*metav1beta1.Table, error) {
+	var (
+		err   error
+		table = &metav1beta1.Table{
+			ColumnDefinitions: c.headers,
+		}
+	)
+
+	if m, err := meta.ListAccessor(obj); err == nil {
+		table.ResourceVersion = m.GetResourceVersion()
+		table.Continue = m.GetContinue()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			table.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	table.Rows, err = metatable.MetaToTableRow(obj, func(obj runtime.Object, _ metav1.Object, _, _ string) ([]any, error) {
+		var (
+			accessRequest = obj.(*core.AccessRequest)
+			cells         = []any{}
+		)
+
+		cells = append(cells, accessRequest.Name)
+		cells = append(cells, accessRequest.Spec.Subject.Kind+"/"+accessRequest.Spec.Subject.Name)
+		cells = append(cells, strings.Join(accessRequest.Spec.Roles, ","))
+		cells = append(cells, accessRequest.Spec.Duration.Duration.String())
+		if phase := accessRequest.Status.Phase; len(phase) > 0 {
+			cells = append(cells, string(phase))
+		} else {
+			cells = append(cells, "<unknown>")
+		}
+		if expirationTimestamp := accessRequest.Status.ExpirationTimestamp; expirationTimestamp != nil {
+			cells = append(cells, metatable.ConvertToHumanReadableDateType(*expirationTimestamp))
+		} else {
+			cells = append(cells, "<none>")
+		}
+		cells = append(cells, metatable.ConvertToHumanReadableDateType(accessRequest.CreationTimestamp))
+
+		return cells, nil
+	})
+
+	return table, err
+}
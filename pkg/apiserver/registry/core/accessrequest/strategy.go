@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package accessrequest
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+type accessRequestStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for AccessRequests.
+var Strategy = accessRequestStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (accessRequestStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (accessRequestStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	accessRequest := obj.(*core.AccessRequest)
+
+	accessRequest.Status = core.AccessRequestStatus{}
+}
+
+func (accessRequestStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	accessRequest := obj.(*core.AccessRequest)
+	return validation.ValidateAccessRequest(accessRequest)
+}
+
+func (accessRequestStrategy) Canonicalize(_ runtime.Object) {
+}
+
+func (accessRequestStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (accessRequestStrategy) PrepareForUpdate(_ context.Context, newObj, oldObj runtime.Object) {
+	oldAccessRequest, newAccessRequest := oldObj.(*core.AccessRequest), newObj.(*core.AccessRequest)
+	newAccessRequest.Status = oldAccessRequest.Status
+}
+
+func (accessRequestStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldAccessRequest, newAccessRequest := oldObj.(*core.AccessRequest), newObj.(*core.AccessRequest)
+	return validation.ValidateAccessRequestUpdate(newAccessRequest, oldAccessRequest)
+}
+
+func (accessRequestStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (accessRequestStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (accessRequestStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
+
+type accessRequestStatusStrategy struct {
+	accessRequestStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of AccessRequests.
+var StatusStrategy = accessRequestStatusStrategy{Strategy}
+
+func (accessRequestStatusStrategy) PrepareForUpdate(_ context.Context, newObj, oldObj runtime.Object) {
+	oldAccessRequest, newAccessRequest := oldObj.(*core.AccessRequest), newObj.(*core.AccessRequest)
+	newAccessRequest.Spec = oldAccessRequest.Spec
+}
+
+func (accessRequestStatusStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldAccessRequest, newAccessRequest := oldObj.(*core.AccessRequest), newObj.(*core.AccessRequest)
+	return validation.ValidateAccessRequestStatusUpdate(newAccessRequest, oldAccessRequest)
+}
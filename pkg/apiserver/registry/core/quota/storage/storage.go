@@ -5,6 +5,9 @@
 package storage
 
 import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/registry/generic"
 	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
@@ -21,20 +24,22 @@ type REST struct {
 
 // QuotaStorage implements the storage for Quotas and their status subresource.
 type QuotaStorage struct {
-	Quota *REST
+	Quota  *REST
+	Status *StatusREST
 }
 
 // NewStorage creates a new QuotaStorage object.
 func NewStorage(optsGetter generic.RESTOptionsGetter) QuotaStorage {
-	quotaRest := NewREST(optsGetter)
+	quotaRest, quotaStatusRest := NewREST(optsGetter)
 
 	return QuotaStorage{
-		Quota: quotaRest,
+		Quota:  quotaRest,
+		Status: quotaStatusRest,
 	}
 }
 
 // NewREST returns a RESTStorage object that will work with Quota objects.
-func NewREST(optsGetter generic.RESTOptionsGetter) *REST {
+func NewREST(optsGetter generic.RESTOptionsGetter) (*REST, *StatusREST) {
 	store := &genericregistry.Store{
 		NewFunc:                   func() runtime.Object { return &core.Quota{} },
 		NewListFunc:               func() runtime.Object { return &core.QuotaList{} },
@@ -53,7 +58,41 @@ func NewREST(optsGetter generic.RESTOptionsGetter) *REST {
 		panic(err)
 	}
 
-	return &REST{store}
+	statusStore := *store
+	statusStore.UpdateStrategy = quota.StatusStrategy
+	return &REST{store}, &StatusREST{store: &statusStore}
+}
+
+// StatusREST implements the REST endpoint for changing the status of a Quota.
+type StatusREST struct {
+	store *genericregistry.Store
+}
+
+var (
+	_ rest.Storage = &StatusREST{}
+	_ rest.Getter  = &StatusREST{}
+	_ rest.Updater = &StatusREST{}
+)
+
+// New creates a new (empty) internal Quota object.
+func (r *StatusREST) New() runtime.Object {
+	return &core.Quota{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *StatusREST) Destroy() {
+	// Given that underlying store is shared with REST,
+	// we don't destroy it here explicitly.
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return r.store.Get(ctx, name, options)
+}
+
+// Update alters the status subset of an object.
+func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, forceAllowCreate, options)
 }
 
 // Implement ShortNamesProvider
@@ -18,6 +18,7 @@ import (
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	accessrequeststore "github.com/gardener/gardener/pkg/apiserver/registry/core/accessrequest/storage"
 	backupbucketstore "github.com/gardener/gardener/pkg/apiserver/registry/core/backupbucket/storage"
 	backupentrystore "github.com/gardener/gardener/pkg/apiserver/registry/core/backupentry/storage"
 	cloudprofilestore "github.com/gardener/gardener/pkg/apiserver/registry/core/cloudprofile/storage"
@@ -71,6 +72,10 @@ func (p StorageProvider) v1Storage(restOptionsGetter generic.RESTOptionsGetter)
 func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGetter) map[string]rest.Storage {
 	storage := map[string]rest.Storage{}
 
+	accessRequestStorage := accessrequeststore.NewStorage(restOptionsGetter)
+	storage["accessrequests"] = accessRequestStorage.AccessRequest
+	storage["accessrequests/status"] = accessRequestStorage.Status
+
 	backupBucketStorage := backupbucketstore.NewStorage(restOptionsGetter)
 	storage["backupbuckets"] = backupBucketStorage.BackupBucket
 	storage["backupbuckets/status"] = backupBucketStorage.Status
@@ -107,6 +112,7 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 
 	quotaStorage := quotastore.NewStorage(restOptionsGetter)
 	storage["quotas"] = quotaStorage.Quota
+	storage["quotas/status"] = quotaStorage.Status
 
 	secretBindingStorage := secretbindingstore.NewStorage(restOptionsGetter)
 	storage["secretbindings"] = secretBindingStorage.SecretBinding
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	context "context"
+
+	corev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	scheme "github.com/gardener/gardener/pkg/client/core/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// AccessRequestsGetter has a method to return a AccessRequestInterface.
+// A group's client should implement this interface.
+type AccessRequestsGetter interface {
+	AccessRequests(namespace string) AccessRequestInterface
+}
+
+// AccessRequestInterface has methods to work with AccessRequest resources.
+type AccessRequestInterface interface {
+	Create(ctx context.Context, accessRequest *corev1beta1.AccessRequest, opts v1.CreateOptions) (*corev1beta1.AccessRequest, error)
+	Update(ctx context.Context, accessRequest *corev1beta1.AccessRequest, opts v1.UpdateOptions) (*corev1beta1.AccessRequest, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, accessRequest *corev1beta1.AccessRequest, opts v1.UpdateOptions) (*corev1beta1.AccessRequest, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*corev1beta1.AccessRequest, error)
+	List(ctx context.Context, opts v1.ListOptions) (*corev1beta1.AccessRequestList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *corev1beta1.AccessRequest, err error)
+	AccessRequestExpansion
+}
+
+// accessRequests implements AccessRequestInterface
+type accessRequests struct {
+	*gentype.ClientWithList[*corev1beta1.AccessRequest, *corev1beta1.AccessRequestList]
+}
+
+// newAccessRequests returns a AccessRequests
+func newAccessRequests(c *CoreV1beta1Client, namespace string) *accessRequests {
+	return &accessRequests{
+		gentype.NewClientWithList[*corev1beta1.AccessRequest, *corev1beta1.AccessRequestList](
+			"accessrequests",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *corev1beta1.AccessRequest { return &corev1beta1.AccessRequest{} },
+			func() *corev1beta1.AccessRequestList { return &corev1beta1.AccessRequestList{} },
+		),
+	}
+}
@@ -16,6 +16,7 @@ import (
 
 type CoreV1beta1Interface interface {
 	RESTClient() rest.Interface
+	AccessRequestsGetter
 	BackupBucketsGetter
 	BackupEntriesGetter
 	CloudProfilesGetter
@@ -38,6 +39,10 @@ type CoreV1beta1Client struct {
 	restClient rest.Interface
 }
 
+func (c *CoreV1beta1Client) AccessRequests(namespace string) AccessRequestInterface {
+	return newAccessRequests(c, namespace)
+}
+
 func (c *CoreV1beta1Client) BackupBuckets() BackupBucketInterface {
 	return newBackupBuckets(c)
 }
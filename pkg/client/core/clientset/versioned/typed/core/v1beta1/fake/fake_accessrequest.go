@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	corev1beta1 "github.com/gardener/gardener/pkg/client/core/clientset/versioned/typed/core/v1beta1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeAccessRequests implements AccessRequestInterface
+type fakeAccessRequests struct {
+	*gentype.FakeClientWithList[*v1beta1.AccessRequest, *v1beta1.AccessRequestList]
+	Fake *FakeCoreV1beta1
+}
+
+func newFakeAccessRequests(fake *FakeCoreV1beta1, namespace string) corev1beta1.AccessRequestInterface {
+	return &fakeAccessRequests{
+		gentype.NewFakeClientWithList[*v1beta1.AccessRequest, *v1beta1.AccessRequestList](
+			fake.Fake,
+			namespace,
+			v1beta1.SchemeGroupVersion.WithResource("accessrequests"),
+			v1beta1.SchemeGroupVersion.WithKind("AccessRequest"),
+			func() *v1beta1.AccessRequest { return &v1beta1.AccessRequest{} },
+			func() *v1beta1.AccessRequestList { return &v1beta1.AccessRequestList{} },
+			func(dst, src *v1beta1.AccessRequestList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta1.AccessRequestList) []*v1beta1.AccessRequest {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta1.AccessRequestList, items []*v1beta1.AccessRequest) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -16,6 +16,10 @@ type FakeCoreV1beta1 struct {
 	*testing.Fake
 }
 
+func (c *FakeCoreV1beta1) AccessRequests(namespace string) v1beta1.AccessRequestInterface {
+	return newFakeAccessRequests(c, namespace)
+}
+
 func (c *FakeCoreV1beta1) BackupBuckets() v1beta1.BackupBucketInterface {
 	return newFakeBackupBuckets(c)
 }
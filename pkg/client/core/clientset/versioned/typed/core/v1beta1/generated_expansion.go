@@ -6,6 +6,8 @@
 
 package v1beta1
 
+type AccessRequestExpansion interface{}
+
 type BackupBucketExpansion interface{}
 
 type BackupEntryExpansion interface{}
@@ -46,6 +46,20 @@ func (m *MockCoreV1beta1Interface) EXPECT() *MockCoreV1beta1InterfaceMockRecorde
 	return m.recorder
 }
 
+// AccessRequests mocks base method.
+func (m *MockCoreV1beta1Interface) AccessRequests(namespace string) v1beta10.AccessRequestInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccessRequests", namespace)
+	ret0, _ := ret[0].(v1beta10.AccessRequestInterface)
+	return ret0
+}
+
+// AccessRequests indicates an expected call of AccessRequests.
+func (mr *MockCoreV1beta1InterfaceMockRecorder) AccessRequests(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccessRequests", reflect.TypeOf((*MockCoreV1beta1Interface)(nil).AccessRequests), namespace)
+}
+
 // BackupBuckets mocks base method.
 func (m *MockCoreV1beta1Interface) BackupBuckets() v1beta10.BackupBucketInterface {
 	m.ctrl.T.Helper()
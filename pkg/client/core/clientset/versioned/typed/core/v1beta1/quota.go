@@ -27,6 +27,8 @@ type QuotasGetter interface {
 type QuotaInterface interface {
 	Create(ctx context.Context, quota *corev1beta1.Quota, opts v1.CreateOptions) (*corev1beta1.Quota, error)
 	Update(ctx context.Context, quota *corev1beta1.Quota, opts v1.UpdateOptions) (*corev1beta1.Quota, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, quota *corev1beta1.Quota, opts v1.UpdateOptions) (*corev1beta1.Quota, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*corev1beta1.Quota, error)
@@ -12,6 +12,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// AccessRequests returns a AccessRequestInformer.
+	AccessRequests() AccessRequestInformer
 	// BackupBuckets returns a BackupBucketInformer.
 	BackupBuckets() BackupBucketInformer
 	// BackupEntries returns a BackupEntryInformer.
@@ -55,6 +57,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// AccessRequests returns a AccessRequestInformer.
+func (v *version) AccessRequests() AccessRequestInformer {
+	return &accessRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // BackupBuckets returns a BackupBucketInformer.
 func (v *version) BackupBuckets() BackupBucketInformer {
 	return &backupBucketInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -46,6 +46,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1().ControllerDeployments().Informer()}, nil
 
 		// Group=core.gardener.cloud, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithResource("accessrequests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().AccessRequests().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("backupbuckets"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().BackupBuckets().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("backupentries"):
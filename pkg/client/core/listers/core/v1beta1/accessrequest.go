@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	corev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// AccessRequestLister helps list AccessRequests.
+// All objects returned here must be treated as read-only.
+type AccessRequestLister interface {
+	// List lists all AccessRequests in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*corev1beta1.AccessRequest, err error)
+	// AccessRequests returns an object that can list and get AccessRequests.
+	AccessRequests(namespace string) AccessRequestNamespaceLister
+	AccessRequestListerExpansion
+}
+
+// accessRequestLister implements the AccessRequestLister interface.
+type accessRequestLister struct {
+	listers.ResourceIndexer[*corev1beta1.AccessRequest]
+}
+
+// NewAccessRequestLister returns a new AccessRequestLister.
+func NewAccessRequestLister(indexer cache.Indexer) AccessRequestLister {
+	return &accessRequestLister{listers.New[*corev1beta1.AccessRequest](indexer, corev1beta1.Resource("accessrequest"))}
+}
+
+// AccessRequests returns an object that can list and get AccessRequests.
+func (s *accessRequestLister) AccessRequests(namespace string) AccessRequestNamespaceLister {
+	return accessRequestNamespaceLister{listers.NewNamespaced[*corev1beta1.AccessRequest](s.ResourceIndexer, namespace)}
+}
+
+// AccessRequestNamespaceLister helps list and get AccessRequests.
+// All objects returned here must be treated as read-only.
+type AccessRequestNamespaceLister interface {
+	// List lists all AccessRequests in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*corev1beta1.AccessRequest, err error)
+	// Get retrieves the AccessRequest from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*corev1beta1.AccessRequest, error)
+	AccessRequestNamespaceListerExpansion
+}
+
+// accessRequestNamespaceLister implements the AccessRequestNamespaceLister
+// interface.
+type accessRequestNamespaceLister struct {
+	listers.ResourceIndexer[*corev1beta1.AccessRequest]
+}
@@ -6,6 +6,14 @@
 
 package v1beta1
 
+// AccessRequestListerExpansion allows custom methods to be added to
+// AccessRequestLister.
+type AccessRequestListerExpansion interface{}
+
+// AccessRequestNamespaceListerExpansion allows custom methods to be added to
+// AccessRequestNamespaceLister.
+type AccessRequestNamespaceListerExpansion interface{}
+
 // BackupBucketListerExpansion allows custom methods to be added to
 // BackupBucketLister.
 type BackupBucketListerExpansion interface{}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"path/filepath"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -33,12 +34,16 @@ const (
 	volumeNameClientTLS     = "etcd-client-tls"
 	volumeNamePeerCA        = "etcd-peer-ca"
 	volumeNamePeerServerTLS = "etcd-peer-server-tls"
+	volumeNameDataRoot      = "data-root"
+	volumeNameSnapshot      = "snapshot"
 
 	volumeMountPathData          = "/var/etcd/data"
 	volumeMountPathETCDCA        = "/var/etcd/ssl/ca"
 	volumeMountPathServerTLS     = "/var/etcd/ssl/server"
 	volumeMountPathPeerCA        = "/var/etcd/ssl/peer/ca"
 	volumeMountPathPeerServerTLS = "/var/etcd/ssl/peer/server"
+	volumeMountPathDataRoot      = "/var/etcd/data-root"
+	volumeMountPathSnapshot      = "/var/etcd/snapshot"
 )
 
 // Values is a set of configuration values for the Etcd component.
@@ -53,6 +58,9 @@ type Values struct {
 	PortPeer int32
 	// PortMetrics is the port for the metrics connections.
 	PortMetrics int32
+	// SnapshotPath is the path to an etcd snapshot file on the host. If set, the data directory is restored from this
+	// snapshot by an init container before etcd is started. The data directory must not exist yet.
+	SnapshotPath string
 }
 
 // New creates a new instance of DeployWaiter for the Etcd.
@@ -262,12 +270,78 @@ func (e *etcdDeployer) Deploy(ctx context.Context) error {
 			},
 		}
 
+		if e.values.SnapshotPath != "" {
+			e.addRestoreInitContainer(&statefulSet.Spec.Template.Spec, statefulSet.Name)
+		}
+
 		return nil
 	})
 
 	return err
 }
 
+// addRestoreInitContainer adds an init container to the given pod spec which restores the data directory from the
+// configured snapshot. `etcdctl snapshot restore` refuses to write into an existing data directory, hence the init
+// container mounts the data root directory (which contains the `new.etcd` data directory) via an additional volume and
+// lets `etcdctl` create the data directory. The type of the data volume of the etcd container is unset, so that the
+// kubelet does not create the data directory before the init container has run. The directory containing the snapshot
+// is mounted read-only.
+func (e *etcdDeployer) addRestoreInitContainer(podSpec *corev1.PodSpec, name string) {
+	for i, volume := range podSpec.Volumes {
+		if volume.Name == volumeNameData {
+			podSpec.Volumes[i].HostPath.Type = nil
+		}
+	}
+
+	podSpec.InitContainers = append(podSpec.InitContainers, corev1.Container{
+		Name:            "restore-snapshot",
+		Image:           e.values.Image,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command: []string{
+			"etcdctl",
+			"snapshot",
+			"restore",
+			volumeMountPathSnapshot + "/" + filepath.Base(e.values.SnapshotPath),
+			"--name=" + name,
+			"--data-dir=" + volumeMountPathDataRoot + "/new.etcd",
+			fmt.Sprintf("--initial-advertise-peer-urls=https://localhost:%d", e.values.PortPeer),
+			fmt.Sprintf("--initial-cluster=%s=https://localhost:%d", name, e.values.PortPeer),
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				MountPath: volumeMountPathDataRoot,
+				Name:      volumeNameDataRoot,
+			},
+			{
+				MountPath: volumeMountPathSnapshot,
+				Name:      volumeNameSnapshot,
+				ReadOnly:  true,
+			},
+		},
+	})
+
+	podSpec.Volumes = append(podSpec.Volumes,
+		corev1.Volume{
+			Name: volumeNameDataRoot,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: staticpodtranslator.StatefulSetVolumeClaimTemplateHostPath(etcd.Name(e.values.Role)),
+					Type: ptr.To(corev1.HostPathDirectoryOrCreate),
+				},
+			},
+		},
+		corev1.Volume{
+			Name: volumeNameSnapshot,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: filepath.Dir(e.values.SnapshotPath),
+					Type: ptr.To(corev1.HostPathDirectory),
+				},
+			},
+		},
+	)
+}
+
 func (e *etcdDeployer) Destroy(_ context.Context) error {
 	return nil
 }
@@ -85,6 +85,67 @@ var _ = Describe("Etcd", func() {
 				MatchFields(IgnoreExtras, Fields{"Name": Equal("etcd-peer-server-tls")}),
 			))
 		})
+
+		It("should add an init container restoring the data directory from a snapshot", func() {
+			etcd = New(c, namespace, sm, Values{Image: image, Role: "main", PortPeer: 2380, SnapshotPath: "/var/lib/gardenadm/etcd-restore/etcd-main.db"})
+
+			Expect(etcd.Deploy(ctx)).To(Succeed())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(statefulSet), statefulSet)).To(Succeed())
+			Expect(statefulSet.Spec.Template.Spec.InitContainers).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Name":  Equal("restore-snapshot"),
+				"Image": Equal(image),
+				"Command": Equal([]string{
+					"etcdctl",
+					"snapshot",
+					"restore",
+					"/var/etcd/snapshot/etcd-main.db",
+					"--name=etcd-bootstrap-main",
+					"--data-dir=/var/etcd/data-root/new.etcd",
+					"--initial-advertise-peer-urls=https://localhost:2380",
+					"--initial-cluster=etcd-bootstrap-main=https://localhost:2380",
+				}),
+				"VolumeMounts": ConsistOf(
+					corev1.VolumeMount{Name: "data-root", MountPath: "/var/etcd/data-root"},
+					corev1.VolumeMount{Name: "snapshot", MountPath: "/var/etcd/snapshot", ReadOnly: true},
+				),
+			})))
+			Expect(statefulSet.Spec.Template.Spec.Volumes).To(HaveLen(8))
+			Expect(statefulSet.Spec.Template.Spec.Volumes).Should(ContainElements(
+				corev1.Volume{
+					Name: "data",
+					VolumeSource: corev1.VolumeSource{
+						HostPath: &corev1.HostPathVolumeSource{
+							Path: "/var/lib/etcd-main/data/new.etcd",
+						},
+					},
+				},
+				corev1.Volume{
+					Name: "data-root",
+					VolumeSource: corev1.VolumeSource{
+						HostPath: &corev1.HostPathVolumeSource{
+							Path: "/var/lib/etcd-main/data",
+							Type: ptr.To(corev1.HostPathDirectoryOrCreate),
+						},
+					},
+				},
+				corev1.Volume{
+					Name: "snapshot",
+					VolumeSource: corev1.VolumeSource{
+						HostPath: &corev1.HostPathVolumeSource{
+							Path: "/var/lib/gardenadm/etcd-restore",
+							Type: ptr.To(corev1.HostPathDirectory),
+						},
+					},
+				},
+			))
+
+			By("Remove the init container again when the snapshot path is no longer set")
+			etcd = New(c, namespace, sm, Values{Image: image, Role: "main", PortPeer: 2380})
+			Expect(etcd.Deploy(ctx)).To(Succeed())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(statefulSet), statefulSet)).To(Succeed())
+			Expect(statefulSet.Spec.Template.Spec.InitContainers).To(BeEmpty())
+			Expect(statefulSet.Spec.Template.Spec.Volumes).To(HaveLen(6))
+		})
 	})
 
 	Describe("#Destroy", func() {
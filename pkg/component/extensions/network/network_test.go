@@ -13,6 +13,7 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/mock/gomock"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -183,6 +184,21 @@ var _ = Describe("#Network", func() {
 				testFunc()
 			})
 		})
+
+		It("should store the trace context in the annotations", func() {
+			defer test.WithVars(
+				&network.TimeNow, mockNow.Do,
+			)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(ctx, "test")
+			defer span.End()
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+			actual := &extensionsv1alpha1.Network{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: networkName, Namespace: networkNs}, actual)).To(Succeed())
+			Expect(actual.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerTraceParent, "00-"+span.SpanContext().TraceID().String()+"-"+span.SpanContext().SpanID().String()+"-01"))
+		})
 	})
 
 	Describe("#Wait", func() {
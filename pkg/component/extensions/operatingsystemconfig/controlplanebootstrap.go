@@ -23,6 +23,7 @@ import (
 	"github.com/gardener/gardener/pkg/extensions"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 type controlPlaneBootstrap struct {
@@ -103,6 +104,7 @@ func (c *controlPlaneBootstrap) Deploy(ctx context.Context) error {
 	_, err = controllerutils.GetAndCreateOrMergePatch(ctx, c.client, c.osc.Object, func() error {
 		metav1.SetMetaDataAnnotation(&c.osc.Object.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
 		metav1.SetMetaDataAnnotation(&c.osc.Object.ObjectMeta, v1beta1constants.GardenerTimestamp, TimeNow().UTC().Format(time.RFC3339Nano))
+		tracing.InjectIntoAnnotations(ctx, &c.osc.Object.ObjectMeta)
 
 		c.osc.Object.Spec = extensionsv1alpha1.OperatingSystemConfigSpec{
 			Purpose: extensionsv1alpha1.OperatingSystemConfigPurposeProvision,
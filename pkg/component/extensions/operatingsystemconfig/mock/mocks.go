@@ -160,6 +160,18 @@ func (mr *MockInterfaceMockRecorder) SetCredentialsRotationStatus(arg0 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCredentialsRotationStatus", reflect.TypeOf((*MockInterface)(nil).SetCredentialsRotationStatus), arg0)
 }
 
+// SetSSHCertificateAuthorityPublicKeys mocks base method.
+func (m *MockInterface) SetSSHCertificateAuthorityPublicKeys(arg0 []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSSHCertificateAuthorityPublicKeys", arg0)
+}
+
+// SetSSHCertificateAuthorityPublicKeys indicates an expected call of SetSSHCertificateAuthorityPublicKeys.
+func (mr *MockInterfaceMockRecorder) SetSSHCertificateAuthorityPublicKeys(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSSHCertificateAuthorityPublicKeys", reflect.TypeOf((*MockInterface)(nil).SetSSHCertificateAuthorityPublicKeys), arg0)
+}
+
 // SetSSHPublicKeys mocks base method.
 func (m *MockInterface) SetSSHPublicKeys(arg0 []string) {
 	m.ctrl.T.Helper()
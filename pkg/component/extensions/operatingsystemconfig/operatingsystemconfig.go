@@ -43,6 +43,7 @@ import (
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 	"github.com/gardener/gardener/pkg/utils/version"
 )
 
@@ -88,6 +89,8 @@ type Interface interface {
 	SetCredentialsRotationStatus(*gardencorev1beta1.ShootCredentialsRotation)
 	// SetSSHPublicKeys sets the SSHPublicKeys value.
 	SetSSHPublicKeys([]string)
+	// SetSSHCertificateAuthorityPublicKeys sets the SSHCertificateAuthorityPublicKeys value.
+	SetSSHCertificateAuthorityPublicKeys([]string)
 	// WorkerPoolNameToOperatingSystemConfigsMap returns a map whose key is a worker pool name and whose value is a structure
 	// containing both the init and the original operating system config data.
 	WorkerPoolNameToOperatingSystemConfigsMap() map[string]*OperatingSystemConfigs
@@ -110,6 +113,8 @@ type Values struct {
 	Workers []gardencorev1beta1.Worker
 	// CredentialsRotationStatus
 	CredentialsRotationStatus *gardencorev1beta1.ShootCredentialsRotation
+	// RegistryMirrors are the registry mirrors applied to the hyperkube image.
+	RegistryMirrors imagevectorutils.Mirrors
 }
 
 // InitValues are configuration values required for the 'provision' OperatingSystemConfigPurpose.
@@ -138,6 +143,9 @@ type OriginalValues struct {
 	MachineTypes []gardencorev1beta1.MachineType
 	// SSHPublicKeys is a list of public SSH keys.
 	SSHPublicKeys []string
+	// SSHCertificateAuthorityPublicKeys is a list of public keys of SSH certificate authorities. User certificates
+	// signed by them are accepted for logging in as the gardener user.
+	SSHCertificateAuthorityPublicKeys []string
 	// SSHAccessEnabled states whether sshd.service service in systemd should be enabled and running for the worker nodes.
 	SSHAccessEnabled bool
 	// ValitailEnabled states whether Valitail shall be enabled.
@@ -661,6 +669,11 @@ func (o *operatingSystemConfig) SetSSHPublicKeys(keys []string) {
 	o.values.SSHPublicKeys = keys
 }
 
+// SetSSHCertificateAuthorityPublicKeys sets the SSHCertificateAuthorityPublicKeys value.
+func (o *operatingSystemConfig) SetSSHCertificateAuthorityPublicKeys(keys []string) {
+	o.values.SSHCertificateAuthorityPublicKeys = keys
+}
+
 // WorkerPoolNameToOperatingSystemConfigsMap returns a map whose key is a worker pool name and whose value is a structure
 // containing both the init script and the original config.
 func (o *operatingSystemConfig) WorkerPoolNameToOperatingSystemConfigsMap() map[string]*OperatingSystemConfigs {
@@ -714,7 +727,7 @@ func (o *operatingSystemConfig) newDeployer(version int, osc *extensionsv1alpha1
 		images[imageName] = image
 	}
 
-	images[imagevector.ContainerImageNameHyperkube], err = imagevector.Containers().FindImage(imagevector.ContainerImageNameHyperkube, imagevectorutils.RuntimeVersion(kubernetesVersion.String()), imagevectorutils.TargetVersion(kubernetesVersion.String()))
+	images[imagevector.ContainerImageNameHyperkube], err = imagevector.Containers().FindImage(imagevector.ContainerImageNameHyperkube, imagevectorutils.RuntimeVersion(kubernetesVersion.String()), imagevectorutils.TargetVersion(kubernetesVersion.String()), imagevectorutils.RegistryMirrors(o.values.RegistryMirrors))
 	if err != nil {
 		return deployer{}, fmt.Errorf("failed finding hyperkube image for version %s: %w", kubernetesVersion.String(), err)
 	}
@@ -765,6 +778,7 @@ func (o *operatingSystemConfig) newDeployer(version int, osc *extensionsv1alpha1
 		kubeProxyEnabled:                        o.values.KubeProxyEnabled,
 		kubernetesVersion:                       kubernetesVersion,
 		sshPublicKeys:                           o.values.SSHPublicKeys,
+		sshCertificateAuthorityPublicKeys:       o.values.SSHCertificateAuthorityPublicKeys,
 		sshAccessEnabled:                        o.values.SSHAccessEnabled,
 		valiIngressHostName:                     o.values.ValiIngressHostName,
 		valitailEnabled:                         o.values.ValitailEnabled,
@@ -836,6 +850,7 @@ type deployer struct {
 	kubeProxyEnabled                            bool
 	kubernetesVersion                           *semver.Version
 	sshPublicKeys                               []string
+	sshCertificateAuthorityPublicKeys           []string
 	sshAccessEnabled                            bool
 	valiIngressHostName                         string
 	valitailEnabled                             bool
@@ -880,6 +895,7 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 		KubeProxyEnabled:                        d.kubeProxyEnabled,
 		KubernetesVersion:                       d.kubernetesVersion,
 		SSHPublicKeys:                           d.sshPublicKeys,
+		SSHCertificateAuthorityPublicKeys:       d.sshCertificateAuthorityPublicKeys,
 		SSHAccessEnabled:                        d.sshAccessEnabled,
 		ValitailEnabled:                         d.valitailEnabled,
 		ValiIngress:                             d.valiIngressHostName,
@@ -931,6 +947,7 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 	_, err = controllerutils.GetAndCreateOrMergePatch(ctx, d.client, d.osc, func() error {
 		metav1.SetMetaDataAnnotation(&d.osc.ObjectMeta, v1beta1constants.GardenerOperation, operation)
 		metav1.SetMetaDataAnnotation(&d.osc.ObjectMeta, v1beta1constants.GardenerTimestamp, TimeNow().UTC().Format(time.RFC3339Nano))
+		tracing.InjectIntoAnnotations(ctx, &d.osc.ObjectMeta)
 		metav1.SetMetaDataLabel(&d.osc.ObjectMeta, v1beta1constants.LabelWorkerPool, d.worker.Name)
 		metav1.SetMetaDataLabel(&d.osc.ObjectMeta, v1beta1constants.LabelExtensionProviderMutatedByControlplaneWebhook, "true")
 
@@ -38,6 +38,7 @@ type Context struct {
 	KubeProxyEnabled                        bool
 	KubernetesVersion                       *semver.Version
 	SSHPublicKeys                           []string
+	SSHCertificateAuthorityPublicKeys       []string
 	SSHAccessEnabled                        bool
 	ValiIngress                             string
 	ValitailEnabled                         bool
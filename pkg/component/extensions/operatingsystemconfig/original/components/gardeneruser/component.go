@@ -45,6 +45,9 @@ const (
 
 	// pathAuthorizedSSHKeys is the new file that can contain multiple SSH public keys.
 	pathAuthorizedSSHKeys = "/var/lib/gardener-user-authorized-keys"
+
+	// pathTrustedUserCAKeys is the file that contains the public keys of the SSH certificate authorities.
+	pathTrustedUserCAKeys = "/var/lib/gardener-user-trusted-ca-keys"
 )
 
 type component struct{}
@@ -59,16 +62,60 @@ func (component) Name() string {
 }
 
 func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
-	var script bytes.Buffer
-	if err := tpl.Execute(&script, map[string]any{
+	values := map[string]any{
 		"pathPublicSSHKey":      pathPublicSSHKey,
 		"pathAuthorizedSSHKeys": pathAuthorizedSSHKeys,
-	}); err != nil {
+	}
+
+	pathUnitContent := "PathChanged=" + pathAuthorizedSSHKeys + "\n"
+	if len(ctx.SSHCertificateAuthorityPublicKeys) > 0 {
+		values["pathTrustedUserCAKeys"] = pathTrustedUserCAKeys
+		pathUnitContent += "PathChanged=" + pathTrustedUserCAKeys + "\n"
+	}
+
+	var script bytes.Buffer
+	if err := tpl.Execute(&script, values); err != nil {
 		return nil, nil, err
 	}
 
 	authorizedKeys := strings.Join(ctx.SSHPublicKeys, "\n")
 
+	files := []extensionsv1alpha1.File{
+		{
+			Path:        pathAuthorizedSSHKeys,
+			Permissions: ptr.To[uint32](0644),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Encoding: "b64",
+					Data:     utils.EncodeBase64([]byte(authorizedKeys)),
+				},
+			},
+		},
+		{
+			Path:        pathScript,
+			Permissions: ptr.To[uint32](0755),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Encoding: "b64",
+					Data:     utils.EncodeBase64(script.Bytes()),
+				},
+			},
+		},
+	}
+
+	if len(ctx.SSHCertificateAuthorityPublicKeys) > 0 {
+		files = append(files, extensionsv1alpha1.File{
+			Path:        pathTrustedUserCAKeys,
+			Permissions: ptr.To[uint32](0644),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Encoding: "b64",
+					Data:     utils.EncodeBase64([]byte(strings.Join(ctx.SSHCertificateAuthorityPublicKeys, "\n"))),
+				},
+			},
+		})
+	}
+
 	return []extensionsv1alpha1.Unit{
 			{
 				Name:   "gardener-user.service",
@@ -86,33 +133,11 @@ ExecStart=` + pathScript + `
 				Name:   "gardener-user.path",
 				Enable: ptr.To(true),
 				Content: ptr.To(`[Path]
-PathChanged=` + pathAuthorizedSSHKeys + `
-[Install]
+` + pathUnitContent + `[Install]
 WantedBy=multi-user.target
 `),
 			},
 		},
-		[]extensionsv1alpha1.File{
-			{
-				Path:        pathAuthorizedSSHKeys,
-				Permissions: ptr.To[uint32](0644),
-				Content: extensionsv1alpha1.FileContent{
-					Inline: &extensionsv1alpha1.FileContentInline{
-						Encoding: "b64",
-						Data:     utils.EncodeBase64([]byte(authorizedKeys)),
-					},
-				},
-			},
-			{
-				Path:        pathScript,
-				Permissions: ptr.To[uint32](0755),
-				Content: extensionsv1alpha1.FileContent{
-					Inline: &extensionsv1alpha1.FileContentInline{
-						Encoding: "b64",
-						Data:     utils.EncodeBase64(script.Bytes()),
-					},
-				},
-			},
-		},
+		files,
 		nil
 }
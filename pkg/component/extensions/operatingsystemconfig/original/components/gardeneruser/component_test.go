@@ -85,6 +85,57 @@ WantedBy=multi-user.target
 				},
 			))
 		})
+
+		It("should return the expected units and files when SSH certificate authorities are trusted", func() {
+			ctx.SSHPublicKeys = nil
+			ctx.SSHCertificateAuthorityPublicKeys = []string{"ca-key", "old-ca-key"}
+
+			units, files, err := component.Config(ctx)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(units).To(ContainElement(extensionsv1alpha1.Unit{
+				Name:   "gardener-user.path",
+				Enable: ptr.To(true),
+				Content: ptr.To(`[Path]
+PathChanged=/var/lib/gardener-user-authorized-keys
+PathChanged=/var/lib/gardener-user-trusted-ca-keys
+[Install]
+WantedBy=multi-user.target
+`),
+			}))
+			Expect(files).To(ConsistOf(
+				extensionsv1alpha1.File{
+					Path:        "/var/lib/gardener-user-authorized-keys",
+					Permissions: ptr.To[uint32](0644),
+					Content: extensionsv1alpha1.FileContent{
+						Inline: &extensionsv1alpha1.FileContentInline{
+							Encoding: "b64",
+							Data:     utils.EncodeBase64([]byte("")),
+						},
+					},
+				},
+				extensionsv1alpha1.File{
+					Path:        "/var/lib/gardener-user-trusted-ca-keys",
+					Permissions: ptr.To[uint32](0644),
+					Content: extensionsv1alpha1.FileContent{
+						Inline: &extensionsv1alpha1.FileContentInline{
+							Encoding: "b64",
+							Data:     utils.EncodeBase64([]byte("ca-key\nold-ca-key")),
+						},
+					},
+				},
+				extensionsv1alpha1.File{
+					Path:        "/var/lib/gardener-user/run.sh",
+					Permissions: ptr.To[uint32](0755),
+					Content: extensionsv1alpha1.FileContent{
+						Inline: &extensionsv1alpha1.FileContentInline{
+							Encoding: "b64",
+							Data:     utils.EncodeBase64([]byte(scriptWithTrustedUserCAKeys)),
+						},
+					},
+				},
+			))
+		})
 	})
 })
 
@@ -93,6 +144,8 @@ const script = `#!/bin/bash -eu
 DIR_SSH="/home/gardener/.ssh"
 PATH_AUTHORIZED_KEYS="$DIR_SSH/authorized_keys"
 PATH_SUDOERS="/etc/sudoers.d/99-gardener-user"
+PATH_SSHD_CONFIG="/etc/ssh/sshd_config"
+PATH_TRUSTED_USER_CA_KEYS="/etc/ssh/gardener-user-trusted-ca-keys"
 USERNAME="gardener"
 
 # create user if missing
@@ -103,6 +156,49 @@ mkdir -p $DIR_SSH
 cp -f "/var/lib/gardener-user-authorized-keys" $PATH_AUTHORIZED_KEYS
 chown $USERNAME:$USERNAME $PATH_AUTHORIZED_KEYS
 
+# stop trusting SSH certificate authorities for user certificates in case they were trusted before
+if grep -q "^TrustedUserCAKeys $PATH_TRUSTED_USER_CA_KEYS$" $PATH_SSHD_CONFIG; then
+  sed -i "\|^TrustedUserCAKeys $PATH_TRUSTED_USER_CA_KEYS$|d" $PATH_SSHD_CONFIG
+  systemctl reload sshd.service || systemctl reload ssh.service || true
+fi
+rm -f $PATH_TRUSTED_USER_CA_KEYS
+
+# remove unused legacy file
+if [ -f "/var/lib/gardener-user-ssh.key" ]; then
+  rm -f "/var/lib/gardener-user-ssh.key"
+fi
+
+# allow sudo for gardener user
+if [ ! -f "$PATH_SUDOERS" ]; then
+  echo "$USERNAME ALL=(ALL) NOPASSWD:ALL" > $PATH_SUDOERS
+fi
+`
+
+const scriptWithTrustedUserCAKeys = `#!/bin/bash -eu
+
+DIR_SSH="/home/gardener/.ssh"
+PATH_AUTHORIZED_KEYS="$DIR_SSH/authorized_keys"
+PATH_SUDOERS="/etc/sudoers.d/99-gardener-user"
+PATH_SSHD_CONFIG="/etc/ssh/sshd_config"
+PATH_TRUSTED_USER_CA_KEYS="/etc/ssh/gardener-user-trusted-ca-keys"
+USERNAME="gardener"
+
+# create user if missing
+id $USERNAME || useradd $USERNAME -mU
+
+# copy authorized_keys file
+mkdir -p $DIR_SSH
+cp -f "/var/lib/gardener-user-authorized-keys" $PATH_AUTHORIZED_KEYS
+chown $USERNAME:$USERNAME $PATH_AUTHORIZED_KEYS
+
+# trust SSH certificate authorities for user certificates
+cp -f "/var/lib/gardener-user-trusted-ca-keys" $PATH_TRUSTED_USER_CA_KEYS
+if ! grep -q "^TrustedUserCAKeys $PATH_TRUSTED_USER_CA_KEYS$" $PATH_SSHD_CONFIG; then
+  # sshd uses the first occurrence of an option, hence the option is prepended
+  sed -i "1i TrustedUserCAKeys $PATH_TRUSTED_USER_CA_KEYS" $PATH_SSHD_CONFIG
+  systemctl reload sshd.service || systemctl reload ssh.service || true
+fi
+
 # remove unused legacy file
 if [ -f "/var/lib/gardener-user-ssh.key" ]; then
   rm -f "/var/lib/gardener-user-ssh.key"
@@ -3,6 +3,8 @@
 DIR_SSH="/home/gardener/.ssh"
 PATH_AUTHORIZED_KEYS="$DIR_SSH/authorized_keys"
 PATH_SUDOERS="/etc/sudoers.d/99-gardener-user"
+PATH_SSHD_CONFIG="/etc/ssh/sshd_config"
+PATH_TRUSTED_USER_CA_KEYS="/etc/ssh/gardener-user-trusted-ca-keys"
 USERNAME="gardener"
 
 # create user if missing
@@ -13,6 +15,25 @@ mkdir -p $DIR_SSH
 cp -f "{{ .pathAuthorizedSSHKeys }}" $PATH_AUTHORIZED_KEYS
 chown $USERNAME:$USERNAME $PATH_AUTHORIZED_KEYS
 
+{{- if .pathTrustedUserCAKeys }}
+
+# trust SSH certificate authorities for user certificates
+cp -f "{{ .pathTrustedUserCAKeys }}" $PATH_TRUSTED_USER_CA_KEYS
+if ! grep -q "^TrustedUserCAKeys $PATH_TRUSTED_USER_CA_KEYS$" $PATH_SSHD_CONFIG; then
+  # sshd uses the first occurrence of an option, hence the option is prepended
+  sed -i "1i TrustedUserCAKeys $PATH_TRUSTED_USER_CA_KEYS" $PATH_SSHD_CONFIG
+  systemctl reload sshd.service || systemctl reload ssh.service || true
+fi
+{{- else }}
+
+# stop trusting SSH certificate authorities for user certificates in case they were trusted before
+if grep -q "^TrustedUserCAKeys $PATH_TRUSTED_USER_CA_KEYS$" $PATH_SSHD_CONFIG; then
+  sed -i "\|^TrustedUserCAKeys $PATH_TRUSTED_USER_CA_KEYS$|d" $PATH_SSHD_CONFIG
+  systemctl reload sshd.service || systemctl reload ssh.service || true
+fi
+rm -f $PATH_TRUSTED_USER_CA_KEYS
+{{- end }}
+
 # remove unused legacy file
 if [ -f "{{ .pathPublicSSHKey }}" ]; then
   rm -f "{{ .pathPublicSSHKey }}"
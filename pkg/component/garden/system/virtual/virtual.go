@@ -247,6 +247,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 				{
 					APIGroups: []string{gardencorev1beta1.GroupName},
 					Resources: []string{
+						"accessrequests",
 						"backupbuckets",
 						"backupentries",
 						"cloudprofiles",
@@ -436,6 +437,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 						"shoots",
 						"secretbindings",
 						"quotas",
+						"accessrequests",
 					},
 					Verbs: []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
 				},
@@ -553,6 +555,11 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					},
 					Verbs: []string{"get", "list", "watch"},
 				},
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{"accessrequests"},
+					Verbs:     []string{"create", "get", "list", "watch"},
+				},
 				{
 					APIGroups: []string{securityv1alpha1.GroupName},
 					Resources: []string{"credentialsbindings"},
@@ -268,6 +268,7 @@ var _ = Describe("Virtual", func() {
 				{
 					APIGroups: []string{"core.gardener.cloud"},
 					Resources: []string{
+						"accessrequests",
 						"backupbuckets",
 						"backupentries",
 						"cloudprofiles",
@@ -456,6 +457,7 @@ var _ = Describe("Virtual", func() {
 						"shoots",
 						"secretbindings",
 						"quotas",
+						"accessrequests",
 					},
 					Verbs: []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
 				},
@@ -571,6 +573,11 @@ var _ = Describe("Virtual", func() {
 					},
 					Verbs: []string{"get", "list", "watch"},
 				},
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{"accessrequests"},
+					Verbs:     []string{"create", "get", "list", "watch"},
+				},
 				{
 					APIGroups: []string{"security.gardener.cloud"},
 					Resources: []string{"credentialsbindings"},
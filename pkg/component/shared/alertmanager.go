@@ -11,11 +11,12 @@ import (
 
 	"github.com/gardener/gardener/imagevector"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/alertmanager"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
 // NewAlertmanager creates a new alertmanager deployer.
-func NewAlertmanager(log logr.Logger, c client.Client, namespace string, values alertmanager.Values) (alertmanager.Interface, error) {
-	imageAlertmanager, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameAlertmanager)
+func NewAlertmanager(log logr.Logger, c client.Client, namespace string, values alertmanager.Values, mirrors imagevectorutils.Mirrors) (alertmanager.Interface, error) {
+	imageAlertmanager, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameAlertmanager, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
@@ -27,11 +27,12 @@ func NewEtcdDruid(
 	secretNameServerCA string,
 	priorityClassName string,
 	managedbyGardenerOperator bool,
+	mirrors imagevectorutils.Mirrors,
 ) (
 	component.DeployWaiter,
 	error,
 ) {
-	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameEtcdDruid, imagevectorutils.RuntimeVersion(runtimeVersion.String()), imagevectorutils.TargetVersion(runtimeVersion.String()))
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameEtcdDruid, imagevectorutils.RuntimeVersion(runtimeVersion.String()), imagevectorutils.TargetVersion(runtimeVersion.String()), imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/gardener/gardener/imagevector"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/observability/logging/fluentbit"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
 // NewFluentBit instantiates a new `Fluent-bit` component.
@@ -19,16 +20,17 @@ func NewFluentBit(
 	enabled bool,
 	valiEnabled bool,
 	priorityClassName string,
+	mirrors imagevectorutils.Mirrors,
 ) (
 	deployer component.DeployWaiter,
 	err error,
 ) {
-	fluentBitImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameFluentBit)
+	fluentBitImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameFluentBit, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
 
-	fluentBitInitImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameFluentBitPluginInstaller)
+	fluentBitInitImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameFluentBitPluginInstaller, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/gardener/gardener/imagevector"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/observability/logging/fluentoperator"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
 // NewFluentOperator instantiates a new `Fluent Operator` component.
@@ -18,11 +19,12 @@ func NewFluentOperator(
 	gardenNamespaceName string,
 	enabled bool,
 	priorityClassName string,
+	mirrors imagevectorutils.Mirrors,
 ) (
 	deployer component.DeployWaiter,
 	err error,
 ) {
-	operatorImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameFluentOperator)
+	operatorImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameFluentOperator, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
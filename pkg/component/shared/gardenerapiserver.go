@@ -21,6 +21,7 @@ import (
 	gardenerapiserver "github.com/gardener/gardener/pkg/component/gardener/apiserver"
 	"github.com/gardener/gardener/pkg/logger"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
@@ -39,11 +40,12 @@ func NewGardenerAPIServer(
 	clusterIdentity,
 	workloadIdentityTokenIssuer string,
 	goAwayChance *float64,
+	mirrors imagevectorutils.Mirrors,
 ) (
 	gardenerapiserver.Interface,
 	error,
 ) {
-	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerApiserver)
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerApiserver, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
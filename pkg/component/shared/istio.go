@@ -28,6 +28,7 @@ import (
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
 // ImageVector is an alias for imagevector.Containers(). Exposed for testing.
@@ -54,6 +55,7 @@ func NewIstio(
 	zones []string,
 	dualStack bool,
 	kubernetesVersion *semver.Version,
+	mirrors imagevectorutils.Mirrors,
 ) (
 	istio.Interface,
 	error,
@@ -63,12 +65,12 @@ func NewIstio(
 		maxReplicas *int
 	)
 
-	istiodImage, err := ImageVector.FindImage(imagevector.ContainerImageNameIstioIstiod)
+	istiodImage, err := ImageVector.FindImage(imagevector.ContainerImageNameIstioIstiod, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
 
-	igwImage, err := ImageVector.FindImage(imagevector.ContainerImageNameIstioProxy)
+	igwImage, err := ImageVector.FindImage(imagevector.ContainerImageNameIstioProxy, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
@@ -82,6 +82,7 @@ func createIstio(testValues istioTestValues) istio.Interface {
 		testValues.zones,
 		testValues.dualStack,
 		testValues.kubernetesVersion,
+		nil,
 	)
 
 	Expect(err).To(Not(HaveOccurred()))
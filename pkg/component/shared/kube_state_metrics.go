@@ -21,11 +21,12 @@ func NewKubeStateMetrics(
 	runtimeVersion *semver.Version,
 	priorityClassName string,
 	nameSuffix string,
+	mirrors imagevectorutils.Mirrors,
 ) (
 	component.DeployWaiter,
 	error,
 ) {
-	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameKubeStateMetrics, imagevectorutils.TargetVersion(runtimeVersion.String()))
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameKubeStateMetrics, imagevectorutils.TargetVersion(runtimeVersion.String()), imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
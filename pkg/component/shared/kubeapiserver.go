@@ -88,11 +88,12 @@ func NewKubeAPIServer(
 	authenticationWebhookConfig *kubeapiserver.AuthenticationWebhook,
 	authorizationWebhookConfigs []kubeapiserver.AuthorizationWebhook,
 	resourcesToStoreInETCDEvents []schema.GroupResource,
+	mirrors imagevectorutils.Mirrors,
 ) (
 	kubeapiserver.Interface,
 	error,
 ) {
-	images, err := computeKubeAPIServerImages(runtimeVersion, targetVersion, vpnConfig)
+	images, err := computeKubeAPIServerImages(runtimeVersion, targetVersion, vpnConfig, mirrors)
 	if err != nil {
 		return nil, err
 	}
@@ -301,13 +302,14 @@ func computeKubeAPIServerImages(
 	runtimeVersion *semver.Version,
 	targetVersion *semver.Version,
 	vpnConfig kubeapiserver.VPNConfig,
+	mirrors imagevectorutils.Mirrors,
 ) (
 	kubeapiserver.Images,
 	error,
 ) {
 	var result kubeapiserver.Images
 
-	imageKubeAPIServer, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameKubeApiserver, imagevectorutils.RuntimeVersion(runtimeVersion.String()), imagevectorutils.TargetVersion(targetVersion.String()))
+	imageKubeAPIServer, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameKubeApiserver, imagevectorutils.RuntimeVersion(runtimeVersion.String()), imagevectorutils.TargetVersion(targetVersion.String()), imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return kubeapiserver.Images{}, err
 	}
@@ -315,14 +317,14 @@ func computeKubeAPIServerImages(
 
 	if vpnConfig.HighAvailabilityEnabled {
 		imageNameVPNShootClient := imagevector.ContainerImageNameVpnClient
-		imageVPNClient, err := imagevector.Containers().FindImage(imageNameVPNShootClient, imagevectorutils.RuntimeVersion(runtimeVersion.String()), imagevectorutils.TargetVersion(targetVersion.String()))
+		imageVPNClient, err := imagevector.Containers().FindImage(imageNameVPNShootClient, imagevectorutils.RuntimeVersion(runtimeVersion.String()), imagevectorutils.TargetVersion(targetVersion.String()), imagevectorutils.RegistryMirrors(mirrors))
 		if err != nil {
 			return kubeapiserver.Images{}, err
 		}
 		result.VPNClient = imageVPNClient.String()
 
 		imageNameEnvoyProxy := imagevector.ContainerImageNameEnvoyProxy
-		imageEnvoyProxy, err := imagevector.Containers().FindImage(imageNameEnvoyProxy, imagevectorutils.RuntimeVersion(runtimeVersion.String()), imagevectorutils.TargetVersion(targetVersion.String()))
+		imageEnvoyProxy, err := imagevector.Containers().FindImage(imageNameEnvoyProxy, imagevectorutils.RuntimeVersion(runtimeVersion.String()), imagevectorutils.TargetVersion(targetVersion.String()), imagevectorutils.RegistryMirrors(mirrors))
 		if err != nil {
 			return kubeapiserver.Images{}, err
 		}
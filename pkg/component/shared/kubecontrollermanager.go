@@ -35,11 +35,12 @@ func NewKubeControllerManager(
 	controllerWorkers kubecontrollermanager.ControllerWorkers,
 	controllerSyncPeriods kubecontrollermanager.ControllerSyncPeriods,
 	managedResourceLabels map[string]string,
+	mirrors imagevectorutils.Mirrors,
 ) (
 	kubecontrollermanager.Interface,
 	error,
 ) {
-	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameKubeControllerManager, imagevectorutils.RuntimeVersion(runtimeVersion.String()), imagevectorutils.TargetVersion(targetVersion.String()))
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameKubeControllerManager, imagevectorutils.RuntimeVersion(runtimeVersion.String()), imagevectorutils.TargetVersion(targetVersion.String()), imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
@@ -32,15 +32,16 @@ func NewNginxIngress(
 	wildcardIngressDomains []string,
 	istioIngressGatewayLabels map[string]string,
 	seedIsGarden bool,
+	mirrors imagevectorutils.Mirrors,
 ) (
 	component.DeployWaiter,
 	error,
 ) {
-	imageController, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameNginxIngressController, imagevectorutils.TargetVersion(kubernetesVersion.String()))
+	imageController, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameNginxIngressController, imagevectorutils.TargetVersion(kubernetesVersion.String()), imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
-	imageDefaultBackend, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameIngressDefaultBackend, imagevectorutils.TargetVersion(kubernetesVersion.String()))
+	imageDefaultBackend, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameIngressDefaultBackend, imagevectorutils.TargetVersion(kubernetesVersion.String()), imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/gardener/gardener/imagevector"
 	"github.com/gardener/gardener/pkg/component"
 	oteloperator "github.com/gardener/gardener/pkg/component/observability/opentelemetry/operator"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
 // NewOpenTelemetryOperator instantiates a new `OpenTelemetryOperator` component.
@@ -18,11 +19,12 @@ func NewOpenTelemetryOperator(
 	gardenNamespaceName string,
 	enabled bool,
 	priorityClassName string,
+	mirrors imagevectorutils.Mirrors,
 ) (
 	deployer component.DeployWaiter,
 	err error,
 ) {
-	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameOpentelemetryOperator)
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameOpentelemetryOperator, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/gardener/gardener/imagevector"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/persesoperator"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
 // NewPersesOperator instantiates a new perses-operator component.
@@ -17,11 +18,12 @@ func NewPersesOperator(
 	c client.Client,
 	gardenNamespaceName string,
 	priorityClassName string,
+	mirrors imagevectorutils.Mirrors,
 ) (
 	deployer component.DeployWaiter,
 	err error,
 ) {
-	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNamePersesOperator)
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNamePersesOperator, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
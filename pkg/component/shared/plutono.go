@@ -10,6 +10,7 @@ import (
 	"github.com/gardener/gardener/imagevector"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/observability/plutono"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
@@ -25,16 +26,17 @@ func NewPlutono(
 	isGardenCluster, vpnHighAvailabilityEnabled, vpaEnabled bool,
 	wildcardCertName *string,
 	onlyDeployDataSourcesAndDashboards bool,
+	mirrors imagevectorutils.Mirrors,
 ) (
 	plutono.Interface,
 	error,
 ) {
-	plutonoImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNamePlutono)
+	plutonoImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNamePlutono, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
 
-	dataRefresher, err := imagevector.Containers().FindImage(imagevector.ContainerImageNamePlutonoDataRefresher)
+	dataRefresher, err := imagevector.Containers().FindImage(imagevector.ContainerImageNamePlutonoDataRefresher, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
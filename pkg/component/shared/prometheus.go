@@ -11,11 +11,12 @@ import (
 
 	"github.com/gardener/gardener/imagevector"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
 // NewPrometheus creates a new prometheus deployer.
-func NewPrometheus(log logr.Logger, c client.Client, namespace string, values prometheus.Values) (prometheus.Interface, error) {
-	imagePrometheus, err := imagevector.Containers().FindImage(imagevector.ContainerImageNamePrometheus)
+func NewPrometheus(log logr.Logger, c client.Client, namespace string, values prometheus.Values, mirrors imagevectorutils.Mirrors) (prometheus.Interface, error) {
+	imagePrometheus, err := imagevector.Containers().FindImage(imagevector.ContainerImageNamePrometheus, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
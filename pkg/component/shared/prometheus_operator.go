@@ -10,6 +10,7 @@ import (
 	"github.com/gardener/gardener/imagevector"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheusoperator"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
 // NewPrometheusOperator instantiates a new prometheus-operator component.
@@ -17,16 +18,17 @@ func NewPrometheusOperator(
 	c client.Client,
 	gardenNamespaceName string,
 	priorityClassName string,
+	mirrors imagevectorutils.Mirrors,
 ) (
 	deployer component.DeployWaiter,
 	err error,
 ) {
-	operatorImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNamePrometheusOperator)
+	operatorImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNamePrometheusOperator, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
 
-	reloaderImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameConfigmapReloader)
+	reloaderImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameConfigmapReloader, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
@@ -30,6 +30,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/networking/nginxingress"
 	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 	retryutils "github.com/gardener/gardener/pkg/utils/retry"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
@@ -63,11 +64,12 @@ func NewRuntimeGardenerResourceManager(
 	gardenNamespaceName string,
 	secretsManager secretsmanager.Interface,
 	values resourcemanager.Values,
+	mirrors imagevectorutils.Mirrors,
 ) (
 	resourcemanager.Interface,
 	error,
 ) {
-	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerResourceManager)
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerResourceManager, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
@@ -100,11 +102,12 @@ func NewTargetGardenerResourceManager(
 	namespaceName string,
 	secretsManager secretsmanager.Interface,
 	values resourcemanager.Values,
+	mirrors imagevectorutils.Mirrors,
 ) (
 	resourcemanager.Interface,
 	error,
 ) {
-	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerResourceManager)
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerResourceManager, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +55,7 @@ var _ = Describe("ResourceManager", func() {
 			resourceManager, err := NewRuntimeGardenerResourceManager(fakeClient, namespace, sm, resourcemanager.Values{
 				ClusterIdentity: ptr.To("foo"),
 				ConcurrentSyncs: ptr.To(21),
-			})
+			}, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resourceManager.GetValues()).To(Equal(resourcemanager.Values{
 				ClusterIdentity:                   ptr.To("foo"),
@@ -82,7 +82,7 @@ var _ = Describe("ResourceManager", func() {
 			resourceManager, err := NewTargetGardenerResourceManager(fakeClient, namespace, sm, resourcemanager.Values{
 				ClusterIdentity:  ptr.To("foo"),
 				TargetNamespaces: []string{},
-			})
+			}, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resourceManager.GetValues()).To(Equal(resourcemanager.Values{
 				AlwaysUpdate:                       ptr.To(true),
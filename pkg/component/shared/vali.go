@@ -11,6 +11,7 @@ import (
 	"github.com/gardener/gardener/imagevector"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/observability/logging/vali"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
@@ -26,31 +27,32 @@ func NewVali(
 	storage *resource.Quantity,
 	ingressHost string,
 	isGardenCluster bool,
+	mirrors imagevectorutils.Mirrors,
 ) (
 	vali.Interface,
 	error,
 ) {
-	valiImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameVali)
+	valiImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameVali, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
 
-	curatorImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameValiCurator)
+	curatorImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameValiCurator, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
 
-	tune2fsImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameTune2fs)
+	tune2fsImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameTune2fs, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
 
-	kubeRBACProxyImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameKubeRbacProxy)
+	kubeRBACProxyImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameKubeRbacProxy, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
 
-	telegrafImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameTelegraf)
+	telegrafImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameTelegraf, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
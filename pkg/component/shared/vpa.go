@@ -33,21 +33,22 @@ func NewVerticalPodAutoscaler(
 	priorityClassNameUpdater string,
 	isGardenCluster bool,
 	featureGates map[string]bool,
+	mirrors imagevectorutils.Mirrors,
 ) (
 	component.DeployWaiter,
 	error,
 ) {
-	imageAdmissionController, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameVpaAdmissionController, imagevectorutils.TargetVersion(runtimeVersion.String()))
+	imageAdmissionController, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameVpaAdmissionController, imagevectorutils.TargetVersion(runtimeVersion.String()), imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
 
-	imageRecommender, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameVpaRecommender, imagevectorutils.TargetVersion(runtimeVersion.String()))
+	imageRecommender, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameVpaRecommender, imagevectorutils.TargetVersion(runtimeVersion.String()), imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
 
-	imageUpdater, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameVpaUpdater, imagevectorutils.TargetVersion(runtimeVersion.String()))
+	imageUpdater, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameVpaUpdater, imagevectorutils.TargetVersion(runtimeVersion.String()), imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
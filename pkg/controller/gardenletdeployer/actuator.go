@@ -33,10 +33,12 @@ import (
 	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/constants"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1/helper"
 	gardenletbootstraputil "github.com/gardener/gardener/pkg/gardenlet/bootstrap/util"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	gardenletutils "github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
 )
@@ -675,7 +677,7 @@ func PrepareGardenletChartValues(
 	}
 
 	// enable self-upgrades for self-hosted shoots
-	gardenletChartImage, err := imagevector.Charts().FindImage(imagevector.ChartImageNameGardenlet)
+	gardenletChartImage, err := imagevector.Charts().FindImage(imagevector.ChartImageNameGardenlet, imagevectorutils.RegistryMirrors(gardenlethelper.RegistryMirrors(gardenletConfig.OCI)))
 	if err != nil {
 		return nil, fmt.Errorf("failed fetching gardenlet chart image: %w", err)
 	}
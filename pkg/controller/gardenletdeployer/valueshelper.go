@@ -14,6 +14,7 @@ import (
 	"github.com/gardener/gardener/imagevector"
 	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/utils"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/gardener/gardener/pkg/utils/secrets"
@@ -51,7 +52,7 @@ func (vp *valuesHelper) MergeGardenletDeployment(deployment *seedmanagementv1alp
 	}
 
 	// Get parent deployment values
-	parentDeployment, err := getParentGardenletDeployment()
+	parentDeployment, err := getParentGardenletDeployment(vp.registryMirrors())
 	if err != nil {
 		return nil, err
 	}
@@ -268,8 +269,15 @@ func (vp *valuesHelper) getGardenletConfigurationValues(config *gardenletconfigv
 	return configValues, nil
 }
 
-func getParentGardenletDeployment() (*seedmanagementv1alpha1.GardenletDeployment, error) {
-	gardenletImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenlet)
+func (vp *valuesHelper) registryMirrors() imagevectorutils.Mirrors {
+	if vp.config == nil {
+		return nil
+	}
+	return gardenlethelper.RegistryMirrors(vp.config.OCI)
+}
+
+func getParentGardenletDeployment(mirrors imagevectorutils.Mirrors) (*seedmanagementv1alpha1.GardenletDeployment, error) {
+	gardenletImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenlet, imagevectorutils.RegistryMirrors(mirrors))
 	if err != nil {
 		return nil, err
 	}
@@ -629,6 +629,37 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootCostControllerConfiguration defaulting", func() {
+		It("should default ShootCostControllerConfiguration correctly", func() {
+			expected := &ShootCostControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod: &metav1.Duration{
+					Duration: 60 * time.Minute,
+				},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCost).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootCost: &ShootCostControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+						SyncPeriod: &metav1.Duration{
+							Duration: 120 * time.Minute,
+						},
+					},
+				},
+			}
+			expected := obj.Controllers.ShootCost.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCost).To(Equal(expected))
+		})
+	})
+
 	Describe("ShootQuotaControllerConfiguration defaulting", func() {
 		It("should default ShootQuotaControllerConfiguration correctly", func() {
 			expected := &ShootQuotaControllerConfiguration{
@@ -93,6 +93,9 @@ type ControllerManagerControllerConfiguration struct {
 	SeedReference *SeedReferenceControllerConfiguration `json:"seedReference,omitempty"`
 	// ShootMaintenance defines the configuration of the ShootMaintenance controller.
 	ShootMaintenance ShootMaintenanceControllerConfiguration `json:"shootMaintenance"`
+	// ShootCost defines the configuration of the ShootCost controller.
+	// +optional
+	ShootCost *ShootCostControllerConfiguration `json:"shootCost,omitempty"`
 	// ShootQuota defines the configuration of the ShootQuota controller.
 	// +optional
 	ShootQuota *ShootQuotaControllerConfiguration `json:"shootQuota,omitempty"`
@@ -339,6 +342,19 @@ type ShootMaintenanceControllerConfiguration struct {
 	EnableShootCoreAddonRestarter *bool `json:"enableShootCoreAddonRestarter"`
 }
 
+// ShootCostControllerConfiguration defines the configuration of the
+// ShootCost controller.
+type ShootCostControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the existing resources are reconciled
+	// (how often the estimated cost of Shoots is recomputed, e.g., to reflect price changes in CloudProfiles).
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
 // ShootQuotaControllerConfiguration defines the configuration of the
 // ShootQuota controller.
 type ShootQuotaControllerConfiguration struct {
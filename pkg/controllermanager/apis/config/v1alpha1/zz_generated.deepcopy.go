@@ -250,6 +250,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		(*in).DeepCopyInto(*out)
 	}
 	in.ShootMaintenance.DeepCopyInto(&out.ShootMaintenance)
+	if in.ShootCost != nil {
+		in, out := &in.ShootCost, &out.ShootCost
+		*out = new(ShootCostControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ShootQuota != nil {
 		in, out := &in.ShootQuota, &out.ShootQuota
 		*out = new(ShootQuotaControllerConfiguration)
@@ -735,6 +740,32 @@ func (in *ShootConditionsControllerConfiguration) DeepCopy() *ShootConditionsCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootCostControllerConfiguration) DeepCopyInto(out *ShootCostControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCostControllerConfiguration.
+func (in *ShootCostControllerConfiguration) DeepCopy() *ShootCostControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootCostControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootHibernationControllerConfiguration) DeepCopyInto(out *ShootHibernationControllerConfiguration) {
 	*out = *in
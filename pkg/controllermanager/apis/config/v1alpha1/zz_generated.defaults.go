@@ -76,6 +76,9 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 		SetDefaults_SeedReferenceControllerConfiguration(in.Controllers.SeedReference)
 	}
 	SetDefaults_ShootMaintenanceControllerConfiguration(&in.Controllers.ShootMaintenance)
+	if in.Controllers.ShootCost != nil {
+		SetDefaults_ShootCostControllerConfiguration(in.Controllers.ShootCost)
+	}
 	if in.Controllers.ShootQuota != nil {
 		SetDefaults_ShootQuotaControllerConfiguration(in.Controllers.ShootQuota)
 	}
@@ -70,6 +70,13 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, managedSeedSe
 		}
 	}()
 
+	// Keep replicas within the autoscaling limits, if any
+	if managedSeedSet.DeletionTimestamp == nil && managedSeedSet.Spec.Autoscaling != nil {
+		if err := a.ensureReplicasWithinAutoscalingLimits(ctx, log, managedSeedSet); err != nil {
+			return status, false, err
+		}
+	}
+
 	// Get replicas
 	replicas, err := a.replicaGetter.GetReplicas(ctx, managedSeedSet)
 	if err != nil {
@@ -136,6 +143,10 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, managedSeedSe
 		// Determine the replica to be deleted
 		// From all deletable replicas, choose the one with lowest priority
 		if len(deletableReplicas) == 0 {
+			// If autoscaling, migrate the shoots away from a replica's seed, so that it can be deleted afterwards
+			if managedSeedSet.DeletionTimestamp == nil && managedSeedSet.Spec.Autoscaling != nil {
+				return status, false, a.drainReplica(ctx, log, managedSeedSet, status, len(replicas), readyReplicas, pendingReplica)
+			}
 			return status, false, fmt.Errorf("no deletable replicas found")
 		}
 		sort.Sort(ascendingPriority(deletableReplicas))
@@ -161,8 +172,23 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, managedSeedSe
 		}
 	}
 
-	log.V(1).Info("Nothing to do")
+	// Remove the draining taint if the replica is not going to be deleted anymore
+	if pendingReplica != nil && status.PendingReplica != nil && status.PendingReplica.Reason == seedmanagementv1alpha1.SeedDrainingReason {
+		if err := a.untaintReplica(ctx, log, pendingReplica); err != nil {
+			return status, false, err
+		}
+	}
+
 	status.PendingReplica = nil
+
+	// Scale based on the utilization of the seeds, if autoscaling and all replicas are ready
+	if managedSeedSet.DeletionTimestamp == nil && managedSeedSet.Spec.Autoscaling != nil && len(readyReplicas) == len(replicas) {
+		if err := a.autoscale(ctx, log, managedSeedSet, status, readyReplicas); err != nil {
+			return status, false, err
+		}
+	}
+
+	log.V(1).Info("Nothing to do")
 	return status, true, nil
 }
 
@@ -182,6 +208,10 @@ const (
 	EventWaitingForManagedSeedRegistered = "WaitingForManagedSeedRegistered"
 	EventWaitingForManagedSeedDeleted    = "WaitingForManagedSeedDeleted"
 	EventWaitingForSeedReady             = "WaitingForSeedReady"
+	EventScaling                         = "Scaling"
+	EventDrainingSeed                    = "DrainingSeed"
+	EventDrainingCancelled               = "DrainingCancelled"
+	EventMigrationRejected               = "MigrationRejected"
 )
 
 func (a *actuator) reconcileReplica(
@@ -7,21 +7,28 @@ package managedseedset_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/seedmanagement/encoding"
 	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/controllermanager/controller/managedseedset"
 	mockmanagedseedset "github.com/gardener/gardener/pkg/controllermanager/controller/managedseedset/mock"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/test"
 	mockrecord "github.com/gardener/gardener/third_party/mock/client-go/tools/record"
@@ -150,6 +157,7 @@ var _ = Describe("Actuator", func() {
 			r.EXPECT().IsSeedReady().Return(seedReady).AnyTimes()
 			r.EXPECT().GetShootHealthStatus().Return(shootStatus).AnyTimes()
 			r.EXPECT().IsDeletable().Return(deletable).AnyTimes()
+			r.EXPECT().IsProtected().Return(false).AnyTimes()
 		}
 	)
 
@@ -529,6 +537,258 @@ var _ = Describe("Actuator", func() {
 			),
 		)
 	})
+
+	Context("autoscaling", func() {
+		var (
+			r1  *mockmanagedseedset.MockReplica
+			mss *seedmanagementv1alpha1.ManagedSeedSet
+
+			shoot = func(seedName string, succeeded bool) gardencorev1beta1.Shoot {
+				s := gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: "shoot-" + seedName, Namespace: "garden-foo"},
+					Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To(seedName)},
+					Status:     gardencorev1beta1.ShootStatus{SeedName: ptr.To(seedName)},
+				}
+				if succeeded {
+					s.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded}
+				}
+				return s
+			}
+
+			expectSeed = func(ordinal int32, allocatable int64, shoots ...gardencorev1beta1.Shoot) {
+				gc.EXPECT().Get(ctx, client.ObjectKey{Name: getReplicaName(ordinal)}, gomock.AssignableToTypeOf(&gardencorev1beta1.Seed{})).DoAndReturn(
+					func(_ context.Context, key client.ObjectKey, seed *gardencorev1beta1.Seed, _ ...client.GetOption) error {
+						seed.Name = key.Name
+						seed.Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: *resource.NewQuantity(allocatable, resource.DecimalSI)}
+						return nil
+					},
+				)
+				gc.EXPECT().List(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.ShootList{}), client.MatchingFields{gardencore.ShootSeedName: getReplicaName(ordinal)}).DoAndReturn(
+					func(_ context.Context, list *gardencorev1beta1.ShootList, _ ...client.ListOption) error {
+						list.Items = shoots
+						return nil
+					},
+				).MinTimes(1)
+			}
+
+			expectSeedTaint = func(ordinal int32, tainted bool) {
+				gc.EXPECT().Get(ctx, getReplicaObjectKey(ordinal), gomock.AssignableToTypeOf(&seedmanagementv1alpha1.ManagedSeed{})).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, managedSeed *seedmanagementv1alpha1.ManagedSeed, _ ...client.GetOption) error {
+						gardenletConfig := &gardenletconfigv1alpha1.GardenletConfiguration{SeedConfig: &gardenletconfigv1alpha1.SeedConfig{}}
+						if !tainted {
+							gardenletConfig.SeedConfig.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "seedmanagement.gardener.cloud/draining"}}
+						}
+						managedSeed.Spec.Gardenlet.Config = runtime.RawExtension{Object: gardenletConfig}
+						return nil
+					},
+				)
+
+				matchTaints := ContainElement(gardencorev1beta1.SeedTaint{Key: "seedmanagement.gardener.cloud/draining"})
+				if !tainted {
+					matchTaints = Not(matchTaints)
+				}
+
+				gc.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&seedmanagementv1alpha1.ManagedSeed{}), gomock.Any()).DoAndReturn(
+					func(_ context.Context, managedSeed *seedmanagementv1alpha1.ManagedSeed, _ client.Patch, _ ...client.PatchOption) error {
+						gardenletConfig, err := encoding.DecodeGardenletConfiguration(&managedSeed.Spec.Gardenlet.Config, false)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(gardenletConfig.SeedConfig.Spec.Taints).To(matchTaints)
+						return nil
+					},
+				)
+				gc.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.Seed{}), gomock.Any()).DoAndReturn(
+					func(_ context.Context, seed *gardencorev1beta1.Seed, _ client.Patch, _ ...client.PatchOption) error {
+						Expect(seed.Name).To(Equal(getReplicaName(ordinal)))
+						Expect(seed.Spec.Taints).To(matchTaints)
+						return nil
+					},
+				)
+			}
+
+			expectReplicasPatch = func(replicas int32) {
+				gc.EXPECT().Patch(ctx, mss, gomock.Any()).DoAndReturn(
+					func(_ context.Context, obj *seedmanagementv1alpha1.ManagedSeedSet, _ client.Patch, _ ...client.PatchOption) error {
+						Expect(obj.Spec.Replicas).To(Equal(ptr.To(replicas)))
+						return nil
+					},
+				)
+			}
+		)
+
+		BeforeEach(func() {
+			r1 = mockmanagedseedset.NewMockReplica(ctrl)
+
+			mss = managedSeedSet(2, 2, "", "", nil)
+			mss.Status.Replicas = 2
+			mss.Spec.Autoscaling = &seedmanagementv1alpha1.ManagedSeedSetAutoscaling{
+				MinReplicas:                  ptr.To[int32](1),
+				MaxReplicas:                  3,
+				ScaleUpThresholdPercentage:   ptr.To[int32](80),
+				ScaleDownThresholdPercentage: ptr.To[int32](50),
+				ScaleDownDelay:               &metav1.Duration{Duration: time.Hour},
+			}
+		})
+
+		It("should set the replicas to the maximum replicas if they exceed it", func() {
+			mss.Spec.Replicas = ptr.To[int32](5)
+			expectReplicasPatch(3)
+			rg.EXPECT().GetReplicas(ctx, mss).Return(nil, fmt.Errorf("fake"))
+			recorder.EXPECT().Eventf(mss, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, "fake")
+
+			_, _, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).To(MatchError("fake"))
+		})
+
+		It("should scale up if the utilization exceeds the scale-up threshold", func() {
+			expectReplica(r0, 0, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, false)
+			expectReplica(r1, 1, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, false)
+			rg.EXPECT().GetReplicas(ctx, mss).Return([]Replica{r0, r1}, nil)
+			expectSeed(0, 10, make([]gardencorev1beta1.Shoot, 9)...)
+			expectSeed(1, 10, make([]gardencorev1beta1.Shoot, 8)...)
+			recorder.EXPECT().Eventf(mss, corev1.EventTypeNormal, EventScaling, "Scaling from %d to %d replicas as %d of %d allocatable shoots are scheduled", int32(2), int32(3), int64(17), int64(20))
+			expectReplicasPatch(3)
+
+			s, _, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s.LastScaleTime).To(Equal(&now))
+		})
+
+		It("should scale down if the remaining replicas do not exceed the scale-down threshold", func() {
+			expectReplica(r0, 0, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, false)
+			expectReplica(r1, 1, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, false)
+			rg.EXPECT().GetReplicas(ctx, mss).Return([]Replica{r0, r1}, nil)
+			expectSeed(0, 10, make([]gardencorev1beta1.Shoot, 3)...)
+			expectSeed(1, 10, make([]gardencorev1beta1.Shoot, 1)...)
+			recorder.EXPECT().Eventf(mss, corev1.EventTypeNormal, EventScaling, "Scaling from %d to %d replicas as %d of %d allocatable shoots are scheduled", int32(2), int32(1), int64(4), int64(20))
+			expectReplicasPatch(1)
+
+			s, _, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s.LastScaleTime).To(Equal(&now))
+		})
+
+		It("should not scale down before the scale-down delay has passed", func() {
+			mss.Status.LastScaleTime = &metav1.Time{Time: now.Add(-30 * time.Minute)}
+			expectReplica(r0, 0, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, false)
+			expectReplica(r1, 1, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, false)
+			rg.EXPECT().GetReplicas(ctx, mss).Return([]Replica{r0, r1}, nil)
+			expectSeed(0, 10, make([]gardencorev1beta1.Shoot, 3)...)
+			expectSeed(1, 10, make([]gardencorev1beta1.Shoot, 1)...)
+
+			s, removeFinalizer, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removeFinalizer).To(BeTrue())
+			Expect(s.LastScaleTime).To(Equal(mss.Status.LastScaleTime))
+		})
+
+		It("should migrate the shoots away from the seed with the fewest shoots if no replica is deletable", func() {
+			mss.Spec.Replicas = ptr.To[int32](1)
+			expectReplica(r0, 0, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, false)
+			expectReplica(r1, 1, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, false)
+			rg.EXPECT().GetReplicas(ctx, mss).Return([]Replica{r0, r1}, nil)
+
+			var (
+				migratable    = shoot(getReplicaName(1), true)
+				notMigratable = shoot(getReplicaName(1), false)
+			)
+			notMigratable.Name = "not-migratable"
+			expectSeed(0, 10, make([]gardencorev1beta1.Shoot, 3)...)
+			expectSeed(1, 10, migratable, notMigratable)
+			expectSeedTaint(1, true)
+
+			sw := mockclient.NewMockSubResourceClient(ctrl)
+			gc.EXPECT().SubResource("binding").Return(sw)
+			sw.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{})).DoAndReturn(
+				func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					Expect(obj.GetName()).To(Equal(migratable.Name))
+					Expect(obj.(*gardencorev1beta1.Shoot).Spec.SeedName).To(Equal(ptr.To(getReplicaName(0))))
+					return nil
+				},
+			)
+			recorder.EXPECT().Eventf(mss, corev1.EventTypeNormal, EventDrainingSeed, "Migrating control plane of Shoot %s from Seed %s to Seed %s",
+				client.ObjectKeyFromObject(&migratable), getReplicaName(1), getReplicaName(0))
+
+			s, removeFinalizer, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removeFinalizer).To(BeFalse())
+			Expect(s.PendingReplica).To(Equal(&seedmanagementv1alpha1.PendingReplica{
+				Name:   getReplicaName(1),
+				Reason: seedmanagementv1alpha1.SeedDrainingReason,
+				Since:  now,
+			}))
+		})
+
+		It("should skip shoots whose migration is rejected and continue with the next one", func() {
+			mss.Spec.Replicas = ptr.To[int32](1)
+			expectReplica(r0, 0, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, false)
+			expectReplica(r1, 1, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, false)
+			rg.EXPECT().GetReplicas(ctx, mss).Return([]Replica{r0, r1}, nil)
+
+			var (
+				rejected = shoot(getReplicaName(1), true)
+				accepted = shoot(getReplicaName(1), true)
+			)
+			rejected.Name = "rejected"
+			expectSeed(0, 10, make([]gardencorev1beta1.Shoot, 3)...)
+			expectSeed(1, 10, rejected, accepted)
+			expectSeedTaint(1, true)
+
+			rejectedErr := apierrors.NewForbidden(gardencorev1beta1.Resource("shoots"), rejected.Name, fmt.Errorf("fake"))
+			sw := mockclient.NewMockSubResourceClient(ctrl)
+			gc.EXPECT().SubResource("binding").Return(sw).Times(2)
+			gomock.InOrder(
+				sw.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{})).DoAndReturn(
+					func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+						Expect(obj.GetName()).To(Equal(rejected.Name))
+						return rejectedErr
+					},
+				),
+				sw.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{})).DoAndReturn(
+					func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+						Expect(obj.GetName()).To(Equal(accepted.Name))
+						return nil
+					},
+				),
+			)
+			recorder.EXPECT().Eventf(mss, corev1.EventTypeNormal, EventDrainingSeed, "Migrating control plane of Shoot %s from Seed %s to Seed %s",
+				client.ObjectKeyFromObject(&rejected), getReplicaName(1), getReplicaName(0))
+			recorder.EXPECT().Eventf(mss, corev1.EventTypeWarning, EventMigrationRejected, "Migrating control plane of Shoot %s from Seed %s to Seed %s was rejected: %v",
+				client.ObjectKeyFromObject(&rejected), getReplicaName(1), getReplicaName(0), rejectedErr)
+			recorder.EXPECT().Eventf(mss, corev1.EventTypeNormal, EventDrainingSeed, "Migrating control plane of Shoot %s from Seed %s to Seed %s",
+				client.ObjectKeyFromObject(&accepted), getReplicaName(1), getReplicaName(0))
+
+			_, removeFinalizer, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removeFinalizer).To(BeFalse())
+		})
+
+		It("should cancel draining if the utilization exceeds the scale-up threshold", func() {
+			mss.Spec.Replicas = ptr.To[int32](1)
+			mss.Status.PendingReplica = &seedmanagementv1alpha1.PendingReplica{Name: getReplicaName(1), Reason: seedmanagementv1alpha1.SeedDrainingReason, Since: before}
+			expectReplica(r0, 0, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, false)
+			expectReplica(r1, 1, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, false)
+			rg.EXPECT().GetReplicas(ctx, mss).Return([]Replica{r0, r1}, nil)
+			expectSeed(0, 10, make([]gardencorev1beta1.Shoot, 9)...)
+			expectSeed(1, 10, make([]gardencorev1beta1.Shoot, 8)...)
+
+			recorder.EXPECT().Eventf(mss, corev1.EventTypeNormal, EventDrainingCancelled, "Cancelling scale-in to %d replicas as %d of %d allocatable shoots are scheduled", int32(1), int64(17), int64(20))
+			expectSeedTaint(1, false)
+			gc.EXPECT().Get(ctx, client.ObjectKey{Name: getReplicaName(1)}, gomock.AssignableToTypeOf(&gardencorev1beta1.Seed{})).DoAndReturn(
+				func(_ context.Context, key client.ObjectKey, seed *gardencorev1beta1.Seed, _ ...client.GetOption) error {
+					seed.Name = key.Name
+					seed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "seedmanagement.gardener.cloud/draining"}}
+					return nil
+				},
+			)
+			expectReplicasPatch(2)
+
+			s, removeFinalizer, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removeFinalizer).To(BeFalse())
+			Expect(s.PendingReplica).To(BeNil())
+			Expect(s.LastScaleTime).To(Equal(&now))
+		})
+	})
 })
 
 func getReplicaName(ordinal int32) string {
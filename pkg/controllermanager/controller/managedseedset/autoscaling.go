@@ -0,0 +1,405 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedseedset
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/apis/seedmanagement/encoding"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	seedmanagementv1alpha1constants "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1/constants"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// seedUtilization contains the number of shoots scheduled on the seed of a replica and the number of shoots the seed
+// can host.
+type seedUtilization struct {
+	replica     Replica
+	seed        *gardencorev1beta1.Seed
+	scheduled   int64
+	allocatable int64
+}
+
+func (u seedUtilization) free() int64 {
+	return u.allocatable - u.scheduled
+}
+
+// ensureReplicasWithinAutoscalingLimits sets the replicas of the given ManagedSeedSet to the closest value within the
+// limits of its autoscaling configuration.
+func (a *actuator) ensureReplicasWithinAutoscalingLimits(ctx context.Context, log logr.Logger, managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet) error {
+	var (
+		autoscaling = managedSeedSet.Spec.Autoscaling
+		replicas    = ptr.Deref(managedSeedSet.Spec.Replicas, 0)
+		desired     = max(min(replicas, autoscaling.MaxReplicas), ptr.Deref(autoscaling.MinReplicas, 1))
+	)
+
+	if replicas == desired {
+		return nil
+	}
+
+	log.Info("Replicas are not within autoscaling limits, adapting them", "replicas", replicas, "desiredReplicas", desired)
+	return a.setReplicas(ctx, managedSeedSet, desired)
+}
+
+// autoscale adds a replica to the given ManagedSeedSet if the utilization of the seeds of its ready replicas exceeds
+// the scale-up threshold. It removes a replica if the scale-down delay has passed and the utilization of the remaining
+// replicas would not exceed the scale-down threshold.
+func (a *actuator) autoscale(
+	ctx context.Context,
+	log logr.Logger,
+	managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet,
+	status *seedmanagementv1alpha1.ManagedSeedSetStatus,
+	readyReplicas []Replica,
+) error {
+	utilizations, err := a.getSeedUtilizations(ctx, readyReplicas)
+	if err != nil {
+		return err
+	}
+
+	var scheduled, allocatable int64
+	for _, u := range utilizations {
+		scheduled += u.scheduled
+		allocatable += u.allocatable
+	}
+	if allocatable == 0 {
+		log.V(1).Info("Seeds do not limit the number of allocatable shoots, not autoscaling")
+		return nil
+	}
+
+	var (
+		autoscaling = managedSeedSet.Spec.Autoscaling
+		replicas    = ptr.Deref(managedSeedSet.Spec.Replicas, 0)
+		desired     = replicas
+	)
+
+	switch {
+	case replicas < autoscaling.MaxReplicas && exceedsScaleUpThreshold(autoscaling, scheduled, allocatable):
+		desired++
+
+	case replicas > ptr.Deref(autoscaling.MinReplicas, 1) && a.scaleDownDelayPassed(managedSeedSet, status):
+		if candidate := getDrainCandidate(utilizations); candidate != nil {
+			remaining := allocatable - candidate.allocatable
+			if scheduled*100 <= remaining*int64(ptr.Deref(autoscaling.ScaleDownThresholdPercentage, 0)) {
+				desired--
+			}
+		}
+	}
+
+	if desired == replicas {
+		return nil
+	}
+
+	log.Info("Scaling based on seed utilization", "replicas", replicas, "desiredReplicas", desired, "scheduledShoots", scheduled, "allocatableShoots", allocatable)
+	a.infoEventf(managedSeedSet, EventScaling, "Scaling from %d to %d replicas as %d of %d allocatable shoots are scheduled", replicas, desired, scheduled, allocatable)
+	if err := a.setReplicas(ctx, managedSeedSet, desired); err != nil {
+		return err
+	}
+
+	status.LastScaleTime = ptr.To(Now())
+	return nil
+}
+
+// drainReplica migrates the shoots scheduled on the seed of a replica to the seeds of other ready replicas, so that the
+// replica can be deleted afterwards. The seed is tainted so that no new shoots are scheduled on it. The control planes
+// are only migrated during the maintenance time window of the respective shoots. Shoots whose migration is rejected are
+// skipped. If the utilization exceeds the scale-up threshold while draining, the scale-in is cancelled by resetting
+// the replicas to the given current count.
+func (a *actuator) drainReplica(
+	ctx context.Context,
+	log logr.Logger,
+	managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet,
+	status *seedmanagementv1alpha1.ManagedSeedSetStatus,
+	count int,
+	readyReplicas []Replica,
+	pendingReplica Replica,
+) error {
+	utilizations, err := a.getSeedUtilizations(ctx, readyReplicas)
+	if err != nil {
+		return err
+	}
+
+	var candidate *seedUtilization
+	for i, u := range utilizations {
+		// Continue draining the replica which is already being drained
+		if pendingReplica != nil && status.PendingReplica.Reason == seedmanagementv1alpha1.SeedDrainingReason && u.replica.GetName() == pendingReplica.GetName() {
+			candidate = &utilizations[i]
+			break
+		}
+	}
+
+	var scheduled, allocatable int64
+	for _, u := range utilizations {
+		scheduled += u.scheduled
+		allocatable += u.allocatable
+	}
+	if exceedsScaleUpThreshold(managedSeedSet.Spec.Autoscaling, scheduled, allocatable) {
+		return a.cancelDrain(ctx, log, managedSeedSet, status, count, candidate, scheduled, allocatable)
+	}
+
+	if candidate == nil {
+		candidate = getDrainCandidate(utilizations)
+	}
+	if candidate == nil {
+		return fmt.Errorf("no deletable replicas found")
+	}
+
+	log = log.WithValues("replica", candidate.replica.GetObjectKey())
+	updatePendingReplica(status, candidate.replica.GetName(), seedmanagementv1alpha1.SeedDrainingReason, nil)
+
+	if err := a.taintReplica(ctx, log, candidate); err != nil {
+		return err
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := a.gardenClient.List(ctx, shootList, client.MatchingFields{gardencore.ShootSeedName: candidate.replica.GetName()}); err != nil {
+		return err
+	}
+
+	for _, shoot := range shootList.Items {
+		if shoot.DeletionTimestamp != nil || (shoot.Status.SeedName != nil && *shoot.Status.SeedName != candidate.replica.GetName()) {
+			continue
+		}
+
+		if shoot.Status.LastOperation == nil || shoot.Status.LastOperation.State != gardencorev1beta1.LastOperationStateSucceeded ||
+			!gardenerutils.EffectiveShootMaintenanceTimeWindow(&shoot).Contains(Now().Time) {
+			log.V(1).Info("Postponing control plane migration of Shoot to its maintenance time window", "shoot", client.ObjectKeyFromObject(&shoot))
+			continue
+		}
+
+		target := getMigrationTarget(utilizations, candidate)
+		if target == nil {
+			log.Info("No seed with free capacity found for migrating control planes")
+			a.infoEventf(managedSeedSet, EventDrainingSeed, "No seed with free capacity found for migrating control planes away from Seed %s", candidate.replica.GetName())
+			return nil
+		}
+
+		log.Info("Migrating control plane of Shoot", "shoot", client.ObjectKeyFromObject(&shoot), "targetSeed", target.replica.GetName())
+		a.infoEventf(managedSeedSet, EventDrainingSeed, "Migrating control plane of Shoot %s from Seed %s to Seed %s", client.ObjectKeyFromObject(&shoot), candidate.replica.GetName(), target.replica.GetName())
+		shoot.Spec.SeedName = ptr.To(target.replica.GetName())
+		if err := a.gardenClient.SubResource("binding").Update(ctx, &shoot); err != nil {
+			if apierrors.IsForbidden(err) || apierrors.IsInvalid(err) {
+				// The migration was rejected (e.g., by an admission plugin), hence retrying it would not help. Skip this
+				// shoot so that the control planes of the other shoots can still be migrated.
+				log.Error(err, "Control plane migration of Shoot was rejected, skipping it", "shoot", client.ObjectKeyFromObject(&shoot), "targetSeed", target.replica.GetName())
+				a.errorEventf(managedSeedSet, EventMigrationRejected, "Migrating control plane of Shoot %s from Seed %s to Seed %s was rejected: %v", client.ObjectKeyFromObject(&shoot), candidate.replica.GetName(), target.replica.GetName(), err)
+				continue
+			}
+			return fmt.Errorf("failed binding shoot %s to seed %s: %w", client.ObjectKeyFromObject(&shoot), target.replica.GetName(), err)
+		}
+		target.scheduled++
+	}
+
+	return nil
+}
+
+// cancelDrain cancels a scale-in by resetting the replicas of the given ManagedSeedSet to the given current count. If a
+// replica is already being drained, its seed is untainted, so that shoots can be scheduled on it again.
+func (a *actuator) cancelDrain(
+	ctx context.Context,
+	log logr.Logger,
+	managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet,
+	status *seedmanagementv1alpha1.ManagedSeedSetStatus,
+	count int,
+	drained *seedUtilization,
+	scheduled, allocatable int64,
+) error {
+	replicas := int32(count) // #nosec G115 -- count is the number of replicas of the ManagedSeedSet, which cannot exceed max int32.
+
+	log.Info("Cancelling scale-in as utilization exceeds the scale-up threshold", "desiredReplicas", replicas, "scheduledShoots", scheduled, "allocatableShoots", allocatable)
+	a.infoEventf(managedSeedSet, EventDrainingCancelled, "Cancelling scale-in to %d replicas as %d of %d allocatable shoots are scheduled", ptr.Deref(managedSeedSet.Spec.Replicas, 0), scheduled, allocatable)
+
+	if drained != nil {
+		if err := a.untaintReplica(ctx, log, drained.replica); err != nil {
+			return err
+		}
+	}
+
+	if err := a.setReplicas(ctx, managedSeedSet, replicas); err != nil {
+		return err
+	}
+
+	status.PendingReplica = nil
+	status.LastScaleTime = ptr.To(Now())
+	return nil
+}
+
+// taintReplica adds the draining taint to the seed of the given replica. The taint is added to the seed template in
+// the gardenlet configuration of the replica's ManagedSeed, so that it is kept when gardenlet registers the seed again,
+// and to the seed itself, so that the scheduler takes it into account immediately.
+func (a *actuator) taintReplica(ctx context.Context, log logr.Logger, u *seedUtilization) error {
+	if v1beta1helper.TaintsHave(u.seed.Spec.Taints, seedmanagementv1alpha1constants.SeedTaintDraining) {
+		return nil
+	}
+
+	log.Info("Tainting seed to prevent scheduling shoots on it while draining")
+	if err := a.mutateSeedTemplateTaints(ctx, u.replica, func(taints []gardencorev1beta1.SeedTaint) []gardencorev1beta1.SeedTaint {
+		return append(taints, gardencorev1beta1.SeedTaint{Key: seedmanagementv1alpha1constants.SeedTaintDraining})
+	}); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(u.seed.DeepCopy())
+	u.seed.Spec.Taints = append(u.seed.Spec.Taints, gardencorev1beta1.SeedTaint{Key: seedmanagementv1alpha1constants.SeedTaintDraining})
+	if err := a.gardenClient.Patch(ctx, u.seed, patch); err != nil {
+		return fmt.Errorf("failed tainting seed %s: %w", u.seed.Name, err)
+	}
+
+	return nil
+}
+
+// untaintReplica removes the draining taint from the seed of the given replica, see taintReplica.
+func (a *actuator) untaintReplica(ctx context.Context, log logr.Logger, r Replica) error {
+	removeTaint := func(taints []gardencorev1beta1.SeedTaint) []gardencorev1beta1.SeedTaint {
+		return slices.DeleteFunc(taints, func(taint gardencorev1beta1.SeedTaint) bool {
+			return taint.Key == seedmanagementv1alpha1constants.SeedTaintDraining
+		})
+	}
+
+	if err := a.mutateSeedTemplateTaints(ctx, r, removeTaint); err != nil {
+		return err
+	}
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := a.gardenClient.Get(ctx, client.ObjectKey{Name: r.GetName()}, seed); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !v1beta1helper.TaintsHave(seed.Spec.Taints, seedmanagementv1alpha1constants.SeedTaintDraining) {
+		return nil
+	}
+
+	log.Info("Removing draining taint from seed", "replica", r.GetObjectKey())
+	patch := client.MergeFrom(seed.DeepCopy())
+	seed.Spec.Taints = removeTaint(seed.Spec.Taints)
+	if err := a.gardenClient.Patch(ctx, seed, patch); err != nil {
+		return fmt.Errorf("failed removing taint from seed %s: %w", seed.Name, err)
+	}
+
+	return nil
+}
+
+// mutateSeedTemplateTaints applies the given function to the taints of the seed template in the gardenlet configuration
+// of the given replica's ManagedSeed and updates the ManagedSeed if the taints have changed.
+func (a *actuator) mutateSeedTemplateTaints(ctx context.Context, r Replica, mutate func([]gardencorev1beta1.SeedTaint) []gardencorev1beta1.SeedTaint) error {
+	managedSeed := &seedmanagementv1alpha1.ManagedSeed{}
+	if err := a.gardenClient.Get(ctx, r.GetObjectKey(), managedSeed); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	gardenletConfig, err := encoding.DecodeGardenletConfiguration(&managedSeed.Spec.Gardenlet.Config, false)
+	if err != nil {
+		return fmt.Errorf("failed decoding gardenlet configuration of ManagedSeed %s: %w", client.ObjectKeyFromObject(managedSeed), err)
+	}
+	if gardenletConfig.SeedConfig == nil {
+		return nil
+	}
+
+	taints := mutate(slices.Clone(gardenletConfig.SeedConfig.Spec.Taints))
+	if slices.Equal(taints, gardenletConfig.SeedConfig.Spec.Taints) {
+		return nil
+	}
+	gardenletConfig.SeedConfig.Spec.Taints = taints
+
+	patch := client.MergeFrom(managedSeed.DeepCopy())
+	rawConfig, err := encoding.EncodeGardenletConfiguration(gardenletConfig)
+	if err != nil {
+		return fmt.Errorf("failed encoding gardenlet configuration of ManagedSeed %s: %w", client.ObjectKeyFromObject(managedSeed), err)
+	}
+	managedSeed.Spec.Gardenlet.Config = *rawConfig
+
+	return a.gardenClient.Patch(ctx, managedSeed, patch)
+}
+
+// getSeedUtilizations returns the utilization of the seeds of the given replicas. Seeds which do not limit the number
+// of allocatable shoots are omitted.
+func (a *actuator) getSeedUtilizations(ctx context.Context, replicas []Replica) ([]seedUtilization, error) {
+	var utilizations []seedUtilization
+
+	for _, r := range replicas {
+		seed := &gardencorev1beta1.Seed{}
+		if err := a.gardenClient.Get(ctx, client.ObjectKey{Name: r.GetName()}, seed); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		allocatable, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]
+		if !ok {
+			continue
+		}
+
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := a.gardenClient.List(ctx, shootList, client.MatchingFields{gardencore.ShootSeedName: seed.Name}); err != nil {
+			return nil, err
+		}
+
+		utilizations = append(utilizations, seedUtilization{
+			replica:     r,
+			seed:        seed,
+			scheduled:   int64(len(shootList.Items)),
+			allocatable: allocatable.Value(),
+		})
+	}
+
+	return utilizations, nil
+}
+
+func (a *actuator) setReplicas(ctx context.Context, managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, replicas int32) error {
+	patch := client.MergeFrom(managedSeedSet.DeepCopy())
+	managedSeedSet.Spec.Replicas = &replicas
+	return a.gardenClient.Patch(ctx, managedSeedSet, patch)
+}
+
+// exceedsScaleUpThreshold returns true if the given number of scheduled shoots exceeds the scale-up threshold of the
+// given number of allocatable shoots.
+func exceedsScaleUpThreshold(autoscaling *seedmanagementv1alpha1.ManagedSeedSetAutoscaling, scheduled, allocatable int64) bool {
+	return allocatable > 0 && scheduled*100 > allocatable*int64(ptr.Deref(autoscaling.ScaleUpThresholdPercentage, 0))
+}
+
+func (a *actuator) scaleDownDelayPassed(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, status *seedmanagementv1alpha1.ManagedSeedSetStatus) bool {
+	if status.LastScaleTime == nil || managedSeedSet.Spec.Autoscaling.ScaleDownDelay == nil {
+		return true
+	}
+	return !Now().Time.Before(status.LastScaleTime.Add(managedSeedSet.Spec.Autoscaling.ScaleDownDelay.Duration))
+}
+
+// getDrainCandidate returns the utilization of the seed with the fewest scheduled shoots whose replica is not protected
+// from deletion.
+func getDrainCandidate(utilizations []seedUtilization) *seedUtilization {
+	var candidate *seedUtilization
+	for i, u := range utilizations {
+		if u.replica.IsProtected() {
+			continue
+		}
+		if candidate == nil || u.scheduled < candidate.scheduled {
+			candidate = &utilizations[i]
+		}
+	}
+	return candidate
+}
+
+// getMigrationTarget returns the utilization of the seed with the most free capacity other than the drained one.
+func getMigrationTarget(utilizations []seedUtilization, drained *seedUtilization) *seedUtilization {
+	var target *seedUtilization
+	for i, u := range utilizations {
+		if u.replica.GetName() == drained.replica.GetName() || u.free() <= 0 {
+			continue
+		}
+		if target == nil || u.free() > target.free() {
+			target = &utilizations[i]
+		}
+	}
+	return target
+}
@@ -240,6 +240,20 @@ func (mr *MockReplicaMockRecorder) IsDeletable() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDeletable", reflect.TypeOf((*MockReplica)(nil).IsDeletable))
 }
 
+// IsProtected mocks base method.
+func (m *MockReplica) IsProtected() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsProtected")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsProtected indicates an expected call of IsProtected.
+func (mr *MockReplicaMockRecorder) IsProtected() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsProtected", reflect.TypeOf((*MockReplica)(nil).IsProtected))
+}
+
 // IsSeedReady mocks base method.
 func (m *MockReplica) IsSeedReady() bool {
 	m.ctrl.T.Helper()
@@ -91,6 +91,8 @@ type Replica interface {
 	IsSeedReady() bool
 	// GetShootHealthStatus returns this replica's shoot health status (healthy, progressing, or unhealthy).
 	GetShootHealthStatus() gardenerutils.ShootStatus
+	// IsProtected returns true if this replica is protected by the "protect-from-deletion" annotation, false otherwise.
+	IsProtected() bool
 	// IsDeletable returns true if this replica can be deleted, false otherwise. A replica can be deleted if it has no
 	// scheduled shoots and is not protected by the "protect-from-deletion" annotation.
 	IsDeletable() bool
@@ -230,12 +232,17 @@ func (r *replica) GetShootHealthStatus() gardenerutils.ShootStatus {
 	return shootHealthStatus(r.shoot)
 }
 
+// IsProtected returns true if this replica is protected by the "protect-from-deletion" annotation, false otherwise.
+func (r *replica) IsProtected() bool {
+	shootProtected := r.shoot != nil && kubernetesutils.HasMetaDataAnnotation(r.shoot, seedmanagementv1alpha1constants.AnnotationProtectFromDeletion, "true")
+	managedSeedProtected := r.managedSeed != nil && kubernetesutils.HasMetaDataAnnotation(r.managedSeed, seedmanagementv1alpha1constants.AnnotationProtectFromDeletion, "true")
+	return shootProtected || managedSeedProtected
+}
+
 // IsDeletable returns true if this replica can be deleted, false otherwise. A replica can be deleted if it has no
 // scheduled shoots and is not protected by the "protect-from-deletion" annotation.
 func (r *replica) IsDeletable() bool {
-	shootProtected := r.shoot != nil && kubernetesutils.HasMetaDataAnnotation(r.shoot, seedmanagementv1alpha1constants.AnnotationProtectFromDeletion, "true")
-	managedSeedProtected := r.managedSeed != nil && kubernetesutils.HasMetaDataAnnotation(r.managedSeed, seedmanagementv1alpha1constants.AnnotationProtectFromDeletion, "true")
-	return !r.hasScheduledShoots && !shootProtected && !managedSeedProtected
+	return !r.hasScheduledShoots && !r.IsProtected()
 }
 
 // CreateShoot initializes this replica's shoot and then creates it using the given context and client.
@@ -280,6 +280,21 @@ var _ = Describe("Replica", func() {
 			shoot(nil, "", "", gardenerutils.ShootStatusUnknown, false), gardenerutils.ShootStatusUnknown),
 	)
 
+	DescribeTable("#IsProtected",
+		func(shoot *gardencorev1beta1.Shoot, managedSeed *seedmanagementv1alpha1.ManagedSeed, protected bool) {
+			replica := NewReplica(managedSeedSet, shoot, managedSeed, nil, false)
+			Expect(replica.IsProtected()).To(Equal(protected))
+		},
+		Entry("should return false",
+			nil, nil, false),
+		Entry("should return false",
+			shoot(nil, "", "", "", false), managedSeed(nil, false, false), false),
+		Entry("should return true",
+			shoot(nil, "", "", "", true), nil, true),
+		Entry("should return true",
+			shoot(nil, "", "", "", false), managedSeed(nil, false, true), true),
+	)
+
 	DescribeTable("#IsDeletable",
 		func(shoot *gardencorev1beta1.Shoot, managedSeed *seedmanagementv1alpha1.ManagedSeed, hasScheduledShoots, deletable bool) {
 			replica := NewReplica(managedSeedSet, shoot, managedSeed, nil, hasScheduledShoots)
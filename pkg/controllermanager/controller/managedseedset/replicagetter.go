@@ -99,10 +99,19 @@ func (rg *replicaGetter) GetReplicas(ctx context.Context, managedSeedSet *seedma
 }
 
 func (rg *replicaGetter) hasScheduledShoots(ctx context.Context, seed *gardencorev1beta1.Seed) (bool, error) {
-	if seed != nil {
-		return kubernetesutils.ResourcesExist(ctx, rg.apiReader, &gardencorev1beta1.ShootList{}, rg.client.Scheme(), client.MatchingFields{
-			gardencore.ShootSeedName: seed.Name,
+	if seed == nil {
+		return false, nil
+	}
+
+	// Shoots whose control plane is being migrated away from the seed are still considered as scheduled until the
+	// migration is finished.
+	for _, fieldName := range []string{gardencore.ShootSeedName, gardencore.ShootStatusSeedName} {
+		exist, err := kubernetesutils.ResourcesExist(ctx, rg.apiReader, &gardencorev1beta1.ShootList{}, rg.client.Scheme(), client.MatchingFields{
+			fieldName: seed.Name,
 		})
+		if err != nil || exist {
+			return exist, err
+		}
 	}
 	return false, nil
 }
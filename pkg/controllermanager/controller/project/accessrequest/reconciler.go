@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package accessrequest
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler reconciles AccessRequests and grants time-bound project memberships for approved requests.
+type Reconciler struct {
+	Client   client.Client
+	Config   controllermanagerconfigv1alpha1.ProjectControllerConfiguration
+	Clock    clock.Clock
+	Recorder record.EventRecorder
+}
+
+// Reconcile reconciles AccessRequests and grants time-bound project memberships for approved requests.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	accessRequest := &gardencorev1beta1.AccessRequest{}
+	if err := r.Client.Get(ctx, request.NamespacedName, accessRequest); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if accessRequest.DeletionTimestamp != nil || accessRequest.Status.Phase == gardencorev1beta1.AccessRequestExpired {
+		return reconcile.Result{}, nil
+	}
+
+	if accessRequest.Annotations[v1beta1constants.AccessRequestApproved] != "true" {
+		return reconcile.Result{}, r.patchStatus(ctx, accessRequest, gardencorev1beta1.AccessRequestPending, nil)
+	}
+
+	if accessRequest.Status.Phase != gardencorev1beta1.AccessRequestGranted {
+		expirationTimestamp := metav1.NewTime(r.Clock.Now().Add(accessRequest.Spec.Duration.Duration))
+		if err := r.patchStatus(ctx, accessRequest, gardencorev1beta1.AccessRequestGranted, &expirationTimestamp); err != nil {
+			return reconcile.Result{}, err
+		}
+		log.Info("Granted access request", "expirationTimestamp", expirationTimestamp)
+	}
+
+	// The project controller removes the member from the project once the membership has expired.
+	untilExpiration := accessRequest.Status.ExpirationTimestamp.Sub(r.Clock.Now())
+	if untilExpiration <= 0 {
+		log.Info("Access request has expired")
+		return reconcile.Result{}, r.patchStatus(ctx, accessRequest, gardencorev1beta1.AccessRequestExpired, accessRequest.Status.ExpirationTimestamp)
+	}
+
+	if err := r.ensureProjectMember(ctx, log, accessRequest); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Add a second to make sure the access request has expired when it is reconciled again.
+	return reconcile.Result{RequeueAfter: untilExpiration + time.Second}, nil
+}
+
+func (r *Reconciler) patchStatus(ctx context.Context, accessRequest *gardencorev1beta1.AccessRequest, phase gardencorev1beta1.AccessRequestPhase, expirationTimestamp *metav1.Time) error {
+	if accessRequest.Status.Phase == phase {
+		return nil
+	}
+
+	patch := client.MergeFrom(accessRequest.DeepCopy())
+	accessRequest.Status.Phase = phase
+	accessRequest.Status.ExpirationTimestamp = expirationTimestamp
+	return r.Client.Status().Patch(ctx, accessRequest, patch)
+}
+
+// ensureProjectMember adds the subject of the access request to the project members. If the subject already has an
+// expiring membership, the requested roles are added and the later expiration timestamp is kept. Permanent memberships
+// are not touched.
+func (r *Reconciler) ensureProjectMember(ctx context.Context, log logr.Logger, accessRequest *gardencorev1beta1.AccessRequest) error {
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, accessRequest.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed getting project for namespace %s: %w", accessRequest.Namespace, err)
+	}
+
+	// Use an optimistic lock to not overwrite members which were added or updated concurrently.
+	oldProject := project.DeepCopy()
+	patch := client.MergeFromWithOptions(oldProject, client.MergeFromWithOptimisticLock{})
+
+	idx := slices.IndexFunc(project.Spec.Members, func(member gardencorev1beta1.ProjectMember) bool {
+		return member.Subject == accessRequest.Spec.Subject
+	})
+
+	if idx == -1 {
+		project.Spec.Members = append(project.Spec.Members, gardencorev1beta1.ProjectMember{
+			Subject:             accessRequest.Spec.Subject,
+			Roles:               accessRequest.Spec.Roles,
+			ExpirationTimestamp: accessRequest.Status.ExpirationTimestamp,
+		})
+	} else {
+		member := &project.Spec.Members[idx]
+		if member.ExpirationTimestamp == nil {
+			log.Info("Subject is already a permanent member of the project, nothing to grant")
+			return nil
+		}
+
+		roles := sets.New(member.Roles...)
+		for _, role := range accessRequest.Spec.Roles {
+			if !roles.Has(role) {
+				member.Roles = append(member.Roles, role)
+			}
+		}
+		if member.ExpirationTimestamp.Before(accessRequest.Status.ExpirationTimestamp) {
+			member.ExpirationTimestamp = accessRequest.Status.ExpirationTimestamp
+		}
+	}
+
+	if apiequality.Semantic.DeepEqual(oldProject.Spec.Members, project.Spec.Members) {
+		return nil
+	}
+
+	if err := r.Client.Patch(ctx, project, patch); err != nil {
+		return fmt.Errorf("failed adding project member: %w", err)
+	}
+
+	log.Info("Added member to project", "projectName", project.Name)
+	r.Recorder.Eventf(project, corev1.EventTypeNormal, gardencorev1beta1.ProjectEventAccessRequestGranted, "Granted roles %s to %s %q until %s as requested by AccessRequest %s", strings.Join(accessRequest.Spec.Roles, ", "), accessRequest.Spec.Subject.Kind, accessRequest.Spec.Subject.Name, accessRequest.Status.ExpirationTimestamp.UTC().Format(time.RFC3339), accessRequest.Name)
+	return nil
+}
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package accessrequest_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/accessrequest"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+
+		subject       = rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "alice"}
+		project       *gardencorev1beta1.Project
+		accessRequest *gardencorev1beta1.AccessRequest
+		request       reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			WithStatusSubresource(&gardencorev1beta1.AccessRequest{}).
+			Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+		recorder = record.NewFakeRecorder(10)
+		reconciler = &Reconciler{Client: fakeClient, Clock: fakeClock, Recorder: recorder}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-dev")},
+		}
+		accessRequest = &gardencorev1beta1.AccessRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "request", Namespace: "garden-dev"},
+			Spec: gardencorev1beta1.AccessRequestSpec{
+				Subject:  subject,
+				Roles:    []string{gardencorev1beta1.ProjectMemberAdmin},
+				Duration: metav1.Duration{Duration: time.Hour},
+				Reason:   "incident",
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(accessRequest)}
+	})
+
+	JustBeforeEach(func() {
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		Expect(fakeClient.Create(ctx, accessRequest)).To(Succeed())
+	})
+
+	expectMembers := func(matchers ...any) {
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		ExpectWithOffset(1, project.Spec.Members).To(ConsistOf(matchers...))
+	}
+
+	It("should do nothing if the object is gone", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Name: "foo", Namespace: "garden-dev"}})).To(Equal(reconcile.Result{}))
+	})
+
+	Context("request is not approved", func() {
+		It("should set the phase to Pending", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, accessRequest)).To(Succeed())
+			Expect(accessRequest.Status.Phase).To(Equal(gardencorev1beta1.AccessRequestPending))
+			expectMembers()
+		})
+	})
+
+	Context("request is approved", func() {
+		BeforeEach(func() {
+			accessRequest.Annotations = map[string]string{"access.gardener.cloud/approved": "true"}
+		})
+
+		It("should grant the request and add the member to the project", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour + time.Second}))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, accessRequest)).To(Succeed())
+			Expect(accessRequest.Status.Phase).To(Equal(gardencorev1beta1.AccessRequestGranted))
+			Expect(accessRequest.Status.ExpirationTimestamp.Time).To(BeTemporally("==", fakeClock.Now().Add(time.Hour)))
+
+			expectMembers(gardencorev1beta1.ProjectMember{
+				Subject:             subject,
+				Roles:               []string{gardencorev1beta1.ProjectMemberAdmin},
+				ExpirationTimestamp: accessRequest.Status.ExpirationTimestamp,
+			})
+			Expect(recorder.Events).To(Receive(ContainSubstring(`Granted roles admin to User "alice"`)))
+		})
+
+		It("should not change the expiration timestamp on subsequent reconciliations", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour + time.Second}))
+			Eventually(recorder.Events).Should(Receive())
+
+			fakeClock.Step(30 * time.Minute)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 30*time.Minute + time.Second}))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, accessRequest)).To(Succeed())
+			Expect(accessRequest.Status.ExpirationTimestamp.Time).To(BeTemporally("==", fakeClock.Now().Add(30*time.Minute)))
+			Expect(recorder.Events).NotTo(Receive())
+		})
+
+		It("should set the phase to Expired once the membership has expired", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour + time.Second}))
+
+			fakeClock.Step(time.Hour + time.Second)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, accessRequest)).To(Succeed())
+			Expect(accessRequest.Status.Phase).To(Equal(gardencorev1beta1.AccessRequestExpired))
+			Expect(accessRequest.Status.ExpirationTimestamp).NotTo(BeNil())
+		})
+
+		Context("subject is already a member", func() {
+			BeforeEach(func() {
+				project.Spec.Members = []gardencorev1beta1.ProjectMember{{
+					Subject:             subject,
+					Roles:               []string{gardencorev1beta1.ProjectMemberViewer},
+					ExpirationTimestamp: &metav1.Time{Time: fakeClock.Now().Add(time.Minute)},
+				}}
+			})
+
+			It("should merge the roles and extend an expiring membership", func() {
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour + time.Second}))
+
+				Expect(fakeClient.Get(ctx, request.NamespacedName, accessRequest)).To(Succeed())
+				expectMembers(gardencorev1beta1.ProjectMember{
+					Subject:             subject,
+					Roles:               []string{gardencorev1beta1.ProjectMemberViewer, gardencorev1beta1.ProjectMemberAdmin},
+					ExpirationTimestamp: accessRequest.Status.ExpirationTimestamp,
+				})
+			})
+
+			It("should not touch a permanent membership", func() {
+				project.Spec.Members[0].ExpirationTimestamp = nil
+				Expect(fakeClient.Update(ctx, project)).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour + time.Second}))
+
+				expectMembers(gardencorev1beta1.ProjectMember{
+					Subject: subject,
+					Roles:   []string{gardencorev1beta1.ProjectMemberViewer},
+				})
+				Expect(recorder.Events).NotTo(Receive())
+			})
+		})
+	})
+
+	Context("request has expired", func() {
+		BeforeEach(func() {
+			accessRequest.Annotations = map[string]string{"access.gardener.cloud/approved": "true"}
+			accessRequest.Status.Phase = gardencorev1beta1.AccessRequestExpired
+		})
+
+		It("should not add the member again", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			expectMembers()
+		})
+	})
+})
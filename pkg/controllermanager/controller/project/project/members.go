@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// removeExpiredMembers removes all members whose membership has expired from the project. This revokes their access
+// since the RBAC resources are only created for the remaining members.
+func (r *Reconciler) removeExpiredMembers(ctx context.Context, log logr.Logger, project *gardencorev1beta1.Project) error {
+	var (
+		now     = r.Clock.Now()
+		members = make([]gardencorev1beta1.ProjectMember, 0, len(project.Spec.Members))
+		expired []gardencorev1beta1.ProjectMember
+	)
+
+	for _, member := range project.Spec.Members {
+		if member.ExpirationTimestamp != nil && !member.ExpirationTimestamp.After(now) {
+			expired = append(expired, member)
+			continue
+		}
+		members = append(members, member)
+	}
+
+	if len(expired) == 0 {
+		return nil
+	}
+
+	// Use an optimistic lock to not overwrite members which were added or updated concurrently.
+	patch := client.MergeFromWithOptions(project.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	project.Spec.Members = members
+	if err := r.Client.Patch(ctx, project, patch); err != nil {
+		return fmt.Errorf("failed removing expired members: %w", err)
+	}
+
+	for _, member := range expired {
+		log.Info("Removed expired member", "kind", member.Kind, "name", member.Name, "namespace", member.Namespace, "expirationTimestamp", member.ExpirationTimestamp)
+		r.Recorder.Eventf(project, corev1.EventTypeNormal, gardencorev1beta1.ProjectEventMembershipExpired, "Removed %s %q from project members since its membership expired at %s", member.Kind, member.Name, member.ExpirationTimestamp.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// nextMembershipExpiration returns the duration until the next membership of the project expires. It returns 0 if no
+// membership expires in the future.
+func (r *Reconciler) nextMembershipExpiration(project *gardencorev1beta1.Project) time.Duration {
+	var (
+		now  = r.Clock.Now()
+		next time.Duration
+	)
+
+	for _, member := range project.Spec.Members {
+		if member.ExpirationTimestamp == nil || !member.ExpirationTimestamp.After(now) {
+			continue
+		}
+
+		// Add a second to make sure the membership has expired when the project is reconciled again.
+		if untilExpiration := member.ExpirationTimestamp.Sub(now) + time.Second; next == 0 || untilExpiration < next {
+			next = untilExpiration
+		}
+	}
+
+	return next
+}
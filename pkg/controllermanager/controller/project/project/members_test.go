@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("Members", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+
+		project *gardencorev1beta1.Project
+	)
+
+	member := func(name string, expirationTimestamp *metav1.Time) gardencorev1beta1.ProjectMember {
+		return gardencorev1beta1.ProjectMember{
+			Subject:             rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: name},
+			Role:                gardencorev1beta1.ProjectMemberAdmin,
+			ExpirationTimestamp: expirationTimestamp,
+		}
+	}
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+		recorder = record.NewFakeRecorder(10)
+		reconciler = &Reconciler{Client: fakeClient, Clock: fakeClock, Recorder: recorder}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: gardencorev1beta1.ProjectSpec{
+				Members: []gardencorev1beta1.ProjectMember{
+					member("permanent", nil),
+					member("expired", &metav1.Time{Time: fakeClock.Now().Add(-time.Minute)}),
+					member("expiring", &metav1.Time{Time: fakeClock.Now().Add(time.Hour)}),
+				},
+			},
+		}
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+	})
+
+	Describe("#removeExpiredMembers", func() {
+		It("should remove the expired members", func() {
+			Expect(reconciler.removeExpiredMembers(ctx, logr.Discard(), project)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			Expect(project.Spec.Members).To(ConsistOf(
+				HaveField("Name", "permanent"),
+				HaveField("Name", "expiring"),
+			))
+			Expect(recorder.Events).To(Receive(ContainSubstring(`Removed User "expired" from project members`)))
+		})
+
+		It("should remove members whose membership expired in the meantime", func() {
+			fakeClock.Step(time.Hour)
+
+			Expect(reconciler.removeExpiredMembers(ctx, logr.Discard(), project)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			Expect(project.Spec.Members).To(ConsistOf(HaveField("Name", "permanent")))
+		})
+
+		It("should not update the project if no membership expired", func() {
+			project.Spec.Members = project.Spec.Members[:1]
+			Expect(fakeClient.Update(ctx, project)).To(Succeed())
+			resourceVersion := project.ResourceVersion
+
+			Expect(reconciler.removeExpiredMembers(ctx, logr.Discard(), project)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			Expect(project.ResourceVersion).To(Equal(resourceVersion))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
+
+	Describe("#nextMembershipExpiration", func() {
+		It("should return the duration until the next membership expires", func() {
+			project.Spec.Members = append(project.Spec.Members, member("expiring-later", &metav1.Time{Time: fakeClock.Now().Add(2 * time.Hour)}))
+
+			Expect(reconciler.nextMembershipExpiration(project)).To(Equal(time.Hour + time.Second))
+		})
+
+		It("should return zero if no membership expires", func() {
+			project.Spec.Members = project.Spec.Members[:1]
+
+			Expect(reconciler.nextMembershipExpiration(project)).To(BeZero())
+		})
+	})
+})
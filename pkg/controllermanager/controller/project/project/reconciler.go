@@ -20,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -41,6 +42,7 @@ import (
 type Reconciler struct {
 	Client   client.Client
 	Config   controllermanagerconfigv1alpha1.ProjectControllerConfiguration
+	Clock    clock.Clock
 	Recorder record.EventRecorder
 
 	// RateLimiter allows limiting exponential backoff for testing purposes
@@ -66,7 +68,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	log.Info("Reconciling project")
-	return reconcile.Result{}, r.reconcile(ctx, log, project)
+	if err := r.reconcile(ctx, log, project); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Reconcile the project again when the next membership expires to revoke the access of the member.
+	return reconcile.Result{RequeueAfter: r.nextMembershipExpiration(project)}, nil
 }
 
 func patchProjectPhase(ctx context.Context, c client.Client, project *gardencorev1beta1.Project, phase gardencorev1beta1.ProjectPhase) error {
@@ -138,6 +145,14 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 		}
 	}
 
+	if err := r.removeExpiredMembers(ctx, log, project); err != nil {
+		r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, "Error while removing expired members: %+v", err)
+		if err := patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectFailed); err != nil {
+			log.Error(err, "Failed to update Project status")
+		}
+		return err
+	}
+
 	// Create RBAC rules to allow project members to interact with it.
 	rbac, err := projectrbac.New(r.Client, project)
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/conditions"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/cost"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/hibernation"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/maintenance"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/migration"
@@ -28,6 +29,12 @@ func AddToManager(mgr manager.Manager, cfg controllermanagerconfigv1alpha1.Contr
 		return fmt.Errorf("failed adding conditions reconciler: %w", err)
 	}
 
+	if err := (&cost.Reconciler{
+		Config: *cfg.Controllers.ShootCost,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding cost reconciler: %w", err)
+	}
+
 	if err := (&hibernation.Reconciler{
 		Config: cfg.Controllers.ShootHibernation,
 	}).AddToManager(mgr); err != nil {
@@ -46,6 +53,12 @@ func AddToManager(mgr manager.Manager, cfg controllermanagerconfigv1alpha1.Contr
 		return fmt.Errorf("failed adding quota reconciler: %w", err)
 	}
 
+	if err := (&quota.StatusReconciler{
+		Config: *cfg.Controllers.ShootQuota,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding quota status reconciler: %w", err)
+	}
+
 	if err := (&migration.Reconciler{
 		Config: *cfg.Controllers.ShootMigration,
 	}).AddToManager(mgr); err != nil {
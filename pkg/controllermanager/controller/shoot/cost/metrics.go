@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cost
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// ProjectEstimatedMonthlyCost defines the gauge gardener_controller_manager_project_estimated_monthly_cost.
+var ProjectEstimatedMonthlyCost = promauto.With(runtimemetrics.Registry).NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "gardener_controller_manager",
+		Name:      "project_estimated_monthly_cost",
+		Help:      "Sum of the estimated monthly cost of all Shoots in a project for the minimum resp. maximum number of worker nodes.",
+	},
+	[]string{
+		"project",
+		"currency",
+		"bound",
+	},
+)
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cost
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler reconciles Shoots, estimates their cost based on the prices in the CloudProfile and reports it in the
+// Shoot status. It also maintains metrics about the estimated cost per project.
+type Reconciler struct {
+	Client client.Client
+	Config controllermanagerconfigv1alpha1.ShootCostControllerConfiguration
+
+	metricsLock sync.Mutex
+}
+
+// Reconcile reconciles Shoots, estimates their cost based on the prices in the CloudProfile and reports it in the
+// Shoot status.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, updating project metrics")
+			return reconcile.Result{}, r.updateProjectMetrics(ctx, request.Namespace)
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	estimate, err := r.estimateCost(ctx, log, shoot)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !apiequality.Semantic.DeepEqual(shoot.Status.EstimatedCost, estimate) {
+		log.Info("Updating estimated cost", "estimatedCost", estimate)
+
+		patch := client.MergeFrom(shoot.DeepCopy())
+		shoot.Status.EstimatedCost = estimate
+		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed patching estimated cost: %w", err)
+		}
+	}
+
+	if err := r.updateProjectMetrics(ctx, shoot.Namespace); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) estimateCost(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.ShootCostEstimate, error) {
+	if shoot.DeletionTimestamp != nil {
+		return nil, nil
+	}
+
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	if err != nil {
+		return nil, fmt.Errorf("could not find referenced cloud profile: %w", err)
+	}
+
+	coreShoot := &core.Shoot{}
+	if err := gardencorev1beta1.Convert_v1beta1_Shoot_To_core_Shoot(shoot, coreShoot, nil); err != nil {
+		return nil, err
+	}
+
+	estimate, err := gardenerutils.EstimateShootCost(coreShoot, &cloudProfile.Spec)
+	if err != nil {
+		// The prices in the CloudProfile cannot be combined for this Shoot, hence there is no point in retrying before
+		// the CloudProfile or the Shoot changes.
+		log.Info("Cannot estimate cost", "reason", err.Error())
+		return nil, nil
+	}
+
+	return estimate, nil
+}
+
+// updateProjectMetrics sums up the estimated cost of all Shoots in the given namespace and reports it for the
+// respective project.
+func (r *Reconciler) updateProjectMetrics(ctx context.Context, namespace string) error {
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed getting project for namespace %s: %w", namespace, err)
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.InNamespace(namespace)); err != nil {
+		return err
+	}
+
+	var (
+		monthlyMinimum = make(map[string]*resource.Quantity)
+		monthlyMaximum = make(map[string]*resource.Quantity)
+	)
+
+	for _, shoot := range shootList.Items {
+		estimate := shoot.Status.EstimatedCost
+		if estimate == nil {
+			continue
+		}
+
+		if _, ok := monthlyMinimum[estimate.Currency]; !ok {
+			monthlyMinimum[estimate.Currency], monthlyMaximum[estimate.Currency] = &resource.Quantity{}, &resource.Quantity{}
+		}
+		monthlyMinimum[estimate.Currency].Add(estimate.MonthlyMinimum)
+		monthlyMaximum[estimate.Currency].Add(estimate.MonthlyMaximum)
+	}
+
+	r.metricsLock.Lock()
+	defer r.metricsLock.Unlock()
+
+	ProjectEstimatedMonthlyCost.DeletePartialMatch(prometheus.Labels{"project": project.Name})
+	for currency, quantity := range monthlyMinimum {
+		ProjectEstimatedMonthlyCost.WithLabelValues(project.Name, currency, "minimum").Set(quantity.AsApproximateFloat64())
+		ProjectEstimatedMonthlyCost.WithLabelValues(project.Name, currency, "maximum").Set(monthlyMaximum[currency].AsApproximateFloat64())
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cost_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/cost"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		reconciler *Reconciler
+
+		project      *gardencorev1beta1.Project
+		cloudProfile *gardencorev1beta1.CloudProfile
+		shoot        *gardencorev1beta1.Shoot
+		request      reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+			Build()
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: controllermanagerconfigv1alpha1.ShootCostControllerConfiguration{
+				ConcurrentSyncs: ptr.To(1),
+				SyncPeriod:      &metav1.Duration{Duration: time.Hour},
+			},
+		}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-dev")},
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				MachineTypes: []gardencorev1beta1.MachineType{
+					{Name: "small", Price: &gardencorev1beta1.Price{Currency: "EUR", Hourly: resource.MustParse("0.1")}},
+				},
+				Regions: []gardencorev1beta1.Region{{Name: "region-1"}},
+			},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfile: &gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "profile"},
+				Region:       "region-1",
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{
+						Name:    "worker",
+						Machine: gardencorev1beta1.Machine{Type: "small"},
+						Minimum: 1,
+						Maximum: 2,
+					}},
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		ProjectEstimatedMonthlyCost.Reset()
+	})
+
+	It("should report the estimated cost in the status and the project metrics", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.EstimatedCost).NotTo(BeNil())
+		Expect(shoot.Status.EstimatedCost.Currency).To(Equal("EUR"))
+		Expect(shoot.Status.EstimatedCost.HourlyMinimum.String()).To(Equal("100m"))
+		Expect(shoot.Status.EstimatedCost.HourlyMaximum.String()).To(Equal("200m"))
+		Expect(shoot.Status.EstimatedCost.MonthlyMinimum.String()).To(Equal("73"))
+		Expect(shoot.Status.EstimatedCost.MonthlyMaximum.String()).To(Equal("146"))
+
+		Expect(testutil.ToFloat64(ProjectEstimatedMonthlyCost.WithLabelValues("dev", "EUR", "minimum"))).To(Equal(73.0))
+		Expect(testutil.ToFloat64(ProjectEstimatedMonthlyCost.WithLabelValues("dev", "EUR", "maximum"))).To(Equal(146.0))
+	})
+
+	It("should remove the estimated cost if the CloudProfile has no prices", func() {
+		shoot.Status.EstimatedCost = &gardencorev1beta1.ShootCostEstimate{Currency: "EUR"}
+		Expect(fakeClient.Status().Update(ctx, shoot)).To(Succeed())
+
+		cloudProfile.Spec.MachineTypes[0].Price = nil
+		Expect(fakeClient.Update(ctx, cloudProfile)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.EstimatedCost).To(BeNil())
+		Expect(testutil.CollectAndCount(ProjectEstimatedMonthlyCost)).To(BeZero())
+	})
+
+	It("should remove the project metrics when the last Shoot is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(testutil.CollectAndCount(ProjectEstimatedMonthlyCost)).To(Equal(2))
+
+		Expect(fakeClient.Delete(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(testutil.CollectAndCount(ProjectEstimatedMonthlyCost)).To(BeZero())
+	})
+})
@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorehelper "github.com/gardener/gardener/pkg/apis/core/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// StatusReconciler reconciles Quotas and reports the resources allocated by the Shoots bound to them in their status.
+type StatusReconciler struct {
+	Client   client.Client
+	Config   controllermanagerconfigv1alpha1.ShootQuotaControllerConfiguration
+	Recorder record.EventRecorder
+}
+
+// Reconcile reconciles Quotas and reports the resources allocated by the Shoots bound to them in their status.
+func (r *StatusReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	quota := &gardencorev1beta1.Quota{}
+	if err := r.Client.Get(ctx, request.NamespacedName, quota); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if quota.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	return reconcile.Result{}, r.reconcileStatus(ctx, log, quota)
+}
+
+// reconcileStatus computes the resources allocated by the Shoots bound to the Quota and reports them in the status.
+// An event is recorded when the usage of a metric exceeds the warning threshold of the Quota.
+func (r *StatusReconciler) reconcileStatus(ctx context.Context, log logr.Logger, quota *gardencorev1beta1.Quota) error {
+	used, err := r.computeUsage(ctx, log, quota)
+	if err != nil {
+		return fmt.Errorf("failed computing usage: %w", err)
+	}
+
+	status := gardencorev1beta1.QuotaStatus{Used: used}
+	if len(quota.Spec.Metrics) > 0 {
+		status.Hard = quota.Spec.Metrics.DeepCopy()
+	}
+
+	if apiequality.Semantic.DeepEqual(quota.Status, status) {
+		return nil
+	}
+
+	metricsAboveThresholdBefore := gardenerutils.QuotaMetricsAboveWarningThreshold(quota, quota.Status.Used)
+
+	patch := client.MergeFrom(quota.DeepCopy())
+	quota.Status = status
+	if err := r.Client.Status().Patch(ctx, quota, patch); err != nil {
+		return fmt.Errorf("failed patching status: %w", err)
+	}
+
+	// Only record an event if a metric newly exceeds the warning threshold to prevent flooding the Quota with events.
+	metricsAboveThreshold := gardenerutils.QuotaMetricsAboveWarningThreshold(quota, used)
+	if slices.ContainsFunc(metricsAboveThreshold, func(metric corev1.ResourceName) bool { return !slices.Contains(metricsAboveThresholdBefore, metric) }) {
+		log.Info("Usage exceeds warning threshold", "metrics", metricsAboveThreshold)
+		r.Recorder.Eventf(quota, corev1.EventTypeWarning, gardencorev1beta1.QuotaEventWarningThresholdExceeded, "More than %d%% of the limits are allocated for %v", ptr.Deref(quota.Spec.WarningThresholdPercentage, 0), metricsAboveThreshold)
+	}
+
+	return nil
+}
+
+// computeUsage computes the resources allocated by the Shoots bound to the given Quota. For Quotas with project scope,
+// the limits apply to each project separately, hence the usage of the project with the highest allocation is
+// reported per metric. Otherwise, the allocations of all Shoots are summed up. Shoots whose allocation cannot be
+// computed (e.g., because they use a machine type which is not part of their cloud profile) are skipped, so that a single
+// invalid Shoot does not prevent reporting the usage of all other Shoots.
+func (r *StatusReconciler) computeUsage(ctx context.Context, log logr.Logger, quota *gardencorev1beta1.Quota) (corev1.ResourceList, error) {
+	if len(quota.Spec.Metrics) == 0 {
+		return nil, nil
+	}
+
+	scope, err := gardencorehelper.QuotaScope(quota.Spec.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	bindingsPerNamespace, err := r.bindingsReferencingQuota(ctx, quota)
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(corev1.ResourceList, len(quota.Spec.Metrics))
+	for metric := range quota.Spec.Metrics {
+		used[metric] = resource.Quantity{}
+	}
+
+	for namespace, bindingNames := range bindingsPerNamespace {
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := r.Client.List(ctx, shootList, client.InNamespace(namespace)); err != nil {
+			return nil, err
+		}
+
+		namespaceUsage := make(corev1.ResourceList, len(quota.Spec.Metrics))
+		for _, shoot := range shootList.Items {
+			if !bindingNames.Has(ptr.Deref(shoot.Spec.SecretBindingName, "")) && !bindingNames.Has(ptr.Deref(shoot.Spec.CredentialsBindingName, "")) {
+				continue
+			}
+
+			cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, &shoot)
+			if err != nil {
+				if !apierrors.IsNotFound(err) {
+					return nil, fmt.Errorf("failed reading cloud profile of shoot %s: %w", client.ObjectKeyFromObject(&shoot), err)
+				}
+				log.Info("Skipping shoot for computing usage since its cloud profile was not found", "shoot", client.ObjectKeyFromObject(&shoot))
+				continue
+			}
+
+			shootResources, err := shootResources(&shoot, cloudProfile)
+			if err != nil {
+				log.Error(err, "Skipping shoot for computing usage since its resources cannot be computed", "shoot", client.ObjectKeyFromObject(&shoot))
+				continue
+			}
+
+			for metric := range quota.Spec.Metrics {
+				quantity := namespaceUsage[metric]
+				quantity.Add(shootResources[metric])
+				namespaceUsage[metric] = quantity
+			}
+		}
+
+		for metric := range quota.Spec.Metrics {
+			quantity, namespaceQuantity := used[metric], namespaceUsage[metric]
+			if scope != "project" {
+				quantity.Add(namespaceQuantity)
+			} else if namespaceQuantity.Cmp(quantity) > 0 {
+				quantity = namespaceQuantity
+			}
+			used[metric] = quantity
+		}
+	}
+
+	return used, nil
+}
+
+// bindingsReferencingQuota returns the names of the SecretBindings and CredentialsBindings referencing the given Quota
+// grouped by their namespace.
+func (r *StatusReconciler) bindingsReferencingQuota(ctx context.Context, quota *gardencorev1beta1.Quota) (map[string]sets.Set[string], error) {
+	var (
+		bindingsPerNamespace = make(map[string]sets.Set[string])
+		addIfReferenced      = func(namespace, name string, quotas []corev1.ObjectReference) {
+			for _, quotaRef := range quotas {
+				if quotaRef.Name == quota.Name && quotaRef.Namespace == quota.Namespace {
+					if _, ok := bindingsPerNamespace[namespace]; !ok {
+						bindingsPerNamespace[namespace] = sets.New[string]()
+					}
+					bindingsPerNamespace[namespace].Insert(name)
+				}
+			}
+		}
+	)
+
+	secretBindingList := &gardencorev1beta1.SecretBindingList{}
+	if err := r.Client.List(ctx, secretBindingList); err != nil {
+		return nil, err
+	}
+	for _, binding := range secretBindingList.Items {
+		addIfReferenced(binding.Namespace, binding.Name, binding.Quotas)
+	}
+
+	credentialsBindingList := &securityv1alpha1.CredentialsBindingList{}
+	if err := r.Client.List(ctx, credentialsBindingList); err != nil {
+		return nil, err
+	}
+	for _, binding := range credentialsBindingList.Items {
+		addIfReferenced(binding.Namespace, binding.Name, binding.Quotas)
+	}
+
+	return bindingsPerNamespace, nil
+}
+
+func shootResources(shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) (corev1.ResourceList, error) {
+	coreShoot := &core.Shoot{}
+	if err := gardencorev1beta1.Convert_v1beta1_Shoot_To_core_Shoot(shoot, coreShoot, nil); err != nil {
+		return nil, err
+	}
+
+	return gardenerutils.ShootQuotaResources(coreShoot, &cloudProfile.Spec)
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package quota_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	shootquota "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/quota"
+)
+
+var _ = Describe("StatusAdd", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		reconciler *shootquota.StatusReconciler
+
+		quotaRefs []corev1.ObjectReference
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		reconciler = &shootquota.StatusReconciler{Client: fakeClient}
+
+		quotaRefs = []corev1.ObjectReference{
+			{Namespace: "garden", Name: "quota1"},
+			{Namespace: "garden", Name: "quota2"},
+		}
+	})
+
+	Describe("#MapBindingToQuotas", func() {
+		It("should map a SecretBinding to its quotas", func() {
+			Expect(shootquota.MapBindingToQuotas(ctx, &gardencorev1beta1.SecretBinding{Quotas: quotaRefs})).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "garden", Name: "quota1"}},
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "garden", Name: "quota2"}},
+			))
+		})
+
+		It("should map a CredentialsBinding to its quotas", func() {
+			Expect(shootquota.MapBindingToQuotas(ctx, &securityv1alpha1.CredentialsBinding{Quotas: quotaRefs[:1]})).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "garden", Name: "quota1"}},
+			))
+		})
+	})
+
+	Describe("#MapShootToQuotas", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Namespace: "project", Name: "shoot"}}
+		})
+
+		It("should map a shoot to the quotas of its SecretBinding", func() {
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.SecretBinding{ObjectMeta: metav1.ObjectMeta{Namespace: "project", Name: "binding"}, Quotas: quotaRefs})).To(Succeed())
+			shoot.Spec.SecretBindingName = ptr.To("binding")
+
+			Expect(reconciler.MapShootToQuotas(logr.Discard())(ctx, shoot)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "garden", Name: "quota1"}},
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "garden", Name: "quota2"}},
+			))
+		})
+
+		It("should map a shoot to the quotas of its CredentialsBinding", func() {
+			Expect(fakeClient.Create(ctx, &securityv1alpha1.CredentialsBinding{ObjectMeta: metav1.ObjectMeta{Namespace: "project", Name: "binding"}, Quotas: quotaRefs[1:]})).To(Succeed())
+			shoot.Spec.CredentialsBindingName = ptr.To("binding")
+
+			Expect(reconciler.MapShootToQuotas(logr.Discard())(ctx, shoot)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "garden", Name: "quota2"}},
+			))
+		})
+
+		It("should return nothing if the binding does not exist", func() {
+			shoot.Spec.SecretBindingName = ptr.To("binding")
+
+			Expect(reconciler.MapShootToQuotas(logr.Discard())(ctx, shoot)).To(BeEmpty())
+		})
+
+		It("should return nothing if the shoot does not reference a binding", func() {
+			Expect(reconciler.MapShootToQuotas(logr.Discard())(ctx, shoot)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package quota_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	shootquota "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/quota"
+)
+
+var _ = Describe("StatusReconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		recorder   *record.FakeRecorder
+		reconciler *shootquota.StatusReconciler
+
+		quota   *gardencorev1beta1.Quota
+		request reconcile.Request
+	)
+
+	shootWithWorkers := func(namespace, name string, maximum int32) *gardencorev1beta1.Shoot {
+		return &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfile: &gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "profile"},
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{
+						Name:    "worker",
+						Machine: gardencorev1beta1.Machine{Type: "small"},
+						Maximum: maximum,
+						Volume:  &gardencorev1beta1.Volume{Type: ptr.To("standard"), VolumeSize: "10Gi"},
+					}},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Quota{}).Build()
+		recorder = record.NewFakeRecorder(10)
+		reconciler = &shootquota.StatusReconciler{Client: fakeClient, Recorder: recorder}
+
+		quota = &gardencorev1beta1.Quota{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "garden", Name: "quota"},
+			Spec: gardencorev1beta1.QuotaSpec{
+				Scope: corev1.ObjectReference{APIVersion: "v1", Kind: "Secret"},
+				Metrics: corev1.ResourceList{
+					"cpu":    resource.MustParse("20"),
+					"memory": resource.MustParse("100Gi"),
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(quota)}
+
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				MachineTypes: []gardencorev1beta1.MachineType{{Name: "small", CPU: resource.MustParse("2"), Memory: resource.MustParse("4Gi")}},
+				VolumeTypes:  []gardencorev1beta1.VolumeType{{Name: "standard", Class: gardencorev1beta1.VolumeClassStandard}},
+			},
+		})).To(Succeed())
+
+		quotaRefs := []corev1.ObjectReference{{Namespace: quota.Namespace, Name: quota.Name}}
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.SecretBinding{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "binding"}, Quotas: quotaRefs})).To(Succeed())
+		Expect(fakeClient.Create(ctx, &securityv1alpha1.CredentialsBinding{ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "binding"}, Quotas: quotaRefs})).To(Succeed())
+
+		shoot1 := shootWithWorkers("ns1", "shoot1", 2)
+		shoot1.Spec.SecretBindingName = ptr.To("binding")
+		shoot2 := shootWithWorkers("ns1", "shoot2", 1)
+		shoot2.Spec.SecretBindingName = ptr.To("binding")
+		shoot3 := shootWithWorkers("ns2", "shoot3", 1)
+		shoot3.Spec.CredentialsBindingName = ptr.To("binding")
+		unboundShoot := shootWithWorkers("ns2", "unbound", 5)
+		unboundShoot.Spec.CredentialsBindingName = ptr.To("other-binding")
+
+		for _, shoot := range []*gardencorev1beta1.Shoot{shoot1, shoot2, shoot3, unboundShoot} {
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		}
+	})
+
+	JustBeforeEach(func() {
+		Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+	})
+
+	It("should do nothing if the quota is gone", func() {
+		Expect(fakeClient.Delete(ctx, quota)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should sum up the usage of all bound shoots", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, quota)).To(Succeed())
+		Expect(quota.Status.Hard).To(Equal(quota.Spec.Metrics))
+		Expect(quota.Status.Used.Cpu().String()).To(Equal("8"))
+		Expect(quota.Status.Used.Memory().String()).To(Equal("16Gi"))
+		Expect(quota.Status.Used).NotTo(HaveKey(corev1.ResourceName("loadbalancer")))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should skip shoots whose resources cannot be computed", func() {
+		invalidShoot := shootWithWorkers("ns1", "invalid", 3)
+		invalidShoot.Spec.SecretBindingName = ptr.To("binding")
+		invalidShoot.Spec.Provider.Workers[0].Machine.Type = "unknown"
+		Expect(fakeClient.Create(ctx, invalidShoot)).To(Succeed())
+
+		unknownCloudProfileShoot := shootWithWorkers("ns1", "unknown-cloud-profile", 3)
+		unknownCloudProfileShoot.Spec.SecretBindingName = ptr.To("binding")
+		unknownCloudProfileShoot.Spec.CloudProfile.Name = "unknown"
+		Expect(fakeClient.Create(ctx, unknownCloudProfileShoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, quota)).To(Succeed())
+		Expect(quota.Status.Used.Cpu().String()).To(Equal("8"))
+	})
+
+	Context("project scope", func() {
+		BeforeEach(func() {
+			quota.Spec.Scope = corev1.ObjectReference{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Project"}
+		})
+
+		It("should report the usage of the project with the highest allocation", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, quota)).To(Succeed())
+			Expect(quota.Status.Used.Cpu().String()).To(Equal("6"))
+		})
+	})
+
+	Context("warning threshold", func() {
+		BeforeEach(func() {
+			quota.Spec.WarningThresholdPercentage = ptr.To[int32](30)
+		})
+
+		It("should record an event once the usage exceeds the warning threshold", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(recorder.Events).To(Receive(Equal("Warning WarningThresholdExceeded More than 30% of the limits are allocated for [cpu]")))
+
+			By("Reconcile again without changes")
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
+
+	Context("no metrics", func() {
+		BeforeEach(func() {
+			quota.Spec.Metrics = nil
+		})
+
+		It("should not report any usage", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, quota)).To(Succeed())
+			Expect(quota.Status).To(Equal(gardencorev1beta1.QuotaStatus{}))
+		})
+	})
+})
@@ -7,13 +7,19 @@ package reconciler
 import (
 	"context"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
+const tracerName = "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+
 type operationAnnotationWrapper struct {
 	reconcile.Reconciler
 
@@ -25,6 +31,11 @@ type operationAnnotationWrapper struct {
 // removes the Gardener operation annotation before `Reconcile` is called.
 //
 // This is useful in conjunction with the HasOperationAnnotation predicate.
+//
+// If the object carries the trace context of the component which requested the operation (see
+// tracing.InjectIntoAnnotations), `Reconcile` is called with a span which continues this trace. Otherwise, the context
+// is passed unchanged. The span is recorded
+// with the global tracer provider, i.e., only if the process has set up tracing.
 func OperationAnnotationWrapper(mgr manager.Manager, newObjFunc func() client.Object, reconciler reconcile.Reconciler) reconcile.Reconciler {
 	return &operationAnnotationWrapper{
 		client:     mgr.GetClient(),
@@ -53,5 +64,14 @@ func (o *operationAnnotationWrapper) Reconcile(ctx context.Context, request reco
 		}
 	}
 
+	if traceCtx := tracing.ContextFromAnnotations(ctx, obj); trace.SpanContextFromContext(traceCtx).IsRemote() {
+		var span trace.Span
+		ctx, span = otel.Tracer(tracerName).Start(traceCtx, "Reconcile", trace.WithAttributes(
+			attribute.String("k8s.namespace.name", request.Namespace),
+			attribute.String("k8s.object.name", request.Name),
+		))
+		defer span.End()
+	}
+
 	return o.Reconciler.Reconcile(ctx, request)
 }
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reconciler_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	. "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("OperationAnnotationWrapper", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		obj        *corev1.ConfigMap
+		request    reconcile.Request
+
+		reconciledCtx context.Context
+		reconciler    reconcile.Reconciler
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+		obj = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"}}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)}
+
+		reconciledCtx = nil
+		reconciler = OperationAnnotationWrapper(
+			test.FakeManager{Client: fakeClient},
+			func() client.Object { return &corev1.ConfigMap{} },
+			reconcile.Func(func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				reconciledCtx = ctx
+				return reconcile.Result{}, nil
+			}),
+		)
+	})
+
+	It("should remove the reconcile operation annotation", func() {
+		obj.Annotations = map[string]string{v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationReconcile}
+		Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, obj)).To(Succeed())
+		Expect(obj.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+		Expect(reconciledCtx).To(BeIdenticalTo(ctx))
+	})
+
+	It("should not call the reconciler if the object waits for its state", func() {
+		obj.Annotations = map[string]string{v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationWaitForState}
+		Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(reconciledCtx).To(BeNil())
+	})
+
+	It("should continue the trace stored in the annotations", func() {
+		recorder := tracetest.NewSpanRecorder()
+		tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		DeferCleanup(func(tp trace.TracerProvider) { otel.SetTracerProvider(tp) }, otel.GetTracerProvider())
+		otel.SetTracerProvider(tracerProvider)
+
+		obj.Annotations = map[string]string{v1beta1constants.GardenerTraceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"}
+		Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		spanContext := trace.SpanContextFromContext(reconciledCtx)
+		Expect(spanContext.TraceID().String()).To(Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+		Expect(spanContext.IsRemote()).To(BeFalse())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Parent().SpanID().String()).To(Equal("00f067aa0ba902b7"))
+	})
+})
@@ -80,6 +80,18 @@ const (
 	// owner: @docktofuture
 	// beta: v1.133.0
 	CustomDNSServerInNodeLocalDNS featuregate.Feature = "CustomDNSServerInNodeLocalDNS"
+
+	// ShootFlowCheckpoints enables checkpoints for the Shoot reconciliation flow. Checkpointable tasks which succeeded in
+	// a failed reconciliation are skipped when the reconciliation is retried with unchanged inputs.
+	// owner: @gardener/gardener-maintainers
+	// alpha: v1.135.0
+	ShootFlowCheckpoints featuregate.Feature = "ShootFlowCheckpoints"
+
+	// SSHCertificateAuthority enables a per-Shoot SSH certificate authority. The worker nodes trust the certificate
+	// authority instead of the shared SSH key pair, and gardenlet issues short-lived SSH user certificates for Bastions.
+	// owner: @gardener/gardener-maintainers
+	// alpha: v1.135.0
+	SSHCertificateAuthority featuregate.Feature = "SSHCertificateAuthority"
 )
 
 // DefaultFeatureGate is the central feature gate map used by all gardener components.
@@ -117,6 +129,8 @@ var AllFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	UseUnifiedHTTPProxyPort:       {Default: false, PreRelease: featuregate.Alpha},
 	VPAInPlaceUpdates:             {Default: false, PreRelease: featuregate.Alpha},
 	CustomDNSServerInNodeLocalDNS: {Default: true, PreRelease: featuregate.Beta},
+	ShootFlowCheckpoints:          {Default: false, PreRelease: featuregate.Alpha},
+	SSHCertificateAuthority:       {Default: false, PreRelease: featuregate.Alpha},
 }
 
 // GetFeatures returns a feature gate map with the respective specifications. Non-existing feature gates are ignored.
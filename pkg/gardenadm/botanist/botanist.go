@@ -108,12 +108,33 @@ func NewGardenadmBotanistFromManifests(
 ) (
 	*GardenadmBotanist,
 	error,
+) {
+	return NewGardenadmBotanistFromManifestsWithKubernetesVersion(ctx, log, clientSet, dir, runsControlPlane, "")
+}
+
+// NewGardenadmBotanistFromManifestsWithKubernetesVersion reads the manifests from dir and initializes a new
+// GardenadmBotanist with them. If kubernetesVersion is non-empty, it overwrites the Kubernetes version of the Shoot read
+// from the manifests, e.g., for upgrading the control plane to a newer version.
+func NewGardenadmBotanistFromManifestsWithKubernetesVersion(
+	ctx context.Context,
+	log logr.Logger,
+	clientSet kubernetes.Interface,
+	dir string,
+	runsControlPlane bool,
+	kubernetesVersion string,
+) (
+	*GardenadmBotanist,
+	error,
 ) {
 	resources, err := gardenadm.ReadManifests(log, DirFS(dir))
 	if err != nil {
 		return nil, fmt.Errorf("failed reading Kubernetes resources from config directory %s: %w", dir, err)
 	}
 
+	if kubernetesVersion != "" {
+		resources.Shoot.Spec.Kubernetes.Version = kubernetesVersion
+	}
+
 	extensions, err := ComputeExtensions(resources, runsControlPlane, v1beta1helper.HasManagedInfrastructure(resources.Shoot))
 	if err != nil {
 		return nil, fmt.Errorf("failed computing extensions: %w", err)
@@ -396,16 +417,18 @@ func initializeShootResource(resources gardenadm.Resources, fs afero.Afero, runs
 		}
 		shoot.Status.UID = uid
 
-		if v1beta1helper.HasManagedInfrastructure(resources.Shoot) {
-			// When running `gardenadm init` for a shoot with managed infrastructure, we need to restore state (secrets,
-			// extensions, etc.) from the ShootState exported by `gardenadm bootstrap`.
-			if resources.ShootState == nil {
-				return fmt.Errorf("shoot has managed infrastructure, but ShootState is missing " +
-					"(the ShootState is usually exported by `gardenadm bootstrap` and read by `gardenadm init`): " +
-					"you should either use `gardenadm bootstrap` to create the self-hosted shoot cluster with managed infrastructure or " +
-					"remove the `Shoot.spec.{secret,credentials}BindingName` field to mark the shoot as having unmanaged infrastructure")
-			}
+		// When running `gardenadm init` for a shoot with managed infrastructure, we need to restore state (secrets,
+		// extensions, etc.) from the ShootState exported by `gardenadm bootstrap`.
+		if v1beta1helper.HasManagedInfrastructure(resources.Shoot) && resources.ShootState == nil {
+			return fmt.Errorf("shoot has managed infrastructure, but ShootState is missing " +
+				"(the ShootState is usually exported by `gardenadm bootstrap` and read by `gardenadm init`): " +
+				"you should either use `gardenadm bootstrap` to create the self-hosted shoot cluster with managed infrastructure or " +
+				"remove the `Shoot.spec.{secret,credentials}BindingName` field to mark the shoot as having unmanaged infrastructure")
+		}
 
+		// The ShootState is also present when restoring the control plane from a snapshot taken with
+		// `gardenadm etcd snapshot`.
+		if resources.ShootState != nil {
 			// Instruct the botanist and shoot package to read the ShootState and restore the state of extensions, secrets, etc.
 			shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{
 				Type: gardencorev1beta1.LastOperationTypeRestore,
@@ -436,9 +459,12 @@ func initializeSeedResource(resources gardenadm.Resources, runsControlPlane bool
 	kubernetes.GardenScheme.Default(seed)
 }
 
+// pathShootUID is the path to the file on the control plane node containing the UID of the shoot.
+var pathShootUID = filepath.Join(string(filepath.Separator), GardenadmBaseDir, "shoot-uid")
+
 func shootUID(fs afero.Afero) (types.UID, error) {
 	var (
-		path                    = filepath.Join(string(filepath.Separator), GardenadmBaseDir, "shoot-uid")
+		path                    = pathShootUID
 		permissions os.FileMode = 0600
 	)
 
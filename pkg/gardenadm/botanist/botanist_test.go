@@ -67,6 +67,22 @@ var _ = Describe("GardenadmBotanist", func() {
 						HaveField("ControllerRegistration.Name", "networking-cilium"),
 					))
 					Expect(b.Seed.GetInfo()).To(HaveField("ObjectMeta.Labels", HaveKeyWithValue("seed.gardener.cloud/self-hosted-shoot-cluster", "true")))
+					Expect(b.IsRestorePhase()).To(BeFalse())
+				})
+
+				It("should set the LastOperation to Restore if a ShootState is present (restoring from an etcd snapshot)", func() {
+					fsys[configDir+"/shootstate.yaml"] = &fstest.MapFile{Data: []byte(`apiVersion: core.gardener.cloud/v1beta1
+kind: ShootState
+metadata:
+  name: gardenadm
+`)}
+
+					b, err := NewGardenadmBotanistFromManifests(ctx, log, nil, configDir, true)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(b.Shoot.GetInfo().Status.LastOperation.Type).To(Equal(gardencorev1beta1.LastOperationTypeRestore))
+					Expect(b.Shoot.GetShootState().Name).To(Equal("gardenadm"))
+					Expect(b.IsRestorePhase()).To(BeTrue())
 				})
 			})
 
@@ -184,6 +200,25 @@ metadata:
 			Expect(b.GardenClient.Get(ctx, client.ObjectKey{Name: "provider-account"}, &gardencorev1beta1.SecretBinding{})).To(Succeed())
 			Expect(b.GardenClient.Get(ctx, client.ObjectKey{Name: "provider-account"}, &securityv1alpha1.CredentialsBinding{})).To(Succeed())
 		})
+
+		Describe("#NewGardenadmBotanistFromManifestsWithKubernetesVersion", func() {
+			It("should keep the Kubernetes version from the manifests if no version is given", func() {
+				b, err := NewGardenadmBotanistFromManifestsWithKubernetesVersion(ctx, log, nil, configDir, true, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(b.Shoot.GetInfo().Spec.Kubernetes.Version).To(Equal("1.33"))
+				Expect(b.Shoot.KubernetesVersion.String()).To(Equal("1.33.0"))
+			})
+
+			It("should overwrite the Kubernetes version from the manifests", func() {
+				b, err := NewGardenadmBotanistFromManifestsWithKubernetesVersion(ctx, log, nil, configDir, true, "1.34.1")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(b.Shoot.GetInfo().Spec.Kubernetes.Version).To(Equal("1.34.1"))
+				Expect(b.Shoot.KubernetesVersion.String()).To(Equal("1.34.1"))
+				Expect(b.Resources.Shoot.Spec.Kubernetes.Version).To(Equal("1.34.1"))
+			})
+		})
 	})
 })
 
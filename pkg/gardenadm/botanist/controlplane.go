@@ -39,6 +39,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/retry"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
@@ -58,17 +59,23 @@ func (b *GardenadmBotanist) deployETCD(role string) func(context.Context) error
 	}
 
 	return func(ctx context.Context) error {
-		image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameEtcd)
+		image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameEtcd, imagevectorutils.RegistryMirrors(b.RegistryMirrors()))
 		if err != nil {
 			return fmt.Errorf("failed fetching image %s: %w", imagevector.ContainerImageNameEtcd, err)
 		}
 
+		snapshotPath, err := b.etcdSnapshotToRestore(role)
+		if err != nil {
+			return err
+		}
+
 		return bootstrapetcd.New(b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, b.SecretsManager, bootstrapetcd.Values{
-			Image:       image.String(),
-			Role:        role,
-			PortClient:  portClient,
-			PortPeer:    portPeer,
-			PortMetrics: portMetrics,
+			Image:        image.String(),
+			Role:         role,
+			PortClient:   portClient,
+			PortPeer:     portPeer,
+			PortMetrics:  portMetrics,
+			SnapshotPath: snapshotPath,
 		}).Deploy(ctx)
 	}
 }
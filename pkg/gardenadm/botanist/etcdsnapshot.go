@@ -0,0 +1,284 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	etcdconstants "github.com/gardener/gardener/pkg/component/etcd/etcd/constants"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+const (
+	// EtcdSnapshotFileName is the name of the file containing the snapshot of the main etcd.
+	EtcdSnapshotFileName = "etcd-main.db"
+	// ShootStateFileName is the name of the file containing the ShootState of the self-hosted shoot cluster.
+	ShootStateFileName = "shootstate.yaml"
+	// ShootUIDFileName is the name of the file containing the UID of the self-hosted shoot cluster.
+	ShootUIDFileName = "shoot-uid"
+
+	snapshotFilePermissions os.FileMode = 0600
+)
+
+var (
+	// PathEtcdRestoreDirectory is the directory on the control plane node containing the etcd snapshot which is restored
+	// by the next `gardenadm init`.
+	PathEtcdRestoreDirectory = filepath.Join(GardenadmBaseDir, "etcd-restore")
+	// pathEtcdMainDataDirectory is the data directory of the main etcd on the control plane node.
+	pathEtcdMainDataDirectory = filepath.Join(staticpod.StatefulSetVolumeClaimTemplateHostPath("etcd-"+v1beta1constants.ETCDRoleMain), "new.etcd")
+)
+
+// SaveEtcdSnapshot streams a snapshot of the main etcd running on this node to the given directory. The snapshot is
+// written to a temporary file first, so that an interrupted snapshot never leaves a truncated file behind.
+func (b *GardenadmBotanist) SaveEtcdSnapshot(ctx context.Context, dir string) error {
+	tlsConfig, err := b.etcdClientTLSConfig()
+	if err != nil {
+		return fmt.Errorf("failed reading etcd client certificate: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://localhost:%d", etcdconstants.PortEtcdClient)
+	etcdClient, err := NewEtcdClient(endpoint, tlsConfig)
+	if err != nil {
+		return fmt.Errorf("failed creating etcd client: %w", err)
+	}
+	defer func() {
+		if err := etcdClient.Close(); err != nil {
+			b.Logger.Error(err, "Failed closing etcd client", "endpoint", endpoint)
+		}
+	}()
+
+	snapshot, err := etcdClient.Snapshot(ctx)
+	if err != nil {
+		return fmt.Errorf("failed requesting snapshot of etcd-%s: %w", v1beta1constants.ETCDRoleMain, err)
+	}
+	defer snapshot.Close()
+
+	if err := b.FS.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed creating directory %s: %w", dir, err)
+	}
+
+	var (
+		path    = filepath.Join(dir, EtcdSnapshotFileName)
+		tmpPath = path + ".part"
+	)
+
+	file, err := b.FS.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, snapshotFilePermissions)
+	if err != nil {
+		return fmt.Errorf("failed creating file %s: %w", tmpPath, err)
+	}
+
+	size, err := io.Copy(file, snapshot)
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed writing snapshot to %s: %w", tmpPath, err), file.Close(), b.FS.Remove(tmpPath))
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed closing file %s: %w", tmpPath, err)
+	}
+
+	if err := b.FS.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed renaming %s to %s: %w", tmpPath, path, err)
+	}
+
+	b.Logger.Info("Successfully saved etcd snapshot", "path", path, "size", size)
+	return nil
+}
+
+// SaveShootState computes the ShootState of the self-hosted shoot cluster and writes it together with the UID of the
+// shoot to the given directory. The ShootState contains the persisted secrets (e.g., the certificate authorities), so
+// that a control plane restored from an etcd snapshot uses the same credentials as before.
+func (b *GardenadmBotanist) SaveShootState(ctx context.Context, dir string) error {
+	// For self-hosted shoots, the control plane runs in the kube-system namespace instead of the technical ID namespace.
+	shoot := b.Shoot.GetInfo().DeepCopy()
+	shoot.Status.TechnicalID = b.Shoot.ControlPlaneNamespace
+
+	if err := shootstate.Deploy(ctx, b.Clock, b.GardenClient, b.SeedClientSet.Client(), shoot, true); err != nil {
+		return fmt.Errorf("failed computing ShootState: %w", err)
+	}
+
+	shootState := &gardencorev1beta1.ShootState{}
+	if err := b.GardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shootState); err != nil {
+		return fmt.Errorf("error getting ShootState: %w", err)
+	}
+
+	// Clear fields that must not be set on creation
+	shootState.SetResourceVersion("")
+
+	shootStateBytes, err := runtime.Encode(kubernetes.GardenCodec.EncoderForVersion(kubernetes.GardenSerializer, gardencorev1beta1.SchemeGroupVersion), shootState)
+	if err != nil {
+		return fmt.Errorf("error encoding ShootState: %w", err)
+	}
+
+	if err := b.FS.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed creating directory %s: %w", dir, err)
+	}
+
+	for fileName, content := range map[string][]byte{
+		ShootStateFileName: shootStateBytes,
+		ShootUIDFileName:   []byte(shoot.Status.UID),
+	} {
+		path := filepath.Join(dir, fileName)
+		if err := b.FS.WriteFile(path, content, snapshotFilePermissions); err != nil {
+			return fmt.Errorf("failed writing file %s: %w", path, err)
+		}
+	}
+
+	b.Logger.Info("Successfully saved ShootState", "dir", dir)
+	return nil
+}
+
+// TriggerEtcdBackupBucketSnapshot instructs etcd-druid to take a full snapshot of the main etcd and to upload it to the
+// BackupBucket of the shoot. It waits until the snapshot has been taken.
+func (b *GardenadmBotanist) TriggerEtcdBackupBucketSnapshot(ctx context.Context) error {
+	if v1beta1helper.GetBackupConfigForShoot(b.Shoot.GetInfo(), nil) == nil {
+		return fmt.Errorf("shoot does not configure a backup (.spec.provider.workers[].controlPlane.backup in the Shoot manifest)")
+	}
+
+	managedByDruid, err := b.IsEtcdManagedByDruid(ctx)
+	if err != nil {
+		return err
+	}
+	if !managedByDruid {
+		return fmt.Errorf("etcd is not managed by etcd-druid yet, hence it does not upload snapshots to the BackupBucket")
+	}
+
+	etcdOpsTask := &druidcorev1alpha1.EtcdOpsTask{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("etcd-%s-snapshot-%d", v1beta1constants.ETCDRoleMain, b.Clock.Now().Unix()),
+			Namespace: b.Shoot.ControlPlaneNamespace,
+		},
+		Spec: druidcorev1alpha1.EtcdOpsTaskSpec{
+			EtcdName:                ptr.To("etcd-" + v1beta1constants.ETCDRoleMain),
+			TTLSecondsAfterFinished: ptr.To[int32](3600),
+			Config: druidcorev1alpha1.EtcdOpsTaskConfig{
+				OnDemandSnapshot: &druidcorev1alpha1.OnDemandSnapshotConfig{
+					Type: druidcorev1alpha1.OnDemandSnapshotTypeFull,
+				},
+			},
+		},
+	}
+
+	if err := b.SeedClientSet.Client().Create(ctx, etcdOpsTask); err != nil {
+		return fmt.Errorf("failed creating EtcdOpsTask %s: %w", client.ObjectKeyFromObject(etcdOpsTask), err)
+	}
+
+	b.Logger.Info("Waiting until etcd snapshot has been uploaded to the BackupBucket", "etcdOpsTask", client.ObjectKeyFromObject(etcdOpsTask))
+
+	return retry.UntilTimeout(ctx, 5*time.Second, 10*time.Minute, func(ctx context.Context) (bool, error) {
+		if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKeyFromObject(etcdOpsTask), etcdOpsTask); err != nil {
+			return retry.SevereError(err)
+		}
+
+		if etcdOpsTask.Status.State == nil {
+			return retry.MinorError(fmt.Errorf("EtcdOpsTask %s has not been processed yet", client.ObjectKeyFromObject(etcdOpsTask)))
+		}
+
+		switch state := *etcdOpsTask.Status.State; state {
+		case druidcorev1alpha1.TaskStateSucceeded:
+			return retry.Ok()
+		case druidcorev1alpha1.TaskStateFailed, druidcorev1alpha1.TaskStateRejected:
+			var descriptions []string
+			for _, lastError := range etcdOpsTask.Status.LastErrors {
+				descriptions = append(descriptions, lastError.Description)
+			}
+			return retry.SevereError(fmt.Errorf("EtcdOpsTask %s is in state %s: %s", client.ObjectKeyFromObject(etcdOpsTask), state, strings.Join(descriptions, ", ")))
+		default:
+			return retry.MinorError(fmt.Errorf("EtcdOpsTask %s is in state %s", client.ObjectKeyFromObject(etcdOpsTask), state))
+		}
+	})
+}
+
+// PrepareEtcdRestore prepares this machine for restoring the control plane from the snapshot in the given directory
+// (as written by SaveEtcdSnapshot and SaveShootState) during the next `gardenadm init`. The etcd snapshot is copied to
+// PathEtcdRestoreDirectory, the shoot UID is persisted on the machine, and the ShootState is copied to the given config
+// directory.
+func (b *GardenadmBotanist) PrepareEtcdRestore(snapshotDir, configDir string) error {
+	for _, path := range []string{PathKubeconfig, pathEtcdMainDataDirectory + "/member"} {
+		exists, err := b.FS.Exists(path)
+		if err != nil {
+			return fmt.Errorf("failed checking whether %s exists: %w", path, err)
+		}
+		if exists {
+			return fmt.Errorf("found %s, this machine has already been initialized (run `gardenadm reset` first)", path)
+		}
+	}
+
+	for _, file := range []struct {
+		source, target string
+	}{
+		{filepath.Join(snapshotDir, EtcdSnapshotFileName), filepath.Join(PathEtcdRestoreDirectory, EtcdSnapshotFileName)},
+		{filepath.Join(snapshotDir, ShootUIDFileName), pathShootUID},
+		{filepath.Join(snapshotDir, ShootStateFileName), filepath.Join(configDir, ShootStateFileName)},
+	} {
+		content, err := b.FS.ReadFile(file.source)
+		if err != nil {
+			return fmt.Errorf("failed reading file %s: %w", file.source, err)
+		}
+
+		if err := b.FS.MkdirAll(filepath.Dir(file.target), 0700); err != nil {
+			return fmt.Errorf("failed creating directory %s: %w", filepath.Dir(file.target), err)
+		}
+
+		if err := b.FS.WriteFile(file.target, content, snapshotFilePermissions); err != nil {
+			return fmt.Errorf("failed writing file %s: %w", file.target, err)
+		}
+	}
+
+	b.Logger.Info("Successfully prepared restoration of control plane", "snapshotDir", snapshotDir, "configDir", configDir)
+	return nil
+}
+
+// etcdSnapshotToRestore returns the path to the snapshot which should be restored by the bootstrap etcd with the given
+// role. It returns an empty string if there is nothing to restore, i.e., if no snapshot was prepared or if the data
+// directory has already been restored.
+func (b *GardenadmBotanist) etcdSnapshotToRestore(role string) (string, error) {
+	// Events are not worth restoring, hence only the main etcd is restored from a snapshot.
+	if role != v1beta1constants.ETCDRoleMain {
+		return "", nil
+	}
+
+	path := filepath.Join(PathEtcdRestoreDirectory, EtcdSnapshotFileName)
+	snapshotExists, err := b.FS.Exists(path)
+	if err != nil {
+		return "", fmt.Errorf("failed checking whether etcd snapshot %s exists: %w", path, err)
+	}
+
+	dataExists, err := b.FS.Exists(pathEtcdMainDataDirectory + "/member")
+	if err != nil {
+		return "", fmt.Errorf("failed checking whether etcd data directory %s exists: %w", pathEtcdMainDataDirectory, err)
+	}
+
+	if !snapshotExists || dataExists {
+		return "", nil
+	}
+
+	return path, nil
+}
+
+// CleanupEtcdRestoreDirectory removes the etcd snapshot which has been restored during `gardenadm init`.
+func (b *GardenadmBotanist) CleanupEtcdRestoreDirectory(_ context.Context) error {
+	if err := b.FS.RemoveAll(PathEtcdRestoreDirectory); err != nil {
+		return fmt.Errorf("failed cleaning up %s directory: %w", PathEtcdRestoreDirectory, err)
+	}
+	return nil
+}
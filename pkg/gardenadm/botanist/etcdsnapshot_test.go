@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"io"
+	"time"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("EtcdSnapshot", func() {
+	var (
+		ctx = context.Background()
+
+		fakeGardenClient client.Client
+		fakeSeedClient   client.Client
+		fs               afero.Afero
+		shootObj         *gardencorev1beta1.Shoot
+
+		b *GardenadmBotanist
+	)
+
+	BeforeEach(func() {
+		fakeGardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		fakeSeedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+
+		shootObj = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "gardenadm", Namespace: "garden"},
+			Status: gardencorev1beta1.ShootStatus{
+				TechnicalID: "shoot--garden--gardenadm",
+				UID:         "shoot-uid",
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		b = &GardenadmBotanist{
+			Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{
+				Logger:        logr.Discard(),
+				Clock:         testclock.NewFakeClock(time.Unix(100, 0)),
+				GardenClient:  fakeGardenClient,
+				SeedClientSet: fakekubernetes.NewClientSetBuilder().WithClient(fakeSeedClient).Build(),
+				Shoot:         &shoot.Shoot{ControlPlaneNamespace: "kube-system"},
+			}},
+			FS: fs,
+		}
+		b.Shoot.SetInfo(shootObj)
+	})
+
+	Describe("#SaveEtcdSnapshot", func() {
+		var endpoint string
+
+		BeforeEach(func() {
+			ca, err := (&secretsutils.CertificateSecretConfig{Name: "ca-etcd", CommonName: "ca-etcd", CertType: secretsutils.CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			clientCertificate, err := (&secretsutils.CertificateSecretConfig{Name: "etcd-client", CommonName: "etcd-client", CertType: secretsutils.ClientCert, SigningCA: ca}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fs.WriteFile("/var/lib/kube-apiserver/ca-etcd/bundle.crt", ca.CertificatePEM, 0600)).To(Succeed())
+			Expect(fs.WriteFile("/var/lib/kube-apiserver/etcd-client/tls.crt", clientCertificate.CertificatePEM, 0600)).To(Succeed())
+			Expect(fs.WriteFile("/var/lib/kube-apiserver/etcd-client/tls.key", clientCertificate.PrivateKeyPEM, 0600)).To(Succeed())
+
+			DeferCleanup(test.WithVar(&NewEtcdClient, func(e string, _ *tls.Config) (EtcdClient, error) {
+				endpoint = e
+				return &fakeSnapshotEtcdClient{snapshot: []byte("snapshot-data")}, nil
+			}))
+		})
+
+		It("should write the snapshot of the main etcd to the directory", func() {
+			Expect(b.SaveEtcdSnapshot(ctx, "/backup")).To(Succeed())
+
+			Expect(endpoint).To(Equal("https://localhost:2379"))
+			Expect(fs.ReadFile("/backup/etcd-main.db")).To(Equal([]byte("snapshot-data")))
+			Expect(fs.Exists("/backup/etcd-main.db.part")).To(BeFalse())
+		})
+
+		It("should fail if the etcd client certificate does not exist", func() {
+			Expect(fs.Remove("/var/lib/kube-apiserver/etcd-client/tls.crt")).To(Succeed())
+
+			Expect(b.SaveEtcdSnapshot(ctx, "/backup")).To(MatchError(ContainSubstring("failed reading etcd client certificate")))
+		})
+	})
+
+	Describe("#SaveShootState", func() {
+		It("should write the ShootState containing the persisted secrets and the shoot UID to the directory", func() {
+			Expect(fakeSeedClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ca",
+					Namespace: "kube-system",
+					Labels:    map[string]string{"managed-by": "secrets-manager", "persist": "true"},
+				},
+				Data: map[string][]byte{"ca.crt": []byte("ca")},
+			})).To(Succeed())
+
+			Expect(b.SaveShootState(ctx, "/backup")).To(Succeed())
+
+			Expect(fs.ReadFile("/backup/shoot-uid")).To(Equal([]byte("shoot-uid")))
+
+			content, err := fs.ReadFile("/backup/shootstate.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			shootState := &gardencorev1beta1.ShootState{}
+			Expect(runtime.DecodeInto(kubernetes.GardenCodec.UniversalDecoder(), content, shootState)).To(Succeed())
+			Expect(shootState.Name).To(Equal("gardenadm"))
+			Expect(shootState.ResourceVersion).To(BeEmpty())
+			Expect(shootState.Spec.Gardener).To(ContainElement(HaveField("Name", "ca")))
+		})
+	})
+
+	Describe("#TriggerEtcdBackupBucketSnapshot", func() {
+		var taskState druidcorev1alpha1.TaskState
+
+		BeforeEach(func() {
+			taskState = druidcorev1alpha1.TaskStateSucceeded
+			shootObj.Spec.Provider.Workers = []gardencorev1beta1.Worker{{
+				Name:         "control-plane",
+				ControlPlane: &gardencorev1beta1.WorkerControlPlane{Backup: &gardencorev1beta1.Backup{Provider: "local"}},
+			}}
+
+			fakeSeedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					if etcdOpsTask, ok := obj.(*druidcorev1alpha1.EtcdOpsTask); ok {
+						etcdOpsTask.Status.State = &taskState
+						etcdOpsTask.Status.LastErrors = []druidapicommon.LastError{{Description: "some error"}}
+					}
+					return c.Create(ctx, obj, opts...)
+				},
+			}).Build()
+			Expect(fakeSeedClient.Create(ctx, &druidcorev1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: "kube-system"}})).To(Succeed())
+		})
+
+		It("should create an EtcdOpsTask for a full snapshot and wait until it succeeded", func() {
+			Expect(b.TriggerEtcdBackupBucketSnapshot(ctx)).To(Succeed())
+
+			etcdOpsTask := &druidcorev1alpha1.EtcdOpsTask{}
+			Expect(fakeSeedClient.Get(ctx, client.ObjectKey{Name: "etcd-main-snapshot-100", Namespace: "kube-system"}, etcdOpsTask)).To(Succeed())
+			Expect(etcdOpsTask.Spec.EtcdName).To(Equal(ptr.To("etcd-main")))
+			Expect(etcdOpsTask.Spec.Config.OnDemandSnapshot).To(Equal(&druidcorev1alpha1.OnDemandSnapshotConfig{Type: druidcorev1alpha1.OnDemandSnapshotTypeFull}))
+		})
+
+		It("should fail if the EtcdOpsTask failed", func() {
+			taskState = druidcorev1alpha1.TaskStateFailed
+
+			Expect(b.TriggerEtcdBackupBucketSnapshot(ctx)).To(MatchError(ContainSubstring("EtcdOpsTask kube-system/etcd-main-snapshot-100 is in state Failed: some error")))
+		})
+
+		It("should fail if the shoot does not configure a backup", func() {
+			shootObj.Spec.Provider.Workers[0].ControlPlane.Backup = nil
+
+			Expect(b.TriggerEtcdBackupBucketSnapshot(ctx)).To(MatchError(ContainSubstring("shoot does not configure a backup")))
+		})
+
+		It("should fail if the etcd is not managed by etcd-druid", func() {
+			Expect(fakeSeedClient.Delete(ctx, &druidcorev1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: "kube-system"}})).To(Succeed())
+
+			Expect(b.TriggerEtcdBackupBucketSnapshot(ctx)).To(MatchError(ContainSubstring("etcd is not managed by etcd-druid")))
+		})
+	})
+
+	Describe("#PrepareEtcdRestore", func() {
+		BeforeEach(func() {
+			Expect(fs.WriteFile("/backup/etcd-main.db", []byte("snapshot-data"), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/backup/shootstate.yaml", []byte("shootstate"), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/backup/shoot-uid", []byte("shoot-uid"), 0600)).To(Succeed())
+		})
+
+		It("should copy the files to the expected locations", func() {
+			Expect(b.PrepareEtcdRestore("/backup", "/manifests")).To(Succeed())
+
+			Expect(fs.ReadFile("/var/lib/gardenadm/etcd-restore/etcd-main.db")).To(Equal([]byte("snapshot-data")))
+			Expect(fs.ReadFile("/var/lib/gardenadm/shoot-uid")).To(Equal([]byte("shoot-uid")))
+			Expect(fs.ReadFile("/manifests/shootstate.yaml")).To(Equal([]byte("shootstate")))
+		})
+
+		It("should fail if the machine has already been initialized", func() {
+			Expect(fs.WriteFile("/etc/kubernetes/admin.conf", []byte("kubeconfig"), 0600)).To(Succeed())
+
+			Expect(b.PrepareEtcdRestore("/backup", "/manifests")).To(MatchError(ContainSubstring("this machine has already been initialized")))
+		})
+
+		It("should fail if the etcd data directory already exists", func() {
+			Expect(fs.MkdirAll("/var/lib/etcd-main/data/new.etcd/member", 0700)).To(Succeed())
+
+			Expect(b.PrepareEtcdRestore("/backup", "/manifests")).To(MatchError(ContainSubstring("this machine has already been initialized")))
+		})
+
+		It("should fail if a file is missing in the snapshot directory", func() {
+			Expect(fs.Remove("/backup/shoot-uid")).To(Succeed())
+
+			Expect(b.PrepareEtcdRestore("/backup", "/manifests")).To(MatchError(ContainSubstring("failed reading file /backup/shoot-uid")))
+		})
+	})
+
+	Describe("#etcdSnapshotToRestore", func() {
+		It("should return nothing if no snapshot was prepared", func() {
+			Expect(b.etcdSnapshotToRestore("main")).To(BeEmpty())
+		})
+
+		When("a snapshot was prepared", func() {
+			BeforeEach(func() {
+				Expect(fs.WriteFile("/var/lib/gardenadm/etcd-restore/etcd-main.db", []byte("snapshot-data"), 0600)).To(Succeed())
+			})
+
+			It("should return the path of the snapshot for the main etcd", func() {
+				Expect(b.etcdSnapshotToRestore("main")).To(Equal("/var/lib/gardenadm/etcd-restore/etcd-main.db"))
+			})
+
+			It("should return nothing for the events etcd", func() {
+				Expect(b.etcdSnapshotToRestore("events")).To(BeEmpty())
+			})
+
+			It("should return nothing if the data directory has already been restored", func() {
+				Expect(fs.MkdirAll("/var/lib/etcd-main/data/new.etcd/member", 0700)).To(Succeed())
+
+				Expect(b.etcdSnapshotToRestore("main")).To(BeEmpty())
+			})
+
+			It("should remove the snapshot during cleanup", func() {
+				Expect(b.CleanupEtcdRestoreDirectory(ctx)).To(Succeed())
+
+				Expect(fs.Exists("/var/lib/gardenadm/etcd-restore")).To(BeFalse())
+			})
+		})
+	})
+})
+
+type fakeSnapshotEtcdClient struct {
+	clientv3.Cluster
+	clientv3.Maintenance
+
+	snapshot []byte
+}
+
+func (f *fakeSnapshotEtcdClient) Snapshot(_ context.Context) (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(f.snapshot)), nil
+}
+
+func (f *fakeSnapshotEtcdClient) Close() error {
+	return nil
+}
@@ -166,7 +166,7 @@ func (b *GardenadmBotanist) ReconcileExtensionControllerInstallations(ctx contex
 	reconciler := controllerinstallation.Reconciler{
 		GardenClient:              b.GardenClient,
 		SeedClientSet:             b.SeedClientSet,
-		HelmRegistry:              oci.NewHelmRegistry(b.SeedClientSet.Client()),
+		HelmRegistry:              oci.NewHelmRegistry(b.SeedClientSet.Client(), oci.HelmRegistryOptions{}),
 		Clock:                     b.Clock,
 		Identity:                  &b.Shoot.GetInfo().Status.Gardener,
 		GardenNamespace:           b.Shoot.ControlPlaneNamespace,
@@ -35,6 +35,7 @@ import (
 	operatingsystemconfigcontroller "github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
 	"github.com/gardener/gardener/pkg/nodeagent/registry"
 	"github.com/gardener/gardener/pkg/utils"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
@@ -174,7 +175,7 @@ func (b *GardenadmBotanist) PrepareGardenerNodeInitConfiguration(ctx context.Con
 }
 
 func (b *GardenadmBotanist) generateGardenerNodeInitOperatingSystemConfig(secretName, controlPlaneAddress, bootstrapToken string, caBundle []byte) (*extensionsv1alpha1.OperatingSystemConfig, error) {
-	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerNodeAgent)
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerNodeAgent, imagevectorutils.RegistryMirrors(b.RegistryMirrors()))
 	if err != nil {
 		return nil, fmt.Errorf("failed finding image %q: %w", imagevector.ContainerImageNameGardenerNodeAgent, err)
 	}
@@ -230,7 +231,7 @@ func (b *GardenadmBotanist) IsGardenerNodeAgentInitialized(ctx context.Context)
 // ControlPlaneBootstrapOperatingSystemConfig creates the deployer for the OperatingSystemConfig custom resource that is
 // used for bootstrapping control plane nodes in `gardenadm bootstrap`.
 func (b *GardenadmBotanist) ControlPlaneBootstrapOperatingSystemConfig() (operatingsystemconfig.Interface, error) {
-	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenadm)
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenadm, imagevectorutils.RegistryMirrors(b.RegistryMirrors()))
 	if err != nil {
 		return nil, fmt.Errorf("failed finding image %q: %w", imagevector.ContainerImageNameGardenadm, err)
 	}
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	etcdconstants "github.com/gardener/gardener/pkg/component/etcd/etcd/constants"
+	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/constants"
+	"github.com/gardener/gardener/pkg/gardenadm/preflight"
+)
+
+const (
+	// portKubelet is the port on which kubelet serves its API.
+	portKubelet = 10250
+	// pathVarLib is the directory below which the container images, kubelet and etcd data are stored.
+	pathVarLib = "/var/lib"
+)
+
+var (
+	// requiredKernelModules are the kernel modules needed by containerd and the pod network.
+	requiredKernelModules = []string{"overlay", "br_netfilter"}
+	// minimumFreeDiskSpace is the free disk space needed below pathVarLib for pulling the images and storing the data
+	// of the node's components.
+	minimumFreeDiskSpace = resource.MustParse("10Gi")
+)
+
+// RunPreflightChecks validates that the machine fulfills all requirements for becoming a control plane (if
+// controlPlane is true) or worker node before it gets mutated. All failures are reported at once, except for those of
+// the checks contained in ignoredChecks. The additionalChecks are run after the common checks.
+// The Swap check depends on the kubelet configuration in the Shoot manifest, hence, it is only run if the Shoot is
+// known. Otherwise, callers must run RunSwapPreflightCheck as soon as the Shoot is known.
+func (b *GardenadmBotanist) RunPreflightChecks(ctx context.Context, ignoredChecks sets.Set[string], controlPlane bool, additionalChecks ...preflight.Check) error {
+	ports := []int32{portKubelet}
+	if controlPlane {
+		ports = append(ports,
+			kubeapiserverconstants.Port,
+			etcdconstants.PortEtcdClient,
+			etcdconstants.PortEtcdPeer,
+			etcdconstants.StaticPodPortEtcdEventsClient,
+			etcdconstants.StaticPodPortEtcdEventsPeer,
+		)
+	}
+
+	checks := []preflight.Check{
+		preflight.KernelModules(b.FS, requiredKernelModules...),
+		preflight.CgroupV2(b.FS),
+	}
+
+	if b.Shoot != nil && b.Shoot.GetInfo() != nil {
+		var (
+			shoot      = b.Shoot.GetInfo()
+			workerPool *gardencorev1beta1.Worker
+		)
+
+		if controlPlane {
+			workerPool = v1beta1helper.ControlPlaneWorkerPoolForShoot(shoot.Spec.Provider.Workers)
+		}
+		checks = append(checks, preflight.Swap(b.FS, failSwapOn(shoot, workerPool)))
+	}
+
+	checks = append(checks,
+		preflight.Ports(ports...),
+		preflight.TimeSync(),
+		preflight.ContainerRuntime(b.DBus),
+		preflight.DiskSpace(pathVarLib, minimumFreeDiskSpace),
+		preflight.Hostname(b.HostName),
+	)
+	checks = append(checks, additionalChecks...)
+
+	b.Logger.Info("Running preflight checks")
+	return preflight.Run(ctx, b.Logger, ignoredChecks, checks...)
+}
+
+// RunSwapPreflightCheck validates that no swap device is active if kubelet of the given worker pool of the Shoot does
+// not tolerate swap. It is used if the Shoot is not known when running RunPreflightChecks, e.g., in `gardenadm join`.
+func (b *GardenadmBotanist) RunSwapPreflightCheck(ctx context.Context, ignoredChecks sets.Set[string], shoot *gardencorev1beta1.Shoot, workerPoolName string) error {
+	var workerPool *gardencorev1beta1.Worker
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if worker.Name == workerPoolName {
+			workerPool = &worker
+			break
+		}
+	}
+
+	b.Logger.Info("Running preflight checks depending on the Shoot manifest", "workerPoolName", workerPoolName)
+	return preflight.Run(ctx, b.Logger, ignoredChecks, preflight.Swap(b.FS, failSwapOn(shoot, workerPool)))
+}
+
+// failSwapOn returns whether kubelet of the given worker pool (might be nil) of the Shoot will refuse to start if swap
+// is enabled.
+func failSwapOn(shoot *gardencorev1beta1.Shoot, workerPool *gardencorev1beta1.Worker) bool {
+	kubeletConfig := shoot.Spec.Kubernetes.Kubelet
+	if workerPool != nil {
+		kubeletConfig = v1beta1helper.CalculateEffectiveKubeletConfiguration(kubeletConfig, workerPool.Kubernetes)
+	}
+
+	if kubeletConfig == nil {
+		return true
+	}
+	return ptr.Deref(kubeletConfig.FailSwapOn, true)
+}
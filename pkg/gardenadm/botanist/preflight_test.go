@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/coreos/go-systemd/v22/dbus"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/preflight"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Preflight", func() {
+	var (
+		ctx = context.Background()
+
+		fs            afero.Afero
+		fakeDBus      *fakedbus.DBus
+		listenedPorts []string
+
+		b *GardenadmBotanist
+	)
+
+	BeforeEach(func() {
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		Expect(fs.WriteFile("/proc/modules", []byte("overlay 151552 0 - Live 0x0000000000000000\nbr_netfilter 32768 0 - Live 0x0000000000000000\n"), 0444)).To(Succeed())
+		Expect(fs.WriteFile("/proc/swaps", []byte("Filename\tType\tSize\tUsed\tPriority\n"), 0444)).To(Succeed())
+		Expect(fs.WriteFile("/sys/fs/cgroup/cgroup.controllers", []byte("cpu memory"), 0444)).To(Succeed())
+
+		fakeDBus = fakedbus.New()
+		fakeDBus.AddUnitsToList(dbus.UnitStatus{Name: "containerd.service"})
+
+		listenedPorts = nil
+		DeferCleanup(test.WithVars(
+			&preflight.Exec, func(_ context.Context, command string, _ ...string) ([]byte, error) {
+				switch command {
+				case "timedatectl":
+					return []byte("yes\n"), nil
+				case "df":
+					return []byte("Filesystem 1024-blocks Used Available Capacity Mounted on\n/dev/sda1 41152736 10000000 31152736 25% /\n"), nil
+				}
+				return nil, fmt.Errorf("unexpected command %s", command)
+			},
+			&preflight.Listen, func(_, address string) (net.Listener, error) {
+				listenedPorts = append(listenedPorts, address)
+				return net.Listen("tcp", "127.0.0.1:0")
+			},
+			&preflight.LookupHost, func(_ context.Context, _ string) ([]string, error) {
+				return []string{"10.0.0.1"}, nil
+			},
+		))
+
+		b = &GardenadmBotanist{
+			Botanist: &botanistpkg.Botanist{
+				Operation: &operation.Operation{
+					Logger: logr.Discard(),
+				},
+			},
+			FS:       fs,
+			DBus:     fakeDBus,
+			HostName: "machine-0",
+		}
+	})
+
+	Describe("#RunPreflightChecks", func() {
+		It("should succeed for a worker node", func() {
+			Expect(b.RunPreflightChecks(ctx, nil, false)).To(Succeed())
+			Expect(listenedPorts).To(ConsistOf(":10250"))
+		})
+
+		It("should succeed for a control plane node", func() {
+			Expect(b.RunPreflightChecks(ctx, nil, true)).To(Succeed())
+			Expect(listenedPorts).To(ConsistOf(":10250", ":443", ":2379", ":2380", ":2382", ":2383"))
+		})
+
+		It("should report all failures at once, including those of additional checks", func() {
+			Expect(fs.Remove("/sys/fs/cgroup/cgroup.controllers")).To(Succeed())
+			fakeDBus = fakedbus.New()
+			b.DBus = fakeDBus
+
+			err := b.RunPreflightChecks(ctx, nil, false, preflight.Check{Name: preflight.CheckBootstrapToken, Fn: func(context.Context) error {
+				return errors.New("token expired")
+			}})
+			Expect(err).To(MatchError(ContainSubstring("[CgroupV2]")))
+			Expect(err).To(MatchError(ContainSubstring("[ContainerRuntime]")))
+			Expect(err).To(MatchError(ContainSubstring("[BootstrapToken] token expired")))
+		})
+
+		It("should not fail for ignored checks", func() {
+			Expect(fs.Remove("/sys/fs/cgroup/cgroup.controllers")).To(Succeed())
+
+			Expect(b.RunPreflightChecks(ctx, sets.New(preflight.CheckCgroupV2), false)).To(Succeed())
+		})
+
+		Context("swap", func() {
+			BeforeEach(func() {
+				Expect(fs.WriteFile("/proc/swaps", []byte("Filename\tType\tSize\tUsed\tPriority\n/swap.img\tfile\t2097148\t0\t-2\n"), 0444)).To(Succeed())
+			})
+
+			It("should not run the swap check if the Shoot is not known", func() {
+				Expect(b.RunPreflightChecks(ctx, nil, false)).To(Succeed())
+			})
+
+			It("should fail if swap is enabled and kubelet does not tolerate it", func() {
+				b.Shoot = &shoot.Shoot{}
+				b.Shoot.SetInfo(&gardencorev1beta1.Shoot{})
+
+				Expect(b.RunPreflightChecks(ctx, nil, true)).To(MatchError(ContainSubstring("[Swap]")))
+			})
+
+			It("should succeed if swap is enabled and kubelet of the control plane pool tolerates it", func() {
+				b.Shoot = &shoot.Shoot{}
+				b.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+					Spec: gardencorev1beta1.ShootSpec{
+						Kubernetes: gardencorev1beta1.Kubernetes{
+							Kubelet: &gardencorev1beta1.KubeletConfig{FailSwapOn: ptr.To(true)},
+						},
+						Provider: gardencorev1beta1.Provider{
+							Workers: []gardencorev1beta1.Worker{{
+								Name:         "control-plane",
+								ControlPlane: &gardencorev1beta1.WorkerControlPlane{},
+								Kubernetes:   &gardencorev1beta1.WorkerKubernetes{Kubelet: &gardencorev1beta1.KubeletConfig{FailSwapOn: ptr.To(false)}},
+							}},
+						},
+					},
+				})
+
+				Expect(b.RunPreflightChecks(ctx, nil, true)).To(Succeed())
+			})
+		})
+	})
+
+	Describe("#RunSwapPreflightCheck", func() {
+		var shootObj *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			Expect(fs.WriteFile("/proc/swaps", []byte("Filename\tType\tSize\tUsed\tPriority\n/swap.img\tfile\t2097148\t0\t-2\n"), 0444)).To(Succeed())
+
+			shootObj = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Provider: gardencorev1beta1.Provider{
+						Workers: []gardencorev1beta1.Worker{
+							{Name: "control-plane", ControlPlane: &gardencorev1beta1.WorkerControlPlane{}},
+							{Name: "worker", Kubernetes: &gardencorev1beta1.WorkerKubernetes{Kubelet: &gardencorev1beta1.KubeletConfig{FailSwapOn: ptr.To(false)}}},
+						},
+					},
+				},
+			}
+		})
+
+		It("should fail if swap is enabled and kubelet of the worker pool does not tolerate it", func() {
+			Expect(b.RunSwapPreflightCheck(ctx, nil, shootObj, "control-plane")).To(MatchError(ContainSubstring("[Swap]")))
+		})
+
+		It("should succeed if swap is enabled and kubelet of the worker pool tolerates it", func() {
+			Expect(b.RunSwapPreflightCheck(ctx, nil, shootObj, "worker")).To(Succeed())
+		})
+
+		It("should not fail if the check is ignored", func() {
+			Expect(b.RunSwapPreflightCheck(ctx, sets.New(preflight.CheckSwap), shootObj, "control-plane")).To(Succeed())
+		})
+	})
+})
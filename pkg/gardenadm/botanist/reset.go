@@ -0,0 +1,437 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"slices"
+	"time"
+
+	containerd "github.com/containerd/containerd/v2/client"
+	"github.com/containerd/containerd/v2/core/mount"
+	"github.com/containerd/containerd/v2/defaults"
+	"github.com/containerd/errdefs"
+	"github.com/spf13/afero"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	bootstrapetcd "github.com/gardener/gardener/pkg/component/etcd/bootstrap"
+	etcdconstants "github.com/gardener/gardener/pkg/component/etcd/etcd/constants"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+const (
+	// containerdNamespaceKubernetes is the containerd namespace in which the containers of the kubelet are running.
+	containerdNamespaceKubernetes = "k8s.io"
+	// pathSystemdUnits is the directory containing the systemd units written by gardener-node-agent.
+	pathSystemdUnits = "/etc/systemd/system"
+)
+
+var (
+	// pathEtcdClientCertificateDirectory is the directory containing the etcd client certificate of the static
+	// kube-apiserver pod.
+	pathEtcdClientCertificateDirectory = filepath.Join(string(filepath.Separator), "var", "lib", v1beta1constants.DeploymentNameKubeAPIServer, "etcd-client")
+	// pathEtcdCADirectory is the directory containing the etcd CA bundle of the static kube-apiserver pod.
+	pathEtcdCADirectory = filepath.Join(string(filepath.Separator), "var", "lib", v1beta1constants.DeploymentNameKubeAPIServer, "ca-etcd")
+)
+
+// EtcdClient is the subset of the etcd client functionality used for removing the etcd members of a node.
+type EtcdClient interface {
+	clientv3.Cluster
+	clientv3.Maintenance
+	Close() error
+}
+
+var (
+	// NewEtcdClient creates a new client for the etcd listening on the given endpoint.
+	// Exposed for testing.
+	NewEtcdClient = func(endpoint string, tlsConfig *tls.Config) (EtcdClient, error) {
+		return clientv3.New(clientv3.Config{
+			Endpoints:   []string{endpoint},
+			TLS:         tlsConfig,
+			DialTimeout: 10 * time.Second,
+			Logger:      zap.NewNop(),
+		})
+	}
+	// UnmountRecursive unmounts the target and all mounts underneath.
+	// Exposed for testing.
+	UnmountRecursive = mount.UnmountRecursive
+)
+
+// IsControlPlaneNode returns whether this node runs a control plane instance of the self-hosted shoot cluster, i.e.,
+// whether the admin kubeconfig exists on the machine.
+func (b *GardenadmBotanist) IsControlPlaneNode() (bool, error) {
+	return b.FS.Exists(PathKubeconfig)
+}
+
+// DeleteNode deletes the Node object of this machine from the cluster.
+func (b *GardenadmBotanist) DeleteNode(ctx context.Context) error {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: b.HostName}}
+	if err := b.ShootClientSet.Client().Delete(ctx, node); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed deleting node %s: %w", b.HostName, err)
+	}
+
+	return nil
+}
+
+// RemoveEtcdMembers removes the members of the main and events etcd running on this node from their clusters. If a
+// member is the last one of its cluster, it is not removed since this would render the etcd cluster unusable.
+func (b *GardenadmBotanist) RemoveEtcdMembers(ctx context.Context) error {
+	tlsConfig, err := b.etcdClientTLSConfig()
+	if err != nil {
+		return fmt.Errorf("failed reading etcd client certificate: %w", err)
+	}
+
+	for _, etcd := range []struct {
+		role string
+		port int32
+	}{
+		{v1beta1constants.ETCDRoleMain, etcdconstants.PortEtcdClient},
+		{v1beta1constants.ETCDRoleEvents, etcdconstants.StaticPodPortEtcdEventsClient},
+	} {
+		if err := b.removeEtcdMember(ctx, fmt.Sprintf("https://localhost:%d", etcd.port), tlsConfig); err != nil {
+			return fmt.Errorf("failed removing member of etcd-%s: %w", etcd.role, err)
+		}
+	}
+
+	return nil
+}
+
+func (b *GardenadmBotanist) etcdClientTLSConfig() (*tls.Config, error) {
+	certificate, err := b.FS.ReadFile(filepath.Join(pathEtcdClientCertificateDirectory, secretsutils.DataKeyCertificate))
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := b.FS.ReadFile(filepath.Join(pathEtcdClientCertificateDirectory, secretsutils.DataKeyPrivateKey))
+	if err != nil {
+		return nil, err
+	}
+
+	caBundle, err := b.FS.ReadFile(filepath.Join(pathEtcdCADirectory, secretsutils.DataKeyCertificateBundle))
+	if err != nil {
+		return nil, err
+	}
+
+	keyPair, err := tls.X509KeyPair(certificate, privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing key pair: %w", err)
+	}
+
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(caBundle) {
+		return nil, fmt.Errorf("failed parsing CA bundle")
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+		RootCAs:      rootCAs,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func (b *GardenadmBotanist) removeEtcdMember(ctx context.Context, endpoint string, tlsConfig *tls.Config) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	etcdClient, err := NewEtcdClient(endpoint, tlsConfig)
+	if err != nil {
+		return fmt.Errorf("failed creating etcd client: %w", err)
+	}
+	defer func() {
+		if err := etcdClient.Close(); err != nil {
+			b.Logger.Error(err, "Failed closing etcd client", "endpoint", endpoint)
+		}
+	}()
+
+	status, err := etcdClient.Status(ctx, endpoint)
+	if err != nil {
+		return fmt.Errorf("failed fetching status of etcd member: %w", err)
+	}
+
+	members, err := etcdClient.MemberList(ctx)
+	if err != nil {
+		return fmt.Errorf("failed listing etcd members: %w", err)
+	}
+
+	if len(members.Members) <= 1 {
+		b.Logger.Info("Skipping removal of last member of etcd cluster", "endpoint", endpoint)
+		return nil
+	}
+
+	if _, err := etcdClient.MemberRemove(ctx, status.Header.MemberId); err != nil {
+		return fmt.Errorf("failed removing etcd member %x: %w", status.Header.MemberId, err)
+	}
+
+	b.Logger.Info("Successfully removed etcd member", "endpoint", endpoint, "memberID", fmt.Sprintf("%x", status.Header.MemberId))
+	return nil
+}
+
+// StopNodeAgentAndKubelet stops and disables the gardener-node-agent and kubelet units so that they don't interfere
+// with the cleanup of the node.
+func (b *GardenadmBotanist) StopNodeAgentAndKubelet(ctx context.Context) error {
+	existingUnits, err := b.existingUnitNames(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, unitName := range []string{nodeagentconfigv1alpha1.InitUnitName, nodeagentconfigv1alpha1.UnitName, kubelet.UnitName} {
+		if !slices.Contains(existingUnits, unitName) {
+			continue
+		}
+
+		if err := b.stopAndDisableUnit(ctx, unitName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RemoveContainers kills and removes all containers started by the kubelet, including their snapshots.
+func (b *GardenadmBotanist) RemoveContainers(ctx context.Context) error {
+	if exists, err := b.FS.Exists(defaults.DefaultAddress); err != nil {
+		return fmt.Errorf("failed checking whether containerd socket %s exists: %w", defaults.DefaultAddress, err)
+	} else if !exists {
+		b.Logger.Info("Containerd socket does not exist, skipping removal of containers", "address", defaults.DefaultAddress)
+		return nil
+	}
+
+	containerdClient, err := containerd.New(defaults.DefaultAddress, containerd.WithDefaultNamespace(containerdNamespaceKubernetes))
+	if err != nil {
+		return fmt.Errorf("error creating containerd client: %w", err)
+	}
+	defer func() {
+		if err := containerdClient.Close(); err != nil {
+			b.Logger.Error(err, "Failed closing containerd client")
+		}
+	}()
+
+	containers, err := containerdClient.Containers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed listing containers: %w", err)
+	}
+
+	var errs []error
+	for _, container := range containers {
+		if task, err := container.Task(ctx, nil); err == nil {
+			if _, err := task.Delete(ctx, containerd.WithProcessKill); err != nil && !errdefs.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("failed deleting task of container %s: %w", container.ID(), err))
+				continue
+			}
+		} else if !errdefs.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed fetching task of container %s: %w", container.ID(), err))
+			continue
+		}
+
+		if err := container.Delete(ctx, containerd.WithSnapshotCleanup); err != nil && !errdefs.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed deleting container %s: %w", container.ID(), err))
+		}
+	}
+
+	if len(errs) == 0 {
+		b.Logger.Info("Successfully removed containers", "count", len(containers))
+	}
+	return errors.Join(errs...)
+}
+
+// RemoveUnits stops and disables the units written by gardener-node-agent, and removes their unit files and drop-ins.
+// Similar to gardener-node-agent, units without content (e.g., default OS units which only got drop-ins) are not
+// stopped and their unit files are kept.
+func (b *GardenadmBotanist) RemoveUnits(ctx context.Context) error {
+	units, _, err := operatingsystemconfig.LastAppliedUnitsAndFiles(b.FS)
+	if err != nil {
+		return fmt.Errorf("failed reading units of last applied OperatingSystemConfig: %w", err)
+	}
+
+	existingUnits, err := b.existingUnitNames(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, unit := range units {
+		unitFilePath := path.Join(pathSystemdUnits, unit.Name)
+
+		if unit.Content != nil {
+			if slices.Contains(existingUnits, unit.Name) {
+				if err := b.stopAndDisableUnit(ctx, unit.Name); err != nil {
+					return err
+				}
+			}
+
+			if err := b.FS.Remove(unitFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+				return fmt.Errorf("failed removing unit file %s: %w", unitFilePath, err)
+			}
+		}
+
+		for _, dropIn := range unit.DropIns {
+			dropInFilePath := path.Join(unitFilePath+".d", dropIn.Name)
+			if err := b.FS.Remove(dropInFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+				return fmt.Errorf("failed removing drop-in file %s: %w", dropInFilePath, err)
+			}
+		}
+
+		b.Logger.Info("Successfully removed unit", "unitName", unit.Name)
+	}
+
+	if err := b.DBus.DaemonReload(ctx); err != nil {
+		return fmt.Errorf("failed reloading systemd daemon: %w", err)
+	}
+
+	return nil
+}
+
+func (b *GardenadmBotanist) existingUnitNames(ctx context.Context) ([]string, error) {
+	unitStatuses, err := b.DBus.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed listing systemd units: %w", err)
+	}
+
+	unitNames := make([]string, 0, len(unitStatuses))
+	for _, unitStatus := range unitStatuses {
+		unitNames = append(unitNames, unitStatus.Name)
+	}
+
+	return unitNames, nil
+}
+
+func (b *GardenadmBotanist) stopAndDisableUnit(ctx context.Context, unitName string) error {
+	if err := b.DBus.Disable(ctx, unitName); err != nil {
+		return fmt.Errorf("failed disabling unit %s: %w", unitName, err)
+	}
+
+	if err := b.DBus.Stop(ctx, nil, nil, unitName); err != nil {
+		return fmt.Errorf("failed stopping unit %s: %w", unitName, err)
+	}
+
+	b.Logger.Info("Successfully stopped and disabled unit", "unitName", unitName)
+	return nil
+}
+
+// RemoveFiles removes the files written by gardener-node-agent, the static pod manifests and the directories used by
+// the static pods (including the etcd data directories).
+func (b *GardenadmBotanist) RemoveFiles(_ context.Context) error {
+	directories, err := b.staticPodHostPathDirectories()
+	if err != nil {
+		return fmt.Errorf("failed computing directories of static pods: %w", err)
+	}
+
+	_, files, err := operatingsystemconfig.LastAppliedUnitsAndFiles(b.FS)
+	if err != nil {
+		return fmt.Errorf("failed reading files of last applied OperatingSystemConfig: %w", err)
+	}
+
+	for _, file := range files {
+		if err := b.FS.Remove(file.Path); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("failed removing file %s: %w", file.Path, err)
+		}
+	}
+
+	directories = append(directories,
+		filepath.Join(string(filepath.Separator), "var", "lib", bootstrapetcd.Name(v1beta1constants.ETCDRoleMain)),
+		filepath.Join(string(filepath.Separator), "var", "lib", bootstrapetcd.Name(v1beta1constants.ETCDRoleEvents)),
+	)
+
+	for _, directory := range directories {
+		if err := b.FS.RemoveAll(directory); err != nil {
+			return fmt.Errorf("failed removing directory %s: %w", directory, err)
+		}
+	}
+
+	b.Logger.Info("Successfully removed files and directories", "files", len(files), "directories", len(directories))
+	return nil
+}
+
+func (b *GardenadmBotanist) staticPodHostPathDirectories() ([]string, error) {
+	entries, err := b.FS.ReadDir(kubelet.FilePathKubernetesManifests)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading directory %s: %w", kubelet.FilePathKubernetesManifests, err)
+	}
+
+	var directories []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		manifestPath := filepath.Join(kubelet.FilePathKubernetesManifests, entry.Name())
+		manifest, err := b.FS.ReadFile(manifestPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading static pod manifest %s: %w", manifestPath, err)
+		}
+
+		pod := &corev1.Pod{}
+		if err := runtime.DecodeInto(kubernetes.SeedCodec.UniversalDeserializer(), manifest, pod); err != nil {
+			b.Logger.Info("Skipping file which is no static pod manifest", "path", manifestPath, "error", err.Error())
+			continue
+		}
+
+		if pod.Labels[staticpod.LabelKeyIsStaticPod] != staticpod.LabelValueIsStaticPod {
+			continue
+		}
+
+		if err := b.FS.Remove(manifestPath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return nil, fmt.Errorf("failed removing static pod manifest %s: %w", manifestPath, err)
+		}
+
+		directories = append(directories, staticpod.HostPathDirectories(pod)...)
+	}
+
+	return directories, nil
+}
+
+// CleanupDirectories unmounts and removes the content of the directories of kubelet and gardener-node-agent, and it
+// removes the admin kubeconfig.
+func (b *GardenadmBotanist) CleanupDirectories(_ context.Context) error {
+	for _, directory := range []string{
+		kubelet.PathKubeletDirectory,
+		nodeagentconfigv1alpha1.BaseDir,
+	} {
+		entries, err := b.FS.ReadDir(directory)
+		if err != nil {
+			if errors.Is(err, afero.ErrFileNotFound) {
+				continue
+			}
+			return fmt.Errorf("failed reading directory %s: %w", directory, err)
+		}
+
+		for _, entry := range entries {
+			entryPath := filepath.Join(directory, entry.Name())
+
+			if err := UnmountRecursive(entryPath, 0); err != nil {
+				return fmt.Errorf("failed unmounting %s: %w", entryPath, err)
+			}
+
+			if err := b.FS.RemoveAll(entryPath); err != nil {
+				return fmt.Errorf("failed removing %s: %w", entryPath, err)
+			}
+		}
+	}
+
+	if err := b.FS.Remove(PathKubeconfig); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return fmt.Errorf("failed removing admin kubeconfig %s: %w", PathKubeconfig, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,366 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+
+	"github.com/coreos/go-systemd/v22/dbus"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reset", func() {
+	var (
+		ctx context.Context
+
+		fakeShootClient client.Client
+		fakeDBus        *fakedbus.DBus
+		fs              afero.Afero
+
+		b *GardenadmBotanist
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		fakeShootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		fakeDBus = fakedbus.New()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+
+		b = &GardenadmBotanist{
+			Botanist: &botanistpkg.Botanist{
+				Operation: &operation.Operation{
+					Logger: logr.Discard(),
+					ShootClientSet: fakekubernetes.
+						NewClientSetBuilder().
+						WithClient(fakeShootClient).
+						WithRESTConfig(&rest.Config{}).
+						Build(),
+				},
+			},
+			FS:       fs,
+			DBus:     fakeDBus,
+			HostName: "machine-0",
+		}
+	})
+
+	Describe("#IsControlPlaneNode", func() {
+		It("should return false if the admin kubeconfig does not exist", func() {
+			Expect(b.IsControlPlaneNode()).To(BeFalse())
+		})
+
+		It("should return true if the admin kubeconfig exists", func() {
+			Expect(fs.WriteFile("/etc/kubernetes/admin.conf", []byte("kubeconfig"), 0600)).To(Succeed())
+
+			Expect(b.IsControlPlaneNode()).To(BeTrue())
+		})
+	})
+
+	Describe("#DeleteNode", func() {
+		It("should delete the node object", func() {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "machine-0"}}
+			Expect(fakeShootClient.Create(ctx, node)).To(Succeed())
+
+			Expect(b.DeleteNode(ctx)).To(Succeed())
+			Expect(fakeShootClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(BeNotFoundError())
+		})
+
+		It("should succeed if the node object does not exist", func() {
+			Expect(b.DeleteNode(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("#RemoveEtcdMembers", func() {
+		var (
+			etcdClients map[string]*fakeEtcdClient
+			endpoints   []string
+		)
+
+		BeforeEach(func() {
+			ca, err := (&secretsutils.CertificateSecretConfig{Name: "ca-etcd", CommonName: "ca-etcd", CertType: secretsutils.CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			clientCertificate, err := (&secretsutils.CertificateSecretConfig{Name: "etcd-client", CommonName: "etcd-client", CertType: secretsutils.ClientCert, SigningCA: ca}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fs.WriteFile("/var/lib/kube-apiserver/ca-etcd/bundle.crt", ca.CertificatePEM, 0600)).To(Succeed())
+			Expect(fs.WriteFile("/var/lib/kube-apiserver/etcd-client/tls.crt", clientCertificate.CertificatePEM, 0600)).To(Succeed())
+			Expect(fs.WriteFile("/var/lib/kube-apiserver/etcd-client/tls.key", clientCertificate.PrivateKeyPEM, 0600)).To(Succeed())
+
+			etcdClients = map[string]*fakeEtcdClient{
+				"https://localhost:2379": {memberID: 1, members: []uint64{1, 2, 3}},
+				"https://localhost:2382": {memberID: 4, members: []uint64{4, 5, 6}},
+			}
+			endpoints = nil
+
+			DeferCleanup(test.WithVar(&NewEtcdClient, func(endpoint string, tlsConfig *tls.Config) (EtcdClient, error) {
+				Expect(tlsConfig.Certificates).To(HaveLen(1))
+				Expect(tlsConfig.RootCAs).NotTo(BeNil())
+
+				endpoints = append(endpoints, endpoint)
+				etcdClient, ok := etcdClients[endpoint]
+				if !ok {
+					return nil, fmt.Errorf("unexpected endpoint %s", endpoint)
+				}
+				return etcdClient, nil
+			}))
+		})
+
+		It("should remove the members of this node", func() {
+			Expect(b.RemoveEtcdMembers(ctx)).To(Succeed())
+
+			Expect(endpoints).To(Equal([]string{"https://localhost:2379", "https://localhost:2382"}))
+			Expect(etcdClients["https://localhost:2379"].removedMembers).To(ConsistOf(uint64(1)))
+			Expect(etcdClients["https://localhost:2379"].closed).To(BeTrue())
+			Expect(etcdClients["https://localhost:2382"].removedMembers).To(ConsistOf(uint64(4)))
+			Expect(etcdClients["https://localhost:2382"].closed).To(BeTrue())
+		})
+
+		It("should not remove the last member of an etcd cluster", func() {
+			etcdClients["https://localhost:2379"].members = []uint64{1}
+
+			Expect(b.RemoveEtcdMembers(ctx)).To(Succeed())
+
+			Expect(etcdClients["https://localhost:2379"].removedMembers).To(BeEmpty())
+			Expect(etcdClients["https://localhost:2382"].removedMembers).To(ConsistOf(uint64(4)))
+		})
+
+		It("should fail if the etcd client certificate does not exist", func() {
+			Expect(fs.Remove("/var/lib/kube-apiserver/etcd-client/tls.key")).To(Succeed())
+
+			Expect(b.RemoveEtcdMembers(ctx)).To(MatchError(ContainSubstring("failed reading etcd client certificate")))
+			Expect(endpoints).To(BeEmpty())
+		})
+	})
+
+	Describe("#StopNodeAgentAndKubelet", func() {
+		It("should stop and disable the existing units", func() {
+			fakeDBus.AddUnitsToList(dbus.UnitStatus{Name: "gardener-node-agent.service"}, dbus.UnitStatus{Name: "kubelet.service"})
+
+			Expect(b.StopNodeAgentAndKubelet(ctx)).To(Succeed())
+
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+				{Action: fakedbus.ActionList},
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"gardener-node-agent.service"}},
+				{Action: fakedbus.ActionStop, UnitNames: []string{"gardener-node-agent.service"}},
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"kubelet.service"}},
+				{Action: fakedbus.ActionStop, UnitNames: []string{"kubelet.service"}},
+			}))
+		})
+	})
+
+	Describe("#RemoveUnits", func() {
+		BeforeEach(func() {
+			Expect(fs.WriteFile("/var/lib/gardener-node-agent/last-applied-osc.yaml", []byte(`apiVersion: extensions.gardener.cloud/v1alpha1
+kind: OperatingSystemConfig
+spec:
+  units:
+  - name: kubelet.service
+    content: kubelet
+    dropIns:
+    - name: 10-kubelet.conf
+      content: kubelet
+  - name: containerd.service
+    dropIns:
+    - name: 10-containerd.conf
+      content: containerd
+status:
+  extensionUnits:
+  - name: extension.service
+    content: extension
+`), 0600)).To(Succeed())
+
+			for _, path := range []string{
+				"/etc/systemd/system/kubelet.service",
+				"/etc/systemd/system/kubelet.service.d/10-kubelet.conf",
+				"/etc/systemd/system/containerd.service",
+				"/etc/systemd/system/containerd.service.d/10-containerd.conf",
+				"/etc/systemd/system/extension.service",
+			} {
+				Expect(fs.WriteFile(path, []byte("unit"), 0600)).To(Succeed())
+			}
+		})
+
+		It("should stop the units created by gardener-node-agent and remove their files", func() {
+			fakeDBus.AddUnitsToList(dbus.UnitStatus{Name: "kubelet.service"}, dbus.UnitStatus{Name: "containerd.service"})
+
+			Expect(b.RemoveUnits(ctx)).To(Succeed())
+
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+				{Action: fakedbus.ActionList},
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"kubelet.service"}},
+				{Action: fakedbus.ActionStop, UnitNames: []string{"kubelet.service"}},
+				{Action: fakedbus.ActionDaemonReload},
+			}))
+
+			Expect(fs.Exists("/etc/systemd/system/kubelet.service")).To(BeFalse())
+			Expect(fs.Exists("/etc/systemd/system/kubelet.service.d/10-kubelet.conf")).To(BeFalse())
+			Expect(fs.Exists("/etc/systemd/system/containerd.service")).To(BeTrue())
+			Expect(fs.Exists("/etc/systemd/system/containerd.service.d/10-containerd.conf")).To(BeFalse())
+			Expect(fs.Exists("/etc/systemd/system/extension.service")).To(BeFalse())
+		})
+
+		It("should only reload the systemd daemon if no OperatingSystemConfig was applied", func() {
+			Expect(fs.Remove("/var/lib/gardener-node-agent/last-applied-osc.yaml")).To(Succeed())
+
+			Expect(b.RemoveUnits(ctx)).To(Succeed())
+
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+				{Action: fakedbus.ActionList},
+				{Action: fakedbus.ActionDaemonReload},
+			}))
+			Expect(fs.Exists("/etc/systemd/system/kubelet.service")).To(BeTrue())
+		})
+	})
+
+	Describe("#RemoveFiles", func() {
+		It("should remove the files, static pod manifests and their directories", func() {
+			Expect(fs.WriteFile("/var/lib/gardener-node-agent/last-applied-osc.yaml", []byte(`apiVersion: extensions.gardener.cloud/v1alpha1
+kind: OperatingSystemConfig
+spec:
+  files:
+  - path: /etc/foo
+status:
+  extensionFiles:
+  - path: /etc/bar
+`), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/etc/foo", []byte("foo"), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/etc/bar", []byte("bar"), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/etc/kubernetes/manifests/etcd-main.yaml", []byte(`apiVersion: v1
+kind: Pod
+metadata:
+  name: etcd-main
+  labels:
+    static-pod: "true"
+spec:
+  volumes:
+  - name: config
+    hostPath:
+      path: /var/lib/etcd-main/config
+  - name: main-etcd
+    hostPath:
+      path: /var/lib/main-etcd/data
+`), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/etc/kubernetes/manifests/other.yaml", []byte(`apiVersion: v1
+kind: Pod
+metadata:
+  name: other
+spec:
+  volumes:
+  - name: data
+    hostPath:
+      path: /var/lib/other/data
+`), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/var/lib/etcd-main/config/etcd.conf.yaml", []byte("config"), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/var/lib/main-etcd/data/member", []byte("data"), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/var/lib/other/data/foo", []byte("data"), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/var/lib/etcd-bootstrap-main/data/member", []byte("data"), 0600)).To(Succeed())
+
+			Expect(b.RemoveFiles(ctx)).To(Succeed())
+
+			Expect(fs.Exists("/etc/foo")).To(BeFalse())
+			Expect(fs.Exists("/etc/bar")).To(BeFalse())
+			Expect(fs.Exists("/etc/kubernetes/manifests/etcd-main.yaml")).To(BeFalse())
+			Expect(fs.Exists("/var/lib/etcd-main")).To(BeFalse())
+			Expect(fs.Exists("/var/lib/main-etcd")).To(BeFalse())
+			Expect(fs.Exists("/var/lib/etcd-bootstrap-main")).To(BeFalse())
+			Expect(fs.Exists("/etc/kubernetes/manifests/other.yaml")).To(BeTrue())
+			Expect(fs.Exists("/var/lib/other/data/foo")).To(BeTrue())
+		})
+	})
+
+	Describe("#CleanupDirectories", func() {
+		var unmounted []string
+
+		BeforeEach(func() {
+			unmounted = nil
+			DeferCleanup(test.WithVar(&UnmountRecursive, func(target string, _ int) error {
+				unmounted = append(unmounted, target)
+				return nil
+			}))
+		})
+
+		It("should unmount and remove the contents of the directories", func() {
+			Expect(fs.WriteFile("/var/lib/kubelet/config/kubelet", []byte("config"), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/var/lib/kubelet/pods/uid/volumes/foo", []byte("volume"), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/var/lib/gardener-node-agent/last-applied-osc.yaml", []byte("osc"), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/etc/kubernetes/admin.conf", []byte("kubeconfig"), 0600)).To(Succeed())
+
+			Expect(b.CleanupDirectories(ctx)).To(Succeed())
+
+			Expect(unmounted).To(ConsistOf(
+				"/var/lib/kubelet/config",
+				"/var/lib/kubelet/pods",
+				"/var/lib/gardener-node-agent/last-applied-osc.yaml",
+			))
+			Expect(fs.DirExists("/var/lib/kubelet")).To(BeTrue())
+			Expect(fs.IsEmpty("/var/lib/kubelet")).To(BeTrue())
+			Expect(fs.DirExists("/var/lib/gardener-node-agent")).To(BeTrue())
+			Expect(fs.IsEmpty("/var/lib/gardener-node-agent")).To(BeTrue())
+			Expect(fs.Exists("/etc/kubernetes/admin.conf")).To(BeFalse())
+		})
+
+		It("should fail if unmounting fails", func() {
+			Expect(fs.WriteFile("/var/lib/kubelet/pods/uid/volumes/foo", []byte("volume"), 0600)).To(Succeed())
+			DeferCleanup(test.WithVar(&UnmountRecursive, func(string, int) error { return fmt.Errorf("fake") }))
+
+			Expect(b.CleanupDirectories(ctx)).To(MatchError(ContainSubstring("failed unmounting /var/lib/kubelet/pods")))
+			Expect(fs.Exists("/var/lib/kubelet/pods/uid/volumes/foo")).To(BeTrue())
+		})
+	})
+})
+
+type fakeEtcdClient struct {
+	clientv3.Cluster
+	clientv3.Maintenance
+
+	memberID       uint64
+	members        []uint64
+	removedMembers []uint64
+	closed         bool
+}
+
+func (f *fakeEtcdClient) Status(_ context.Context, _ string) (*clientv3.StatusResponse, error) {
+	return &clientv3.StatusResponse{Header: &etcdserverpb.ResponseHeader{MemberId: f.memberID}}, nil
+}
+
+func (f *fakeEtcdClient) MemberList(_ context.Context, _ ...clientv3.OpOption) (*clientv3.MemberListResponse, error) {
+	var members []*etcdserverpb.Member
+	for _, id := range f.members {
+		members = append(members, &etcdserverpb.Member{ID: id})
+	}
+	return &clientv3.MemberListResponse{Members: members}, nil
+}
+
+func (f *fakeEtcdClient) MemberRemove(_ context.Context, id uint64) (*clientv3.MemberRemoveResponse, error) {
+	f.removedMembers = append(f.removedMembers, id)
+	return &clientv3.MemberRemoveResponse{}, nil
+}
+
+func (f *fakeEtcdClient) Close() error {
+	f.closed = true
+	return nil
+}
@@ -9,24 +9,31 @@ import (
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
-// MigrateSecrets exports the secrets generated with the fake client and imports them with the real client.
+// MigrateSecrets exports the secrets generated with the fake client and imports them with the real client. If the
+// control plane is restored from an etcd snapshot (see PrepareEtcdRestore), the secrets already exist and are kept.
 func (b *GardenadmBotanist) MigrateSecrets(ctx context.Context, fakeClient, realClient client.Client) error {
 	secretList := &corev1.SecretList{}
 	if err := fakeClient.List(ctx, secretList, client.InNamespace(b.Shoot.ControlPlaneNamespace)); err != nil {
 		return fmt.Errorf("failed listing secrets with fake client: %w", err)
 	}
 
+	restoring, err := b.FS.Exists(PathEtcdRestoreDirectory)
+	if err != nil {
+		return fmt.Errorf("failed checking whether %s exists: %w", PathEtcdRestoreDirectory, err)
+	}
+
 	var taskFns []flow.TaskFn
 
 	for _, secret := range secretList.Items {
 		taskFns = append(taskFns, func(ctx context.Context) error {
-			return realClient.Create(ctx, &corev1.Secret{
+			if err := realClient.Create(ctx, &corev1.Secret{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:        secret.Name,
 					Namespace:   secret.Namespace,
@@ -36,7 +43,15 @@ func (b *GardenadmBotanist) MigrateSecrets(ctx context.Context, fakeClient, real
 				Type:      secret.Type,
 				Immutable: secret.Immutable,
 				Data:      secret.Data,
-			})
+			}); err != nil {
+				// When the control plane was restored from an etcd snapshot, the secrets already exist. The certificate
+				// authorities were restored from the ShootState, hence the existing secrets are still valid.
+				if restoring && apierrors.IsAlreadyExists(err) {
+					return nil
+				}
+				return err
+			}
+			return nil
 		})
 	}
 
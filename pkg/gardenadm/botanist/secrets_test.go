@@ -9,6 +9,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
@@ -29,6 +30,7 @@ var _ = Describe("Secrets", func() {
 
 		fakeClient1 client.Client
 		fakeClient2 client.Client
+		fs          afero.Afero
 
 		b *GardenadmBotanist
 	)
@@ -38,8 +40,10 @@ var _ = Describe("Secrets", func() {
 
 		fakeClient1 = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
 		fakeClient2 = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
 
 		b = &GardenadmBotanist{
+			FS: fs,
 			Botanist: &botanistpkg.Botanist{
 				Operation: &operation.Operation{
 					SeedClientSet: fakekubernetes.
@@ -100,5 +104,32 @@ var _ = Describe("Secrets", func() {
 				},
 			))
 		})
+
+		Context("secrets already exist", func() {
+			BeforeEach(func() {
+				Expect(fakeClient1.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "s1", Namespace: "kube-system"},
+					Data:       map[string][]byte{"foo": []byte("new")},
+				})).To(Succeed())
+				Expect(fakeClient2.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "s1", Namespace: "kube-system"},
+					Data:       map[string][]byte{"foo": []byte("restored")},
+				})).To(Succeed())
+			})
+
+			It("should fail if the control plane is not restored from an etcd snapshot", func() {
+				Expect(b.MigrateSecrets(ctx, fakeClient1, fakeClient2)).To(MatchError(ContainSubstring("already exists")))
+			})
+
+			It("should keep the existing secrets if the control plane is restored from an etcd snapshot", func() {
+				Expect(fs.MkdirAll(PathEtcdRestoreDirectory, 0700)).To(Succeed())
+
+				Expect(b.MigrateSecrets(ctx, fakeClient1, fakeClient2)).To(Succeed())
+
+				secret := &corev1.Secret{}
+				Expect(fakeClient2.Get(ctx, client.ObjectKey{Name: "s1", Namespace: "kube-system"}, secret)).To(Succeed())
+				Expect(secret.Data).To(HaveKeyWithValue("foo", []byte("restored")))
+			})
+		})
 	})
 })
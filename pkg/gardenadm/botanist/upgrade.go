@@ -0,0 +1,349 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/imagevector"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/apis/core/validation"
+	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+// MaxKubeletMinorVersionSkew is the maximum number of minor versions that a kubelet may be older than the
+// kube-apiserver, see https://kubernetes.io/releases/version-skew-policy/#kubelet.
+const MaxKubeletMinorVersionSkew = 3
+
+// AvailableKubernetesUpgrades returns the versions from the given list that the control plane can be upgraded to when
+// running the given current version. Only versions which are newer than the current version, which do not skip a minor
+// version, and which are not expired are considered. The result is sorted in ascending order.
+func AvailableKubernetesUpgrades(versions []gardencorev1beta1.ExpirableVersion, current *semver.Version) ([]gardencorev1beta1.ExpirableVersion, error) {
+	var available []gardencorev1beta1.ExpirableVersion
+
+	for _, version := range versions {
+		v, err := semver.NewVersion(version.Version)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing version %q: %w", version.Version, err)
+		}
+
+		if !v.GreaterThan(current) ||
+			v.Major() != current.Major() ||
+			v.Minor() > current.Minor()+1 ||
+			v1beta1helper.CurrentLifecycleClassification(version) == gardencorev1beta1.ClassificationExpired {
+			continue
+		}
+
+		available = append(available, version)
+	}
+
+	slices.SortFunc(available, func(a, b gardencorev1beta1.ExpirableVersion) int {
+		return semver.MustParse(a.Version).Compare(semver.MustParse(b.Version))
+	})
+
+	return available, nil
+}
+
+// ValidateKubernetesVersionUpgrade checks whether the control plane can be upgraded from the current to the target
+// Kubernetes version. The target version must be offered by the CloudProfile and must not be expired, the upgrade must
+// neither be a downgrade nor skip a minor version, and the kubelets of all nodes must still be within the supported
+// version skew after the upgrade.
+func (b *GardenadmBotanist) ValidateKubernetesVersionUpgrade(ctx context.Context, current, target *semver.Version) error {
+	var errs []error
+
+	if fieldErrs := validation.ValidateKubernetesVersionUpdate(target.String(), current.String(), false, field.NewPath("spec", "kubernetes", "version")); len(fieldErrs) > 0 {
+		errs = append(errs, fieldErrs.ToAggregate())
+	}
+
+	exists, version, err := v1beta1helper.KubernetesVersionExistsInCloudProfile(b.Shoot.CloudProfile, target.String())
+	if err != nil {
+		return fmt.Errorf("failed checking whether version %s exists in CloudProfile: %w", target, err)
+	}
+	if !exists {
+		errs = append(errs, fmt.Errorf("kubernetes version %s is not offered by CloudProfile %q", target, b.Shoot.CloudProfile.Name))
+	} else if v1beta1helper.CurrentLifecycleClassification(version) == gardencorev1beta1.ClassificationExpired {
+		errs = append(errs, fmt.Errorf("kubernetes version %s is expired in CloudProfile %q", target, b.Shoot.CloudProfile.Name))
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := b.ShootClientSet.Client().List(ctx, nodeList); err != nil {
+		return fmt.Errorf("failed listing nodes: %w", err)
+	}
+
+	for _, node := range nodeList.Items {
+		kubeletVersion, err := semver.NewVersion(node.Status.NodeInfo.KubeletVersion)
+		if err != nil {
+			return fmt.Errorf("failed parsing kubelet version %q of node %q: %w", node.Status.NodeInfo.KubeletVersion, node.Name, err)
+		}
+
+		if kubeletVersion.Major() != target.Major() || kubeletVersion.Minor()+MaxKubeletMinorVersionSkew < target.Minor() {
+			errs = append(errs, fmt.Errorf("kubelet version %s of node %q is more than %d minor versions older than the target version %s", kubeletVersion, node.Name, MaxKubeletMinorVersionSkew, target))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ComponentImage describes the currently used and the desired container image of a component.
+type ComponentImage struct {
+	// Name is the name of the component.
+	Name string
+	// Current is the image which is currently used by the component. If the component runs on multiple nodes with
+	// different images, all of them are listed (comma-separated).
+	Current string
+	// Desired is the image which the component would use after the upgrade according to the image vector.
+	Desired string
+}
+
+// Changed returns true if the desired image differs from the currently used image.
+func (c ComponentImage) Changed() bool {
+	return c.Current != c.Desired
+}
+
+type upgradeComponent struct {
+	name      string
+	namespace string
+	imageName string
+	// staticPod specifies whether the component runs as static pod on the control plane nodes. Otherwise, it runs as a
+	// Deployment with the given name.
+	staticPod bool
+}
+
+func (b *GardenadmBotanist) upgradeComponents() []upgradeComponent {
+	return []upgradeComponent{
+		{name: v1beta1constants.DeploymentNameKubeAPIServer, namespace: b.Shoot.ControlPlaneNamespace, imageName: imagevector.ContainerImageNameKubeApiserver, staticPod: true},
+		{name: v1beta1constants.DeploymentNameKubeControllerManager, namespace: b.Shoot.ControlPlaneNamespace, imageName: imagevector.ContainerImageNameKubeControllerManager, staticPod: true},
+		{name: v1beta1constants.DeploymentNameKubeScheduler, namespace: b.Shoot.ControlPlaneNamespace, imageName: imagevector.ContainerImageNameKubeScheduler, staticPod: true},
+		{name: v1beta1constants.DeploymentNameGardenerResourceManager, namespace: b.Shoot.ControlPlaneNamespace, imageName: imagevector.ContainerImageNameGardenerResourceManager},
+		{name: etcd.Druid, namespace: v1beta1constants.GardenNamespace, imageName: imagevector.ContainerImageNameEtcdDruid},
+	}
+}
+
+// ComponentImages computes the currently used and the desired container images of the control plane components whose
+// images are determined by the image vector of this gardenadm binary and the given target Kubernetes version.
+func (b *GardenadmBotanist) ComponentImages(ctx context.Context, target *semver.Version) ([]ComponentImage, error) {
+	staticPodList := &corev1.PodList{}
+	if err := b.SeedClientSet.Client().List(ctx, staticPodList, client.InNamespace(b.Shoot.ControlPlaneNamespace), client.MatchingLabels{staticpod.LabelKeyIsStaticPod: staticpod.LabelValueIsStaticPod}); err != nil {
+		return nil, fmt.Errorf("failed listing static pods in namespace %q: %w", b.Shoot.ControlPlaneNamespace, err)
+	}
+
+	var result []ComponentImage
+
+	for _, component := range b.upgradeComponents() {
+		image, err := imagevector.Containers().FindImage(component.imageName, imagevectorutils.RuntimeVersion(target.String()), imagevectorutils.TargetVersion(target.String()), imagevectorutils.RegistryMirrors(b.RegistryMirrors()))
+		if err != nil {
+			return nil, fmt.Errorf("failed finding image %q: %w", component.imageName, err)
+		}
+
+		currentImages := sets.New[string]()
+		if component.staticPod {
+			for _, pod := range staticPodList.Items {
+				if pod.Name != component.name+"-"+pod.Spec.NodeName {
+					continue
+				}
+				currentImages.Insert(containerImage(pod.Spec.Containers, component.name))
+			}
+		} else {
+			deployment := &appsv1.Deployment{}
+			if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Name: component.name, Namespace: component.namespace}, deployment); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return nil, fmt.Errorf("failed reading deployment %s/%s: %w", component.namespace, component.name, err)
+				}
+			} else {
+				currentImages.Insert(containerImage(deployment.Spec.Template.Spec.Containers, component.name))
+			}
+		}
+
+		result = append(result, ComponentImage{
+			Name:    component.name,
+			Current: strings.Join(sets.List(currentImages), ", "),
+			Desired: image.String(),
+		})
+	}
+
+	return result, nil
+}
+
+func containerImage(containers []corev1.Container, name string) string {
+	for _, container := range containers {
+		if container.Name == name {
+			return container.Image
+		}
+	}
+	return ""
+}
+
+// IsEtcdManagedByDruid checks whether the etcd clusters of the control plane are already managed by etcd-druid, or
+// whether the control plane still runs the bootstrap etcds (e.g., because `gardenadm init` was executed with
+// `--use-bootstrap-etcd`).
+func (b *GardenadmBotanist) IsEtcdManagedByDruid(ctx context.Context) (bool, error) {
+	if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Name: "etcd-" + v1beta1constants.ETCDRoleMain, Namespace: b.Shoot.ControlPlaneNamespace}, &druidcorev1alpha1.Etcd{}); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed checking whether etcd is managed by etcd-druid: %w", err)
+	}
+
+	return true, nil
+}
+
+// ControlPlaneNodeNames returns the sorted names of all nodes running static control plane pods.
+func (b *GardenadmBotanist) ControlPlaneNodeNames(ctx context.Context) ([]string, error) {
+	staticPodList := &corev1.PodList{}
+	if err := b.SeedClientSet.Client().List(ctx, staticPodList, client.InNamespace(b.Shoot.ControlPlaneNamespace), client.MatchingLabels{staticpod.LabelKeyIsStaticPod: staticpod.LabelValueIsStaticPod}); err != nil {
+		return nil, fmt.Errorf("failed listing static pods in namespace %q: %w", b.Shoot.ControlPlaneNamespace, err)
+	}
+
+	nodeNames := sets.New[string]()
+	for _, pod := range staticPodList.Items {
+		nodeNames.Insert(pod.Spec.NodeName)
+	}
+
+	return sets.List(nodeNames), nil
+}
+
+// HoldControlPlaneNodeUpdates annotates all control plane nodes so that gardener-node-agent does not apply changes of
+// the operating system config to them until RollOutControlPlaneNodes releases them one after another. It must be called
+// before the Secret containing the operating system config for gardener-node-agent is updated.
+func (b *GardenadmBotanist) HoldControlPlaneNodeUpdates(ctx context.Context) error {
+	nodeNames, err := b.ControlPlaneNodeNames(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, nodeName := range nodeNames {
+		node := &corev1.Node{}
+		if err := b.ShootClientSet.Client().Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+			return fmt.Errorf("failed reading node %q: %w", nodeName, err)
+		}
+
+		patch := client.MergeFrom(node.DeepCopy())
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, v1beta1constants.AnnotationNodeAgentHoldOperatingSystemConfigUpdate, "true")
+		if err := b.ShootClientSet.Client().Patch(ctx, node, patch); err != nil {
+			return fmt.Errorf("failed putting updates of node %q on hold: %w", nodeName, err)
+		}
+	}
+
+	return nil
+}
+
+// RollOutControlPlaneNodes upgrades the control plane nodes one after another. For each node, it removes the annotation
+// added by HoldControlPlaneNodeUpdates, so that gardener-node-agent applies the new operating system config, and waits
+// until the node has been upgraded to the desired state before releasing the next node. A node is considered upgraded
+// when it is healthy, its kubelet runs the Kubernetes version of the shoot, and all static control plane pods on this
+// node have the desired hash and are ready. The nodes are upgraded in alphabetical order and the first node which does
+// not become healthy in time stops the upgrade, i.e., the remaining nodes stay on hold and keep running the previous
+// version.
+func (b *GardenadmBotanist) RollOutControlPlaneNodes(ctx context.Context) error {
+	nodeNames, err := b.ControlPlaneNodeNames(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, nodeName := range nodeNames {
+		log := b.Logger.WithValues("node", nodeName)
+		log.Info("Releasing control plane node for upgrade")
+
+		if err := b.releaseControlPlaneNode(ctx, nodeName); err != nil {
+			return err
+		}
+
+		log.Info("Waiting until control plane node has been upgraded")
+
+		if err := b.waitUntilControlPlaneNodeUpgraded(ctx, log, nodeName); err != nil {
+			return fmt.Errorf("failed waiting for control plane node %q to be upgraded: %w", nodeName, err)
+		}
+
+		log.Info("Control plane node has been upgraded successfully")
+	}
+
+	return nil
+}
+
+func (b *GardenadmBotanist) releaseControlPlaneNode(ctx context.Context, nodeName string) error {
+	node := &corev1.Node{}
+	if err := b.ShootClientSet.Client().Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		return fmt.Errorf("failed reading node %q: %w", nodeName, err)
+	}
+
+	if !metav1.HasAnnotation(node.ObjectMeta, v1beta1constants.AnnotationNodeAgentHoldOperatingSystemConfigUpdate) {
+		return nil
+	}
+
+	patch := client.MergeFrom(node.DeepCopy())
+	delete(node.Annotations, v1beta1constants.AnnotationNodeAgentHoldOperatingSystemConfigUpdate)
+	if err := b.ShootClientSet.Client().Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed releasing updates of node %q: %w", nodeName, err)
+	}
+
+	return nil
+}
+
+func (b *GardenadmBotanist) waitUntilControlPlaneNodeUpgraded(ctx context.Context, log logr.Logger, nodeName string) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, botanist.GetTimeoutWaitOperatingSystemConfigUpdated(b.Shoot))
+	defer cancel()
+
+	desiredKubeletVersion := "v" + b.Shoot.KubernetesVersion.String()
+
+	return retry.Until(timeoutCtx, botanist.IntervalWaitOperatingSystemConfigUpdated, func(ctx context.Context) (done bool, err error) {
+		// kube-apiserver might restart while it is upgraded, hence, we should tolerate that it is temporarily not
+		// available. That's why we use retry.MinorError here instead of retry.SevereError.
+		node := &corev1.Node{}
+		if err := b.ShootClientSet.Client().Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+			return retry.MinorError(fmt.Errorf("failed reading node: %w", err))
+		}
+
+		if err := health.CheckNode(node); err != nil {
+			return retry.MinorError(fmt.Errorf("node is not healthy: %w", err))
+		}
+
+		if kubeletVersion := node.Status.NodeInfo.KubeletVersion; kubeletVersion != desiredKubeletVersion {
+			log.Info("Waiting for kubelet to be upgraded", "kubeletVersion", kubeletVersion, "desiredKubeletVersion", desiredKubeletVersion)
+			return retry.MinorError(fmt.Errorf("kubelet has version %s, expected %s", kubeletVersion, desiredKubeletVersion))
+		}
+
+		for name, hash := range b.staticPodNameToHash {
+			pod := &corev1.Pod{}
+			if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Name: name + "-" + nodeName, Namespace: b.Shoot.ControlPlaneNamespace}, pod); err != nil {
+				return retry.MinorError(fmt.Errorf("failed reading static pod %q: %w", name, err))
+			}
+
+			if pod.Annotations[staticpod.AnnotationKeyHash] != hash {
+				log.Info("Waiting for static pod to be updated to the desired state", "pod", client.ObjectKeyFromObject(pod))
+				return retry.MinorError(fmt.Errorf("static pod %q has not been updated yet", name))
+			}
+
+			if !health.IsPodReady(pod) {
+				log.Info("Waiting for static pod to become ready", "pod", client.ObjectKeyFromObject(pod))
+				return retry.MinorError(fmt.Errorf("static pod %q is not ready yet", name))
+			}
+		}
+
+		return retry.Ok()
+	})
+}
@@ -0,0 +1,343 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Upgrade", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClient    client.Client
+		fakeClientSet kubernetes.Interface
+		b             *GardenadmBotanist
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClientSet = fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build()
+
+		b = &GardenadmBotanist{
+			Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{
+				Logger:         logr.Discard(),
+				SeedClientSet:  fakeClientSet,
+				ShootClientSet: fakeClientSet,
+				Shoot: &shoot.Shoot{
+					ControlPlaneNamespace: "kube-system",
+					KubernetesVersion:     semver.MustParse("1.34.1"),
+					CloudProfile: &gardencorev1beta1.CloudProfile{
+						ObjectMeta: metav1.ObjectMeta{Name: "local"},
+						Spec: gardencorev1beta1.CloudProfileSpec{
+							Kubernetes: gardencorev1beta1.KubernetesSettings{
+								Versions: []gardencorev1beta1.ExpirableVersion{
+									{Version: "1.33.0"},
+									{Version: "1.34.1"},
+									{Version: "1.34.0", ExpirationDate: &metav1.Time{Time: time.Now().Add(-time.Hour)}},
+								},
+							},
+						},
+					},
+				},
+			}},
+		}
+	})
+
+	Describe("#AvailableKubernetesUpgrades", func() {
+		It("should only return newer versions which do not skip a minor version and are not expired", func() {
+			versions := []gardencorev1beta1.ExpirableVersion{
+				{Version: "2.0.0"},
+				{Version: "1.35.0"},
+				{Version: "1.34.2"},
+				{Version: "1.34.1", ExpirationDate: &metav1.Time{Time: time.Now().Add(-time.Hour)}},
+				{Version: "1.33.5", Classification: ptr.To(gardencorev1beta1.ClassificationDeprecated)},
+				{Version: "1.33.1"},
+				{Version: "1.33.0"},
+				{Version: "1.32.9"},
+			}
+
+			Expect(AvailableKubernetesUpgrades(versions, semver.MustParse("1.33.1"))).To(HaveExactElements(
+				HaveField("Version", "1.33.5"),
+				HaveField("Version", "1.34.2"),
+			))
+		})
+
+		It("should return nothing if there are no newer versions", func() {
+			Expect(AvailableKubernetesUpgrades([]gardencorev1beta1.ExpirableVersion{{Version: "1.33.0"}}, semver.MustParse("1.33.0"))).To(BeEmpty())
+		})
+
+		It("should fail if a version cannot be parsed", func() {
+			Expect(AvailableKubernetesUpgrades([]gardencorev1beta1.ExpirableVersion{{Version: "foo"}}, semver.MustParse("1.33.0"))).Error().To(MatchError(ContainSubstring(`failed parsing version "foo"`)))
+		})
+	})
+
+	Describe("#ValidateKubernetesVersionUpgrade", func() {
+		createNode := func(name, kubeletVersion string) {
+			GinkgoHelper()
+			Expect(fakeClient.Create(ctx, &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Status:     corev1.NodeStatus{NodeInfo: corev1.NodeSystemInfo{KubeletVersion: kubeletVersion}},
+			})).To(Succeed())
+		}
+
+		It("should succeed for a valid upgrade", func() {
+			createNode("node1", "v1.33.0")
+			createNode("node2", "v1.31.4")
+
+			Expect(b.ValidateKubernetesVersionUpgrade(ctx, semver.MustParse("1.33.0"), semver.MustParse("1.34.1"))).To(Succeed())
+		})
+
+		It("should fail for a downgrade", func() {
+			Expect(b.ValidateKubernetesVersionUpgrade(ctx, semver.MustParse("1.34.1"), semver.MustParse("1.33.0"))).To(MatchError(ContainSubstring("kubernetes version downgrade is not supported")))
+		})
+
+		It("should fail when skipping a minor version", func() {
+			b.Shoot.CloudProfile.Spec.Kubernetes.Versions = append(b.Shoot.CloudProfile.Spec.Kubernetes.Versions, gardencorev1beta1.ExpirableVersion{Version: "1.35.0"})
+
+			Expect(b.ValidateKubernetesVersionUpgrade(ctx, semver.MustParse("1.33.0"), semver.MustParse("1.35.0"))).To(MatchError(ContainSubstring("kubernetes version upgrade cannot skip a minor version")))
+		})
+
+		It("should fail when the version is not offered by the CloudProfile", func() {
+			Expect(b.ValidateKubernetesVersionUpgrade(ctx, semver.MustParse("1.33.0"), semver.MustParse("1.34.2"))).To(MatchError(ContainSubstring(`kubernetes version 1.34.2 is not offered by CloudProfile "local"`)))
+		})
+
+		It("should fail when the version is expired", func() {
+			Expect(b.ValidateKubernetesVersionUpgrade(ctx, semver.MustParse("1.33.0"), semver.MustParse("1.34.0"))).To(MatchError(ContainSubstring(`kubernetes version 1.34.0 is expired in CloudProfile "local"`)))
+		})
+
+		It("should fail when a kubelet would exceed the supported version skew", func() {
+			createNode("node1", "v1.33.0")
+			createNode("node2", "v1.30.2")
+
+			Expect(b.ValidateKubernetesVersionUpgrade(ctx, semver.MustParse("1.33.0"), semver.MustParse("1.34.1"))).To(MatchError(ContainSubstring(`kubelet version 1.30.2 of node "node2" is more than 3 minor versions older than the target version 1.34.1`)))
+		})
+
+		It("should fail when a kubelet version cannot be parsed", func() {
+			createNode("node1", "foo")
+
+			Expect(b.ValidateKubernetesVersionUpgrade(ctx, semver.MustParse("1.33.0"), semver.MustParse("1.34.1"))).To(MatchError(ContainSubstring(`failed parsing kubelet version "foo" of node "node1"`)))
+		})
+	})
+
+	Describe("#ComponentImages", func() {
+		It("should compute the current and desired images", func() {
+			for _, nodeName := range []string{"node1", "node2"} {
+				Expect(fakeClient.Create(ctx, &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "kube-apiserver-" + nodeName,
+						Namespace: "kube-system",
+						Labels:    map[string]string{"static-pod": "true"},
+					},
+					Spec: corev1.PodSpec{
+						NodeName:   nodeName,
+						Containers: []corev1.Container{{Name: "kube-apiserver", Image: "registry.k8s.io/kube-apiserver:v1.33.0"}},
+					},
+				})).To(Succeed())
+			}
+
+			Expect(fakeClient.Create(ctx, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "kube-scheduler-node1",
+					Namespace: "kube-system",
+					Labels:    map[string]string{"static-pod": "true"},
+				},
+				Spec: corev1.PodSpec{
+					NodeName:   "node1",
+					Containers: []corev1.Container{{Name: "kube-scheduler", Image: "registry.k8s.io/kube-scheduler:v1.34.1"}},
+				},
+			})).To(Succeed())
+
+			Expect(fakeClient.Create(ctx, &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "gardener-resource-manager", Namespace: "kube-system"},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "gardener-resource-manager", Image: "gardener-resource-manager:old"}},
+					}},
+				},
+			})).To(Succeed())
+
+			componentImages, err := b.ComponentImages(ctx, semver.MustParse("1.34.1"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(componentImages).To(HaveExactElements(
+				Equal(ComponentImage{Name: "kube-apiserver", Current: "registry.k8s.io/kube-apiserver:v1.33.0", Desired: "registry.k8s.io/kube-apiserver:v1.34.1"}),
+				Equal(ComponentImage{Name: "kube-controller-manager", Current: "", Desired: "registry.k8s.io/kube-controller-manager:v1.34.1"}),
+				Equal(ComponentImage{Name: "kube-scheduler", Current: "registry.k8s.io/kube-scheduler:v1.34.1", Desired: "registry.k8s.io/kube-scheduler:v1.34.1"}),
+				And(HaveField("Name", "gardener-resource-manager"), HaveField("Current", "gardener-resource-manager:old"), HaveField("Desired", Not(BeEmpty()))),
+				And(HaveField("Name", "etcd-druid"), HaveField("Current", BeEmpty()), HaveField("Desired", Not(BeEmpty()))),
+			))
+
+			Expect(componentImages[0].Changed()).To(BeTrue())
+			Expect(componentImages[2].Changed()).To(BeFalse())
+		})
+	})
+
+	Describe("#IsEtcdManagedByDruid", func() {
+		It("should return false if the etcd resource does not exist", func() {
+			Expect(b.IsEtcdManagedByDruid(ctx)).To(BeFalse())
+		})
+
+		It("should return true if the etcd resource exists", func() {
+			Expect(fakeClient.Create(ctx, &druidcorev1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: "kube-system"}})).To(Succeed())
+
+			Expect(b.IsEtcdManagedByDruid(ctx)).To(BeTrue())
+		})
+	})
+
+	Describe("#RollOutControlPlaneNodes", func() {
+		var (
+			node1, node2 *corev1.Node
+			pod1, pod2   *corev1.Pod
+		)
+
+		newNode := func(name string) *corev1.Node {
+			return &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+					NodeInfo:   corev1.NodeSystemInfo{KubeletVersion: "v1.34.1"},
+				},
+			}
+		}
+
+		newPod := func(nodeName string) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "kube-apiserver-" + nodeName,
+					Namespace:   "kube-system",
+					Labels:      map[string]string{"static-pod": "true"},
+					Annotations: map[string]string{"gardener.cloud/config.mirror": "hash"},
+				},
+				Spec: corev1.PodSpec{NodeName: nodeName},
+				Status: corev1.PodStatus{
+					Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			DeferCleanup(test.WithVars(
+				&botanistpkg.IntervalWaitOperatingSystemConfigUpdated, 5*time.Millisecond,
+				&botanistpkg.GetTimeoutWaitOperatingSystemConfigUpdated, func(_ *shoot.Shoot) time.Duration { return 10 * time.Millisecond },
+			))
+
+			b.staticPodNameToHash = map[string]string{"kube-apiserver": "hash"}
+
+			node1, node2 = newNode("node1"), newNode("node2")
+			pod1, pod2 = newPod("node1"), newPod("node2")
+		})
+
+		createObjects := func() {
+			GinkgoHelper()
+			for _, obj := range []client.Object{node1, node2, pod1, pod2} {
+				Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+			}
+		}
+
+		It("should return the sorted names of the control plane nodes", func() {
+			createObjects()
+
+			Expect(b.ControlPlaneNodeNames(ctx)).To(Equal([]string{"node1", "node2"}))
+		})
+
+		It("should put all control plane nodes on hold", func() {
+			createObjects()
+
+			Expect(b.HoldControlPlaneNodeUpdates(ctx)).To(Succeed())
+
+			for _, node := range []*corev1.Node{node1, node2} {
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				Expect(node.Annotations).To(HaveKeyWithValue("node-agent.gardener.cloud/hold-operating-system-config-update", "true"))
+			}
+		})
+
+		It("should succeed and release all nodes when all control plane nodes have been upgraded", func() {
+			createObjects()
+			Expect(b.HoldControlPlaneNodeUpdates(ctx)).To(Succeed())
+
+			Expect(b.RollOutControlPlaneNodes(ctx)).To(Succeed())
+
+			for _, node := range []*corev1.Node{node1, node2} {
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				Expect(node.Annotations).NotTo(HaveKey("node-agent.gardener.cloud/hold-operating-system-config-update"))
+			}
+		})
+
+		It("should fail when a node is not ready", func() {
+			node2.Status.Conditions[0].Status = corev1.ConditionFalse
+			createObjects()
+
+			Expect(b.RollOutControlPlaneNodes(ctx)).To(MatchError(And(
+				ContainSubstring(`failed waiting for control plane node "node2" to be upgraded`),
+				ContainSubstring("node is not healthy"),
+			)))
+		})
+
+		It("should keep the remaining nodes on hold when a node does not become healthy", func() {
+			node1.Status.Conditions[0].Status = corev1.ConditionFalse
+			createObjects()
+			Expect(b.HoldControlPlaneNodeUpdates(ctx)).To(Succeed())
+
+			Expect(b.RollOutControlPlaneNodes(ctx)).To(MatchError(ContainSubstring(`failed waiting for control plane node "node1" to be upgraded`)))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node1), node1)).To(Succeed())
+			Expect(node1.Annotations).NotTo(HaveKey("node-agent.gardener.cloud/hold-operating-system-config-update"))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node2), node2)).To(Succeed())
+			Expect(node2.Annotations).To(HaveKeyWithValue("node-agent.gardener.cloud/hold-operating-system-config-update", "true"))
+		})
+
+		It("should fail when the kubelet has not been upgraded", func() {
+			node1.Status.NodeInfo.KubeletVersion = "v1.33.0"
+			createObjects()
+
+			Expect(b.RollOutControlPlaneNodes(ctx)).To(MatchError(And(
+				ContainSubstring(`failed waiting for control plane node "node1" to be upgraded`),
+				ContainSubstring("kubelet has version v1.33.0, expected v1.34.1"),
+			)))
+		})
+
+		It("should fail when a static pod has an outdated hash", func() {
+			pod2.Annotations["gardener.cloud/config.mirror"] = "old-hash"
+			createObjects()
+
+			Expect(b.RollOutControlPlaneNodes(ctx)).To(MatchError(And(
+				ContainSubstring(`failed waiting for control plane node "node2" to be upgraded`),
+				ContainSubstring(`static pod "kube-apiserver" has not been updated yet`),
+			)))
+		})
+
+		It("should fail when a static pod is not ready", func() {
+			pod1.Status.Conditions[0].Status = corev1.ConditionFalse
+			createObjects()
+
+			Expect(b.RollOutControlPlaneNodes(ctx)).To(MatchError(And(
+				ContainSubstring(`failed waiting for control plane node "node1" to be upgraded`),
+				ContainSubstring(`static pod "kube-apiserver" is not ready yet`),
+			)))
+		})
+	})
+})
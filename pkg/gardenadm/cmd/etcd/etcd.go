@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/etcd/restore"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/etcd/snapshot"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "etcd",
+		Short: "Take snapshots of the etcd of a self-hosted shoot cluster and restore the control plane from them",
+		Long: `Take snapshots of the etcd of a self-hosted shoot cluster and restore the control plane from them.
+
+Use 'gardenadm etcd snapshot' on a control plane node to save a snapshot to a local directory (and optionally to the
+BackupBucket of the shoot). If the control plane is lost, copy the snapshot directory to a fresh machine, run
+'gardenadm etcd restore' and afterwards 'gardenadm init' to rebuild the control plane from the snapshot. This does not
+require a garden cluster to be reachable.`,
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	cmd.AddCommand(snapshot.NewCommand(globalOpts))
+	cmd.AddCommand(restore.NewCommand(globalOpts))
+
+	return cmd
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package restore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "restore directory",
+		Short: "Prepare a fresh machine for rebuilding the control plane from a snapshot with the next 'gardenadm init'",
+		Long: `Prepare a fresh machine for rebuilding the control plane from a snapshot with the next 'gardenadm init'.
+
+The given directory must contain the files written by 'gardenadm etcd snapshot'. The command must be executed on a
+machine which has not been initialized yet (or which has been reset with 'gardenadm reset'). It places the etcd
+snapshot and the UID of the shoot on the machine and copies the ShootState into the config directory.
+
+Afterwards, run 'gardenadm init' with the same config directory. The bootstrap etcd then restores its data from the
+snapshot before it is started, and the credentials (e.g., certificate authorities) are restored from the ShootState.
+Only the main etcd is restored, events are not part of the snapshot.
+
+Only snapshot directories written by 'gardenadm etcd snapshot' can be restored. Snapshots in the BackupBucket of the
+shoot (see 'gardenadm etcd snapshot --backup-bucket') are out of scope of this command: downloading them requires the
+provider extension, which only runs after the control plane has been bootstrapped, and they do not contain the
+ShootState. They are only restored by etcd-druid when the data of the running etcd is lost.`,
+
+		Example: `# Prepare the restoration of the control plane and rebuild it
+gardenadm etcd restore /var/backups/gardenadm/latest --config-dir /path/to/manifests
+gardenadm init --config-dir /path/to/manifests`,
+
+		Args: cobra.ExactArgs(1),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(_ context.Context, opts *Options) error {
+	b, err := botanist.NewGardenadmBotanistWithoutResources(opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+
+	if err := b.PrepareEtcdRestore(opts.Directory, opts.ConfigDir); err != nil {
+		return fmt.Errorf("failed preparing restoration of control plane: %w", err)
+	}
+
+	fmt.Fprintf(opts.Out, `
+The machine has been prepared for restoring the control plane from %s successfully!
+
+Please run the following command to rebuild the control plane from the snapshot:
+
+  gardenadm init --config-dir %s
+`, opts.Directory, opts.ConfigDir)
+
+	return nil
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+	cmd.ManifestOptions
+
+	// Directory is the path to the local directory to which the snapshot is written.
+	Directory string
+	// BackupBucket specifies whether a full snapshot should additionally be uploaded to the BackupBucket of the shoot.
+	BackupBucket bool
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(args []string) error {
+	if len(args) > 0 {
+		o.Directory = strings.TrimSpace(args[0])
+	}
+
+	return o.ManifestOptions.ParseArgs(args)
+}
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if len(o.Directory) == 0 {
+		return fmt.Errorf("must provide a path to a directory for the snapshot")
+	}
+
+	if err := o.DefaultConfigDir(); err != nil {
+		return err
+	}
+
+	return o.ManifestOptions.Validate()
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error { return o.ManifestOptions.Complete() }
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	o.ManifestOptions.AddFlags(fs)
+	fs.BoolVar(&o.BackupBucket, "backup-bucket", false, "Additionally upload a full snapshot to the BackupBucket of the shoot via etcd-druid. "+
+		"This requires that the etcd is already managed by etcd-druid and that a backup is configured in the Shoot manifest. "+
+		"The uploaded snapshot cannot be restored with 'gardenadm etcd restore'.")
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/etcd/snapshot"
+)
+
+var _ = Describe("Options", func() {
+	var (
+		options *Options
+	)
+
+	BeforeEach(func() {
+		options = &Options{}
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should do nothing when no argument is set", func() {
+			Expect(options.ParseArgs(nil)).To(Succeed())
+			Expect(options.Directory).To(BeEmpty())
+		})
+
+		It("should trim spaces when the argument is set", func() {
+			Expect(options.ParseArgs([]string{" /path/to/backup "})).To(Succeed())
+			Expect(options.Directory).To(Equal("/path/to/backup"))
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should succeed when proper values were provided", func() {
+			options.Directory = "/path/to/backup"
+			options.ConfigDir = "path/to/config/dir"
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail when no directory was provided", func() {
+			options.ConfigDir = "path/to/config/dir"
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a directory for the snapshot")))
+		})
+
+		It("should fail when it cannot read the default config dir location file", func() {
+			options.Directory = "/path/to/backup"
+			Expect(options.Validate()).To(MatchError(ContainSubstring("error reading config dir location file")))
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should return nil", func() {
+			Expect(options.Complete()).To(Succeed())
+		})
+	})
+})
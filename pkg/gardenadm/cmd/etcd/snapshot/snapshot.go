@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "snapshot directory",
+		Short: "Save a snapshot of the etcd and the state of the control plane to a local directory",
+		Long: `Save a snapshot of the etcd and the state of the control plane to a local directory.
+
+The command must be executed on a control plane node. It writes the following files to the given directory:
+- ` + botanist.EtcdSnapshotFileName + `: a snapshot of the main etcd
+- ` + botanist.ShootStateFileName + `: the ShootState containing the credentials (e.g., certificate authorities) and the state of extensions
+- ` + botanist.ShootUIDFileName + `: the UID of the shoot, which is used for naming the BackupEntry
+
+The directory contains sensitive data and should be stored securely (and off-site). It can be used with
+'gardenadm etcd restore' to rebuild the control plane on a fresh machine.
+
+With --backup-bucket, etcd-druid is additionally instructed to take a full snapshot and to upload it to the BackupBucket
+of the shoot using the configured provider extension. This snapshot is only restored by etcd-druid when the data of the
+running etcd is lost. It cannot be restored with 'gardenadm etcd restore', hence, the local directory is still needed
+for rebuilding the control plane on a fresh machine.`,
+
+		Example: `# Save a snapshot to a local directory
+gardenadm etcd snapshot /var/backups/gardenadm/$(date +%Y%m%d%H%M%S)
+
+# Save a snapshot to a local directory and upload a full snapshot to the BackupBucket
+gardenadm etcd snapshot /var/backups/gardenadm/latest --backup-bucket`,
+
+		Args: cobra.ExactArgs(1),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	bootstrapBotanist, err := botanist.NewGardenadmBotanistWithoutResources(opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+
+	clientSet, err := bootstrapBotanist.CreateClientSet(ctx)
+	if err != nil {
+		return fmt.Errorf("failed creating client set: %w", err)
+	}
+
+	b, err := botanist.NewGardenadmBotanistFromManifests(ctx, opts.Log, clientSet, opts.ConfigDir, true)
+	if err != nil {
+		return err
+	}
+
+	var (
+		g = flow.NewGraph("snapshot")
+
+		saveEtcdSnapshot = g.Add(flow.Task{
+			Name: "Saving snapshot of main ETCD",
+			Fn: func(ctx context.Context) error {
+				return b.SaveEtcdSnapshot(ctx, opts.Directory)
+			},
+		})
+		_ = g.Add(flow.Task{
+			Name: "Saving ShootState",
+			Fn: func(ctx context.Context) error {
+				return b.SaveShootState(ctx, opts.Directory)
+			},
+			Dependencies: flow.NewTaskIDs(saveEtcdSnapshot),
+		})
+		_ = g.Add(flow.Task{
+			Name:   "Uploading full snapshot of main ETCD to BackupBucket",
+			Fn:     b.TriggerEtcdBackupBucketSnapshot,
+			SkipIf: !opts.BackupBucket,
+		})
+	)
+
+	if err := g.Compile().Run(ctx, flow.Opts{
+		Log: opts.Log,
+	}); err != nil {
+		return flow.Errors(err)
+	}
+
+	fmt.Fprintf(opts.Out, `
+The snapshot has been saved to %s successfully!
+
+The directory contains sensitive data, please store it securely. To restore the control plane on a fresh machine, copy
+the directory to the machine and run:
+
+  gardenadm etcd restore %s --config-dir <path-to-manifests>
+  gardenadm init --config-dir <path-to-manifests>
+`, opts.Directory, opts.Directory)
+
+	return nil
+}
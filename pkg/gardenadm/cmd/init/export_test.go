@@ -0,0 +1,12 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package init
+
+// Functions exported for testing.
+
+var (
+	Phases                 = phases
+	ValidateSelectedPhases = validateSelectedPhases
+)
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -26,31 +27,49 @@ import (
 
 // NewCommand creates a new cobra.Command.
 func NewCommand(globalOpts *cmd.Options) *cobra.Command {
-	opts := &Options{Options: globalOpts}
+	opts := &Options{Options: globalOpts, PhaseOptions: cmd.PhaseOptions{Phases: phases}}
+
+	phaseCmd := cmd.NewPhaseCommand("init", phases, func(phase cmd.Phase) *cobra.Command {
+		return newPhaseCommand(globalOpts, phase)
+	})
 
 	cmd := &cobra.Command{
 		Use:   "init",
 		Short: "Bootstrap the first control plane node",
-		Long:  "Bootstrap the first control plane node",
+		Long: `Bootstrap the first control plane node.
+
+The command runs the following phases in this order:
+
+` + phases.String() + `
+Individual phases can be skipped with --skip-phases or run on their own with 'gardenadm init phase <phase>'.`,
 
 		Example: `# Bootstrap the first control plane node
-gardenadm init --config-dir /path/to/manifests`,
+gardenadm init --config-dir /path/to/manifests
 
-		RunE: func(cmd *cobra.Command, args []string) error {
-			if err := opts.ParseArgs(args); err != nil {
-				return err
-			}
+# Bootstrap the first control plane node without deploying the shoot worker pools
+gardenadm init --config-dir /path/to/manifests --skip-phases workers`,
 
-			if err := opts.Validate(); err != nil {
-				return err
-			}
+		RunE: runE(opts),
+	}
 
-			if err := opts.Complete(); err != nil {
-				return err
-			}
+	opts.addFlags(cmd.Flags())
+	cmd.AddCommand(phaseCmd)
+
+	return cmd
+}
 
-			return run(cmd.Context(), opts)
-		},
+func newPhaseCommand(globalOpts *cmd.Options, phase cmd.Phase) *cobra.Command {
+	opts := &Options{Options: globalOpts, PhaseOptions: cmd.PhaseOptions{Phases: phases, OnlyPhase: phase.Name}}
+
+	cmd := &cobra.Command{
+		Use:   phase.Name,
+		Short: phase.Description,
+		Long:  phase.Description + ".",
+
+		Example: fmt.Sprintf(`# Run the %[1]s phase of 'gardenadm init'
+gardenadm init phase %[1]s --config-dir /path/to/manifests`, phase.Name),
+
+		RunE: runE(opts),
 	}
 
 	opts.addFlags(cmd.Flags())
@@ -58,12 +77,35 @@ gardenadm init --config-dir /path/to/manifests`,
 	return cmd
 }
 
+func runE(opts *Options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if err := opts.ParseArgs(args); err != nil {
+			return err
+		}
+
+		if err := opts.Validate(); err != nil {
+			return err
+		}
+
+		if err := opts.Complete(); err != nil {
+			return err
+		}
+
+		return run(cmd.Context(), opts)
+	}
+}
+
 func run(ctx context.Context, opts *Options) error {
 	b, err := bootstrapControlPlane(ctx, opts)
 	if err != nil {
 		return fmt.Errorf("failed bootstrapping control plane: %w", err)
 	}
 
+	if b == nil {
+		printPhasesCompleted(opts)
+		return nil
+	}
+
 	dir := filepath.Dir(cmd.ConfigDirLocation)
 	if err := b.FS.MkdirAll(dir, os.ModeDir); err != nil {
 		return fmt.Errorf("failed creating config directory location dir %s: %w", dir, err)
@@ -83,28 +125,32 @@ func run(ctx context.Context, opts *Options) error {
 		kubeProxyEnabled = v1beta1helper.KubeProxyEnabled(b.Shoot.GetInfo().Spec.Kubernetes.KubeProxy)
 
 		deployControlPlaneNamespace = g.Add(flow.Task{
-			Name: "Deploying control plane namespace",
-			Fn:   b.DeployControlPlaneNamespace,
+			Name:   "Deploying control plane namespace",
+			Fn:     b.DeployControlPlaneNamespace,
+			SkipIf: opts.SkipPhase(phaseClusterResources),
 		})
 		deployGardenNamespace = g.Add(flow.Task{
 			Name: "Deploying garden namespace",
 			Fn: func(ctx context.Context) error {
 				return gardenerutils.ReconcileGardenNamespace(ctx, b.SeedClientSet.Client(), v1beta1constants.GardenNamespace, b.Seed.GetInfo().Spec.Provider.Zones, true, nil)
 			},
+			SkipIf: opts.SkipPhase(phaseClusterResources),
 		})
 		deployCloudProviderSecret = g.Add(flow.Task{
 			Name:         "Deploying cloud provider account secret",
 			Fn:           b.DeployCloudProviderSecret,
-			SkipIf:       b.Shoot.Credentials == nil,
+			SkipIf:       opts.SkipPhase(phaseClusterResources) || b.Shoot.Credentials == nil,
 			Dependencies: flow.NewTaskIDs(deployControlPlaneNamespace),
 		})
 		reconcileCustomResourceDefinitions = g.Add(flow.Task{
-			Name: "Reconciling CustomResourceDefinitions",
-			Fn:   b.ReconcileCustomResourceDefinitions,
+			Name:   "Reconciling CustomResourceDefinitions",
+			Fn:     b.ReconcileCustomResourceDefinitions,
+			SkipIf: opts.SkipPhase(phaseClusterResources),
 		})
 		ensureCustomResourceDefinitionsReady = g.Add(flow.Task{
 			Name:         "Ensuring CustomResourceDefinitions are ready",
 			Fn:           flow.TaskFn(b.EnsureCustomResourceDefinitionsReady).RetryUntilTimeout(time.Second, time.Minute),
+			SkipIf:       opts.SkipPhase(phaseClusterResources),
 			Dependencies: flow.NewTaskIDs(reconcileCustomResourceDefinitions),
 		})
 		reconcileClusterResource = g.Add(flow.Task{
@@ -112,6 +158,7 @@ func run(ctx context.Context, opts *Options) error {
 			Fn: func(ctx context.Context) error {
 				return gardenerextensions.SyncClusterResourceToSeed(ctx, b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, b.Shoot.GetInfo(), b.Shoot.CloudProfile, b.Seed.GetInfo())
 			},
+			SkipIf:       opts.SkipPhase(phaseClusterResources),
 			Dependencies: flow.NewTaskIDs(ensureCustomResourceDefinitionsReady),
 		})
 		initializeSecretsManagement = g.Add(flow.Task{
@@ -122,11 +169,13 @@ func run(ctx context.Context, opts *Options) error {
 		activateGardenerNodeAgent = g.Add(flow.Task{
 			Name:         "Activating gardener-node-agent",
 			Fn:           b.ActivateGardenerNodeAgent,
+			SkipIf:       opts.SkipPhase(phaseNodeAgent),
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement),
 		})
 		approveGardenerNodeAgentCSR = g.Add(flow.Task{
 			Name:         "Approving gardener-node-agent client certificate signing request",
 			Fn:           flow.TaskFn(b.ApproveNodeAgentCertificateSigningRequest).RetryUntilTimeout(2*time.Second, time.Minute),
+			SkipIf:       opts.SkipPhase(phaseNodeAgent),
 			Dependencies: flow.NewTaskIDs(activateGardenerNodeAgent),
 		})
 		deployGardenerResourceManager = g.Add(flow.Task{
@@ -140,6 +189,7 @@ func run(ctx context.Context, opts *Options) error {
 					b.Shoot.Components.ControlPlane.ResourceManager.Deploy,
 				)(ctx)
 			},
+			SkipIf:       opts.SkipPhase(phaseResourceManager),
 			Dependencies: flow.NewTaskIDs(approveGardenerNodeAgentCSR, deployGardenNamespace),
 		})
 		waitUntilGardenerResourceManagerReady = g.Add(flow.Task{
@@ -148,6 +198,7 @@ func run(ctx context.Context, opts *Options) error {
 				b.Components.RuntimeResourceManager.Wait,
 				b.Shoot.Components.ControlPlane.ResourceManager.Wait,
 			),
+			SkipIf:       opts.SkipPhase(phaseResourceManager),
 			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager),
 		})
 		_ = g.Add(flow.Task{
@@ -155,11 +206,13 @@ func run(ctx context.Context, opts *Options) error {
 			Fn: func(ctx context.Context) error {
 				return seedsystem.New(b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, seedsystem.Values{}).Deploy(ctx)
 			},
+			SkipIf:       opts.SkipPhase(phaseResourceManager),
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Deploying shoot system resources",
 			Fn:           b.DeployShootSystem,
+			SkipIf:       opts.SkipPhase(phaseResourceManager),
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady),
 		})
 		deployExtensionControllers = g.Add(flow.Task{
@@ -167,64 +220,73 @@ func run(ctx context.Context, opts *Options) error {
 			Fn: func(ctx context.Context) error {
 				return b.ReconcileExtensionControllerInstallations(ctx, !podNetworkAvailable)
 			},
+			SkipIf:       opts.SkipPhase(phaseExtensions),
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady),
 		})
 		waitUntilExtensionControllersReady = g.Add(flow.Task{
 			Name:         "Waiting until extension controllers report readiness",
 			Fn:           b.WaitUntilExtensionControllerInstallationsHealthy,
+			SkipIf:       opts.SkipPhase(phaseExtensions),
 			Dependencies: flow.NewTaskIDs(deployExtensionControllers),
 		})
 		deployNetworkPolicies = g.Add(flow.Task{
 			Name:         "Deploying network policies",
 			Fn:           b.ApplyNetworkPolicies,
+			SkipIf:       opts.SkipPhase(phaseExtensions),
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, deployExtensionControllers),
 		})
 		deployInfrastructure = g.Add(flow.Task{
 			Name:         "Deploying Shoot infrastructure",
 			Fn:           b.DeployInfrastructure,
-			SkipIf:       !b.Shoot.HasManagedInfrastructure(),
+			SkipIf:       opts.SkipPhase(phaseExtensions) || !b.Shoot.HasManagedInfrastructure(),
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, waitUntilExtensionControllersReady),
 		})
 		waitUntilInfrastructureReady = g.Add(flow.Task{
 			Name:         "Waiting until Shoot infrastructure has been reconciled",
 			Fn:           b.WaitForInfrastructure,
-			SkipIf:       !b.Shoot.HasManagedInfrastructure(),
+			SkipIf:       opts.SkipPhase(phaseExtensions) || !b.Shoot.HasManagedInfrastructure(),
 			Dependencies: flow.NewTaskIDs(deployInfrastructure),
 		})
 		deployShootNamespaces = g.Add(flow.Task{
 			Name:         "Deploying shoot namespaces system component",
 			Fn:           b.Shoot.Components.SystemComponents.Namespaces.Deploy,
+			SkipIf:       opts.SkipPhase(phaseAddons),
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady),
 		})
 		waitUntilShootNamespacesReady = g.Add(flow.Task{
 			Name:         "Waiting until shoot namespaces have been reconciled",
 			Fn:           b.Shoot.Components.SystemComponents.Namespaces.Wait,
+			SkipIf:       opts.SkipPhase(phaseAddons),
 			Dependencies: flow.NewTaskIDs(deployShootNamespaces),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Deploying kube-proxy system component",
 			Fn:           b.DeployKubeProxy,
-			SkipIf:       !kubeProxyEnabled,
+			SkipIf:       opts.SkipPhase(phaseAddons) || !kubeProxyEnabled,
 			Dependencies: flow.NewTaskIDs(waitUntilShootNamespacesReady, waitUntilInfrastructureReady),
 		})
 		deployNetwork = g.Add(flow.Task{
 			Name:         "Deploying shoot network plugin",
 			Fn:           b.DeployNetwork,
+			SkipIf:       opts.SkipPhase(phaseAddons),
 			Dependencies: flow.NewTaskIDs(waitUntilShootNamespacesReady, waitUntilInfrastructureReady),
 		})
 		waitUntilNetworkReady = g.Add(flow.Task{
 			Name:         "Waiting until shoot network plugin has been reconciled",
 			Fn:           b.Shoot.Components.Extensions.Network.Wait,
+			SkipIf:       opts.SkipPhase(phaseAddons),
 			Dependencies: flow.NewTaskIDs(deployNetwork),
 		})
 		deployCoreDNS = g.Add(flow.Task{
 			Name:         "Deploying CoreDNS system component",
 			Fn:           b.DeployCoreDNS,
+			SkipIf:       opts.SkipPhase(phaseAddons),
 			Dependencies: flow.NewTaskIDs(waitUntilNetworkReady, deployNetworkPolicies),
 		})
 		waitUntilCoreDNSReady = g.Add(flow.Task{
 			Name:         "Waiting until CoreDNS system component is ready",
 			Fn:           b.Shoot.Components.SystemComponents.CoreDNS.Wait,
+			SkipIf:       opts.SkipPhase(phaseAddons),
 			Dependencies: flow.NewTaskIDs(deployCoreDNS),
 		})
 
@@ -239,7 +301,7 @@ func run(ctx context.Context, opts *Options) error {
 					b.Shoot.Components.ControlPlane.ResourceManager.Deploy,
 				)(ctx)
 			},
-			SkipIf:       podNetworkAvailable,
+			SkipIf:       opts.SkipPhase(phaseAddons) || podNetworkAvailable,
 			Dependencies: flow.NewTaskIDs(waitUntilCoreDNSReady),
 		})
 		waitUntilGardenerResourceManagerInPodNetworkReady = g.Add(flow.Task{
@@ -248,7 +310,7 @@ func run(ctx context.Context, opts *Options) error {
 				b.Components.RuntimeResourceManager.Wait,
 				b.Shoot.Components.ControlPlane.ResourceManager.Wait,
 			),
-			SkipIf:       podNetworkAvailable,
+			SkipIf:       opts.SkipPhase(phaseAddons) || podNetworkAvailable,
 			Dependencies: flow.NewTaskIDs(deployGardenerResourceManagerIntoPodNetwork),
 		})
 		deployExtensionControllersIntoPodNetwork = g.Add(flow.Task{
@@ -256,13 +318,13 @@ func run(ctx context.Context, opts *Options) error {
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return b.ReconcileExtensionControllerInstallations(ctx, false)
 			}).RetryUntilTimeout(5*time.Second, 30*time.Second),
-			SkipIf:       podNetworkAvailable,
+			SkipIf:       opts.SkipPhase(phaseAddons) || podNetworkAvailable,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerInPodNetworkReady),
 		})
 		waitUntilExtensionControllersInPodNetworkReady = g.Add(flow.Task{
 			Name:         "Waiting until extension controllers (in pod network) report readiness",
 			Fn:           b.WaitUntilExtensionControllerInstallationsHealthy,
-			SkipIf:       podNetworkAvailable,
+			SkipIf:       opts.SkipPhase(phaseAddons) || podNetworkAvailable,
 			Dependencies: flow.NewTaskIDs(deployExtensionControllersIntoPodNetwork),
 		})
 		syncPointBootstrapped = flow.NewTaskIDs(
@@ -278,62 +340,73 @@ func run(ctx context.Context, opts *Options) error {
 		_ = g.Add(flow.Task{
 			Name:         "Restoring external DNSRecord",
 			Fn:           b.RestoreExternalDNSRecord,
-			SkipIf:       !b.Shoot.HasManagedInfrastructure(),
+			SkipIf:       opts.SkipPhase(phaseControlPlane) || !b.Shoot.HasManagedInfrastructure(),
 			Dependencies: flow.NewTaskIDs(syncPointBootstrapped),
 		})
 		reconcileBackupBucket = g.Add(flow.Task{
 			Name:         "Deploying BackupBucket for ETCD data",
 			Fn:           b.ReconcileBackupBucket,
-			SkipIf:       !allowBackup || opts.UseBootstrapEtcd,
+			SkipIf:       opts.SkipPhase(phaseEtcd) || !allowBackup || opts.UseBootstrapEtcd,
 			Dependencies: flow.NewTaskIDs(syncPointBootstrapped),
 		})
 		reconcileBackupEntry = g.Add(flow.Task{
 			Name:         "Deploying BackupEntry for ETCD data",
 			Fn:           b.ReconcileBackupEntry,
-			SkipIf:       !allowBackup || opts.UseBootstrapEtcd,
+			SkipIf:       opts.SkipPhase(phaseEtcd) || !allowBackup || opts.UseBootstrapEtcd,
 			Dependencies: flow.NewTaskIDs(reconcileBackupBucket),
 		})
 		deployControlPlane = g.Add(flow.Task{
 			Name:         "Deploying shoot control plane components",
 			Fn:           b.DeployControlPlane,
+			SkipIf:       opts.SkipPhase(phaseControlPlane),
 			Dependencies: flow.NewTaskIDs(syncPointBootstrapped),
 		})
 		waitUntilControlPlaneReady = g.Add(flow.Task{
 			Name:         "Waiting until shoot control plane has been reconciled",
 			Fn:           b.Shoot.Components.Extensions.ControlPlane.Wait,
+			SkipIf:       opts.SkipPhase(phaseControlPlane),
 			Dependencies: flow.NewTaskIDs(deployControlPlane),
 		})
 		deployEtcdDruid = g.Add(flow.Task{
 			Name:         "Deploying ETCD Druid",
 			Fn:           b.DeployEtcdDruid,
+			SkipIf:       opts.SkipPhase(phaseEtcd),
 			Dependencies: flow.NewTaskIDs(syncPointBootstrapped),
 		})
 		deployEtcds = g.Add(flow.Task{
 			Name:         "Deploying main and events ETCDs",
 			Fn:           b.DeployEtcd,
-			SkipIf:       opts.UseBootstrapEtcd,
+			SkipIf:       opts.SkipPhase(phaseEtcd) || opts.UseBootstrapEtcd,
 			Dependencies: flow.NewTaskIDs(deployEtcdDruid, reconcileBackupEntry),
 		})
 		waitUntilEtcdsReady = g.Add(flow.Task{
 			Name:         "Waiting until main and event ETCDs have been reconciled",
 			Fn:           b.WaitUntilEtcdsReconciled,
-			SkipIf:       opts.UseBootstrapEtcd,
+			SkipIf:       opts.SkipPhase(phaseEtcd) || opts.UseBootstrapEtcd,
 			Dependencies: flow.NewTaskIDs(deployEtcds),
 		})
 		deployControlPlaneDeployments = g.Add(flow.Task{
 			Name:         "Deploying control plane components as Deployments/StatefulSets and updating gardener-node-agent Secret",
 			Fn:           b.DeployControlPlaneDeployments,
+			SkipIf:       opts.SkipPhase(phaseControlPlane),
 			Dependencies: flow.NewTaskIDs(waitUntilControlPlaneReady, waitUntilEtcdsReady),
 		})
 		waitUntilControlPlaneDeploymentsReady = g.Add(flow.Task{
 			Name:         "Waiting until control plane components (static pods) are ready",
 			Fn:           b.WaitUntilControlPlaneDeploymentsReady,
+			SkipIf:       opts.SkipPhase(phaseControlPlane),
 			Dependencies: flow.NewTaskIDs(deployControlPlaneDeployments),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Finalizing ETCD bootstrap transition (cleanup bootstrap ETCD left-overs)",
 			Fn:           b.FinalizeEtcdBootstrapTransition,
-			SkipIf:       opts.UseBootstrapEtcd,
+			SkipIf:       opts.SkipPhase(phaseControlPlane) || opts.UseBootstrapEtcd,
+			Dependencies: flow.NewTaskIDs(waitUntilControlPlaneDeploymentsReady),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Cleaning up ETCD snapshot used for restoring the control plane",
+			Fn:           b.CleanupEtcdRestoreDirectory,
+			SkipIf:       opts.SkipPhase(phaseControlPlane),
 			Dependencies: flow.NewTaskIDs(waitUntilControlPlaneDeploymentsReady),
 		})
 		// During the migration from the bootstrap etcds to the druid-managed etcds, components serving webhooks might be
@@ -345,24 +418,25 @@ func run(ctx context.Context, opts *Options) error {
 				b.Shoot.Components.ControlPlane.ResourceManager.Wait,
 				b.WaitUntilExtensionControllerInstallationsHealthy,
 			),
+			SkipIf:       opts.SkipPhase(phaseControlPlane),
 			Dependencies: flow.NewTaskIDs(waitUntilControlPlaneDeploymentsReady),
 		})
 		deployMachineControllerManager = g.Add(flow.Task{
 			Name:         "Deploying machine-controller-manager",
 			Fn:           flow.TaskFn(b.DeployMachineControllerManager).RetryUntilTimeout(time.Second, time.Minute),
-			SkipIf:       !b.Shoot.HasManagedInfrastructure(),
+			SkipIf:       opts.SkipPhase(phaseWorkers) || !b.Shoot.HasManagedInfrastructure(),
 			Dependencies: flow.NewTaskIDs(waitUntilWebhookComponentsReady),
 		})
 		deployWorker = g.Add(flow.Task{
 			Name:         "Deploying shoot worker pools",
 			Fn:           b.DeployWorker,
-			SkipIf:       !b.Shoot.HasManagedInfrastructure(),
+			SkipIf:       opts.SkipPhase(phaseWorkers) || !b.Shoot.HasManagedInfrastructure(),
 			Dependencies: flow.NewTaskIDs(deployMachineControllerManager),
 		})
 		waitUntilWorkerReady = g.Add(flow.Task{
 			Name:         "Waiting until shoot worker nodes have been reconciled",
 			Fn:           b.Shoot.Components.Extensions.Worker.Wait,
-			SkipIf:       !b.Shoot.HasManagedInfrastructure(),
+			SkipIf:       opts.SkipPhase(phaseWorkers) || !b.Shoot.HasManagedInfrastructure(),
 			Dependencies: flow.NewTaskIDs(deployWorker),
 		})
 		// We need to deploy the worker before activating the node-agent-authorizer. Without the machine objects,
@@ -371,11 +445,13 @@ func run(ctx context.Context, opts *Options) error {
 		finalizeGardenerNodeAgentBootstrapping = g.Add(flow.Task{
 			Name:         "Finalizing gardener-node-agent bootstrapping (remove cluster-admin access, activate node-agent authorizer)",
 			Fn:           b.FinalizeGardenerNodeAgentBootstrapping,
+			SkipIf:       opts.SkipPhase(phaseFinalize),
 			Dependencies: flow.NewTaskIDs(waitUntilWorkerReady),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Waiting until gardener-node-agent lease is renewed",
 			Fn:           b.WaitUntilGardenerNodeAgentLeaseIsRenewed,
+			SkipIf:       opts.SkipPhase(phaseFinalize),
 			Dependencies: flow.NewTaskIDs(finalizeGardenerNodeAgentBootstrapping),
 		})
 	)
@@ -386,6 +462,11 @@ func run(ctx context.Context, opts *Options) error {
 		return flow.Errors(err)
 	}
 
+	if !opts.RunsAllPhases() {
+		printPhasesCompleted(opts)
+		return nil
+	}
+
 	fmt.Fprintf(opts.Out, `
 Your Shoot cluster control-plane has initialized successfully!
 
@@ -423,6 +504,8 @@ see https://gardener.cloud/docs/gardener/shoot/shoot_access/.
 	return nil
 }
 
+// bootstrapControlPlane bootstraps the control plane on this machine and returns a botanist connected to it. It returns
+// nil if all phases of the main flow are skipped, i.e., if no connection to the control plane is needed.
 func bootstrapControlPlane(ctx context.Context, opts *Options) (*botanist.GardenadmBotanist, error) {
 	b, err := botanist.NewGardenadmBotanistFromManifests(ctx, opts.Log, nil, opts.ConfigDir, true)
 	if err != nil {
@@ -434,35 +517,46 @@ func bootstrapControlPlane(ctx context.Context, opts *Options) (*botanist.Garden
 		return nil, fmt.Errorf("failed checking whether kubeconfig file %s exists: %w", botanist.PathKubeconfig, err)
 	}
 
+	if err := validateSelectedPhases(opts, kubeconfigFileExists); err != nil {
+		return nil, err
+	}
+
 	if kubeconfigFileExists {
 		b.Logger.Info("Found existing kubeconfig file, skipping initialization of control plane", "path", botanist.PathKubeconfig)
+	} else if !opts.SkipPhase(phasePreflight) {
+		if err := b.RunPreflightChecks(ctx, opts.IgnoredPreflightChecks(), true); err != nil {
+			return nil, err
+		}
 	}
 
 	var (
-		clientSet kubernetes.Interface
-		g         = flow.NewGraph("bootstrap")
+		clientSet                 kubernetes.Interface
+		skipBootstrapControlPlane = kubeconfigFileExists || opts.SkipPhase(phaseBootstrapControlPlane)
+		skipMainFlow              = opts.SkipAllPhases(mainFlowPhases...)
+
+		g = flow.NewGraph("bootstrap")
 
 		initializeSecretsManagement = g.Add(flow.Task{
 			Name:   "Initializing secrets management",
 			Fn:     b.InitializeSecretsManagement,
-			SkipIf: kubeconfigFileExists,
+			SkipIf: skipBootstrapControlPlane,
 		})
 		writeKubeletBootstrapKubeconfig = g.Add(flow.Task{
 			Name:         "Writing kubelet bootstrap kubeconfig with a fake token to disk to make kubelet start",
 			Fn:           b.WriteKubeletBootstrapKubeconfig,
-			SkipIf:       kubeconfigFileExists,
+			SkipIf:       skipBootstrapControlPlane,
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement),
 		})
 		deployOperatingSystemConfigSecretForNodeAgent = g.Add(flow.Task{
 			Name:         "Generating OperatingSystemConfig and deploying Secret for gardener-node-agent",
 			Fn:           b.DeployOperatingSystemConfigSecretForBootstrap,
-			SkipIf:       kubeconfigFileExists,
+			SkipIf:       skipBootstrapControlPlane,
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement),
 		})
 		applyOperatingSystemConfig = g.Add(flow.Task{
 			Name:         "Applying OperatingSystemConfig using gardener-node-agent's reconciliation logic",
 			Fn:           b.ApplyOperatingSystemConfig,
-			SkipIf:       kubeconfigFileExists,
+			SkipIf:       skipBootstrapControlPlane,
 			Dependencies: flow.NewTaskIDs(writeKubeletBootstrapKubeconfig, deployOperatingSystemConfigSecretForNodeAgent),
 		})
 		initializeClientSet = g.Add(flow.Task{
@@ -471,6 +565,7 @@ func bootstrapControlPlane(ctx context.Context, opts *Options) (*botanist.Garden
 				clientSet, err = b.CreateClientSet(ctx)
 				return err
 			}).RetryUntilTimeout(2*time.Second, 2*time.Minute),
+			SkipIf:       skipBootstrapControlPlane && skipMainFlow,
 			Dependencies: flow.NewTaskIDs(applyOperatingSystemConfig),
 		})
 		_ = g.Add(flow.Task{
@@ -478,7 +573,7 @@ func bootstrapControlPlane(ctx context.Context, opts *Options) (*botanist.Garden
 			Fn: func(ctx context.Context) error {
 				return b.MigrateSecrets(ctx, b.SeedClientSet.Client(), clientSet.Client())
 			},
-			SkipIf:       kubeconfigFileExists,
+			SkipIf:       skipBootstrapControlPlane,
 			Dependencies: flow.NewTaskIDs(initializeClientSet),
 		})
 	)
@@ -489,5 +584,36 @@ func bootstrapControlPlane(ctx context.Context, opts *Options) (*botanist.Garden
 		return nil, flow.Errors(err)
 	}
 
+	if skipMainFlow {
+		return nil, nil
+	}
+
 	return botanist.NewGardenadmBotanistFromManifests(ctx, opts.Log, clientSet, opts.ConfigDir, true)
 }
+
+// validateSelectedPhases fails if the selected phases cannot be run in the current state of the machine, which is
+// indicated by the existence of the admin kubeconfig file.
+func validateSelectedPhases(opts *Options, kubeconfigFileExists bool) error {
+	if kubeconfigFileExists {
+		// The preflight checks and the bootstrapping of the control plane are skipped on machines which are already
+		// initialized. If only these phases were selected, nothing would be done, hence fail instead of pretending
+		// success.
+		if opts.SkipAllPhases(mainFlowPhases...) {
+			return fmt.Errorf("the control plane on this machine is already initialized (found existing kubeconfig file %s), phases %s cannot be run again", botanist.PathKubeconfig, strings.Join(opts.SelectedPhases(), ", "))
+		}
+		return nil
+	}
+
+	// The phases of the main flow connect to the control plane using the kubeconfig file which is written by the
+	// bootstrapping of the control plane. Fail right away instead of waiting for the connection to time out.
+	if opts.SkipPhase(phaseBootstrapControlPlane) && !opts.SkipAllPhases(mainFlowPhases...) {
+		return fmt.Errorf("the control plane on this machine is not initialized yet (kubeconfig file %s does not exist), run the %s phase before phases %s", botanist.PathKubeconfig, phaseBootstrapControlPlane, strings.Join(opts.SelectedPhases(), ", "))
+	}
+	return nil
+}
+
+func printPhasesCompleted(opts *Options) {
+	fmt.Fprintf(opts.Out, `
+The following phases of 'gardenadm init' have been completed successfully: %s
+`, strings.Join(opts.SelectedPhases(), ", "))
+}
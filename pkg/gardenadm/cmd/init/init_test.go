@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package init_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/init"
+	clitest "github.com/gardener/gardener/pkg/utils/test/cli"
+)
+
+var _ = Describe("Init", func() {
+	var (
+		globalOpts *cmd.Options
+		command    *cobra.Command
+	)
+
+	BeforeEach(func() {
+		globalOpts = &cmd.Options{}
+		globalOpts.IOStreams, _, _, _ = clitest.NewTestIOStreams()
+		command = NewCommand(globalOpts)
+	})
+
+	Describe("#NewCommand", func() {
+		It("should list the phases and allow skipping them", func() {
+			Expect(command.Long).To(ContainSubstring("bootstrap-control-plane"))
+			Expect(command.Flags().Lookup("skip-phases")).NotTo(BeNil())
+		})
+
+		It("should have a sub-command for each phase", func() {
+			phaseCommand, _, err := command.Find([]string{"phase", "etcd"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(phaseCommand.Name()).To(Equal("etcd"))
+			Expect(phaseCommand.RunE).NotTo(BeNil())
+			Expect(phaseCommand.Flags().Lookup("config-dir")).NotTo(BeNil())
+			Expect(phaseCommand.Flags().Lookup("skip-phases")).To(BeNil())
+
+			var names []string
+			for _, subCommand := range phaseCommand.Parent().Commands() {
+				names = append(names, subCommand.Name())
+			}
+			Expect(names).To(ConsistOf(
+				"preflight",
+				"bootstrap-control-plane",
+				"cluster-resources",
+				"node-agent",
+				"resource-manager",
+				"extensions",
+				"addons",
+				"etcd",
+				"control-plane",
+				"workers",
+				"finalize",
+			))
+		})
+	})
+
+	Describe("#ValidateSelectedPhases", func() {
+		var opts *Options
+
+		BeforeEach(func() {
+			opts = &Options{Options: globalOpts, PhaseOptions: cmd.PhaseOptions{Phases: Phases}}
+		})
+
+		Context("machine is already initialized", func() {
+			It("should succeed if all phases are run", func() {
+				Expect(ValidateSelectedPhases(opts, true)).To(Succeed())
+			})
+
+			It("should succeed if a phase of the main flow is selected", func() {
+				opts.OnlyPhase = "addons"
+				Expect(ValidateSelectedPhases(opts, true)).To(Succeed())
+			})
+
+			It("should fail if only the bootstrap phases are selected", func() {
+				opts.SkipPhases = []string{"cluster-resources", "node-agent", "resource-manager", "extensions", "addons", "etcd", "control-plane", "workers", "finalize"}
+				Expect(ValidateSelectedPhases(opts, true)).To(MatchError(ContainSubstring("already initialized")))
+			})
+		})
+
+		Context("machine is not initialized", func() {
+			It("should succeed if all phases are run", func() {
+				Expect(ValidateSelectedPhases(opts, false)).To(Succeed())
+			})
+
+			It("should succeed if only the bootstrap phases are selected", func() {
+				opts.OnlyPhase = "bootstrap-control-plane"
+				Expect(ValidateSelectedPhases(opts, false)).To(Succeed())
+			})
+
+			It("should fail if a phase of the main flow is selected", func() {
+				opts.OnlyPhase = "addons"
+				Expect(ValidateSelectedPhases(opts, false)).To(MatchError(ContainSubstring("not initialized yet")))
+			})
+
+			It("should fail if the bootstrap phase is skipped", func() {
+				opts.SkipPhases = []string{"bootstrap-control-plane"}
+				Expect(ValidateSelectedPhases(opts, false)).To(MatchError(ContainSubstring("run the bootstrap-control-plane phase before")))
+			})
+		})
+	})
+})
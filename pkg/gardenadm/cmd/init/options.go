@@ -14,6 +14,8 @@ import (
 type Options struct {
 	*cmd.Options
 	cmd.ManifestOptions
+	cmd.PreflightOptions
+	cmd.PhaseOptions
 
 	// UseBootstrapEtcd indicates whether to use the bootstrap etcd instead of transitioning to etcd-druid.
 	UseBootstrapEtcd bool
@@ -21,20 +23,46 @@ type Options struct {
 
 // ParseArgs parses the arguments to the options.
 func (o *Options) ParseArgs(args []string) error {
-	return o.ManifestOptions.ParseArgs(args)
+	if err := o.ManifestOptions.ParseArgs(args); err != nil {
+		return err
+	}
+
+	if err := o.PreflightOptions.ParseArgs(args); err != nil {
+		return err
+	}
+
+	return o.PhaseOptions.ParseArgs(args)
 }
 
 // Validate validates the options.
 func (o *Options) Validate() error {
-	return o.ManifestOptions.Validate()
+	if err := o.ManifestOptions.Validate(); err != nil {
+		return err
+	}
+
+	if err := o.PreflightOptions.Validate(); err != nil {
+		return err
+	}
+
+	return o.PhaseOptions.Validate()
 }
 
 // Complete completes the options.
 func (o *Options) Complete() error {
-	return o.ManifestOptions.Complete()
+	if err := o.ManifestOptions.Complete(); err != nil {
+		return err
+	}
+
+	if err := o.PreflightOptions.Complete(); err != nil {
+		return err
+	}
+
+	return o.PhaseOptions.Complete()
 }
 
 func (o *Options) addFlags(fs *pflag.FlagSet) {
 	o.ManifestOptions.AddFlags(fs)
+	o.PreflightOptions.AddFlags(fs)
+	o.PhaseOptions.AddFlags(fs)
 	fs.BoolVar(&o.UseBootstrapEtcd, "use-bootstrap-etcd", false, "If set, the control plane continues using the bootstrap etcd instead of transitioning to etcd-druid. This is useful for testing purposes to save time.")
 }
@@ -36,6 +36,20 @@ var _ = Describe("Options", func() {
 		It("should fail because config dir path is not set", func() {
 			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a config directory")))
 		})
+
+		It("should fail because an unknown preflight check is ignored", func() {
+			options.ConfigDir = "some-path-to-config-dir"
+			options.IgnorePreflightErrors = []string{"foo"}
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring(`unknown preflight check "foo"`)))
+		})
+
+		It("should fail because an unknown phase is skipped", func() {
+			options.ConfigDir = "some-path-to-config-dir"
+			options.SkipPhases = []string{"foo"}
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring(`unknown phase "foo"`)))
+		})
 	})
 
 	Describe("#Complete", func() {
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package init
+
+import (
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+const (
+	phasePreflight             = "preflight"
+	phaseBootstrapControlPlane = "bootstrap-control-plane"
+	phaseClusterResources      = "cluster-resources"
+	phaseNodeAgent             = "node-agent"
+	phaseResourceManager       = "resource-manager"
+	phaseExtensions            = "extensions"
+	phaseAddons                = "addons"
+	phaseEtcd                  = "etcd"
+	phaseControlPlane          = "control-plane"
+	phaseWorkers               = "workers"
+	phaseFinalize              = "finalize"
+)
+
+// phases are the phases of `gardenadm init` in the order of their execution.
+var phases = cmd.Phases{
+	{Name: phasePreflight, Description: "Run preflight checks before changing anything on the machine"},
+	// The certificates and credentials of the bootstrap control plane are only generated in memory before they are
+	// imported into the control plane. Hence, they cannot be generated in a separate phase.
+	{Name: phaseBootstrapControlPlane, Description: "Generate certificates, start kubelet and the bootstrap control plane as static pods, and import the certificates into it"},
+	{Name: phaseClusterResources, Description: "Deploy namespaces, the cloud provider secret, CustomResourceDefinitions and the Cluster resource"},
+	{Name: phaseNodeAgent, Description: "Activate gardener-node-agent and approve its client certificate"},
+	{Name: phaseResourceManager, Description: "Deploy gardener-resource-manager and the seed and shoot system resources"},
+	{Name: phaseExtensions, Description: "Deploy extension controllers, network policies and the shoot infrastructure"},
+	{Name: phaseAddons, Description: "Deploy the network plugin, kube-proxy and CoreDNS, and move components into the pod network"},
+	{Name: phaseEtcd, Description: "Deploy etcd-druid, the ETCD backup resources, and the main and events ETCDs"},
+	{Name: phaseControlPlane, Description: "Deploy the control plane components and finalize the transition from the bootstrap control plane"},
+	{Name: phaseWorkers, Description: "Deploy machine-controller-manager and the shoot worker pools"},
+	{Name: phaseFinalize, Description: "Finalize the gardener-node-agent bootstrapping"},
+}
+
+// mainFlowPhases are the phases which require a connection to the (bootstrap) control plane.
+var mainFlowPhases = []string{
+	phaseClusterResources,
+	phaseNodeAgent,
+	phaseResourceManager,
+	phaseExtensions,
+	phaseAddons,
+	phaseEtcd,
+	phaseControlPlane,
+	phaseWorkers,
+	phaseFinalize,
+}
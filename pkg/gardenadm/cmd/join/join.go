@@ -7,6 +7,7 @@ package join
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
@@ -20,13 +21,18 @@ import (
 	gardenerextensions "github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/gardenadm/botanist"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/preflight"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
 // NewCommand creates a new cobra.Command.
 func NewCommand(globalOpts *cmd.Options) *cobra.Command {
-	opts := &Options{Options: globalOpts}
+	opts := &Options{Options: globalOpts, PhaseOptions: cmd.PhaseOptions{Phases: phases}}
+
+	phaseCmd := cmd.NewPhaseCommand("join", phases, func(phase cmd.Phase) *cobra.Command {
+		return newPhaseCommand(globalOpts, phase)
+	})
 
 	cmd := &cobra.Command{
 		Use:   "join",
@@ -34,7 +40,12 @@ func NewCommand(globalOpts *cmd.Options) *cobra.Command {
 		Long: `Bootstrap control plane or worker nodes and join them to the cluster.
 
 This command helps to initialize and configure a node to join an existing self-hosted shoot cluster.
-It ensures that the necessary configurations are applied and the node is properly registered as a control plane or worker node.`,
+It ensures that the necessary configurations are applied and the node is properly registered as a control plane or worker node.
+
+The command runs the following phases in this order:
+
+` + phases.String() + `
+Individual phases can be skipped with --skip-phases or run on their own with 'gardenadm join phase <phase>'.`,
 		Example: `# Bootstrap a control plane node and join it to the cluster
 gardenadm join --bootstrap-token <token> --ca-certificate <ca-cert> --control-plane <control-plane-address>
 
@@ -46,21 +57,29 @@ gardenadm join --bootstrap-token <token> --ca-certificate <ca-cert> --worker-poo
 
 		Args: cobra.ExactArgs(1),
 
-		RunE: func(cmd *cobra.Command, args []string) error {
-			if err := opts.ParseArgs(args); err != nil {
-				return err
-			}
+		RunE: runE(opts),
+	}
+
+	opts.addFlags(cmd.Flags())
+	cmd.AddCommand(phaseCmd)
+
+	return cmd
+}
+
+func newPhaseCommand(globalOpts *cmd.Options, phase cmd.Phase) *cobra.Command {
+	opts := &Options{Options: globalOpts, PhaseOptions: cmd.PhaseOptions{Phases: phases, OnlyPhase: phase.Name}}
+
+	cmd := &cobra.Command{
+		Use:   phase.Name + " <control-plane-address>",
+		Short: phase.Description,
+		Long:  phase.Description + ".",
 
-			if err := opts.Validate(); err != nil {
-				return err
-			}
+		Example: fmt.Sprintf(`# Run the %[1]s phase of 'gardenadm join'
+gardenadm join phase %[1]s --bootstrap-token <token> --ca-certificate <ca-cert> <control-plane-address>`, phase.Name),
 
-			if err := opts.Complete(); err != nil {
-				return err
-			}
+		Args: cobra.ExactArgs(1),
 
-			return run(cmd.Context(), opts)
-		},
+		RunE: runE(opts),
 	}
 
 	opts.addFlags(cmd.Flags())
@@ -68,6 +87,24 @@ gardenadm join --bootstrap-token <token> --ca-certificate <ca-cert> --worker-poo
 	return cmd
 }
 
+func runE(opts *Options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if err := opts.ParseArgs(args); err != nil {
+			return err
+		}
+
+		if err := opts.Validate(); err != nil {
+			return err
+		}
+
+		if err := opts.Complete(); err != nil {
+			return err
+		}
+
+		return run(cmd.Context(), opts)
+	}
+}
+
 func run(ctx context.Context, opts *Options) error {
 	b, err := botanist.NewGardenadmBotanistWithoutResources(opts.Log)
 	if err != nil {
@@ -78,6 +115,26 @@ func run(ctx context.Context, opts *Options) error {
 	if err != nil {
 		return fmt.Errorf("failed creating a new bootstrap client set: %w", err)
 	}
+
+	alreadyJoined, err := b.IsGardenerNodeAgentInitialized(ctx)
+	if err != nil {
+		return fmt.Errorf("failed checking if gardener-node-agent was already initialized: %w", err)
+	}
+
+	// All phases are skipped on machines which have already joined the cluster. Fail if phases were selected explicitly
+	// instead of pretending success.
+	if alreadyJoined && !opts.RunsAllPhases() {
+		return fmt.Errorf("this machine has already joined the cluster (gardener-node-agent is initialized), phases %s cannot be run again", strings.Join(opts.SelectedPhases(), ", "))
+	}
+
+	// The Shoot manifest is not known yet, hence, the checks depending on it are run after connecting to the cluster,
+	// see below.
+	if !alreadyJoined && !opts.SkipPhase(phasePreflight) {
+		if err := b.RunPreflightChecks(ctx, opts.IgnoredPreflightChecks(), opts.ControlPlane, preflight.BootstrapToken(bootstrapClientSet, opts.BootstrapToken)); err != nil {
+			return err
+		}
+	}
+
 	version, err := b.DiscoverKubernetesVersion(bootstrapClientSet)
 	if err != nil {
 		return fmt.Errorf("failed discovering Kubernetes version of cluster: %w", err)
@@ -85,12 +142,7 @@ func run(ctx context.Context, opts *Options) error {
 	b.Shoot = &shootpkg.Shoot{KubernetesVersion: version}
 	b.Shoot.SetInfo(nil)
 
-	alreadyJoined, err := b.IsGardenerNodeAgentInitialized(ctx)
-	if err != nil {
-		return fmt.Errorf("failed checking if gardener-node-agent was already initialized: %w", err)
-	}
-
-	if !alreadyJoined {
+	if !alreadyJoined && !opts.SkipPhase(phaseKubeletStart) {
 		var (
 			g                           = flow.NewGraph("join")
 			gardenerNodeAgentSecretName string
@@ -108,6 +160,14 @@ func run(ctx context.Context, opts *Options) error {
 					return nil
 				},
 			})
+			runShootPreflightChecks = g.Add(flow.Task{
+				Name: "Running preflight checks depending on the Shoot manifest",
+				Fn: func(ctx context.Context) error {
+					return runPreflightChecksForShoot(ctx, opts, b)
+				},
+				SkipIf:       opts.SkipPhase(phasePreflight),
+				Dependencies: flow.NewTaskIDs(retrieveShortLivedKubeconfig),
+			})
 			determineGardenerNodeAgentSecretName = g.Add(flow.Task{
 				Name: "Determining gardener-node-agent Secret containing the configuration for this node",
 				Fn: func(ctx context.Context) error {
@@ -118,6 +178,7 @@ func run(ctx context.Context, opts *Options) error {
 				Dependencies: flow.NewTaskIDs(retrieveShortLivedKubeconfig),
 			})
 			syncPointReadyForGardenerNodeInit = flow.NewTaskIDs(
+				runShootPreflightChecks,
 				determineGardenerNodeAgentSecretName,
 			)
 
@@ -142,6 +203,13 @@ func run(ctx context.Context, opts *Options) error {
 		}
 	}
 
+	if !opts.RunsAllPhases() {
+		fmt.Fprintf(opts.Out, `
+The following phases of 'gardenadm join' have been completed successfully: %s
+`, strings.Join(opts.SelectedPhases(), ", "))
+		return nil
+	}
+
 	if opts.ControlPlane {
 		fmt.Fprintf(opts.Out, `
 Your node has successfully been instructed to join the cluster as a control-plane instance!
@@ -196,20 +264,51 @@ func GetGardenerNodeAgentSecretName(ctx context.Context, opts *Options, b *botan
 	return gardenerNodeAgentSecret.Name, nil
 }
 
+// runPreflightChecksForShoot runs the preflight checks which depend on the Shoot manifest. It is read from the Cluster
+// object in the shoot cluster.
+func runPreflightChecksForShoot(ctx context.Context, opts *Options, b *botanist.GardenadmBotanist) error {
+	shoot, err := getShoot(ctx, b)
+	if err != nil {
+		return err
+	}
+
+	workerPoolName, err := workerPoolNameForShoot(opts, shoot)
+	if err != nil {
+		return fmt.Errorf("failed to determine worker pool name in Shoot manifest: %w", err)
+	}
+
+	return b.RunSwapPreflightCheck(ctx, opts.IgnoredPreflightChecks(), shoot, workerPoolName)
+}
+
 func getWorkerPoolName(ctx context.Context, opts *Options, b *botanist.GardenadmBotanist) (string, error) {
 	if opts.WorkerPoolName != "" {
 		return opts.WorkerPoolName, nil
 	}
 
+	shoot, err := getShoot(ctx, b)
+	if err != nil {
+		return "", err
+	}
+	return workerPoolNameForShoot(opts, shoot)
+}
+
+func getShoot(ctx context.Context, b *botanist.GardenadmBotanist) (*gardencorev1beta1.Shoot, error) {
 	cluster, err := gardenerextensions.GetCluster(ctx, b.ShootClientSet.Client(), metav1.NamespaceSystem)
 	if err != nil {
-		return "", fmt.Errorf("failed reading extensions.gardener.cloud/v1alpha1.Cluster object: %w", err)
+		return nil, fmt.Errorf("failed reading extensions.gardener.cloud/v1alpha1.Cluster object: %w", err)
+	}
+	return cluster.Shoot, nil
+}
+
+func workerPoolNameForShoot(opts *Options, shoot *gardencorev1beta1.Shoot) (string, error) {
+	if opts.WorkerPoolName != "" {
+		return opts.WorkerPoolName, nil
 	}
 
 	if opts.ControlPlane {
-		return getControlPlaneWorkerPoolName(cluster.Shoot.Spec.Provider.Workers)
+		return getControlPlaneWorkerPoolName(shoot.Spec.Provider.Workers)
 	}
-	return getFirstWorkerPoolName(cluster.Shoot.Spec.Provider.Workers)
+	return getFirstWorkerPoolName(shoot.Spec.Provider.Workers)
 }
 
 func getControlPlaneWorkerPoolName(workers []gardencorev1beta1.Worker) (string, error) {
@@ -9,6 +9,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -21,12 +22,44 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
 	. "github.com/gardener/gardener/pkg/gardenadm/cmd/join"
 	operationpkg "github.com/gardener/gardener/pkg/gardenlet/operation"
 	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	clitest "github.com/gardener/gardener/pkg/utils/test/cli"
 )
 
 var _ = Describe("Join", func() {
+	Describe("#NewCommand", func() {
+		var command *cobra.Command
+
+		BeforeEach(func() {
+			globalOpts := &cmd.Options{}
+			globalOpts.IOStreams, _, _, _ = clitest.NewTestIOStreams()
+			command = NewCommand(globalOpts)
+		})
+
+		It("should list the phases and allow skipping them", func() {
+			Expect(command.Long).To(ContainSubstring("kubelet-start"))
+			Expect(command.Flags().Lookup("skip-phases")).NotTo(BeNil())
+		})
+
+		It("should have a sub-command for each phase", func() {
+			phaseCommand, args, err := command.Find([]string{"phase", "preflight", "https://api.example.com"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(args).To(ConsistOf("https://api.example.com"))
+			Expect(phaseCommand.Name()).To(Equal("preflight"))
+			Expect(phaseCommand.Flags().Lookup("bootstrap-token")).NotTo(BeNil())
+			Expect(phaseCommand.Flags().Lookup("skip-phases")).To(BeNil())
+
+			var names []string
+			for _, subCommand := range phaseCommand.Parent().Commands() {
+				names = append(names, subCommand.Name())
+			}
+			Expect(names).To(ConsistOf("preflight", "kubelet-start"))
+		})
+	})
+
 	Describe("#GetGardenerNodeAgentSecretName", func() {
 		var (
 			ctx = context.Background()
@@ -51,6 +51,20 @@ var _ = Describe("Options", func() {
 
 			Expect(options.Validate()).To(MatchError(ContainSubstring("cannot provide a worker pool name when joining a control plane node")))
 		})
+
+		It("should fail when an unknown preflight check is ignored", func() {
+			options.BootstrapToken = "some-token"
+			options.IgnorePreflightErrors = []string{"foo"}
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring(`unknown preflight check "foo"`)))
+		})
+
+		It("should fail because an unknown phase is skipped", func() {
+			options.BootstrapToken = "some-token"
+			options.SkipPhases = []string{"foo"}
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring(`unknown phase "foo"`)))
+		})
 	})
 
 	Describe("#Complete", func() {
@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package join
+
+import (
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+const (
+	phasePreflight    = "preflight"
+	phaseKubeletStart = "kubelet-start"
+)
+
+// phases are the phases of `gardenadm join` in the order of their execution.
+var phases = cmd.Phases{
+	{Name: phasePreflight, Description: "Run preflight checks before changing anything on the machine"},
+	{Name: phaseKubeletStart, Description: "Retrieve the configuration for this node and start kubelet via gardener-node-agent"},
+}
@@ -39,6 +39,18 @@ var _ = Describe("ManifestOptions", func() {
 		})
 	})
 
+	Describe("#DefaultConfigDir", func() {
+		It("should not change the config dir if it is set", func() {
+			Expect(options.DefaultConfigDir()).To(Succeed())
+			Expect(options.ConfigDir).To(Equal("some-path-to-config-dir"))
+		})
+
+		It("should fail when it cannot read the default config dir location file", func() {
+			options.ConfigDir = ""
+			Expect(options.DefaultConfigDir()).To(MatchError(ContainSubstring("error reading config dir location file")))
+		})
+	})
+
 	Describe("#Complete", func() {
 		It("should return nil", func() {
 			Expect(options.Complete()).To(Succeed())
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// Phase is a named group of tasks of a command which can be run or skipped individually.
+type Phase struct {
+	// Name is the name of the phase.
+	Name string
+	// Description is a short description of what the phase does.
+	Description string
+}
+
+// Phases is a list of phases in the order of their execution.
+type Phases []Phase
+
+// Names returns the names of the phases.
+func (p Phases) Names() []string {
+	names := make([]string, 0, len(p))
+	for _, phase := range p {
+		names = append(names, phase.Name)
+	}
+	return names
+}
+
+// String returns a human-readable list of the phases and their descriptions, one phase per line.
+func (p Phases) String() string {
+	width := 0
+	for _, phase := range p {
+		width = max(width, len(phase.Name))
+	}
+
+	var sb strings.Builder
+	for _, phase := range p {
+		fmt.Fprintf(&sb, "  %-*s   %s\n", width, phase.Name, phase.Description)
+	}
+	return sb.String()
+}
+
+// PhaseOptions contains options for running only a subset of the phases of a command.
+type PhaseOptions struct {
+	// Phases are all phases of the command in the order of their execution.
+	Phases Phases
+	// SkipPhases is a list of phases which should be skipped.
+	SkipPhases []string
+	// OnlyPhase is the name of the only phase which should be run. It is set by the `phase` sub-commands.
+	OnlyPhase string
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *PhaseOptions) ParseArgs(_ []string) error { return nil }
+
+// Validate validates the options.
+func (o *PhaseOptions) Validate() error {
+	for _, name := range append(slices.Clone(o.SkipPhases), o.OnlyPhase) {
+		if name != "" && !slices.Contains(o.Phases.Names(), name) {
+			return fmt.Errorf("unknown phase %q, known phases are %s", name, strings.Join(o.Phases.Names(), ", "))
+		}
+	}
+
+	if o.OnlyPhase != "" && len(o.SkipPhases) > 0 {
+		return fmt.Errorf("cannot skip phases when running a single phase")
+	}
+
+	return nil
+}
+
+// Complete completes the options.
+func (o *PhaseOptions) Complete() error { return nil }
+
+// SkipPhase returns whether the phase with the given name should be skipped.
+func (o *PhaseOptions) SkipPhase(name string) bool {
+	if o.OnlyPhase != "" {
+		return o.OnlyPhase != name
+	}
+	return slices.Contains(o.SkipPhases, name)
+}
+
+// SkipAllPhases returns whether all phases with the given names should be skipped.
+func (o *PhaseOptions) SkipAllPhases(names ...string) bool {
+	for _, name := range names {
+		if !o.SkipPhase(name) {
+			return false
+		}
+	}
+	return true
+}
+
+// RunsAllPhases returns whether no phase is skipped.
+func (o *PhaseOptions) RunsAllPhases() bool {
+	return o.OnlyPhase == "" && len(o.SkipPhases) == 0
+}
+
+// SelectedPhases returns the names of the phases which are run.
+func (o *PhaseOptions) SelectedPhases() []string {
+	var names []string
+	for _, name := range o.Phases.Names() {
+		if !o.SkipPhase(name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// AddFlags implements Flagger.AddFlags. The --skip-phases flag is only added if the options are not used for running
+// a single phase.
+func (o *PhaseOptions) AddFlags(fs *pflag.FlagSet) {
+	if o.OnlyPhase != "" {
+		return
+	}
+
+	fs.StringSliceVar(&o.SkipPhases, "skip-phases", nil, "List of phases to be skipped. Available phases: "+strings.Join(o.Phases.Names(), ", ")+".")
+}
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+var _ = Describe("PhaseOptions", func() {
+	var (
+		phases  Phases
+		options *PhaseOptions
+	)
+
+	BeforeEach(func() {
+		phases = Phases{
+			{Name: "first", Description: "The first phase"},
+			{Name: "second-phase", Description: "The second phase"},
+			{Name: "third", Description: "The third phase"},
+		}
+		options = &PhaseOptions{Phases: phases}
+	})
+
+	Describe("Phases", func() {
+		It("should return the names of the phases in order", func() {
+			Expect(phases.Names()).To(Equal([]string{"first", "second-phase", "third"}))
+		})
+
+		It("should return an aligned list of the phases", func() {
+			Expect(phases.String()).To(Equal(`  first          The first phase
+  second-phase   The second phase
+  third          The third phase
+`))
+		})
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should return nil", func() {
+			Expect(options.ParseArgs(nil)).To(Succeed())
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should pass if all phases are run", func() {
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should pass for known phases to skip", func() {
+			options.SkipPhases = []string{"first", "third"}
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should pass for a known single phase", func() {
+			options.OnlyPhase = "second-phase"
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail for unknown phases to skip", func() {
+			options.SkipPhases = []string{"first", "foo"}
+			Expect(options.Validate()).To(MatchError(ContainSubstring(`unknown phase "foo", known phases are first, second-phase, third`)))
+		})
+
+		It("should fail for an unknown single phase", func() {
+			options.OnlyPhase = "foo"
+			Expect(options.Validate()).To(MatchError(ContainSubstring(`unknown phase "foo"`)))
+		})
+
+		It("should fail when skipping phases while running a single phase", func() {
+			options.OnlyPhase = "first"
+			options.SkipPhases = []string{"third"}
+			Expect(options.Validate()).To(MatchError(ContainSubstring("cannot skip phases when running a single phase")))
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should return nil", func() {
+			Expect(options.Complete()).To(Succeed())
+		})
+	})
+
+	Describe("#SkipPhase", func() {
+		It("should not skip any phase by default", func() {
+			Expect(options.SkipPhase("first")).To(BeFalse())
+			Expect(options.SkipAllPhases("first", "third")).To(BeFalse())
+			Expect(options.RunsAllPhases()).To(BeTrue())
+			Expect(options.SelectedPhases()).To(Equal([]string{"first", "second-phase", "third"}))
+		})
+
+		It("should skip the given phases", func() {
+			options.SkipPhases = []string{"first", "third"}
+
+			Expect(options.SkipPhase("first")).To(BeTrue())
+			Expect(options.SkipPhase("second-phase")).To(BeFalse())
+			Expect(options.SkipAllPhases("first", "third")).To(BeTrue())
+			Expect(options.SkipAllPhases("first", "second-phase")).To(BeFalse())
+			Expect(options.RunsAllPhases()).To(BeFalse())
+			Expect(options.SelectedPhases()).To(Equal([]string{"second-phase"}))
+		})
+
+		It("should skip all but the single phase", func() {
+			options.OnlyPhase = "third"
+
+			Expect(options.SkipPhase("first")).To(BeTrue())
+			Expect(options.SkipPhase("third")).To(BeFalse())
+			Expect(options.SkipAllPhases("first", "second-phase")).To(BeTrue())
+			Expect(options.RunsAllPhases()).To(BeFalse())
+			Expect(options.SelectedPhases()).To(Equal([]string{"third"}))
+		})
+	})
+
+	Describe("#AddFlags", func() {
+		It("should add the skip-phases flag", func() {
+			fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			options.AddFlags(fs)
+
+			Expect(fs.Parse([]string{"--skip-phases=first,third"})).To(Succeed())
+			Expect(options.SkipPhases).To(Equal([]string{"first", "third"}))
+		})
+
+		It("should not add the skip-phases flag when running a single phase", func() {
+			options.OnlyPhase = "first"
+			fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			options.AddFlags(fs)
+
+			Expect(fs.Lookup("skip-phases")).To(BeNil())
+		})
+	})
+})
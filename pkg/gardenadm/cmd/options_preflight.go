@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"strings"
+
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/gardener/pkg/gardenadm/preflight"
+)
+
+// PreflightOptions contains options related to the preflight checks performed before mutating the machine.
+type PreflightOptions struct {
+	// IgnorePreflightErrors is a list of preflight checks whose failures should be reported as warnings only.
+	IgnorePreflightErrors []string
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *PreflightOptions) ParseArgs(_ []string) error { return nil }
+
+// Validate validates the options.
+func (o *PreflightOptions) Validate() error {
+	return preflight.ValidateIgnoredChecks(o.IgnorePreflightErrors)
+}
+
+// Complete completes the options.
+func (o *PreflightOptions) Complete() error { return nil }
+
+// IgnoredPreflightChecks returns the set of preflight checks whose failures should be ignored.
+func (o *PreflightOptions) IgnoredPreflightChecks() sets.Set[string] {
+	return sets.New(o.IgnorePreflightErrors...)
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *PreflightOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVar(&o.IgnorePreflightErrors, "ignore-preflight-errors", nil, "A list of preflight checks whose errors "+
+		"will be shown as warnings instead of failing the command. Example: 'Swap,TimeSync'. Value 'all' ignores errors "+
+		"from all checks. Available checks: "+strings.Join(sets.List(preflight.AllCheckNames), ", ")+".")
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+var _ = Describe("PreflightOptions", func() {
+	var (
+		options *PreflightOptions
+	)
+
+	BeforeEach(func() {
+		options = &PreflightOptions{}
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should return nil", func() {
+			Expect(options.ParseArgs(nil)).To(Succeed())
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should pass if no preflight errors are ignored", func() {
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should pass for known preflight checks", func() {
+			options.IgnorePreflightErrors = []string{"Swap", "timesync", "all"}
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail for unknown preflight checks", func() {
+			options.IgnorePreflightErrors = []string{"foo"}
+			Expect(options.Validate()).To(MatchError(ContainSubstring(`unknown preflight check "foo"`)))
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should return nil", func() {
+			Expect(options.Complete()).To(Succeed())
+		})
+	})
+
+	Describe("#IgnoredPreflightChecks", func() {
+		It("should return the ignored checks as set", func() {
+			options.IgnorePreflightErrors = []string{"Swap", "TimeSync"}
+			Expect(options.IgnoredPreflightChecks()).To(Equal(sets.New("Swap", "TimeSync")))
+		})
+	})
+})
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewPhaseCommand creates the `phase` sub-command of the given parent command which consists of the given phases. It
+// contains one sub-command per phase, each created with newPhaseCommand.
+func NewPhaseCommand(parentName string, phases Phases, newPhaseCommand func(Phase) *cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "phase",
+		Short: "Run a single phase of the " + parentName + " workflow",
+		Long: `Run a single phase of the ` + parentName + ` workflow.
+
+The phases are executed in the following order when running '` + parentName + `' without a phase:
+
+` + phases.String(),
+	}
+
+	for _, phase := range phases {
+		cmd.AddCommand(newPhaseCommand(phase))
+	}
+
+	return cmd
+}
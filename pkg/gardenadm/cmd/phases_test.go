@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+var _ = Describe("Phases", func() {
+	Describe("#NewPhaseCommand", func() {
+		It("should create one sub-command per phase", func() {
+			phases := Phases{
+				{Name: "first", Description: "The first phase"},
+				{Name: "second", Description: "The second phase"},
+			}
+
+			command := NewPhaseCommand("foo", phases, func(phase Phase) *cobra.Command {
+				return &cobra.Command{Use: phase.Name, Short: phase.Description}
+			})
+
+			Expect(command.Use).To(Equal("phase"))
+			Expect(command.RunE).To(BeNil())
+			Expect(command.Long).To(ContainSubstring("first    The first phase"))
+
+			var names []string
+			for _, subCommand := range command.Commands() {
+				names = append(names, subCommand.Name())
+			}
+			Expect(names).To(ConsistOf("first", "second"))
+		})
+	})
+})
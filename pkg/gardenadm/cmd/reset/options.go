@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reset
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+
+	// Force skips the confirmation prompt before resetting the node.
+	Force bool
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(_ []string) error { return nil }
+
+// Validate validates the options.
+func (o *Options) Validate() error { return nil }
+
+// Complete completes the options.
+func (o *Options) Complete() error { return nil }
+
+// Confirm asks the user for confirmation before resetting the node. It returns true without asking if Force is set.
+func (o *Options) Confirm() (bool, error) {
+	if o.Force {
+		return true, nil
+	}
+
+	fmt.Fprint(o.Out, "The node will be reset, i.e., all changes made by 'gardenadm init' or 'gardenadm join' will be reverted.\nAre you sure you want to proceed? [y/N]: ")
+
+	answer, err := bufio.NewReader(o.In).ReadString('\n')
+	if err != nil && answer == "" {
+		return false, fmt.Errorf("failed reading confirmation: %w", err)
+	}
+
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes", nil
+}
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.BoolVarP(&o.Force, "force", "f", false, "Reset the node without prompting for confirmation")
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reset_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/reset"
+)
+
+var _ = Describe("Options", func() {
+	var (
+		options *Options
+		stdin   *bytes.Buffer
+		stdout  *bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		var streams genericiooptions.IOStreams
+		streams, stdin, stdout, _ = genericiooptions.NewTestIOStreams()
+
+		options = &Options{Options: &cmd.Options{IOStreams: streams}}
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should return nil", func() {
+			Expect(options.ParseArgs(nil)).To(Succeed())
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should return nil", func() {
+			Expect(options.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should return nil", func() {
+			Expect(options.Complete()).To(Succeed())
+		})
+	})
+
+	Describe("#Confirm", func() {
+		It("should not prompt if force is set", func() {
+			options.Force = true
+
+			Expect(options.Confirm()).To(BeTrue())
+			Expect(stdout.String()).To(BeEmpty())
+		})
+
+		DescribeTable("should prompt for confirmation",
+			func(answer string, expected bool) {
+				stdin.WriteString(answer)
+
+				Expect(options.Confirm()).To(Equal(expected))
+				Expect(stdout.String()).To(ContainSubstring("Are you sure you want to proceed? [y/N]"))
+			},
+
+			Entry("yes", "y\n", true),
+			Entry("yes (long)", "Yes\n", true),
+			Entry("yes without newline", "y", true),
+			Entry("no", "n\n", false),
+			Entry("empty", "\n", false),
+			Entry("anything else", "foo\n", false),
+		)
+
+		It("should fail if there is no input", func() {
+			Expect(options.Confirm()).Error().To(MatchError(ContainSubstring("failed reading confirmation")))
+		})
+	})
+})
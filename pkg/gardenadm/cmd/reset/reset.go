@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "reset",
+		Short: "Revert the changes made to this node by 'gardenadm init' or 'gardenadm join'",
+		Long: `Revert the changes made to this node by 'gardenadm init' or 'gardenadm join'.
+
+This command tears down a node of a self-hosted shoot cluster so that it can be re-provisioned without reimaging the machine.
+On control plane nodes, the etcd members of this node are removed from their etcd clusters and the Node object is deleted
+from the cluster first.
+Afterwards, it stops and disables the systemd units written by gardener-node-agent, removes all containers, static pod
+manifests and files, and cleans up the state of kubelet, gardener-node-agent and etcd.`,
+		Example: `# Reset the node after confirming the prompt
+gardenadm reset
+
+# Reset the node without prompting for confirmation
+gardenadm reset --force`,
+
+		Args: cobra.NoArgs,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	confirmed, err := opts.Confirm()
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Fprintln(opts.Out, "Aborted reset of node.")
+		return nil
+	}
+
+	b, err := botanist.NewGardenadmBotanistWithoutResources(opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+
+	isControlPlaneNode, err := b.IsControlPlaneNode()
+	if err != nil {
+		return fmt.Errorf("failed checking whether this node runs a control plane instance: %w", err)
+	}
+
+	connectedToControlPlane := false
+	if isControlPlaneNode {
+		clientSet, err := b.CreateClientSet(ctx)
+		if err != nil {
+			b.Logger.Error(err, "Failed connecting to the control plane, the Node object must be deleted manually")
+		} else {
+			b.ShootClientSet = clientSet
+			connectedToControlPlane = true
+		}
+	}
+
+	var (
+		g = flow.NewGraph("reset")
+
+		deleteNode = g.Add(flow.Task{
+			Name:   "Deleting Node object of this machine",
+			Fn:     b.DeleteNode,
+			SkipIf: !connectedToControlPlane,
+		})
+		removeEtcdMembers = g.Add(flow.Task{
+			Name:         "Removing etcd members of this machine from the etcd clusters",
+			Fn:           b.RemoveEtcdMembers,
+			SkipIf:       !isControlPlaneNode,
+			Dependencies: flow.NewTaskIDs(deleteNode),
+		})
+		stopNodeAgentAndKubelet = g.Add(flow.Task{
+			Name:         "Stopping gardener-node-agent and kubelet",
+			Fn:           b.StopNodeAgentAndKubelet,
+			Dependencies: flow.NewTaskIDs(removeEtcdMembers),
+		})
+		removeContainers = g.Add(flow.Task{
+			Name:         "Removing containers",
+			Fn:           b.RemoveContainers,
+			Dependencies: flow.NewTaskIDs(stopNodeAgentAndKubelet),
+		})
+		removeUnits = g.Add(flow.Task{
+			Name:         "Stopping and removing systemd units written by gardener-node-agent",
+			Fn:           b.RemoveUnits,
+			Dependencies: flow.NewTaskIDs(removeContainers),
+		})
+		removeFiles = g.Add(flow.Task{
+			Name:         "Removing files written by gardener-node-agent and static pod data directories",
+			Fn:           b.RemoveFiles,
+			Dependencies: flow.NewTaskIDs(removeUnits),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Cleaning up directories of kubelet and gardener-node-agent",
+			Fn:           b.CleanupDirectories,
+			Dependencies: flow.NewTaskIDs(removeFiles),
+		})
+	)
+
+	if err := g.Compile().Run(ctx, flow.Opts{
+		Log: opts.Log,
+	}); err != nil {
+		return flow.Errors(err)
+	}
+
+	fmt.Fprintf(opts.Out, `
+Your node has been reset successfully!
+
+The following has not been cleaned up and might need to be removed manually:
+  - the container images cached by containerd
+  - the binaries in %s
+  - the state of gardenadm in %s
+
+It is recommended to reboot the machine before running 'gardenadm init' or
+'gardenadm join' again.
+`, nodeagentconfigv1alpha1.BinaryDir, botanist.GardenadmBaseDir)
+
+	return nil
+}
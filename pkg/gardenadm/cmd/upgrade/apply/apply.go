@@ -0,0 +1,270 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apply
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	gardenerextensions "github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "apply [kubernetes-version]",
+		Short: "Upgrade the control plane to the given Kubernetes version and to the Gardener version of this gardenadm binary",
+		Long: `Upgrade the control plane to the given Kubernetes version and to the Gardener version of this gardenadm binary.
+
+If no Kubernetes version is given, the version from the Shoot manifest in the config directory is used. Before upgrading,
+the same checks as in 'gardenadm upgrade plan' are performed, i.e., the version must be offered by the CloudProfile, the
+upgrade must not skip a minor version, and all kubelets must still be within the supported version skew.
+
+The control plane components are redeployed with the new version. The static control plane pods are rolled out by
+gardener-node-agent. Before the new configuration is published, all control plane nodes are put on hold with the
+annotation node-agent.gardener.cloud/hold-operating-system-config-update. The nodes are then released one after
+another, and the next node is only released after the previous one is healthy, its kubelet runs the new version, and
+all static pods on it are updated and ready. The upgrade stops at the first node which does not become healthy, the
+remaining nodes stay on hold with the previous version. Running 'gardenadm upgrade apply' again continues the upgrade.`,
+
+		Example: `# Upgrade the control plane to Kubernetes version 1.34.1
+gardenadm upgrade apply 1.34.1
+
+# Upgrade the control plane to the Kubernetes version specified in the Shoot manifest
+gardenadm upgrade apply --config-dir /path/to/manifests`,
+
+		Args: cobra.MaximumNArgs(1),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	bootstrapBotanist, err := botanist.NewGardenadmBotanistWithoutResources(opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+
+	clientSet, err := bootstrapBotanist.CreateClientSet(ctx)
+	if err != nil {
+		return fmt.Errorf("failed creating client set: %w", err)
+	}
+
+	b, err := botanist.NewGardenadmBotanistFromManifestsWithKubernetesVersion(ctx, opts.Log, clientSet, opts.ConfigDir, true, opts.KubernetesVersion)
+	if err != nil {
+		return err
+	}
+
+	currentVersion, err := b.DiscoverKubernetesVersion(clientSet)
+	if err != nil {
+		return err
+	}
+
+	if err := b.ValidateKubernetesVersionUpgrade(ctx, currentVersion, b.Shoot.KubernetesVersion); err != nil {
+		return fmt.Errorf("cannot upgrade control plane from Kubernetes version %s to %s: %w", currentVersion, b.Shoot.KubernetesVersion, err)
+	}
+
+	etcdManagedByDruid, err := b.IsEtcdManagedByDruid(ctx)
+	if err != nil {
+		return err
+	}
+
+	b.Logger.Info("Upgrading control plane", "currentVersion", currentVersion, "targetVersion", b.Shoot.KubernetesVersion)
+
+	var (
+		g                = flow.NewGraph("upgrade")
+		allowBackup      = v1beta1helper.GetBackupConfigForShoot(b.Shoot.GetInfo(), nil) != nil
+		kubeProxyEnabled = v1beta1helper.KubeProxyEnabled(b.Shoot.GetInfo().Spec.Kubernetes.KubeProxy)
+
+		reconcileCustomResourceDefinitions = g.Add(flow.Task{
+			Name: "Reconciling CustomResourceDefinitions",
+			Fn:   b.ReconcileCustomResourceDefinitions,
+		})
+		ensureCustomResourceDefinitionsReady = g.Add(flow.Task{
+			Name:         "Ensuring CustomResourceDefinitions are ready",
+			Fn:           flow.TaskFn(b.EnsureCustomResourceDefinitionsReady).RetryUntilTimeout(time.Second, time.Minute),
+			Dependencies: flow.NewTaskIDs(reconcileCustomResourceDefinitions),
+		})
+		reconcileClusterResource = g.Add(flow.Task{
+			Name: "Reconciling extensions.gardener.cloud/v1alpha1.Cluster resource",
+			Fn: func(ctx context.Context) error {
+				return gardenerextensions.SyncClusterResourceToSeed(ctx, b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, b.Shoot.GetInfo(), b.Shoot.CloudProfile, b.Seed.GetInfo())
+			},
+			Dependencies: flow.NewTaskIDs(ensureCustomResourceDefinitionsReady),
+		})
+		initializeSecretsManagement = g.Add(flow.Task{
+			Name:         "Initializing internal state of Gardener secrets manager",
+			Fn:           b.InitializeSecretsManagement,
+			Dependencies: flow.NewTaskIDs(reconcileClusterResource),
+		})
+		deployGardenerResourceManager = g.Add(flow.Task{
+			Name: "Deploying gardener-resource-manager",
+			Fn: func(ctx context.Context) error {
+				b.Components.RuntimeResourceManager.SetBootstrapControlPlaneNode(false)
+				b.Shoot.Components.ControlPlane.ResourceManager.SetBootstrapControlPlaneNode(false)
+
+				return flow.Parallel(
+					b.Components.RuntimeResourceManager.Deploy,
+					b.Shoot.Components.ControlPlane.ResourceManager.Deploy,
+				)(ctx)
+			},
+			Dependencies: flow.NewTaskIDs(initializeSecretsManagement),
+		})
+		waitUntilGardenerResourceManagerReady = g.Add(flow.Task{
+			Name: "Waiting until gardener-resource-manager reports readiness",
+			Fn: flow.Parallel(
+				b.Components.RuntimeResourceManager.Wait,
+				b.Shoot.Components.ControlPlane.ResourceManager.Wait,
+			),
+			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying shoot system resources",
+			Fn:           b.DeployShootSystem,
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady),
+		})
+		deployExtensionControllers = g.Add(flow.Task{
+			Name: "Deploying extension controllers",
+			Fn: func(ctx context.Context) error {
+				return b.ReconcileExtensionControllerInstallations(ctx, false)
+			},
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady),
+		})
+		waitUntilExtensionControllersReady = g.Add(flow.Task{
+			Name:         "Waiting until extension controllers report readiness",
+			Fn:           b.WaitUntilExtensionControllerInstallationsHealthy,
+			Dependencies: flow.NewTaskIDs(deployExtensionControllers),
+		})
+		reconcileBackupBucket = g.Add(flow.Task{
+			Name:         "Deploying BackupBucket for ETCD data",
+			Fn:           b.ReconcileBackupBucket,
+			SkipIf:       !allowBackup || !etcdManagedByDruid,
+			Dependencies: flow.NewTaskIDs(waitUntilExtensionControllersReady),
+		})
+		reconcileBackupEntry = g.Add(flow.Task{
+			Name:         "Deploying BackupEntry for ETCD data",
+			Fn:           b.ReconcileBackupEntry,
+			SkipIf:       !allowBackup || !etcdManagedByDruid,
+			Dependencies: flow.NewTaskIDs(reconcileBackupBucket),
+		})
+		deployControlPlane = g.Add(flow.Task{
+			Name:         "Deploying shoot control plane components",
+			Fn:           b.DeployControlPlane,
+			Dependencies: flow.NewTaskIDs(waitUntilExtensionControllersReady),
+		})
+		waitUntilControlPlaneReady = g.Add(flow.Task{
+			Name:         "Waiting until shoot control plane has been reconciled",
+			Fn:           b.Shoot.Components.Extensions.ControlPlane.Wait,
+			Dependencies: flow.NewTaskIDs(deployControlPlane),
+		})
+		deployEtcdDruid = g.Add(flow.Task{
+			Name:         "Deploying ETCD Druid",
+			Fn:           b.DeployEtcdDruid,
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady),
+		})
+		deployEtcds = g.Add(flow.Task{
+			Name:         "Deploying main and events ETCDs",
+			Fn:           b.DeployEtcd,
+			SkipIf:       !etcdManagedByDruid,
+			Dependencies: flow.NewTaskIDs(deployEtcdDruid, reconcileBackupEntry),
+		})
+		waitUntilEtcdsReady = g.Add(flow.Task{
+			Name:         "Waiting until main and event ETCDs have been reconciled",
+			Fn:           b.WaitUntilEtcdsReconciled,
+			SkipIf:       !etcdManagedByDruid,
+			Dependencies: flow.NewTaskIDs(deployEtcds),
+		})
+		holdControlPlaneNodeUpdates = g.Add(flow.Task{
+			Name:         "Putting updates of control plane nodes on hold",
+			Fn:           b.HoldControlPlaneNodeUpdates,
+			Dependencies: flow.NewTaskIDs(waitUntilControlPlaneReady, waitUntilEtcdsReady),
+		})
+		deployControlPlaneDeployments = g.Add(flow.Task{
+			Name:         "Deploying control plane components as Deployments/StatefulSets and updating gardener-node-agent Secret",
+			Fn:           b.DeployControlPlaneDeployments,
+			Dependencies: flow.NewTaskIDs(holdControlPlaneNodeUpdates),
+		})
+		rollOutControlPlaneNodes = g.Add(flow.Task{
+			Name:         "Upgrading control plane nodes one after another",
+			Fn:           b.RollOutControlPlaneNodes,
+			Dependencies: flow.NewTaskIDs(deployControlPlaneDeployments),
+		})
+		waitUntilControlPlaneDeploymentsReady = g.Add(flow.Task{
+			Name:         "Waiting until control plane components (static pods) are ready",
+			Fn:           b.WaitUntilControlPlaneDeploymentsReady,
+			Dependencies: flow.NewTaskIDs(rollOutControlPlaneNodes),
+		})
+		waitUntilWebhookComponentsReady = g.Add(flow.Task{
+			Name: "Waiting until components with webhooks are ready",
+			Fn: flow.Sequential(
+				b.Shoot.Components.ControlPlane.ResourceManager.Wait,
+				b.WaitUntilExtensionControllerInstallationsHealthy,
+			),
+			Dependencies: flow.NewTaskIDs(waitUntilControlPlaneDeploymentsReady),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying kube-proxy system component",
+			Fn:           b.DeployKubeProxy,
+			SkipIf:       !kubeProxyEnabled,
+			Dependencies: flow.NewTaskIDs(waitUntilWebhookComponentsReady),
+		})
+		deployCoreDNS = g.Add(flow.Task{
+			Name:         "Deploying CoreDNS system component",
+			Fn:           b.DeployCoreDNS,
+			Dependencies: flow.NewTaskIDs(waitUntilWebhookComponentsReady),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Waiting until CoreDNS system component is ready",
+			Fn:           b.Shoot.Components.SystemComponents.CoreDNS.Wait,
+			Dependencies: flow.NewTaskIDs(deployCoreDNS),
+		})
+	)
+
+	if err := g.Compile().Run(ctx, flow.Opts{
+		Log: opts.Log,
+	}); err != nil {
+		return flow.Errors(err)
+	}
+
+	fmt.Fprintf(opts.Out, `
+Your Shoot cluster control-plane has been upgraded to Kubernetes version %s successfully!
+`, b.Shoot.KubernetesVersion)
+
+	if opts.KubernetesVersion != "" {
+		fmt.Fprintf(opts.Out, `
+Please make sure to also update the Kubernetes version in the Shoot manifest in the config directory %s.
+Otherwise, subsequent runs of 'gardenadm init' would use the previous version.
+`, opts.ConfigDir)
+	}
+
+	return nil
+}
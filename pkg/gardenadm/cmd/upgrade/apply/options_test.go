@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apply_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/apply"
+)
+
+var _ = Describe("Options", func() {
+	var (
+		options *Options
+	)
+
+	BeforeEach(func() {
+		options = &Options{}
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should do nothing when no argument is set", func() {
+			Expect(options.ParseArgs(nil)).To(Succeed())
+			Expect(options.KubernetesVersion).To(BeEmpty())
+		})
+
+		It("should trim spaces and the 'v' prefix when the argument is set", func() {
+			Expect(options.ParseArgs([]string{" v1.34.1 "})).To(Succeed())
+			Expect(options.KubernetesVersion).To(Equal("1.34.1"))
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should succeed when proper values were provided", func() {
+			options.KubernetesVersion = "1.34.1"
+			options.ConfigDir = "path/to/config/dir"
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should succeed when no Kubernetes version was provided", func() {
+			options.ConfigDir = "path/to/config/dir"
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail when the Kubernetes version is invalid", func() {
+			options.KubernetesVersion = "foo"
+			options.ConfigDir = "path/to/config/dir"
+			Expect(options.Validate()).To(MatchError(ContainSubstring(`invalid Kubernetes version "foo"`)))
+		})
+
+		It("should fail when it cannot read the default config dir location file", func() {
+			Expect(options.Validate()).To(MatchError(ContainSubstring("error reading config dir location file")))
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should return nil", func() {
+			Expect(options.Complete()).To(Succeed())
+		})
+	})
+})
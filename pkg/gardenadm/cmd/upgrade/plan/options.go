@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+	cmd.ManifestOptions
+
+	// KubernetesVersion is the Kubernetes version to which the control plane should be upgraded.
+	KubernetesVersion string
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(args []string) error {
+	if len(args) > 0 {
+		o.KubernetesVersion = strings.TrimPrefix(strings.TrimSpace(args[0]), "v")
+	}
+
+	return o.ManifestOptions.ParseArgs(args)
+}
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if len(o.KubernetesVersion) > 0 {
+		if _, err := semver.NewVersion(o.KubernetesVersion); err != nil {
+			return fmt.Errorf("invalid Kubernetes version %q: %w", o.KubernetesVersion, err)
+		}
+	}
+
+	if err := o.DefaultConfigDir(); err != nil {
+		return err
+	}
+
+	return o.ManifestOptions.Validate()
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error { return o.ManifestOptions.Complete() }
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	o.ManifestOptions.AddFlags(fs)
+}
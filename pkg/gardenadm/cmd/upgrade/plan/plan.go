@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "plan [kubernetes-version]",
+		Short: "Check which versions are available to upgrade to and validate whether the control plane is upgradeable",
+		Long: `Check which versions are available to upgrade to and validate whether the control plane is upgradeable.
+
+The available Kubernetes versions are read from the CloudProfile in the config directory. Only versions that are newer
+than the version of the running control plane, that do not skip a minor version, and that are not expired are listed.
+For the given target version (defaults to the latest available version), the version skew of all kubelets is checked and
+the container images of the control plane components are compared with the images from the image vector of this
+gardenadm binary.`,
+
+		Example: `# Show the available versions and the changes for upgrading to the latest available version
+gardenadm upgrade plan
+
+# Show the changes for upgrading to a specific Kubernetes version
+gardenadm upgrade plan 1.34.1 --config-dir /path/to/manifests`,
+
+		Args: cobra.MaximumNArgs(1),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	bootstrapBotanist, err := botanist.NewGardenadmBotanistWithoutResources(opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+
+	clientSet, err := bootstrapBotanist.CreateClientSet(ctx)
+	if err != nil {
+		return fmt.Errorf("failed creating client set: %w", err)
+	}
+
+	b, err := botanist.NewGardenadmBotanistFromManifests(ctx, opts.Log, clientSet, opts.ConfigDir, true)
+	if err != nil {
+		return err
+	}
+
+	currentVersion, err := b.DiscoverKubernetesVersion(clientSet)
+	if err != nil {
+		return err
+	}
+
+	availableVersions, err := botanist.AvailableKubernetesUpgrades(b.Shoot.CloudProfile.Spec.Kubernetes.Versions, currentVersion)
+	if err != nil {
+		return fmt.Errorf("failed computing available Kubernetes versions: %w", err)
+	}
+
+	fmt.Fprintf(opts.Out, "Current Kubernetes version of the control plane: %s\n\n", currentVersion)
+
+	if len(availableVersions) == 0 {
+		fmt.Fprintf(opts.Out, "There are no newer Kubernetes versions available in CloudProfile %q.\n\n", b.Shoot.CloudProfile.Name)
+	} else {
+		fmt.Fprintf(opts.Out, "Available Kubernetes versions in CloudProfile %q:\n", b.Shoot.CloudProfile.Name)
+
+		table := &metav1.Table{
+			ColumnDefinitions: []metav1.TableColumnDefinition{
+				{Name: "VERSION", Type: "string", Description: "Kubernetes version"},
+				{Name: "CLASSIFICATION", Type: "string", Description: "Lifecycle classification of the Kubernetes version"},
+			},
+		}
+		for _, version := range availableVersions {
+			table.Rows = append(table.Rows, metav1.TableRow{Cells: []any{version.Version, string(v1beta1helper.CurrentLifecycleClassification(version))}})
+		}
+
+		if err := printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(table, opts.Out); err != nil {
+			return err
+		}
+		fmt.Fprintln(opts.Out)
+	}
+
+	targetVersion := currentVersion
+	if opts.KubernetesVersion != "" {
+		targetVersion = semver.MustParse(opts.KubernetesVersion)
+	} else if len(availableVersions) > 0 {
+		targetVersion = semver.MustParse(availableVersions[len(availableVersions)-1].Version)
+	}
+
+	fmt.Fprintf(opts.Out, "Upgrade to Kubernetes version %s:\n", targetVersion)
+
+	upgradeable := true
+	if err := b.ValidateKubernetesVersionUpgrade(ctx, currentVersion, targetVersion); err != nil {
+		upgradeable = false
+		fmt.Fprintf(opts.Out, "  The control plane cannot be upgraded:\n  %s\n\n", strings.ReplaceAll(err.Error(), "\n", "\n  "))
+	}
+
+	componentImages, err := b.ComponentImages(ctx, targetVersion)
+	if err != nil {
+		return fmt.Errorf("failed computing component images: %w", err)
+	}
+
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "COMPONENT", Type: "string", Description: "Name of the component"},
+			{Name: "CURRENT IMAGE", Type: "string", Description: "Currently used image"},
+			{Name: "TARGET IMAGE", Type: "string", Description: "Image used after the upgrade"},
+			{Name: "CHANGED", Type: "string", Description: "Whether the image changes during the upgrade"},
+		},
+	}
+	for _, componentImage := range componentImages {
+		current := componentImage.Current
+		if current == "" {
+			current = "<none>"
+		}
+
+		changed := "no"
+		if componentImage.Changed() {
+			changed = "yes"
+		}
+
+		table.Rows = append(table.Rows, metav1.TableRow{Cells: []any{componentImage.Name, current, componentImage.Desired, changed}})
+	}
+
+	if err := printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(table, opts.Out); err != nil {
+		return err
+	}
+
+	if upgradeable {
+		fmt.Fprintf(opts.Out, `
+You can now apply the upgrade by executing the following command on a control plane node:
+
+  gardenadm upgrade apply %s
+`, targetVersion)
+	}
+
+	return nil
+}
@@ -0,0 +1,272 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	systemddbus "github.com/coreos/go-systemd/v22/dbus"
+	"github.com/spf13/afero"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+	bootstraptokenutil "k8s.io/cluster-bootstrap/token/util"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+)
+
+// Exec is the execution function to invoke outside binaries. Exposed for testing.
+var Exec = func(ctx context.Context, command string, arg ...string) ([]byte, error) {
+	return exec.CommandContext(ctx, command, arg...).Output()
+}
+
+// Listen is an alias for net.Listen. Exposed for testing.
+var Listen = net.Listen
+
+// LookupHost is an alias for net.DefaultResolver.LookupHost. Exposed for testing.
+var LookupHost = net.DefaultResolver.LookupHost
+
+const (
+	pathProcModules         = "/proc/modules"
+	pathProcSwaps           = "/proc/swaps"
+	pathSysModule           = "/sys/module"
+	pathCgroupV2Controllers = "/sys/fs/cgroup/cgroup.controllers"
+
+	containerdUnitName = "containerd.service"
+)
+
+// KernelModules returns a check verifying that the given kernel modules are loaded or built into the kernel.
+func KernelModules(fs afero.Afero, modules ...string) Check {
+	return Check{
+		Name: CheckKernelModules,
+		Fn: func(_ context.Context) error {
+			procModules, err := fs.ReadFile(pathProcModules)
+			if err != nil {
+				return fmt.Errorf("failed reading %s: %w", pathProcModules, err)
+			}
+
+			var loaded []string
+			for line := range strings.Lines(string(procModules)) {
+				if fields := strings.Fields(line); len(fields) > 0 {
+					loaded = append(loaded, fields[0])
+				}
+			}
+
+			var missing []string
+			for _, module := range modules {
+				if slices.Contains(loaded, module) {
+					continue
+				}
+
+				// Modules built into the kernel are not listed in /proc/modules but might still be present in /sys/module.
+				exists, err := fs.DirExists(filepath.Join(pathSysModule, module))
+				if err != nil {
+					return fmt.Errorf("failed checking whether kernel module %s exists: %w", module, err)
+				}
+				if !exists {
+					missing = append(missing, module)
+				}
+			}
+
+			if len(missing) > 0 {
+				return fmt.Errorf("required kernel modules are not loaded: %s (load them with 'modprobe <module>')", strings.Join(missing, ", "))
+			}
+			return nil
+		},
+	}
+}
+
+// CgroupV2 returns a check verifying that the machine uses the unified cgroup hierarchy (cgroup v2).
+func CgroupV2(fs afero.Afero) Check {
+	return Check{
+		Name: CheckCgroupV2,
+		Fn: func(_ context.Context) error {
+			exists, err := fs.Exists(pathCgroupV2Controllers)
+			if err != nil {
+				return fmt.Errorf("failed checking whether %s exists: %w", pathCgroupV2Controllers, err)
+			}
+			if !exists {
+				return fmt.Errorf("cgroup v2 is not enabled, %s does not exist", pathCgroupV2Controllers)
+			}
+			return nil
+		},
+	}
+}
+
+// Swap returns a check verifying that no swap device is active. The check always passes if failSwapOn is false, i.e.,
+// if kubelet is configured to tolerate swap.
+func Swap(fs afero.Afero, failSwapOn bool) Check {
+	return Check{
+		Name: CheckSwap,
+		Fn: func(_ context.Context) error {
+			if !failSwapOn {
+				return nil
+			}
+
+			swaps, err := fs.ReadFile(pathProcSwaps)
+			if err != nil {
+				return fmt.Errorf("failed reading %s: %w", pathProcSwaps, err)
+			}
+
+			// The first line of /proc/swaps is the header.
+			var devices []string
+			for i, line := range slices.Collect(strings.Lines(string(swaps))) {
+				if fields := strings.Fields(line); i > 0 && len(fields) > 0 {
+					devices = append(devices, fields[0])
+				}
+			}
+
+			if len(devices) > 0 {
+				return fmt.Errorf("swap is enabled on %s, but kubelet is configured with failSwapOn=true (disable swap with 'swapoff -a')", strings.Join(devices, ", "))
+			}
+			return nil
+		},
+	}
+}
+
+// Ports returns a check verifying that the given TCP ports are not in use.
+func Ports(ports ...int32) Check {
+	return Check{
+		Name: CheckPorts,
+		Fn: func(_ context.Context) error {
+			var inUse []string
+
+			for _, port := range ports {
+				listener, err := Listen("tcp", fmt.Sprintf(":%d", port))
+				if err != nil {
+					inUse = append(inUse, strconv.Itoa(int(port)))
+					continue
+				}
+				if err := listener.Close(); err != nil {
+					return fmt.Errorf("failed closing listener on port %d: %w", port, err)
+				}
+			}
+
+			if len(inUse) > 0 {
+				return fmt.Errorf("required ports are already in use: %s", strings.Join(inUse, ", "))
+			}
+			return nil
+		},
+	}
+}
+
+// TimeSync returns a check verifying that the system clock is synchronized, as reported by systemd.
+func TimeSync() Check {
+	return Check{
+		Name: CheckTimeSync,
+		Fn: func(ctx context.Context) error {
+			out, err := Exec(ctx, "timedatectl", "show", "--property=NTPSynchronized", "--value")
+			if err != nil {
+				return fmt.Errorf("failed checking whether the system clock is synchronized: %w", err)
+			}
+
+			if value := strings.TrimSpace(string(out)); value != "yes" {
+				return fmt.Errorf("system clock is not synchronized (NTPSynchronized=%s), certificates issued by this node might not be valid yet", value)
+			}
+			return nil
+		},
+	}
+}
+
+// ContainerRuntime returns a check verifying that the containerd systemd unit is installed.
+func ContainerRuntime(dbus dbus.DBus) Check {
+	return Check{
+		Name: CheckContainerRuntime,
+		Fn: func(ctx context.Context) error {
+			unitStatuses, err := dbus.List(ctx)
+			if err != nil {
+				return fmt.Errorf("failed listing systemd units: %w", err)
+			}
+
+			if !slices.ContainsFunc(unitStatuses, func(status systemddbus.UnitStatus) bool {
+				return status.Name == containerdUnitName
+			}) {
+				return fmt.Errorf("container runtime is not available, systemd unit %s was not found", containerdUnitName)
+			}
+			return nil
+		},
+	}
+}
+
+// DiskSpace returns a check verifying that the file system containing the given path has at least the given amount of
+// free disk space.
+func DiskSpace(path string, minimum resource.Quantity) Check {
+	return Check{
+		Name: CheckDiskSpace,
+		Fn: func(ctx context.Context) error {
+			out, err := Exec(ctx, "df", "-Pk", path)
+			if err != nil {
+				return fmt.Errorf("failed determining free disk space of %s: %w", path, err)
+			}
+
+			// The output has a header line followed by one line per file system. The fourth column contains the number of
+			// available 1024-byte blocks.
+			var fields []string
+			if lines := slices.Collect(strings.Lines(string(out))); len(lines) > 1 {
+				fields = strings.Fields(lines[1])
+			}
+			if len(fields) < 4 {
+				return fmt.Errorf("failed parsing output of 'df -Pk %s': %q", path, string(out))
+			}
+
+			availableKiB, err := strconv.ParseInt(fields[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed parsing available disk space %q: %w", fields[3], err)
+			}
+
+			if available := resource.NewQuantity(availableKiB*1024, resource.BinarySI); available.Cmp(minimum) < 0 {
+				return fmt.Errorf("not enough free disk space on the file system containing %s: %s available, at least %s required", path, available.String(), minimum.String())
+			}
+			return nil
+		},
+	}
+}
+
+// Hostname returns a check verifying that the given hostname is a valid DNS subdomain and can be resolved.
+func Hostname(hostName string) Check {
+	return Check{
+		Name: CheckHostname,
+		Fn: func(ctx context.Context) error {
+			if errs := validation.IsDNS1123Subdomain(hostName); len(errs) > 0 {
+				return fmt.Errorf("hostname %q is not a valid node name: %s", hostName, strings.Join(errs, "; "))
+			}
+
+			if _, err := LookupHost(ctx, hostName); err != nil {
+				return fmt.Errorf("hostname %q cannot be resolved: %w", hostName, err)
+			}
+			return nil
+		},
+	}
+}
+
+// BootstrapToken returns a check verifying that the given bootstrap token is well-formed and accepted by the control
+// plane which the given client set connects to.
+func BootstrapToken(clientSet kubernetes.Interface, token string) Check {
+	return Check{
+		Name: CheckBootstrapToken,
+		Fn: func(ctx context.Context) error {
+			if !bootstraptokenutil.IsValidBootstrapToken(token) {
+				return fmt.Errorf("bootstrap token does not match the expected format [a-z0-9]{6}.[a-z0-9]{16}")
+			}
+
+			if err := clientSet.Client().Create(ctx, &authenticationv1.SelfSubjectReview{}); err != nil {
+				if apierrors.IsUnauthorized(err) {
+					return fmt.Errorf("bootstrap token was rejected by the control plane, it might have expired or been deleted (create a new one with 'gardenadm token create' on a control plane node)")
+				}
+				return fmt.Errorf("failed validating bootstrap token against the control plane: %w", err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	systemddbus "github.com/coreos/go-systemd/v22/dbus"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenadm/preflight"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Checks", func() {
+	var (
+		ctx = context.Background()
+		fs  afero.Afero
+	)
+
+	BeforeEach(func() {
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+	})
+
+	Describe("#KernelModules", func() {
+		BeforeEach(func() {
+			Expect(fs.WriteFile("/proc/modules", []byte("overlay 151552 0 - Live 0x0000000000000000\nnf_nat 61440 1 - Live 0x0000000000000000\n"), 0444)).To(Succeed())
+		})
+
+		It("should succeed if all modules are loaded or built-in", func() {
+			Expect(fs.MkdirAll("/sys/module/br_netfilter", 0755)).To(Succeed())
+
+			check := KernelModules(fs, "overlay", "br_netfilter")
+			Expect(check.Name).To(Equal(CheckKernelModules))
+			Expect(check.Fn(ctx)).To(Succeed())
+		})
+
+		It("should report all missing modules", func() {
+			Expect(KernelModules(fs, "overlay", "br_netfilter", "foo").Fn(ctx)).To(MatchError(ContainSubstring("required kernel modules are not loaded: br_netfilter, foo")))
+		})
+	})
+
+	Describe("#CgroupV2", func() {
+		It("should succeed if cgroup v2 is enabled", func() {
+			Expect(fs.WriteFile("/sys/fs/cgroup/cgroup.controllers", []byte("cpu memory"), 0444)).To(Succeed())
+			Expect(CgroupV2(fs).Fn(ctx)).To(Succeed())
+		})
+
+		It("should fail if cgroup v2 is not enabled", func() {
+			Expect(CgroupV2(fs).Fn(ctx)).To(MatchError(ContainSubstring("cgroup v2 is not enabled")))
+		})
+	})
+
+	Describe("#Swap", func() {
+		header := "Filename\t\t\t\tType\t\tSize\t\tUsed\t\tPriority\n"
+
+		It("should succeed if no swap device is active", func() {
+			Expect(fs.WriteFile("/proc/swaps", []byte(header), 0444)).To(Succeed())
+			Expect(Swap(fs, true).Fn(ctx)).To(Succeed())
+		})
+
+		It("should fail if swap is active", func() {
+			Expect(fs.WriteFile("/proc/swaps", []byte(header+"/swap.img\t\t\t\tfile\t\t2097148\t\t0\t\t-2\n"), 0444)).To(Succeed())
+			Expect(Swap(fs, true).Fn(ctx)).To(MatchError(ContainSubstring("swap is enabled on /swap.img")))
+		})
+
+		It("should succeed if swap is active but tolerated", func() {
+			Expect(fs.WriteFile("/proc/swaps", []byte(header+"/swap.img\t\t\t\tfile\t\t2097148\t\t0\t\t-2\n"), 0444)).To(Succeed())
+			Expect(Swap(fs, false).Fn(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("#Ports", func() {
+		BeforeEach(func() {
+			DeferCleanup(test.WithVar(&Listen, func(_, address string) (net.Listener, error) {
+				if address == ":443" || address == ":2379" {
+					return nil, fmt.Errorf("listen tcp %s: bind: address already in use", address)
+				}
+				return net.Listen("tcp", "127.0.0.1:0")
+			}))
+		})
+
+		It("should succeed if all ports are available", func() {
+			Expect(Ports(10250, 2380).Fn(ctx)).To(Succeed())
+		})
+
+		It("should report all ports in use", func() {
+			Expect(Ports(2379, 2380, 443, 10250).Fn(ctx)).To(MatchError(ContainSubstring("required ports are already in use: 2379, 443")))
+		})
+	})
+
+	Describe("#TimeSync", func() {
+		var output string
+
+		BeforeEach(func() {
+			DeferCleanup(test.WithVar(&Exec, func(_ context.Context, command string, args ...string) ([]byte, error) {
+				Expect(command).To(Equal("timedatectl"))
+				Expect(args).To(Equal([]string{"show", "--property=NTPSynchronized", "--value"}))
+				return []byte(output), nil
+			}))
+		})
+
+		It("should succeed if the clock is synchronized", func() {
+			output = "yes\n"
+			Expect(TimeSync().Fn(ctx)).To(Succeed())
+		})
+
+		It("should fail if the clock is not synchronized", func() {
+			output = "no\n"
+			Expect(TimeSync().Fn(ctx)).To(MatchError(ContainSubstring("system clock is not synchronized (NTPSynchronized=no)")))
+		})
+	})
+
+	Describe("#ContainerRuntime", func() {
+		var fakeDBus *fakedbus.DBus
+
+		BeforeEach(func() {
+			fakeDBus = fakedbus.New()
+		})
+
+		It("should succeed if the containerd unit exists", func() {
+			fakeDBus.AddUnitsToList(systemddbus.UnitStatus{Name: "containerd.service"})
+			Expect(ContainerRuntime(fakeDBus).Fn(ctx)).To(Succeed())
+		})
+
+		It("should fail if the containerd unit does not exist", func() {
+			fakeDBus.AddUnitsToList(systemddbus.UnitStatus{Name: "kubelet.service"})
+			Expect(ContainerRuntime(fakeDBus).Fn(ctx)).To(MatchError(ContainSubstring("systemd unit containerd.service was not found")))
+		})
+	})
+
+	Describe("#DiskSpace", func() {
+		var output string
+
+		BeforeEach(func() {
+			DeferCleanup(test.WithVar(&Exec, func(_ context.Context, command string, args ...string) ([]byte, error) {
+				Expect(command).To(Equal("df"))
+				Expect(args).To(Equal([]string{"-Pk", "/var/lib"}))
+				return []byte(output), nil
+			}))
+		})
+
+		It("should succeed if enough disk space is available", func() {
+			output = "Filesystem     1024-blocks     Used Available Capacity Mounted on\n/dev/sda1         41152736 10000000  31152736      25% /\n"
+			Expect(DiskSpace("/var/lib", resource.MustParse("10Gi")).Fn(ctx)).To(Succeed())
+		})
+
+		It("should fail if not enough disk space is available", func() {
+			output = "Filesystem     1024-blocks     Used Available Capacity Mounted on\n/dev/sda1         41152736 40104160   1048576      98% /\n"
+			Expect(DiskSpace("/var/lib", resource.MustParse("10Gi")).Fn(ctx)).To(MatchError(ContainSubstring("1Gi available, at least 10Gi required")))
+		})
+
+		It("should fail if the output cannot be parsed", func() {
+			output = "foo"
+			Expect(DiskSpace("/var/lib", resource.MustParse("10Gi")).Fn(ctx)).To(MatchError(ContainSubstring("failed parsing output")))
+		})
+	})
+
+	Describe("#Hostname", func() {
+		BeforeEach(func() {
+			DeferCleanup(test.WithVar(&LookupHost, func(_ context.Context, host string) ([]string, error) {
+				if host == "machine-0" {
+					return []string{"10.0.0.1"}, nil
+				}
+				return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+			}))
+		})
+
+		It("should succeed for a valid and resolvable hostname", func() {
+			Expect(Hostname("machine-0").Fn(ctx)).To(Succeed())
+		})
+
+		It("should fail for an invalid hostname", func() {
+			Expect(Hostname("Machine_0").Fn(ctx)).To(MatchError(ContainSubstring(`hostname "Machine_0" is not a valid node name`)))
+		})
+
+		It("should fail for a hostname which cannot be resolved", func() {
+			Expect(Hostname("machine-1").Fn(ctx)).To(MatchError(ContainSubstring(`hostname "machine-1" cannot be resolved`)))
+		})
+	})
+
+	Describe("#BootstrapToken", func() {
+		var createErr error
+
+		newClientSet := func() kubernetes.Interface {
+			return fakekubernetes.NewClientSetBuilder().WithClient(fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.CreateOption) error {
+					return createErr
+				},
+			}).Build()).Build()
+		}
+
+		BeforeEach(func() {
+			createErr = nil
+		})
+
+		It("should succeed for a valid token", func() {
+			Expect(BootstrapToken(newClientSet(), "abcdef.0123456789abcdef").Fn(ctx)).To(Succeed())
+		})
+
+		It("should fail for a malformed token", func() {
+			Expect(BootstrapToken(newClientSet(), "foo").Fn(ctx)).To(MatchError(ContainSubstring("bootstrap token does not match the expected format")))
+		})
+
+		It("should fail if the token is rejected by the control plane", func() {
+			createErr = apierrors.NewUnauthorized("invalid token")
+			Expect(BootstrapToken(newClientSet(), "abcdef.0123456789abcdef").Fn(ctx)).To(MatchError(ContainSubstring("bootstrap token was rejected by the control plane")))
+		})
+
+		It("should fail if the control plane cannot be reached", func() {
+			createErr = errors.New("connection refused")
+			Expect(BootstrapToken(newClientSet(), "abcdef.0123456789abcdef").Fn(ctx)).To(MatchError(ContainSubstring("connection refused")))
+		})
+	})
+})
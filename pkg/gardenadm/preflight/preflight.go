@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// CheckKernelModules is the name of the check verifying that the required kernel modules are loaded.
+	CheckKernelModules = "KernelModules"
+	// CheckCgroupV2 is the name of the check verifying that the machine uses the unified cgroup hierarchy (cgroup v2).
+	CheckCgroupV2 = "CgroupV2"
+	// CheckSwap is the name of the check verifying that swap is disabled (unless kubelet is configured to tolerate it).
+	CheckSwap = "Swap"
+	// CheckPorts is the name of the check verifying that the ports needed by the node's components are available.
+	CheckPorts = "Ports"
+	// CheckTimeSync is the name of the check verifying that the system clock is synchronized.
+	CheckTimeSync = "TimeSync"
+	// CheckContainerRuntime is the name of the check verifying that the container runtime is installed.
+	CheckContainerRuntime = "ContainerRuntime"
+	// CheckDiskSpace is the name of the check verifying that enough disk space is available.
+	CheckDiskSpace = "DiskSpace"
+	// CheckHostname is the name of the check verifying that the hostname is valid and can be resolved.
+	CheckHostname = "Hostname"
+	// CheckBootstrapToken is the name of the check verifying that the bootstrap token is valid.
+	CheckBootstrapToken = "BootstrapToken"
+
+	// IgnoreAll can be passed to Run to ignore the failures of all checks.
+	IgnoreAll = "all"
+)
+
+// AllCheckNames contains the names of all known checks.
+var AllCheckNames = sets.New(
+	CheckKernelModules,
+	CheckCgroupV2,
+	CheckSwap,
+	CheckPorts,
+	CheckTimeSync,
+	CheckContainerRuntime,
+	CheckDiskSpace,
+	CheckHostname,
+	CheckBootstrapToken,
+)
+
+// Check is a single preflight check validating that the machine fulfills a requirement before gardenadm starts mutating
+// it.
+type Check struct {
+	// Name is the name of the check. It can be passed to the --ignore-preflight-errors flag to ignore its failures.
+	Name string
+	// Fn performs the check. It returns an error if the requirement is not fulfilled.
+	Fn func(context.Context) error
+}
+
+// Run executes all given checks and reports all failures at once. Failures of checks whose names are contained in
+// ignoredChecks (compared case-insensitively) are only logged. If ignoredChecks contains IgnoreAll, no failure is
+// reported.
+func Run(ctx context.Context, log logr.Logger, ignoredChecks sets.Set[string], checks ...Check) error {
+	ignored := sets.New[string]()
+	for name := range ignoredChecks {
+		ignored.Insert(strings.ToLower(name))
+	}
+
+	var errs []error
+
+	for _, check := range checks {
+		log.V(1).Info("Running preflight check", "check", check.Name)
+
+		if err := check.Fn(ctx); err != nil {
+			if ignored.Has(IgnoreAll) || ignored.Has(strings.ToLower(check.Name)) {
+				log.Info("Ignoring failed preflight check", "check", check.Name, "error", err.Error())
+				continue
+			}
+
+			errs = append(errs, fmt.Errorf("[%s] %w", check.Name, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("preflight checks failed, fix the reported issues or ignore them with --ignore-preflight-errors=<check>,...:\n%w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// ValidateIgnoredChecks validates that the given names of ignored checks are known.
+func ValidateIgnoredChecks(names []string) error {
+	known := sets.New(strings.ToLower(IgnoreAll))
+	for name := range AllCheckNames {
+		known.Insert(strings.ToLower(name))
+	}
+
+	for _, name := range names {
+		if !known.Has(strings.ToLower(name)) {
+			return fmt.Errorf("unknown preflight check %q, known checks are %s and %q", name, strings.Join(sets.List(AllCheckNames), ", "), IgnoreAll)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/preflight"
+)
+
+var _ = Describe("Preflight", func() {
+	var (
+		ctx = context.Background()
+		log = logr.Discard()
+
+		executed []string
+		checks   []Check
+	)
+
+	BeforeEach(func() {
+		executed = nil
+
+		newCheck := func(name string, err error) Check {
+			return Check{Name: name, Fn: func(_ context.Context) error {
+				executed = append(executed, name)
+				return err
+			}}
+		}
+
+		checks = []Check{
+			newCheck(CheckSwap, errors.New("swap is on")),
+			newCheck(CheckPorts, nil),
+			newCheck(CheckTimeSync, errors.New("clock is off")),
+		}
+	})
+
+	Describe("#Run", func() {
+		It("should succeed if all checks pass", func() {
+			Expect(Run(ctx, log, nil, checks[1])).To(Succeed())
+			Expect(executed).To(ConsistOf(CheckPorts))
+		})
+
+		It("should run all checks and report all failures at once", func() {
+			err := Run(ctx, log, nil, checks...)
+			Expect(err).To(MatchError(ContainSubstring("[Swap] swap is on")))
+			Expect(err).To(MatchError(ContainSubstring("[TimeSync] clock is off")))
+			Expect(err).To(MatchError(ContainSubstring("--ignore-preflight-errors")))
+			Expect(executed).To(Equal([]string{CheckSwap, CheckPorts, CheckTimeSync}))
+		})
+
+		It("should not report failures of ignored checks (case-insensitively)", func() {
+			err := Run(ctx, log, sets.New("swap"), checks...)
+			Expect(err).To(MatchError(ContainSubstring("[TimeSync] clock is off")))
+			Expect(err).NotTo(MatchError(ContainSubstring("Swap")))
+			Expect(executed).To(Equal([]string{CheckSwap, CheckPorts, CheckTimeSync}))
+		})
+
+		It("should not report any failure if all checks are ignored", func() {
+			Expect(Run(ctx, log, sets.New("ALL"), checks...)).To(Succeed())
+			Expect(executed).To(Equal([]string{CheckSwap, CheckPorts, CheckTimeSync}))
+		})
+	})
+
+	Describe("#ValidateIgnoredChecks", func() {
+		It("should succeed for known checks", func() {
+			Expect(ValidateIgnoredChecks(nil)).To(Succeed())
+			Expect(ValidateIgnoredChecks([]string{"Swap", "timesync", "all"})).To(Succeed())
+		})
+
+		It("should fail for unknown checks", func() {
+			Expect(ValidateIgnoredChecks([]string{"Swap", "foo"})).To(MatchError(ContainSubstring(`unknown preflight check "foo"`)))
+		})
+	})
+})
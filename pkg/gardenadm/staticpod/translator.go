@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"slices"
+	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -192,3 +193,35 @@ func translateVolumes(ctx context.Context, c client.Client, pod *corev1.Pod, sou
 func StatefulSetVolumeClaimTemplateHostPath(volumeClaimTemplateName string) string {
 	return fmt.Sprintf("/var/lib/%s/data", volumeClaimTemplateName)
 }
+
+// HostPathDirectories returns the directories on the host which are used by the volumes of the given static pod. These
+// are the directories for the files of ConfigMap, Secret and projected volumes, as well as the directories of
+// translated StatefulSet VolumeClaimTemplates.
+func HostPathDirectories(pod *corev1.Pod) []string {
+	var (
+		podDirectory = filepath.Join(string(filepath.Separator), "var", "lib", pod.Name)
+		directories  []string
+	)
+
+	for _, volume := range pod.Spec.Volumes {
+		if volume.HostPath == nil {
+			continue
+		}
+
+		var directory string
+		switch {
+		case strings.HasPrefix(volume.HostPath.Path, podDirectory+string(filepath.Separator)):
+			directory = podDirectory
+		case volume.HostPath.Path == StatefulSetVolumeClaimTemplateHostPath(volume.Name):
+			directory = filepath.Dir(volume.HostPath.Path)
+		default:
+			continue
+		}
+
+		if !slices.Contains(directories, directory) {
+			directories = append(directories, directory)
+		}
+	}
+
+	return directories
+}
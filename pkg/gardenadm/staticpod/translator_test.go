@@ -910,6 +910,29 @@ kind: Config
 			Expect(StatefulSetVolumeClaimTemplateHostPath("foo")).To(Equal("/var/lib/foo/data"))
 		})
 	})
+
+	Describe("#HostPathDirectories", func() {
+		It("should return the directories of the translated volumes", func() {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd-main"},
+				Spec: corev1.PodSpec{
+					Volumes: []corev1.Volume{
+						{Name: "config", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/lib/etcd-main/config"}}},
+						{Name: "ca", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/lib/etcd-main/ca"}}},
+						{Name: "main-etcd", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/lib/main-etcd/data"}}},
+						{Name: "host", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/lib/foo"}}},
+						{Name: "empty", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+					},
+				},
+			}
+
+			Expect(HostPathDirectories(pod)).To(ConsistOf("/var/lib/etcd-main", "/var/lib/main-etcd"))
+		})
+
+		It("should return nothing if the pod has no translated volumes", func() {
+			Expect(HostPathDirectories(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "foo"}})).To(BeEmpty())
+		})
+	})
 })
 
 func foobarThePod(pod *corev1.Pod) {
@@ -5,16 +5,24 @@
 package helper_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
 var _ = Describe("helper", func() {
@@ -279,4 +287,84 @@ var _ = Describe("helper", func() {
 			Expect(GetManagedResourceProgressingThreshold(gardenletConfig)).To(Equal(threshold))
 		})
 	})
+
+	Describe("#NewCAKeyBackend", func() {
+		It("should return nil if no key backend is configured", func() {
+			Expect(NewCAKeyBackend(nil)).To(BeNil())
+			Expect(NewCAKeyBackend(&gardenletconfigv1alpha1.CAKeyBackendConfiguration{})).To(BeNil())
+		})
+
+		It("should return the Vault key backend", func() {
+			backend, err := NewCAKeyBackend(&gardenletconfigv1alpha1.CAKeyBackendConfiguration{
+				Vault: &gardenletconfigv1alpha1.VaultCAKeyBackend{
+					Address:   "https://vault.example.com:8200",
+					TokenFile: "/var/run/secrets/vault/token",
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(backend.Name()).To(Equal(secretsutils.KeyBackendNameVault))
+		})
+	})
+
+	Describe("#NewHelmRegistryOptions", func() {
+		It("should return a cache and no verifier for an empty configuration", func() {
+			opts, err := NewHelmRegistryOptions(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(opts.Cache).NotTo(BeNil())
+			Expect(opts.Verifier).To(BeNil())
+			Expect(opts.Mirrors).To(BeEmpty())
+		})
+
+		It("should configure the cache, the verifier and the registry mirrors", func() {
+			privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			publicKey, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+			Expect(err).NotTo(HaveOccurred())
+
+			opts, err := NewHelmRegistryOptions(&gardenletconfigv1alpha1.OCIConfiguration{
+				Cache:        &gardenletconfigv1alpha1.OCICacheConfiguration{MaxSize: ptr.To(resource.MustParse("1Mi")), Directory: ptr.To(GinkgoT().TempDir())},
+				Verification: &gardenletconfigv1alpha1.OCIVerificationConfiguration{PublicKeys: []string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}))}},
+				Mirrors:      []gardenletconfigv1alpha1.OCIRegistryMirror{{Source: "registry.k8s.io", Mirror: "registry.example.com/k8s"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(opts.Cache).NotTo(BeNil())
+			Expect(opts.Verifier).NotTo(BeNil())
+			Expect(opts.Mirrors).To(Equal(imagevector.Mirrors{{Source: "registry.k8s.io", Mirror: "registry.example.com/k8s"}}))
+		})
+
+		It("should fail for an invalid cache size", func() {
+			_, err := NewHelmRegistryOptions(&gardenletconfigv1alpha1.OCIConfiguration{
+				Cache: &gardenletconfigv1alpha1.OCICacheConfiguration{MaxSize: ptr.To(resource.MustParse("0"))},
+			})
+			Expect(err).To(MatchError(ContainSubstring("failed configuring cache for OCI artifacts")))
+		})
+
+		It("should fail for invalid public keys", func() {
+			_, err := NewHelmRegistryOptions(&gardenletconfigv1alpha1.OCIConfiguration{
+				Verification: &gardenletconfigv1alpha1.OCIVerificationConfiguration{PublicKeys: []string{"foo"}},
+			})
+			Expect(err).To(MatchError(ContainSubstring("failed configuring signature verification for OCI artifacts")))
+		})
+	})
+
+	Describe("#RegistryMirrors", func() {
+		It("should return nil if no mirrors are configured", func() {
+			Expect(RegistryMirrors(nil)).To(BeNil())
+			Expect(RegistryMirrors(&gardenletconfigv1alpha1.OCIConfiguration{})).To(BeNil())
+		})
+
+		It("should return the configured mirrors", func() {
+			Expect(RegistryMirrors(&gardenletconfigv1alpha1.OCIConfiguration{
+				Mirrors: []gardenletconfigv1alpha1.OCIRegistryMirror{
+					{Source: "europe-docker.pkg.dev/gardener-project", Mirror: "registry.example.com/gardener"},
+					{Source: "registry.k8s.io", Mirror: "registry.example.com/k8s"},
+				},
+			})).To(Equal(imagevector.Mirrors{
+				{Source: "europe-docker.pkg.dev/gardener-project", Mirror: "registry.example.com/gardener"},
+				{Source: "registry.k8s.io", Mirror: "registry.example.com/k8s"},
+			}))
+		})
+	})
 })
@@ -85,6 +85,17 @@ type GardenletConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeToleration `json:"nodeToleration,omitempty"`
+	// Tracing contains optional settings for exporting traces of the shoot and seed operations.
+	// +optional
+	Tracing *TracingConfiguration `json:"tracing,omitempty"`
+	// OCI contains optional settings for pulling OCI artifacts, e.g., the Helm charts of extensions.
+	// +optional
+	OCI *OCIConfiguration `json:"oci,omitempty"`
+	// CAKeyBackend contains optional settings for a backend holding the private keys of the certificate authorities
+	// generated by gardenlet. If it is set, the secrets of newly generated CAs only contain references to the private
+	// keys.
+	// +optional
+	CAKeyBackend *CAKeyBackendConfiguration `json:"caKeyBackend,omitempty"`
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -539,6 +550,9 @@ type ServerConfiguration struct {
 	// Metrics is the configuration for serving the metrics endpoint.
 	// +optional
 	Metrics *Server `json:"metrics,omitempty"`
+	// EnableDebugHandlers determines whether the /debug/ handlers are served on the metrics endpoint.
+	// +optional
+	EnableDebugHandlers *bool `json:"enableDebugHandlers,omitempty"`
 }
 
 // Server contains information for HTTP(S) server configuration.
@@ -752,3 +766,79 @@ type NodeToleration struct {
 	// +optional
 	DefaultUnreachableTolerationSeconds *int64 `json:"defaultUnreachableTolerationSeconds,omitempty"`
 }
+
+// TracingConfiguration contains settings for exporting traces via the OpenTelemetry protocol (OTLP).
+type TracingConfiguration struct {
+	// Endpoint is the address (`host:port`) of the OTLP/gRPC endpoint to which the traces are exported.
+	Endpoint string `json:"endpoint"`
+	// Insecure specifies whether the connection to the endpoint is established without TLS.
+	// +optional
+	Insecure *bool `json:"insecure,omitempty"`
+}
+
+// OCIConfiguration contains settings for pulling OCI artifacts.
+type OCIConfiguration struct {
+	// Cache contains settings for the cache of pulled Helm charts.
+	// +optional
+	Cache *OCICacheConfiguration `json:"cache,omitempty"`
+	// Verification contains settings for verifying the signatures of pulled Helm charts.
+	// +optional
+	Verification *OCIVerificationConfiguration `json:"verification,omitempty"`
+	// Mirrors is a list of registry mirrors. The repositories of all container images and Helm charts matching the
+	// source of a mirror are rewritten to the mirror, while tags and digests are preserved. If multiple sources match,
+	// the longest one is used.
+	// +optional
+	Mirrors []OCIRegistryMirror `json:"mirrors,omitempty"`
+}
+
+// OCIRegistryMirror maps a registry or repository prefix to the location of its mirror.
+type OCIRegistryMirror struct {
+	// Source is the registry or repository prefix which is mirrored, e.g., `europe-docker.pkg.dev/gardener-project`.
+	Source string `json:"source"`
+	// Mirror is the registry or repository prefix replacing the source, e.g., `registry.example.com/gardener`.
+	Mirror string `json:"mirror"`
+}
+
+// OCICacheConfiguration contains settings for the cache of pulled Helm charts.
+type OCICacheConfiguration struct {
+	// MaxSize is the maximum total size of the Helm charts kept in memory. If Directory is set, the same limit applies
+	// to the Helm charts persisted in it. Defaults to 100Mi.
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+	// Directory is the path of a directory in which pulled Helm charts are persisted, so that they don't need to be
+	// pulled again after a restart. It should be backed by a volume, e.g., an emptyDir.
+	// +optional
+	Directory *string `json:"directory,omitempty"`
+}
+
+// CAKeyBackendConfiguration contains settings for a backend holding the private keys of certificate authorities.
+type CAKeyBackendConfiguration struct {
+	// Vault configures a backend holding the private keys in the transit secrets engine of HashiCorp Vault (or a
+	// compatible implementation like OpenBao).
+	// +optional
+	Vault *VaultCAKeyBackend `json:"vault,omitempty"`
+}
+
+// VaultCAKeyBackend contains settings for a backend holding the private keys in the transit secrets engine of
+// HashiCorp Vault.
+type VaultCAKeyBackend struct {
+	// Address is the address of the Vault server, e.g., `https://vault.example.com:8200`.
+	Address string `json:"address"`
+	// MountPath is the path at which the transit secrets engine is mounted. Defaults to `transit`.
+	// +optional
+	MountPath *string `json:"mountPath,omitempty"`
+	// TokenFile is the path to the file containing the token for authenticating against Vault. It is read for every
+	// request, so that the token can be rotated.
+	TokenFile string `json:"tokenFile"`
+	// CAFile is the path to a file containing the PEM-encoded CA bundle for verifying the server certificate of Vault.
+	// If it is not set, the system trust store is used.
+	// +optional
+	CAFile *string `json:"caFile,omitempty"`
+}
+
+// OCIVerificationConfiguration contains settings for verifying the signatures of pulled Helm charts.
+type OCIVerificationConfiguration struct {
+	// PublicKeys is a list of PEM-encoded ECDSA, RSA, or Ed25519 public keys. Only Helm charts with a cosign signature
+	// which can be verified with one of the keys are accepted.
+	PublicKeys []string `json:"publicKeys"`
+}
@@ -7,6 +7,9 @@ package validation
 import (
 	"fmt"
 	"net"
+	"net/url"
+	"path/filepath"
+	"strings"
 	"time"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -21,6 +24,7 @@ import (
 	gardencorevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/utils"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 )
@@ -116,6 +120,10 @@ func ValidateGardenletConfiguration(cfg *gardenletconfigv1alpha1.GardenletConfig
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(ptr.Deref(nodeTolerationCfg.DefaultUnreachableTolerationSeconds, 0), nodeTolerationConfigPath.Child("defaultUnreachableTolerationSeconds"))...)
 	}
 
+	allErrs = append(allErrs, validateTracingConfiguration(cfg.Tracing, fldPath.Child("tracing"))...)
+	allErrs = append(allErrs, ValidateOCIConfiguration(cfg.OCI, fldPath.Child("oci"))...)
+	allErrs = append(allErrs, validateCAKeyBackendConfiguration(cfg.CAKeyBackend, fldPath.Child("caKeyBackend"))...)
+
 	return allErrs
 }
 
@@ -343,3 +351,110 @@ func validateExposureClassHandlers(handlers []gardenletconfigv1alpha1.ExposureCl
 
 	return allErrs
 }
+
+func validateTracingConfiguration(conf *gardenletconfigv1alpha1.TracingConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf == nil {
+		return allErrs
+	}
+
+	if len(conf.Endpoint) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("endpoint"), "must provide an endpoint"))
+	} else if _, _, err := net.SplitHostPort(conf.Endpoint); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("endpoint"), conf.Endpoint, fmt.Sprintf("must be of the form host:port: %v", err)))
+	}
+
+	return allErrs
+}
+
+// ValidateOCIConfiguration validates the configuration for pulling OCI artifacts.
+func ValidateOCIConfiguration(conf *gardenletconfigv1alpha1.OCIConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf == nil {
+		return allErrs
+	}
+
+	if cache := conf.Cache; cache != nil {
+		cachePath := fldPath.Child("cache")
+
+		if cache.MaxSize != nil && cache.MaxSize.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(cachePath.Child("maxSize"), cache.MaxSize.String(), "must be greater than 0"))
+		}
+		if cache.Directory != nil && !filepath.IsAbs(*cache.Directory) {
+			allErrs = append(allErrs, field.Invalid(cachePath.Child("directory"), *cache.Directory, "must be an absolute path"))
+		}
+	}
+
+	if verification := conf.Verification; verification != nil {
+		publicKeysPath := fldPath.Child("verification", "publicKeys")
+
+		if len(verification.PublicKeys) == 0 {
+			allErrs = append(allErrs, field.Required(publicKeysPath, "must provide at least one public key"))
+		}
+		for i, publicKey := range verification.PublicKeys {
+			if _, err := utils.DecodePublicKey([]byte(publicKey)); err != nil {
+				allErrs = append(allErrs, field.Invalid(publicKeysPath.Index(i), "<public key>", fmt.Sprintf("must be a PEM-encoded ECDSA, RSA, or Ed25519 public key: %v", err)))
+			}
+		}
+	}
+
+	sources := sets.New[string]()
+	for i, mirror := range conf.Mirrors {
+		idxPath := fldPath.Child("mirrors").Index(i)
+
+		allErrs = append(allErrs, validateRepositoryPrefix(mirror.Source, idxPath.Child("source"))...)
+		allErrs = append(allErrs, validateRepositoryPrefix(mirror.Mirror, idxPath.Child("mirror"))...)
+
+		source := strings.TrimSuffix(mirror.Source, "/")
+		if sources.Has(source) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("source"), mirror.Source))
+		}
+		sources.Insert(source)
+	}
+
+	return allErrs
+}
+
+func validateCAKeyBackendConfiguration(conf *gardenletconfigv1alpha1.CAKeyBackendConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf == nil {
+		return allErrs
+	}
+
+	if conf.Vault == nil {
+		return append(allErrs, field.Required(fldPath.Child("vault"), "must configure a key backend"))
+	}
+
+	vaultPath := fldPath.Child("vault")
+	if u, err := url.ParseRequestURI(conf.Vault.Address); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(vaultPath.Child("address"), conf.Vault.Address, "must be an http or https URL"))
+	}
+	if !filepath.IsAbs(conf.Vault.TokenFile) {
+		allErrs = append(allErrs, field.Invalid(vaultPath.Child("tokenFile"), conf.Vault.TokenFile, "must be an absolute path"))
+	}
+	if conf.Vault.CAFile != nil && !filepath.IsAbs(*conf.Vault.CAFile) {
+		allErrs = append(allErrs, field.Invalid(vaultPath.Child("caFile"), *conf.Vault.CAFile, "must be an absolute path"))
+	}
+
+	return allErrs
+}
+
+// validateRepositoryPrefix validates that the given value is a registry or repository prefix without scheme, tag, or
+// digest.
+func validateRepositoryPrefix(value string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch {
+	case strings.TrimSuffix(value, "/") == "":
+		allErrs = append(allErrs, field.Required(fldPath, "must provide a registry or repository"))
+	case strings.Contains(value, "://"):
+		allErrs = append(allErrs, field.Invalid(fldPath, value, "must not contain a scheme"))
+	case strings.Contains(value, "@") || (strings.Contains(value, "/") && strings.LastIndex(value, ":") > strings.Index(value, "/")):
+		allErrs = append(allErrs, field.Invalid(fldPath, value, "must not contain a tag or digest"))
+	}
+
+	return allErrs
+}
@@ -767,6 +767,192 @@ var _ = Describe("GardenletConfiguration", func() {
 				)
 			})
 		})
+
+		Context("tracing", func() {
+			It("should pass with unset tracing configuration", func() {
+				cfg.Tracing = nil
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should pass with valid tracing configuration", func() {
+				cfg.Tracing = &gardenletconfigv1alpha1.TracingConfiguration{Endpoint: "otel-collector.garden.svc:4317", Insecure: ptr.To(true)}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should fail with missing endpoint", func() {
+				cfg.Tracing = &gardenletconfigv1alpha1.TracingConfiguration{}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("tracing.endpoint"),
+					})),
+				))
+			})
+
+			It("should fail with invalid endpoint", func() {
+				cfg.Tracing = &gardenletconfigv1alpha1.TracingConfiguration{Endpoint: "otel-collector.garden.svc"}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("tracing.endpoint"),
+					})),
+				))
+			})
+		})
+
+		Context("oci", func() {
+			const publicKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAES5Uwza/veQxmhm2ryhPXzkXUJ3gk
+HDOGB+mkshU7p/y5Zx5olfRrrWuZdXPpoOsEKtWJBVH1gZDeOKG+qMgdwg==
+-----END PUBLIC KEY-----
+`
+
+			It("should pass with valid OCI configuration", func() {
+				cfg.OCI = &gardenletconfigv1alpha1.OCIConfiguration{
+					Cache: &gardenletconfigv1alpha1.OCICacheConfiguration{
+						MaxSize:   ptr.To(resource.MustParse("100Mi")),
+						Directory: ptr.To("/var/cache/gardenlet/oci"),
+					},
+					Verification: &gardenletconfigv1alpha1.OCIVerificationConfiguration{PublicKeys: []string{publicKey}},
+					Mirrors: []gardenletconfigv1alpha1.OCIRegistryMirror{
+						{Source: "europe-docker.pkg.dev/gardener-project", Mirror: "registry.example.com:5000/gardener"},
+						{Source: "registry.k8s.io", Mirror: "registry.example.com:5000/k8s"},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should fail with invalid cache configuration", func() {
+				cfg.OCI = &gardenletconfigv1alpha1.OCIConfiguration{
+					Cache: &gardenletconfigv1alpha1.OCICacheConfiguration{
+						MaxSize:   ptr.To(resource.MustParse("0")),
+						Directory: ptr.To("cache"),
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("oci.cache.maxSize"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("oci.cache.directory"),
+					})),
+				))
+			})
+
+			It("should fail with missing public keys", func() {
+				cfg.OCI = &gardenletconfigv1alpha1.OCIConfiguration{Verification: &gardenletconfigv1alpha1.OCIVerificationConfiguration{}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("oci.verification.publicKeys"),
+					})),
+				))
+			})
+
+			It("should fail with invalid public keys", func() {
+				cfg.OCI = &gardenletconfigv1alpha1.OCIConfiguration{Verification: &gardenletconfigv1alpha1.OCIVerificationConfiguration{PublicKeys: []string{publicKey, "foo"}}}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("oci.verification.publicKeys[1]"),
+					})),
+				))
+			})
+
+			It("should fail with invalid mirrors", func() {
+				cfg.OCI = &gardenletconfigv1alpha1.OCIConfiguration{
+					Mirrors: []gardenletconfigv1alpha1.OCIRegistryMirror{
+						{Source: "registry.k8s.io", Mirror: ""},
+						{Source: "https://quay.io", Mirror: "registry.example.com/quay:v1"},
+						{Source: "registry.k8s.io/", Mirror: "registry.example.com@sha256:foo"},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("oci.mirrors[0].mirror"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("oci.mirrors[1].source"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("oci.mirrors[1].mirror"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("oci.mirrors[2].mirror"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("oci.mirrors[2].source"),
+					})),
+				))
+			})
+		})
+
+		Context("CA key backend", func() {
+			It("should pass with valid Vault configuration", func() {
+				cfg.CAKeyBackend = &gardenletconfigv1alpha1.CAKeyBackendConfiguration{
+					Vault: &gardenletconfigv1alpha1.VaultCAKeyBackend{
+						Address:   "https://vault.example.com:8200",
+						MountPath: ptr.To("gardener/transit"),
+						TokenFile: "/var/run/secrets/vault/token",
+						CAFile:    ptr.To("/var/run/secrets/vault/ca.crt"),
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should fail if no backend is configured", func() {
+				cfg.CAKeyBackend = &gardenletconfigv1alpha1.CAKeyBackendConfiguration{}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("caKeyBackend.vault"),
+					})),
+				))
+			})
+
+			It("should fail with invalid Vault configuration", func() {
+				cfg.CAKeyBackend = &gardenletconfigv1alpha1.CAKeyBackendConfiguration{
+					Vault: &gardenletconfigv1alpha1.VaultCAKeyBackend{
+						Address:   "vault.example.com",
+						TokenFile: "token",
+						CAFile:    ptr.To("ca.crt"),
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("caKeyBackend.vault.address"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("caKeyBackend.vault.tokenFile"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("caKeyBackend.vault.caFile"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateGardenletConfigurationUpdate", func() {
@@ -131,6 +131,27 @@ func (in *BastionControllerConfiguration) DeepCopy() *BastionControllerConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CAKeyBackendConfiguration) DeepCopyInto(out *CAKeyBackendConfiguration) {
+	*out = *in
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultCAKeyBackend)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAKeyBackendConfiguration.
+func (in *CAKeyBackendConfiguration) DeepCopy() *CAKeyBackendConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CAKeyBackendConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionThreshold) DeepCopyInto(out *ConditionThreshold) {
 	*out = *in
@@ -501,6 +522,21 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(NodeToleration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(TracingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CAKeyBackend != nil {
+		in, out := &in.CAKeyBackend, &out.CAKeyBackend
+		*out = new(CAKeyBackendConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -860,6 +896,100 @@ func (in *NodeToleration) DeepCopy() *NodeToleration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCICacheConfiguration) DeepCopyInto(out *OCICacheConfiguration) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Directory != nil {
+		in, out := &in.Directory, &out.Directory
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCICacheConfiguration.
+func (in *OCICacheConfiguration) DeepCopy() *OCICacheConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(OCICacheConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIConfiguration) DeepCopyInto(out *OCIConfiguration) {
+	*out = *in
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(OCICacheConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(OCIVerificationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mirrors != nil {
+		in, out := &in.Mirrors, &out.Mirrors
+		*out = make([]OCIRegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIConfiguration.
+func (in *OCIConfiguration) DeepCopy() *OCIConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIRegistryMirror) DeepCopyInto(out *OCIRegistryMirror) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIRegistryMirror.
+func (in *OCIRegistryMirror) DeepCopy() *OCIRegistryMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIRegistryMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIVerificationConfiguration) DeepCopyInto(out *OCIVerificationConfiguration) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIVerificationConfiguration.
+func (in *OCIVerificationConfiguration) DeepCopy() *OCIVerificationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIVerificationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteWriteMonitoringConfig) DeepCopyInto(out *RemoteWriteMonitoringConfig) {
 	*out = *in
@@ -1090,6 +1220,11 @@ func (in *ServerConfiguration) DeepCopyInto(out *ServerConfiguration) {
 		*out = new(Server)
 		**out = **in
 	}
+	if in.EnableDebugHandlers != nil {
+		in, out := &in.EnableDebugHandlers, &out.EnableDebugHandlers
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1402,6 +1537,27 @@ func (in *TokenRequestorWorkloadIdentityControllerConfiguration) DeepCopy() *Tok
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingConfiguration) DeepCopyInto(out *TracingConfiguration) {
+	*out = *in
+	if in.Insecure != nil {
+		in, out := &in.Insecure, &out.Insecure
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingConfiguration.
+func (in *TracingConfiguration) DeepCopy() *TracingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPAEvictionRequirementsControllerConfiguration) DeepCopyInto(out *VPAEvictionRequirementsControllerConfiguration) {
 	*out = *in
@@ -1448,3 +1604,29 @@ func (in *Vali) DeepCopy() *Vali {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultCAKeyBackend) DeepCopyInto(out *VaultCAKeyBackend) {
+	*out = *in
+	if in.MountPath != nil {
+		in, out := &in.MountPath, &out.MountPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.CAFile != nil {
+		in, out := &in.CAFile, &out.CAFile
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultCAKeyBackend.
+func (in *VaultCAKeyBackend) DeepCopy() *VaultCAKeyBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultCAKeyBackend)
+	in.DeepCopyInto(out)
+	return out
+}
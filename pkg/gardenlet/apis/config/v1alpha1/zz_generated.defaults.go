@@ -133,4 +133,9 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 			SetDefaults_ShootMonitoringConfig(in.Monitoring.Shoot)
 		}
 	}
+	if in.OCI != nil {
+		if in.OCI.Cache != nil {
+			SetDefaults_OCICacheConfiguration(in.OCI.Cache)
+		}
+	}
 }
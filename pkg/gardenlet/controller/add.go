@@ -37,6 +37,8 @@ import (
 	"github.com/gardener/gardener/pkg/healthz"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	gardenletutils "github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
+	"github.com/gardener/gardener/pkg/utils/oci"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
 // AddToManager adds all gardenlet controllers to the given manager.
@@ -49,6 +51,8 @@ func AddToManager(
 	shootClientMap clientmap.ClientMap,
 	cfg *gardenletconfigv1alpha1.GardenletConfiguration,
 	healthManager healthz.Manager,
+	helmRegistry oci.Interface,
+	caKeyBackend secretsutils.KeyBackend,
 ) error {
 	identity, err := gardenerutils.DetermineIdentity()
 	if err != nil {
@@ -99,7 +103,8 @@ func AddToManager(
 		}
 
 		if err := (&gardenlet.Reconciler{
-			Config: *cfg,
+			Config:       *cfg,
+			HelmRegistry: helmRegistry,
 		}).AddToManager(mgr, gardenCluster, seedClientSet); err != nil {
 			return fmt.Errorf("failed adding Gardenlet controller: %w", err)
 		}
@@ -143,12 +148,13 @@ func AddToManager(
 		return fmt.Errorf("failed adding Bastion controller: %w", err)
 	}
 
-	if err := controllerinstallation.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, *cfg, identity, gardenClusterIdentity); err != nil {
+	if err := controllerinstallation.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, *cfg, identity, gardenClusterIdentity, helmRegistry); err != nil {
 		return fmt.Errorf("failed adding ControllerInstallation controller: %w", err)
 	}
 
 	if err := (&gardenlet.Reconciler{
-		Config: *cfg,
+		Config:       *cfg,
+		HelmRegistry: helmRegistry,
 	}).AddToManager(mgr, gardenCluster, seedClientSet); err != nil {
 		return fmt.Errorf("failed adding Gardenlet controller: %w", err)
 	}
@@ -164,11 +170,11 @@ func AddToManager(
 		return fmt.Errorf("failed adding NetworkPolicy controller: %w", err)
 	}
 
-	if err := seed.AddToManager(mgr, gardenCluster, seedCluster, seedClientSet, *cfg, identity, healthManager); err != nil {
+	if err := seed.AddToManager(mgr, gardenCluster, seedCluster, seedClientSet, *cfg, identity, healthManager, caKeyBackend); err != nil {
 		return fmt.Errorf("failed adding Seed controller: %w", err)
 	}
 
-	if err := shoot.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, shootClientMap, *cfg, identity, gardenClusterIdentity, healthManager); err != nil {
+	if err := shoot.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, shootClientMap, *cfg, identity, gardenClusterIdentity, healthManager, caKeyBackend); err != nil {
 		return fmt.Errorf("failed adding Shoot controller: %w", err)
 	}
 
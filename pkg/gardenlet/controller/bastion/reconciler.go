@@ -5,6 +5,7 @@
 package bastion
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -29,6 +30,7 @@ import (
 	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/gardener/gardener/pkg/features"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
@@ -100,6 +102,14 @@ func (r *Reconciler) reconcileBastion(
 		}
 	}
 
+	var sshCA *sshCertificateAuthority
+	if features.DefaultFeatureGate.Enabled(features.SSHCertificateAuthority) {
+		var err error
+		if sshCA, err = r.getSSHCertificateAuthority(seedCtx, shoot); err != nil {
+			return err
+		}
+	}
+
 	extensionBastion := newBastionExtension(bastion, shoot)
 	extensionIngress := make([]extensionsv1alpha1.BastionIngressPolicy, len(bastion.Spec.Ingress))
 	for i, ingress := range bastion.Spec.Ingress {
@@ -115,7 +125,7 @@ func (r *Reconciler) reconcileBastion(
 			DefaultSpec: extensionsv1alpha1.DefaultSpec{
 				Type: *bastion.Spec.ProviderType,
 			},
-			UserData: createUserData(bastion),
+			UserData: createUserData(bastion, sshCA),
 			Ingress:  extensionIngress,
 		}
 	)
@@ -175,14 +185,32 @@ func (r *Reconciler) reconcileBastion(
 	}
 
 	if extensionBastion.Status.LastOperation != nil && extensionBastion.Status.LastOperation.State == gardencorev1beta1.LastOperationStateSucceeded {
+		var sshCertificate *string
+		if sshCA != nil {
+			var err error
+			if sshCertificate, err = r.sshCertificate(bastion, sshCA); err != nil {
+				if patchErr := patchReadyCondition(gardenCtx, r.GardenClient, r.Clock, bastion, gardencorev1beta1.ConditionFalse, "FailedReconciling", err.Error()); patchErr != nil {
+					log.Error(patchErr, "Failed patching ready condition")
+				}
+				return err
+			}
+		}
+
 		// copy over the extension's status to the operation bastion and set the condition
 		patch := client.MergeFrom(bastion.DeepCopy())
 		setReadyCondition(r.Clock, bastion, gardencorev1beta1.ConditionTrue, "SuccessfullyReconciled", "The bastion has been reconciled successfully.")
 		bastion.Status.Ingress = extensionBastion.Status.Ingress.DeepCopy()
 		bastion.Status.ObservedGeneration = &bastion.Generation
+		bastion.Status.SSHCertificate = sshCertificate
 		if err := r.GardenClient.Status().Patch(gardenCtx, bastion, patch); err != nil {
 			return fmt.Errorf("failed patching ready condition of Bastion: %w", err)
 		}
+
+		if sshCertificate != nil {
+			// Heartbeats advance the ExpirationTimestamp without changing the generation of the Bastion, hence it is
+			// requeued to renew the certificate accordingly.
+			return &reconcilerutils.RequeueAfterError{RequeueAfter: SSHCertificateRenewalInterval}
+		}
 	}
 
 	return nil
@@ -265,7 +293,11 @@ func patchReadyCondition(ctx context.Context, c client.StatusClient, clock clock
 	return c.Status().Patch(ctx, bastion, patch)
 }
 
-func createUserData(bastion *operationsv1alpha1.Bastion) []byte {
+func createUserData(bastion *operationsv1alpha1.Bastion, sshCA *sshCertificateAuthority) []byte {
+	if sshCA != nil {
+		return createUserDataWithTrustedUserCAKeys(sshCA)
+	}
+
 	userData := fmt.Sprintf(`#!/bin/bash -eu
 
 id gardener || useradd gardener -mU
@@ -278,3 +310,19 @@ systemctl start sshd || systemctl start ssh
 
 	return []byte(userData)
 }
+
+// createUserDataWithTrustedUserCAKeys creates the user data for a bastion host which trusts the SSH certificate
+// authority of the shoot instead of the user's public key, i.e., only the SSH certificate issued for the Bastion can be
+// used to log in.
+func createUserDataWithTrustedUserCAKeys(sshCA *sshCertificateAuthority) []byte {
+	userData := fmt.Sprintf(`#!/bin/bash -eu
+
+id gardener || useradd gardener -mU
+echo "%s" > /etc/ssh/gardener-user-trusted-ca-keys
+sed -i "1i TrustedUserCAKeys /etc/ssh/gardener-user-trusted-ca-keys" /etc/ssh/sshd_config
+echo "gardener ALL=(ALL) NOPASSWD:ALL" >/etc/sudoers.d/99-gardener-user
+systemctl restart sshd || systemctl restart ssh
+`, bytes.Join(sshCA.publicKeys, []byte("\n")))
+
+	return []byte(userData)
+}
@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion_test
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/features"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/bastion"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Reconciler", func() {
+	Describe("SSH certificates", func() {
+		var (
+			ctx          = context.TODO()
+			gardenClient client.Client
+			seedClient   client.Client
+			fakeClock    *testclock.FakeClock
+			reconciler   *Reconciler
+			request      reconcile.Request
+
+			shootTechnicalID = "shoot--" + projectName + "--shoot"
+			projectNamespace = "garden-" + projectName
+
+			ca, user *secretsutils.RSAKeys
+			bastion  *operationsv1alpha1.Bastion
+		)
+
+		generateKeys := func(name string) *secretsutils.RSAKeys {
+			obj, err := (&secretsutils.RSASecretConfig{Name: name, Bits: 2048, UsedForSSH: true}).Generate()
+			Expect(err).NotTo(HaveOccurred())
+			return obj.(*secretsutils.RSAKeys)
+		}
+
+		createCASecret := func(name string, keys *secretsutils.RSAKeys, issuedAt time.Time) {
+			Expect(seedClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: shootTechnicalID,
+					Labels: map[string]string{
+						"name":             "ssh-ca",
+						"managed-by":       "secrets-manager",
+						"manager-identity": "gardenlet",
+						"issued-at-time":   strconv.FormatInt(issuedAt.Unix(), 10),
+					},
+				},
+				Data: keys.SecretData(),
+			})).To(Succeed())
+		}
+
+		markExtensionBastionSucceeded := func() {
+			extensionBastion := &extensionsv1alpha1.Bastion{ObjectMeta: metav1.ObjectMeta{Name: bastionName, Namespace: shootTechnicalID}}
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionBastion), extensionBastion)).To(Succeed())
+			extensionBastion.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded}
+			Expect(seedClient.Status().Update(ctx, extensionBastion)).To(Succeed())
+		}
+
+		parseCertificate := func() *ssh.Certificate {
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(bastion), bastion)).To(Succeed())
+			Expect(bastion.Status.SSHCertificate).NotTo(BeNil())
+
+			publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(*bastion.Status.SSHCertificate))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(publicKey).To(BeAssignableToTypeOf(&ssh.Certificate{}))
+			return publicKey.(*ssh.Certificate)
+		}
+
+		BeforeEach(func() {
+			DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.SSHCertificateAuthority, true))
+
+			gardenClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithStatusSubresource(&operationsv1alpha1.Bastion{}).
+				Build()
+			seedClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.SeedScheme).
+				WithStatusSubresource(&extensionsv1alpha1.Bastion{}).
+				Build()
+			fakeClock = testclock.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+			reconciler = &Reconciler{
+				GardenClient: gardenClient,
+				SeedClient:   seedClient,
+				Clock:        fakeClock,
+			}
+
+			ca = generateKeys("ssh-ca")
+			user = generateKeys("user")
+
+			Expect(gardenClient.Create(ctx, &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: projectNamespace},
+				Status:     gardencorev1beta1.ShootStatus{TechnicalID: shootTechnicalID},
+			})).To(Succeed())
+
+			bastion = &operationsv1alpha1.Bastion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        bastionName,
+					Namespace:   projectNamespace,
+					Annotations: map[string]string{"gardener.cloud/created-by": "foo@example.com"},
+				},
+				Spec: operationsv1alpha1.BastionSpec{
+					ShootRef:     corev1.LocalObjectReference{Name: "shoot"},
+					ProviderType: ptr.To("local"),
+					SSHPublicKey: string(user.OpenSSHAuthorizedKey),
+				},
+			}
+			Expect(gardenClient.Create(ctx, bastion)).To(Succeed())
+			bastion.Status.ExpirationTimestamp = &metav1.Time{Time: fakeClock.Now().Add(time.Hour)}
+			Expect(gardenClient.Status().Update(ctx, bastion)).To(Succeed())
+
+			request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(bastion)}
+		})
+
+		It("should trust the certificate authority on the bastion host and issue a certificate", func() {
+			createCASecret("ssh-ca-1", ca, fakeClock.Now())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			extensionBastion := &extensionsv1alpha1.Bastion{ObjectMeta: metav1.ObjectMeta{Name: bastionName, Namespace: shootTechnicalID}}
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionBastion), extensionBastion)).To(Succeed())
+			Expect(string(extensionBastion.Spec.UserData)).To(And(
+				ContainSubstring(`echo "`+string(ca.OpenSSHAuthorizedKey)+`" > /etc/ssh/gardener-user-trusted-ca-keys`),
+				ContainSubstring("TrustedUserCAKeys /etc/ssh/gardener-user-trusted-ca-keys"),
+				Not(ContainSubstring(string(user.OpenSSHAuthorizedKey))),
+			))
+
+			markExtensionBastionSucceeded()
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: SSHCertificateRenewalInterval}))
+
+			certificate := parseCertificate()
+			Expect(certificate.CertType).To(Equal(uint32(ssh.UserCert)))
+			Expect(certificate.KeyId).To(Equal("foo@example.com (bastion " + projectNamespace + "/" + bastionName + ")"))
+			Expect(certificate.ValidPrincipals).To(ConsistOf("gardener"))
+			Expect(certificate.ValidBefore).To(Equal(uint64(fakeClock.Now().Add(time.Hour).Unix())))
+			Expect(string(ssh.MarshalAuthorizedKey(certificate.Key))).To(Equal(string(user.OpenSSHAuthorizedKey) + "\n"))
+			Expect(string(ssh.MarshalAuthorizedKey(certificate.SignatureKey))).To(Equal(string(ca.OpenSSHAuthorizedKey) + "\n"))
+		})
+
+		It("should keep the certificate as long as the expiration timestamp does not change", func() {
+			createCASecret("ssh-ca-1", ca, fakeClock.Now())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			markExtensionBastionSucceeded()
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: SSHCertificateRenewalInterval}))
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(bastion), bastion)).To(Succeed())
+			Expect(bastion.Status.SSHCertificate).NotTo(BeNil())
+			certificate := *bastion.Status.SSHCertificate
+
+			fakeClock.Step(SSHCertificateRenewalInterval)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: SSHCertificateRenewalInterval}))
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(bastion), bastion)).To(Succeed())
+			Expect(*bastion.Status.SSHCertificate).To(Equal(certificate))
+
+			By("Advance expiration timestamp")
+			bastion.Status.ExpirationTimestamp = &metav1.Time{Time: fakeClock.Now().Add(time.Hour)}
+			Expect(gardenClient.Status().Update(ctx, bastion)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: SSHCertificateRenewalInterval}))
+			Expect(parseCertificate().ValidBefore).To(Equal(uint64(fakeClock.Now().Add(time.Hour).Unix())))
+		})
+
+		It("should sign the certificate with the current certificate authority and trust the old one during rotation", func() {
+			oldCA := generateKeys("ssh-ca-old")
+			createCASecret("ssh-ca-1", oldCA, fakeClock.Now().Add(-time.Hour))
+			createCASecret("ssh-ca-2", ca, fakeClock.Now())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			extensionBastion := &extensionsv1alpha1.Bastion{ObjectMeta: metav1.ObjectMeta{Name: bastionName, Namespace: shootTechnicalID}}
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionBastion), extensionBastion)).To(Succeed())
+			Expect(string(extensionBastion.Spec.UserData)).To(ContainSubstring(`echo "` + string(ca.OpenSSHAuthorizedKey) + "\n" + string(oldCA.OpenSSHAuthorizedKey) + `"`))
+
+			markExtensionBastionSucceeded()
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: SSHCertificateRenewalInterval}))
+			Expect(string(ssh.MarshalAuthorizedKey(parseCertificate().SignatureKey))).To(Equal(string(ca.OpenSSHAuthorizedKey) + "\n"))
+		})
+
+		It("should use the public key of the user if there is no certificate authority", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			extensionBastion := &extensionsv1alpha1.Bastion{ObjectMeta: metav1.ObjectMeta{Name: bastionName, Namespace: shootTechnicalID}}
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionBastion), extensionBastion)).To(Succeed())
+			Expect(string(extensionBastion.Spec.UserData)).To(ContainSubstring(`echo "` + string(user.OpenSSHAuthorizedKey) + `" > /home/gardener/.ssh/authorized_keys`))
+
+			markExtensionBastionSucceeded()
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(bastion), bastion)).To(Succeed())
+			Expect(bastion.Status.SSHCertificate).To(BeNil())
+		})
+
+		It("should not issue a certificate if the feature gate is disabled", func() {
+			DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.SSHCertificateAuthority, false))
+			createCASecret("ssh-ca-1", ca, fakeClock.Now())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			markExtensionBastionSucceeded()
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(bastion), bastion)).To(Succeed())
+			Expect(bastion.Status.SSHCertificate).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+const (
+	// sshUser is the user on the bastion host and the shoot nodes which the SSH certificates are valid for.
+	sshUser = "gardener"
+	// sshCertificateClockSkew is the duration by which the start of the validity period of SSH certificates is moved to
+	// the past to tolerate clock skew between gardenlet and the hosts.
+	sshCertificateClockSkew = 5 * time.Minute
+)
+
+// SSHCertificateRenewalInterval is the interval in which Bastions with SSH certificates are reconciled to renew the
+// certificates after their ExpirationTimestamp was advanced by a heartbeat.
+var SSHCertificateRenewalInterval = 10 * time.Minute
+
+// sshCertificateAuthority contains the secrets of the SSH certificate authority of a shoot.
+type sshCertificateAuthority struct {
+	// current is the secret of the certificate authority which is used to sign certificates.
+	current *corev1.Secret
+	// publicKeys are the public keys of the current and the old certificate authority (if any). Both are trusted by
+	// the nodes during the rotation of the certificate authority.
+	publicKeys [][]byte
+}
+
+// getSSHCertificateAuthority returns the SSH certificate authority of the given shoot. It returns nil if no certificate
+// authority was generated for the shoot.
+func (r *Reconciler) getSSHCertificateAuthority(ctx context.Context, shoot *gardencorev1beta1.Shoot) (*sshCertificateAuthority, error) {
+	secretList := &corev1.SecretList{}
+	if err := r.SeedClient.List(ctx, secretList, client.InNamespace(shoot.Status.TechnicalID), client.MatchingLabels{
+		secretsmanager.LabelKeyName:            v1beta1constants.SecretNameSSHCertificateAuthority,
+		secretsmanager.LabelKeyManagedBy:       secretsmanager.LabelValueSecretsManager,
+		secretsmanager.LabelKeyManagerIdentity: v1beta1constants.SecretManagerIdentityGardenlet,
+	}); err != nil {
+		return nil, fmt.Errorf("failed listing secrets of SSH certificate authority: %w", err)
+	}
+
+	if len(secretList.Items) == 0 {
+		return nil, nil
+	}
+
+	// The most recently issued secret is the current certificate authority, all others are kept during its rotation.
+	slices.SortFunc(secretList.Items, func(a, b corev1.Secret) int {
+		return compareIssuedAtTime(b, a)
+	})
+
+	ca := &sshCertificateAuthority{current: &secretList.Items[0]}
+	for _, secret := range secretList.Items {
+		ca.publicKeys = append(ca.publicKeys, secret.Data[secretsutils.DataKeySSHAuthorizedKeys])
+	}
+
+	return ca, nil
+}
+
+func compareIssuedAtTime(a, b corev1.Secret) int {
+	issuedAtA, _ := strconv.ParseInt(a.Labels[secretsmanager.LabelKeyIssuedAtTime], 10, 64)
+	issuedAtB, _ := strconv.ParseInt(b.Labels[secretsmanager.LabelKeyIssuedAtTime], 10, 64)
+
+	return cmp.Compare(issuedAtA, issuedAtB)
+}
+
+// sshCertificate returns the SSH certificate for the user of the Bastion. It reuses the certificate in the status of
+// the Bastion if it is signed by the current certificate authority and valid until the ExpirationTimestamp of the
+// Bastion. Otherwise, a new certificate is issued. It returns nil if the Bastion has already expired.
+func (r *Reconciler) sshCertificate(bastion *operationsv1alpha1.Bastion, ca *sshCertificateAuthority) (*string, error) {
+	now := r.Clock.Now()
+	if bastion.Status.ExpirationTimestamp == nil || !bastion.Status.ExpirationTimestamp.After(now) {
+		return nil, nil
+	}
+	validBefore := bastion.Status.ExpirationTimestamp.Time
+
+	if bastion.Status.SSHCertificate != nil && isSSHCertificateUpToDate(*bastion.Status.SSHCertificate, ca, validBefore) {
+		return bastion.Status.SSHCertificate, nil
+	}
+
+	certificate, err := secretsutils.SignSSHUserCertificate(ca.current.Data[secretsutils.DataKeyRSAPrivateKey], secretsutils.SSHUserCertificateConfig{
+		PublicKey: []byte(bastion.Spec.SSHPublicKey),
+		// The key ID is logged by sshd when the certificate is used, hence it identifies the user who logged in.
+		KeyID:       fmt.Sprintf("%s (bastion %s/%s)", bastion.Annotations[v1beta1constants.GardenCreatedBy], bastion.Namespace, bastion.Name),
+		Serial:      uint64(now.UnixNano()), // #nosec G115 -- The current time is positive.
+		Principals:  []string{sshUser},
+		ValidAfter:  now.Add(-sshCertificateClockSkew),
+		ValidBefore: validBefore,
+		Extensions:  []string{"permit-pty", "permit-port-forwarding"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed issuing SSH certificate: %w", err)
+	}
+
+	return ptr.To(string(certificate)), nil
+}
+
+func isSSHCertificateUpToDate(data string, ca *sshCertificateAuthority, validBefore time.Time) bool {
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(data))
+	if err != nil {
+		return false
+	}
+
+	certificate, ok := publicKey.(*ssh.Certificate)
+	if !ok {
+		return false
+	}
+
+	return certificate.ValidBefore == uint64(validBefore.Unix()) && // #nosec G115 -- The expiration timestamp is positive.
+		bytes.Equal(bytes.TrimSpace(ssh.MarshalAuthorizedKey(certificate.SignatureKey)), bytes.TrimSpace(ca.current.Data[secretsutils.DataKeySSHAuthorizedKeys]))
+}
@@ -57,7 +57,7 @@ func (r *Reconciler) AddToManager(
 		}
 	}
 	if r.HelmRegistry == nil {
-		r.HelmRegistry = oci.NewHelmRegistry(r.GardenClient)
+		r.HelmRegistry = oci.NewHelmRegistry(r.GardenClient, oci.HelmRegistryOptions{})
 	}
 	if r.ValuesHelper == nil {
 		r.ValuesHelper = gardenletdeployer.NewValuesHelper(&r.Config)
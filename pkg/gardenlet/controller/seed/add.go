@@ -20,6 +20,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/seed"
 	"github.com/gardener/gardener/pkg/healthz"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
 // AddToManager adds all Seed controllers to the given manager.
@@ -31,6 +32,7 @@ func AddToManager(
 	cfg gardenletconfigv1alpha1.GardenletConfiguration,
 	identity *gardencorev1beta1.Gardener,
 	healthManager healthz.Manager,
+	caKeyBackend secretsutils.KeyBackend,
 ) error {
 	var (
 		componentImageVectors imagevectorutils.ComponentImageVectors
@@ -60,6 +62,7 @@ func AddToManager(
 		Config:                cfg,
 		Identity:              identity,
 		ComponentImageVectors: componentImageVectors,
+		CAKeyBackend:          caKeyBackend,
 	}).AddToManager(mgr, gardenCluster); err != nil {
 		return fmt.Errorf("failed adding main reconciler: %w", err)
 	}
@@ -278,6 +278,10 @@ func (r *Reconciler) instantiateComponents(
 	return c, nil
 }
 
+func (r *Reconciler) registryMirrors() imagevectorutils.Mirrors {
+	return gardenlethelper.RegistryMirrors(r.Config.OCI)
+}
+
 func (r *Reconciler) newGardenerResourceManager(seed *gardencorev1beta1.Seed, secretsManager secretsmanager.Interface) (component.DeployWaiter, error) {
 	var defaultNotReadyTolerationSeconds, defaultUnreachableTolerationSeconds *int64
 	if nodeToleration := r.Config.NodeToleration; nodeToleration != nil {
@@ -315,7 +319,7 @@ func (r *Reconciler) newGardenerResourceManager(seed *gardencorev1beta1.Seed, se
 		// TODO(vitanovs): Remove the VPAInPlaceUpdates webhook once the
 		// VPAInPlaceUpdates feature gates is deprecated.
 		VPAInPlaceUpdatesEnabled: features.DefaultFeatureGate.Enabled(features.VPAInPlaceUpdates),
-	})
+	}, r.registryMirrors())
 }
 
 func (r *Reconciler) newIstio(ctx context.Context, seed *seedpkg.Seed, isGardenCluster bool) (component.DeployWaiter, map[string]string, string, error) {
@@ -350,6 +354,7 @@ func (r *Reconciler) newIstio(ctx context.Context, seed *seedpkg.Seed, isGardenC
 		seed.GetInfo().Spec.Provider.Zones,
 		seed.IsDualStack(),
 		r.SeedVersion,
+		r.registryMirrors(),
 	)
 	if err != nil {
 		return nil, nil, "", err
@@ -434,7 +439,7 @@ func (r *Reconciler) newIstio(ctx context.Context, seed *seedpkg.Seed, isGardenC
 }
 
 func (r *Reconciler) newDependencyWatchdogs(seedSettings *gardencorev1beta1.SeedSettings) (dwdWeeder component.DeployWaiter, dwdProber component.DeployWaiter, err error) {
-	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameDependencyWatchdog, imagevectorutils.RuntimeVersion(r.SeedVersion.String()), imagevectorutils.TargetVersion(r.SeedVersion.String()))
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameDependencyWatchdog, imagevectorutils.RuntimeVersion(r.SeedVersion.String()), imagevectorutils.TargetVersion(r.SeedVersion.String()), imagevectorutils.RegistryMirrors(r.registryMirrors()))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -503,7 +508,7 @@ func (r *Reconciler) newDependencyWatchdogs(seedSettings *gardencorev1beta1.Seed
 }
 
 func (r *Reconciler) newSystem(seed *gardencorev1beta1.Seed) (component.DeployWaiter, error) {
-	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNamePauseContainer)
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNamePauseContainer, imagevectorutils.RegistryMirrors(r.registryMirrors()))
 	if err != nil {
 		return nil, err
 	}
@@ -544,6 +549,7 @@ func (r *Reconciler) newVali() (component.Deployer, error) {
 		storage,
 		"",
 		false,
+		r.registryMirrors(),
 	)
 	if err != nil {
 		return nil, err
@@ -583,6 +589,7 @@ func (r *Reconciler) newPlutono(seed *seedpkg.Seed, secretsManager secretsmanage
 		v1beta1helper.SeedSettingVerticalPodAutoscalerEnabled(seed.GetInfo().Spec.Settings),
 		wildcardCertName,
 		seedIsGarden,
+		r.registryMirrors(),
 	)
 }
 
@@ -611,7 +618,7 @@ func (r *Reconciler) newCachePrometheus(log logr.Logger, seed *seedpkg.Seed, see
 			cacheprometheus.NetworkPolicyToNodeExporter(r.GardenNamespace, seed.GetNodeCIDR()),
 			cacheprometheus.NetworkPolicyToKubelet(r.GardenNamespace, seed.GetNodeCIDR()),
 		},
-	})
+	}, r.registryMirrors())
 }
 
 func (r *Reconciler) newSeedPrometheus(log logr.Logger, seed *seedpkg.Seed) (component.DeployWaiter, error) {
@@ -635,7 +642,7 @@ func (r *Reconciler) newSeedPrometheus(log logr.Logger, seed *seedpkg.Seed) (com
 			PodMonitors:   seedprometheus.CentralPodMonitors(),
 			ScrapeConfigs: seedprometheus.CentralScrapeConfigs(),
 		},
-	})
+	}, r.registryMirrors())
 }
 
 func (r *Reconciler) newAggregatePrometheus(log logr.Logger, seed *seedpkg.Seed, seedIsGarden bool, secretsManager secretsmanager.Interface, globalMonitoringSecret, wildcardCertSecret, alertingSMTPSecret *corev1.Secret) (component.DeployWaiter, error) {
@@ -678,7 +685,7 @@ func (r *Reconciler) newAggregatePrometheus(log logr.Logger, seed *seedpkg.Seed,
 		values.Alerting = &prometheus.AlertingValues{Alertmanagers: []*prometheus.Alertmanager{{Name: "alertmanager-seed"}}}
 	}
 
-	return sharedcomponent.NewPrometheus(log, r.SeedClientSet.Client(), r.GardenNamespace, values)
+	return sharedcomponent.NewPrometheus(log, r.SeedClientSet.Client(), r.GardenNamespace, values, r.registryMirrors())
 }
 
 func (r *Reconciler) newAlertmanager(log logr.Logger, seed *seedpkg.Seed, alertingSMTPSecret *corev1.Secret) (component.DeployWaiter, error) {
@@ -689,7 +696,7 @@ func (r *Reconciler) newAlertmanager(log logr.Logger, seed *seedpkg.Seed, alerti
 		StorageCapacity:    resource.MustParse(seed.GetValidVolumeSize("1Gi")),
 		Replicas:           1,
 		AlertingSMTPSecret: alertingSMTPSecret,
-	})
+	}, r.registryMirrors())
 
 	if alertingSMTPSecret == nil {
 		return component.OpDestroyAndWait(c), nil
@@ -769,6 +776,7 @@ func (r *Reconciler) newVerticalPodAutoscaler(settings *gardencorev1beta1.SeedSe
 		v1beta1constants.PriorityClassNameSeedSystem700,
 		isGardenCluster,
 		featureGates,
+		r.registryMirrors(),
 	)
 	if err != nil {
 		return nil, err
@@ -792,6 +800,7 @@ func (r *Reconciler) newEtcdDruid(secretsManager secretsmanager.Interface) (comp
 		v1beta1constants.SecretNameCASeed,
 		v1beta1constants.PriorityClassNameSeedSystem800,
 		false,
+		r.registryMirrors(),
 	)
 }
 
@@ -802,6 +811,7 @@ func (r *Reconciler) newKubeStateMetrics() (component.DeployWaiter, error) {
 		r.SeedVersion,
 		v1beta1constants.PriorityClassNameSeedSystem600,
 		kubestatemetrics.SuffixSeed,
+		r.registryMirrors(),
 	)
 }
 
@@ -810,6 +820,7 @@ func (r *Reconciler) newPrometheusOperator() (component.DeployWaiter, error) {
 		r.SeedClientSet.Client(),
 		r.GardenNamespace,
 		v1beta1constants.PriorityClassNameSeedSystem600,
+		r.registryMirrors(),
 	)
 }
 
@@ -818,6 +829,7 @@ func (r *Reconciler) newPersesOperator() (component.DeployWaiter, error) {
 		r.SeedClientSet.Client(),
 		r.GardenNamespace,
 		v1beta1constants.PriorityClassNameSeedSystem600,
+		r.registryMirrors(),
 	)
 }
 
@@ -827,6 +839,7 @@ func (r *Reconciler) newFluentOperator() (component.DeployWaiter, error) {
 		r.GardenNamespace,
 		gardenlethelper.IsLoggingEnabled(&r.Config),
 		v1beta1constants.PriorityClassNameSeedSystem600,
+		r.registryMirrors(),
 	)
 }
 
@@ -837,6 +850,7 @@ func (r *Reconciler) newFluentBit() (component.DeployWaiter, error) {
 		gardenlethelper.IsLoggingEnabled(&r.Config),
 		gardenlethelper.IsValiEnabled(&r.Config),
 		v1beta1constants.PriorityClassNameSeedSystem600,
+		r.registryMirrors(),
 	)
 }
 
@@ -846,6 +860,7 @@ func (r *Reconciler) newOpenTelemetryOperator() (component.DeployWaiter, error)
 		r.GardenNamespace,
 		gardenlethelper.IsLoggingEnabled(&r.Config),
 		v1beta1constants.PriorityClassNameSeedSystem600,
+		r.registryMirrors(),
 	)
 }
 
@@ -895,6 +910,7 @@ func (r *Reconciler) newNginxIngressController(seed *seedpkg.Seed, istioDefaultL
 		[]string{seed.GetIngressFQDN("*")},
 		istioDefaultLabels,
 		seedIsGarden,
+		r.registryMirrors(),
 	)
 }
 
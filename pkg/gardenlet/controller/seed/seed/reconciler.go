@@ -11,6 +11,7 @@ import (
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -30,6 +31,7 @@ import (
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	gardenletutils "github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
 // Reconciler reconciles Seed resources and provisions or de-provisions the seed system components.
@@ -39,11 +41,14 @@ type Reconciler struct {
 	SeedVersion                          *semver.Version
 	Config                               gardenletconfigv1alpha1.GardenletConfiguration
 	Clock                                clock.Clock
+	Tracer                               trace.Tracer
+	FlowRecorder                         *flow.ExecutionRecorder
 	Recorder                             record.EventRecorder
 	Identity                             *gardencorev1beta1.Gardener
 	ComponentImageVectors                imagevector.ComponentImageVectors
 	ClientCertificateExpirationTimestamp *metav1.Time
 	GardenNamespace                      string
+	CAKeyBackend                         secretsutils.KeyBackend
 }
 
 // Reconcile reconciles Seed resources and provisions or de-provisions the seed system components.
@@ -124,6 +129,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return reconcile.Result{RequeueAfter: r.Config.Controllers.Seed.SyncPeriod.Duration}, r.updateStatusOperationSuccess(ctx, seed, operationType)
 }
 
+// seedFlowRecordKey returns the key under which the flow executions for the given Seed are recorded.
+func seedFlowRecordKey(seed *gardencorev1beta1.Seed) string {
+	return "Seed " + seed.Name
+}
+
 func (r *Reconciler) reportProgress(log logr.Logger, seed *gardencorev1beta1.Seed) flow.ProgressReporter {
 	return flow.NewDelayingProgressReporter(clock.RealClock{}, func(ctx context.Context, stats *flow.Stats) {
 		patch := client.MergeFrom(seed.DeepCopy())
@@ -91,6 +91,7 @@ func (r *Reconciler) delete(
 			return reconcile.Result{}, fmt.Errorf("failed to remove finalizer: %w", err)
 		}
 	}
+	r.FlowRecorder.Forget(seedFlowRecordKey(seed))
 
 	return reconcile.Result{}, nil
 }
@@ -374,6 +375,9 @@ func (r *Reconciler) runDeleteSeedFlow(
 	if err := g.Compile().Run(ctx, flow.Opts{
 		Log:              log,
 		ProgressReporter: r.reportProgress(log, seed.GetInfo()),
+		Tracer:           r.Tracer,
+		Recorder:         r.FlowRecorder,
+		RecordKey:        seedFlowRecordKey(seed.GetInfo()),
 	}); err != nil {
 		return flow.Errors(err)
 	}
@@ -122,7 +122,10 @@ func (r *Reconciler) runReconcileSeedFlow(
 		clock.RealClock{},
 		r.SeedClientSet.Client(),
 		v1beta1constants.SecretManagerIdentityGardenlet,
-		secretsmanager.Config{CASecretAutoRotation: true},
+		secretsmanager.Config{
+			CASecretAutoRotation: true,
+			CAKeyBackend:         r.CAKeyBackend,
+		},
 		r.GardenNamespace,
 	)
 	if err != nil {
@@ -508,6 +511,9 @@ func (r *Reconciler) runReconcileSeedFlow(
 	if err := g.Compile().Run(ctx, flow.Opts{
 		Log:              log,
 		ProgressReporter: r.reportProgress(log, seed.GetInfo()),
+		Tracer:           r.Tracer,
+		Recorder:         r.FlowRecorder,
+		RecordKey:        seedFlowRecordKey(seed.GetInfo()),
 	}); err != nil {
 		return flow.Errors(err)
 	}
@@ -27,6 +27,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/status"
 	"github.com/gardener/gardener/pkg/healthz"
 	"github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
 // AddToManager adds all Shoot controllers to the given manager.
@@ -41,6 +42,7 @@ func AddToManager(
 	identity *gardencorev1beta1.Gardener,
 	gardenClusterIdentity string,
 	healthManager healthz.Manager,
+	caKeyBackend secretsutils.KeyBackend,
 ) error {
 	var responsibleForUnmanagedSeed bool
 	if err := gardenCluster.GetAPIReader().Get(ctx, client.ObjectKey{Name: cfg.SeedConfig.Name, Namespace: v1beta1constants.GardenNamespace}, &seedmanagementv1alpha1.ManagedSeed{}); err != nil {
@@ -59,6 +61,7 @@ func AddToManager(
 		Identity:                    identity,
 		GardenClusterIdentity:       gardenClusterIdentity,
 		ShootStateControllerEnabled: shootStateControllerEnabled,
+		CAKeyBackend:                caKeyBackend,
 	}).AddToManager(mgr, gardenCluster); err != nil {
 		return fmt.Errorf("failed adding main reconciler: %w", err)
 	}
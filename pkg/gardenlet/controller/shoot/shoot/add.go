@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/clock"
@@ -25,6 +26,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot/helper"
+	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
 // ControllerName is the name of this controller.
@@ -41,6 +43,12 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Clu
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.Tracer == nil {
+		r.Tracer = otel.Tracer(ControllerName)
+	}
+	if r.FlowRecorder == nil {
+		r.FlowRecorder = flow.DefaultExecutionRecorder
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// checkpointConfigMapName is the name of the ConfigMap in the control plane namespace of a Shoot which stores the
+// checkpoints of the Shoot reconciliation flow.
+const checkpointConfigMapName = "shoot-flow-checkpoints"
+
+// checkpointInputsHash computes the inputs hash for the checkpointable tasks of the Shoot reconciliation flow. The
+// checkpointable tasks idempotently deploy components whose configuration is derived from the desired state of the
+// Shoot (including the credentials rotation state), the Seed, and the version of gardenlet. If any of them changes, all
+// checkpointable tasks are executed again.
+func checkpointInputsHash(gardenerVersion string, shoot *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed) string {
+	return utils.ComputeChecksum(map[string]any{
+		"gardenerVersion":  gardenerVersion,
+		"shootUID":         shoot.UID,
+		"shootGeneration":  shoot.Generation,
+		"shootCredentials": shoot.Status.Credentials,
+		"seedName":         seed.Name,
+		"seedGeneration":   seed.Generation,
+	})
+}
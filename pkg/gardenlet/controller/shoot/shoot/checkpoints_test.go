@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+var _ = Describe("Checkpoints", func() {
+	Describe("#checkpointInputsHash", func() {
+		var (
+			shoot *gardencorev1beta1.Shoot
+			seed  *gardencorev1beta1.Seed
+			hash  string
+		)
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "foo", UID: "uid", Generation: 1}}
+			seed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed", Generation: 1}}
+			hash = checkpointInputsHash("v1.135.0", shoot, seed)
+		})
+
+		It("should not change if the inputs did not change", func() {
+			shoot.Annotations = map[string]string{"foo": "bar"}
+			Expect(checkpointInputsHash("v1.135.0", shoot, seed)).To(Equal(hash))
+		})
+
+		It("should change if the gardenlet version changes", func() {
+			Expect(checkpointInputsHash("v1.136.0", shoot, seed)).NotTo(Equal(hash))
+		})
+
+		It("should change if the Shoot specification changes", func() {
+			shoot.Generation = 2
+			Expect(checkpointInputsHash("v1.135.0", shoot, seed)).NotTo(Equal(hash))
+		})
+
+		It("should change if the credentials rotation state of the Shoot changes", func() {
+			shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{}}
+			Expect(checkpointInputsHash("v1.135.0", shoot, seed)).NotTo(Equal(hash))
+		})
+
+		It("should change if the Seed changes", func() {
+			seed.Generation = 2
+			Expect(checkpointInputsHash("v1.135.0", shoot, seed)).NotTo(Equal(hash))
+		})
+	})
+})
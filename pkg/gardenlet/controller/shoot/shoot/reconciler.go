@@ -14,6 +14,7 @@ import (
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -53,6 +54,8 @@ import (
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	retryutils "github.com/gardener/gardener/pkg/utils/retry"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
 
@@ -68,7 +71,10 @@ type Reconciler struct {
 	Identity                    *gardencorev1beta1.Gardener
 	GardenClusterIdentity       string
 	Clock                       clock.Clock
+	Tracer                      trace.Tracer
+	FlowRecorder                *flow.ExecutionRecorder
 	ShootStateControllerEnabled bool
+	CAKeyBackend                secretsutils.KeyBackend
 }
 
 // Reconcile implements the main shoot reconciliation logic, i.e., creation, hibernation, migration and deletion.
@@ -434,6 +440,7 @@ func (r *Reconciler) initializeOperation(
 		NewBuilder().
 		WithLogger(log).
 		WithConfig(&r.Config).
+		WithCAKeyBackend(r.CAKeyBackend).
 		WithGardenerInfo(r.Identity).
 		WithGardenClusterIdentity(r.GardenClusterIdentity).
 		WithSecrets(gardenSecrets).
@@ -499,6 +506,8 @@ func (r *Reconciler) finalizeShootMigration(ctx context.Context, shoot *gardenco
 		return reconcile.Result{}, err
 	}
 
+	secretsmanager.DeleteMetrics(shoot.Status.TechnicalID)
+
 	r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.EventMigrationPrepared, "Prepared Shoot cluster for migration")
 	return reconcile.Result{}, r.patchShootStatusOperationSuccess(ctx, shoot, nil, gardencorev1beta1.LastOperationTypeMigrate)
 }
@@ -511,6 +520,8 @@ func (r *Reconciler) finalizeShootDeletion(ctx context.Context, log logr.Logger,
 		return reconcile.Result{}, errorsutils.WithSuppressed(errors.New(lastErr.Description), updateErr)
 	}
 
+	secretsmanager.DeleteMetrics(shoot.Status.TechnicalID)
+
 	return reconcile.Result{}, r.removeFinalizerFromShoot(ctx, log, shoot)
 }
 
@@ -536,6 +547,7 @@ func (r *Reconciler) removeFinalizerFromShoot(ctx context.Context, log logr.Logg
 			return fmt.Errorf("failed to remove finalizer: %w", err)
 		}
 	}
+	r.FlowRecorder.Forget(shootFlowRecordKey(shoot))
 
 	reportMetrics(shoot, operationType, r.Clock.Now().UTC().Sub(shoot.DeletionTimestamp.Time))
 
@@ -557,6 +569,11 @@ func (r *Reconciler) removeFinalizerFromShoot(ctx context.Context, log logr.Logg
 	})
 }
 
+// shootFlowRecordKey returns the key under which the flow executions for the given Shoot are recorded.
+func shootFlowRecordKey(shoot *gardencorev1beta1.Shoot) string {
+	return "Shoot " + client.ObjectKeyFromObject(shoot).String()
+}
+
 func (r *Reconciler) newProgressReporter(reporterFn flow.ProgressReporterFn) flow.ProgressReporter {
 	if r.Config.Controllers.Shoot != nil && r.Config.Controllers.Shoot.ProgressReportPeriod != nil {
 		return flow.NewDelayingProgressReporter(clock.RealClock{}, reporterFn, r.Config.Controllers.Shoot.ProgressReportPeriod.Duration)
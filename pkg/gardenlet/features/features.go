@@ -27,5 +27,7 @@ func GetFeatures() []featuregate.Feature {
 		features.UseUnifiedHTTPProxyPort,
 		features.VPAInPlaceUpdates,
 		features.CustomDNSServerInNodeLocalDNS,
+		features.ShootFlowCheckpoints,
+		features.SSHCertificateAuthority,
 	}
 }
@@ -15,12 +15,12 @@ import (
 
 // DefaultAPIServerProxy returns a deployer for the apiserver-proxy.
 func (b *Botanist) DefaultAPIServerProxy() (apiserverproxy.Interface, error) {
-	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameEnvoyProxy, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameEnvoyProxy, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()), imagevectorutils.RegistryMirrors(b.RegistryMirrors()))
 	if err != nil {
 		return nil, err
 	}
 
-	sidecarImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameApiserverProxySidecar, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+	sidecarImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameApiserverProxySidecar, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()), imagevectorutils.RegistryMirrors(b.RegistryMirrors()))
 	if err != nil {
 		return nil, err
 	}
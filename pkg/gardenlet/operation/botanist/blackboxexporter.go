@@ -42,6 +42,7 @@ func (b *Botanist) DefaultBlackboxExporterControlPlane() (component.DeployWaiter
 			ScrapeConfigs:     controlplaneblackboxexporter.ScrapeConfig(b.Shoot.ControlPlaneNamespace, monitoringv1alpha1.Target("https://"+b.Shoot.ComputeOutOfClusterAPIServerAddress(true)+"/healthz")),
 			Replicas:          b.Shoot.GetReplicas(1),
 		},
+		b.RegistryMirrors(),
 	)
 }
 
@@ -76,6 +77,7 @@ func (b *Botanist) DefaultBlackboxExporterCluster() (component.DeployWaiter, err
 			PrometheusRules:   clusterblackboxexporter.PrometheusRule(b.Shoot.ControlPlaneNamespace),
 			Replicas:          1,
 		},
+		b.RegistryMirrors(),
 	)
 }
 
@@ -40,7 +40,7 @@ func (b *Botanist) DefaultInfrastructure() infrastructure.Interface {
 // DeployInfrastructure deploys the Infrastructure custom resource and triggers the restore operation in case
 // the Shoot is in the restore phase of the control plane migration.
 func (b *Botanist) DeployInfrastructure(ctx context.Context) error {
-	if v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()) {
+	if v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()) && !b.SSHCertificateAuthorityEnabled() {
 		sshKeypairSecret, found := b.SecretsManager.Get(v1beta1constants.SecretNameSSHKeyPair)
 		if !found {
 			return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameSSHKeyPair)
@@ -70,6 +70,7 @@ func (b *Botanist) DefaultKubeAPIServer(ctx context.Context) (kubeapiserver.Inte
 		nil,
 		nil,
 		nil,
+		b.RegistryMirrors(),
 	)
 }
 
@@ -34,6 +34,7 @@ func (b *Botanist) DefaultKubeControllerManager() (kubecontrollermanager.Interfa
 		kubecontrollermanager.ControllerWorkers{},
 		kubecontrollermanager.ControllerSyncPeriods{},
 		nil,
+		b.RegistryMirrors(),
 	)
 }
 
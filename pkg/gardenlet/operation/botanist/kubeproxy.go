@@ -26,7 +26,7 @@ import (
 
 // DefaultKubeProxy returns a deployer for the kube-proxy.
 func (b *Botanist) DefaultKubeProxy() (kubeproxy.Interface, error) {
-	imageAlpine, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameAlpineConntrack, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+	imageAlpine, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameAlpineConntrack, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()), imagevectorutils.RegistryMirrors(b.RegistryMirrors()))
 	if err != nil {
 		return nil, err
 	}
@@ -88,7 +88,7 @@ func (b *Botanist) computeWorkerPoolsForKubeProxy(ctx context.Context) ([]kubepr
 			return nil, err
 		}
 
-		image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameKubeProxy, imagevectorutils.RuntimeVersion(kubernetesVersion.String()), imagevectorutils.TargetVersion(kubernetesVersion.String()))
+		image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameKubeProxy, imagevectorutils.RuntimeVersion(kubernetesVersion.String()), imagevectorutils.TargetVersion(kubernetesVersion.String()), imagevectorutils.RegistryMirrors(b.RegistryMirrors()))
 		if err != nil {
 			return nil, err
 		}
@@ -117,7 +117,7 @@ func (b *Botanist) computeWorkerPoolsForKubeProxy(ctx context.Context) ([]kubepr
 			return nil, err
 		}
 
-		image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameKubeProxy, imagevectorutils.RuntimeVersion(kubernetesVersionString), imagevectorutils.TargetVersion(kubernetesVersionString))
+		image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameKubeProxy, imagevectorutils.RuntimeVersion(kubernetesVersionString), imagevectorutils.TargetVersion(kubernetesVersionString), imagevectorutils.RegistryMirrors(b.RegistryMirrors()))
 		if err != nil {
 			return nil, err
 		}
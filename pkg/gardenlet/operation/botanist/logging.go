@@ -98,7 +98,7 @@ func (b *Botanist) isShootEventLoggerEnabled() bool {
 
 // DefaultEventLogger returns a deployer for the shoot-event-logger.
 func (b *Botanist) DefaultEventLogger() (component.Deployer, error) {
-	imageEventLogger, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameEventLogger, imagevectorutils.RuntimeVersion(b.SeedVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+	imageEventLogger, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameEventLogger, imagevectorutils.RuntimeVersion(b.SeedVersion()), imagevectorutils.TargetVersion(b.ShootVersion()), imagevectorutils.RegistryMirrors(b.RegistryMirrors()))
 	if err != nil {
 		return nil, err
 	}
@@ -127,17 +127,18 @@ func (b *Botanist) DefaultVali() (vali.Interface, error) {
 		nil,
 		b.ComputeValiHost(),
 		false,
+		b.RegistryMirrors(),
 	)
 }
 
 // DefaultOtelCollector returns a deployer for the OpenTelemetry Collector.
 func (b *Botanist) DefaultOtelCollector() (collector.Interface, error) {
-	collectorImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameOpentelemetryCollector)
+	collectorImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameOpentelemetryCollector, imagevectorutils.RegistryMirrors(b.RegistryMirrors()))
 	if err != nil {
 		return nil, err
 	}
 
-	kubeRBACProxyImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameKubeRbacProxy)
+	kubeRBACProxyImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameKubeRbacProxy, imagevectorutils.RegistryMirrors(b.RegistryMirrors()))
 	if err != nil {
 		return nil, err
 	}
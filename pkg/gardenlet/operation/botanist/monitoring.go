@@ -45,7 +45,7 @@ func (b *Botanist) DefaultAlertmanager() (alertmanager.Interface, error) {
 			SecretsManager: b.SecretsManager,
 			SigningCA:      v1beta1constants.SecretNameCACluster,
 		},
-	})
+	}, b.RegistryMirrors())
 }
 
 // DeployAlertManager reconciles the shoot alert manager.
@@ -144,7 +144,7 @@ func (b *Botanist) DefaultPrometheus() (prometheus.Interface, error) {
 		}
 	}
 
-	return sharedcomponent.NewPrometheus(b.Logger, b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, values)
+	return sharedcomponent.NewPrometheus(b.Logger, b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, values, b.RegistryMirrors())
 }
 
 // DeployPrometheus reconciles the shoot Prometheus.
@@ -56,7 +56,7 @@ func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interfa
 // It can be used both in the DefaultOperatingSystemConfig and in the ControlPlaneBootstrapOperatingSystemConfig.
 // Reusing the same values in both places ensures that the computed OperatingSystemConfig hashes are the same.
 func (b *Botanist) OperatingSystemConfigValues() (*operatingsystemconfig.Values, error) {
-	oscImages, err := imagevectorutils.FindImages(imagevector.Containers(), []string{imagevector.ContainerImageNamePauseContainer, imagevector.ContainerImageNameValitail, imagevector.ContainerImageNameOpentelemetryCollector}, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+	oscImages, err := imagevectorutils.FindImages(imagevector.Containers(), []string{imagevector.ContainerImageNamePauseContainer, imagevector.ContainerImageNameValitail, imagevector.ContainerImageNameOpentelemetryCollector}, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()), imagevectorutils.RegistryMirrors(b.RegistryMirrors()))
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +66,7 @@ func (b *Botanist) OperatingSystemConfigValues() (*operatingsystemconfig.Values,
 	// is not set. This is true for gardener-node-agent because gardenlet always deploys it with its own version (ref
 	// WithOptionalTag call a few lines below).
 	// See also: https://github.com/gardener/gardener/issues/9577
-	oscImages[imagevector.ContainerImageNameGardenerNodeAgent], err = imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerNodeAgent)
+	oscImages[imagevector.ContainerImageNameGardenerNodeAgent], err = imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerNodeAgent, imagevectorutils.RegistryMirrors(b.RegistryMirrors()))
 	if err != nil {
 		return nil, fmt.Errorf("failed finding image %q: %w", imagevector.ContainerImageNameGardenerNodeAgent, err)
 	}
@@ -83,6 +83,7 @@ func (b *Botanist) OperatingSystemConfigValues() (*operatingsystemconfig.Values,
 		Namespace:         b.Shoot.ControlPlaneNamespace,
 		KubernetesVersion: b.Shoot.KubernetesVersion,
 		Workers:           b.Shoot.GetInfo().Spec.Provider.Workers,
+		RegistryMirrors:   b.RegistryMirrors(),
 		OriginalValues: operatingsystemconfig.OriginalValues{
 			ClusterDomain:                           gardencorev1beta1.DefaultDomain,
 			Images:                                  oscImages,
@@ -122,7 +123,19 @@ func (b *Botanist) DeployOperatingSystemConfig(ctx context.Context) error {
 		b.Shoot.Components.Extensions.OperatingSystemConfig.SetCredentialsRotationStatus(shoot.Status.Credentials.Rotation)
 	}
 
-	if v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()) {
+	if b.SSHCertificateAuthorityEnabled() {
+		sshCASecret, found := b.SecretsManager.Get(v1beta1constants.SecretNameSSHCertificateAuthority)
+		if !found {
+			return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameSSHCertificateAuthority)
+		}
+		publicKeys := []string{string(sshCASecret.Data[secretsutils.DataKeySSHAuthorizedKeys])}
+
+		if sshCASecretOld, found := b.SecretsManager.Get(v1beta1constants.SecretNameSSHCertificateAuthority, secretsmanager.Old); found {
+			publicKeys = append(publicKeys, string(sshCASecretOld.Data[secretsutils.DataKeySSHAuthorizedKeys]))
+		}
+
+		b.Shoot.Components.Extensions.OperatingSystemConfig.SetSSHCertificateAuthorityPublicKeys(publicKeys)
+	} else if v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()) {
 		sshKeypairSecret, found := b.SecretsManager.Get(v1beta1constants.SecretNameSSHKeyPair)
 		if !found {
 			return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameSSHKeyPair)
@@ -30,6 +30,7 @@ import (
 	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
 	mockoperatingsystemconfig "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/mock"
+	"github.com/gardener/gardener/pkg/features"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
@@ -191,6 +192,25 @@ var _ = Describe("operatingsystemconfig", func() {
 			})
 		})
 
+		Context("with SSH certificate authority", func() {
+			BeforeEach(func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.SSHCertificateAuthority, true))
+
+				Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ssh-ca", Namespace: namespace}, Data: map[string][]byte{"id_rsa.pub": []byte("ca-key")}})).To(Succeed())
+				Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ssh-ca-old", Namespace: namespace}, Data: map[string][]byte{"id_rsa.pub": []byte("old-ca-key")}})).To(Succeed())
+			})
+
+			It("should trust the SSH certificate authorities instead of the SSH key pair", func() {
+				operatingSystemConfig.EXPECT().SetAPIServerURL(fmt.Sprintf("https://api.%s", shootDomain))
+				operatingSystemConfig.EXPECT().SetCABundle(caBundle)
+				operatingSystemConfig.EXPECT().SetSSHCertificateAuthorityPublicKeys([]string{"ca-key", "old-ca-key"})
+				operatingSystemConfig.EXPECT().SetClusterDNSAddresses(coreDNS)
+
+				operatingSystemConfig.EXPECT().Deploy(ctx)
+				Expect(botanist.DeployOperatingSystemConfig(ctx)).To(Succeed())
+			})
+		})
+
 		Context("restore", func() {
 			BeforeEach(func() {
 				operatingSystemConfig.EXPECT().SetAPIServerURL(fmt.Sprintf("https://api.%s", shootDomain))
@@ -33,6 +33,7 @@ func (b *Botanist) DefaultPlutono() (plutono.Interface, error) {
 		b.Shoot.WantsVerticalPodAutoscaler,
 		nil,
 		false,
+		b.RegistryMirrors(),
 	)
 }
 
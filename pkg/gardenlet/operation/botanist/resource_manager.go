@@ -76,7 +76,7 @@ func (b *Botanist) DefaultResourceManager() (resourcemanager.Interface, error) {
 		}
 	}
 
-	return newFunc(b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, b.SecretsManager, values)
+	return newFunc(b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, b.SecretsManager, values, b.RegistryMirrors())
 }
 
 // DeployGardenerResourceManager deploys the gardener-resource-manager
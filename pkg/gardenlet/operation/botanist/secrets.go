@@ -22,6 +22,7 @@ import (
 	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	kubeapiserver "github.com/gardener/gardener/pkg/component/kubernetes/apiserver"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/gardener/tokenrequest"
@@ -51,7 +52,9 @@ func (b *Botanist) InitializeSecretsManagement(ctx context.Context) error {
 		b.reconcileWildcardIngressCertificate,
 	}
 
-	if v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()) {
+	if b.SSHCertificateAuthorityEnabled() {
+		taskFns = append(taskFns, b.generateSSHCertificateAuthority, b.deleteSSHKeypair)
+	} else if v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()) {
 		taskFns = append(taskFns, b.generateSSHKeypair)
 	} else {
 		taskFns = append(taskFns, b.deleteSSHKeypair)
@@ -81,6 +84,7 @@ func (b *Botanist) lastSecretRotationStartTimes() map[string]time.Time {
 
 		if shootStatus.Credentials.Rotation.SSHKeypair != nil && shootStatus.Credentials.Rotation.SSHKeypair.LastInitiationTime != nil {
 			rotation[v1beta1constants.SecretNameSSHKeyPair] = shootStatus.Credentials.Rotation.SSHKeypair.LastInitiationTime.Time
+			rotation[v1beta1constants.SecretNameSSHCertificateAuthority] = shootStatus.Credentials.Rotation.SSHKeypair.LastInitiationTime.Time
 		}
 
 		if shootStatus.Credentials.Rotation.Observability != nil && shootStatus.Credentials.Rotation.Observability.LastInitiationTime != nil {
@@ -151,8 +155,10 @@ func caCertConfigurations(isWorkerless, isSelfHosted bool) []secretsutils.Config
 		// generated to ensure that each CA has a unique common name. For backwards-compatibility, we still keep the
 		// CommonNames here (if we removed them then new CAs would be generated with the next shoot reconciliation
 		// without the end-user to explicitly trigger it).
+		// The private keys of the client and kubelet CAs must be exportable since kube-controller-manager uses them for
+		// signing certificates.
 		&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCACluster, CommonName: "kubernetes", CertType: secretsutils.CACert},
-		&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCAClient, CommonName: "kubernetes-client", CertType: secretsutils.CACert},
+		&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCAClient, CommonName: "kubernetes-client", CertType: secretsutils.CACert, ExportablePrivateKey: true},
 		&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCAETCD, CommonName: "etcd", CertType: secretsutils.CACert},
 		&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCAETCDPeer, CommonName: "etcd-peer", CertType: secretsutils.CACert},
 		&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCAFrontProxy, CommonName: "front-proxy", CertType: secretsutils.CACert},
@@ -160,7 +166,7 @@ func caCertConfigurations(isWorkerless, isSelfHosted bool) []secretsutils.Config
 
 	if !isWorkerless {
 		certificateSecretConfigs = append(certificateSecretConfigs,
-			&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCAKubelet, CommonName: "kubelet", CertType: secretsutils.CACert},
+			&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCAKubelet, CommonName: "kubelet", CertType: secretsutils.CACert, ExportablePrivateKey: true},
 			&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCAMetricsServer, CommonName: "metrics-server", CertType: secretsutils.CACert},
 		)
 
@@ -470,6 +476,26 @@ func (b *Botanist) syncShootConfigMapToGarden(
 	return err
 }
 
+// SSHCertificateAuthorityEnabled returns whether the worker nodes of the shoot trust an SSH certificate authority
+// instead of the shared SSH key pair. Self-hosted shoots keep using the SSH key pair since gardenadm uses it to connect
+// to the control plane machines.
+func (b *Botanist) SSHCertificateAuthorityEnabled() bool {
+	return features.DefaultFeatureGate.Enabled(features.SSHCertificateAuthority) &&
+		v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()) &&
+		!b.Shoot.IsSelfHosted()
+}
+
+func (b *Botanist) generateSSHCertificateAuthority(ctx context.Context) error {
+	// The certificate authority is rotated together with the SSH key pair, see lastSecretRotationStartTimes. The old
+	// certificate authority is kept so that certificates issued before the rotation stay valid until they expire.
+	_, err := b.SecretsManager.Generate(ctx, &secretsutils.RSASecretConfig{
+		Name:       v1beta1constants.SecretNameSSHCertificateAuthority,
+		Bits:       4096,
+		UsedForSSH: true,
+	}, secretsmanager.Persist(), secretsmanager.Rotate(secretsmanager.KeepOld))
+	return err
+}
+
 func (b *Botanist) deleteSSHKeypair(ctx context.Context) error {
 	return b.deleteShootCredentialFromGarden(ctx, gardenerutils.ShootProjectSecretSuffixSSHKeypair, gardenerutils.ShootProjectSecretSuffixOldSSHKeypair)
 }
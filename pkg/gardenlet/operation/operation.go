@@ -34,7 +34,9 @@ import (
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
 
@@ -44,6 +46,9 @@ func NewBuilder() *Builder {
 		clockFunc: func() clock.Clock {
 			return clock.RealClock{}
 		},
+		caKeyBackendFunc: func() secretsutils.KeyBackend {
+			return nil
+		},
 		configFunc: func() (*gardenletconfigv1alpha1.GardenletConfiguration, error) {
 			return nil, errors.New("config is required but not set")
 		},
@@ -200,6 +205,12 @@ func (b *Builder) WithClock(c clock.Clock) *Builder {
 	return b
 }
 
+// WithCAKeyBackend sets the caKeyBackendFunc attribute at the Builder.
+func (b *Builder) WithCAKeyBackend(backend secretsutils.KeyBackend) *Builder {
+	b.caKeyBackendFunc = func() secretsutils.KeyBackend { return backend }
+	return b
+}
+
 // Build initializes a new Operation object.
 func (b *Builder) Build(
 	ctx context.Context,
@@ -212,6 +223,7 @@ func (b *Builder) Build(
 ) {
 	operation := &Operation{
 		Clock:          b.clockFunc(),
+		CAKeyBackend:   b.caKeyBackendFunc(),
 		GardenClient:   gardenClient,
 		SeedClientSet:  seedClientSet,
 		ShootClientMap: shootClientMap,
@@ -423,6 +435,14 @@ func (o *Operation) ShootVersion() string {
 	return o.Shoot.GetInfo().Spec.Kubernetes.Version
 }
 
+// RegistryMirrors is a shorthand for the registry mirrors configured in the gardenlet configuration.
+func (o *Operation) RegistryMirrors() imagevector.Mirrors {
+	if o.Config == nil {
+		return nil
+	}
+	return helper.RegistryMirrors(o.Config.OCI)
+}
+
 // DeleteClusterResourceFromSeed deletes the `Cluster` extension resource for the shoot in the seed cluster.
 func (o *Operation) DeleteClusterResourceFromSeed(ctx context.Context) error {
 	return client.IgnoreNotFound(o.SeedClientSet.Client().Delete(ctx, &extensionsv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: o.Shoot.ControlPlaneNamespace}}))
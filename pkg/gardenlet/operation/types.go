@@ -22,6 +22,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
@@ -38,6 +39,7 @@ type Builder struct {
 	seedFunc                  func(context.Context) (*seed.Seed, error)
 	shootFunc                 func(context.Context, client.Reader, *garden.Garden, *seed.Seed, *corev1.Secret) (*shoot.Shoot, error)
 	clockFunc                 func() clock.Clock
+	caKeyBackendFunc          func() secretsutils.KeyBackend
 }
 
 // Operation contains all data required to perform an operation on a Shoot cluster.
@@ -48,6 +50,7 @@ type Operation struct {
 
 	Clock                 clock.Clock
 	Config                *gardenletconfigv1alpha1.GardenletConfiguration
+	CAKeyBackend          secretsutils.KeyBackend
 	Logger                logr.Logger
 	GardenerInfo          *gardencorev1beta1.Gardener
 	GardenClusterIdentity string
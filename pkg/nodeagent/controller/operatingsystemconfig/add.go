@@ -67,6 +67,11 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) erro
 			handler.EnqueueRequestsFromMapFunc(r.NodeToSecretMapper()),
 			builder.WithPredicates(r.NodeReadyForUpdate()),
 		).
+		Watches(
+			&corev1.Node{},
+			handler.EnqueueRequestsFromMapFunc(r.NodeToOwnSecretMapper()),
+			builder.WithPredicates(r.NodeUpdateReleased()),
+		).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: 1,
 			ReconciliationTimeout:   controllerutils.DefaultReconciliationTimeout,
@@ -155,6 +160,15 @@ func (r *Reconciler) NodeToSecretMapper() handler.MapFunc {
 	}
 }
 
+// NodeToOwnSecretMapper returns a mapper that returns a request for the secret configured for this gardener-node-agent.
+// In contrast to NodeToSecretMapper, it also works for nodes without the secret name label (e.g., nodes of
+// self-hosted shoots which are not managed by machine-controller-manager).
+func (r *Reconciler) NodeToOwnSecretMapper() handler.MapFunc {
+	return func(_ context.Context, _ client.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: r.Config.SecretName, Namespace: metav1.NamespaceSystem}}}
+	}
+}
+
 // NodeReadyForUpdate returns a predicate that returns
 // - true for Create event if the new node has the InPlaceUpdate condition with the reason ReadyForUpdate.
 // - true for Update event if the new node has the InPlaceUpdate condition with the reason ReadyForUpdate and old node doesn't.
@@ -190,6 +204,22 @@ func (r *Reconciler) NodeReadyForUpdate() predicate.Predicate {
 	}
 }
 
+// NodeUpdateReleased returns a predicate that returns true for Update events if the old node has the
+// node-agent.gardener.cloud/hold-operating-system-config-update annotation and the new node doesn't. It returns false
+// for all other events.
+func (r *Reconciler) NodeUpdateReleased() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			_, oldOnHold := e.ObjectOld.GetAnnotations()[v1beta1constants.AnnotationNodeAgentHoldOperatingSystemConfigUpdate]
+			_, newOnHold := e.ObjectNew.GetAnnotations()[v1beta1constants.AnnotationNodeAgentHoldOperatingSystemConfigUpdate]
+			return oldOnHold && !newOnHold
+		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
+
 func nodeHasInPlaceUpdateConditionWithReasonReadyForUpdate(conditions []corev1.NodeCondition) bool {
 	for _, condition := range conditions {
 		if condition.Type == machinev1alpha1.NodeInPlaceUpdate && condition.Reason == machinev1alpha1.ReadyForUpdate {
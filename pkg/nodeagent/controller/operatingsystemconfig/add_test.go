@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
 	mockworkqueue "github.com/gardener/gardener/third_party/mock/client-go/util/workqueue"
 )
@@ -291,6 +292,16 @@ var _ = Describe("Add", func() {
 		})
 	})
 
+	Describe("#NodeToOwnSecretMapper", func() {
+		It("should map any node to the configured secret", func() {
+			mapper := (&Reconciler{Config: nodeagentconfigv1alpha1.OperatingSystemConfigControllerConfig{SecretName: "osc-secret"}}).NodeToOwnSecretMapper()
+
+			Expect(mapper(context.Background(), &corev1.Node{})).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "osc-secret", Namespace: "kube-system"}},
+			))
+		})
+	})
+
 	Describe("#NodeReadyForUpdatePredicate", func() {
 		var (
 			p    predicate.Predicate
@@ -382,4 +393,40 @@ var _ = Describe("Add", func() {
 			})
 		})
 	})
+
+	Describe("#NodeUpdateReleased", func() {
+		var (
+			p    predicate.Predicate
+			node *corev1.Node
+		)
+
+		BeforeEach(func() {
+			p = (&Reconciler{}).NodeUpdateReleased()
+
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				"node-agent.gardener.cloud/hold-operating-system-config-update": "true",
+			}}}
+		})
+
+		It("should return false for create events", func() {
+			Expect(p.Create(event.CreateEvent{Object: node})).To(BeFalse())
+		})
+
+		It("should return false when the annotation is still present", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: node})).To(BeFalse())
+		})
+
+		It("should return false when the annotation is added", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: &corev1.Node{}, ObjectNew: node})).To(BeFalse())
+		})
+
+		It("should return true when the annotation is removed", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: &corev1.Node{}})).To(BeTrue())
+		})
+
+		It("should return false for delete and generic events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: node})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: node})).To(BeFalse())
+		})
+	})
 })
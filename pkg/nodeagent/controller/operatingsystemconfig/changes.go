@@ -54,6 +54,26 @@ func extractOSCFromSecret(secret *corev1.Secret) (*extensionsv1alpha1.OperatingS
 	return osc, secret.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig], nil
 }
 
+// LastAppliedUnitsAndFiles returns the units and files of the OperatingSystemConfig which was last applied
+// successfully. Units and files provided by extensions are included. It returns no units and files if no
+// OperatingSystemConfig was applied yet.
+func LastAppliedUnitsAndFiles(fs afero.Afero) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+	oscRaw, err := fs.ReadFile(lastAppliedOperatingSystemConfigFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("error reading last applied OSC from file path %s: %w", lastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	osc := &extensionsv1alpha1.OperatingSystemConfig{}
+	if err := runtime.DecodeInto(decoder, oscRaw, osc); err != nil {
+		return nil, nil, fmt.Errorf("unable to decode the last applied OSC read from file path %s: %w", lastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	return mergeUnits(osc.Spec.Units, osc.Status.ExtensionUnits), collectAllFiles(osc), nil
+}
+
 func computeOperatingSystemConfigChanges(log logr.Logger, fs afero.Afero, newOSC *extensionsv1alpha1.OperatingSystemConfig, newOSCChecksum string, currentOSVersion *string, skipPersist bool) (*operatingSystemConfigChanges, error) {
 	changes := &operatingSystemConfigChanges{
 		fs:                            fs,
@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	gomegatypes "github.com/onsi/gomega/types"
+	"github.com/spf13/afero"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
 	"k8s.io/utils/ptr"
@@ -177,4 +178,64 @@ var _ = Describe("Changes", func() {
 			Expect(saKeyRotation).To(BeFalse())
 		})
 	})
+
+	Describe("#LastAppliedUnitsAndFiles", func() {
+		var fs afero.Afero
+
+		BeforeEach(func() {
+			fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		})
+
+		It("should return nothing if no OSC was applied yet", func() {
+			units, files, err := LastAppliedUnitsAndFiles(fs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(units).To(BeEmpty())
+			Expect(files).To(BeEmpty())
+		})
+
+		It("should return an error if the last applied OSC cannot be decoded", func() {
+			Expect(fs.WriteFile("/var/lib/gardener-node-agent/last-applied-osc.yaml", []byte("{"), 0600)).To(Succeed())
+
+			_, _, err := LastAppliedUnitsAndFiles(fs)
+			Expect(err).To(MatchError(ContainSubstring("unable to decode the last applied OSC")))
+		})
+
+		It("should return the units and files including those provided by extensions", func() {
+			Expect(fs.WriteFile("/var/lib/gardener-node-agent/last-applied-osc.yaml", []byte(`apiVersion: extensions.gardener.cloud/v1alpha1
+kind: OperatingSystemConfig
+spec:
+  units:
+  - name: kubelet.service
+    content: kubelet
+  - name: containerd.service
+    dropIns:
+    - name: 10-foo.conf
+      content: foo
+  files:
+  - path: /etc/foo
+status:
+  extensionUnits:
+  - name: containerd.service
+    dropIns:
+    - name: 20-bar.conf
+      content: bar
+  - name: extension.service
+    content: extension
+  extensionFiles:
+  - path: /etc/bar
+`), 0600)).To(Succeed())
+
+			units, files, err := LastAppliedUnitsAndFiles(fs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(units).To(ConsistOf(
+				extensionsv1alpha1.Unit{Name: "kubelet.service", Content: ptr.To("kubelet")},
+				extensionsv1alpha1.Unit{Name: "containerd.service", DropIns: []extensionsv1alpha1.DropIn{{Name: "10-foo.conf", Content: "foo"}, {Name: "20-bar.conf", Content: "bar"}}},
+				extensionsv1alpha1.Unit{Name: "extension.service", Content: ptr.To("extension")},
+			))
+			Expect(files).To(ConsistOf(
+				extensionsv1alpha1.File{Path: "/etc/foo"},
+				extensionsv1alpha1.File{Path: "/etc/bar"},
+			))
+		})
+	})
 })
@@ -147,6 +147,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed extracting OSC from secret: %w", err)
 	}
 
+	if node != nil && metav1.HasAnnotation(node.ObjectMeta, v1beta1constants.AnnotationNodeAgentHoldOperatingSystemConfigUpdate) &&
+		node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] != oscChecksum {
+		log.Info("Update of operating system config is on hold, waiting for the annotation to be removed", "annotation", v1beta1constants.AnnotationNodeAgentHoldOperatingSystemConfigUpdate)
+		return reconcile.Result{}, nil
+	}
+
 	if node != nil {
 		nodeRole := "worker"
 		if slices.ContainsFunc(osc.Spec.Files, func(file extensionsv1alpha1.File) bool {
@@ -7,6 +7,7 @@ package v1alpha1
 import (
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 	"k8s.io/utils/ptr"
@@ -136,3 +137,10 @@ func SetDefaults_ExtensionRequiredVirtualControllerConfiguration(obj *ExtensionR
 		obj.ConcurrentSyncs = ptr.To(5)
 	}
 }
+
+// SetDefaults_OCICacheConfiguration sets defaults for the OCI cache configuration.
+func SetDefaults_OCICacheConfiguration(obj *OCICacheConfiguration) {
+	if obj.MaxSize == nil {
+		obj.MaxSize = ptr.To(resource.MustParse("100Mi"))
+	}
+}
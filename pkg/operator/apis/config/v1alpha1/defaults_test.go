@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 	"k8s.io/utils/ptr"
@@ -352,4 +353,22 @@ var _ = Describe("Defaults", func() {
 			})
 		})
 	})
+
+	Describe("OCI configuration defaulting", func() {
+		It("should default the maximum size of the cache", func() {
+			obj.OCI = &OCIConfiguration{Cache: &OCICacheConfiguration{}}
+
+			SetObjectDefaults_OperatorConfiguration(obj)
+
+			Expect(obj.OCI.Cache.MaxSize).To(PointTo(Equal(resource.MustParse("100Mi"))))
+		})
+
+		It("should not overwrite already set values for the cache", func() {
+			obj.OCI = &OCIConfiguration{Cache: &OCICacheConfiguration{MaxSize: ptr.To(resource.MustParse("1Gi"))}}
+
+			SetObjectDefaults_OperatorConfiguration(obj)
+
+			Expect(obj.OCI.Cache.MaxSize).To(PointTo(Equal(resource.MustParse("1Gi"))))
+		})
+	})
 })
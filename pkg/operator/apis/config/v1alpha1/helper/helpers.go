@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"fmt"
+
+	"k8s.io/utils/ptr"
+
+	operatorconfigv1alpha1 "github.com/gardener/gardener/pkg/operator/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+	"github.com/gardener/gardener/pkg/utils/oci"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// NewCAKeyBackend returns the key backend for the private keys of CAs configured in the given configuration. It returns
+// nil if no key backend is configured.
+func NewCAKeyBackend(c *operatorconfigv1alpha1.CAKeyBackendConfiguration) (secretsutils.KeyBackend, error) {
+	if c == nil || c.Vault == nil {
+		return nil, nil
+	}
+
+	return secretsutils.NewVaultKeyBackend(secretsutils.VaultKeyBackendOptions{
+		Address:   c.Vault.Address,
+		MountPath: ptr.Deref(c.Vault.MountPath, ""),
+		TokenFile: c.Vault.TokenFile,
+		CAFile:    ptr.Deref(c.Vault.CAFile, ""),
+	})
+}
+
+// NewHelmRegistryOptions returns the options for the HelmRegistry instances of gardener-operator based on the given
+// OCI configuration, i.e., the cache, the signature verification, and the registry mirrors for pulled Helm charts.
+func NewHelmRegistryOptions(c *operatorconfigv1alpha1.OCIConfiguration) (oci.HelmRegistryOptions, error) {
+	var (
+		opts      = oci.HelmRegistryOptions{Mirrors: RegistryMirrors(c)}
+		cacheOpts = oci.CacheOptions{MaxSize: oci.DefaultCacheMaxSize}
+		err       error
+	)
+
+	if c == nil {
+		c = &operatorconfigv1alpha1.OCIConfiguration{}
+	}
+
+	if c.Cache != nil {
+		cacheOpts.Directory = ptr.Deref(c.Cache.Directory, "")
+		if c.Cache.MaxSize != nil {
+			cacheOpts.MaxSize = c.Cache.MaxSize.Value()
+		}
+	}
+	if opts.Cache, err = oci.NewCache(cacheOpts); err != nil {
+		return oci.HelmRegistryOptions{}, fmt.Errorf("failed configuring cache for OCI artifacts: %w", err)
+	}
+
+	if c.Verification != nil && len(c.Verification.PublicKeys) > 0 {
+		if opts.Verifier, err = oci.NewVerifier(c.Verification.PublicKeys); err != nil {
+			return oci.HelmRegistryOptions{}, fmt.Errorf("failed configuring signature verification for OCI artifacts: %w", err)
+		}
+	}
+
+	return opts, nil
+}
+
+// RegistryMirrors returns the registry mirrors for container images and OCI artifacts configured in the given OCI
+// configuration.
+func RegistryMirrors(c *operatorconfigv1alpha1.OCIConfiguration) imagevector.Mirrors {
+	if c == nil || len(c.Mirrors) == 0 {
+		return nil
+	}
+
+	mirrors := make(imagevector.Mirrors, 0, len(c.Mirrors))
+	for _, mirror := range c.Mirrors {
+		mirrors = append(mirrors, imagevector.Mirror{Source: mirror.Source, Mirror: mirror.Mirror})
+	}
+	return mirrors
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	operatorconfigv1alpha1 "github.com/gardener/gardener/pkg/operator/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/operator/apis/config/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("helper", func() {
+	Describe("#NewCAKeyBackend", func() {
+		It("should return nil if no key backend is configured", func() {
+			Expect(NewCAKeyBackend(nil)).To(BeNil())
+			Expect(NewCAKeyBackend(&operatorconfigv1alpha1.CAKeyBackendConfiguration{})).To(BeNil())
+		})
+
+		It("should return the Vault key backend", func() {
+			backend, err := NewCAKeyBackend(&operatorconfigv1alpha1.CAKeyBackendConfiguration{
+				Vault: &operatorconfigv1alpha1.VaultCAKeyBackend{
+					Address:   "https://vault.example.com:8200",
+					TokenFile: "/var/run/secrets/vault/token",
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(backend.Name()).To(Equal(secretsutils.KeyBackendNameVault))
+		})
+	})
+
+	Describe("#NewHelmRegistryOptions", func() {
+		It("should return a cache and no verifier for an empty configuration", func() {
+			opts, err := NewHelmRegistryOptions(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(opts.Cache).NotTo(BeNil())
+			Expect(opts.Verifier).To(BeNil())
+			Expect(opts.Mirrors).To(BeEmpty())
+		})
+
+		It("should configure the cache, the verifier and the registry mirrors", func() {
+			privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			publicKey, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+			Expect(err).NotTo(HaveOccurred())
+
+			opts, err := NewHelmRegistryOptions(&operatorconfigv1alpha1.OCIConfiguration{
+				Cache:        &operatorconfigv1alpha1.OCICacheConfiguration{MaxSize: ptr.To(resource.MustParse("1Mi")), Directory: ptr.To(GinkgoT().TempDir())},
+				Verification: &operatorconfigv1alpha1.OCIVerificationConfiguration{PublicKeys: []string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}))}},
+				Mirrors:      []operatorconfigv1alpha1.OCIRegistryMirror{{Source: "registry.k8s.io", Mirror: "registry.example.com/k8s"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(opts.Cache).NotTo(BeNil())
+			Expect(opts.Verifier).NotTo(BeNil())
+			Expect(opts.Mirrors).To(Equal(imagevector.Mirrors{{Source: "registry.k8s.io", Mirror: "registry.example.com/k8s"}}))
+		})
+
+		It("should fail for an invalid cache size", func() {
+			_, err := NewHelmRegistryOptions(&operatorconfigv1alpha1.OCIConfiguration{
+				Cache: &operatorconfigv1alpha1.OCICacheConfiguration{MaxSize: ptr.To(resource.MustParse("0"))},
+			})
+			Expect(err).To(MatchError(ContainSubstring("failed configuring cache for OCI artifacts")))
+		})
+
+		It("should fail for invalid public keys", func() {
+			_, err := NewHelmRegistryOptions(&operatorconfigv1alpha1.OCIConfiguration{
+				Verification: &operatorconfigv1alpha1.OCIVerificationConfiguration{PublicKeys: []string{"foo"}},
+			})
+			Expect(err).To(MatchError(ContainSubstring("failed configuring signature verification for OCI artifacts")))
+		})
+	})
+
+	Describe("#RegistryMirrors", func() {
+		It("should return nil if no mirrors are configured", func() {
+			Expect(RegistryMirrors(nil)).To(BeNil())
+			Expect(RegistryMirrors(&operatorconfigv1alpha1.OCIConfiguration{})).To(BeNil())
+		})
+
+		It("should return the configured mirrors", func() {
+			Expect(RegistryMirrors(&operatorconfigv1alpha1.OCIConfiguration{
+				Mirrors: []operatorconfigv1alpha1.OCIRegistryMirror{
+					{Source: "europe-docker.pkg.dev/gardener-project", Mirror: "registry.example.com/gardener"},
+					{Source: "registry.k8s.io", Mirror: "registry.example.com/k8s"},
+				},
+			})).To(Equal(imagevector.Mirrors{
+				{Source: "europe-docker.pkg.dev/gardener-project", Mirror: "registry.example.com/gardener"},
+				{Source: "registry.k8s.io", Mirror: "registry.example.com/k8s"},
+			}))
+		})
+	})
+})
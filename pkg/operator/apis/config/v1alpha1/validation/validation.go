@@ -5,6 +5,11 @@
 package validation
 
 import (
+	"fmt"
+	"net"
+	"net/url"
+	"path/filepath"
+	"strings"
 	"time"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -16,6 +21,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/logger"
 	operatorconfigv1alpha1 "github.com/gardener/gardener/pkg/operator/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 )
 
@@ -37,6 +43,72 @@ func ValidateOperatorConfiguration(conf *operatorconfigv1alpha1.OperatorConfigur
 
 	allErrs = append(allErrs, validateControllerConfiguration(conf.Controllers, field.NewPath("controllers"))...)
 	allErrs = append(allErrs, validateNodeTolerationConfiguration(conf.NodeToleration, field.NewPath("nodeToleration"))...)
+	allErrs = append(allErrs, validateTracingConfiguration(conf.Tracing, field.NewPath("tracing"))...)
+	allErrs = append(allErrs, validateOCIConfiguration(conf.OCI, field.NewPath("oci"))...)
+	allErrs = append(allErrs, validateCAKeyBackendConfiguration(conf.CAKeyBackend, field.NewPath("caKeyBackend"))...)
+
+	return allErrs
+}
+
+func validateOCIConfiguration(conf *operatorconfigv1alpha1.OCIConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf == nil {
+		return allErrs
+	}
+
+	if cache := conf.Cache; cache != nil {
+		cachePath := fldPath.Child("cache")
+
+		if cache.MaxSize != nil && cache.MaxSize.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(cachePath.Child("maxSize"), cache.MaxSize.String(), "must be greater than 0"))
+		}
+		if cache.Directory != nil && !filepath.IsAbs(*cache.Directory) {
+			allErrs = append(allErrs, field.Invalid(cachePath.Child("directory"), *cache.Directory, "must be an absolute path"))
+		}
+	}
+
+	if verification := conf.Verification; verification != nil {
+		publicKeysPath := fldPath.Child("verification", "publicKeys")
+
+		if len(verification.PublicKeys) == 0 {
+			allErrs = append(allErrs, field.Required(publicKeysPath, "must provide at least one public key"))
+		}
+		for i, publicKey := range verification.PublicKeys {
+			if _, err := utils.DecodePublicKey([]byte(publicKey)); err != nil {
+				allErrs = append(allErrs, field.Invalid(publicKeysPath.Index(i), "<public key>", fmt.Sprintf("must be a PEM-encoded ECDSA, RSA, or Ed25519 public key: %v", err)))
+			}
+		}
+	}
+
+	sources := sets.New[string]()
+	for i, mirror := range conf.Mirrors {
+		idxPath := fldPath.Child("mirrors").Index(i)
+
+		allErrs = append(allErrs, validateRepositoryPrefix(mirror.Source, idxPath.Child("source"))...)
+		allErrs = append(allErrs, validateRepositoryPrefix(mirror.Mirror, idxPath.Child("mirror"))...)
+
+		source := strings.TrimSuffix(mirror.Source, "/")
+		if sources.Has(source) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("source"), mirror.Source))
+		}
+		sources.Insert(source)
+	}
+
+	return allErrs
+}
+
+func validateRepositoryPrefix(value string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch {
+	case strings.TrimSuffix(value, "/") == "":
+		allErrs = append(allErrs, field.Required(fldPath, "must provide a registry or repository"))
+	case strings.Contains(value, "://"):
+		allErrs = append(allErrs, field.Invalid(fldPath, value, "must not contain a scheme"))
+	case strings.Contains(value, "@") || (strings.Contains(value, "/") && strings.LastIndex(value, ":") > strings.Index(value, "/")):
+		allErrs = append(allErrs, field.Invalid(fldPath, value, "must not contain a tag or digest"))
+	}
 
 	return allErrs
 }
@@ -122,3 +194,44 @@ func validateNodeTolerationConfiguration(conf *operatorconfigv1alpha1.NodeTolera
 
 	return allErrs
 }
+
+func validateTracingConfiguration(conf *operatorconfigv1alpha1.TracingConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf == nil {
+		return allErrs
+	}
+
+	if len(conf.Endpoint) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("endpoint"), "must provide an endpoint"))
+	} else if _, _, err := net.SplitHostPort(conf.Endpoint); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("endpoint"), conf.Endpoint, fmt.Sprintf("must be of the form host:port: %v", err)))
+	}
+
+	return allErrs
+}
+
+func validateCAKeyBackendConfiguration(conf *operatorconfigv1alpha1.CAKeyBackendConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf == nil {
+		return allErrs
+	}
+
+	if conf.Vault == nil {
+		return append(allErrs, field.Required(fldPath.Child("vault"), "must configure a key backend"))
+	}
+
+	vaultPath := fldPath.Child("vault")
+	if u, err := url.ParseRequestURI(conf.Vault.Address); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(vaultPath.Child("address"), conf.Vault.Address, "must be an http or https URL"))
+	}
+	if !filepath.IsAbs(conf.Vault.TokenFile) {
+		allErrs = append(allErrs, field.Invalid(vaultPath.Child("tokenFile"), conf.Vault.TokenFile, "must be an absolute path"))
+	}
+	if conf.Vault.CAFile != nil && !filepath.IsAbs(*conf.Vault.CAFile) {
+		allErrs = append(allErrs, field.Invalid(vaultPath.Child("caFile"), *conf.Vault.CAFile, "must be an absolute path"))
+	}
+
+	return allErrs
+}
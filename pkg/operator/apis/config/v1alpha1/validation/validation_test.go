@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -292,4 +293,177 @@ var _ = Describe("#ValidateOperatorConfiguration", func() {
 			)
 		})
 	})
+
+	Context("tracing", func() {
+		It("should pass with unset tracing configuration", func() {
+			conf.Tracing = nil
+
+			Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should pass with valid tracing configuration", func() {
+			conf.Tracing = &operatorconfigv1alpha1.TracingConfiguration{Endpoint: "otel-collector.garden.svc:4317", Insecure: ptr.To(true)}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail with missing endpoint", func() {
+			conf.Tracing = &operatorconfigv1alpha1.TracingConfiguration{}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("tracing.endpoint"),
+				})),
+			))
+		})
+
+		It("should fail with invalid endpoint", func() {
+			conf.Tracing = &operatorconfigv1alpha1.TracingConfiguration{Endpoint: "otel-collector.garden.svc"}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("tracing.endpoint"),
+				})),
+			))
+		})
+	})
+
+	Context("oci", func() {
+		const publicKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAES5Uwza/veQxmhm2ryhPXzkXUJ3gk
+HDOGB+mkshU7p/y5Zx5olfRrrWuZdXPpoOsEKtWJBVH1gZDeOKG+qMgdwg==
+-----END PUBLIC KEY-----
+`
+
+		It("should pass with unset OCI configuration", func() {
+			conf.OCI = nil
+
+			Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should pass with valid OCI configuration", func() {
+			conf.OCI = &operatorconfigv1alpha1.OCIConfiguration{
+				Cache: &operatorconfigv1alpha1.OCICacheConfiguration{
+					MaxSize:   ptr.To(resource.MustParse("100Mi")),
+					Directory: ptr.To("/var/cache/gardener-operator/oci"),
+				},
+				Verification: &operatorconfigv1alpha1.OCIVerificationConfiguration{PublicKeys: []string{publicKey}},
+				Mirrors: []operatorconfigv1alpha1.OCIRegistryMirror{
+					{Source: "europe-docker.pkg.dev/gardener-project", Mirror: "registry.example.com:5000/gardener"},
+					{Source: "registry.k8s.io", Mirror: "registry.example.com:5000/k8s"},
+				},
+			}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail with invalid OCI configuration", func() {
+			conf.OCI = &operatorconfigv1alpha1.OCIConfiguration{
+				Cache:        &operatorconfigv1alpha1.OCICacheConfiguration{Directory: ptr.To("cache")},
+				Verification: &operatorconfigv1alpha1.OCIVerificationConfiguration{PublicKeys: []string{"foo"}},
+				Mirrors:      []operatorconfigv1alpha1.OCIRegistryMirror{{Source: "registry.k8s.io"}},
+			}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("oci.cache.directory"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("oci.verification.publicKeys[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("oci.mirrors[0].mirror"),
+				})),
+			))
+		})
+
+		It("should fail with invalid mirrors", func() {
+			conf.OCI = &operatorconfigv1alpha1.OCIConfiguration{
+				Cache: &operatorconfigv1alpha1.OCICacheConfiguration{MaxSize: ptr.To(resource.MustParse("0"))},
+				Mirrors: []operatorconfigv1alpha1.OCIRegistryMirror{
+					{Source: "https://quay.io", Mirror: "registry.example.com/quay:v1"},
+					{Source: "quay.io/", Mirror: "registry.example.com@sha256:foo"},
+				},
+			}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("oci.cache.maxSize"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("oci.mirrors[0].source"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("oci.mirrors[0].mirror"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("oci.mirrors[1].mirror"),
+				})),
+			))
+		})
+	})
+
+	Context("CA key backend", func() {
+		It("should pass with unset CA key backend configuration", func() {
+			conf.CAKeyBackend = nil
+
+			Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should pass with valid CA key backend configuration", func() {
+			conf.CAKeyBackend = &operatorconfigv1alpha1.CAKeyBackendConfiguration{
+				Vault: &operatorconfigv1alpha1.VaultCAKeyBackend{
+					Address:   "https://vault.example.com:8200",
+					TokenFile: "/var/run/secrets/vault/token",
+					CAFile:    ptr.To("/var/run/secrets/vault/ca.crt"),
+				},
+			}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail if no key backend is configured", func() {
+			conf.CAKeyBackend = &operatorconfigv1alpha1.CAKeyBackendConfiguration{}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("caKeyBackend.vault"),
+				})),
+			))
+		})
+
+		It("should fail with invalid CA key backend configuration", func() {
+			conf.CAKeyBackend = &operatorconfigv1alpha1.CAKeyBackendConfiguration{
+				Vault: &operatorconfigv1alpha1.VaultCAKeyBackend{
+					Address: "vault.example.com",
+					CAFile:  ptr.To("ca.crt"),
+				},
+			}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("caKeyBackend.vault.address"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("caKeyBackend.vault.tokenFile"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("caKeyBackend.vault.caFile"),
+				})),
+			))
+		})
+	})
 })
@@ -34,4 +34,9 @@ func SetObjectDefaults_OperatorConfiguration(in *OperatorConfiguration) {
 	SetDefaults_ExtensionCareControllerConfiguration(&in.Controllers.ExtensionCare)
 	SetDefaults_ExtensionRequiredRuntimeControllerConfiguration(&in.Controllers.ExtensionRequiredRuntime)
 	SetDefaults_ExtensionRequiredVirtualControllerConfiguration(&in.Controllers.ExtensionRequiredVirtual)
+	if in.OCI != nil {
+		if in.OCI.Cache != nil {
+			SetDefaults_OCICacheConfiguration(in.OCI.Cache)
+		}
+	}
 }
@@ -35,20 +35,22 @@ import (
 	"github.com/gardener/gardener/pkg/operator/controller/gardenlet"
 	"github.com/gardener/gardener/pkg/operator/controller/virtual"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/oci"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
 // AddToManager adds all controllers to the given manager.
-func AddToManager(operatorCancel context.CancelFunc, mgr manager.Manager, cfg *operatorconfigv1alpha1.OperatorConfiguration, gardenClientMap clientmap.ClientMap) error {
+func AddToManager(operatorCancel context.CancelFunc, mgr manager.Manager, cfg *operatorconfigv1alpha1.OperatorConfiguration, gardenClientMap clientmap.ClientMap, helmRegistry oci.Interface, caKeyBackend secretsutils.KeyBackend) error {
 	identity, err := gardenerutils.DetermineIdentity()
 	if err != nil {
 		return err
 	}
 
-	if err := garden.AddToManager(mgr, cfg, identity, gardenClientMap); err != nil {
+	if err := garden.AddToManager(mgr, cfg, identity, gardenClientMap, caKeyBackend); err != nil {
 		return err
 	}
 
-	if err := extension.AddToManager(mgr, cfg, gardenClientMap); err != nil {
+	if err := extension.AddToManager(mgr, cfg, gardenClientMap, helmRegistry); err != nil {
 		return err
 	}
 
@@ -103,7 +105,8 @@ func AddToManager(operatorCancel context.CancelFunc, mgr manager.Manager, cfg *o
 					}
 
 					return true, (&gardenlet.Reconciler{
-						Config: cfg.Controllers.GardenletDeployer,
+						Config:       cfg.Controllers.GardenletDeployer,
+						HelmRegistry: helmRegistry,
 						// garden.Spec.VirtualCluster.DNS.Domains[0].Name is immutable and always set.
 						DefaultGardenClusterAddress: fmt.Sprintf("https://%s", v1beta1helper.GetAPIServerDomain(garden.Spec.VirtualCluster.DNS.Domains[0].Name)),
 					}).AddToManager(ctx, mgr, virtualCluster)
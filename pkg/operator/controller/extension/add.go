@@ -12,13 +12,15 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
 	operatorconfigv1alpha1 "github.com/gardener/gardener/pkg/operator/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/operator/controller/extension/extension"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 // AddToManager adds the extension controllers to the given manager.
-func AddToManager(mgr manager.Manager, cfg *operatorconfigv1alpha1.OperatorConfiguration, gardenClientMap clientmap.ClientMap) error {
+func AddToManager(mgr manager.Manager, cfg *operatorconfigv1alpha1.OperatorConfiguration, gardenClientMap clientmap.ClientMap, helmRegistry oci.Interface) error {
 	if err := (&extension.Reconciler{
 		Config:          *cfg,
 		GardenClientMap: gardenClientMap,
+		HelmRegistry:    helmRegistry,
 	}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding main reconciler: %w", err)
 	}
@@ -66,7 +66,7 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 	}
 
 	if r.HelmRegistry == nil {
-		r.HelmRegistry = oci.NewHelmRegistry(r.RuntimeClientSet.Client())
+		r.HelmRegistry = oci.NewHelmRegistry(r.RuntimeClientSet.Client(), oci.HelmRegistryOptions{})
 	}
 
 	if r.GardenNamespace == "" {
@@ -18,6 +18,7 @@ import (
 	"github.com/gardener/gardener/pkg/operator/controller/garden/garden"
 	"github.com/gardener/gardener/pkg/operator/controller/garden/reference"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
 // AddToManager adds all Garden controllers to the given manager.
@@ -26,6 +27,7 @@ func AddToManager(
 	cfg *operatorconfigv1alpha1.OperatorConfiguration,
 	identity *gardencorev1beta1.Gardener,
 	gardenClientMap clientmap.ClientMap,
+	caKeyBackend secretsutils.KeyBackend,
 ) error {
 	var (
 		componentImageVectors imagevectorutils.ComponentImageVectors
@@ -44,6 +46,7 @@ func AddToManager(
 		Identity:              identity,
 		ComponentImageVectors: componentImageVectors,
 		GardenNamespace:       v1beta1constants.GardenNamespace,
+		CAKeyBackend:          caKeyBackend,
 	}).AddToManager(mgr, gardenClientMap); err != nil {
 		return fmt.Errorf("failed adding Garden controller: %w", err)
 	}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/Masterminds/semver/v3"
+	"go.opentelemetry.io/otel"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -22,6 +23,7 @@ import (
 	"github.com/gardener/gardener/pkg/apis/operator/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
+	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
 // ControllerName is the name of this controller.
@@ -58,6 +60,12 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenClientMap clientmap
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.Tracer == nil {
+		r.Tracer = otel.Tracer(ControllerName)
+	}
+	if r.FlowRecorder == nil {
+		r.FlowRecorder = flow.DefaultExecutionRecorder
+	}
 	if r.Recorder == nil {
 		r.Recorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
 	}
@@ -81,8 +81,10 @@ import (
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/logger"
+	operatorconfighelper "github.com/gardener/gardener/pkg/operator/apis/config/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
@@ -351,6 +353,10 @@ func (r *Reconciler) instantiateComponents(
 	return c, nil
 }
 
+func (r *Reconciler) registryMirrors() imagevectorutils.Mirrors {
+	return operatorconfighelper.RegistryMirrors(r.Config.OCI)
+}
+
 func (r *Reconciler) enableAdmissionControllerAuthorizers(ctx context.Context, version *semver.Version) (bool, error) {
 	// The reconcile flow deploys the kube-apiserver of the virtual garden cluster before the gardener-apiserver and
 	// gardener-admission-controller (it has to be this way, otherwise the Gardener components cannot start). However,
@@ -429,7 +435,7 @@ func (r *Reconciler) newGardenerResourceManager(garden *operatorv1alpha1.Garden,
 			},
 		},
 		VPAInPlaceUpdatesEnabled: features.DefaultFeatureGate.Enabled(features.VPAInPlaceUpdates),
-	})
+	}, r.registryMirrors())
 }
 
 func (r *Reconciler) newVirtualGardenGardenerResourceManager(secretsManager secretsmanager.Interface) (resourcemanager.Interface, error) {
@@ -442,7 +448,7 @@ func (r *Reconciler) newVirtualGardenGardenerResourceManager(secretsManager secr
 		RuntimeKubernetesVersion: r.RuntimeVersion,
 		SecretNameServerCA:       operatorv1alpha1.SecretNameCARuntime,
 		TargetNamespaces:         []string{v1beta1constants.GardenNamespace, metav1.NamespaceSystem, gardencorev1beta1.GardenerShootIssuerNamespace, gardencorev1beta1.GardenerSystemPublicNamespace},
-	})
+	}, r.registryMirrors())
 }
 
 func (r *Reconciler) newVerticalPodAutoscaler(garden *operatorv1alpha1.Garden, secretsManager secretsmanager.Interface) (component.DeployWaiter, error) {
@@ -466,6 +472,7 @@ func (r *Reconciler) newVerticalPodAutoscaler(garden *operatorv1alpha1.Garden, s
 		v1beta1constants.PriorityClassNameGardenSystem200,
 		true,
 		featureGates,
+		r.registryMirrors(),
 	)
 	if err != nil {
 		return nil, err
@@ -489,6 +496,7 @@ func (r *Reconciler) newEtcdDruid(secretsManager secretsmanager.Interface) (comp
 		operatorv1alpha1.SecretNameCARuntime,
 		v1beta1constants.PriorityClassNameGardenSystem300,
 		true,
+		r.registryMirrors(),
 	)
 }
 
@@ -746,6 +754,7 @@ func (r *Reconciler) newKubeAPIServer(
 		authenticationWebhookConfig,
 		authorizationWebhookConfigs,
 		resourcesToStoreInETCDEvents,
+		r.registryMirrors(),
 	)
 }
 
@@ -877,6 +886,7 @@ func (r *Reconciler) newKubeControllerManager(
 			ResourceQuota: ptr.To(time.Minute),
 		},
 		map[string]string{v1beta1constants.LabelCareConditionType: string(operatorv1alpha1.VirtualComponentsHealthy)},
+		r.registryMirrors(),
 	)
 }
 
@@ -887,6 +897,7 @@ func (r *Reconciler) newKubeStateMetrics() (component.DeployWaiter, error) {
 		r.RuntimeVersion,
 		v1beta1constants.PriorityClassNameGardenSystem100,
 		kubestatemetrics.SuffixRuntime,
+		r.registryMirrors(),
 	)
 }
 
@@ -918,6 +929,7 @@ func (r *Reconciler) newIstio(ctx context.Context, garden *operatorv1alpha1.Gard
 		garden.Spec.RuntimeCluster.Provider.Zones,
 		len(garden.Spec.RuntimeCluster.Networking.IPFamilies) == 2,
 		r.RuntimeVersion,
+		r.registryMirrors(),
 	)
 }
 
@@ -1059,11 +1071,12 @@ func (r *Reconciler) newNginxIngressController(garden *operatorv1alpha1.Garden,
 		ingressDomains,
 		ingressGatewayValues[0].Labels,
 		false,
+		r.registryMirrors(),
 	)
 }
 
 func (r *Reconciler) newGardenerMetricsExporter(secretsManager secretsmanager.Interface) (component.DeployWaiter, error) {
-	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerMetricsExporter)
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerMetricsExporter, imagevectorutils.RegistryMirrors(r.registryMirrors()))
 	if err != nil {
 		return nil, err
 	}
@@ -1088,6 +1101,7 @@ func (r *Reconciler) newPlutono(garden *operatorv1alpha1.Garden, secretsManager
 		vpaEnabled(garden.Spec.RuntimeCluster.Settings),
 		wildcardCertSecretName,
 		false,
+		r.registryMirrors(),
 	)
 }
 
@@ -1162,11 +1176,12 @@ func (r *Reconciler) newGardenerAPIServer(ctx context.Context, garden *operatorv
 		garden.Spec.VirtualCluster.Gardener.ClusterIdentity,
 		workloadIdentityTokenIssuer,
 		goAwayChance,
+		r.registryMirrors(),
 	)
 }
 
 func (r *Reconciler) newGardenerAdmissionController(garden *operatorv1alpha1.Garden, secretsManager secretsmanager.Interface, enableAuthorizerRestrictions bool) (component.DeployWaiter, error) {
-	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerAdmissionController)
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerAdmissionController, imagevectorutils.RegistryMirrors(r.registryMirrors()))
 	if err != nil {
 		return nil, err
 	}
@@ -1191,7 +1206,7 @@ func (r *Reconciler) newGardenerAdmissionController(garden *operatorv1alpha1.Gar
 }
 
 func (r *Reconciler) newGardenerControllerManager(garden *operatorv1alpha1.Garden, secretsManager secretsmanager.Interface) (component.DeployWaiter, error) {
-	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerControllerManager)
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerControllerManager, imagevectorutils.RegistryMirrors(r.registryMirrors()))
 	if err != nil {
 		return nil, err
 	}
@@ -1220,7 +1235,7 @@ func (r *Reconciler) newGardenerControllerManager(garden *operatorv1alpha1.Garde
 }
 
 func (r *Reconciler) newGardenerScheduler(garden *operatorv1alpha1.Garden, secretsManager secretsmanager.Interface) (component.DeployWaiter, error) {
-	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerScheduler)
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerScheduler, imagevectorutils.RegistryMirrors(r.registryMirrors()))
 	if err != nil {
 		return nil, err
 	}
@@ -1242,7 +1257,7 @@ func (r *Reconciler) newGardenerScheduler(garden *operatorv1alpha1.Garden, secre
 }
 
 func (r *Reconciler) newGardenerDashboard(garden *operatorv1alpha1.Garden, secretsManager secretsmanager.Interface, wildcardCertSecretName *string) (gardenerdashboard.Interface, error) {
-	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerDashboard)
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerDashboard, imagevectorutils.RegistryMirrors(r.registryMirrors()))
 	if err != nil {
 		return nil, err
 	}
@@ -1312,7 +1327,7 @@ func (r *Reconciler) newGardenerDashboard(garden *operatorv1alpha1.Garden, secre
 }
 
 func (r *Reconciler) newTerminalControllerManager(garden *operatorv1alpha1.Garden, secretsManager secretsmanager.Interface) (component.DeployWaiter, error) {
-	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameTerminalControllerManager)
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameTerminalControllerManager, imagevectorutils.RegistryMirrors(r.registryMirrors()))
 	if err != nil {
 		return nil, err
 	}
@@ -1337,6 +1352,7 @@ func (r *Reconciler) newFluentOperator() (component.DeployWaiter, error) {
 		r.GardenNamespace,
 		true,
 		v1beta1constants.PriorityClassNameGardenSystem100,
+		r.registryMirrors(),
 	)
 }
 
@@ -1347,6 +1363,7 @@ func (r *Reconciler) newFluentBit() (component.DeployWaiter, error) {
 		true,
 		true,
 		v1beta1constants.PriorityClassNameGardenSystem100,
+		r.registryMirrors(),
 	)
 }
 
@@ -1373,6 +1390,7 @@ func (r *Reconciler) newVali() (component.Deployer, error) {
 		nil,
 		"",
 		true,
+		r.registryMirrors(),
 	)
 }
 
@@ -1381,6 +1399,7 @@ func (r *Reconciler) newPrometheusOperator() (component.DeployWaiter, error) {
 		r.RuntimeClientSet.Client(),
 		r.GardenNamespace,
 		v1beta1constants.PriorityClassNameGardenSystem100,
+		r.registryMirrors(),
 	)
 }
 
@@ -1398,7 +1417,7 @@ func (r *Reconciler) newAlertmanager(log logr.Logger, garden *operatorv1alpha1.G
 			SigningCA:              operatorv1alpha1.SecretNameCARuntime,
 			WildcardCertSecretName: wildcardCertSecretName,
 		},
-	})
+	}, r.registryMirrors())
 }
 
 func (r *Reconciler) newPrometheusGarden(log logr.Logger, garden *operatorv1alpha1.Garden, secretsManager secretsmanager.Interface, ingressDomain string, wildcardCertSecretName *string) (prometheus.Interface, error) {
@@ -1449,11 +1468,11 @@ func (r *Reconciler) newPrometheusGarden(log logr.Logger, garden *operatorv1alph
 			WildcardCertSecretName: wildcardCertSecretName,
 		},
 		TargetCluster: &prometheus.TargetClusterValues{ServiceAccountName: gardenprometheus.ServiceAccountName},
-	})
+	}, r.registryMirrors())
 }
 
 func (r *Reconciler) newPrometheusLongTerm(log logr.Logger, garden *operatorv1alpha1.Garden, secretsManager secretsmanager.Interface, ingressDomain string, wildcardCertSecretName *string) (prometheus.Interface, error) {
-	imageCortex, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameCortex)
+	imageCortex, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameCortex, imagevectorutils.RegistryMirrors(r.registryMirrors()))
 	if err != nil {
 		return nil, err
 	}
@@ -1483,7 +1502,7 @@ func (r *Reconciler) newPrometheusLongTerm(log logr.Logger, garden *operatorv1al
 			Image:         imageCortex.String(),
 			CacheValidity: 7 * 24 * time.Hour, // 1 week
 		},
-	})
+	}, r.registryMirrors())
 }
 
 func (r *Reconciler) newBlackboxExporter(garden *operatorv1alpha1.Garden, secretsManager secretsmanager.Interface, wildcardCertSecretName *string) (component.DeployWaiter, error) {
@@ -1525,6 +1544,7 @@ func (r *Reconciler) newBlackboxExporter(garden *operatorv1alpha1.Garden, secret
 			ScrapeConfigs:     gardenblackboxexporter.ScrapeConfig(r.GardenNamespace, kubeAPIServerTargets, gardenerDashboardTarget),
 			Replicas:          1,
 		},
+		r.registryMirrors(),
 	)
 }
 
@@ -1533,6 +1553,7 @@ func (r *Reconciler) newPersesOperator() (component.DeployWaiter, error) {
 		r.RuntimeClientSet.Client(),
 		r.GardenNamespace,
 		v1beta1constants.PriorityClassNameGardenSystem100,
+		r.registryMirrors(),
 	)
 }
 
@@ -1542,7 +1563,7 @@ func (r *Reconciler) newGardenerDiscoveryServer(
 	wildcardCertSecretName *string,
 	workloadIdentityTokenIssuer string,
 ) (component.DeployWaiter, error) {
-	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerDiscoveryServer)
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenerDiscoveryServer, imagevectorutils.RegistryMirrors(r.registryMirrors()))
 	if err != nil {
 		return nil, err
 	}
@@ -1566,6 +1587,7 @@ func (r *Reconciler) newOpenTelemetryOperator() (component.DeployWaiter, error)
 		r.GardenNamespace,
 		true,
 		v1beta1constants.PriorityClassNameGardenSystem100,
+		r.registryMirrors(),
 	)
 }
 
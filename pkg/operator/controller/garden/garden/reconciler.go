@@ -14,6 +14,7 @@ import (
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/trace"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -57,10 +58,13 @@ type Reconciler struct {
 	RuntimeVersion        *semver.Version
 	Config                operatorconfigv1alpha1.OperatorConfiguration
 	Clock                 clock.Clock
+	Tracer                trace.Tracer
+	FlowRecorder          *flow.ExecutionRecorder
 	Recorder              record.EventRecorder
 	Identity              *gardencorev1beta1.Gardener
 	ComponentImageVectors imagevector.ComponentImageVectors
 	GardenNamespace       string
+	CAKeyBackend          secretsutils.KeyBackend
 	// GardenClientMap is the ClientMap used to communicate with the virtual garden cluster. It should be set by AddToManager function but the field is still public for usage in tests.
 	GardenClientMap clientmap.ClientMap
 }
@@ -106,6 +110,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		secretsmanager.Config{
 			CASecretAutoRotation: true,
 			SecretNamesToTimes:   lastSecretRotationStartTimes(garden),
+			CAKeyBackend:         r.CAKeyBackend,
 		},
 		r.GardenNamespace,
 	)
@@ -155,6 +160,11 @@ func (r *Reconciler) ensureAtMostOneGardenExists(ctx context.Context) error {
 	return fmt.Errorf("there can be at most one operator.gardener.cloud/v1alpha1.Garden resource in the system at a time")
 }
 
+// gardenFlowRecordKey returns the key under which the flow executions for the given Garden are recorded.
+func gardenFlowRecordKey(garden *operatorv1alpha1.Garden) string {
+	return "Garden " + garden.Name
+}
+
 func (r *Reconciler) reportProgress(log logr.Logger, garden *operatorv1alpha1.Garden, reportProgress bool) flow.ProgressReporter {
 	return flow.NewDelayingProgressReporter(clock.RealClock{}, func(ctx context.Context, stats *flow.Stats) {
 		patch := client.MergeFrom(garden.DeepCopy())
@@ -540,7 +550,7 @@ func nonAutoRotatedCACertConfigurations() []secretsutils.ConfigInterface {
 		&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCAETCD, CommonName: "etcd", CertType: secretsutils.CACert},
 		&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCAETCDPeer, CommonName: "etcd-peer", CertType: secretsutils.CACert},
 		&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCACluster, CommonName: "kubernetes", CertType: secretsutils.CACert},
-		&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCAClient, CommonName: "kubernetes-client", CertType: secretsutils.CACert},
+		&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCAClient, CommonName: "kubernetes-client", CertType: secretsutils.CACert, ExportablePrivateKey: true},
 		&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCAFrontProxy, CommonName: "front-proxy", CertType: secretsutils.CACert},
 		&secretsutils.CertificateSecretConfig{Name: operatorv1alpha1.SecretNameCAGardener, CommonName: "gardener", CertType: secretsutils.CACert},
 	}
@@ -438,17 +438,23 @@ func (r *Reconciler) delete(
 	if err := g.Compile().Run(ctx, flow.Opts{
 		Log:              log,
 		ProgressReporter: r.reportProgress(log, gardenCopy, true),
+		Tracer:           r.Tracer,
+		Recorder:         r.FlowRecorder,
+		RecordKey:        gardenFlowRecordKey(garden),
 	}); err != nil {
 		return reconcilerutils.ReconcileErr(flow.Errors(err))
 	}
 	*garden = *gardenCopy
 
+	secretsmanager.DeleteMetrics(r.GardenNamespace)
+
 	if controllerutil.ContainsFinalizer(garden, operatorv1alpha1.FinalizerName) {
 		log.Info("Removing finalizer")
 		if err := controllerutils.RemoveFinalizers(ctx, r.RuntimeClientSet.Client(), garden, operatorv1alpha1.FinalizerName); err != nil {
 			return reconcile.Result{}, fmt.Errorf("failed to remove finalizer: %w", err)
 		}
 	}
+	r.FlowRecorder.Forget(gardenFlowRecordKey(garden))
 
 	return reconcile.Result{}, nil
 }
@@ -73,6 +73,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils/gardener/operator"
 	"github.com/gardener/gardener/pkg/utils/gardener/secretsrotation"
 	"github.com/gardener/gardener/pkg/utils/gardener/tokenrequest"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/retry"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
@@ -639,6 +640,9 @@ func (r *Reconciler) reconcile(
 	if err := g.Compile().Run(ctx, flow.Opts{
 		Log:              log,
 		ProgressReporter: r.reportProgress(log, gardenCopy, true),
+		Tracer:           r.Tracer,
+		Recorder:         r.FlowRecorder,
+		RecordKey:        gardenFlowRecordKey(garden),
 	}); err != nil {
 		return reconcile.Result{}, flow.Errors(err)
 	}
@@ -767,6 +771,9 @@ func (r *Reconciler) runRuntimeSetupFlow(ctx context.Context, log logr.Logger, g
 	if err := g.Compile().Run(ctx, flow.Opts{
 		Log:              log,
 		ProgressReporter: r.reportProgress(log, garden.DeepCopy(), false),
+		Tracer:           r.Tracer,
+		Recorder:         r.FlowRecorder,
+		RecordKey:        gardenFlowRecordKey(garden),
 	}); err != nil {
 		return flow.Errors(err)
 	}
@@ -1140,7 +1147,7 @@ func (r *Reconciler) deployLongTermPrometheus(ctx context.Context, secretsManage
 }
 
 func (r *Reconciler) updateHelmChartRefForGardenlets(ctx context.Context, log logr.Logger, virtualClusterClient client.Client) error {
-	gardenletChartImage, err := imagevector.Charts().FindImage(imagevector.ChartImageNameGardenlet)
+	gardenletChartImage, err := imagevector.Charts().FindImage(imagevector.ChartImageNameGardenlet, imagevectorutils.RegistryMirrors(r.registryMirrors()))
 	if err != nil {
 		return err
 	}
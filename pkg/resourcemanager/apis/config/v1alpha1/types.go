@@ -145,6 +145,29 @@ type HealthControllerConfig struct {
 	// SyncPeriod is the duration how often the controller performs its reconciliation.
 	// +optional
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// Rules contains CEL-based health and progressing rules for resources of arbitrary kinds. A rule takes precedence
+	// over the built-in checks for the respective kind.
+	// +optional
+	Rules []HealthRule `json:"rules,omitempty"`
+}
+
+// HealthRule contains CEL expressions for checking the health and progressing state of resources of a certain kind.
+// The expressions are evaluated against the resource which is accessible via the `object` variable. They must evaluate
+// to either a bool or a string.
+type HealthRule struct {
+	// APIGroup is the API group of the resources. It is empty for the core API group.
+	// +optional
+	APIGroup string `json:"apiGroup,omitempty"`
+	// Kind is the kind of the resources.
+	Kind string `json:"kind"`
+	// Health is the CEL expression for checking the health of the resources. A resource is healthy if the expression
+	// evaluates to true or to an empty string. A non-empty string is used as reason why the resource is unhealthy.
+	// +optional
+	Health *string `json:"health,omitempty"`
+	// Progressing is the CEL expression for checking whether the resources are progressing. A resource is progressing
+	// if the expression evaluates to true or to a non-empty string, which is used as reason.
+	// +optional
+	Progressing *string `json:"progressing,omitempty"`
 }
 
 // ManagedResourceControllerConfig is the configuration for the managed resource controller.
@@ -9,12 +9,14 @@ import (
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/logger"
 	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 )
@@ -98,8 +100,7 @@ func validateResourceManagerControllerConfiguration(conf resourcemanagerconfigv1
 		allErrs = append(allErrs, validateSyncPeriod(conf.GarbageCollector.SyncPeriod, fldPath.Child("garbageCollector"))...)
 	}
 
-	allErrs = append(allErrs, validateConcurrentSyncs(conf.Health.ConcurrentSyncs, fldPath.Child("health"))...)
-	allErrs = append(allErrs, validateSyncPeriod(conf.Health.SyncPeriod, fldPath.Child("health"))...)
+	allErrs = append(allErrs, validateHealthControllerConfiguration(conf.Health, fldPath.Child("health"))...)
 
 	allErrs = append(allErrs, validateManagedResourceControllerConfiguration(conf.ManagedResource, fldPath.Child("managedResources"))...)
 
@@ -114,6 +115,51 @@ func validateResourceManagerControllerConfiguration(conf resourcemanagerconfigv1
 	return allErrs
 }
 
+func validateHealthControllerConfiguration(conf resourcemanagerconfigv1alpha1.HealthControllerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateConcurrentSyncs(conf.ConcurrentSyncs, fldPath)...)
+	allErrs = append(allErrs, validateSyncPeriod(conf.SyncPeriod, fldPath)...)
+
+	groupKinds := sets.New[schema.GroupKind]()
+	for i, rule := range conf.Rules {
+		idxPath := fldPath.Child("rules").Index(i)
+
+		if len(rule.Kind) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("kind"), "must specify kind"))
+		}
+
+		groupKind := schema.GroupKind{Group: rule.APIGroup, Kind: rule.Kind}
+		if groupKinds.Has(groupKind) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, groupKind.String()))
+		}
+		groupKinds.Insert(groupKind)
+
+		if rule.Health == nil && rule.Progressing == nil {
+			allErrs = append(allErrs, field.Required(idxPath, "must specify at least one of health or progressing"))
+		}
+
+		allErrs = append(allErrs, validateCELRule(rule.Health, idxPath.Child("health"))...)
+		allErrs = append(allErrs, validateCELRule(rule.Progressing, idxPath.Child("progressing"))...)
+	}
+
+	return allErrs
+}
+
+func validateCELRule(expression *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if expression == nil {
+		return allErrs
+	}
+
+	if _, err := health.NewCELRule(*expression); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, *expression, err.Error()))
+	}
+
+	return allErrs
+}
+
 func validateManagedResourceControllerConfiguration(conf resourcemanagerconfigv1alpha1.ManagedResourceControllerConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
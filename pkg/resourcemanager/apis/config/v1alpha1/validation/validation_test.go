@@ -323,6 +323,48 @@ var _ = Describe("Validation", func() {
 						})),
 					))
 				})
+				It("should allow valid health rules", func() {
+					conf.Controllers.Health.Rules = []resourcemanagerconfigv1alpha1.HealthRule{
+						{APIGroup: "example.com", Kind: "Foo", Health: ptr.To(`object.status.phase == "Ready"`)},
+						{APIGroup: "example.com", Kind: "Bar", Progressing: ptr.To(`object.status.observedGeneration < object.metadata.generation ? "outdated" : ""`)},
+						{Kind: "ConfigMap", Health: ptr.To(`has(object.data)`), Progressing: ptr.To(`false`)},
+					}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+
+				It("should return errors because health rules are invalid", func() {
+					conf.Controllers.Health.Rules = []resourcemanagerconfigv1alpha1.HealthRule{
+						{APIGroup: "example.com", Health: ptr.To(`true`)},
+						{APIGroup: "example.com", Kind: "Foo"},
+						{APIGroup: "example.com", Kind: "Foo", Health: ptr.To(`object.status.`), Progressing: ptr.To(`1 + 1`)},
+					}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("controllers.health.rules[0].kind"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("controllers.health.rules[1]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("controllers.health.rules[2]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("controllers.health.rules[2].health"),
+							"Detail": ContainSubstring("failed compiling CEL expression"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("controllers.health.rules[2].progressing"),
+							"Detail": Equal("CEL expression must evaluate to bool or string, got int"),
+						})),
+					))
+				})
 			})
 
 			Context("managed resources", func() {
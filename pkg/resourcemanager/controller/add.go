@@ -22,6 +22,7 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/csrapprover"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/health"
+	healthutils "github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/managedresource"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/networkpolicy"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/node"
@@ -53,7 +54,12 @@ func AddToManager(ctx context.Context, mgr manager.Manager, sourceCluster, targe
 		}
 	}
 
-	if err := health.AddToManager(ctx, mgr, sourceCluster, targetCluster, *cfg); err != nil {
+	healthRules, err := healthutils.NewRules(cfg.Controllers.Health.Rules)
+	if err != nil {
+		return fmt.Errorf("failed compiling health rules: %w", err)
+	}
+
+	if err := health.AddToManager(ctx, mgr, sourceCluster, targetCluster, *cfg, healthRules); err != nil {
 		return fmt.Errorf("failed adding health controller: %w", err)
 	}
 
@@ -62,6 +68,7 @@ func AddToManager(ctx context.Context, mgr manager.Manager, sourceCluster, targe
 		ClassFilter:               resourcemanagerpredicate.NewClassFilter(*cfg.Controllers.ResourceClass),
 		ClusterID:                 *cfg.Controllers.ClusterID,
 		GarbageCollectorActivated: cfg.Controllers.GarbageCollector.Enabled,
+		HealthRules:               healthRules,
 	}).AddToManager(mgr, sourceCluster, targetCluster); err != nil {
 		return fmt.Errorf("failed adding managed resource controller: %w", err)
 	}
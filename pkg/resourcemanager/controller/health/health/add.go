@@ -102,7 +102,7 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 			targetCluster.GetCache(),
 			obj,
 			handler.EnqueueRequestsFromMapFunc(utils.MapToOriginManagedResource(c.GetLogger(), clusterID)),
-			utils.HealthStatusChanged(c.GetLogger()),
+			utils.HealthStatusChanged(c.GetLogger(), r.Rules, gvk.GroupKind()),
 		)); err != nil {
 			return fmt.Errorf("error starting watch for GVK %s: %w", gvk.String(), err)
 		}
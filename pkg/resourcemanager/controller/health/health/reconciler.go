@@ -13,6 +13,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/clock"
@@ -37,6 +38,7 @@ type Reconciler struct {
 	Config       resourcemanagerconfigv1alpha1.HealthControllerConfig
 	Clock        clock.Clock
 	ClassFilter  *resourcemanagerpredicate.ClassFilter
+	Rules        utils.Rules
 
 	// ensureWatchForGVK ensures that the controller is watching the given object to reconcile corresponding
 	// ManagedResources on health status changes.
@@ -101,7 +103,7 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 			objectLog = log.WithValues("object", objectKey, "objectGVK", objectGVK)
 		)
 
-		obj, err := newObjectForHealthCheck(objectLog, r.TargetScheme, objectGVK)
+		obj, err := newObjectForHealthCheck(objectLog, r.TargetScheme, objectGVK, r.Rules.HealthRuleFor(objectGVK.GroupKind()) != nil)
 		if err != nil {
 			return reconcile.Result{}, fmt.Errorf("failed to construct new object for reference: %w", err)
 		}
@@ -134,7 +136,7 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 			return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 		}
 
-		if checked, err := utils.CheckHealth(obj); err != nil {
+		if checked, err := utils.CheckHealthWithRules(r.Rules, objectGVK.GroupKind(), obj); err != nil {
 			var (
 				reason  = ref.Kind + "Unhealthy"
 				message = fmt.Sprintf("%s %q is unhealthy: %v", ref.Kind, objectKey.String(), err)
@@ -180,9 +182,11 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 }
 
-func newObjectForHealthCheck(log logr.Logger, scheme *runtime.Scheme, gvk schema.GroupVersionKind) (client.Object, error) {
+func newObjectForHealthCheck(log logr.Logger, scheme *runtime.Scheme, gvk schema.GroupVersionKind, hasHealthRule bool) (client.Object, error) {
 	// Create a typed object if GVK is registered in scheme. This object will be fully watched in the target cluster.
-	// If we don't know the GVK, we definitely don't have a dedicated health check for it.
+	// If we don't know the GVK but there is a health rule for it, the rule needs the full object, hence, we use an
+	// unstructured object.
+	// Otherwise, we definitely don't have a dedicated health check for it.
 	// I.e., we only care about whether the object is present or not.
 	// Hence, we can use metadata-only requests/watches instead of watching the entire object, which saves bandwidth and
 	// memory.
@@ -193,6 +197,13 @@ func newObjectForHealthCheck(log logr.Logger, scheme *runtime.Scheme, gvk schema
 			return nil, err
 		}
 
+		if hasHealthRule {
+			log.V(1).Info("Using unstructured object for health rule (not registered in the target scheme)", "groupVersionKind", gvk)
+			obj := &unstructured.Unstructured{}
+			obj.SetGroupVersionKind(gvk)
+			return obj, nil
+		}
+
 		log.V(1).Info("Falling back to metadata-only object for health checks (not registered in the target scheme)", "groupVersionKind", gvk, "err", err.Error())
 		obj := &metav1.PartialObjectMetadata{}
 		obj.SetGroupVersionKind(gvk)
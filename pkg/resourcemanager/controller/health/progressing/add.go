@@ -19,6 +19,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -132,8 +133,13 @@ func (r *Reconciler) ProgressingStatusChanged(ctx context.Context) predicate.Pre
 				return true
 			}
 
-			oldProgressing, _, _ := r.checkProgressing(ctx, e.ObjectOld)
-			newProgressing, _, _ := r.checkProgressing(ctx, e.ObjectNew)
+			gvk, err := apiutil.GVKForObject(e.ObjectNew, r.TargetClient.Scheme())
+			if err != nil {
+				return false
+			}
+
+			oldProgressing, _, _ := r.checkProgressingWithRules(ctx, gvk.GroupKind(), e.ObjectOld)
+			newProgressing, _, _ := r.checkProgressingWithRules(ctx, gvk.GroupKind(), e.ObjectNew)
 
 			return oldProgressing != newProgressing
 		},
@@ -15,6 +15,9 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -38,6 +41,7 @@ type Reconciler struct {
 	Config       resourcemanagerconfigv1alpha1.HealthControllerConfig
 	Clock        clock.Clock
 	ClassFilter  *resourcemanagerpredicate.ClassFilter
+	Rules        utils.Rules
 }
 
 // Reconcile performs the progressing checks.
@@ -90,8 +94,13 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	conditionResourcesProgressing := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesProgressing)
 
 	for _, ref := range mr.Status.Resources {
+		var (
+			groupKind = ref.GroupVersionKind().GroupKind()
+			rule      = r.Rules.ProgressingRuleFor(groupKind)
+		)
+
 		// Skip API groups that are irrelevant for progressing checks.
-		if !sets.New(appsv1.GroupName, monitoring.GroupName, certv1alpha1.GroupName).Has(ref.GroupVersionKind().Group) {
+		if rule == nil && !sets.New(appsv1.GroupName, monitoring.GroupName, certv1alpha1.GroupName).Has(groupKind.Group) {
 			continue
 		}
 
@@ -112,7 +121,12 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 			obj = &certv1alpha1.Certificate{}
 		case "Issuer":
 			obj = &certv1alpha1.Issuer{}
-		default:
+		}
+
+		if rule != nil {
+			obj = newObjectForProgressingRule(r.TargetClient.Scheme(), ref.GroupVersionKind())
+		}
+		if obj == nil {
 			continue
 		}
 
@@ -129,7 +143,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 			return reconcile.Result{}, err
 		}
 
-		if progressing, description, err := r.checkProgressing(ctx, obj); err != nil {
+		if progressing, description, err := r.checkProgressingWithRules(ctx, groupKind, obj); err != nil {
 			return reconcile.Result{}, err
 		} else if progressing {
 			var (
@@ -171,6 +185,35 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 }
 
+// newObjectForProgressingRule returns a typed object if the GVK is registered in the scheme and an unstructured object
+// otherwise since progressing rules need the full object.
+func newObjectForProgressingRule(scheme *runtime.Scheme, gvk schema.GroupVersionKind) client.Object {
+	if typedObject, err := scheme.New(gvk); err == nil {
+		if obj, ok := typedObject.(client.Object); ok {
+			return obj
+		}
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return obj
+}
+
+// checkProgressingWithRules checks whether the given object is progressing. If there is a progressing rule for the
+// given kind, it is evaluated instead of the built-in checks of checkProgressing.
+func (r *Reconciler) checkProgressingWithRules(ctx context.Context, groupKind schema.GroupKind, obj client.Object) (bool, string, error) {
+	rule := r.Rules.ProgressingRuleFor(groupKind)
+	if rule == nil {
+		return r.checkProgressing(ctx, obj)
+	}
+
+	if obj.GetAnnotations()[resourcesv1alpha1.SkipHealthCheck] == "true" {
+		return false, "", nil
+	}
+
+	return health.IsProgressingWithCELRule(obj, rule)
+}
+
 // checkProgressing checks whether the given object is progressing. It returns a bool indicating whether the object is
 // progressing, a reason for it if so and an error if the check failed.
 func (r *Reconciler) checkProgressing(ctx context.Context, obj client.Object) (bool, string, error) {
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+// Rules contains the compiled CEL-based health and progressing rules per kind.
+type Rules map[schema.GroupKind]Rule
+
+// Rule contains the compiled CEL-based health and progressing rules for a kind.
+type Rule struct {
+	// Health is the rule for checking the health. It is nil if the built-in checks should be used.
+	Health *health.CELRule
+	// Progressing is the rule for checking whether the resources are progressing. It is nil if the built-in checks
+	// should be used.
+	Progressing *health.CELRule
+}
+
+// NewRules compiles the given health rules.
+func NewRules(healthRules []resourcemanagerconfigv1alpha1.HealthRule) (Rules, error) {
+	rules := make(Rules, len(healthRules))
+
+	for _, healthRule := range healthRules {
+		var (
+			groupKind = schema.GroupKind{Group: healthRule.APIGroup, Kind: healthRule.Kind}
+			rule      Rule
+			err       error
+		)
+
+		if healthRule.Health != nil {
+			if rule.Health, err = health.NewCELRule(*healthRule.Health); err != nil {
+				return nil, fmt.Errorf("failed compiling health rule for %s: %w", groupKind, err)
+			}
+		}
+		if healthRule.Progressing != nil {
+			if rule.Progressing, err = health.NewCELRule(*healthRule.Progressing); err != nil {
+				return nil, fmt.Errorf("failed compiling progressing rule for %s: %w", groupKind, err)
+			}
+		}
+
+		rules[groupKind] = rule
+	}
+
+	return rules, nil
+}
+
+// HealthRuleFor returns the health rule for the given kind, or nil if there is none.
+func (r Rules) HealthRuleFor(groupKind schema.GroupKind) *health.CELRule {
+	return r[groupKind].Health
+}
+
+// ProgressingRuleFor returns the progressing rule for the given kind, or nil if there is none.
+func (r Rules) ProgressingRuleFor(groupKind schema.GroupKind) *health.CELRule {
+	return r[groupKind].Progressing
+}
+
+// CheckHealthWithRules checks whether the given object is healthy. If there is a health rule for the given kind, it
+// is evaluated instead of the built-in checks of CheckHealth.
+// It returns a bool indicating whether the object was actually checked and an error if any health check failed.
+func CheckHealthWithRules(rules Rules, groupKind schema.GroupKind, obj client.Object) (bool, error) {
+	rule := rules.HealthRuleFor(groupKind)
+	if rule == nil {
+		return CheckHealth(obj)
+	}
+
+	if obj.GetAnnotations()[resourcesv1alpha1.SkipHealthCheck] == "true" {
+		return false, nil
+	}
+
+	if err := health.CheckWithCELRule(obj, rule); err != nil {
+		return !errors.Is(err, health.ErrCELRuleEvaluation), err
+	}
+	return true, nil
+}
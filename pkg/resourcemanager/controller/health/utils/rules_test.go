@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+var _ = Describe("Rules", func() {
+	var (
+		fooGroupKind = schema.GroupKind{Group: "example.com", Kind: "Foo"}
+		podGroupKind = schema.GroupKind{Kind: "Pod"}
+	)
+
+	Describe("#NewRules", func() {
+		It("should compile the rules", func() {
+			rules, err := NewRules([]resourcemanagerconfigv1alpha1.HealthRule{
+				{APIGroup: "example.com", Kind: "Foo", Health: ptr.To(`object.status.ready`)},
+				{Kind: "Pod", Progressing: ptr.To(`object.status.phase == "Pending"`)},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(rules.HealthRuleFor(fooGroupKind).String()).To(Equal(`object.status.ready`))
+			Expect(rules.ProgressingRuleFor(fooGroupKind)).To(BeNil())
+			Expect(rules.HealthRuleFor(podGroupKind)).To(BeNil())
+			Expect(rules.ProgressingRuleFor(podGroupKind).String()).To(Equal(`object.status.phase == "Pending"`))
+		})
+
+		It("should fail for invalid rules", func() {
+			_, err := NewRules([]resourcemanagerconfigv1alpha1.HealthRule{
+				{APIGroup: "example.com", Kind: "Foo", Health: ptr.To(`object.status.`)},
+			})
+			Expect(err).To(MatchError(ContainSubstring("failed compiling health rule for Foo.example.com")))
+		})
+
+		It("should return no rules for nil rules", func() {
+			var rules Rules
+			Expect(rules.HealthRuleFor(fooGroupKind)).To(BeNil())
+			Expect(rules.ProgressingRuleFor(fooGroupKind)).To(BeNil())
+		})
+	})
+
+	Describe("#CheckHealthWithRules", func() {
+		var (
+			rules Rules
+			obj   *unstructured.Unstructured
+		)
+
+		BeforeEach(func() {
+			var err error
+			rules, err = NewRules([]resourcemanagerconfigv1alpha1.HealthRule{
+				{APIGroup: "example.com", Kind: "Foo", Health: ptr.To(`object.status.ready`)},
+				{Kind: "Pod", Health: ptr.To(`object.status.phase == "Running"`)},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			obj = &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "example.com/v1",
+				"kind":       "Foo",
+				"status":     map[string]any{"ready": false},
+			}}
+		})
+
+		It("should evaluate the health rule", func() {
+			checked, err := CheckHealthWithRules(rules, fooGroupKind, obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError(`health rule "object.status.ready" is not satisfied`))
+
+			obj.Object["status"] = map[string]any{"ready": true}
+			checked, err = CheckHealthWithRules(rules, fooGroupKind, obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should prefer the health rule over the built-in checks", func() {
+			pod := &corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodPending}}
+
+			checked, err := CheckHealthWithRules(rules, podGroupKind, pod)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("is not satisfied")))
+		})
+
+		It("should skip the health rule if the skip-health-check annotation is set", func() {
+			obj.SetAnnotations(map[string]string{resourcesv1alpha1.SkipHealthCheck: "true"})
+
+			checked, err := CheckHealthWithRules(rules, fooGroupKind, obj)
+			Expect(checked).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not report the object as checked if the rule cannot be evaluated", func() {
+			delete(obj.Object, "status")
+
+			checked, err := CheckHealthWithRules(rules, fooGroupKind, obj)
+			Expect(checked).To(BeFalse())
+			Expect(err).To(MatchError(health.ErrCELRuleEvaluation))
+		})
+
+		It("should fall back to the built-in checks if there is no rule", func() {
+			namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}
+
+			checked, err := CheckHealthWithRules(rules, schema.GroupKind{Kind: "Namespace"}, namespace)
+			Expect(checked).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
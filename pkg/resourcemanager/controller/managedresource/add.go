@@ -50,6 +50,9 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 	if r.RequeueAfterOnDeletionPending == nil {
 		r.RequeueAfterOnDeletionPending = ptr.To(5 * time.Second)
 	}
+	if r.RequeueAfterOnApplyWavePending == nil {
+		r.RequeueAfterOnApplyWavePending = ptr.To(5 * time.Second)
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
@@ -65,6 +68,7 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 				resourcemanagerpredicate.HasOperationAnnotation(),
 				resourcemanagerpredicate.ConditionStatusChanged(resourcesv1alpha1.ResourcesHealthy, resourcemanagerpredicate.ConditionChangedToUnhealthy),
 				resourcemanagerpredicate.NoLongerIgnored(),
+				resourcemanagerpredicate.PreviewModeChanged(),
 				// we need to reconcile once if the ManagedResource got marked as ignored in order to update the conditions
 				resourcemanagerpredicate.GotMarkedAsIgnored(),
 				r.ClassFilter.CleanupCompleted(),
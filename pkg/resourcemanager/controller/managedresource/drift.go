@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+// maxDriftedObjectsInMessage is the maximum number of drifted objects listed in the message of the ResourcesDrifted
+// condition.
+const maxDriftedObjectsInMessage = 10
+
+// objectDrift describes an out-of-band modification of an object which was reverted by the controller.
+type objectDrift struct {
+	resource string
+	// fields contains the paths of the reverted fields.
+	fields []string
+	// deleted is true if the object was deleted out-of-band and had to be re-created.
+	deleted bool
+}
+
+func (d objectDrift) String() string {
+	if d.deleted {
+		return d.resource + ": object was deleted"
+	}
+	return d.resource + ": " + strings.Join(d.fields, ", ")
+}
+
+// shouldDetectDrift returns whether drift of the target objects can be detected during the current reconciliation of
+// the given ManagedResource. This is only the case if its desired state did not change since the last successful
+// reconciliation, because otherwise changes to the target objects are expected.
+func shouldDetectDrift(mr *resourcesv1alpha1.ManagedResource, secretsDataChecksum string) bool {
+	if mr.Status.ObservedGeneration != mr.Generation || mr.Status.SecretsDataChecksum == nil || *mr.Status.SecretsDataChecksum != secretsDataChecksum {
+		return false
+	}
+
+	conditionResourcesApplied := v1beta1helper.GetCondition(mr.Status.Conditions, resourcesv1alpha1.ResourcesApplied)
+	return conditionResourcesApplied != nil && conditionResourcesApplied.Status == gardencorev1beta1.ConditionTrue
+}
+
+// detectDrift returns the drift of the given object, or nil if there is none. The existing object is the state before
+// the object was applied, and the current object is the state returned by the API server after it was applied.
+func detectDrift(scheme *runtime.Scheme, obj object, existing, current *unstructured.Unstructured, created bool) *objectDrift {
+	// only objects which were applied previously can drift
+	if obj.oldInformation.Name == "" {
+		return nil
+	}
+
+	if created {
+		return &objectDrift{resource: unstructuredToString(obj.obj), deleted: true}
+	}
+
+	// the API server only increases the resource version if the update actually changed the object
+	if existing.GetResourceVersion() == current.GetResourceVersion() {
+		return nil
+	}
+
+	fields := changedFields(normalizedContent(scheme, existing), normalizedContent(scheme, current))
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return &objectDrift{resource: unstructuredToString(obj.obj), fields: truncateFields(fields)}
+}
+
+// normalizedContent returns the content of the given object. If the object's kind is registered in the given scheme,
+// the content is converted to the typed object and back to remove differences caused by the representation only.
+func normalizedContent(scheme *runtime.Scheme, obj *unstructured.Unstructured) map[string]any {
+	typed, err := scheme.New(obj.GroupVersionKind())
+	if err != nil {
+		return obj.Object
+	}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, typed); err != nil {
+		return obj.Object
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(typed)
+	if err != nil {
+		return obj.Object
+	}
+	return content
+}
+
+// driftedCondition returns the ResourcesDrifted condition updated with the given drifts.
+func (r *Reconciler) driftedCondition(mr *resourcesv1alpha1.ManagedResource, drifts []objectDrift) gardencorev1beta1.Condition {
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesDrifted)
+
+	if len(drifts) == 0 {
+		return v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionNoDriftDetected, "No resources were modified out-of-band.")
+	}
+
+	var message strings.Builder
+	fmt.Fprintf(&message, "%d resource(s) were modified out-of-band and have been reverted:", len(drifts))
+	for i, drift := range drifts {
+		if i == maxDriftedObjectsInMessage {
+			fmt.Fprintf(&message, "\n- ... (%d more)", len(drifts)-maxDriftedObjectsInMessage)
+			break
+		}
+		message.WriteString("\n- " + drift.String())
+	}
+
+	return v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, resourcesv1alpha1.ConditionDriftDetected, message.String())
+}
@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Drift", func() {
+	var (
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+		mr        *resourcesv1alpha1.ManagedResource
+	)
+
+	BeforeEach(func() {
+		mr = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default", Generation: 2},
+			Status: resourcesv1alpha1.ManagedResourceStatus{
+				ObservedGeneration:  2,
+				SecretsDataChecksum: ptr.To("checksum"),
+				Conditions: []gardencorev1beta1.Condition{
+					{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionTrue},
+				},
+			},
+		}
+	})
+
+	Describe("#shouldDetectDrift", func() {
+		It("should return true if the desired state did not change since the last successful reconciliation", func() {
+			Expect(shouldDetectDrift(mr, "checksum")).To(BeTrue())
+		})
+
+		It("should return false if the generation changed", func() {
+			mr.Generation = 3
+			Expect(shouldDetectDrift(mr, "checksum")).To(BeFalse())
+		})
+
+		It("should return false if the secrets data changed", func() {
+			Expect(shouldDetectDrift(mr, "other")).To(BeFalse())
+		})
+
+		It("should return false if the ManagedResource was never reconciled", func() {
+			mr.Status.SecretsDataChecksum = nil
+			Expect(shouldDetectDrift(mr, "checksum")).To(BeFalse())
+		})
+
+		It("should return false if the resources were not applied successfully", func() {
+			mr.Status.Conditions[0].Status = gardencorev1beta1.ConditionFalse
+			Expect(shouldDetectDrift(mr, "checksum")).To(BeFalse())
+		})
+	})
+
+	Describe("#detectDrift", func() {
+		var (
+			obj               object
+			existing, current *unstructured.Unstructured
+		)
+
+		newConfigMap := func(resourceVersion string, data map[string]string) *unstructured.Unstructured {
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default", ResourceVersion: resourceVersion},
+				Data:       data,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			u := &unstructured.Unstructured{Object: content}
+			u.SetAPIVersion("v1")
+			u.SetKind("ConfigMap")
+			return u
+		}
+
+		BeforeEach(func() {
+			existing = newConfigMap("1", map[string]string{"foo": "baz"})
+			current = newConfigMap("2", map[string]string{"foo": "bar"})
+			obj = object{
+				obj:            current.DeepCopy(),
+				oldInformation: resourcesv1alpha1.ObjectReference{ObjectReference: corev1.ObjectReference{Kind: "ConfigMap", Namespace: "default", Name: "foo"}},
+			}
+		})
+
+		It("should return the reverted fields", func() {
+			Expect(detectDrift(kubernetesscheme.Scheme, obj, existing, current, false)).To(Equal(&objectDrift{
+				resource: "v1/ConfigMap/default/foo",
+				fields:   []string{"data.foo"},
+			}))
+		})
+
+		It("should return a drift if the object was re-created", func() {
+			Expect(detectDrift(kubernetesscheme.Scheme, obj, nil, current, true)).To(Equal(&objectDrift{
+				resource: "v1/ConfigMap/default/foo",
+				deleted:  true,
+			}))
+		})
+
+		It("should return nil if the object was not applied before", func() {
+			obj.oldInformation = resourcesv1alpha1.ObjectReference{}
+			Expect(detectDrift(kubernetesscheme.Scheme, obj, existing, current, true)).To(BeNil())
+		})
+
+		It("should return nil if the update did not change the object", func() {
+			current.SetResourceVersion("1")
+			Expect(detectDrift(kubernetesscheme.Scheme, obj, existing, current, false)).To(BeNil())
+		})
+	})
+
+	Describe("#driftedCondition", func() {
+		var reconciler *Reconciler
+
+		BeforeEach(func() {
+			reconciler = &Reconciler{Clock: fakeClock}
+		})
+
+		It("should report that no drift was detected", func() {
+			condition := reconciler.driftedCondition(mr, nil)
+			Expect(condition.Type).To(Equal(resourcesv1alpha1.ResourcesDrifted))
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(resourcesv1alpha1.ConditionNoDriftDetected))
+		})
+
+		It("should list the drifted objects", func() {
+			condition := reconciler.driftedCondition(mr, []objectDrift{
+				{resource: "v1/ConfigMap/default/foo", fields: []string{"data.foo", "metadata.labels.bar"}},
+				{resource: "v1/Secret/default/bar", deleted: true},
+			})
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(resourcesv1alpha1.ConditionDriftDetected))
+			Expect(condition.Message).To(Equal(`2 resource(s) were modified out-of-band and have been reverted:
+- v1/ConfigMap/default/foo: data.foo, metadata.labels.bar
+- v1/Secret/default/bar: object was deleted`))
+		})
+
+		It("should truncate the list of drifted objects", func() {
+			var drifts []objectDrift
+			for i := range maxDriftedObjectsInMessage + 2 {
+				drifts = append(drifts, objectDrift{resource: fmt.Sprintf("v1/ConfigMap/default/foo-%d", i), deleted: true})
+			}
+
+			condition := reconciler.driftedCondition(mr, drifts)
+			Expect(condition.Message).To(HavePrefix("12 resource(s) were modified out-of-band"))
+			Expect(condition.Message).To(ContainSubstring("foo-9: object was deleted"))
+			Expect(condition.Message).NotTo(ContainSubstring("foo-10"))
+			Expect(condition.Message).To(HaveSuffix("- ... (2 more)"))
+		})
+	})
+
+	Describe("#recordDriftMetrics", func() {
+		var (
+			gauge   *prometheus.GaugeVec
+			counter *prometheus.CounterVec
+		)
+
+		BeforeEach(func() {
+			gauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "drifted_objects"}, []string{"namespace", "name"})
+			counter = prometheus.NewCounterVec(prometheus.CounterOpts{Name: "drift_detections_total"}, []string{"namespace", "name"})
+			DeferCleanup(test.WithVars(
+				&driftedObjects, gauge,
+				&driftDetectionsTotal, counter,
+			))
+		})
+
+		It("should record and delete the drift metrics", func() {
+			recordDriftMetrics(mr, []objectDrift{{resource: "foo"}, {resource: "bar"}})
+			recordDriftMetrics(mr, []objectDrift{{resource: "foo"}})
+
+			Expect(testutil.ToFloat64(gauge.WithLabelValues("default", "foo"))).To(Equal(float64(1)))
+			Expect(testutil.ToFloat64(counter.WithLabelValues("default", "foo"))).To(Equal(float64(3)))
+
+			deleteDriftMetrics(mr)
+
+			Expect(testutil.CollectAndCount(gauge)).To(Equal(0))
+			Expect(testutil.CollectAndCount(counter)).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+var (
+	registerOnce = make(chan struct{})
+
+	driftedObjects       *prometheus.GaugeVec
+	driftDetectionsTotal *prometheus.CounterVec
+)
+
+const metricsNamespace = "gardener_resource_manager"
+
+// RegisterMetrics registers the metrics for the ManagedResource controller on the passed registry.
+// This function can only be called once.
+// If this function is not called, no metrics are collected in this package.
+func RegisterMetrics(r prometheus.Registerer) {
+	close(registerOnce) // Metrics can only be registered once on a registry.
+
+	factory := promauto.With(r)
+
+	driftedObjects = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "managedresource_drifted_objects",
+			Help:      "Number of objects of a ManagedResource which were modified out-of-band and reverted during the last reconciliation.",
+		},
+		[]string{
+			"namespace",
+			"name",
+		},
+	)
+
+	driftDetectionsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "managedresource_drift_detections_total",
+			Help:      "Total number of objects of a ManagedResource which were modified out-of-band and reverted.",
+		},
+		[]string{
+			"namespace",
+			"name",
+		},
+	)
+}
+
+func recordDriftMetrics(mr *resourcesv1alpha1.ManagedResource, drifts []objectDrift) {
+	if driftedObjects != nil {
+		driftedObjects.WithLabelValues(mr.Namespace, mr.Name).Set(float64(len(drifts)))
+	}
+	if driftDetectionsTotal != nil {
+		driftDetectionsTotal.WithLabelValues(mr.Namespace, mr.Name).Add(float64(len(drifts)))
+	}
+}
+
+func deleteDriftMetrics(mr *resourcesv1alpha1.ManagedResource) {
+	if driftedObjects != nil {
+		driftedObjects.DeleteLabelValues(mr.Namespace, mr.Name)
+	}
+	if driftDetectionsTotal != nil {
+		driftDetectionsTotal.DeleteLabelValues(mr.Namespace, mr.Name)
+	}
+}
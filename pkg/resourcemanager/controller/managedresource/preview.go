@@ -0,0 +1,246 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/hashicorp/go-multierror"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
+)
+
+// maxPreviewFieldsPerObject is the maximum number of changed fields reported per object in the preview to keep the
+// status of the ManagedResource reasonably small.
+const maxPreviewFieldsPerObject = 25
+
+// ignoredPreviewFields contains the paths of fields which are changed by the API server on every update or which are
+// not managed by the resource manager. They are not reported in the preview.
+var ignoredPreviewFields = sets.New(
+	"metadata.creationTimestamp",
+	"metadata.generation",
+	"metadata.managedFields",
+	"metadata.resourceVersion",
+	"metadata.uid",
+	"status",
+)
+
+// preview computes the changes which would be applied to the target cluster via server-side dry-run requests and
+// reports them in the status of the ManagedResource. Neither the target cluster nor the conditions of the
+// ManagedResource are changed.
+func (r *Reconciler) preview(
+	ctx context.Context,
+	log logr.Logger,
+	mr *resourcesv1alpha1.ManagedResource,
+	origin string,
+	secretsDataChecksum string,
+	newResourcesObjects []object,
+	existingResourcesIndex *objectIndex,
+	equivalences Equivalences,
+) (
+	reconcile.Result,
+	error,
+) {
+	log.Info("Computing preview of changes since ManagedResource is in preview mode")
+
+	errorList := &multierror.Error{
+		ErrorFormat: errorsutils.NewErrorFormatFuncWithPrefix("Could not compute all changes"),
+	}
+
+	changes, err := r.previewNewResources(ctx, origin, newResourcesObjects, mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue}), equivalences)
+	if err != nil {
+		errorList = multierror.Append(errorList, err)
+	}
+
+	deletions, err := r.previewOldResources(ctx, existingResourcesIndex)
+	if err != nil {
+		errorList = multierror.Append(errorList, err)
+	}
+	changes = append(changes, deletions...)
+
+	mr.Status.Preview = &resourcesv1alpha1.ManagedResourcePreview{
+		LastUpdateTime:      metav1.NewTime(r.Clock.Now()),
+		SecretsDataChecksum: secretsDataChecksum,
+		Changes:             changes,
+	}
+	if err := errorList.ErrorOrNil(); err != nil {
+		mr.Status.Preview.Error = ptr.To(err.Error())
+	}
+
+	if err := r.SourceClient.Status().Update(ctx, mr); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+	}
+
+	if err := errorList.ErrorOrNil(); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	log.Info("Finished computing preview of changes", "changes", len(changes))
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) previewNewResources(ctx context.Context, origin string, newResourcesObjects []object, labelsToInject map[string]string, equivalences Equivalences) ([]resourcesv1alpha1.ObjectChange, error) {
+	horizontallyScaledObjects, err := computeHorizontallyScaledObjectKeys(ctx, r.TargetClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute all HPA target ref object keys: %w", err)
+	}
+
+	var (
+		dryRunClient = client.NewDryRunClient(r.TargetClient)
+		changes      []resourcesv1alpha1.ObjectChange
+		errorList    = &multierror.Error{}
+	)
+
+	for _, obj := range sortByKind(newResourcesObjects) {
+		var (
+			current            = obj.obj.DeepCopy()
+			existing           *unstructured.Unstructured
+			scaledHorizontally = isScaled(obj.obj, horizontallyScaledObjects, equivalences)
+			mutate             = mutateFunc(origin, obj, current, labelsToInject, scaledHorizontally)
+		)
+
+		operationResult, err := controllerutils.TypedCreateOrUpdate(ctx, dryRunClient, r.TargetScheme, current, false, func() error {
+			existing = current.DeepCopy()
+			return mutate()
+		})
+		if err != nil {
+			errorList = multierror.Append(errorList, fmt.Errorf("error during dry-run apply of object %q: %w", unstructuredToString(obj.obj), err))
+			continue
+		}
+
+		change := resourcesv1alpha1.ObjectChange{ObjectReference: objectReferenceFor(obj.obj)}
+
+		switch operationResult {
+		case controllerutil.OperationResultCreated:
+			change.Operation = resourcesv1alpha1.ObjectOperationCreate
+		case controllerutil.OperationResultUpdated:
+			fields := changedFields(existing.Object, current.Object)
+			if len(fields) == 0 {
+				// the API server did not compute any changes, e.g. because the differences were only caused by defaulting
+				continue
+			}
+			change.Operation = resourcesv1alpha1.ObjectOperationUpdate
+			change.Fields = truncateFields(fields)
+		default:
+			continue
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, errorList.ErrorOrNil()
+}
+
+func (r *Reconciler) previewOldResources(ctx context.Context, index *objectIndex) ([]resourcesv1alpha1.ObjectChange, error) {
+	var (
+		changes   []resourcesv1alpha1.ObjectChange
+		errorList = &multierror.Error{}
+	)
+
+	for _, oldResource := range index.Objects() {
+		if index.Found(oldResource) {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(oldResource.APIVersion)
+		obj.SetKind(oldResource.Kind)
+
+		if err := r.TargetClient.Get(ctx, client.ObjectKey{Namespace: oldResource.Namespace, Name: oldResource.Name}, obj); err != nil {
+			if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+				errorList = multierror.Append(errorList, fmt.Errorf("error reading old resource %q: %w", objectKeyByReference(oldResource), err))
+			}
+			continue
+		}
+
+		if keepObject(obj) || (r.GarbageCollectorActivated && isGarbageCollectableResource(obj)) {
+			continue
+		}
+
+		changes = append(changes, resourcesv1alpha1.ObjectChange{
+			ObjectReference: objectReferenceFor(obj),
+			Operation:       resourcesv1alpha1.ObjectOperationDelete,
+		})
+	}
+
+	// sort deletions to keep consistent ordering (the index is a map)
+	slices.SortFunc(changes, func(a, b resourcesv1alpha1.ObjectChange) int {
+		return strings.Compare(objectKey(a.GroupVersionKind().Group, a.Kind, a.Namespace, a.Name), objectKey(b.GroupVersionKind().Group, b.Kind, b.Namespace, b.Name))
+	})
+
+	return changes, errorList.ErrorOrNil()
+}
+
+func objectReferenceFor(obj *unstructured.Unstructured) corev1.ObjectReference {
+	return corev1.ObjectReference{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Name:       obj.GetName(),
+		Namespace:  obj.GetNamespace(),
+	}
+}
+
+// changedFields returns the sorted paths of all fields which differ between the given objects. Lists are compared as
+// a whole, i.e., only the path of the list is returned if any of its elements differ.
+func changedFields(oldObj, newObj map[string]any) []string {
+	var fields []string
+	collectChangedFields("", oldObj, newObj, &fields)
+	slices.Sort(fields)
+	return fields
+}
+
+func collectChangedFields(path string, oldValue, newValue any, fields *[]string) {
+	if ignoredPreviewFields.Has(path) {
+		return
+	}
+
+	oldMap, oldIsMap := oldValue.(map[string]any)
+	newMap, newIsMap := newValue.(map[string]any)
+	if !oldIsMap || !newIsMap {
+		if !apiequality.Semantic.DeepEqual(oldValue, newValue) {
+			*fields = append(*fields, path)
+		}
+		return
+	}
+
+	keys := sets.KeySet(oldMap).Union(sets.KeySet(newMap))
+	for _, key := range sets.List(keys) {
+		collectChangedFields(fieldPath(path, key), oldMap[key], newMap[key], fields)
+	}
+}
+
+func fieldPath(parent, key string) string {
+	if strings.ContainsAny(key, "./") {
+		return parent + "[" + key + "]"
+	}
+	if parent == "" {
+		return key
+	}
+	return parent + "." + key
+}
+
+func truncateFields(fields []string) []string {
+	if len(fields) <= maxPreviewFieldsPerObject {
+		return fields
+	}
+	return append(slices.Clip(fields[:maxPreviewFieldsPerObject]), fmt.Sprintf("... (%d more)", len(fields)-maxPreviewFieldsPerObject))
+}
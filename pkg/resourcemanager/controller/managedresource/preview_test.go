@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Preview", func() {
+	Describe("#preview", func() {
+		var (
+			ctx       = context.TODO()
+			fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+			origin    = "origin"
+
+			sourceClient, targetClient client.Client
+			reconciler                 *Reconciler
+			mr                         *resourcesv1alpha1.ManagedResource
+		)
+
+		toObject := func(obj runtime.Object) object {
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+			Expect(err).NotTo(HaveOccurred())
+			u := &unstructured.Unstructured{Object: content}
+			u.SetAPIVersion("v1")
+			u.SetKind("ConfigMap")
+			return object{obj: u}
+		}
+
+		configMapRef := func(name string) resourcesv1alpha1.ObjectReference {
+			return resourcesv1alpha1.ObjectReference{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: name}}
+		}
+
+		BeforeEach(func() {
+			sourceClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&resourcesv1alpha1.ManagedResource{}).Build()
+			targetClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+
+			reconciler = &Reconciler{
+				SourceClient: sourceClient,
+				TargetClient: targetClient,
+				TargetScheme: kubernetesscheme.Scheme,
+				Clock:        fakeClock,
+				Config: resourcemanagerconfigv1alpha1.ManagedResourceControllerConfig{
+					ManagedByLabelValue: ptr.To("gardener"),
+					SyncPeriod:          &metav1.Duration{Duration: time.Minute},
+				},
+			}
+
+			mr = &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "mr", Namespace: "garden"}}
+			Expect(sourceClient.Create(ctx, mr)).To(Succeed())
+		})
+
+		It("should report the changes without applying them", func() {
+			unchanged := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "unchanged",
+					Namespace:   "default",
+					Labels:      map[string]string{resourcesv1alpha1.ManagedBy: "gardener"},
+					Annotations: map[string]string{descriptionAnnotation: descriptionAnnotationText, resourcesv1alpha1.OriginAnnotation: origin},
+				},
+				Data: map[string]string{"foo": "bar"},
+			}
+			changed := unchanged.DeepCopy()
+			changed.Name = "changed"
+			old := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "old", Namespace: "default"}}
+			kept := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "kept", Namespace: "default", Annotations: map[string]string{resourcesv1alpha1.KeepObject: "true"}}}
+
+			for _, obj := range []client.Object{unchanged, changed, old, kept} {
+				Expect(targetClient.Create(ctx, obj)).To(Succeed())
+			}
+
+			desiredChanged := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "changed", Namespace: "default"}, Data: map[string]string{"foo": "baz"}}
+			desiredNew := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "default"}}
+			desiredUnchanged := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "unchanged", Namespace: "default"}, Data: map[string]string{"foo": "bar"}}
+
+			index := NewObjectIndex([]resourcesv1alpha1.ObjectReference{
+				configMapRef("changed"),
+				configMapRef("unchanged"),
+				configMapRef("old"),
+				configMapRef("kept"),
+				configMapRef("gone"),
+			}, nil)
+			index.Lookup(configMapRef("changed"))
+			index.Lookup(configMapRef("unchanged"))
+
+			result, err := reconciler.preview(ctx, logr.Discard(), mr, origin, "checksum", []object{toObject(desiredChanged), toObject(desiredNew), toObject(desiredUnchanged)}, index, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(time.Minute))
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(mr), mr)).To(Succeed())
+			Expect(mr.Status.Preview).To(Equal(&resourcesv1alpha1.ManagedResourcePreview{
+				LastUpdateTime:      metav1.NewTime(fakeClock.Now()),
+				SecretsDataChecksum: "checksum",
+				Changes: []resourcesv1alpha1.ObjectChange{
+					{
+						ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "changed"},
+						Operation:       resourcesv1alpha1.ObjectOperationUpdate,
+						Fields:          []string{"data.foo"},
+					},
+					{
+						ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "new"},
+						Operation:       resourcesv1alpha1.ObjectOperationCreate,
+					},
+					{
+						ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "old"},
+						Operation:       resourcesv1alpha1.ObjectOperationDelete,
+					},
+				},
+			}))
+
+			By("Ensure nothing was applied")
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(changed), changed)).To(Succeed())
+			Expect(changed.Data).To(HaveKeyWithValue("foo", "bar"))
+			Expect(targetClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "new"}, &corev1.ConfigMap{})).To(BeNotFoundError())
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(old), old)).To(Succeed())
+		})
+	})
+
+	Describe("#changedFields", func() {
+		It("should return the paths of all changed fields", func() {
+			oldObj := map[string]any{
+				"metadata": map[string]any{
+					"resourceVersion": "1",
+					"labels":          map[string]any{"foo": "bar"},
+					"annotations":     map[string]any{"example.com/foo": "bar"},
+				},
+				"spec": map[string]any{
+					"replicas": int64(1),
+					"list":     []any{"a", "b"},
+					"removed":  "foo",
+				},
+				"status": map[string]any{"phase": "Running"},
+			}
+			newObj := map[string]any{
+				"metadata": map[string]any{
+					"resourceVersion": "2",
+					"labels":          map[string]any{"foo": "bar", "bar": "baz"},
+					"annotations":     map[string]any{"example.com/foo": "baz"},
+				},
+				"spec": map[string]any{
+					"replicas": int64(2),
+					"list":     []any{"a", "c"},
+				},
+			}
+
+			Expect(changedFields(oldObj, newObj)).To(Equal([]string{
+				"metadata.annotations[example.com/foo]",
+				"metadata.labels.bar",
+				"spec.list",
+				"spec.removed",
+				"spec.replicas",
+			}))
+		})
+
+		It("should return nothing if the objects are equal", func() {
+			obj := map[string]any{"spec": map[string]any{"replicas": int64(1)}}
+			Expect(changedFields(obj, obj)).To(BeEmpty())
+		})
+	})
+
+	Describe("#truncateFields", func() {
+		It("should truncate the fields", func() {
+			fields := make([]string, maxPreviewFieldsPerObject+2)
+			Expect(truncateFields(fields)).To(HaveLen(maxPreviewFieldsPerObject + 1))
+			Expect(truncateFields(fields)[maxPreviewFieldsPerObject]).To(Equal("... (2 more)"))
+		})
+
+		It("should not truncate the fields", func() {
+			fields := []string{"foo"}
+			Expect(truncateFields(fields)).To(Equal(fields))
+		})
+	})
+})
@@ -51,6 +51,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	healthutils "github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
 	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
 	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -74,6 +75,12 @@ type Reconciler struct {
 	ClusterID                     string
 	GarbageCollectorActivated     bool
 	RequeueAfterOnDeletionPending *time.Duration
+	// RequeueAfterOnApplyWavePending is the duration after which the ManagedResource is requeued in case the objects
+	// of an apply wave are not yet healthy.
+	RequeueAfterOnApplyWavePending *time.Duration
+	// HealthRules are the compiled health rules of the health controller. They are evaluated instead of the built-in
+	// checks when checking the health of the objects of an apply wave.
+	HealthRules healthutils.Rules
 }
 
 // Reconcile manages the resources reference by ManagedResources.
@@ -284,6 +291,10 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	// (otherwise, the order will be different on each update)
 	sortObjectReferences(newResourcesObjectReferences)
 
+	if resourcemanagerpredicate.IsInPreviewMode(mr) {
+		return r.preview(ctx, log, mr, origin, secretsDataChecksum, newResourcesObjects, existingResourcesIndex, equivalences)
+	}
+
 	// invalidate conditions, if resources have been added/removed from the managed resource
 	if !apiequality.Semantic.DeepEqual(mr.Status.Resources, newResourcesObjectReferences) || mr.Status.SecretsDataChecksum == nil || *mr.Status.SecretsDataChecksum != secretsDataChecksum {
 		conditionResourcesHealthy := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesHealthy)
@@ -344,8 +355,26 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 		return reconcile.Result{}, fmt.Errorf("could not release all orphaned resources: %+v", err)
 	}
 
-	injectLabels := mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue})
-	if err := r.applyNewResources(ctx, log, origin, newResourcesObjects, injectLabels, equivalences); err != nil {
+	var (
+		injectLabels      = mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue})
+		shouldDetectDrift = shouldDetectDrift(mr, secretsDataChecksum)
+	)
+
+	drifts, applyPending, err := r.applyNewResources(ctx, log, origin, newResourcesObjects, injectLabels, equivalences, shouldDetectDrift)
+	if err != nil {
+		if applyPending {
+			log.Info("Apply is still pending", "err", err)
+
+			// the status already contains all new resources to make sure that the resources of the already applied waves
+			// are cleaned up if the ManagedResource is deleted in the meantime
+			conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionProgressing, resourcesv1alpha1.ConditionApplyWavePending, err.Error())
+			if err := updateManagedResourceStatus(ctx, r.SourceClient, mr, &secretsDataChecksum, newResourcesObjectReferences, conditionResourcesApplied); err != nil {
+				return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+			}
+
+			return reconcile.Result{RequeueAfter: *r.RequeueAfterOnApplyWavePending}, nil
+		}
+
 		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionApplyFailed, err.Error())
 		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
 			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
@@ -360,7 +389,13 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionTrue, resourcesv1alpha1.ConditionApplySucceeded, "All resources are applied.")
 	}
 
-	if err := updateManagedResourceStatus(ctx, r.SourceClient, mr, &secretsDataChecksum, newResourcesObjectReferences, conditionResourcesApplied); err != nil {
+	updatedConditions := []gardencorev1beta1.Condition{conditionResourcesApplied}
+	if shouldDetectDrift {
+		updatedConditions = append(updatedConditions, r.driftedCondition(mr, drifts))
+		recordDriftMetrics(mr, drifts)
+	}
+
+	if err := updateManagedResourceStatus(ctx, r.SourceClient, mr, &secretsDataChecksum, newResourcesObjectReferences, updatedConditions...); err != nil {
 		return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
 	}
 
@@ -430,6 +465,8 @@ func (r *Reconciler) delete(ctx context.Context, log logr.Logger, mr *resourcesv
 		}
 	}
 
+	deleteDriftMetrics(mr)
+
 	log.Info("Finished deleting resources created by ManagedResource")
 	return reconcile.Result{}, nil
 }
@@ -460,75 +497,112 @@ func (r *Reconciler) updateConditionsForDeletion(ctx context.Context, mr *resour
 	return updateConditions(ctx, r.SourceClient, mr, conditionResourcesHealthy, conditionResourcesProgressing)
 }
 
-func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, origin string, newResourcesObjects []object, labelsToInject map[string]string, equivalences Equivalences) error {
-	newResourcesObjects = sortByKind(newResourcesObjects)
+func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, origin string, newResourcesObjects []object, labelsToInject map[string]string, equivalences Equivalences, shouldDetectDrift bool) ([]objectDrift, bool, error) {
+	waves, err := groupByApplyWave(newResourcesObjects)
+	if err != nil {
+		return nil, false, err
+	}
 
 	// get all HPA targetRefs to check if we should prevent overwriting replicas.
 	// VPAs don't have to be checked, as they don't update the spec directly and only mutate Pods via a MutatingWebhook
 	// and therefore don't interfere with the resource manager.
 	horizontallyScaledObjects, err := computeHorizontallyScaledObjectKeys(ctx, r.TargetClient)
 	if err != nil {
-		return fmt.Errorf("failed to compute all HPA target ref object keys: %w", err)
+		return nil, false, fmt.Errorf("failed to compute all HPA target ref object keys: %w", err)
 	}
 
-	for _, obj := range newResourcesObjects {
-		var (
-			current            = obj.obj.DeepCopy()
-			resource           = unstructuredToString(obj.obj)
-			scaledHorizontally = isScaled(obj.obj, horizontallyScaledObjects, equivalences)
-		)
+	var drifts []objectDrift
+
+	for i, wave := range waves {
+		appliedObjects := make([]*unstructured.Unstructured, 0, len(wave.objects))
 
-		resourceLogger := log.WithValues("resource", resource)
+		for _, obj := range wave.objects {
+			var (
+				current            = obj.obj.DeepCopy()
+				existing           *unstructured.Unstructured
+				resource           = unstructuredToString(obj.obj)
+				scaledHorizontally = isScaled(obj.obj, horizontallyScaledObjects, equivalences)
+				mutate             = mutateFunc(origin, obj, current, labelsToInject, scaledHorizontally)
+			)
 
-		resourceLogger.V(1).Info("Applying")
+			resourceLogger := log.WithValues("resource", resource)
 
-		operationResult, err := controllerutils.TypedCreateOrUpdate(ctx, r.TargetClient, r.TargetScheme, current, ptr.Deref(r.Config.AlwaysUpdate, false), func() error {
-			metadata, err := meta.Accessor(obj.obj)
+			resourceLogger.V(1).Info("Applying")
+
+			operationResult, err := controllerutils.TypedCreateOrUpdate(ctx, r.TargetClient, r.TargetScheme, current, ptr.Deref(r.Config.AlwaysUpdate, false), func() error {
+				existing = current.DeepCopy()
+				return mutate()
+			})
 			if err != nil {
-				return fmt.Errorf("error getting metadata of object %q: %s", resource, err)
-			}
+				if apierrors.IsConflict(err) {
+					return nil, false, err
+				}
 
-			// if the ignore annotation is set to false, do nothing (ignore the resource)
-			if ignore(metadata) {
-				annotations := current.GetAnnotations()
-				delete(annotations, descriptionAnnotation)
-				current.SetAnnotations(annotations)
-				return nil
-			}
+				if apierrors.IsInvalid(err) && operationResult == controllerutil.OperationResultUpdated && deleteOnInvalidUpdate(current, err) {
+					if deleteErr := r.TargetClient.Delete(ctx, current); client.IgnoreNotFound(deleteErr) != nil {
+						return nil, false, fmt.Errorf("error deleting object %q after 'invalid' update error: %s", resource, deleteErr)
+					}
+					// return error directly, so that the create after delete will be retried
+					return nil, false, fmt.Errorf("deleted object %q because of 'invalid' update error, and 'delete-on-invalid-update' annotation on object or the resource is an immutable ConfigMap/Secret: %s", resource, err)
+				}
 
-			if err := injectLabels(obj.obj, labelsToInject); err != nil {
-				return fmt.Errorf("error injecting labels into object %q: %s", resource, err)
+				return nil, false, fmt.Errorf("error during apply of object %q: %s", resource, err)
 			}
 
-			return merge(origin, obj.obj, current, obj.forceOverwriteLabels, obj.oldInformation.Labels, obj.forceOverwriteAnnotations, obj.oldInformation.Annotations, scaledHorizontally)
-		})
-		if err != nil {
-			if apierrors.IsConflict(err) {
-				return err
+			if shouldDetectDrift && operationResult != controllerutil.OperationResultNone {
+				if drift := detectDrift(r.TargetScheme, obj, existing, current, operationResult == controllerutil.OperationResultCreated); drift != nil {
+					resourceLogger.Info("Reverted out-of-band modification of resource", "drift", drift.String())
+					drifts = append(drifts, *drift)
+				}
 			}
 
-			if apierrors.IsInvalid(err) && operationResult == controllerutil.OperationResultUpdated && deleteOnInvalidUpdate(current, err) {
-				if deleteErr := r.TargetClient.Delete(ctx, current); client.IgnoreNotFound(deleteErr) != nil {
-					return fmt.Errorf("error deleting object %q after 'invalid' update error: %s", resource, deleteErr)
-				}
-				// return error directly, so that the create after delete will be retried
-				return fmt.Errorf("deleted object %q because of 'invalid' update error, and 'delete-on-invalid-update' annotation on object or the resource is an immutable ConfigMap/Secret: %s", resource, err)
+			switch operationResult {
+			case controllerutil.OperationResultCreated:
+				resourceLogger.Info("Created resource because it was not existing before")
+			case controllerutil.OperationResultUpdated:
+				resourceLogger.Info("Updated resource because its actual state differed from the desired state")
+			case controllerutil.OperationResultNone:
+				resourceLogger.V(1).Info("Resource was neither created nor updated because its actual state matches with the desired state")
 			}
 
-			return fmt.Errorf("error during apply of object %q: %s", resource, err)
+			appliedObjects = append(appliedObjects, current)
 		}
 
-		switch operationResult {
-		case controllerutil.OperationResultCreated:
-			resourceLogger.Info("Created resource because it was not existing before")
-		case controllerutil.OperationResultUpdated:
-			resourceLogger.Info("Updated resource because its actual state differed from the desired state")
-		case controllerutil.OperationResultNone:
-			resourceLogger.V(1).Info("Resource was neither created nor updated because its actual state matches with the desired state")
+		// the objects of the next wave are only applied once all objects of this wave are healthy
+		if i < len(waves)-1 {
+			if err := checkHealthOfApplyWave(r.TargetScheme, r.HealthRules, appliedObjects); err != nil {
+				return nil, true, fmt.Errorf("waiting for objects of apply wave %d to become healthy before applying wave %d: %w", wave.number, waves[i+1].number, err)
+			}
 		}
 	}
 
-	return nil
+	return drifts, false, nil
+}
+
+// mutateFunc returns a function which mutates the current state of the given object to its desired state.
+func mutateFunc(origin string, obj object, current *unstructured.Unstructured, labelsToInject map[string]string, scaledHorizontally bool) func() error {
+	return func() error {
+		resource := unstructuredToString(obj.obj)
+
+		metadata, err := meta.Accessor(obj.obj)
+		if err != nil {
+			return fmt.Errorf("error getting metadata of object %q: %s", resource, err)
+		}
+
+		// if the ignore annotation is set to false, do nothing (ignore the resource)
+		if ignore(metadata) {
+			annotations := current.GetAnnotations()
+			delete(annotations, descriptionAnnotation)
+			current.SetAnnotations(annotations)
+			return nil
+		}
+
+		if err := injectLabels(obj.obj, labelsToInject); err != nil {
+			return fmt.Errorf("error injecting labels into object %q: %s", resource, err)
+		}
+
+		return merge(origin, obj.obj, current, obj.forceOverwriteLabels, obj.oldInformation.Labels, obj.forceOverwriteAnnotations, obj.oldInformation.Annotations, scaledHorizontally)
+	}
 }
 
 // computeHorizontallyScaledObjectKeys returns a set of object keys (in the form `Group/Kind/Namespace/Name`)
@@ -629,6 +703,30 @@ func keyExistsAndValueTrue(kv map[string]string, key string) bool {
 }
 
 func (r *Reconciler) cleanOldResources(ctx context.Context, log logr.Logger, mr *resourcesv1alpha1.ManagedResource, index *objectIndex) (bool, error) {
+	var (
+		deletePVCs   = mr.Spec.DeletePersistentVolumeClaims != nil && *mr.Spec.DeletePersistentVolumeClaims
+		oldResources []resourcesv1alpha1.ObjectReference
+	)
+
+	for _, oldResource := range index.Objects() {
+		if !index.Found(oldResource) {
+			oldResources = append(oldResources, oldResource)
+		}
+	}
+
+	// delete the resources in the reverse order of their apply waves, i.e., the resources of a wave are only deleted
+	// after all resources of the subsequent waves are gone
+	waves := groupReferencesByApplyWave(oldResources)
+	for i := len(waves) - 1; i >= 0; i-- {
+		if deletionPending, err := r.cleanOldResourcesOfWave(ctx, log, waves[i].references, deletePVCs); err != nil {
+			return deletionPending, err
+		}
+	}
+
+	return false, nil
+}
+
+func (r *Reconciler) cleanOldResourcesOfWave(ctx context.Context, log logr.Logger, oldResources []resourcesv1alpha1.ObjectReference, deletePVCs bool) (bool, error) {
 	type output struct {
 		obj             *unstructured.Unstructured
 		deletionPending bool
@@ -638,90 +736,87 @@ func (r *Reconciler) cleanOldResources(ctx context.Context, log logr.Logger, mr
 	var (
 		results         = make(chan *output)
 		wg              sync.WaitGroup
-		deletePVCs      = mr.Spec.DeletePersistentVolumeClaims != nil && *mr.Spec.DeletePersistentVolumeClaims
 		deletionPending = false
 		errorList       = &multierror.Error{
 			ErrorFormat: errorsutils.NewErrorFormatFuncWithPrefix("Could not clean all old resources"),
 		}
 	)
 
-	for _, oldResource := range index.Objects() {
-		if !index.Found(oldResource) {
-			wg.Add(1)
-			go func(ref resourcesv1alpha1.ObjectReference) {
-				defer wg.Done()
-
-				obj := &unstructured.Unstructured{}
-				obj.SetAPIVersion(ref.APIVersion)
-				obj.SetKind(ref.Kind)
-				obj.SetNamespace(ref.Namespace)
-				obj.SetName(ref.Name)
-
-				logger := log.WithValues("resource", unstructuredToString(obj))
-				logger.Info("Deleting")
-
-				// get object before deleting to be able to do cleanup work for it
-				if err := r.TargetClient.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, obj); err != nil {
-					if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
-						logger.Error(err, "Error during deletion")
-						results <- &output{obj, true, err}
-						return
-					}
-
-					// resource already deleted, nothing to do here
-					results <- &output{obj, false, nil}
-					return
-				}
+	for _, oldResource := range oldResources {
+		wg.Add(1)
+		go func(ref resourcesv1alpha1.ObjectReference) {
+			defer wg.Done()
 
-				if keepObject(obj) {
-					logger.Info("Keeping object in the system as "+resourcesv1alpha1.KeepObject+" annotation found", "resource", unstructuredToString(obj))
-					results <- &output{obj, false, nil}
-					return
-				}
+			obj := &unstructured.Unstructured{}
+			obj.SetAPIVersion(ref.APIVersion)
+			obj.SetKind(ref.Kind)
+			obj.SetNamespace(ref.Namespace)
+			obj.SetName(ref.Name)
 
-				if r.GarbageCollectorActivated && isGarbageCollectableResource(obj) {
-					logger.Info("Keeping object in the system as it is marked as 'garbage-collectable'", "resource", unstructuredToString(obj))
-					results <- &output{obj, false, nil}
-					return
-				}
+			logger := log.WithValues("resource", unstructuredToString(obj))
+			logger.Info("Deleting")
 
-				if err := cleanup(ctx, r.TargetClient, r.TargetScheme, obj, deletePVCs); err != nil {
-					logger.Error(err, "Error during cleanup")
+			// get object before deleting to be able to do cleanup work for it
+			if err := r.TargetClient.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, obj); err != nil {
+				if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+					logger.Error(err, "Error during deletion")
 					results <- &output{obj, true, err}
 					return
 				}
 
-				deleteOptions := &client.DeleteOptions{}
+				// resource already deleted, nothing to do here
+				results <- &output{obj, false, nil}
+				return
+			}
 
-				// only delete resources in specific API groups with foreground deletion propagation
-				// see https://github.com/kubernetes/kubernetes/issues/91621, https://github.com/kubernetes/kubernetes/issues/91287
-				// and similar, because of which some objects (e.g `rbac/*` or `v1/Service`) cannot be deleted reliably
-				// with foreground deletion propagation.
-				if foregroundDeletionAPIGroups.Has(obj.GroupVersionKind().Group) {
-					// delete with DeletePropagationForeground to be sure to cleanup all resources (e.g. batch/v1beta1.CronJob
-					// defaults PropagationPolicy to Orphan for backwards compatibility, so it will orphan its Jobs)
-					deleteOptions.PropagationPolicy = &deletePropagationForeground
-				}
+			if keepObject(obj) {
+				logger.Info("Keeping object in the system as "+resourcesv1alpha1.KeepObject+" annotation found", "resource", unstructuredToString(obj))
+				results <- &output{obj, false, nil}
+				return
+			}
 
-				if err := r.TargetClient.Delete(ctx, obj, deleteOptions); err != nil {
-					if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
-						logger.Error(err, "Error during deletion")
-						results <- &output{obj, true, err}
-						return
-					}
-					results <- &output{obj, false, nil}
-					return
-				}
+			if r.GarbageCollectorActivated && isGarbageCollectableResource(obj) {
+				logger.Info("Keeping object in the system as it is marked as 'garbage-collectable'", "resource", unstructuredToString(obj))
+				results <- &output{obj, false, nil}
+				return
+			}
+
+			if err := cleanup(ctx, r.TargetClient, r.TargetScheme, obj, deletePVCs); err != nil {
+				logger.Error(err, "Error during cleanup")
+				results <- &output{obj, true, err}
+				return
+			}
+
+			deleteOptions := &client.DeleteOptions{}
+
+			// only delete resources in specific API groups with foreground deletion propagation
+			// see https://github.com/kubernetes/kubernetes/issues/91621, https://github.com/kubernetes/kubernetes/issues/91287
+			// and similar, because of which some objects (e.g `rbac/*` or `v1/Service`) cannot be deleted reliably
+			// with foreground deletion propagation.
+			if foregroundDeletionAPIGroups.Has(obj.GroupVersionKind().Group) {
+				// delete with DeletePropagationForeground to be sure to cleanup all resources (e.g. batch/v1beta1.CronJob
+				// defaults PropagationPolicy to Orphan for backwards compatibility, so it will orphan its Jobs)
+				deleteOptions.PropagationPolicy = &deletePropagationForeground
+			}
 
-				if err := finalizeResourceIfNecessary(ctx, logger, r.TargetClient, r.Clock, obj); err != nil {
-					logger.Error(err, "Error when finalizing resource if necessary")
+			if err := r.TargetClient.Delete(ctx, obj, deleteOptions); err != nil {
+				if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+					logger.Error(err, "Error during deletion")
 					results <- &output{obj, true, err}
 					return
 				}
+				results <- &output{obj, false, nil}
+				return
+			}
 
-				results <- &output{obj, true, nil}
-			}(oldResource)
-		}
+			if err := finalizeResourceIfNecessary(ctx, logger, r.TargetClient, r.Clock, obj); err != nil {
+				logger.Error(err, "Error when finalizing resource if necessary")
+				results <- &output{obj, true, err}
+				return
+			}
+
+			results <- &output{obj, true, nil}
+		}(oldResource)
 	}
 
 	go func() {
@@ -863,6 +958,7 @@ func updateManagedResourceStatus(
 	mr.Status.SecretsDataChecksum = secretsDataChecksum
 	mr.Status.Resources = resources
 	mr.Status.ObservedGeneration = mr.Generation
+	mr.Status.Preview = nil
 	return c.Status().Update(ctx, mr)
 }
 
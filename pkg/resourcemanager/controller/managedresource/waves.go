@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	healthutils "github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
+)
+
+type applyWave struct {
+	number  int
+	objects []object
+}
+
+type deleteWave struct {
+	number     int
+	references []resourcesv1alpha1.ObjectReference
+}
+
+// applyWaveNumber returns the value of the apply-wave annotation in the given annotations, or 0 if it is not set.
+func applyWaveNumber(annotations map[string]string) (int, error) {
+	value, ok := annotations[resourcesv1alpha1.ApplyWave]
+	if !ok {
+		return 0, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q for annotation %s: %w", value, resourcesv1alpha1.ApplyWave, err)
+	}
+	return number, nil
+}
+
+// groupByApplyWave groups the given objects by their apply waves. The waves are sorted in ascending order, and the
+// objects of each wave are sorted by kind.
+func groupByApplyWave(objects []object) ([]applyWave, error) {
+	objectsByWave := make(map[int][]object)
+
+	for _, obj := range objects {
+		number, err := applyWaveNumber(obj.obj.GetAnnotations())
+		if err != nil {
+			return nil, fmt.Errorf("error determining apply wave of object %q: %w", unstructuredToString(obj.obj), err)
+		}
+		objectsByWave[number] = append(objectsByWave[number], obj)
+	}
+
+	waves := make([]applyWave, 0, len(objectsByWave))
+	for number, objs := range objectsByWave {
+		waves = append(waves, applyWave{number: number, objects: sortByKind(objs)})
+	}
+	slices.SortFunc(waves, func(a, b applyWave) int { return a.number - b.number })
+
+	return waves, nil
+}
+
+// groupReferencesByApplyWave groups the given references by the apply waves stored in their annotations. The waves
+// are sorted in ascending order. References with an invalid apply-wave annotation are assigned to wave 0.
+func groupReferencesByApplyWave(references []resourcesv1alpha1.ObjectReference) []deleteWave {
+	referencesByWave := make(map[int][]resourcesv1alpha1.ObjectReference)
+
+	for _, ref := range references {
+		number, _ := applyWaveNumber(ref.Annotations)
+		referencesByWave[number] = append(referencesByWave[number], ref)
+	}
+
+	waves := make([]deleteWave, 0, len(referencesByWave))
+	for number, refs := range referencesByWave {
+		waves = append(waves, deleteWave{number: number, references: refs})
+	}
+	slices.SortFunc(waves, func(a, b deleteWave) int { return a.number - b.number })
+
+	return waves
+}
+
+// checkHealthOfApplyWave checks whether the given applied objects are healthy. It uses the same checks as the health
+// controller, i.e., the health rule for the object's kind is evaluated if there is one, otherwise the built-in checks
+// are used. Like in the health controller, objects of kinds which are neither registered in the given scheme nor
+// covered by a health rule are only required to exist, which is the case since they have just been applied.
+func checkHealthOfApplyWave(scheme *runtime.Scheme, rules healthutils.Rules, objects []*unstructured.Unstructured) error {
+	for _, obj := range objects {
+		groupKind := obj.GroupVersionKind().GroupKind()
+
+		healthObj, err := objectForHealthCheck(scheme, rules, obj)
+		if err != nil {
+			return fmt.Errorf("could not convert object %q: %w", unstructuredToString(obj), err)
+		}
+		if healthObj == nil {
+			continue
+		}
+
+		if _, err := healthutils.CheckHealthWithRules(rules, groupKind, healthObj); err != nil {
+			return fmt.Errorf("object %q is unhealthy: %w", unstructuredToString(obj), err)
+		}
+	}
+
+	return nil
+}
+
+// objectForHealthCheck returns the object which is passed to the health checks for the given applied object. Like in
+// the health controller, it is a typed object if the kind is registered in the given scheme, and the unstructured object
+// if there is a health rule for an unregistered kind. It returns nil if the object is only required to exist.
+func objectForHealthCheck(scheme *runtime.Scheme, rules healthutils.Rules, obj *unstructured.Unstructured) (client.Object, error) {
+	typed, err := scheme.New(obj.GroupVersionKind())
+	if err != nil {
+		if !runtime.IsNotRegisteredError(err) {
+			return nil, err
+		}
+
+		if rules.HealthRuleFor(obj.GroupVersionKind().GroupKind()) != nil {
+			return obj, nil
+		}
+		return nil, nil
+	}
+
+	typedObj, ok := typed.(client.Object)
+	if !ok {
+		return nil, nil
+	}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, typedObj); err != nil {
+		return nil, err
+	}
+	return typedObj, nil
+}
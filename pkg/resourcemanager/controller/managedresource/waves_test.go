@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
+	healthutils "github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
+)
+
+var _ = Describe("Waves", func() {
+	newObject := func(apiVersion, kind, name, wave string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetName(name)
+		if wave != "" {
+			obj.SetAnnotations(map[string]string{resourcesv1alpha1.ApplyWave: wave})
+		}
+		return obj
+	}
+
+	Describe("#groupByApplyWave", func() {
+		It("should group the objects by apply wave and sort them", func() {
+			var (
+				deployment = object{obj: newObject("apps/v1", "Deployment", "foo", "")}
+				crd        = object{obj: newObject("apiextensions.k8s.io/v1", "CustomResourceDefinition", "foo", "-1")}
+				configMap  = object{obj: newObject("v1", "ConfigMap", "foo", "")}
+				custom     = object{obj: newObject("example.com/v1", "Foo", "foo", "2")}
+			)
+
+			waves, err := groupByApplyWave([]object{deployment, crd, custom, configMap})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(waves).To(Equal([]applyWave{
+				{number: -1, objects: []object{crd}},
+				{number: 0, objects: []object{configMap, deployment}},
+				{number: 2, objects: []object{custom}},
+			}))
+		})
+
+		It("should fail for invalid apply waves", func() {
+			_, err := groupByApplyWave([]object{{obj: newObject("v1", "ConfigMap", "foo", "first")}})
+			Expect(err).To(MatchError(ContainSubstring(`invalid value "first" for annotation resources.gardener.cloud/apply-wave`)))
+		})
+	})
+
+	Describe("#groupReferencesByApplyWave", func() {
+		It("should group the references by apply wave", func() {
+			var (
+				ref1 = resourcesv1alpha1.ObjectReference{ObjectReference: corev1.ObjectReference{Kind: "ConfigMap", Name: "foo"}}
+				ref2 = resourcesv1alpha1.ObjectReference{ObjectReference: corev1.ObjectReference{Kind: "ConfigMap", Name: "bar"}, Annotations: map[string]string{resourcesv1alpha1.ApplyWave: "1"}}
+				ref3 = resourcesv1alpha1.ObjectReference{ObjectReference: corev1.ObjectReference{Kind: "ConfigMap", Name: "baz"}, Annotations: map[string]string{resourcesv1alpha1.ApplyWave: "invalid"}}
+			)
+
+			Expect(groupReferencesByApplyWave([]resourcesv1alpha1.ObjectReference{ref2, ref1, ref3})).To(Equal([]deleteWave{
+				{number: 0, references: []resourcesv1alpha1.ObjectReference{ref1, ref3}},
+				{number: 1, references: []resourcesv1alpha1.ObjectReference{ref2}},
+			}))
+		})
+	})
+
+	Describe("#checkHealthOfApplyWave", func() {
+		It("should succeed if all objects are healthy or only need to exist", func() {
+			Expect(checkHealthOfApplyWave(kubernetesscheme.Scheme, nil, []*unstructured.Unstructured{
+				newObject("v1", "ConfigMap", "foo", ""),
+				newObject("example.com/v1", "Foo", "foo", ""),
+			})).To(Succeed())
+		})
+
+		It("should fail if an object is unhealthy", func() {
+			deployment := newObject("apps/v1", "Deployment", "foo", "")
+			deployment.SetGeneration(2)
+
+			Expect(checkHealthOfApplyWave(kubernetesscheme.Scheme, nil, []*unstructured.Unstructured{deployment})).To(MatchError(ContainSubstring(`object "apps/v1/Deployment/default/foo" is unhealthy`)))
+		})
+
+		It("should succeed if an unhealthy object is skipped", func() {
+			deployment := newObject("apps/v1", "Deployment", "foo", "")
+			deployment.SetGeneration(2)
+			deployment.SetAnnotations(map[string]string{resourcesv1alpha1.SkipHealthCheck: "true"})
+
+			Expect(checkHealthOfApplyWave(kubernetesscheme.Scheme, nil, []*unstructured.Unstructured{deployment})).To(Succeed())
+		})
+
+		Context("with health rules", func() {
+			var rules healthutils.Rules
+
+			BeforeEach(func() {
+				var err error
+				rules, err = healthutils.NewRules([]resourcemanagerconfigv1alpha1.HealthRule{
+					{APIGroup: "example.com", Kind: "Foo", Health: ptr.To(`object.status.phase == "Ready" ? "" : "phase is " + object.status.phase`)},
+					{APIGroup: "apps", Kind: "Deployment", Health: ptr.To(`true`)},
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should evaluate the health rule for kinds which are not registered in the scheme", func() {
+				foo := newObject("example.com/v1", "Foo", "foo", "")
+				Expect(unstructured.SetNestedField(foo.Object, "Pending", "status", "phase")).To(Succeed())
+
+				Expect(checkHealthOfApplyWave(kubernetesscheme.Scheme, rules, []*unstructured.Unstructured{foo})).To(MatchError(ContainSubstring("phase is Pending")))
+
+				Expect(unstructured.SetNestedField(foo.Object, "Ready", "status", "phase")).To(Succeed())
+				Expect(checkHealthOfApplyWave(kubernetesscheme.Scheme, rules, []*unstructured.Unstructured{foo})).To(Succeed())
+			})
+
+			It("should fail if the health rule cannot be evaluated", func() {
+				Expect(checkHealthOfApplyWave(kubernetesscheme.Scheme, rules, []*unstructured.Unstructured{
+					newObject("example.com/v1", "Foo", "foo", ""),
+				})).To(MatchError(ContainSubstring(`object "example.com/v1/Foo/default/foo" is unhealthy`)))
+			})
+
+			It("should evaluate the health rule instead of the built-in checks", func() {
+				deployment := newObject("apps/v1", "Deployment", "foo", "")
+				deployment.SetGeneration(2)
+
+				Expect(checkHealthOfApplyWave(kubernetesscheme.Scheme, rules, []*unstructured.Unstructured{deployment})).To(Succeed())
+			})
+		})
+	})
+})
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package predicate_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	. "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+)
+
+var _ = Describe("preview", func() {
+	var (
+		managedResource *resourcesv1alpha1.ManagedResource
+		predicate       predicate.Predicate
+	)
+
+	BeforeEach(func() {
+		managedResource = &resourcesv1alpha1.ManagedResource{}
+	})
+
+	Describe("#IsInPreviewMode", func() {
+		It("should return false because no preview annotation is present", func() {
+			Expect(IsInPreviewMode(managedResource)).To(BeFalse())
+		})
+
+		It("should return false because preview annotation is not true", func() {
+			metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "resources.gardener.cloud/preview", "false")
+			Expect(IsInPreviewMode(managedResource)).To(BeFalse())
+		})
+
+		It("should return true because preview annotation is true", func() {
+			metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "resources.gardener.cloud/preview", "true")
+			Expect(IsInPreviewMode(managedResource)).To(BeTrue())
+		})
+	})
+
+	Describe("#PreviewModeChanged", func() {
+		BeforeEach(func() {
+			predicate = PreviewModeChanged()
+		})
+
+		It("should match on create, delete and generic events", func() {
+			Expect(predicate.Create(event.CreateEvent{Object: managedResource})).To(BeTrue())
+			Expect(predicate.Delete(event.DeleteEvent{Object: managedResource})).To(BeTrue())
+			Expect(predicate.Generic(event.GenericEvent{Object: managedResource})).To(BeTrue())
+		})
+
+		It("should not match because preview annotation did not change", func() {
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: managedResource, ObjectNew: managedResource.DeepCopy()})).To(BeFalse())
+		})
+
+		It("should match because preview annotation was added", func() {
+			newManagedResource := managedResource.DeepCopy()
+			metav1.SetMetaDataAnnotation(&newManagedResource.ObjectMeta, "resources.gardener.cloud/preview", "true")
+
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: managedResource, ObjectNew: newManagedResource})).To(BeTrue())
+		})
+
+		It("should match because preview annotation was removed", func() {
+			metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "resources.gardener.cloud/preview", "true")
+			newManagedResource := managedResource.DeepCopy()
+			delete(newManagedResource.Annotations, "resources.gardener.cloud/preview")
+
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: managedResource, ObjectNew: newManagedResource})).To(BeTrue())
+		})
+	})
+})
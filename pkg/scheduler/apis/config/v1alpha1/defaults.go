@@ -5,7 +5,11 @@
 package v1alpha1
 
 import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
 )
 
 // SetDefaults_SchedulerConfiguration sets defaults for the configuration of the Gardener scheduler.
@@ -44,6 +48,45 @@ func SetDefaults_SchedulerControllerConfiguration(obj *SchedulerControllerConfig
 	if obj.Shoot.ConcurrentSyncs == 0 {
 		obj.Shoot.ConcurrentSyncs = 5
 	}
+
+	if obj.Shoot.Strategy == Scoring && obj.Shoot.Scoring == nil {
+		obj.Shoot.Scoring = &SeedScoringConfiguration{
+			Plugins: []ScorePlugin{
+				{Name: ScorePluginRegionDistance, Weight: 2},
+				{Name: ScorePluginCapacityHeadroom, Weight: 1},
+			},
+		}
+	}
+}
+
+// SetDefaults_ShootRebalancingConfiguration sets defaults for the configuration of the ShootRebalancing controller.
+func SetDefaults_ShootRebalancingConfiguration(obj *ShootRebalancingConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(1)
+	}
+
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+
+	if obj.MinImprovement == nil {
+		obj.MinImprovement = ptr.To[int64](10)
+	}
+
+	if obj.Mode == nil {
+		obj.Mode = ptr.To(ShootRebalancingModeRecommend)
+	}
+
+	if obj.MaxMigrationsPerHour == nil {
+		obj.MaxMigrationsPerHour = ptr.To(5)
+	}
+}
+
+// SetDefaults_ScorePlugin sets defaults for a score plugin.
+func SetDefaults_ScorePlugin(obj *ScorePlugin) {
+	if obj.Weight == 0 {
+		obj.Weight = 1
+	}
 }
 
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
@@ -90,6 +90,70 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootSchedulerConfiguration defaulting", func() {
+		It("should default the scoring configuration for the 'Scoring' strategy", func() {
+			obj.Schedulers.Shoot = &schedulerconfigv1alpha1.ShootSchedulerConfiguration{Strategy: schedulerconfigv1alpha1.Scoring}
+
+			schedulerconfigv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.Scoring).To(Equal(&schedulerconfigv1alpha1.SeedScoringConfiguration{
+				Plugins: []schedulerconfigv1alpha1.ScorePlugin{
+					{Name: schedulerconfigv1alpha1.ScorePluginRegionDistance, Weight: 2},
+					{Name: schedulerconfigv1alpha1.ScorePluginCapacityHeadroom, Weight: 1},
+				},
+			}))
+		})
+
+		It("should default the weight of score plugins", func() {
+			obj.Schedulers.Shoot = &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				Scoring: &schedulerconfigv1alpha1.SeedScoringConfiguration{
+					Plugins: []schedulerconfigv1alpha1.ScorePlugin{{Name: schedulerconfigv1alpha1.ScorePluginZones}},
+				},
+			}
+
+			schedulerconfigv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.Scoring.Plugins).To(ConsistOf(schedulerconfigv1alpha1.ScorePlugin{Name: schedulerconfigv1alpha1.ScorePluginZones, Weight: 1}))
+		})
+	})
+
+	Describe("ShootRebalancingConfiguration defaulting", func() {
+		It("should not default the shoot rebalancing configuration", func() {
+			schedulerconfigv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.ShootRebalancing).To(BeNil())
+		})
+
+		It("should default the shoot rebalancing configuration", func() {
+			obj.Schedulers.ShootRebalancing = &schedulerconfigv1alpha1.ShootRebalancingConfiguration{}
+
+			schedulerconfigv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.ShootRebalancing).To(Equal(&schedulerconfigv1alpha1.ShootRebalancingConfiguration{
+				ConcurrentSyncs:      ptr.To(1),
+				SyncPeriod:           &metav1.Duration{Duration: time.Hour},
+				MinImprovement:       ptr.To[int64](10),
+				Mode:                 ptr.To(schedulerconfigv1alpha1.ShootRebalancingModeRecommend),
+				MaxMigrationsPerHour: ptr.To(5),
+			}))
+		})
+
+		It("should not overwrite already set values for the shoot rebalancing configuration", func() {
+			obj.Schedulers.ShootRebalancing = &schedulerconfigv1alpha1.ShootRebalancingConfiguration{
+				ConcurrentSyncs:      ptr.To(3),
+				SyncPeriod:           &metav1.Duration{Duration: time.Minute},
+				MinImprovement:       ptr.To[int64](0),
+				Mode:                 ptr.To(schedulerconfigv1alpha1.ShootRebalancingModeMigrate),
+				MaxMigrationsPerHour: ptr.To(1),
+			}
+			expected := obj.Schedulers.ShootRebalancing.DeepCopy()
+
+			schedulerconfigv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.ShootRebalancing).To(Equal(expected))
+		})
+	})
+
 	Describe("ServerConfiguration defaulting", func() {
 		It("should not overwrite already set values for ServerConfiguration", func() {
 			serverConfiguration := &schedulerconfigv1alpha1.ServerConfiguration{
@@ -14,6 +14,9 @@ const (
 	SameRegion CandidateDeterminationStrategy = "SameRegion"
 	// MinimalDistance Strategy determines a seed candidate for a shoot if the cloud profile are identical. Then chooses the seed with the minimal distance to the shoot.
 	MinimalDistance CandidateDeterminationStrategy = "MinimalDistance"
+	// Scoring Strategy considers all seeds passing the filters as candidates and chooses the one with the highest
+	// weighted score calculated by the configured score plugins.
+	Scoring CandidateDeterminationStrategy = "Scoring"
 	// Default Strategy is the default strategy to use when there is no configuration provided
 	Default = SameRegion
 	// SchedulerDefaultLockObjectNamespace is the default lock namespace for leader election.
@@ -35,7 +38,7 @@ const (
 )
 
 // Strategies defines all currently implemented SeedCandidateDeterminationStrategies
-var Strategies = []CandidateDeterminationStrategy{SameRegion, MinimalDistance}
+var Strategies = []CandidateDeterminationStrategy{SameRegion, MinimalDistance, Scoring}
 
 // CandidateDeterminationStrategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 type CandidateDeterminationStrategy string
@@ -80,6 +83,10 @@ type SchedulerControllerConfiguration struct {
 	// Shoot defines the configuration of the Shoot controller.
 	// +optional
 	Shoot *ShootSchedulerConfiguration `json:"shoot,omitempty"`
+	// ShootRebalancing defines the configuration of the controller which periodically evaluates the placement of
+	// scheduled shoots and recommends better seeds. The controller is disabled if not set.
+	// +optional
+	ShootRebalancing *ShootRebalancingConfiguration `json:"shootRebalancing,omitempty"`
 }
 
 // BackupBucketSchedulerConfiguration defines the configuration of the BackupBucket to Seed
@@ -98,8 +105,161 @@ type ShootSchedulerConfiguration struct {
 	ConcurrentSyncs int `json:"concurrentSyncs"`
 	// Strategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 	Strategy CandidateDeterminationStrategy `json:"candidateDeterminationStrategy"`
+	// Scoring configures the score plugins which are used to rank the seed candidates. If set, the seed with the
+	// highest weighted score wins, otherwise the seed with the least number of shoots is chosen. It is defaulted if the
+	// `Scoring` strategy is used.
+	// +optional
+	Scoring *SeedScoringConfiguration `json:"scoring,omitempty"`
+	// Filters configures the filter plugins which are used to determine the seed candidates. If not set, all filter
+	// plugins are executed.
+	// +optional
+	Filters *SeedFilterConfiguration `json:"filters,omitempty"`
+}
+
+// SeedFilterConfiguration defines which filter plugins are used to determine the seed candidates.
+type SeedFilterConfiguration struct {
+	// Disabled is the list of filter plugins which are not executed. Only the plugins listed in
+	// DisableableFilterPlugins can be disabled, the others are required for a valid scheduling decision.
+	// +optional
+	Disabled []FilterPluginName `json:"disabled,omitempty"`
+}
+
+// FilterPluginName is the name of a filter plugin.
+type FilterPluginName string
+
+const (
+	// FilterPluginUsable filters seeds which are not ready, are being deleted, or are not visible for scheduling.
+	FilterPluginUsable FilterPluginName = "Usable"
+	// FilterPluginCloudProfileSeedSelector filters seeds not matching the seed selector of the shoot's cloud profile.
+	FilterPluginCloudProfileSeedSelector FilterPluginName = "CloudProfileSeedSelector"
+	// FilterPluginShootSeedSelector filters seeds not matching the seed selector of the shoot.
+	FilterPluginShootSeedSelector FilterPluginName = "ShootSeedSelector"
+	// FilterPluginProvider filters seeds whose provider type is not allowed by the seed selectors.
+	FilterPluginProvider FilterPluginName = "Provider"
+	// FilterPluginZonalShootControlPlane filters seeds with less than three zones for shoots with a zonal failure
+	// tolerance.
+	FilterPluginZonalShootControlPlane FilterPluginName = "ZonalShootControlPlane"
+	// FilterPluginAccessRestrictions filters seeds which do not support the access restrictions of the shoot.
+	FilterPluginAccessRestrictions FilterPluginName = "AccessRestrictions"
+	// FilterPluginDomain filters seeds which cannot support the shoot's domain configuration.
+	FilterPluginDomain FilterPluginName = "Domain"
+	// FilterPluginShootReconciliationsEnabled filters seeds on which shoot reconciliations are disabled.
+	FilterPluginShootReconciliationsEnabled FilterPluginName = "ShootReconciliationsEnabled"
+	// FilterPluginCandidates filters seeds with overlapping networks, taints not tolerated by the shoot, or without
+	// capacity for further shoots.
+	FilterPluginCandidates FilterPluginName = "Candidates"
+	// FilterPluginStrategy narrows down the seeds according to the configured candidate determination strategy.
+	FilterPluginStrategy FilterPluginName = "Strategy"
+)
+
+// FilterPlugins defines all currently implemented filter plugins in the order they are executed.
+var FilterPlugins = []FilterPluginName{
+	FilterPluginUsable,
+	FilterPluginCloudProfileSeedSelector,
+	FilterPluginShootSeedSelector,
+	FilterPluginProvider,
+	FilterPluginZonalShootControlPlane,
+	FilterPluginAccessRestrictions,
+	FilterPluginDomain,
+	FilterPluginShootReconciliationsEnabled,
+	FilterPluginCandidates,
+	FilterPluginStrategy,
+}
+
+// DisableableFilterPlugins defines the filter plugins which can be disabled.
+var DisableableFilterPlugins = []FilterPluginName{
+	FilterPluginCloudProfileSeedSelector,
+	FilterPluginProvider,
+	FilterPluginZonalShootControlPlane,
+	FilterPluginDomain,
+	FilterPluginShootReconciliationsEnabled,
+}
+
+// SeedScoringConfiguration defines how seed candidates are ranked.
+type SeedScoringConfiguration struct {
+	// Plugins is the list of score plugins. The score of each plugin is normalized to the range [0, 100] and multiplied
+	// with its weight. The seed with the highest sum wins, ties are broken by choosing the seed with the least number
+	// of shoots.
+	Plugins []ScorePlugin `json:"plugins"`
+}
+
+// ScorePlugin configures a score plugin.
+type ScorePlugin struct {
+	// Name is the name of the score plugin.
+	Name ScorePluginName `json:"name"`
+	// Weight is the factor the normalized score of the plugin is multiplied with.
+	Weight int32 `json:"weight"`
+	// SeedLabels is the list of label preferences evaluated by the `SeedLabels` plugin. It must not be set for other
+	// plugins.
+	// +optional
+	SeedLabels []SeedLabelPreference `json:"seedLabels,omitempty"`
 }
 
+// SeedLabelPreference adds the given score to seeds having a matching label.
+type SeedLabelPreference struct {
+	// Key is the label key.
+	Key string `json:"key"`
+	// Value is the label value. If empty, seeds having the label key are matching regardless of its value.
+	// +optional
+	Value *string `json:"value,omitempty"`
+	// Score is added to the raw score of matching seeds. Negative values can be used to express anti-affinity.
+	Score int32 `json:"score"`
+}
+
+// ScorePluginName is the name of a score plugin.
+type ScorePluginName string
+
+const (
+	// ScorePluginRegionDistance prefers seeds with a small distance to the shoot's region. Distances are taken from the
+	// region config map if available, otherwise the Levenshtein distance of the region names is used.
+	ScorePluginRegionDistance ScorePluginName = "RegionDistance"
+	// ScorePluginCapacityHeadroom prefers seeds with a high ratio of free capacity for shoots, i.e. allocatable shoots
+	// compared to the current number of shoots. Seeds without allocatable shoots are considered to have full headroom.
+	ScorePluginCapacityHeadroom ScorePluginName = "CapacityHeadroom"
+	// ScorePluginZones prefers seeds with a high number of zones.
+	ScorePluginZones ScorePluginName = "Zones"
+	// ScorePluginSeedLabels prefers seeds according to the configured label preferences.
+	ScorePluginSeedLabels ScorePluginName = "SeedLabels"
+)
+
+// ScorePlugins defines all currently implemented score plugins.
+var ScorePlugins = []ScorePluginName{ScorePluginRegionDistance, ScorePluginCapacityHeadroom, ScorePluginZones, ScorePluginSeedLabels}
+
+// ShootRebalancingConfiguration defines the configuration of the ShootRebalancing controller.
+type ShootRebalancingConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the placement of scheduled shoots is evaluated.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// MinImprovement is the minimum improvement a different seed must offer compared to the current seed of a shoot
+	// before it is recommended. If scoring is configured for the shoot scheduler, the improvement is the difference of the
+	// total scores, otherwise it is the difference of the number of shoots scheduled onto the seeds.
+	// +optional
+	MinImprovement *int64 `json:"minImprovement,omitempty"`
+	// Mode defines whether recommendations are only reported (`Recommend`) or whether the control plane migration to the
+	// recommended seed is triggered during the shoot's maintenance time window (`Migrate`).
+	// +optional
+	Mode *ShootRebalancingMode `json:"mode,omitempty"`
+	// MaxMigrationsPerHour is the maximum number of control plane migrations triggered per hour in `Migrate` mode. It
+	// prevents that the seeds are overloaded by many concurrent migrations, e.g., after a new seed was added. Shoots
+	// exceeding the limit keep the recommendation and are migrated with one of the next evaluations. Defaults to 5.
+	// +optional
+	MaxMigrationsPerHour *int `json:"maxMigrationsPerHour,omitempty"`
+}
+
+// ShootRebalancingMode is the mode of the ShootRebalancing controller.
+type ShootRebalancingMode string
+
+const (
+	// ShootRebalancingModeRecommend only reports seed rebalancing recommendations.
+	ShootRebalancingModeRecommend ShootRebalancingMode = "Recommend"
+	// ShootRebalancingModeMigrate reports seed rebalancing recommendations and triggers the control plane migration to
+	// the recommended seed during the shoot's maintenance time window.
+	ShootRebalancingModeMigrate ShootRebalancingMode = "Migrate"
+)
+
 // ServerConfiguration contains details for the HTTP(S) servers.
 type ServerConfiguration struct {
 	// HealthProbes is the configuration for serving the healthz and readyz endpoints.
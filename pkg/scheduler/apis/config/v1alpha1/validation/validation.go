@@ -5,7 +5,10 @@
 package validation
 
 import (
+	"fmt"
+
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -51,11 +54,43 @@ func validateSchedulerControllerConfiguration(schedulers schedulerconfigv1alpha1
 	if schedulers.Shoot != nil {
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(schedulers.Shoot.ConcurrentSyncs), fldPath.Child("shoot", "concurrentSyncs"))...)
 		allErrs = append(allErrs, validateStrategy(schedulers.Shoot.Strategy, fldPath.Child("shoot", "strategy"))...)
+
+		if schedulers.Shoot.Strategy == schedulerconfigv1alpha1.Scoring && schedulers.Shoot.Scoring == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("shoot", "scoring"), "scoring configuration is required when using the 'Scoring' strategy"))
+		}
+		if schedulers.Shoot.Scoring != nil {
+			allErrs = append(allErrs, validateScoring(schedulers.Shoot.Scoring, fldPath.Child("shoot", "scoring"))...)
+		}
+		if schedulers.Shoot.Filters != nil {
+			allErrs = append(allErrs, validateFilters(schedulers.Shoot.Filters, fldPath.Child("shoot", "filters"))...)
+		}
+	}
+
+	if rebalancing := schedulers.ShootRebalancing; rebalancing != nil {
+		fldPath := fldPath.Child("shootRebalancing")
+
+		if rebalancing.ConcurrentSyncs != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*rebalancing.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+		}
+		if rebalancing.SyncPeriod != nil && rebalancing.SyncPeriod.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), rebalancing.SyncPeriod.Duration.String(), "must be greater than 0"))
+		}
+		if rebalancing.MinImprovement != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(*rebalancing.MinImprovement, fldPath.Child("minImprovement"))...)
+		}
+		if rebalancing.Mode != nil && !supportedShootRebalancingModes.Has(*rebalancing.Mode) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), *rebalancing.Mode, sets.List(supportedShootRebalancingModes)))
+		}
+		if rebalancing.MaxMigrationsPerHour != nil && *rebalancing.MaxMigrationsPerHour <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxMigrationsPerHour"), *rebalancing.MaxMigrationsPerHour, "must be greater than 0"))
+		}
 	}
 
 	return allErrs
 }
 
+var supportedShootRebalancingModes = sets.New(schedulerconfigv1alpha1.ShootRebalancingModeRecommend, schedulerconfigv1alpha1.ShootRebalancingModeMigrate)
+
 func validateStrategy(strategy schedulerconfigv1alpha1.CandidateDeterminationStrategy, fldPath *field.Path) field.ErrorList {
 	var (
 		allErrs             = field.ErrorList{}
@@ -74,3 +109,67 @@ func validateStrategy(strategy schedulerconfigv1alpha1.CandidateDeterminationStr
 
 	return allErrs
 }
+
+func validateScoring(scoring *schedulerconfigv1alpha1.SeedScoringConfiguration, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs          = field.ErrorList{}
+		pluginNames      = sets.New[schedulerconfigv1alpha1.ScorePluginName]()
+		supportedPlugins = sets.New(schedulerconfigv1alpha1.ScorePlugins...)
+	)
+
+	if len(scoring.Plugins) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("plugins"), "at least one score plugin must be configured"))
+	}
+
+	for i, plugin := range scoring.Plugins {
+		idxPath := fldPath.Child("plugins").Index(i)
+
+		if !supportedPlugins.Has(plugin.Name) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), plugin.Name, sets.List(supportedPlugins)))
+		} else if pluginNames.Has(plugin.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), plugin.Name))
+		}
+		pluginNames.Insert(plugin.Name)
+
+		if plugin.Weight <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("weight"), plugin.Weight, "must be greater than 0"))
+		}
+
+		if plugin.Name != schedulerconfigv1alpha1.ScorePluginSeedLabels {
+			if len(plugin.SeedLabels) > 0 {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("seedLabels"), fmt.Sprintf("seed labels are only supported for the %q plugin", schedulerconfigv1alpha1.ScorePluginSeedLabels)))
+			}
+			continue
+		}
+
+		if len(plugin.SeedLabels) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("seedLabels"), "at least one seed label preference must be configured"))
+		}
+		for j, preference := range plugin.SeedLabels {
+			allErrs = append(allErrs, metav1validation.ValidateLabelName(preference.Key, idxPath.Child("seedLabels").Index(j).Child("key"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateFilters(filters *schedulerconfigv1alpha1.SeedFilterConfiguration, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs          = field.ErrorList{}
+		pluginNames      = sets.New[schedulerconfigv1alpha1.FilterPluginName]()
+		supportedPlugins = sets.New(schedulerconfigv1alpha1.DisableableFilterPlugins...)
+	)
+
+	for i, plugin := range filters.Disabled {
+		idxPath := fldPath.Child("disabled").Index(i)
+
+		if !supportedPlugins.Has(plugin) {
+			allErrs = append(allErrs, field.NotSupported(idxPath, plugin, sets.List(supportedPlugins)))
+		} else if pluginNames.Has(plugin) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, plugin))
+		}
+		pluginNames.Insert(plugin)
+	}
+
+	return allErrs
+}
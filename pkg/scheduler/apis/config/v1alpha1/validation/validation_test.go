@@ -5,6 +5,8 @@
 package validation
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -138,6 +140,142 @@ var _ = Describe("#ValidateConfiguration", func() {
 			}))))
 		})
 
+		It("should pass because the Gardener Scheduler Configuration with the 'Scoring' Strategy is a valid configuration", func() {
+			scoringConfiguration := conf.DeepCopy()
+			scoringConfiguration.Schedulers.Shoot.Strategy = schedulerconfigv1alpha1.Scoring
+			scoringConfiguration.Schedulers.Shoot.Scoring = &schedulerconfigv1alpha1.SeedScoringConfiguration{
+				Plugins: []schedulerconfigv1alpha1.ScorePlugin{
+					{Name: schedulerconfigv1alpha1.ScorePluginRegionDistance, Weight: 2},
+					{Name: schedulerconfigv1alpha1.ScorePluginSeedLabels, Weight: 1, SeedLabels: []schedulerconfigv1alpha1.SeedLabelPreference{{Key: "seed.gardener.cloud/preferred", Score: 10}}},
+				},
+			}
+
+			Expect(ValidateConfiguration(scoringConfiguration)).To(BeEmpty())
+		})
+
+		It("should fail because the 'Scoring' Strategy is used without scoring configuration", func() {
+			invalidConfiguration := conf.DeepCopy()
+			invalidConfiguration.Schedulers.Shoot.Strategy = schedulerconfigv1alpha1.Scoring
+
+			Expect(ValidateConfiguration(invalidConfiguration)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("schedulers.shoot.scoring"),
+			}))))
+		})
+
+		It("should fail because the scoring configuration is invalid", func() {
+			invalidConfiguration := conf.DeepCopy()
+			invalidConfiguration.Schedulers.Shoot.Scoring = &schedulerconfigv1alpha1.SeedScoringConfiguration{
+				Plugins: []schedulerconfigv1alpha1.ScorePlugin{
+					{Name: "Foo", Weight: 1},
+					{Name: schedulerconfigv1alpha1.ScorePluginZones, Weight: 0},
+					{Name: schedulerconfigv1alpha1.ScorePluginZones, Weight: 1, SeedLabels: []schedulerconfigv1alpha1.SeedLabelPreference{{Key: "foo", Score: 1}}},
+					{Name: schedulerconfigv1alpha1.ScorePluginSeedLabels, Weight: 1},
+				},
+			}
+
+			Expect(ValidateConfiguration(invalidConfiguration)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("schedulers.shoot.scoring.plugins[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.scoring.plugins[1].weight"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("schedulers.shoot.scoring.plugins[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("schedulers.shoot.scoring.plugins[2].seedLabels"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("schedulers.shoot.scoring.plugins[3].seedLabels"),
+				})),
+			))
+		})
+
+		It("should pass because the filter configuration is valid", func() {
+			filterConfiguration := conf.DeepCopy()
+			filterConfiguration.Schedulers.Shoot.Filters = &schedulerconfigv1alpha1.SeedFilterConfiguration{
+				Disabled: []schedulerconfigv1alpha1.FilterPluginName{schedulerconfigv1alpha1.FilterPluginDomain, schedulerconfigv1alpha1.FilterPluginZonalShootControlPlane},
+			}
+
+			Expect(ValidateConfiguration(filterConfiguration)).To(BeEmpty())
+		})
+
+		It("should fail because the filter configuration is invalid", func() {
+			invalidConfiguration := conf.DeepCopy()
+			invalidConfiguration.Schedulers.Shoot.Filters = &schedulerconfigv1alpha1.SeedFilterConfiguration{
+				Disabled: []schedulerconfigv1alpha1.FilterPluginName{"Foo", schedulerconfigv1alpha1.FilterPluginUsable, schedulerconfigv1alpha1.FilterPluginDomain, schedulerconfigv1alpha1.FilterPluginDomain},
+			}
+
+			Expect(ValidateConfiguration(invalidConfiguration)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("schedulers.shoot.filters.disabled[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("schedulers.shoot.filters.disabled[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("schedulers.shoot.filters.disabled[3]"),
+				})),
+			))
+		})
+
+		It("should pass because the shoot rebalancing configuration is valid", func() {
+			rebalancingConfiguration := conf.DeepCopy()
+			rebalancingConfiguration.Schedulers.ShootRebalancing = &schedulerconfigv1alpha1.ShootRebalancingConfiguration{
+				ConcurrentSyncs:      ptr.To(1),
+				SyncPeriod:           &metav1.Duration{Duration: time.Hour},
+				MinImprovement:       ptr.To[int64](0),
+				Mode:                 ptr.To(schedulerconfigv1alpha1.ShootRebalancingModeMigrate),
+				MaxMigrationsPerHour: ptr.To(1),
+			}
+
+			Expect(ValidateConfiguration(rebalancingConfiguration)).To(BeEmpty())
+		})
+
+		It("should fail because the shoot rebalancing configuration is invalid", func() {
+			invalidConfiguration := conf.DeepCopy()
+			invalidConfiguration.Schedulers.ShootRebalancing = &schedulerconfigv1alpha1.ShootRebalancingConfiguration{
+				ConcurrentSyncs:      ptr.To(-1),
+				SyncPeriod:           &metav1.Duration{},
+				MinImprovement:       ptr.To[int64](-1),
+				Mode:                 ptr.To[schedulerconfigv1alpha1.ShootRebalancingMode]("Foo"),
+				MaxMigrationsPerHour: ptr.To(0),
+			}
+
+			Expect(ValidateConfiguration(invalidConfiguration)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shootRebalancing.concurrentSyncs"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shootRebalancing.syncPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shootRebalancing.minImprovement"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("schedulers.shootRebalancing.mode"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shootRebalancing.maxMigrationsPerHour"),
+				})),
+			))
+		})
+
 		It("should fail because backupBucket concurrentSyncs are negative", func() {
 			invalidConfiguration := conf.DeepCopy()
 			invalidConfiguration.Schedulers.BackupBucket.ConcurrentSyncs = -1
@@ -29,4 +29,15 @@ func SetObjectDefaults_SchedulerConfiguration(in *SchedulerConfiguration) {
 	}
 	SetDefaults_ServerConfiguration(&in.Server)
 	SetDefaults_SchedulerControllerConfiguration(&in.Schedulers)
+	if in.Schedulers.Shoot != nil {
+		if in.Schedulers.Shoot.Scoring != nil {
+			for i := range in.Schedulers.Shoot.Scoring.Plugins {
+				a := &in.Schedulers.Shoot.Scoring.Plugins[i]
+				SetDefaults_ScorePlugin(a)
+			}
+		}
+	}
+	if in.Schedulers.ShootRebalancing != nil {
+		SetDefaults_ShootRebalancingConfiguration(in.Schedulers.ShootRebalancing)
+	}
 }
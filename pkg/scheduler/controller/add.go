@@ -10,6 +10,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/scheduler/controller/rebalancing"
 	"github.com/gardener/gardener/pkg/scheduler/controller/shoot"
 )
 
@@ -21,5 +22,14 @@ func AddToManager(mgr manager.Manager, cfg *schedulerconfigv1alpha1.SchedulerCon
 		return fmt.Errorf("failed adding Shoot controller: %w", err)
 	}
 
+	if cfg.Schedulers.ShootRebalancing != nil {
+		if err := (&rebalancing.Reconciler{
+			Config:          cfg.Schedulers.ShootRebalancing,
+			SchedulerConfig: cfg.Schedulers.Shoot,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding ShootRebalancing controller: %w", err)
+		}
+	}
+
 	return nil
 }
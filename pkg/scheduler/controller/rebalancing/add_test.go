@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancing_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/scheduler/controller/rebalancing"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		reconciler *Reconciler
+		shoot      *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		shoot = &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed")}}
+	})
+
+	Describe("#ShootScheduledPredicate", func() {
+		It("should return true for scheduled shoots", func() {
+			Expect(reconciler.ShootScheduledPredicate().Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should return false for unscheduled shoots", func() {
+			shoot.Spec.SeedName = nil
+			Expect(reconciler.ShootScheduledPredicate().Create(event.CreateEvent{Object: shoot})).To(BeFalse())
+		})
+
+		It("should return false for shoots using a different scheduler", func() {
+			shoot.Spec.SchedulerName = ptr.To("foo")
+			Expect(reconciler.ShootScheduledPredicate().Create(event.CreateEvent{Object: shoot})).To(BeFalse())
+		})
+
+		It("should return false for self-hosted shoots", func() {
+			shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{{ControlPlane: &gardencorev1beta1.WorkerControlPlane{}}}
+			Expect(reconciler.ShootScheduledPredicate().Create(event.CreateEvent{Object: shoot})).To(BeFalse())
+		})
+	})
+
+	Describe("#ShootSeedChangedPredicate", func() {
+		It("should return true for create events", func() {
+			Expect(reconciler.ShootSeedChangedPredicate().Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should return true if the seed name changed", func() {
+			oldShoot := shoot.DeepCopy()
+			oldShoot.Spec.SeedName = nil
+			Expect(reconciler.ShootSeedChangedPredicate().Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+		})
+
+		It("should return false if the seed name did not change", func() {
+			Expect(reconciler.ShootSeedChangedPredicate().Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: shoot})).To(BeFalse())
+		})
+
+		It("should return false for delete and generic events", func() {
+			Expect(reconciler.ShootSeedChangedPredicate().Delete(event.DeleteEvent{Object: shoot})).To(BeFalse())
+			Expect(reconciler.ShootSeedChangedPredicate().Generic(event.GenericEvent{Object: shoot})).To(BeFalse())
+		})
+	})
+})
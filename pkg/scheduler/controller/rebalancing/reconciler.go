@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler periodically evaluates the placement of scheduled shoots. It recommends a better seed via an annotation on
+// the shoot and, if configured, triggers the control plane migration to this seed during the shoot's maintenance time
+// window.
+type Reconciler struct {
+	Client          client.Client
+	Config          *schedulerconfigv1alpha1.ShootRebalancingConfiguration
+	SchedulerConfig *schedulerconfigv1alpha1.ShootSchedulerConfiguration
+	GardenNamespace string
+	Clock           clock.Clock
+	Recorder        record.EventRecorder
+	// MigrationLimiter limits the number of control plane migrations triggered in `Migrate` mode. If it is nil, the
+	// number of migrations is not limited.
+	MigrationLimiter *rate.Limiter
+}
+
+// Reconcile evaluates the placement of a scheduled shoot.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shoot.DeletionTimestamp != nil || shoot.Spec.SeedName == nil {
+		log.V(1).Info("Shoot is not scheduled or is being deleted, stop reconciling")
+		return reconcile.Result{}, nil
+	}
+
+	result := reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}
+
+	if shoot.Status.SeedName != nil && *shoot.Status.SeedName != *shoot.Spec.SeedName {
+		log.Info("Control plane migration is in progress, skipping evaluation", "seed", *shoot.Spec.SeedName, "sourceSeed", *shoot.Status.SeedName)
+		return result, nil
+	}
+
+	recommendedSeed, err := r.recommendSeed(ctx, log, shoot)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if recommendedSeed != nil && ptr.Deref(r.Config.Mode, "") == schedulerconfigv1alpha1.ShootRebalancingModeMigrate && r.migrationAllowed(shoot) {
+		if reservation, ok := r.reserveMigration(); ok {
+			return result, r.triggerMigration(ctx, log, shoot, *recommendedSeed, reservation)
+		}
+		log.Info("Rate limit for control plane migrations reached, only recommending seed", "recommendedSeed", *recommendedSeed)
+	}
+
+	return result, r.updateRecommendation(ctx, log, shoot, recommendedSeed)
+}
+
+// recommendSeed returns the name of a seed which is a better placement for the shoot than its current seed. It returns
+// nil if the current seed is the best candidate or if the improvement is below the configured threshold. Errors are
+// only returned if the seeds could not be ranked because of a (transient) failure, so that the current recommendation
+// is kept.
+func (r *Reconciler) recommendSeed(ctx context.Context, log logr.Logger, shootObj *gardencorev1beta1.Shoot) (*string, error) {
+	scheduler := &shoot.Reconciler{
+		Client:          r.Client,
+		Config:          r.SchedulerConfig,
+		GardenNamespace: r.GardenNamespace,
+	}
+
+	scores, err := scheduler.RankSeeds(ctx, log, shootObj)
+	if err != nil {
+		if shoot.IsNoSeedCandidatesError(err) {
+			log.Info("No seed candidates found for shoot, not recommending any seed", "reason", err.Error())
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed ranking seeds for shoot: %w", err)
+	}
+
+	var current *shoot.SeedScore
+	for i := range scores {
+		if scores[i].Seed.Name == *shootObj.Spec.SeedName {
+			current = &scores[i]
+			break
+		}
+	}
+
+	if current == nil {
+		// If the current seed is not eligible (anymore), e.g. because it is temporarily not ready, we do not recommend a
+		// migration. Moving away from seeds is handled by operators in such cases.
+		log.V(1).Info("Current seed is not a scheduling candidate for shoot, not recommending any seed", "seed", *shootObj.Spec.SeedName)
+		return nil, nil
+	}
+
+	best := scores[0]
+	if best.Seed.Name == current.Seed.Name {
+		return nil, nil
+	}
+
+	improvement := int64(current.Shoots - best.Shoots)
+	if r.SchedulerConfig.Scoring != nil {
+		improvement = best.Total - current.Total
+	}
+
+	if improvement < ptr.Deref(r.Config.MinImprovement, 0) {
+		log.V(1).Info("Improvement of best seed is below threshold, not recommending any seed", "bestSeed", best.Seed.Name, "improvement", improvement)
+		return nil, nil
+	}
+
+	log.Info("Found better seed for shoot", "seed", current.Seed.Name, "recommendedSeed", best.Seed.Name, "improvement", improvement)
+	return &best.Seed.Name, nil
+}
+
+func (r *Reconciler) updateRecommendation(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, recommendedSeed *string) error {
+	currentRecommendation, ok := shoot.Annotations[v1beta1constants.AnnotationSchedulingRecommendedSeed]
+	if recommendedSeed == nil && !ok || recommendedSeed != nil && *recommendedSeed == currentRecommendation {
+		return nil
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	if recommendedSeed == nil {
+		log.Info("Removing seed rebalancing recommendation")
+		delete(shoot.Annotations, v1beta1constants.AnnotationSchedulingRecommendedSeed)
+	} else {
+		log.Info("Recommending seed for shoot", "recommendedSeed", *recommendedSeed)
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationSchedulingRecommendedSeed, *recommendedSeed)
+	}
+
+	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed updating seed rebalancing recommendation: %w", err)
+	}
+
+	if recommendedSeed != nil {
+		r.Recorder.Eventf(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventSeedRebalancingRecommended, "Seed %q is recommended for hosting the control plane instead of %q", *recommendedSeed, *shoot.Spec.SeedName)
+	}
+	return nil
+}
+
+// migrationAllowed returns true if the shoot is currently in its maintenance time window and the last operation
+// succeeded.
+func (r *Reconciler) migrationAllowed(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot.Status.LastOperation != nil &&
+		shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateSucceeded &&
+		gardenerutils.IsNowInEffectiveShootMaintenanceTimeWindow(shoot, r.Clock)
+}
+
+// reserveMigration reserves a migration from the MigrationLimiter. It returns false if the rate limit for control
+// plane migrations is reached. The returned reservation is nil if the number of migrations is not limited.
+func (r *Reconciler) reserveMigration() (*rate.Reservation, bool) {
+	if r.MigrationLimiter == nil {
+		return nil, true
+	}
+
+	now := r.Clock.Now()
+	reservation := r.MigrationLimiter.ReserveN(now, 1)
+	if !reservation.OK() || reservation.DelayFrom(now) > 0 {
+		reservation.CancelAt(now)
+		return nil, false
+	}
+	return reservation, true
+}
+
+func (r *Reconciler) triggerMigration(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, recommendedSeed string, reservation *rate.Reservation) error {
+	sourceSeed := *shoot.Spec.SeedName
+
+	log.Info("Triggering control plane migration to recommended seed", "sourceSeed", sourceSeed, "recommendedSeed", recommendedSeed)
+	shoot.Spec.SeedName = &recommendedSeed
+	if err := r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
+		// The migration was not triggered, hence, it must not count against the rate limit.
+		if reservation != nil {
+			reservation.CancelAt(r.Clock.Now())
+		}
+		return fmt.Errorf("failed binding shoot to recommended seed %q: %w", recommendedSeed, err)
+	}
+	r.Recorder.Eventf(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventSeedRebalancingTriggered, "Triggered control plane migration from seed %q to seed %q", sourceSeed, recommendedSeed)
+
+	return r.updateRecommendation(ctx, log, shoot, nil)
+}
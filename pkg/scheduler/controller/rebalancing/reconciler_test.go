@@ -0,0 +1,322 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancing_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/scheduler/controller/rebalancing"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+
+		cloudProfile *gardencorev1beta1.CloudProfile
+		project      *gardencorev1beta1.Project
+		seed1        *gardencorev1beta1.Seed
+		seed2        *gardencorev1beta1.Seed
+		shoot        *gardencorev1beta1.Shoot
+
+		seedListErr error
+		bindingErr  error
+	)
+
+	newSeed := func(name string) *gardencorev1beta1.Seed {
+		return &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: gardencorev1beta1.SeedSpec{
+				Provider: gardencorev1beta1.SeedProvider{Type: "foo", Region: "europe"},
+				Networks: gardencorev1beta1.SeedNetworks{
+					Nodes:    ptr.To("10.10.0.0/16"),
+					Pods:     "10.20.0.0/16",
+					Services: "10.30.0.0/16",
+				},
+				Settings: &gardencorev1beta1.SeedSettings{Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true}},
+			},
+			Status: gardencorev1beta1.SeedStatus{
+				Conditions:    []gardencorev1beta1.Condition{{Type: gardencorev1beta1.GardenletReady, Status: gardencorev1beta1.ConditionTrue}},
+				LastOperation: &gardencorev1beta1.LastOperation{},
+			},
+		}
+	}
+
+	newShoot := func(name, seedName string) *gardencorev1beta1.Shoot {
+		return &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-project"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: ptr.To("cloudprofile"),
+				Region:           "europe",
+				SeedName:         &seedName,
+				Provider: gardencorev1beta1.Provider{
+					Type:    "foo",
+					Workers: []gardencorev1beta1.Worker{{Name: "worker"}},
+				},
+				Networking: &gardencorev1beta1.Networking{
+					Nodes:    ptr.To("10.40.0.0/16"),
+					Pods:     ptr.To("10.50.0.0/16"),
+					Services: ptr.To("10.60.0.0/16"),
+				},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				SeedName:      &seedName,
+				LastOperation: &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		seedListErr = nil
+		bindingErr = nil
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithIndex(&gardencorev1beta1.Project{}, gardencore.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			WithInterceptorFuncs(interceptor.Funcs{
+				// The fake client does not know the binding subresource, hence, we simulate it with a regular update.
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					Expect(subResourceName).To(Equal("binding"))
+					if bindingErr != nil {
+						return bindingErr
+					}
+					return c.Update(ctx, obj)
+				},
+				List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+					if _, ok := list.(*gardencorev1beta1.SeedList); ok && seedListErr != nil {
+						return seedListErr
+					}
+					return c.List(ctx, list, opts...)
+				},
+			}).
+			Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+		recorder = record.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: &schedulerconfigv1alpha1.ShootRebalancingConfiguration{
+				SyncPeriod:     &metav1.Duration{Duration: time.Hour},
+				MinImprovement: ptr.To[int64](2),
+				Mode:           ptr.To(schedulerconfigv1alpha1.ShootRebalancingModeRecommend),
+			},
+			SchedulerConfig: &schedulerconfigv1alpha1.ShootSchedulerConfiguration{Strategy: schedulerconfigv1alpha1.SameRegion},
+			GardenNamespace: v1beta1constants.GardenNamespace,
+			Clock:           fakeClock,
+			Recorder:        recorder,
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "cloudprofile"}}
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "project"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-project")},
+		}
+		seed1 = newSeed("seed-1")
+		seed2 = newSeed("seed-2")
+		shoot = newShoot("shoot", seed1.Name)
+
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		Expect(fakeClient.Create(ctx, seed1)).To(Succeed())
+		Expect(fakeClient.Create(ctx, seed2)).To(Succeed())
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+	})
+
+	reconcileShoot := func() {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+	}
+
+	createShoots := func(seedName string, count int) {
+		for i := range count {
+			Expect(fakeClient.Create(ctx, newShoot(seedName+"-shoot-"+string(rune('a'+i)), seedName))).To(Succeed())
+		}
+	}
+
+	It("should not recommend a seed if the current seed is the best one", func() {
+		createShoots(seed2.Name, 1)
+
+		reconcileShoot()
+
+		Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationSchedulingRecommendedSeed))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should not recommend a seed if the improvement is below the threshold", func() {
+		createShoots(seed1.Name, 1)
+
+		reconcileShoot()
+
+		Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationSchedulingRecommendedSeed))
+	})
+
+	It("should recommend a better seed", func() {
+		createShoots(seed1.Name, 2)
+
+		reconcileShoot()
+
+		Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.AnnotationSchedulingRecommendedSeed, seed2.Name))
+		Expect(shoot.Spec.SeedName).To(Equal(&seed1.Name))
+		Expect(recorder.Events).To(Receive(ContainSubstring(gardencorev1beta1.ShootEventSeedRebalancingRecommended)))
+	})
+
+	It("should remove an outdated recommendation", func() {
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationSchedulingRecommendedSeed, seed2.Name)
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		reconcileShoot()
+
+		Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationSchedulingRecommendedSeed))
+	})
+
+	It("should keep the recommendation and return the error if the seeds cannot be ranked", func() {
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationSchedulingRecommendedSeed, seed2.Name)
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+		seedListErr = errors.New("fake")
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+		Expect(err).To(MatchError(ContainSubstring("fake")))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.AnnotationSchedulingRecommendedSeed, seed2.Name))
+	})
+
+	It("should remove the recommendation if there are no seed candidates", func() {
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationSchedulingRecommendedSeed, seed2.Name)
+		shoot.Spec.Provider.Type = "bar"
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		reconcileShoot()
+
+		Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationSchedulingRecommendedSeed))
+	})
+
+	It("should not recommend a seed if the current seed is not a candidate", func() {
+		createShoots(seed1.Name, 2)
+		seed1.Spec.Settings.Scheduling.Visible = false
+		Expect(fakeClient.Update(ctx, seed1)).To(Succeed())
+
+		reconcileShoot()
+
+		Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationSchedulingRecommendedSeed))
+	})
+
+	It("should skip shoots which are currently being migrated", func() {
+		createShoots(seed1.Name, 2)
+		shoot.Status.SeedName = ptr.To("other-seed")
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		reconcileShoot()
+
+		Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationSchedulingRecommendedSeed))
+	})
+
+	Context("mode Migrate", func() {
+		BeforeEach(func() {
+			reconciler.Config.Mode = ptr.To(schedulerconfigv1alpha1.ShootRebalancingModeMigrate)
+			createShoots(seed1.Name, 2)
+		})
+
+		It("should trigger the migration to the recommended seed in the maintenance time window", func() {
+			shoot.Spec.Maintenance = &gardencorev1beta1.Maintenance{TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "110000+0000", End: "130000+0000"}}
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			reconcileShoot()
+
+			Expect(shoot.Spec.SeedName).To(Equal(&seed2.Name))
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationSchedulingRecommendedSeed))
+			Expect(recorder.Events).To(Receive(ContainSubstring(gardencorev1beta1.ShootEventSeedRebalancingTriggered)))
+		})
+
+		It("should only recommend the seed outside of the maintenance time window", func() {
+			shoot.Spec.Maintenance = &gardencorev1beta1.Maintenance{TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"}}
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			reconcileShoot()
+
+			Expect(shoot.Spec.SeedName).To(Equal(&seed1.Name))
+			Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.AnnotationSchedulingRecommendedSeed, seed2.Name))
+		})
+
+		It("should only recommend the seed if the migration rate limit is reached", func() {
+			shoot.Spec.Maintenance = &gardencorev1beta1.Maintenance{TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "110000+0000", End: "140000+0000"}}
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			reconciler.MigrationLimiter = rate.NewLimiter(rate.Every(time.Hour), 1)
+			Expect(reconciler.MigrationLimiter.AllowN(fakeClock.Now(), 1)).To(BeTrue())
+
+			reconcileShoot()
+
+			Expect(shoot.Spec.SeedName).To(Equal(&seed1.Name))
+			Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.AnnotationSchedulingRecommendedSeed, seed2.Name))
+
+			By("Trigger migration after the rate limit allows it again")
+			fakeClock.Step(30 * time.Minute)
+			Expect(reconciler.MigrationLimiter.AllowN(fakeClock.Now(), 1)).To(BeFalse())
+			fakeClock.Step(30 * time.Minute)
+
+			reconcileShoot()
+
+			Expect(shoot.Spec.SeedName).To(Equal(&seed2.Name))
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationSchedulingRecommendedSeed))
+		})
+
+		It("should not count failed migrations against the rate limit", func() {
+			shoot.Spec.Maintenance = &gardencorev1beta1.Maintenance{TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "110000+0000", End: "130000+0000"}}
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			reconciler.MigrationLimiter = rate.NewLimiter(rate.Every(time.Hour), 1)
+			bindingErr = errors.New("fake")
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+			Expect(err).To(MatchError(ContainSubstring("fake")))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.SeedName).To(Equal(&seed1.Name))
+
+			By("Trigger migration on retry without waiting for the rate limit")
+			bindingErr = nil
+
+			reconcileShoot()
+
+			Expect(shoot.Spec.SeedName).To(Equal(&seed2.Name))
+			Expect(reconciler.MigrationLimiter.AllowN(fakeClock.Now(), 1)).To(BeFalse())
+		})
+
+		It("should only recommend the seed if the last operation did not succeed", func() {
+			shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateFailed
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			reconcileShoot()
+
+			Expect(shoot.Spec.SeedName).To(Equal(&seed1.Name))
+			Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.AnnotationSchedulingRecommendedSeed, seed2.Name))
+		})
+	})
+})
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+)
+
+// Explanation describes the scheduling decision for a shoot.
+type Explanation struct {
+	// Strategy is the configured candidate determination strategy.
+	Strategy schedulerconfigv1alpha1.CandidateDeterminationStrategy `json:"strategy"`
+	// SeedName is the name of the seed the shoot would be scheduled onto. It is empty if no seed is eligible.
+	SeedName string `json:"seedName,omitempty"`
+	// Seeds contains the explanations for all seeds. Eligible seeds are listed first, ordered by their rank, followed by
+	// the rejected seeds, ordered by their names.
+	Seeds []SeedExplanation `json:"seeds"`
+}
+
+// SeedExplanation describes the scheduling decision for a single seed.
+type SeedExplanation struct {
+	// Name is the name of the seed.
+	Name string `json:"name"`
+	// Filter is the name of the filter plugin which rejected the seed. It is empty if the seed is eligible.
+	Filter string `json:"filter,omitempty"`
+	// Reason describes why the filter plugin rejected the seed.
+	Reason string `json:"reason,omitempty"`
+	// Rank is the position of the seed in the ranking of eligible seeds, starting with 1. It is 0 for rejected seeds.
+	Rank int `json:"rank,omitempty"`
+	// Shoots is the number of shoots scheduled onto the seed.
+	Shoots int `json:"shoots"`
+	// PluginScores maps the names of the score plugins to the weighted, normalized scores of the seed.
+	PluginScores map[string]int64 `json:"pluginScores,omitempty"`
+	// Score is the total score of the seed.
+	Score int64 `json:"score"`
+}
+
+// Explain evaluates the filter and score plugins for the given shoot and reports for each seed which filter plugin
+// rejected it or which rank and score it got. In contrast to DetermineSeed, it continues with the remaining filter
+// plugins for explaining all seeds if no seed is eligible. It does neither mutate the shoot nor any other object.
+func (r *Reconciler) Explain(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*Explanation, error) {
+	sc, seeds, err := r.newSchedulingContext(ctx, log, shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	scorePlugins, err := NewScorePlugins(r.Config.Scoring)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		explanation = &Explanation{Strategy: r.Config.Strategy}
+		rejected    []SeedExplanation
+	)
+
+	for _, plugin := range NewFilterPlugins(r.Config.Filters) {
+		if len(seeds) == 0 {
+			break
+		}
+
+		filteredSeeds, filterErr := plugin.Filter(sc, seeds)
+		eligible := sets.New[string]()
+		for _, seed := range filteredSeeds {
+			eligible.Insert(seed.Name)
+		}
+
+		for _, seed := range seeds {
+			if eligible.Has(seed.Name) {
+				continue
+			}
+			rejected = append(rejected, SeedExplanation{
+				Name:   seed.Name,
+				Filter: plugin.Name(),
+				Reason: rejectionReason(sc, plugin, seed, filterErr),
+				Shoots: sc.SeedUsage[seed.Name],
+			})
+		}
+
+		seeds = filteredSeeds
+	}
+
+	scores, err := scoreSeeds(sc, seeds, scorePlugins)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, score := range scores {
+		explanation.Seeds = append(explanation.Seeds, SeedExplanation{
+			Name:         score.Seed.Name,
+			Rank:         i + 1,
+			Shoots:       score.Shoots,
+			PluginScores: score.PluginScores,
+			Score:        score.Total,
+		})
+	}
+	if len(scores) > 0 {
+		explanation.SeedName = scores[0].Seed.Name
+	}
+
+	slices.SortFunc(rejected, func(a, b SeedExplanation) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	explanation.Seeds = append(explanation.Seeds, rejected...)
+
+	return explanation, nil
+}
+
+// rejectionReason determines why the given filter plugin rejected the seed. Filter plugins only report an error if none
+// of the seeds is eligible, hence, the plugin is evaluated for the seed alone. If the seed passes this evaluation, the
+// plugin compares the seeds with each other (e.g., the MinimalDistance strategy) and preferred other seeds.
+func rejectionReason(sc *SchedulingContext, plugin FilterPlugin, seed gardencorev1beta1.Seed, filterErr error) string {
+	if _, err := plugin.Filter(sc, []gardencorev1beta1.Seed{seed}); err != nil {
+		return err.Error()
+	}
+	if filterErr != nil {
+		return filterErr.Error()
+	}
+	return "other seeds are preferred by this filter"
+}
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"slices"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+)
+
+// SchedulingContext contains all information about the shoot to be scheduled which is needed by the filter and score
+// plugins.
+type SchedulingContext struct {
+	// Log is the logger of the current scheduling attempt.
+	Log logr.Logger
+	// Shoot is the shoot to be scheduled.
+	Shoot *gardencorev1beta1.Shoot
+	// ShootList is the list of all shoots in the system.
+	ShootList []*gardencorev1beta1.Shoot
+	// SeedUsage maps seed names to the number of shoots scheduled onto them.
+	SeedUsage map[string]int
+	// CloudProfile is the cloud profile referenced by the shoot.
+	CloudProfile *gardencorev1beta1.CloudProfile
+	// ProjectName is the name of the project the shoot belongs to.
+	ProjectName string
+	// RegionConfig is the region config map for the cloud profile of the shoot (might be nil).
+	RegionConfig *corev1.ConfigMap
+	// Strategy is the configured candidate determination strategy.
+	Strategy schedulerconfigv1alpha1.CandidateDeterminationStrategy
+}
+
+// FilterPlugin filters seeds which are not eligible for hosting the shoot.
+type FilterPlugin interface {
+	// Name returns the name of the plugin.
+	Name() string
+	// Filter returns the subset of the given seeds which are eligible for hosting the shoot. It returns an error if none
+	// of the seeds is eligible.
+	Filter(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error)
+}
+
+type filterPlugin struct {
+	name   schedulerconfigv1alpha1.FilterPluginName
+	filter func(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error)
+}
+
+func (f *filterPlugin) Name() string {
+	return string(f.name)
+}
+
+func (f *filterPlugin) Filter(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+	return f.filter(sc, seeds)
+}
+
+// DefaultFilterPlugins returns the filter plugins of the scheduler in the order they are executed.
+func DefaultFilterPlugins() []FilterPlugin {
+	return []FilterPlugin{
+		&filterPlugin{name: schedulerconfigv1alpha1.FilterPluginUsable, filter: func(_ *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterUsableSeeds(seeds)
+		}},
+		&filterPlugin{name: schedulerconfigv1alpha1.FilterPluginCloudProfileSeedSelector, filter: func(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsMatchingLabelSelector(seeds, sc.CloudProfile.Spec.SeedSelector, "CloudProfile")
+		}},
+		&filterPlugin{name: schedulerconfigv1alpha1.FilterPluginShootSeedSelector, filter: func(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsMatchingLabelSelector(seeds, sc.Shoot.Spec.SeedSelector, "Shoot")
+		}},
+		&filterPlugin{name: schedulerconfigv1alpha1.FilterPluginProvider, filter: func(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsMatchingProviders(sc.CloudProfile, sc.Shoot, seeds)
+		}},
+		&filterPlugin{name: schedulerconfigv1alpha1.FilterPluginZonalShootControlPlane, filter: func(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsForZonalShootControlPlanes(seeds, sc.Shoot)
+		}},
+		&filterPlugin{name: schedulerconfigv1alpha1.FilterPluginAccessRestrictions, filter: func(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsForAccessRestrictions(seeds, sc.Shoot)
+		}},
+		&filterPlugin{name: schedulerconfigv1alpha1.FilterPluginDomain, filter: func(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsMatchingDomain(seeds, sc.Shoot, sc.ProjectName)
+		}},
+		&filterPlugin{name: schedulerconfigv1alpha1.FilterPluginShootReconciliationsEnabled, filter: func(_ *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsWithDisabledShootReconciliations(seeds)
+		}},
+		&filterPlugin{name: schedulerconfigv1alpha1.FilterPluginCandidates, filter: func(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterCandidates(sc.Shoot, sc.ShootList, seeds)
+		}},
+		&filterPlugin{name: schedulerconfigv1alpha1.FilterPluginStrategy, filter: func(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return applyStrategy(sc.Log, sc.Shoot, seeds, sc.Strategy, sc.RegionConfig)
+		}},
+	}
+}
+
+// NewFilterPlugins returns the filter plugins of the scheduler in the order they are executed, excluding the plugins
+// disabled in the given configuration.
+func NewFilterPlugins(config *schedulerconfigv1alpha1.SeedFilterConfiguration) []FilterPlugin {
+	plugins := DefaultFilterPlugins()
+	if config == nil || len(config.Disabled) == 0 {
+		return plugins
+	}
+
+	return slices.DeleteFunc(plugins, func(plugin FilterPlugin) bool {
+		return slices.Contains(config.Disabled, schedulerconfigv1alpha1.FilterPluginName(plugin.Name()))
+	})
+}
+
+// runFilterPlugins executes the given filter plugins one after another and returns the remaining seeds.
+func runFilterPlugins(sc *SchedulingContext, seeds []gardencorev1beta1.Seed, plugins []FilterPlugin) ([]gardencorev1beta1.Seed, error) {
+	var err error
+	for _, plugin := range plugins {
+		if seeds, err = plugin.Filter(sc, seeds); err != nil {
+			return nil, err
+		}
+	}
+	return seeds, nil
+}
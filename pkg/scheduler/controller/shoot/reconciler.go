@@ -119,71 +119,97 @@ func (r *Reconciler) DetermineSeed(
 	*gardencorev1beta1.Seed,
 	error,
 ) {
-	seedList := &gardencorev1beta1.SeedList{}
-	if err := r.Client.List(ctx, seedList); err != nil {
-		return nil, err
-	}
-	sl := &gardencorev1beta1.ShootList{}
-	if err := r.Client.List(ctx, sl); err != nil {
-		return nil, err
-	}
-
-	shootList := v1beta1helper.ConvertShootList(sl.Items)
-
-	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
-	if err != nil {
-		return nil, err
-	}
-	regionConfig, err := r.getRegionConfigMap(ctx, log, cloudProfile)
-	if err != nil {
-		return nil, err
-	}
-	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace)
+	scores, err := r.RankSeeds(ctx, log, shoot)
 	if err != nil {
 		return nil, err
 	}
 
-	filteredSeeds, err := filterUsableSeeds(seedList.Items)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, cloudProfile.Spec.SeedSelector, "CloudProfile")
-	if err != nil {
-		return nil, err
+	if r.Config.Scoring != nil {
+		log.V(1).Info("Scored seed candidates", "bestSeed", scores[0].Seed.Name, "score", scores[0].Total, "pluginScores", scores[0].PluginScores)
 	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, shoot.Spec.SeedSelector, "Shoot")
+	return scores[0].Seed, nil
+}
+
+// RankSeeds returns all seeds which are eligible for hosting the given shoot, ordered from best to worst. If scoring is
+// not configured, the seeds are ordered by the number of shoots scheduled onto them. The shoot itself is not taken into
+// account when calculating the seed usage, hence, it can also be used to evaluate the placement of scheduled shoots.
+func (r *Reconciler) RankSeeds(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) ([]SeedScore, error) {
+	sc, seeds, err := r.newSchedulingContext(ctx, log, shoot)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterSeedsMatchingProviders(cloudProfile, shoot, filteredSeeds)
+
+	filteredSeeds, err := runFilterPlugins(sc, seeds, NewFilterPlugins(r.Config.Filters))
 	if err != nil {
-		return nil, err
+		return nil, &NoSeedCandidatesError{err: err}
 	}
-	filteredSeeds, err = filterSeedsForZonalShootControlPlanes(filteredSeeds, shoot)
+
+	scorePlugins, err := NewScorePlugins(r.Config.Scoring)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterSeedsForAccessRestrictions(filteredSeeds, shoot)
-	if err != nil {
-		return nil, err
+	return scoreSeeds(sc, filteredSeeds, scorePlugins)
+}
+
+// NoSeedCandidatesError is returned by RankSeeds if no seed is eligible for hosting the shoot. In contrast to other
+// errors, it is not caused by a transient failure, e.g., when reading the seeds.
+type NoSeedCandidatesError struct {
+	err error
+}
+
+func (e *NoSeedCandidatesError) Error() string {
+	return e.err.Error()
+}
+
+func (e *NoSeedCandidatesError) Unwrap() error {
+	return e.err
+}
+
+// IsNoSeedCandidatesError returns true if the given error is a NoSeedCandidatesError.
+func IsNoSeedCandidatesError(err error) bool {
+	var noSeedCandidatesError *NoSeedCandidatesError
+	return errors.As(err, &noSeedCandidatesError)
+}
+
+// newSchedulingContext reads all information needed by the filter and score plugins for scheduling the given shoot. It
+// returns the scheduling context and the list of all seeds.
+func (r *Reconciler) newSchedulingContext(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*SchedulingContext, []gardencorev1beta1.Seed, error) {
+	seedList := &gardencorev1beta1.SeedList{}
+	if err := r.Client.List(ctx, seedList); err != nil {
+		return nil, nil, err
 	}
-	filteredSeeds, err = filterSeedsMatchingDomain(filteredSeeds, shoot, project.Name)
-	if err != nil {
-		return nil, err
+	sl := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, sl); err != nil {
+		return nil, nil, err
 	}
-	filteredSeeds, err = filterSeedsWithDisabledShootReconciliations(filteredSeeds)
+
+	shootList := v1beta1helper.ConvertShootList(slices.DeleteFunc(sl.Items, func(s gardencorev1beta1.Shoot) bool {
+		return s.Namespace == shoot.Namespace && s.Name == shoot.Name
+	}))
+
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	filteredSeeds, err = filterCandidates(shoot, shootList, filteredSeeds)
+	regionConfig, err := r.getRegionConfigMap(ctx, log, cloudProfile)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	filteredSeeds, err = applyStrategy(log, shoot, filteredSeeds, r.Config.Strategy, regionConfig)
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace)
 	if err != nil {
-		return nil, err
-	}
-	return getSeedWithLeastShootsDeployed(filteredSeeds, shootList)
+		return nil, nil, err
+	}
+
+	return &SchedulingContext{
+		Log:          log,
+		Shoot:        shoot,
+		ShootList:    shootList,
+		SeedUsage:    v1beta1helper.CalculateSeedUsage(shootList),
+		CloudProfile: cloudProfile,
+		ProjectName:  project.Name,
+		RegionConfig: regionConfig,
+		Strategy:     r.Config.Strategy,
+	}, seedList.Items, nil
 }
 
 func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cloudProfile *gardencorev1beta1.CloudProfile) (*corev1.ConfigMap, error) {
@@ -376,6 +402,9 @@ func applyStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []g
 		if err != nil {
 			return nil, err
 		}
+	case strategy == schedulerconfigv1alpha1.Scoring:
+		// All seeds are candidates, the score plugins decide which one is chosen.
+		candidates = seedList
 	default:
 		return nil, fmt.Errorf("failed to determine seed candidates. shoot purpose: '%s', strategy: '%s', valid strategies are: %v", *shoot.Spec.Purpose, strategy, schedulerconfigv1alpha1.Strategies)
 	}
@@ -426,24 +455,6 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1b
 	return candidates, nil
 }
 
-// getSeedWithLeastShootsDeployed finds the best candidate (i.e. the one managing the smallest number of shoots right now).
-func getSeedWithLeastShootsDeployed(seedList []gardencorev1beta1.Seed, shootList []*gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error) {
-	var (
-		bestCandidate gardencorev1beta1.Seed
-		min           *int
-		seedUsage     = v1beta1helper.CalculateSeedUsage(shootList)
-	)
-
-	for _, seed := range seedList {
-		if numberOfManagedShoots := seedUsage[seed.Name]; min == nil || numberOfManagedShoots < *min {
-			bestCandidate = seed
-			min = &numberOfManagedShoots
-		}
-	}
-
-	return &bestCandidate, nil
-}
-
 func matchProvider(seedProviderType, shootProviderType string, enabledProviderTypes []string) bool {
 	if len(enabledProviderTypes) == 0 {
 		return seedProviderType == shootProviderType
@@ -495,21 +506,15 @@ func determineCandidatesWithMinimalDistanceStrategy(log logr.Logger, shoot *gard
 func regionConfigMinimalDistance(log logr.Logger, seeds []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot, regionConfig *corev1.ConfigMap) ([]gardencorev1beta1.Seed, error) {
 	var candidates []gardencorev1beta1.Seed
 
-	if regionConfig == nil || regionConfig.Data[shoot.Spec.Region] == "" {
+	regionConfigData, err := parseRegionConfig(shoot, regionConfig)
+	if err != nil {
+		return nil, err
+	}
+	if regionConfigData == nil {
 		log.Info("Region ConfigMap not provided or Shoot region not available", "region", shoot.Spec.Region)
 		return candidates, nil
 	}
 
-	regionConfigData := make(map[string]int)
-	if err := yaml.Unmarshal([]byte(regionConfig.Data[shoot.Spec.Region]), &regionConfigData); err != nil {
-		return nil, fmt.Errorf("failed to determine seed candidates. Wrong format in region ConfigMap %s/%s, Region %q: %w", regionConfig.Namespace, regionConfig.Name, shoot.Spec.Region, err)
-	}
-
-	// If not configured otherwise, assume that a region has the smallest possible distance to itself.
-	if _, ok := regionConfigData[shoot.Spec.Region]; !ok {
-		regionConfigData[shoot.Spec.Region] = 0
-	}
-
 	minDistance := math.MaxInt32
 	for _, seed := range seeds {
 		dist, ok := regionConfigData[seed.Spec.Provider.Region]
@@ -532,6 +537,26 @@ func regionConfigMinimalDistance(log logr.Logger, seeds []gardencorev1beta1.Seed
 	return candidates, nil
 }
 
+// parseRegionConfig returns the distances of seed regions to the shoot's region configured in the given region config
+// map. It returns nil if the config map is not provided or does not contain the shoot's region.
+func parseRegionConfig(shoot *gardencorev1beta1.Shoot, regionConfig *corev1.ConfigMap) (map[string]int, error) {
+	if regionConfig == nil || regionConfig.Data[shoot.Spec.Region] == "" {
+		return nil, nil
+	}
+
+	regionConfigData := make(map[string]int)
+	if err := yaml.Unmarshal([]byte(regionConfig.Data[shoot.Spec.Region]), &regionConfigData); err != nil {
+		return nil, fmt.Errorf("failed to determine seed candidates. Wrong format in region ConfigMap %s/%s, Region %q: %w", regionConfig.Namespace, regionConfig.Name, shoot.Spec.Region, err)
+	}
+
+	// If not configured otherwise, assume that a region has the smallest possible distance to itself.
+	if _, ok := regionConfigData[shoot.Spec.Region]; !ok {
+		regionConfigData[shoot.Spec.Region] = 0
+	}
+
+	return regionConfigData, nil
+}
+
 func levenshteinMinimalDistance(seeds []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) []gardencorev1beta1.Seed {
 	var (
 		minDistance   = 1000
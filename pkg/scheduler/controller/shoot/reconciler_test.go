@@ -11,6 +11,7 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -22,6 +23,7 @@ import (
 	"github.com/gardener/gardener/pkg/api/indexer"
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
 )
@@ -634,6 +636,88 @@ var _ = Describe("Scheduler_Control", func() {
 		})
 	})
 
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - find an adequate one using 'Scoring' seed determination strategy", func() {
+		BeforeEach(func() {
+			cloudProfile = cloudProfileBase.DeepCopy()
+			project = projectBase.DeepCopy()
+			seed = seedBase.DeepCopy()
+			shoot = shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+			schedulerConfiguration.Schedulers.Shoot.Strategy = schedulerconfigv1alpha1.Scoring
+			schedulerConfiguration.Schedulers.Shoot.Scoring = &schedulerconfigv1alpha1.SeedScoringConfiguration{
+				Plugins: []schedulerconfigv1alpha1.ScorePlugin{
+					{Name: schedulerconfigv1alpha1.ScorePluginRegionDistance, Weight: 1},
+					{Name: schedulerconfigv1alpha1.ScorePluginSeedLabels, Weight: 2, SeedLabels: []schedulerconfigv1alpha1.SeedLabelPreference{{Key: "preferred", Score: 1}}},
+				},
+			}
+		})
+
+		It("should find the seed cluster with the highest score", func() {
+			seed.Spec.Provider.Region = "europe-west1"
+
+			secondSeed := seedBase.DeepCopy()
+			secondSeed.Name = "seed-2"
+			secondSeed.Spec.Provider.Region = "europe-north1"
+
+			thirdSeed := seedBase.DeepCopy()
+			thirdSeed.Name = "seed-3"
+			thirdSeed.Spec.Provider.Region = "asia-south1"
+
+			shoot.Spec.Region = "europe-west1"
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, secondSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, thirdSeed)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should prefer the seed cluster with the preferred label over the closest one", func() {
+			seed.Spec.Provider.Region = "europe-west1"
+
+			secondSeed := seedBase.DeepCopy()
+			secondSeed.Name = "seed-2"
+			secondSeed.Labels = map[string]string{"preferred": "true"}
+			secondSeed.Spec.Provider.Region = "europe-north1"
+
+			shoot.Spec.Region = "europe-west1"
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, secondSeed)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+
+		It("should pick candidate with least shoots deployed in case of equal scores", func() {
+			secondSeed := seedBase.DeepCopy()
+			secondSeed.Name = "seed-2"
+
+			secondShoot := shootBase.DeepCopy()
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &seed.Name
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, secondSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, secondShoot)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+	})
+
 	Context("SEED DETERMINATION - Shoot does not reference a Seed - find an adequate one using default seed determination strategy", func() {
 		BeforeEach(func() {
 			cloudProfile = cloudProfileBase.DeepCopy()
@@ -914,6 +998,122 @@ var _ = Describe("Scheduler_Control", func() {
 		})
 	})
 
+	Context("#Explain", func() {
+		var secondSeed, thirdSeed *gardencorev1beta1.Seed
+
+		BeforeEach(func() {
+			cloudProfile = cloudProfileBase.DeepCopy()
+			project = projectBase.DeepCopy()
+			seed = seedBase.DeepCopy()
+			shoot = shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			shoot.Spec.SeedName = nil
+
+			secondSeed = seedBase.DeepCopy()
+			secondSeed.Name = "seed-2"
+			thirdSeed = seedBase.DeepCopy()
+			thirdSeed.Name = "seed-3"
+		})
+
+		createObjects := func() {
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, secondSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, thirdSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+		}
+
+		It("should report the rejecting filter for each seed and rank the eligible seeds", func() {
+			schedulerConfiguration.Schedulers.Shoot.Strategy = schedulerconfigv1alpha1.Scoring
+			schedulerConfiguration.Schedulers.Shoot.Scoring = &schedulerconfigv1alpha1.SeedScoringConfiguration{
+				Plugins: []schedulerconfigv1alpha1.ScorePlugin{{Name: schedulerconfigv1alpha1.ScorePluginZones, Weight: 1}},
+			}
+
+			seed.Spec.Provider.Zones = []string{"a"}
+			secondSeed.Spec.Settings.Scheduling.Visible = false
+			thirdSeed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo"}}
+
+			createObjects()
+
+			explanation, err := reconciler.Explain(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(explanation.Strategy).To(Equal(schedulerconfigv1alpha1.Scoring))
+			Expect(explanation.SeedName).To(Equal(seed.Name))
+			Expect(explanation.Seeds).To(Equal([]SeedExplanation{
+				{Name: seed.Name, Rank: 1, PluginScores: map[string]int64{"Zones": 100}, Score: 100},
+				{Name: secondSeed.Name, Filter: "Usable", Reason: "none of the 1 seeds is valid for scheduling (not deleting, visible and ready)"},
+				{Name: thirdSeed.Name, Filter: "Candidates", Reason: "0/1 seed cluster candidate(s) are eligible for scheduling: {seed-3 => shoot does not tolerate the seed's taints}"},
+			}))
+		})
+
+		It("should explain all seeds if none of them is eligible", func() {
+			seed.Spec.Provider.Type = "bar"
+			secondSeed.Spec.Provider.Type = "bar"
+			thirdSeed.Spec.Provider.Region = "asia"
+
+			createObjects()
+
+			explanation, err := reconciler.Explain(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(explanation.SeedName).To(BeEmpty())
+			Expect(explanation.Seeds).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{"Name": Equal(seed.Name), "Filter": Equal("Provider"), "Rank": BeZero()}),
+				MatchFields(IgnoreExtras, Fields{"Name": Equal(secondSeed.Name), "Filter": Equal("Provider"), "Rank": BeZero()}),
+				MatchFields(IgnoreExtras, Fields{"Name": Equal(thirdSeed.Name), "Filter": Equal("Strategy"), "Reason": ContainSubstring("no matching seed candidate found"), "Rank": BeZero()}),
+			))
+		})
+
+		It("should explain seeds which are rejected in favor of better candidates", func() {
+			schedulerConfiguration.Schedulers.Shoot.Strategy = schedulerconfigv1alpha1.MinimalDistance
+
+			shoot.Spec.Region = "europe-west1"
+			seed.Spec.Provider.Region = "europe-west1"
+			secondSeed.Spec.Provider.Region = "europe-west2"
+			thirdSeed.Spec.Provider.Region = "asia-south1"
+
+			createObjects()
+
+			explanation, err := reconciler.Explain(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(explanation.SeedName).To(Equal(seed.Name))
+			Expect(explanation.Seeds).To(Equal([]SeedExplanation{
+				{Name: seed.Name, Rank: 1, PluginScores: map[string]int64{}},
+				{Name: secondSeed.Name, Filter: "Strategy", Reason: "other seeds are preferred by this filter"},
+				{Name: thirdSeed.Name, Filter: "Strategy", Reason: "other seeds are preferred by this filter"},
+			}))
+		})
+
+		It("should not run disabled filter plugins", func() {
+			schedulerConfiguration.Schedulers.Shoot.Filters = &schedulerconfigv1alpha1.SeedFilterConfiguration{
+				Disabled: []schedulerconfigv1alpha1.FilterPluginName{schedulerconfigv1alpha1.FilterPluginShootReconciliationsEnabled},
+			}
+
+			metav1.SetMetaDataAnnotation(&seed.ObjectMeta, v1beta1constants.AnnotationEmergencyStopShootReconciliations, "true")
+			metav1.SetMetaDataAnnotation(&secondSeed.ObjectMeta, v1beta1constants.AnnotationEmergencyStopShootReconciliations, "true")
+			metav1.SetMetaDataAnnotation(&thirdSeed.ObjectMeta, v1beta1constants.AnnotationEmergencyStopShootReconciliations, "true")
+
+			createObjects()
+
+			explanation, err := reconciler.Explain(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(explanation.SeedName).NotTo(BeEmpty())
+			Expect(explanation.Seeds).NotTo(ContainElement(MatchFields(IgnoreExtras, Fields{"Filter": Equal("ShootReconciliationsEnabled")})))
+		})
+
+		It("should not mutate the shoot", func() {
+			createObjects()
+			shootBefore := shoot.DeepCopy()
+
+			_, err := reconciler.Explain(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.SeedName).To(BeNil())
+			Expect(shoot.Status).To(Equal(shootBefore.Status))
+		})
+	})
+
 	Context("#DetermineBestSeedCandidate", func() {
 		BeforeEach(func() {
 			seed = seedBase.DeepCopy()
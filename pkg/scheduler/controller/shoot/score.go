@@ -0,0 +1,236 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+)
+
+// MaxScore is the maximum normalized score a score plugin can assign to a seed.
+const MaxScore int64 = 100
+
+// ScorePlugin ranks seed candidates.
+type ScorePlugin interface {
+	// Name returns the name of the plugin.
+	Name() string
+	// Score returns a raw score for each of the given seeds (in the same order). Higher scores are better, they are
+	// normalized to the range [0, MaxScore] before the plugin weight is applied.
+	Score(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]float64, error)
+}
+
+// WeightedScorePlugin is a score plugin with its configured weight.
+type WeightedScorePlugin struct {
+	ScorePlugin
+	Weight int64
+}
+
+// SeedScore is the result of scoring a seed candidate.
+type SeedScore struct {
+	// Seed is the seed candidate.
+	Seed *gardencorev1beta1.Seed
+	// Shoots is the number of shoots scheduled onto the seed.
+	Shoots int
+	// PluginScores maps the names of the score plugins to the weighted, normalized scores of the seed.
+	PluginScores map[string]int64
+	// Total is the sum of all plugin scores.
+	Total int64
+}
+
+// NewScorePlugins creates the score plugins for the given configuration.
+func NewScorePlugins(config *schedulerconfigv1alpha1.SeedScoringConfiguration) ([]WeightedScorePlugin, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	plugins := make([]WeightedScorePlugin, 0, len(config.Plugins))
+	for _, p := range config.Plugins {
+		var plugin ScorePlugin
+
+		switch p.Name {
+		case schedulerconfigv1alpha1.ScorePluginRegionDistance:
+			plugin = &regionDistanceScorePlugin{}
+		case schedulerconfigv1alpha1.ScorePluginCapacityHeadroom:
+			plugin = &capacityHeadroomScorePlugin{}
+		case schedulerconfigv1alpha1.ScorePluginZones:
+			plugin = &zonesScorePlugin{}
+		case schedulerconfigv1alpha1.ScorePluginSeedLabels:
+			plugin = &seedLabelsScorePlugin{preferences: p.SeedLabels}
+		default:
+			return nil, fmt.Errorf("unsupported score plugin %q, valid plugins are: %v", p.Name, schedulerconfigv1alpha1.ScorePlugins)
+		}
+
+		plugins = append(plugins, WeightedScorePlugin{ScorePlugin: plugin, Weight: int64(p.Weight)})
+	}
+
+	return plugins, nil
+}
+
+// scoreSeeds calculates the weighted scores of the given seeds and returns them ordered from best to worst. Seeds with
+// equal total scores are ordered by the number of shoots scheduled onto them (ascending).
+func scoreSeeds(sc *SchedulingContext, seeds []gardencorev1beta1.Seed, plugins []WeightedScorePlugin) ([]SeedScore, error) {
+	scores := make([]SeedScore, 0, len(seeds))
+	for i := range seeds {
+		scores = append(scores, SeedScore{Seed: &seeds[i], Shoots: sc.SeedUsage[seeds[i].Name], PluginScores: make(map[string]int64, len(plugins))})
+	}
+
+	for _, plugin := range plugins {
+		rawScores, err := plugin.Score(sc, seeds)
+		if err != nil {
+			return nil, fmt.Errorf("failed running score plugin %q: %w", plugin.Name(), err)
+		}
+		if len(rawScores) != len(seeds) {
+			return nil, fmt.Errorf("score plugin %q returned %d scores for %d seeds", plugin.Name(), len(rawScores), len(seeds))
+		}
+
+		for i, score := range normalizeScores(rawScores) {
+			scores[i].PluginScores[plugin.Name()] = score * plugin.Weight
+			scores[i].Total += score * plugin.Weight
+		}
+	}
+
+	slices.SortStableFunc(scores, func(a, b SeedScore) int {
+		if a.Total != b.Total {
+			return cmp.Compare(b.Total, a.Total)
+		}
+		return cmp.Compare(a.Shoots, b.Shoots)
+	})
+
+	return scores, nil
+}
+
+// normalizeScores maps the given raw scores linearly to the range [0, MaxScore]. If all raw scores are equal, all seeds
+// get the maximum score.
+func normalizeScores(rawScores []float64) []int64 {
+	if len(rawScores) == 0 {
+		return nil
+	}
+
+	var (
+		minScore = slices.Min(rawScores)
+		maxScore = slices.Max(rawScores)
+		result   = make([]int64, len(rawScores))
+	)
+
+	for i, score := range rawScores {
+		if maxScore == minScore {
+			result[i] = MaxScore
+			continue
+		}
+		result[i] = int64((score - minScore) / (maxScore - minScore) * float64(MaxScore))
+	}
+
+	return result
+}
+
+type regionDistanceScorePlugin struct{}
+
+func (p *regionDistanceScorePlugin) Name() string {
+	return string(schedulerconfigv1alpha1.ScorePluginRegionDistance)
+}
+
+func (p *regionDistanceScorePlugin) Score(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]float64, error) {
+	regionConfigData, err := parseRegionConfig(sc.Shoot, sc.RegionConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		distances   = make([]int, len(seeds))
+		unknown     = make([]bool, len(seeds))
+		maxDistance int
+	)
+
+	for i, seed := range seeds {
+		if regionConfigData == nil {
+			distances[i] = distance(seed.Spec.Provider.Region, sc.Shoot.Spec.Region)
+			if seed.Spec.Provider.Type != sc.Shoot.Spec.Provider.Type {
+				distances[i] += 2
+			}
+		} else if dist, ok := regionConfigData[seed.Spec.Provider.Region]; ok {
+			distances[i] = dist
+		} else {
+			unknown[i] = true
+			continue
+		}
+
+		maxDistance = max(maxDistance, distances[i])
+	}
+
+	rawScores := make([]float64, len(seeds))
+	for i := range seeds {
+		// Seeds in regions missing in the region config are considered to be farther away than all known regions.
+		if unknown[i] {
+			distances[i] = maxDistance + 1
+		}
+		rawScores[i] = -float64(distances[i])
+	}
+
+	return rawScores, nil
+}
+
+type capacityHeadroomScorePlugin struct{}
+
+func (p *capacityHeadroomScorePlugin) Name() string {
+	return string(schedulerconfigv1alpha1.ScorePluginCapacityHeadroom)
+}
+
+func (p *capacityHeadroomScorePlugin) Score(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]float64, error) {
+	rawScores := make([]float64, len(seeds))
+
+	for i, seed := range seeds {
+		allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]
+		if !ok || allocatableShoots.Value() <= 0 {
+			rawScores[i] = 1
+			continue
+		}
+
+		rawScores[i] = float64(allocatableShoots.Value()-int64(sc.SeedUsage[seed.Name])) / float64(allocatableShoots.Value())
+	}
+
+	return rawScores, nil
+}
+
+type zonesScorePlugin struct{}
+
+func (p *zonesScorePlugin) Name() string {
+	return string(schedulerconfigv1alpha1.ScorePluginZones)
+}
+
+func (p *zonesScorePlugin) Score(_ *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]float64, error) {
+	rawScores := make([]float64, len(seeds))
+	for i, seed := range seeds {
+		rawScores[i] = float64(len(seed.Spec.Provider.Zones))
+	}
+	return rawScores, nil
+}
+
+type seedLabelsScorePlugin struct {
+	preferences []schedulerconfigv1alpha1.SeedLabelPreference
+}
+
+func (p *seedLabelsScorePlugin) Name() string {
+	return string(schedulerconfigv1alpha1.ScorePluginSeedLabels)
+}
+
+func (p *seedLabelsScorePlugin) Score(_ *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]float64, error) {
+	rawScores := make([]float64, len(seeds))
+
+	for i, seed := range seeds {
+		for _, preference := range p.preferences {
+			value, ok := seed.Labels[preference.Key]
+			if !ok || (preference.Value != nil && *preference.Value != value) {
+				continue
+			}
+			rawScores[i] += float64(preference.Score)
+		}
+	}
+
+	return rawScores, nil
+}
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+)
+
+var _ = Describe("Score", func() {
+	var (
+		sc    *SchedulingContext
+		seeds []gardencorev1beta1.Seed
+	)
+
+	BeforeEach(func() {
+		sc = &SchedulingContext{
+			Log: logr.Discard(),
+			Shoot: &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Region:   "europe-west1",
+					Provider: gardencorev1beta1.Provider{Type: "foo"},
+				},
+			},
+			SeedUsage: map[string]int{"seed-1": 8, "seed-2": 2, "seed-3": 0},
+		}
+
+		seeds = []gardencorev1beta1.Seed{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "seed-1", Labels: map[string]string{"tier": "gold"}},
+				Spec: gardencorev1beta1.SeedSpec{Provider: gardencorev1beta1.SeedProvider{
+					Type:   "foo",
+					Region: "europe-west1",
+					Zones:  []string{"a", "b", "c"},
+				}},
+				Status: gardencorev1beta1.SeedStatus{Allocatable: corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("10")}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "seed-2", Labels: map[string]string{"tier": "silver"}},
+				Spec: gardencorev1beta1.SeedSpec{Provider: gardencorev1beta1.SeedProvider{
+					Type:   "foo",
+					Region: "europe-north1",
+					Zones:  []string{"a"},
+				}},
+				Status: gardencorev1beta1.SeedStatus{Allocatable: corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("10")}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "seed-3"},
+				Spec: gardencorev1beta1.SeedSpec{Provider: gardencorev1beta1.SeedProvider{
+					Type:   "bar",
+					Region: "asia-south1",
+				}},
+			},
+		}
+	})
+
+	Describe("#NewScorePlugins", func() {
+		It("should return nil if scoring is not configured", func() {
+			Expect(NewScorePlugins(nil)).To(BeNil())
+		})
+
+		It("should fail for an unsupported plugin", func() {
+			_, err := NewScorePlugins(&schedulerconfigv1alpha1.SeedScoringConfiguration{
+				Plugins: []schedulerconfigv1alpha1.ScorePlugin{{Name: "Foo", Weight: 1}},
+			})
+			Expect(err).To(MatchError(ContainSubstring(`unsupported score plugin "Foo"`)))
+		})
+	})
+
+	Describe("#normalizeScores", func() {
+		It("should map the raw scores to the range [0, MaxScore]", func() {
+			Expect(normalizeScores([]float64{-10, 0, -5})).To(Equal([]int64{0, 100, 50}))
+		})
+
+		It("should assign the maximum score if all raw scores are equal", func() {
+			Expect(normalizeScores([]float64{3, 3})).To(Equal([]int64{100, 100}))
+		})
+	})
+
+	Describe("#scoreSeeds", func() {
+		score := func(plugins ...schedulerconfigv1alpha1.ScorePlugin) []SeedScore {
+			scorePlugins, err := NewScorePlugins(&schedulerconfigv1alpha1.SeedScoringConfiguration{Plugins: plugins})
+			Expect(err).NotTo(HaveOccurred())
+
+			scores, err := scoreSeeds(sc, seeds, scorePlugins)
+			Expect(err).NotTo(HaveOccurred())
+			return scores
+		}
+
+		names := func(scores []SeedScore) []string {
+			var result []string
+			for _, s := range scores {
+				result = append(result, s.Seed.Name)
+			}
+			return result
+		}
+
+		It("should prefer seeds close to the shoot's region based on the Levenshtein distance", func() {
+			Expect(names(score(schedulerconfigv1alpha1.ScorePlugin{Name: schedulerconfigv1alpha1.ScorePluginRegionDistance, Weight: 1}))).To(Equal([]string{"seed-1", "seed-2", "seed-3"}))
+		})
+
+		It("should prefer seeds close to the shoot's region based on the region config", func() {
+			sc.RegionConfig = &corev1.ConfigMap{Data: map[string]string{"europe-west1": "europe-north1: 5\nasia-south1: 1\neurope-west1: 10"}}
+
+			scores := score(schedulerconfigv1alpha1.ScorePlugin{Name: schedulerconfigv1alpha1.ScorePluginRegionDistance, Weight: 1})
+			Expect(names(scores)).To(Equal([]string{"seed-3", "seed-2", "seed-1"}))
+			Expect(scores[0].Total).To(Equal(MaxScore))
+			Expect(scores[2].Total).To(BeZero())
+		})
+
+		It("should prefer seeds with more capacity headroom", func() {
+			Expect(names(score(schedulerconfigv1alpha1.ScorePlugin{Name: schedulerconfigv1alpha1.ScorePluginCapacityHeadroom, Weight: 1}))).To(Equal([]string{"seed-3", "seed-2", "seed-1"}))
+		})
+
+		It("should prefer seeds with more zones", func() {
+			Expect(names(score(schedulerconfigv1alpha1.ScorePlugin{Name: schedulerconfigv1alpha1.ScorePluginZones, Weight: 1}))).To(Equal([]string{"seed-1", "seed-2", "seed-3"}))
+		})
+
+		It("should prefer seeds according to the label preferences", func() {
+			scores := score(schedulerconfigv1alpha1.ScorePlugin{
+				Name:   schedulerconfigv1alpha1.ScorePluginSeedLabels,
+				Weight: 1,
+				SeedLabels: []schedulerconfigv1alpha1.SeedLabelPreference{
+					{Key: "tier", Value: ptr.To("silver"), Score: 10},
+					{Key: "tier", Score: -5},
+				},
+			})
+
+			Expect(names(scores)).To(Equal([]string{"seed-2", "seed-3", "seed-1"}))
+		})
+
+		It("should combine the weighted scores and break ties by the number of shoots", func() {
+			scores := score(
+				schedulerconfigv1alpha1.ScorePlugin{Name: schedulerconfigv1alpha1.ScorePluginZones, Weight: 1},
+				schedulerconfigv1alpha1.ScorePlugin{Name: schedulerconfigv1alpha1.ScorePluginCapacityHeadroom, Weight: 1},
+			)
+
+			Expect(names(scores)).To(Equal([]string{"seed-2", "seed-3", "seed-1"}))
+			Expect(scores[0].PluginScores).To(Equal(map[string]int64{"Zones": 33, "CapacityHeadroom": 75}))
+			Expect(scores[1].PluginScores).To(Equal(map[string]int64{"Zones": 0, "CapacityHeadroom": 100}))
+			Expect(scores[2].PluginScores).To(Equal(map[string]int64{"Zones": 100, "CapacityHeadroom": 0}))
+		})
+
+		It("should apply the plugin weights", func() {
+			scores := score(
+				schedulerconfigv1alpha1.ScorePlugin{Name: schedulerconfigv1alpha1.ScorePluginZones, Weight: 3},
+				schedulerconfigv1alpha1.ScorePlugin{Name: schedulerconfigv1alpha1.ScorePluginCapacityHeadroom, Weight: 1},
+			)
+
+			Expect(names(scores)).To(Equal([]string{"seed-1", "seed-2", "seed-3"}))
+			Expect(scores[0].Total).To(Equal(int64(300)))
+		})
+	})
+})
@@ -5,6 +5,9 @@
 package utils
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"crypto/sha256"
 	"crypto/x509"
@@ -12,6 +15,7 @@ import (
 	"encoding/hex"
 	"encoding/pem"
 	"errors"
+	"fmt"
 	"slices"
 	"strconv"
 
@@ -78,6 +82,65 @@ func DecodePrivateKey(bytes []byte) (*rsa.PrivateKey, error) {
 	return x509.ParsePKCS1PrivateKey(block.Bytes)
 }
 
+// EncodeECPrivateKey takes an ECDSA private key object, encodes it to the SEC 1 PEM format, and returns it as a byte
+// slice.
+func EncodeECPrivateKey(key *ecdsa.PrivateKey) ([]byte, error) {
+	bytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "EC PRIVATE KEY",
+		Bytes: bytes,
+	}), nil
+}
+
+// EncodePKCS8PrivateKey takes a private key object of any type supported by x509.MarshalPKCS8PrivateKey, encodes it to
+// the PKCS8 PEM format, and returns it as a byte slice.
+func EncodePKCS8PrivateKey(key crypto.Signer) ([]byte, error) {
+	bytes, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "PRIVATE KEY",
+		Bytes: bytes,
+	}), nil
+}
+
+// DecodePrivateKeySigner takes a byte slice, decodes it from the PEM format, and returns the contained RSA, ECDSA, or
+// Ed25519 private key. Keys in the PKCS1 ("RSA PRIVATE KEY"), SEC 1 ("EC PRIVATE KEY"), and PKCS8 ("PRIVATE KEY")
+// formats are supported. In case an error occurs, it returns the error.
+func DecodePrivateKeySigner(bytes []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(bytes)
+	if block == nil {
+		return nil, errors.New("could not decode the PEM-encoded private key")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+			return key, nil
+		}
+		// EncodePrivateKeyInPKCS8 uses the "RSA PRIVATE KEY" type for PKCS8-encoded RSA keys as well.
+		return DecodeRSAPrivateKeyFromPKCS8(bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("the decoded private key of type %T is not a signer", key)
+		}
+		return signer, nil
+	}
+
+	return nil, fmt.Errorf("unsupported PEM block type %q for private key", block.Type)
+}
+
 // EncodeCertificate takes a certificate as a byte slice, encodes it to the PEM format, and returns
 // it as byte slice.
 func EncodeCertificate(certificate []byte) []byte {
@@ -97,6 +160,27 @@ func DecodeCertificate(bytes []byte) (*x509.Certificate, error) {
 	return x509.ParseCertificate(block.Bytes)
 }
 
+// DecodePublicKey takes a byte slice, decodes it from the PEM format, and returns the contained RSA, ECDSA, or Ed25519
+// public key in PKIX format ("PUBLIC KEY"). In case an error occurs, it returns the error.
+func DecodePublicKey(bytes []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(bytes)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return nil, errors.New("PEM block type must be PUBLIC KEY")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	switch key.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("unsupported public key type %T", key)
+}
+
 // DecodeCertificateRequest parses the given PEM-encoded CSR.
 func DecodeCertificateRequest(data []byte) (*x509.CertificateRequest, error) {
 	block, _ := pem.Decode(data)
@@ -5,7 +5,13 @@
 package utils_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"strings"
 
@@ -27,6 +33,79 @@ var _ = Describe("Encoding", func() {
 		})
 	})
 
+	Describe("#DecodePrivateKeySigner", func() {
+		It("should decode PKCS1 and PKCS8 encoded RSA keys", func() {
+			key, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(DecodePrivateKeySigner(EncodePrivateKey(key))).To(Equal(key))
+
+			pkcs8, err := EncodePrivateKeyInPKCS8(key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(DecodePrivateKeySigner(pkcs8)).To(Equal(key))
+		})
+
+		It("should decode SEC 1 and PKCS8 encoded ECDSA keys", func() {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			sec1, err := EncodeECPrivateKey(key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(DecodePrivateKeySigner(sec1)).To(Equal(key))
+
+			pkcs8, err := EncodePKCS8PrivateKey(key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(DecodePrivateKeySigner(pkcs8)).To(Equal(key))
+		})
+
+		It("should decode PKCS8 encoded Ed25519 keys", func() {
+			_, key, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			pkcs8, err := EncodePKCS8PrivateKey(key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(DecodePrivateKeySigner(pkcs8)).To(Equal(key))
+		})
+
+		It("should fail for unsupported PEM blocks", func() {
+			_, err := DecodePrivateKeySigner(EncodeCertificate([]byte("foo")))
+			Expect(err).To(MatchError(`unsupported PEM block type "CERTIFICATE" for private key`))
+
+			_, err = DecodePrivateKeySigner([]byte("foo"))
+			Expect(err).To(MatchError("could not decode the PEM-encoded private key"))
+		})
+	})
+
+	Describe("#DecodePublicKey", func() {
+		encode := func(key any) []byte {
+			der, err := x509.MarshalPKIXPublicKey(key)
+			Expect(err).NotTo(HaveOccurred())
+			return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+		}
+
+		It("should decode RSA, ECDSA, and Ed25519 public keys", func() {
+			rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(DecodePublicKey(encode(rsaKey.Public()))).To(Equal(rsaKey.Public()))
+
+			ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(DecodePublicKey(encode(ecdsaKey.Public()))).To(Equal(ecdsaKey.Public()))
+
+			ed25519PublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(DecodePublicKey(encode(ed25519PublicKey))).To(Equal(ed25519PublicKey))
+		})
+
+		It("should fail for unsupported PEM blocks", func() {
+			_, err := DecodePublicKey(EncodeCertificate([]byte("foo")))
+			Expect(err).To(MatchError("PEM block type must be PUBLIC KEY"))
+
+			_, err = DecodePublicKey([]byte("foo"))
+			Expect(err).To(MatchError("PEM block type must be PUBLIC KEY"))
+		})
+	})
+
 	DescribeTable("#ComputeGardenNamespace",
 		func(data []byte, csrMatcher func(*x509.CertificateRequest), errMatcher gomegatypes.GomegaMatcher) {
 			csr, err := DecodeCertificateRequest(data)
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Checkpoints is a mapping from the IDs of checkpointable tasks which succeeded in an execution to their inputs hash.
+type Checkpoints map[TaskID]string
+
+// CheckpointStore loads and persists the checkpoints of flows.
+type CheckpointStore interface {
+	// Load returns the checkpoints stored for the flow with the given name. It returns empty checkpoints if none are
+	// stored.
+	Load(ctx context.Context, flowName string) (Checkpoints, error)
+	// Store persists the given checkpoints for the flow with the given name, replacing the previously stored ones. If
+	// the checkpoints are empty, the stored checkpoints are removed.
+	Store(ctx context.Context, flowName string, checkpoints Checkpoints) error
+}
+
+// isCheckpointed returns whether the task with the given ID is checkpointable and succeeded with the same inputs hash in
+// a previous execution.
+func (e *execution) isCheckpointed(id TaskID) bool {
+	inputsHash := e.flow.nodes[id].inputsHash
+	return inputsHash != "" && e.checkpoints[id] == inputsHash
+}
+
+func (e *execution) loadCheckpoints(ctx context.Context) {
+	if e.checkpointStore == nil {
+		return
+	}
+
+	checkpoints, err := e.checkpointStore.Load(ctx, e.flow.name)
+	if err != nil {
+		// Not being able to load the checkpoints only means that all tasks are executed, hence we don't fail the flow.
+		e.log.Error(err, "Failed loading checkpoints, executing all tasks")
+		return
+	}
+	e.checkpoints = checkpoints
+}
+
+// storeCheckpoints persists the checkpoints of the succeeded checkpointable tasks if the execution failed, so that they
+// are not executed again in the next execution. If the execution succeeded, the stored checkpoints are removed, i.e.,
+// the next execution runs all tasks again.
+func (e *execution) storeCheckpoints(ctx context.Context, flowErr error) {
+	if e.checkpointStore == nil {
+		return
+	}
+
+	checkpoints := e.newCheckpoints
+	if flowErr == nil {
+		checkpoints = nil
+	}
+
+	if len(checkpoints) == 0 && len(e.checkpoints) == 0 {
+		return
+	}
+
+	// The checkpoints must also be stored if the flow was canceled.
+	if err := e.checkpointStore.Store(context.WithoutCancel(ctx), e.flow.name, checkpoints); err != nil {
+		e.log.Error(err, "Failed storing checkpoints")
+	}
+}
+
+// configMapCheckpointStore is a CheckpointStore which persists the checkpoints of all flows in a ConfigMap.
+type configMapCheckpointStore struct {
+	client    client.Client
+	namespace string
+	name      string
+}
+
+// NewConfigMapCheckpointStore returns a CheckpointStore which persists the checkpoints in the ConfigMap with the given
+// name and namespace. The checkpoints of each flow are stored as JSON under a key derived from the flow name. The
+// ConfigMap is created when checkpoints are stored for the first time and deleted when no checkpoints are left.
+func NewConfigMapCheckpointStore(c client.Client, namespace, name string) CheckpointStore {
+	return &configMapCheckpointStore{client: c, namespace: namespace, name: name}
+}
+
+var invalidConfigMapKeyCharacters = regexp.MustCompile(`[^-._a-zA-Z0-9]+`)
+
+func configMapKey(flowName string) string {
+	return invalidConfigMapKeyCharacters.ReplaceAllString(strings.ToLower(flowName), "-")
+}
+
+func (s *configMapCheckpointStore) Load(ctx context.Context, flowName string) (Checkpoints, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := s.client.Get(ctx, client.ObjectKey{Namespace: s.namespace, Name: s.name}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return Checkpoints{}, nil
+		}
+		return nil, fmt.Errorf("failed reading checkpoint ConfigMap: %w", err)
+	}
+
+	checkpoints := Checkpoints{}
+	data, ok := configMap.Data[configMapKey(flowName)]
+	if !ok {
+		return checkpoints, nil
+	}
+
+	if err := json.Unmarshal([]byte(data), &checkpoints); err != nil {
+		return nil, fmt.Errorf("failed decoding checkpoints of flow %q: %w", flowName, err)
+	}
+	return checkpoints, nil
+}
+
+func (s *configMapCheckpointStore) Store(ctx context.Context, flowName string, checkpoints Checkpoints) error {
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: s.name, Namespace: s.namespace}}
+	if err := s.client.Get(ctx, client.ObjectKeyFromObject(configMap), configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed reading checkpoint ConfigMap: %w", err)
+		}
+		if len(checkpoints) == 0 {
+			return nil
+		}
+
+		if err := s.setCheckpoints(configMap, flowName, checkpoints); err != nil {
+			return err
+		}
+		return s.client.Create(ctx, configMap)
+	}
+
+	if err := s.setCheckpoints(configMap, flowName, checkpoints); err != nil {
+		return err
+	}
+
+	if len(configMap.Data) == 0 {
+		return client.IgnoreNotFound(s.client.Delete(ctx, configMap))
+	}
+	return s.client.Update(ctx, configMap)
+}
+
+func (s *configMapCheckpointStore) setCheckpoints(configMap *corev1.ConfigMap, flowName string, checkpoints Checkpoints) error {
+	key := configMapKey(flowName)
+
+	if len(checkpoints) == 0 {
+		delete(configMap.Data, key)
+		return nil
+	}
+
+	data, err := json.Marshal(checkpoints)
+	if err != nil {
+		return fmt.Errorf("failed encoding checkpoints of flow %q: %w", flowName, err)
+	}
+
+	if configMap.Data == nil {
+		configMap.Data = make(map[string]string, 1)
+	}
+	configMap.Data[key] = string(data)
+	return nil
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Checkpoints", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		store      flow.CheckpointStore
+		configMap  *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+		store = flow.NewConfigMapCheckpointStore(fakeClient, "shoot--foo--bar", "flow-checkpoints")
+		configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "flow-checkpoints", Namespace: "shoot--foo--bar"}}
+	})
+
+	Describe("#Run", func() {
+		var (
+			xRuns, yRuns atomic.Int32
+			zErr         error
+
+			newFlow = func(inputsHash string) *flow.Flow {
+				g := flow.NewGraph("Shoot cluster reconciliation")
+				x := g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { xRuns.Add(1); return nil }, InputsHash: inputsHash})
+				y := g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { yRuns.Add(1); return nil }, Dependencies: flow.NewTaskIDs(x)})
+				g.Add(flow.Task{Name: "z", Fn: func(_ context.Context) error { return zErr }, Dependencies: flow.NewTaskIDs(y), InputsHash: inputsHash})
+				return g.Compile()
+			}
+		)
+
+		BeforeEach(func() {
+			xRuns.Store(0)
+			yRuns.Store(0)
+			zErr = errors.New("fail")
+		})
+
+		It("should skip checkpointed tasks on retry and remove the checkpoints after success", func() {
+			Expect(newFlow("hash1").Run(ctx, flow.Opts{CheckpointStore: store})).NotTo(Succeed())
+			Expect(xRuns.Load()).To(BeEquivalentTo(1))
+			Expect(yRuns.Load()).To(BeEquivalentTo(1))
+
+			Expect(store.Load(ctx, "Shoot cluster reconciliation")).To(Equal(flow.Checkpoints{"x": "hash1"}))
+
+			zErr = nil
+			Expect(newFlow("hash1").Run(ctx, flow.Opts{CheckpointStore: store})).To(Succeed())
+			Expect(xRuns.Load()).To(BeEquivalentTo(1))
+			Expect(yRuns.Load()).To(BeEquivalentTo(2))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(BeNotFoundError())
+		})
+
+		It("should execute checkpointed tasks again if their inputs changed", func() {
+			Expect(newFlow("hash1").Run(ctx, flow.Opts{CheckpointStore: store})).NotTo(Succeed())
+			Expect(newFlow("hash2").Run(ctx, flow.Opts{CheckpointStore: store})).NotTo(Succeed())
+			Expect(xRuns.Load()).To(BeEquivalentTo(2))
+
+			Expect(store.Load(ctx, "Shoot cluster reconciliation")).To(Equal(flow.Checkpoints{"x": "hash2"}))
+		})
+
+		It("should not skip tasks if no checkpoint store is given", func() {
+			Expect(newFlow("hash1").Run(ctx, flow.Opts{CheckpointStore: store})).NotTo(Succeed())
+			Expect(newFlow("hash1").Run(ctx, flow.Opts{})).NotTo(Succeed())
+			Expect(xRuns.Load()).To(BeEquivalentTo(2))
+		})
+
+		It("should not store checkpoints for tasks which are not checkpointable", func() {
+			Expect(newFlow("").Run(ctx, flow.Opts{CheckpointStore: store})).NotTo(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(BeNotFoundError())
+		})
+	})
+
+	Describe("ConfigMapCheckpointStore", func() {
+		It("should return empty checkpoints if the ConfigMap does not exist", func() {
+			Expect(store.Load(ctx, "foo")).To(BeEmpty())
+		})
+
+		It("should store the checkpoints of multiple flows", func() {
+			Expect(store.Store(ctx, "Foo reconciliation", flow.Checkpoints{"a": "1"})).To(Succeed())
+			Expect(store.Store(ctx, "Bar", flow.Checkpoints{"b": "2"})).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{
+				"foo-reconciliation": `{"a":"1"}`,
+				"bar":                `{"b":"2"}`,
+			}))
+
+			Expect(store.Load(ctx, "Foo reconciliation")).To(Equal(flow.Checkpoints{"a": "1"}))
+			Expect(store.Load(ctx, "Bar")).To(Equal(flow.Checkpoints{"b": "2"}))
+		})
+
+		It("should delete the ConfigMap when the last checkpoints are removed", func() {
+			Expect(store.Store(ctx, "foo", flow.Checkpoints{"a": "1"})).To(Succeed())
+			Expect(store.Store(ctx, "bar", flow.Checkpoints{"b": "2"})).To(Succeed())
+
+			Expect(store.Store(ctx, "foo", nil)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKey("bar"))
+
+			Expect(store.Store(ctx, "bar", nil)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(BeNotFoundError())
+		})
+
+		It("should not create the ConfigMap for empty checkpoints", func() {
+			Expect(store.Store(ctx, "foo", nil)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(BeNotFoundError())
+		})
+
+		It("should fail for invalid data", func() {
+			configMap.Data = map[string]string{"foo": "{"}
+			Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+
+			_, err := store.Load(ctx, "foo")
+			Expect(err).To(MatchError(ContainSubstring("failed decoding checkpoints")))
+		})
+	})
+})
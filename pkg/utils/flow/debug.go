@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// DebugHandlerPath is the HTTP handler path for the flow debug handler.
+const DebugHandlerPath = "/debug/flows"
+
+const (
+	executionCurrent = "current"
+	executionLast    = "last"
+
+	formatDOT     = "dot"
+	formatMermaid = "mermaid"
+)
+
+type debugHandler struct {
+	recorder *ExecutionRecorder
+	clock    clock.PassiveClock
+}
+
+// NewDebugHandler creates a new HTTP handler for inspecting the flow executions recorded by the given recorder.
+// Without parameters, it lists the currently running and the last finished execution for all recorded keys. With the
+// `key` parameter, it shows the state, duration and error of all tasks of these executions. With the additional
+// `execution` (`current` or `last`) and `format` (`dot` or `mermaid`) parameters, it renders the graph of the respective
+// execution in the given format.
+func NewDebugHandler(recorder *ExecutionRecorder) http.HandlerFunc {
+	return (&debugHandler{recorder: recorder, clock: clock.RealClock{}}).Handle
+}
+
+func (h *debugHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	var (
+		query     = r.URL.Query()
+		key       = query.Get("key")
+		execution = query.Get("execution")
+		format    = query.Get("format")
+	)
+
+	if key == "" {
+		h.writeHTML(w, h.overview())
+		return
+	}
+
+	current, last := h.recorder.Get(key)
+	if current == nil && last == nil {
+		http.Error(w, fmt.Sprintf("no flow execution recorded for key %q", key), http.StatusNotFound)
+		return
+	}
+
+	if format == "" {
+		h.writeHTML(w, h.details(key, current, last))
+		return
+	}
+
+	record := last
+	if execution == executionCurrent {
+		record = current
+	}
+	if record == nil {
+		http.Error(w, fmt.Sprintf("no %s flow execution recorded for key %q", execution, key), http.StatusNotFound)
+		return
+	}
+
+	var out string
+	switch format {
+	case formatDOT:
+		out = record.DOT()
+	case formatMermaid:
+		out = record.Mermaid()
+	default:
+		http.Error(w, fmt.Sprintf("unsupported format %q, supported formats are %q and %q", format, formatDOT, formatMermaid), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprint(w, out)
+}
+
+func (h *debugHandler) overview() string {
+	var (
+		out  = separate(true)
+		keys = h.recorder.Keys()
+		now  = h.clock.Now()
+	)
+
+	for i, key := range keys {
+		current, last := h.recorder.Get(key)
+
+		out += indent(0, "# %s", keyLink(key))
+		if current != nil {
+			out += indent(1, "%s: %s", executionCurrent, summary(current, now))
+		}
+		if last != nil {
+			out += indent(1, "%s: %s", executionLast, summary(last, now))
+		}
+		out += emptyNewline() + separate(i < len(keys)-1)
+	}
+
+	return out
+}
+
+func (h *debugHandler) details(key string, current, last *ExecutionRecord) string {
+	var (
+		out = indent(0, `<a href="%s">&lt;all&gt;</a>`, DebugHandlerPath) + separate(true)
+		now = h.clock.Now()
+	)
+
+	for _, e := range []struct {
+		name   string
+		record *ExecutionRecord
+	}{
+		{executionCurrent, current},
+		{executionLast, last},
+	} {
+		if e.record == nil {
+			continue
+		}
+
+		out += indent(0, "# %s: %s", e.name, summary(e.record, now))
+		out += indent(0, "started: %s", e.record.Start.UTC().Format(time.RFC3339))
+		if e.record.Error != "" {
+			out += indent(0, "error: %s", html.EscapeString(e.record.Error))
+		}
+		out += indent(0, "graph: %s, %s", graphLink(key, e.name, formatDOT), graphLink(key, e.name, formatMermaid))
+		out += emptyNewline()
+
+		for _, id := range e.record.SortedTaskIDs() {
+			task := e.record.Tasks[id]
+
+			line := fmt.Sprintf("%-9s %10s %s", task.State, formatDuration(task.Duration(now)), html.EscapeString(string(id)))
+			out += indent(1, "%s", strings.ReplaceAll(line, " ", "&nbsp;"))
+			if task.Error != "" {
+				out += indent(2, "error: %s", html.EscapeString(task.Error))
+			}
+		}
+
+		out += emptyNewline() + separate(e.name == executionCurrent && last != nil)
+	}
+
+	return out
+}
+
+func (h *debugHandler) writeHTML(w http.ResponseWriter, out string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprint(w, `<font size="2" face="Courier New">`+out+`</font>`)
+}
+
+func summary(record *ExecutionRecord, now time.Time) string {
+	var (
+		status = "succeeded"
+		failed int
+	)
+
+	for _, task := range record.Tasks {
+		if task.State == TaskStateFailed {
+			failed++
+		}
+	}
+
+	switch {
+	case record.Running():
+		status = "running"
+	case record.Error != "":
+		status = "failed"
+	}
+
+	return fmt.Sprintf("%s (%s, %s, %d task(s), %d failed)", html.EscapeString(record.Flow), status, formatDuration(record.Duration(now)), len(record.Tasks), failed)
+}
+
+func formatDuration(d time.Duration) string {
+	return d.Round(time.Millisecond).String()
+}
+
+func keyLink(key string) string {
+	return fmt.Sprintf(`<a href="%s?key=%s">%s</a>`, DebugHandlerPath, url.QueryEscape(key), html.EscapeString(key))
+}
+
+func graphLink(key, execution, format string) string {
+	return fmt.Sprintf(`<a href="%s?key=%s&execution=%s&format=%s">%s</a>`, DebugHandlerPath, url.QueryEscape(key), execution, format, format)
+}
+
+func emptyNewline() string {
+	return "|<br />"
+}
+
+func separate(withNewBeginning bool) string {
+	result := "-------------------------------------------------------------------------------<br />"
+	if withNewBeginning {
+		result += emptyNewline()
+	}
+	return result
+}
+
+func indent(level int, format string, a ...any) string {
+	return fmt.Sprintf("| "+strings.Repeat("&nbsp;&nbsp;", level)+format+"<br />", a...)
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("DebugHandler", func() {
+	var (
+		recorder *flow.ExecutionRecorder
+		handler  http.HandlerFunc
+	)
+
+	BeforeEach(func() {
+		recorder = flow.NewExecutionRecorder()
+		handler = flow.NewDebugHandler(recorder)
+
+		g := flow.NewGraph("Shoot cluster reconciliation")
+		x := g.Add(flow.Task{Name: "Deploying <namespace>", Fn: func(_ context.Context) error { return nil }})
+		g.Add(flow.Task{Name: "Waiting", Fn: func(_ context.Context) error { return errors.New("timed out") }, Dependencies: flow.NewTaskIDs(x)})
+		Expect(g.Compile().Run(context.Background(), flow.Opts{Recorder: recorder, RecordKey: "Shoot garden-foo/bar"})).NotTo(Succeed())
+	})
+
+	get := func(target string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler(rec, httptest.NewRequest(http.MethodGet, target, nil))
+		return rec
+	}
+
+	It("should list all recorded executions", func() {
+		rec := get(flow.DebugHandlerPath)
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Header().Get("Content-Type")).To(Equal("text/html; charset=utf-8"))
+		Expect(rec.Body.String()).To(ContainSubstring(`<a href="/debug/flows?key=Shoot+garden-foo%2Fbar">Shoot garden-foo/bar</a>`))
+		Expect(rec.Body.String()).To(ContainSubstring("last: Shoot cluster reconciliation (failed, "))
+	})
+
+	It("should show the tasks of the recorded executions", func() {
+		rec := get(flow.DebugHandlerPath + "?key=Shoot+garden-foo%2Fbar")
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Body.String()).To(ContainSubstring("Succeeded"))
+		Expect(rec.Body.String()).To(ContainSubstring("Deploying&nbsp;&lt;namespace&gt;"))
+		Expect(rec.Body.String()).To(ContainSubstring("error: timed out"))
+		Expect(rec.Body.String()).To(ContainSubstring(`<a href="/debug/flows?key=Shoot+garden-foo%2Fbar&execution=last&format=dot">dot</a>`))
+	})
+
+	It("should render the graph of the recorded execution", func() {
+		rec := get(flow.DebugHandlerPath + "?key=Shoot+garden-foo%2Fbar&execution=last&format=mermaid")
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Header().Get("Content-Type")).To(Equal("text/plain; charset=utf-8"))
+		Expect(rec.Body.String()).To(HavePrefix("flowchart LR"))
+		Expect(rec.Body.String()).To(ContainSubstring(":::failed"))
+	})
+
+	It("should fail for unknown keys", func() {
+		Expect(get(flow.DebugHandlerPath + "?key=foo").Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("should fail if there is no current execution", func() {
+		Expect(get(flow.DebugHandlerPath + "?key=Shoot+garden-foo%2Fbar&execution=current&format=dot").Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("should fail for unsupported formats", func() {
+		Expect(get(flow.DebugHandlerPath + "?key=Shoot+garden-foo%2Fbar&format=svg").Code).To(Equal(http.StatusBadRequest))
+	})
+})
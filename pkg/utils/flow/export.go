@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// exportedTask is a task of a flow with its dependencies and (optionally) its state, as rendered by the graph exporters.
+type exportedTask struct {
+	id           TaskID
+	dependencies TaskIDSlice
+	state        TaskState
+}
+
+// dotStyles maps the task states to the node attributes used in the DOT output.
+var dotStyles = map[TaskState]string{
+	TaskStateSkipped:   `style="dashed"`,
+	TaskStatePending:   `style="filled", fillcolor="white"`,
+	TaskStateRunning:   `style="filled", fillcolor="lightskyblue"`,
+	TaskStateSucceeded: `style="filled", fillcolor="palegreen"`,
+	TaskStateFailed:    `style="filled", fillcolor="lightcoral"`,
+}
+
+// mermaidStyles maps the task states to the class definitions used in the Mermaid output.
+var mermaidStyles = map[TaskState]string{
+	TaskStateSkipped:   "stroke-dasharray: 5 5",
+	TaskStatePending:   "fill:#ffffff",
+	TaskStateRunning:   "fill:#87cefa",
+	TaskStateSucceeded: "fill:#98fb98",
+	TaskStateFailed:    "fill:#f08080",
+}
+
+// DOT renders the tasks of the flow and their dependencies as a directed graph in the DOT language of Graphviz. Each
+// edge points from a dependency to the task depending on it. Skipped tasks are rendered with a dashed border.
+func (f *Flow) DOT() string {
+	return exportDOT(f.name, f.exportedTasks())
+}
+
+// Mermaid renders the tasks of the flow and their dependencies as a Mermaid flowchart. Each edge points from a
+// dependency to the task depending on it. Skipped tasks are rendered with a dashed border.
+func (f *Flow) Mermaid() string {
+	return exportMermaid(f.exportedTasks())
+}
+
+func (f *Flow) exportedTasks() []exportedTask {
+	dependencies := f.nodes.dependencies()
+
+	tasks := make([]exportedTask, 0, len(f.nodes))
+	for id, node := range f.nodes {
+		task := exportedTask{id: id, dependencies: dependencies[id].List()}
+		if node.skip {
+			task.state = TaskStateSkipped
+		}
+		tasks = append(tasks, task)
+	}
+
+	sortExportedTasks(tasks)
+	return tasks
+}
+
+// dependencies computes the dependencies of all nodes by inverting their targets.
+func (ns nodes) dependencies() map[TaskID]TaskIDs {
+	out := make(map[TaskID]TaskIDs, len(ns))
+	for id, node := range ns {
+		for target := range node.targetIDs {
+			if out[target] == nil {
+				out[target] = NewTaskIDs()
+			}
+			out[target].Insert(id)
+		}
+	}
+	return out
+}
+
+func sortExportedTasks(tasks []exportedTask) {
+	slices.SortFunc(tasks, func(a, b exportedTask) int {
+		return strings.Compare(string(a.id), string(b.id))
+	})
+}
+
+func exportDOT(name string, tasks []exportedTask) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "digraph %q {\n", name)
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+
+	for _, task := range tasks {
+		if style, ok := dotStyles[task.state]; ok {
+			fmt.Fprintf(&b, "  %q [%s];\n", task.id, style)
+		} else {
+			fmt.Fprintf(&b, "  %q;\n", task.id)
+		}
+	}
+
+	for _, task := range tasks {
+		for _, dependency := range task.dependencies {
+			fmt.Fprintf(&b, "  %q -> %q;\n", dependency, task.id)
+		}
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func exportMermaid(tasks []exportedTask) string {
+	var (
+		b          strings.Builder
+		nodeIDs    = make(map[TaskID]string, len(tasks))
+		usedStates = make(map[TaskState]struct{})
+	)
+
+	// Task names contain spaces and other characters which are not allowed in Mermaid node IDs, hence we generate IDs
+	// and use the task names as labels.
+	for i, task := range tasks {
+		nodeIDs[task.id] = fmt.Sprintf("t%d", i)
+	}
+
+	b.WriteString("flowchart LR\n")
+
+	for _, task := range tasks {
+		fmt.Fprintf(&b, "  %s[\"%s\"]", nodeIDs[task.id], strings.ReplaceAll(string(task.id), `"`, "#quot;"))
+		if _, ok := mermaidStyles[task.state]; ok {
+			fmt.Fprintf(&b, ":::%s", mermaidClass(task.state))
+			usedStates[task.state] = struct{}{}
+		}
+		b.WriteString("\n")
+	}
+
+	for _, task := range tasks {
+		for _, dependency := range task.dependencies {
+			fmt.Fprintf(&b, "  %s --> %s\n", nodeIDs[dependency], nodeIDs[task.id])
+		}
+	}
+
+	for _, state := range allTaskStates {
+		if _, ok := usedStates[state]; ok {
+			fmt.Fprintf(&b, "  classDef %s %s\n", mermaidClass(state), mermaidStyles[state])
+		}
+	}
+
+	return b.String()
+}
+
+func mermaidClass(state TaskState) string {
+	return strings.ToLower(string(state))
+}
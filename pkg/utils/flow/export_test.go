@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Export", func() {
+	var f *flow.Flow
+
+	BeforeEach(func() {
+		g := flow.NewGraph("foo")
+		x := g.Add(flow.Task{Name: "Deploy x"})
+		y := g.Add(flow.Task{Name: `Deploy "y"`, SkipIf: true})
+		g.Add(flow.Task{Name: "Wait", Dependencies: flow.NewTaskIDs(x, y)})
+		f = g.Compile()
+	})
+
+	Describe("#DOT", func() {
+		It("should render the flow in the DOT language", func() {
+			Expect(f.DOT()).To(Equal(`digraph "foo" {
+  rankdir=LR;
+  node [shape=box];
+  "Deploy \"y\"" [style="dashed"];
+  "Deploy x";
+  "Wait";
+  "Deploy \"y\"" -> "Wait";
+  "Deploy x" -> "Wait";
+}
+`))
+		})
+	})
+
+	Describe("#Mermaid", func() {
+		It("should render the flow as Mermaid flowchart", func() {
+			Expect(f.Mermaid()).To(Equal(`flowchart LR
+  t0["Deploy #quot;y#quot;"]:::skipped
+  t1["Deploy x"]
+  t2["Wait"]
+  t0 --> t2
+  t1 --> t2
+  classDef skipped stroke-dasharray: 5 5
+`))
+		})
+	})
+
+	Describe("ExecutionRecord", func() {
+		var record *flow.ExecutionRecord
+
+		BeforeEach(func() {
+			g := flow.NewGraph("foo")
+			x := g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+			g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { return nil }, Dependencies: flow.NewTaskIDs(x)})
+
+			recorder := flow.NewExecutionRecorder()
+			Expect(g.Compile().Run(context.Background(), flow.Opts{Recorder: recorder, RecordKey: "key"})).To(Succeed())
+			_, record = recorder.Get("key")
+		})
+
+		It("should render the execution in the DOT language", func() {
+			Expect(record.DOT()).To(Equal(`digraph "foo" {
+  rankdir=LR;
+  node [shape=box];
+  "x" [style="filled", fillcolor="palegreen"];
+  "y" [style="filled", fillcolor="palegreen"];
+  "x" -> "y";
+}
+`))
+		})
+
+		It("should render the execution as Mermaid flowchart", func() {
+			Expect(record.Mermaid()).To(Equal(`flowchart LR
+  t0["x"]:::succeeded
+  t1["y"]:::succeeded
+  t0 --> t1
+  classDef succeeded fill:#98fb98
+`))
+		})
+	})
+})
@@ -13,6 +13,8 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 	"k8s.io/utils/clock"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -71,10 +73,11 @@ func (f *Flow) Len() int {
 // node is a compiled Task that contains the triggered Tasks, the
 // number of triggers the node itself requires and its payload function.
 type node struct {
-	targetIDs TaskIDs
-	required  int
-	fn        TaskFn
-	skip      bool
+	targetIDs  TaskIDs
+	required   int
+	fn         TaskFn
+	skip       bool
+	inputsHash string
 }
 
 func (n *node) String() string {
@@ -102,6 +105,18 @@ type Opts struct {
 	ErrorCleaner func(ctx context.Context, taskID string)
 	// ErrorContext is used to store any error related context.
 	ErrorContext *errorsutils.ErrorContext
+	// Tracer is used to record a trace for the flow execution with a child span for each task. If it is not set, no
+	// trace is recorded.
+	Tracer trace.Tracer
+	// Recorder is used to record the state of the flow execution under the given RecordKey, e.g., for inspecting it via
+	// the debug handler. If it is not set, the execution is not recorded.
+	Recorder *ExecutionRecorder
+	// RecordKey identifies the object the flow is executed for in the Recorder, e.g., `Shoot garden-foo/bar`. If it is
+	// empty, the name of the flow is used.
+	RecordKey string
+	// CheckpointStore is used to load and persist the checkpoints of checkpointable tasks (see Task.InputsHash). If it is
+	// not set, all tasks are executed.
+	CheckpointStore CheckpointStore
 }
 
 // Run starts an execution of a Flow.
@@ -111,9 +126,10 @@ func (f *Flow) Run(ctx context.Context, opts Opts) error {
 }
 
 type nodeResult struct {
-	TaskID  TaskID
-	Error   error
-	skipped bool
+	TaskID       TaskID
+	Error        error
+	skipped      bool
+	checkpointed bool
 
 	delay    time.Duration
 	duration time.Duration
@@ -177,11 +193,28 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		log = opts.Log.WithValues(logKeyFlow, flow.name)
 	}
 
+	tracer := opts.Tracer
+	if tracer == nil {
+		tracer = noop.NewTracerProvider().Tracer("")
+	}
+
+	recordKey := opts.RecordKey
+	if recordKey == "" {
+		recordKey = flow.name
+	}
+
 	return &execution{
 		flow,
 		InitialStats(flow.name, all),
 		nil,
 		log,
+		tracer,
+		opts.Recorder,
+		recordKey,
+		nil,
+		opts.CheckpointStore,
+		nil,
+		make(Checkpoints),
 		opts.ProgressReporter,
 		opts.ErrorCleaner,
 		opts.ErrorContext,
@@ -197,6 +230,13 @@ type execution struct {
 	taskErrors []error
 
 	log              logr.Logger
+	tracer           trace.Tracer
+	recorder         *ExecutionRecorder
+	recordKey        string
+	recording        *recording
+	checkpointStore  CheckpointStore
+	checkpoints      Checkpoints
+	newCheckpoints   Checkpoints
 	progressReporter ProgressReporter
 	errorCleaner     ErrorCleaner
 	errorContext     *errorsutils.ErrorContext
@@ -213,6 +253,11 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 	if node.skip {
 		log.V(1).Info("Skipped")
 		e.stats.Skipped.Insert(id)
+		e.recording.taskSkipped(id)
+
+		now := e.flow.clock.Now()
+		_, span := e.tracer.Start(ctx, string(id), trace.WithTimestamp(now), trace.WithAttributes(AttributeKeyTask.String(string(id)), AttributeKeyTaskSkipped.Bool(true)))
+		endSpan(span, nil, now)
 
 		go func() {
 			e.done <- &nodeResult{TaskID: id, Error: nil, skipped: true, delay: taskStartDelay}
@@ -221,6 +266,24 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 		return
 	}
 
+	if e.isCheckpointed(id) {
+		log.Info("Skipped because it succeeded with the same inputs in a previous execution")
+		e.stats.Pending.Delete(id)
+		e.stats.Running.Insert(id)
+
+		now := e.flow.clock.Now()
+		_, span := e.tracer.Start(ctx, string(id), trace.WithTimestamp(now), trace.WithAttributes(AttributeKeyTask.String(string(id)), AttributeKeyTaskSkipped.Bool(false), AttributeKeyTaskCheckpointed.Bool(true)))
+		endSpan(span, nil, now)
+		e.recording.taskStarted(id, now)
+		e.recording.taskFinished(id, now, nil)
+
+		go func() {
+			e.done <- &nodeResult{TaskID: id, Error: nil, checkpointed: true, delay: taskStartDelay}
+		}()
+
+		return
+	}
+
 	if e.errorContext != nil {
 		e.errorContext.AddErrorID(string(id))
 	}
@@ -230,11 +293,18 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 
 	go func() {
 		start := e.flow.clock.Now().UTC()
+		taskCtx, span := e.tracer.Start(ctx, string(id), trace.WithTimestamp(start), trace.WithAttributes(AttributeKeyTask.String(string(id)), AttributeKeyTaskSkipped.Bool(false)))
+		e.recording.taskStarted(id, start)
 		log.V(1).Info("Started")
-		err := node.fn(ctx)
-		duration := e.flow.clock.Now().UTC().Sub(start)
+		err := node.fn(taskCtx)
+		end := e.flow.clock.Now().UTC()
+		e.recording.taskFinished(id, end, err)
+		duration := end.Sub(start)
 		log.V(1).Info("Finished", "duration", duration)
 
+		span.SetAttributes(AttributeKeyTaskFailed.Bool(err != nil))
+		endSpan(span, err, end)
+
 		if err != nil {
 			log.Error(err, "Error")
 			err = fmt.Errorf("task %q failed: %w", id, err)
@@ -249,6 +319,10 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 func (e *execution) updateSuccess(id TaskID) {
 	e.stats.Running.Delete(id)
 	e.stats.Succeeded.Insert(id)
+
+	if inputsHash := e.flow.nodes[id].inputsHash; inputsHash != "" {
+		e.newCheckpoints[id] = inputsHash
+	}
 }
 
 func (e *execution) updateFailure(id TaskID) {
@@ -278,10 +352,19 @@ func (e *execution) reportProgress(ctx context.Context) {
 	}
 }
 
-func (e *execution) run(ctx context.Context) error {
+func (e *execution) run(ctx context.Context) (err error) {
 	e.flow.start = e.flow.clock.Now()
 	defer close(e.done)
 
+	ctx, span := e.tracer.Start(ctx, e.flow.name, trace.WithTimestamp(e.flow.start), trace.WithAttributes(AttributeKeyFlow.String(e.flow.name)))
+	defer func() { endSpan(span, err, e.flow.clock.Now()) }()
+
+	e.recording = e.recorder.start(e.recordKey, e.flow, e.flow.start)
+	defer func() { e.recording.finished(e.flow.clock.Now(), err) }()
+
+	e.loadCheckpoints(ctx)
+	defer func() { e.storeCheckpoints(ctx, err) }()
+
 	if e.progressReporter != nil {
 		if err := e.progressReporter.Start(ctx); err != nil {
 			return err
@@ -359,7 +442,7 @@ func (e *execution) reportTaskMetrics(r *nodeResult) {
 			WithLabelValues(e.flow.name, string(r.TaskID), utils.IifString(r.skipped, "true", "false")).
 			Observe(r.delay.Seconds())
 	}
-	if flowTaskDurationSeconds != nil && !r.skipped {
+	if flowTaskDurationSeconds != nil && !r.skipped && !r.checkpointed {
 		flowTaskDurationSeconds.WithLabelValues(e.flow.name, string(r.TaskID)).Observe(r.duration.Seconds())
 	}
 	if flowTaskResults != nil {
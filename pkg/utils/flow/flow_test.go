@@ -11,6 +11,9 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
 
@@ -169,6 +172,79 @@ var _ = Describe("Flow", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(flow.WasCanceled(err)).To(BeTrue())
 		})
+		It("should record a trace with a span for each task", func() {
+			var (
+				recorder       = tracetest.NewSpanRecorder()
+				tracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+				err1 = errors.New("err1")
+
+				g = flow.NewGraph("foo")
+				x = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+				_ = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { return nil }, SkipIf: true})
+				_ = g.Add(flow.Task{Name: "z", Fn: func(_ context.Context) error { return err1 }, Dependencies: flow.NewTaskIDs(x)})
+				f = g.Compile()
+			)
+			DeferCleanup(func() { Expect(tracerProvider.Shutdown(context.Background())).To(Succeed()) })
+
+			Expect(f.Run(ctx, flow.Opts{Tracer: tracerProvider.Tracer("test")})).NotTo(Succeed())
+
+			spans := recorder.Ended()
+			Expect(spans).To(HaveLen(4))
+
+			spansByName := make(map[string]sdktrace.ReadOnlySpan, len(spans))
+			for _, span := range spans {
+				spansByName[span.Name()] = span
+			}
+
+			flowSpan := spansByName["foo"]
+			Expect(flowSpan).NotTo(BeNil())
+			Expect(flowSpan.Attributes()).To(ContainElement(flow.AttributeKeyFlow.String("foo")))
+			Expect(flowSpan.Status().Code).To(Equal(codes.Error))
+
+			for _, name := range []string{"x", "y", "z"} {
+				Expect(spansByName).To(HaveKey(name))
+				Expect(spansByName[name].Parent().SpanID()).To(Equal(flowSpan.SpanContext().SpanID()))
+				Expect(spansByName[name].SpanContext().TraceID()).To(Equal(flowSpan.SpanContext().TraceID()))
+			}
+
+			Expect(spansByName["x"].Attributes()).To(ContainElements(flow.AttributeKeyTaskSkipped.Bool(false), flow.AttributeKeyTaskFailed.Bool(false)))
+			Expect(spansByName["x"].Status().Code).To(Equal(codes.Unset))
+			Expect(spansByName["y"].Attributes()).To(ContainElement(flow.AttributeKeyTaskSkipped.Bool(true)))
+			Expect(spansByName["z"].Attributes()).To(ContainElement(flow.AttributeKeyTaskFailed.Bool(true)))
+			Expect(spansByName["z"].Status()).To(Equal(sdktrace.Status{Code: codes.Error, Description: "err1"}))
+		})
+
+		It("should record the execution in the given recorder", func() {
+			var (
+				recorder = flow.NewExecutionRecorder()
+
+				g = flow.NewGraph("foo")
+				x = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+				_ = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { return nil }, SkipIf: true})
+				z = g.Add(flow.Task{Name: "z", Fn: func(_ context.Context) error { return errors.New("err1") }, Dependencies: flow.NewTaskIDs(x)})
+				_ = g.Add(flow.Task{Name: "w", Fn: func(_ context.Context) error { return nil }, Dependencies: flow.NewTaskIDs(z)})
+				f = g.Compile()
+			)
+
+			Expect(f.Run(ctx, flow.Opts{Recorder: recorder, RecordKey: "Shoot foo/bar"})).NotTo(Succeed())
+
+			Expect(recorder.Keys()).To(ConsistOf("Shoot foo/bar"))
+			current, last := recorder.Get("Shoot foo/bar")
+			Expect(current).To(BeNil())
+			Expect(last).NotTo(BeNil())
+			Expect(last.Flow).To(Equal("foo"))
+			Expect(last.Running()).To(BeFalse())
+			Expect(last.Error).To(ContainSubstring("err1"))
+
+			Expect(last.Tasks).To(HaveLen(4))
+			Expect(last.Tasks["x"].State).To(Equal(flow.TaskStateSucceeded))
+			Expect(last.Tasks["y"].State).To(Equal(flow.TaskStateSkipped))
+			Expect(last.Tasks["z"].State).To(Equal(flow.TaskStateFailed))
+			Expect(last.Tasks["z"].Error).To(Equal("err1"))
+			Expect(last.Tasks["z"].Dependencies).To(Equal(flow.NewTaskIDs(x)))
+			Expect(last.Tasks["w"].State).To(Equal(flow.TaskStatePending))
+		})
 	})
 
 	Describe("#Sequential", func() {
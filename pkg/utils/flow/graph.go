@@ -17,6 +17,11 @@ type Task struct {
 	Fn           TaskFn
 	SkipIf       bool
 	Dependencies TaskIDs
+	// InputsHash marks the task as checkpointable if it is set. It must change whenever any input of the task changes.
+	// If the flow is executed with a CheckpointStore and the task succeeded with the same inputs hash in a previous
+	// failed execution, it is not executed again. Only tasks which are idempotent and do not produce any results that
+	// other tasks rely on may be marked as checkpointable.
+	InputsHash string
 }
 
 // Spec returns the TaskSpec of a task.
@@ -25,6 +30,7 @@ func (t *Task) Spec() *TaskSpec {
 		t.Fn,
 		t.SkipIf,
 		t.Dependencies.Copy(),
+		t.InputsHash,
 	}
 }
 
@@ -34,6 +40,7 @@ type TaskSpec struct {
 	Fn           TaskFn
 	Skip         bool
 	Dependencies TaskIDs
+	InputsHash   string
 }
 
 // Tasks is a mapping from TaskID to TaskSpec.
@@ -91,6 +98,7 @@ func (g *Graph) Compile() *Flow {
 		node := nodes.getOrCreate(taskName)
 		node.fn = taskSpec.Fn
 		node.skip = taskSpec.Skip
+		node.inputsHash = taskSpec.InputsHash
 		node.required = taskSpec.Dependencies.Len()
 	}
 
@@ -0,0 +1,304 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// TaskState is the state of a task in a recorded flow execution.
+type TaskState string
+
+const (
+	// TaskStatePending is the state of a task whose dependencies have not completed yet.
+	TaskStatePending TaskState = "Pending"
+	// TaskStateRunning is the state of a task which is currently executed.
+	TaskStateRunning TaskState = "Running"
+	// TaskStateSucceeded is the state of a task which completed successfully.
+	TaskStateSucceeded TaskState = "Succeeded"
+	// TaskStateFailed is the state of a task which returned an error.
+	TaskStateFailed TaskState = "Failed"
+	// TaskStateSkipped is the state of a task which was skipped.
+	TaskStateSkipped TaskState = "Skipped"
+)
+
+var allTaskStates = []TaskState{TaskStatePending, TaskStateRunning, TaskStateSucceeded, TaskStateFailed, TaskStateSkipped}
+
+// DefaultExecutionRecorder is the ExecutionRecorder used by the controllers of gardenlet and gardener-operator. Its
+// records are served by the debug handler (see NewDebugHandler) if the debug handlers are enabled.
+var DefaultExecutionRecorder = NewExecutionRecorder()
+
+// ExecutionRecorder records the state of flow executions per key, e.g., per Shoot. For each key, it keeps the currently
+// running and the last finished execution. It is safe for concurrent use.
+type ExecutionRecorder struct {
+	lock       sync.RWMutex
+	executions map[string]*executions
+}
+
+type executions struct {
+	current, last *ExecutionRecord
+}
+
+// NewExecutionRecorder returns a new ExecutionRecorder.
+func NewExecutionRecorder() *ExecutionRecorder {
+	return &ExecutionRecorder{executions: make(map[string]*executions)}
+}
+
+// ExecutionRecord is the record of a single flow execution.
+type ExecutionRecord struct {
+	// Flow is the name of the flow.
+	Flow string
+	// Start is the time when the execution was started.
+	Start time.Time
+	// End is the time when the execution finished. It is zero as long as the execution is running.
+	End time.Time
+	// Error is the error message of the execution, if it failed.
+	Error string
+	// Tasks are the records of all tasks of the flow.
+	Tasks map[TaskID]*TaskRecord
+}
+
+// TaskRecord is the record of a single task in a flow execution.
+type TaskRecord struct {
+	// Dependencies are the IDs of the tasks this task depends on.
+	Dependencies TaskIDs
+	// State is the state of the task.
+	State TaskState
+	// Start is the time when the task was started. It is zero as long as the task is pending.
+	Start time.Time
+	// End is the time when the task finished. It is zero as long as the task is pending or running.
+	End time.Time
+	// Error is the error message of the task, if it failed.
+	Error string
+}
+
+// Running returns whether the execution is still running.
+func (r *ExecutionRecord) Running() bool {
+	return r.End.IsZero()
+}
+
+// Duration returns the duration of the execution. If the execution is still running, the duration until the given time
+// is returned.
+func (r *ExecutionRecord) Duration(now time.Time) time.Duration {
+	return duration(r.Start, r.End, now)
+}
+
+// Duration returns the duration of the task. If the task is still running, the duration until the given time is
+// returned. Pending and skipped tasks have a duration of zero.
+func (t *TaskRecord) Duration(now time.Time) time.Duration {
+	if t.Start.IsZero() {
+		return 0
+	}
+	return duration(t.Start, t.End, now)
+}
+
+func duration(start, end, now time.Time) time.Duration {
+	if end.IsZero() {
+		end = now
+	}
+	return end.Sub(start)
+}
+
+// DOT renders the tasks of the recorded execution and their dependencies as a directed graph in the DOT language of
+// Graphviz. The tasks are colored according to their state.
+func (r *ExecutionRecord) DOT() string {
+	return exportDOT(r.Flow, r.exportedTasks())
+}
+
+// Mermaid renders the tasks of the recorded execution and their dependencies as a Mermaid flowchart. The tasks are
+// colored according to their state.
+func (r *ExecutionRecord) Mermaid() string {
+	return exportMermaid(r.exportedTasks())
+}
+
+func (r *ExecutionRecord) exportedTasks() []exportedTask {
+	tasks := make([]exportedTask, 0, len(r.Tasks))
+	for id, task := range r.Tasks {
+		tasks = append(tasks, exportedTask{id: id, dependencies: task.Dependencies.List(), state: task.State})
+	}
+
+	sortExportedTasks(tasks)
+	return tasks
+}
+
+// SortedTaskIDs returns the IDs of all tasks of the recorded execution ordered by their start time. Tasks which have
+// not been started are ordered last. Tasks with the same start time are ordered by their ID.
+func (r *ExecutionRecord) SortedTaskIDs() TaskIDSlice {
+	ids := make(TaskIDSlice, 0, len(r.Tasks))
+	for id := range r.Tasks {
+		ids = append(ids, id)
+	}
+
+	slices.SortFunc(ids, func(a, b TaskID) int {
+		startA, startB := r.Tasks[a].Start, r.Tasks[b].Start
+		switch {
+		case startA.Equal(startB):
+			if a < b {
+				return -1
+			}
+			return 1
+		case startA.IsZero():
+			return 1
+		case startB.IsZero():
+			return -1
+		}
+		return startA.Compare(startB)
+	})
+	return ids
+}
+
+func (r *ExecutionRecord) copy() *ExecutionRecord {
+	if r == nil {
+		return nil
+	}
+
+	out := *r
+	out.Tasks = make(map[TaskID]*TaskRecord, len(r.Tasks))
+	for id, task := range r.Tasks {
+		taskCopy := *task
+		taskCopy.Dependencies = task.Dependencies.Copy()
+		out.Tasks[id] = &taskCopy
+	}
+	return &out
+}
+
+// Keys returns all keys for which executions were recorded in alphabetical order.
+func (r *ExecutionRecorder) Keys() []string {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	keys := make([]string, 0, len(r.executions))
+	for key := range r.executions {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+	return keys
+}
+
+// Get returns copies of the currently running and the last finished execution recorded for the given key. Both are nil
+// if there is no such execution.
+func (r *ExecutionRecorder) Get(key string) (current, last *ExecutionRecord) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	e, ok := r.executions[key]
+	if !ok {
+		return nil, nil
+	}
+	return e.current.copy(), e.last.copy()
+}
+
+// Forget removes all executions recorded for the given key. It should be called once the object the key refers to is
+// gone. It is a no-op if the recorder is nil.
+func (r *ExecutionRecorder) Forget(key string) {
+	if r == nil {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	delete(r.executions, key)
+}
+
+// start records the start of an execution of the given flow for the given key. It returns nil if the recorder is nil.
+func (r *ExecutionRecorder) start(key string, f *Flow, now time.Time) *recording {
+	if r == nil {
+		return nil
+	}
+
+	record := &ExecutionRecord{
+		Flow:  f.name,
+		Start: now,
+		Tasks: make(map[TaskID]*TaskRecord, len(f.nodes)),
+	}
+
+	dependencies := f.nodes.dependencies()
+	for id := range f.nodes {
+		record.Tasks[id] = &TaskRecord{Dependencies: NewTaskIDs(dependencies[id]), State: TaskStatePending}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	e, ok := r.executions[key]
+	if !ok {
+		e = &executions{}
+		r.executions[key] = e
+	}
+	e.current = record
+
+	return &recording{recorder: r, key: key, record: record}
+}
+
+// recording updates the record of a running execution. All methods are no-ops if the recording is nil.
+type recording struct {
+	recorder *ExecutionRecorder
+	key      string
+	record   *ExecutionRecord
+}
+
+func (r *recording) update(id TaskID, fn func(*TaskRecord)) {
+	if r == nil {
+		return
+	}
+
+	r.recorder.lock.Lock()
+	defer r.recorder.lock.Unlock()
+
+	fn(r.record.Tasks[id])
+}
+
+func (r *recording) taskSkipped(id TaskID) {
+	r.update(id, func(task *TaskRecord) {
+		task.State = TaskStateSkipped
+	})
+}
+
+func (r *recording) taskStarted(id TaskID, now time.Time) {
+	r.update(id, func(task *TaskRecord) {
+		task.State = TaskStateRunning
+		task.Start = now
+	})
+}
+
+func (r *recording) taskFinished(id TaskID, now time.Time, err error) {
+	r.update(id, func(task *TaskRecord) {
+		task.State = TaskStateSucceeded
+		task.End = now
+		if err != nil {
+			task.State = TaskStateFailed
+			task.Error = err.Error()
+		}
+	})
+}
+
+func (r *recording) finished(now time.Time, err error) {
+	if r == nil {
+		return
+	}
+
+	r.recorder.lock.Lock()
+	defer r.recorder.lock.Unlock()
+
+	r.record.End = now
+	if err != nil {
+		r.record.Error = err.Error()
+	}
+
+	e, ok := r.recorder.executions[r.key]
+	if !ok {
+		// the key was forgotten while the execution was running
+		return
+	}
+
+	e.last = r.record
+	if e.current == r.record {
+		e.current = nil
+	}
+}
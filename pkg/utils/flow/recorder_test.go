@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("ExecutionRecorder", func() {
+	var (
+		ctx      = context.Background()
+		recorder *flow.ExecutionRecorder
+	)
+
+	BeforeEach(func() {
+		recorder = flow.NewExecutionRecorder()
+	})
+
+	It("should record the currently running and the last execution", func() {
+		var (
+			block    bool
+			started  = make(chan struct{})
+			finish   = make(chan struct{})
+			finished = make(chan error)
+
+			g = flow.NewGraph("foo")
+			x = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+			_ = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error {
+				if block {
+					close(started)
+					<-finish
+				}
+				return nil
+			}, Dependencies: flow.NewTaskIDs(x)})
+			f = g.Compile()
+		)
+
+		Expect(f.Run(ctx, flow.Opts{Recorder: recorder, RecordKey: "key"})).To(Succeed())
+
+		block = true
+
+		go func() { finished <- f.Run(ctx, flow.Opts{Recorder: recorder, RecordKey: "key"}) }()
+		Eventually(started).Should(BeClosed())
+
+		current, last := recorder.Get("key")
+		Expect(current).NotTo(BeNil())
+		Expect(current.Running()).To(BeTrue())
+		Expect(current.Tasks["x"].State).To(Equal(flow.TaskStateSucceeded))
+		Expect(current.Tasks["y"].State).To(Equal(flow.TaskStateRunning))
+		Expect(current.Tasks["y"].End.IsZero()).To(BeTrue())
+		Expect(current.SortedTaskIDs()).To(Equal(flow.TaskIDSlice{"x", "y"}))
+		Expect(last).NotTo(BeNil())
+		Expect(last.Running()).To(BeFalse())
+		Expect(last.Tasks["y"].State).To(Equal(flow.TaskStateSucceeded))
+
+		close(finish)
+		Eventually(finished).Should(Receive(BeNil()))
+
+		current, last = recorder.Get("key")
+		Expect(current).To(BeNil())
+		Expect(last.Tasks["y"].State).To(Equal(flow.TaskStateSucceeded))
+	})
+
+	It("should return copies of the records", func() {
+		g := flow.NewGraph("foo")
+		g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+		Expect(g.Compile().Run(ctx, flow.Opts{Recorder: recorder, RecordKey: "key"})).To(Succeed())
+
+		_, last := recorder.Get("key")
+		last.Tasks["x"].State = flow.TaskStateFailed
+
+		_, last = recorder.Get("key")
+		Expect(last.Tasks["x"].State).To(Equal(flow.TaskStateSucceeded))
+	})
+
+	It("should use the flow name as key if no key is given", func() {
+		g := flow.NewGraph("foo")
+		g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+		Expect(g.Compile().Run(ctx, flow.Opts{Recorder: recorder})).To(Succeed())
+
+		Expect(recorder.Keys()).To(ConsistOf("foo"))
+	})
+
+	It("should forget the records for a key", func() {
+		g := flow.NewGraph("foo")
+		g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+		Expect(g.Compile().Run(ctx, flow.Opts{Recorder: recorder, RecordKey: "key"})).To(Succeed())
+
+		recorder.Forget("key")
+
+		Expect(recorder.Keys()).To(BeEmpty())
+		current, last := recorder.Get("key")
+		Expect(current).To(BeNil())
+		Expect(last).To(BeNil())
+	})
+
+	Describe("#Duration", func() {
+		It("should compute the duration of running and finished tasks", func() {
+			var (
+				start = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+				now   = start.Add(time.Minute)
+			)
+
+			Expect((&flow.TaskRecord{}).Duration(now)).To(BeZero())
+			Expect((&flow.TaskRecord{Start: start}).Duration(now)).To(Equal(time.Minute))
+			Expect((&flow.TaskRecord{Start: start, End: start.Add(time.Second)}).Duration(now)).To(Equal(time.Second))
+			Expect((&flow.ExecutionRecord{Start: start}).Duration(now)).To(Equal(time.Minute))
+		})
+	})
+})
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/gardener/gardener/pkg/utils/retry"
 )
@@ -42,8 +43,16 @@ func (t TaskFn) RetryUntilTimeout(interval, timeout time.Duration) TaskFn {
 		ctx, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
 
+		var (
+			span     = trace.SpanFromContext(ctx)
+			attempts int
+		)
+		defer func() { span.SetAttributes(AttributeKeyTaskRetries.Int(max(attempts-1, 0))) }()
+
 		return retry.Until(ctx, interval, func(ctx context.Context) (done bool, err error) {
+			attempts++
 			if err := t(ctx); err != nil {
+				recordFailedAttempt(span, attempts, err)
 				return retry.MinorError(err)
 			}
 			return retry.Ok()
@@ -8,10 +8,13 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -34,6 +37,32 @@ var _ = Describe("task functions", func() {
 		goleak.VerifyNone(GinkgoT(), ignoreCurrent)
 	})
 
+	Describe("#RetryUntilTimeout", func() {
+		It("should retry the function and record the failed attempts in the span", func() {
+			var (
+				recorder       = tracetest.NewSpanRecorder()
+				tracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+				attempts       int
+			)
+			DeferCleanup(func() { Expect(tracerProvider.Shutdown(context.Background())).To(Succeed()) })
+
+			ctx, span := tracerProvider.Tracer("test").Start(context.Background(), "task")
+			Expect(flow.TaskFn(func(_ context.Context) error {
+				attempts++
+				if attempts < 3 {
+					return errors.New("not yet")
+				}
+				return nil
+			}).RetryUntilTimeout(time.Millisecond, time.Second)(ctx)).To(Succeed())
+			span.End()
+
+			Expect(attempts).To(Equal(3))
+			Expect(recorder.Ended()).To(HaveLen(1))
+			Expect(recorder.Ended()[0].Attributes()).To(ContainElement(flow.AttributeKeyTaskRetries.Int(2)))
+			Expect(recorder.Ended()[0].Events()).To(HaveLen(2))
+		})
+	})
+
 	Describe("#Parallel", func() {
 		It("should execute the functions in parallel", func() {
 			var (
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// AttributeKeyFlow is the span attribute containing the name of the flow.
+	AttributeKeyFlow = attribute.Key("flow.name")
+	// AttributeKeyTask is the span attribute containing the ID of the task.
+	AttributeKeyTask = attribute.Key("flow.task.id")
+	// AttributeKeyTaskSkipped is the span attribute indicating whether the task was skipped.
+	AttributeKeyTaskSkipped = attribute.Key("flow.task.skipped")
+	// AttributeKeyTaskFailed is the span attribute indicating whether the task failed.
+	AttributeKeyTaskFailed = attribute.Key("flow.task.failed")
+	// AttributeKeyTaskRetries is the span attribute containing the number of retries of the task.
+	AttributeKeyTaskRetries = attribute.Key("flow.task.retries")
+	// AttributeKeyTaskCheckpointed is the span attribute indicating whether the task was not executed because it
+	// succeeded with the same inputs in a previous execution.
+	AttributeKeyTaskCheckpointed = attribute.Key("flow.task.checkpointed")
+
+	eventNameAttemptFailed = "attempt failed"
+	attributeKeyAttempt    = attribute.Key("attempt")
+)
+
+// endSpan records the given error (if any) in the given span and ends it at the given time.
+func endSpan(span trace.Span, err error, end time.Time) {
+	if err != nil {
+		span.RecordError(err, trace.WithTimestamp(end))
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End(trace.WithTimestamp(end))
+}
+
+// recordFailedAttempt adds an event for a failed attempt of a retried task to the given span.
+func recordFailedAttempt(span trace.Span, attempt int, err error) {
+	span.AddEvent(eventNameAttemptFailed, trace.WithAttributes(
+		attributeKeyAttempt.Int(attempt),
+		attribute.String("exception.message", err.Error()),
+	))
+}
@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/robfig/cron"
+	"gopkg.in/inf.v0"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// HoursPerMonth is the average number of hours per month which is used for estimating the monthly cost of Shoots.
+const HoursPerMonth = 730
+
+const week = 7 * 24 * time.Hour
+
+// referenceWeekStart is the start of the week which is used for evaluating the hibernation schedules of Shoots. A week
+// without daylight saving time transitions is chosen in order to get stable results for all locations.
+var referenceWeekStart = time.Date(2025, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+// EstimateShootCost estimates the cost of the given Shoot based on the prices of the machine and volume types in the
+// given CloudProfile spec. The prices are multiplied with the price factor of the Shoot's region. Volumes are only
+// accounted if their volume type has a price. It returns nil if the Shoot has no workers or if a machine type used by
+// the Shoot has no price, i.e., if no meaningful estimate can be computed.
+func EstimateShootCost(shoot *core.Shoot, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec) (*gardencorev1beta1.ShootCostEstimate, error) {
+	if len(shoot.Spec.Provider.Workers) == 0 {
+		return nil, nil
+	}
+
+	var (
+		currency      string
+		hourlyMinimum = new(inf.Dec)
+		hourlyMaximum = new(inf.Dec)
+
+		addPrice = func(price *gardencorev1beta1.Price, amount *inf.Dec, minimum, maximum int32) error {
+			if currency == "" {
+				currency = price.Currency
+			} else if currency != price.Currency {
+				return fmt.Errorf("cannot combine prices in different currencies %s and %s", currency, price.Currency)
+			}
+
+			hourly := price.Hourly.DeepCopy()
+			perNode := new(inf.Dec).Mul(hourly.AsDec(), amount)
+			hourlyMinimum.Add(hourlyMinimum, new(inf.Dec).Mul(perNode, inf.NewDec(int64(minimum), 0)))
+			hourlyMaximum.Add(hourlyMaximum, new(inf.Dec).Mul(perNode, inf.NewDec(int64(maximum), 0)))
+			return nil
+		}
+
+		addVolume = func(volumeType *string, volumeSize string, minimum, maximum int32) error {
+			if volumeType == nil {
+				return nil
+			}
+
+			idx := slices.IndexFunc(cloudProfileSpec.VolumeTypes, func(v gardencorev1beta1.VolumeType) bool { return v.Name == *volumeType })
+			if idx == -1 || cloudProfileSpec.VolumeTypes[idx].Price == nil {
+				return nil
+			}
+
+			size, err := resource.ParseQuantity(volumeSize)
+			if err != nil {
+				return fmt.Errorf("failed parsing volume size %q: %w", volumeSize, err)
+			}
+			sizeGiB := new(inf.Dec).QuoRound(size.AsDec(), inf.NewDec(1<<30, 0), 3, inf.RoundUp)
+
+			return addPrice(cloudProfileSpec.VolumeTypes[idx].Price, sizeGiB, minimum, maximum)
+		}
+	)
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		idx := slices.IndexFunc(cloudProfileSpec.MachineTypes, func(m gardencorev1beta1.MachineType) bool { return m.Name == worker.Machine.Type })
+		if idx == -1 {
+			return nil, fmt.Errorf("machineType %s not found in CloudProfile", worker.Machine.Type)
+		}
+		machineType := cloudProfileSpec.MachineTypes[idx]
+		if machineType.Price == nil {
+			return nil, nil
+		}
+
+		if err := addPrice(machineType.Price, inf.NewDec(1, 0), worker.Minimum, worker.Maximum); err != nil {
+			return nil, err
+		}
+		if worker.Volume != nil {
+			if err := addVolume(worker.Volume.Type, worker.Volume.VolumeSize, worker.Minimum, worker.Maximum); err != nil {
+				return nil, err
+			}
+		}
+		for _, dataVolume := range worker.DataVolumes {
+			if err := addVolume(dataVolume.Type, dataVolume.VolumeSize, worker.Minimum, worker.Maximum); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, region := range cloudProfileSpec.Regions {
+		if region.Name == shoot.Spec.Region && region.PriceFactor != nil {
+			priceFactor := region.PriceFactor.DeepCopy()
+			hourlyMinimum.Mul(hourlyMinimum, priceFactor.AsDec())
+			hourlyMaximum.Mul(hourlyMaximum, priceFactor.AsDec())
+		}
+	}
+
+	awake, err := shootAwakeDurationPerWeek(shoot.Spec.Hibernation)
+	if err != nil {
+		return nil, fmt.Errorf("failed evaluating hibernation schedules: %w", err)
+	}
+	monthly := func(hourly *inf.Dec) resource.Quantity {
+		awakeHoursPerMonth := inf.NewDec(int64(HoursPerMonth*awake/time.Minute), 0)
+		dec := new(inf.Dec).Mul(hourly, awakeHoursPerMonth)
+		return *resource.NewDecimalQuantity(*dec.QuoRound(dec, inf.NewDec(int64(week/time.Minute), 0), 2, inf.RoundHalfUp), resource.DecimalSI)
+	}
+
+	return &gardencorev1beta1.ShootCostEstimate{
+		Currency:       currency,
+		HourlyMinimum:  *resource.NewDecimalQuantity(*new(inf.Dec).Round(hourlyMinimum, 4, inf.RoundHalfUp), resource.DecimalSI),
+		HourlyMaximum:  *resource.NewDecimalQuantity(*new(inf.Dec).Round(hourlyMaximum, 4, inf.RoundHalfUp), resource.DecimalSI),
+		MonthlyMinimum: monthly(hourlyMinimum),
+		MonthlyMaximum: monthly(hourlyMaximum),
+	}, nil
+}
+
+type hibernationTransition struct {
+	time      time.Time
+	hibernate bool
+}
+
+// shootAwakeDurationPerWeek returns how long a Shoot with the given hibernation settings is awake during a week.
+func shootAwakeDurationPerWeek(hibernation *core.Hibernation) (time.Duration, error) {
+	if hibernation == nil {
+		return week, nil
+	}
+
+	hibernated := ptr.Deref(hibernation.Enabled, false)
+	if len(hibernation.Schedules) == 0 {
+		if hibernated {
+			return 0, nil
+		}
+		return week, nil
+	}
+
+	// Transitions are collected starting one week before the reference week in order to know whether the Shoot is
+	// hibernated at the beginning of the reference week.
+	var (
+		from        = referenceWeekStart.Add(-week)
+		to          = referenceWeekStart.Add(week)
+		transitions []hibernationTransition
+	)
+
+	for _, schedule := range hibernation.Schedules {
+		location, err := time.LoadLocation(ptr.Deref(schedule.Location, time.UTC.String()))
+		if err != nil {
+			return 0, err
+		}
+
+		for _, spec := range []struct {
+			cronSpec  *string
+			hibernate bool
+		}{
+			{schedule.Start, true},
+			{schedule.End, false},
+		} {
+			if spec.cronSpec == nil {
+				continue
+			}
+
+			parsed, err := cron.ParseStandard(*spec.cronSpec)
+			if err != nil {
+				return 0, err
+			}
+
+			for t := parsed.Next(from.In(location)); t.Before(to); t = parsed.Next(t) {
+				transitions = append(transitions, hibernationTransition{time: t, hibernate: spec.hibernate})
+			}
+		}
+	}
+
+	slices.SortStableFunc(transitions, func(a, b hibernationTransition) int { return a.time.Compare(b.time) })
+
+	var (
+		awake  time.Duration
+		cursor = referenceWeekStart
+	)
+
+	for _, transition := range transitions {
+		if transition.time.After(referenceWeekStart) {
+			if !hibernated {
+				awake += transition.time.Sub(cursor)
+			}
+			cursor = transition.time
+		}
+		hibernated = transition.hibernate
+	}
+
+	if !hibernated {
+		awake += to.Sub(cursor)
+	}
+
+	return awake, nil
+}
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var _ = Describe("Cost", func() {
+	Describe("#EstimateShootCost", func() {
+		var (
+			shoot            *core.Shoot
+			cloudProfileSpec *gardencorev1beta1.CloudProfileSpec
+		)
+
+		BeforeEach(func() {
+			shoot = &core.Shoot{
+				Spec: core.ShootSpec{
+					Region: "region-1",
+					Provider: core.Provider{
+						Workers: []core.Worker{
+							{
+								Name:        "worker-1",
+								Machine:     core.Machine{Type: "small"},
+								Minimum:     1,
+								Maximum:     3,
+								Volume:      &core.Volume{Type: ptr.To("standard"), VolumeSize: "20Gi"},
+								DataVolumes: []core.DataVolume{{Name: "data", Type: ptr.To("standard"), VolumeSize: "30Gi"}},
+							},
+							{
+								Name:    "worker-2",
+								Machine: core.Machine{Type: "large"},
+								Minimum: 0,
+								Maximum: 1,
+							},
+						},
+					},
+				},
+			}
+
+			cloudProfileSpec = &gardencorev1beta1.CloudProfileSpec{
+				MachineTypes: []gardencorev1beta1.MachineType{
+					{Name: "small", Price: &gardencorev1beta1.Price{Currency: "EUR", Hourly: resource.MustParse("0.1")}},
+					{Name: "large", Price: &gardencorev1beta1.Price{Currency: "EUR", Hourly: resource.MustParse("0.5")}},
+				},
+				VolumeTypes: []gardencorev1beta1.VolumeType{
+					{Name: "standard", Price: &gardencorev1beta1.Price{Currency: "EUR", Hourly: resource.MustParse("0.0002")}},
+				},
+				Regions: []gardencorev1beta1.Region{
+					{Name: "region-1"},
+				},
+			}
+		})
+
+		It("should estimate the cost for the minimum and maximum number of workers", func() {
+			estimate, err := EstimateShootCost(shoot, cloudProfileSpec)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(estimate.Currency).To(Equal("EUR"))
+			Expect(estimate.HourlyMinimum.String()).To(Equal("110m"))
+			Expect(estimate.HourlyMaximum.String()).To(Equal("830m"))
+			Expect(estimate.MonthlyMinimum.String()).To(Equal("80300m"))
+			Expect(estimate.MonthlyMaximum.String()).To(Equal("605900m"))
+		})
+
+		It("should apply the price factor of the region", func() {
+			cloudProfileSpec.Regions[0].PriceFactor = ptr.To(resource.MustParse("1.5"))
+
+			estimate, err := EstimateShootCost(shoot, cloudProfileSpec)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(estimate.HourlyMinimum.String()).To(Equal("165m"))
+			Expect(estimate.HourlyMaximum.String()).To(Equal("1245m"))
+		})
+
+		It("should not account volumes whose volume type has no price", func() {
+			cloudProfileSpec.VolumeTypes[0].Price = nil
+
+			estimate, err := EstimateShootCost(shoot, cloudProfileSpec)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(estimate.HourlyMinimum.String()).To(Equal("100m"))
+			Expect(estimate.HourlyMaximum.String()).To(Equal("800m"))
+		})
+
+		It("should take the hibernation schedules into account", func() {
+			// awake from 8:00 to 18:00 on weekdays, i.e., for 50 of 168 hours per week
+			shoot.Spec.Hibernation = &core.Hibernation{Schedules: []core.HibernationSchedule{{
+				Start:    ptr.To("00 18 * * 1,2,3,4,5"),
+				End:      ptr.To("00 08 * * 1,2,3,4,5"),
+				Location: ptr.To("Europe/Berlin"),
+			}}}
+
+			estimate, err := EstimateShootCost(shoot, cloudProfileSpec)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(estimate.HourlyMinimum.String()).To(Equal("110m"))
+			Expect(estimate.MonthlyMinimum.String()).To(Equal("23900m"))
+			Expect(estimate.MonthlyMaximum.String()).To(Equal("180330m"))
+		})
+
+		It("should estimate no monthly cost for hibernated Shoots without schedules", func() {
+			shoot.Spec.Hibernation = &core.Hibernation{Enabled: ptr.To(true)}
+
+			estimate, err := EstimateShootCost(shoot, cloudProfileSpec)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(estimate.MonthlyMinimum.IsZero()).To(BeTrue())
+			Expect(estimate.MonthlyMaximum.IsZero()).To(BeTrue())
+		})
+
+		It("should return nil if a machine type has no price", func() {
+			cloudProfileSpec.MachineTypes[1].Price = nil
+
+			Expect(EstimateShootCost(shoot, cloudProfileSpec)).To(BeNil())
+		})
+
+		It("should return nil for workerless Shoots", func() {
+			shoot.Spec.Provider.Workers = nil
+
+			Expect(EstimateShootCost(shoot, cloudProfileSpec)).To(BeNil())
+		})
+
+		It("should fail if prices have different currencies", func() {
+			cloudProfileSpec.MachineTypes[1].Price.Currency = "USD"
+
+			_, err := EstimateShootCost(shoot, cloudProfileSpec)
+			Expect(err).To(MatchError(ContainSubstring("cannot combine prices in different currencies EUR and USD")))
+		})
+
+		It("should fail if the machine type is unknown", func() {
+			shoot.Spec.Provider.Workers[0].Machine.Type = "unknown"
+
+			_, err := EstimateShootCost(shoot, cloudProfileSpec)
+			Expect(err).To(MatchError(ContainSubstring("machineType unknown not found in CloudProfile")))
+		})
+	})
+})
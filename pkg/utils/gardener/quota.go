@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorehelper "github.com/gardener/gardener/pkg/apis/core/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// QuotaMetricNames are the names of all metrics which can be constrained by a Quota.
+var QuotaMetricNames = []corev1.ResourceName{
+	core.QuotaMetricCPU,
+	core.QuotaMetricGPU,
+	core.QuotaMetricMemory,
+	core.QuotaMetricStorageStandard,
+	core.QuotaMetricStoragePremium,
+	core.QuotaMetricLoadbalancer,
+}
+
+// ShootQuotaResources computes the resources which are accounted to the Quotas of the given Shoot. The maximum number
+// of worker nodes is used for the calculation, i.e., the result reflects the resources the Shoot may allocate at most.
+func ShootQuotaResources(shoot *core.Shoot, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec) (corev1.ResourceList, error) {
+	var (
+		countLB   int64 = 1
+		resources       = make(corev1.ResourceList)
+	)
+
+	for _, worker := range shootQuotaWorkers(shoot, cloudProfileSpec) {
+		var (
+			machineType *gardencorev1beta1.MachineType
+			volumeType  *gardencorev1beta1.VolumeType
+		)
+
+		// Get the proper machineType
+		for _, e := range cloudProfileSpec.MachineTypes {
+			element := e
+			if element.Name == worker.Machine.Type {
+				machineType = &element
+				break
+			}
+		}
+		if machineType == nil {
+			return nil, fmt.Errorf("machineType %s not found in CloudProfile", worker.Machine.Type)
+		}
+
+		if worker.Volume != nil {
+			if machineType.Storage != nil {
+				volumeType = &gardencorev1beta1.VolumeType{
+					Class: machineType.Storage.Class,
+				}
+			} else {
+				// Get the proper VolumeType
+				for _, e := range cloudProfileSpec.VolumeTypes {
+					element := e
+					if worker.Volume.Type != nil && element.Name == *worker.Volume.Type {
+						volumeType = &element
+						break
+					}
+				}
+			}
+		}
+		if volumeType == nil {
+			return nil, fmt.Errorf("VolumeType %s not found in CloudProfile", worker.Machine.Type)
+		}
+
+		// For now we always use the max. amount of resources for quota calculation
+		resources[core.QuotaMetricCPU] = sumQuantity(resources[core.QuotaMetricCPU], multiplyQuantity(machineType.CPU, worker.Maximum))
+		resources[core.QuotaMetricGPU] = sumQuantity(resources[core.QuotaMetricGPU], multiplyQuantity(machineType.GPU, worker.Maximum))
+		resources[core.QuotaMetricMemory] = sumQuantity(resources[core.QuotaMetricMemory], multiplyQuantity(machineType.Memory, worker.Maximum))
+
+		size, _ := resource.ParseQuantity("0Gi")
+		if worker.Volume != nil {
+			var err error
+			size, err = resource.ParseQuantity(worker.Volume.VolumeSize)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		switch volumeType.Class {
+		case core.VolumeClassStandard:
+			resources[core.QuotaMetricStorageStandard] = sumQuantity(resources[core.QuotaMetricStorageStandard], multiplyQuantity(size, worker.Maximum))
+		case core.VolumeClassPremium:
+			resources[core.QuotaMetricStoragePremium] = sumQuantity(resources[core.QuotaMetricStoragePremium], multiplyQuantity(size, worker.Maximum))
+		default:
+			return nil, fmt.Errorf("unknown volumeType class %s", volumeType.Class)
+		}
+	}
+
+	if gardencorehelper.NginxIngressEnabled(shoot.Spec.Addons) {
+		countLB++
+	}
+	resources[core.QuotaMetricLoadbalancer] = *resource.NewQuantity(countLB, resource.DecimalSI)
+
+	return resources, nil
+}
+
+// QuotaMetricsAboveWarningThreshold returns the metrics of the given Quota for which the given used resources exceed the
+// warning threshold percentage of the limit. It returns nil if the Quota does not specify a warning threshold.
+func QuotaMetricsAboveWarningThreshold(quota *gardencorev1beta1.Quota, used corev1.ResourceList) []corev1.ResourceName {
+	if quota.Spec.WarningThresholdPercentage == nil {
+		return nil
+	}
+
+	var metrics []corev1.ResourceName
+	for _, metric := range QuotaMetricNames {
+		limit, ok := quota.Spec.Metrics[metric]
+		if !ok {
+			continue
+		}
+
+		usage := used[metric]
+		if usage.AsApproximateFloat64() > limit.AsApproximateFloat64()*float64(*quota.Spec.WarningThresholdPercentage)/100 {
+			metrics = append(metrics, metric)
+		}
+	}
+
+	return metrics
+}
+
+func shootQuotaWorkers(shoot *core.Shoot, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec) []core.Worker {
+	workers := make([]core.Worker, 0, len(shoot.Spec.Provider.Workers))
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		workerCopy := worker.DeepCopy()
+
+		if worker.Volume == nil {
+			for _, machineType := range cloudProfileSpec.MachineTypes {
+				if worker.Machine.Type == machineType.Name && machineType.Storage != nil && machineType.Storage.StorageSize != nil {
+					workerCopy.Volume = &core.Volume{
+						Type:       &machineType.Storage.Type,
+						VolumeSize: machineType.Storage.StorageSize.String(),
+					}
+				}
+			}
+		}
+
+		workers = append(workers, *workerCopy)
+	}
+
+	return workers
+}
+
+func sumQuantity(values ...resource.Quantity) resource.Quantity {
+	res := resource.Quantity{}
+	for _, v := range values {
+		res.Add(v)
+	}
+	return res
+}
+
+func multiplyQuantity(quantity resource.Quantity, multiplier int32) resource.Quantity {
+	res := resource.Quantity{}
+	for i := 0; i < int(multiplier); i++ {
+		res.Add(quantity)
+	}
+	return res
+}
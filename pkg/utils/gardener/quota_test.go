@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var _ = Describe("Quota", func() {
+	Describe("#ShootQuotaResources", func() {
+		var (
+			shoot            *core.Shoot
+			cloudProfileSpec *gardencorev1beta1.CloudProfileSpec
+		)
+
+		BeforeEach(func() {
+			shoot = &core.Shoot{
+				Spec: core.ShootSpec{
+					Provider: core.Provider{
+						Workers: []core.Worker{
+							{
+								Name:    "worker-1",
+								Machine: core.Machine{Type: "small"},
+								Maximum: 2,
+								Volume:  &core.Volume{Type: ptr.To("standard"), VolumeSize: "20Gi"},
+							},
+							{
+								Name:    "worker-2",
+								Machine: core.Machine{Type: "large"},
+								Maximum: 1,
+							},
+						},
+					},
+				},
+			}
+
+			cloudProfileSpec = &gardencorev1beta1.CloudProfileSpec{
+				MachineTypes: []gardencorev1beta1.MachineType{
+					{Name: "small", CPU: resource.MustParse("2"), GPU: resource.MustParse("0"), Memory: resource.MustParse("4Gi")},
+					{Name: "large", CPU: resource.MustParse("8"), GPU: resource.MustParse("1"), Memory: resource.MustParse("32Gi"), Storage: &gardencorev1beta1.MachineTypeStorage{
+						Class:       gardencorev1beta1.VolumeClassPremium,
+						Type:        "premium",
+						StorageSize: ptr.To(resource.MustParse("50Gi")),
+					}},
+				},
+				VolumeTypes: []gardencorev1beta1.VolumeType{
+					{Name: "standard", Class: gardencorev1beta1.VolumeClassStandard},
+				},
+			}
+		})
+
+		It("should compute the resources for the maximum number of workers", func() {
+			resources, err := ShootQuotaResources(shoot, cloudProfileSpec)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(resources.Cpu().String()).To(Equal("12"))
+			Expect(resources.Memory().String()).To(Equal("40Gi"))
+			Expect(resources.Name(core.QuotaMetricGPU, resource.DecimalSI).String()).To(Equal("1"))
+			Expect(resources.Name(core.QuotaMetricStorageStandard, resource.BinarySI).String()).To(Equal("40Gi"))
+			Expect(resources.Name(core.QuotaMetricStoragePremium, resource.BinarySI).String()).To(Equal("50Gi"))
+			Expect(resources.Name(core.QuotaMetricLoadbalancer, resource.DecimalSI).String()).To(Equal("1"))
+		})
+
+		It("should count an additional load balancer if the nginx-ingress addon is enabled", func() {
+			shoot.Spec.Addons = &core.Addons{NginxIngress: &core.NginxIngress{Addon: core.Addon{Enabled: true}}}
+
+			resources, err := ShootQuotaResources(shoot, cloudProfileSpec)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(resources.Name(core.QuotaMetricLoadbalancer, resource.DecimalSI).String()).To(Equal("2"))
+		})
+
+		It("should fail if the machine type is unknown", func() {
+			shoot.Spec.Provider.Workers[0].Machine.Type = "unknown"
+
+			_, err := ShootQuotaResources(shoot, cloudProfileSpec)
+			Expect(err).To(MatchError(ContainSubstring("machineType unknown not found in CloudProfile")))
+		})
+	})
+
+	Describe("#QuotaMetricsAboveWarningThreshold", func() {
+		var (
+			quota *gardencorev1beta1.Quota
+			used  corev1.ResourceList
+		)
+
+		BeforeEach(func() {
+			quota = &gardencorev1beta1.Quota{
+				Spec: gardencorev1beta1.QuotaSpec{
+					Metrics: corev1.ResourceList{
+						core.QuotaMetricCPU:    resource.MustParse("100"),
+						core.QuotaMetricMemory: resource.MustParse("100Gi"),
+					},
+					WarningThresholdPercentage: ptr.To[int32](80),
+				},
+			}
+			used = corev1.ResourceList{
+				core.QuotaMetricCPU:          resource.MustParse("81"),
+				core.QuotaMetricMemory:       resource.MustParse("80Gi"),
+				core.QuotaMetricLoadbalancer: resource.MustParse("10"),
+			}
+		})
+
+		It("should return the metrics above the warning threshold", func() {
+			Expect(QuotaMetricsAboveWarningThreshold(quota, used)).To(ConsistOf(core.QuotaMetricCPU))
+		})
+
+		It("should return nothing if no warning threshold is configured", func() {
+			quota.Spec.WarningThresholdPercentage = nil
+
+			Expect(QuotaMetricsAboveWarningThreshold(quota, used)).To(BeEmpty())
+		})
+	})
+})
@@ -238,6 +238,13 @@ func Architecture(arch string) FindOptionFunc {
 	}
 }
 
+// RegistryMirrors sets the Mirrors of the FindOptions to the given mirrors.
+func RegistryMirrors(mirrors Mirrors) FindOptionFunc {
+	return func(options *FindOptions) {
+		options.Mirrors = mirrors
+	}
+}
+
 var r = regexp.MustCompile(`^(v?[0-9]+\.[0-9]+\.[0-9]+|=)`)
 
 func checkVersionConstraint(constraint, version *string) (score int, ok bool, err error) {
@@ -316,6 +323,7 @@ func match(source *ImageSource, name string, opts *FindOptions) (score int, ok b
 // stated in the image definition.
 // In case multiple images match the search, the first which was found is returned.
 // In case no image was found, an error is returned.
+// The repository of the returned image is rewritten to the given registry mirrors, if any.
 func (v ImageVector) FindImage(name string, opts ...FindOptionFunc) (*Image, error) {
 	o := &FindOptions{}
 	o = o.ApplyOptions(opts)
@@ -343,7 +351,9 @@ func (v ImageVector) FindImage(name string, opts ...FindOptionFunc) (*Image, err
 		return nil, fmt.Errorf("could not find image %q opts %v", name, o)
 	}
 
-	return bestCandidate.ToImage(o.TargetVersion), nil
+	image := bestCandidate.ToImage(o.TargetVersion)
+	o.Mirrors.apply(image)
+	return image, nil
 }
 
 // FindImages returns an image map with the given <names> from the sources in the image vector.
@@ -611,6 +611,16 @@ images:
 				_, err := FindImages(v, []string{image1Name, image2Name, image3Name})
 				Expect(err).To(HaveOccurred())
 			})
+
+			It("should rewrite the images to the given mirrors", func() {
+				v := ImageVector{image1Src1, image2Src1}
+
+				images, err := FindImages(v, []string{image1Name, image2Name}, RegistryMirrors(Mirrors{{Source: *repo1, Mirror: "mirror.example.com/repo1"}}))
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(images[image1Name].String()).To(Equal("mirror.example.com/repo1:" + tag1))
+				Expect(images[image2Name]).To(Equal(image2Src1.ToImage(nil)))
+			})
 		})
 	})
 
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagevector
+
+import (
+	"strings"
+)
+
+// Mirror maps a source registry or repository prefix to the location of its mirror.
+type Mirror struct {
+	// Source is the registry or repository prefix which is mirrored, e.g. `europe-docker.pkg.dev/gardener-project`.
+	Source string
+	// Mirror is the registry or repository prefix replacing the source prefix, e.g. `registry.example.com/gardener`.
+	Mirror string
+}
+
+// Mirrors is a list of registry mirrors.
+type Mirrors []Mirror
+
+// Rewrite replaces the repository of the given image reference with the location of the mirror with the longest
+// matching source prefix. Tags and digests are preserved. References which do not match any source or which already
+// point to a mirror are returned unchanged.
+func (m Mirrors) Rewrite(ref string) string {
+	repository, suffix := splitReference(ref)
+
+	var source, mirror string
+	for _, mm := range m {
+		src, dst := strings.TrimSuffix(mm.Source, "/"), strings.TrimSuffix(mm.Mirror, "/")
+		if hasRepositoryPrefix(repository, dst) {
+			return ref
+		}
+		if hasRepositoryPrefix(repository, src) && len(src) > len(source) {
+			source, mirror = src, dst
+		}
+	}
+
+	if source == "" {
+		return ref
+	}
+	return mirror + strings.TrimPrefix(repository, source) + suffix
+}
+
+// apply rewrites the repository or reference of the given image.
+func (m Mirrors) apply(image *Image) {
+	if image.Ref != nil {
+		ref := m.Rewrite(*image.Ref)
+		image.Ref = &ref
+	}
+	if image.Repository != nil {
+		repository := m.Rewrite(*image.Repository)
+		image.Repository = &repository
+	}
+}
+
+// splitReference splits the given image reference into the repository and the tag and/or digest suffix including
+// its delimiter.
+func splitReference(ref string) (string, string) {
+	end := len(ref)
+	if i := strings.Index(ref, "@"); i >= 0 {
+		end = i
+	}
+	if i := strings.LastIndex(ref[:end], ":"); i > strings.LastIndex(ref[:end], "/") {
+		end = i
+	}
+	return ref[:end], ref[end:]
+}
+
+func hasRepositoryPrefix(repository, prefix string) bool {
+	return prefix != "" && (repository == prefix || strings.HasPrefix(repository, prefix+"/"))
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagevector_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+var _ = Describe("Mirrors", func() {
+	mirrors := Mirrors{
+		{Source: "europe-docker.pkg.dev/gardener-project", Mirror: "registry.example.com/gardener"},
+		{Source: "europe-docker.pkg.dev/gardener-project/releases/charts", Mirror: "charts.example.com"},
+		{Source: "registry.k8s.io/", Mirror: "registry.example.com/k8s/"},
+	}
+
+	DescribeTable("#Rewrite",
+		func(ref, expected string) {
+			Expect(mirrors.Rewrite(ref)).To(Equal(expected))
+		},
+
+		Entry("repository without tag", "europe-docker.pkg.dev/gardener-project/releases/gardener/apiserver", "registry.example.com/gardener/releases/gardener/apiserver"),
+		Entry("reference with tag", "europe-docker.pkg.dev/gardener-project/releases/gardener/apiserver:v1.2.3", "registry.example.com/gardener/releases/gardener/apiserver:v1.2.3"),
+		Entry("reference with digest", "europe-docker.pkg.dev/gardener-project/releases/gardener/apiserver@sha256:7a855a6d69033dd3240d9648e8bd46a67a528059158e098c7794ac9227735b4a", "registry.example.com/gardener/releases/gardener/apiserver@sha256:7a855a6d69033dd3240d9648e8bd46a67a528059158e098c7794ac9227735b4a"),
+		Entry("reference with tag and digest", "europe-docker.pkg.dev/gardener-project/releases/gardener/apiserver:v1.2.3@sha256:7a855a6d69033dd3240d9648e8bd46a67a528059158e098c7794ac9227735b4a", "registry.example.com/gardener/releases/gardener/apiserver:v1.2.3@sha256:7a855a6d69033dd3240d9648e8bd46a67a528059158e098c7794ac9227735b4a"),
+		Entry("longest matching source", "europe-docker.pkg.dev/gardener-project/releases/charts/gardener/gardenlet:v1.2.3", "charts.example.com/gardener/gardenlet:v1.2.3"),
+		Entry("source with trailing slash", "registry.k8s.io/pause:3.10", "registry.example.com/k8s/pause:3.10"),
+		Entry("source only matching on path boundaries", "europe-docker.pkg.dev/gardener-project-other/foo:v1", "europe-docker.pkg.dev/gardener-project-other/foo:v1"),
+		Entry("registry with port", "localhost:5001/foo:v1", "localhost:5001/foo:v1"),
+		Entry("non-matching reference", "quay.io/prometheus/prometheus:v2.0.0", "quay.io/prometheus/prometheus:v2.0.0"),
+		Entry("already mirrored reference", "registry.example.com/gardener/releases/gardener/apiserver:v1.2.3", "registry.example.com/gardener/releases/gardener/apiserver:v1.2.3"),
+	)
+
+	It("should not rewrite references without mirrors", func() {
+		Expect(Mirrors(nil).Rewrite("registry.k8s.io/pause:3.10")).To(Equal("registry.k8s.io/pause:3.10"))
+	})
+})
@@ -54,6 +54,7 @@ type FindOptions struct {
 	RuntimeVersion *string
 	TargetVersion  *string
 	Architecture   *string
+	Mirrors        Mirrors
 }
 
 // FindOptionFunc is a function that mutates FindOptions.
@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// celCostLimit limits the cost of evaluating a CEL rule to prevent expensive expressions from blocking the caller.
+const celCostLimit = 1_000_000
+
+// CELRule is a compiled CEL expression which is evaluated against an object. The object is accessible via the
+// `object` variable. The expression must evaluate to either a bool or a string.
+type CELRule struct {
+	expression string
+	program    cel.Program
+}
+
+// NewCELRule compiles the given CEL expression.
+func NewCELRule(expression string) (*CELRule, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("object", cel.DynType),
+		ext.Strings(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("failed compiling CEL expression: %w", issues.Err())
+	}
+
+	if outputType := ast.OutputType(); !outputType.IsExactType(cel.BoolType) && !outputType.IsExactType(cel.StringType) && !outputType.IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("CEL expression must evaluate to bool or string, got %s", outputType)
+	}
+
+	program, err := env.Program(ast, cel.CostLimit(celCostLimit))
+	if err != nil {
+		return nil, fmt.Errorf("failed creating CEL program: %w", err)
+	}
+
+	return &CELRule{expression: expression, program: program}, nil
+}
+
+// String returns the CEL expression of the rule.
+func (r *CELRule) String() string {
+	return r.expression
+}
+
+// evaluate evaluates the rule for the given object and returns either a bool or a string.
+func (r *CELRule) evaluate(obj client.Object) (any, error) {
+	var content map[string]any
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		content = u.Object
+	} else {
+		var err error
+		if content, err = runtime.DefaultUnstructuredConverter.ToUnstructured(obj); err != nil {
+			return nil, err
+		}
+	}
+
+	value, _, err := r.program.Eval(map[string]any{"object": content})
+	if err != nil {
+		return nil, fmt.Errorf("failed evaluating CEL expression %q: %w", r.expression, err)
+	}
+
+	switch v := value.Value().(type) {
+	case bool, string:
+		return v, nil
+	}
+	return nil, fmt.Errorf("CEL expression %q evaluated to %s, expected bool or string", r.expression, value.Type().TypeName())
+}
+
+// ErrCELRuleEvaluation is wrapped by the errors returned by CheckWithCELRule and IsProgressingWithCELRule in case the
+// rule could not be evaluated (which is different from a failed check).
+var ErrCELRuleEvaluation = errors.New("error evaluating CEL rule")
+
+// CheckWithCELRule checks whether the given object is healthy according to the given rule. The object is healthy if
+// the rule evaluates to true or to an empty string. A non-empty string is used as reason why the object is unhealthy.
+func CheckWithCELRule(obj client.Object, rule *CELRule) error {
+	value, err := rule.evaluate(obj)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCELRuleEvaluation, err)
+	}
+
+	switch v := value.(type) {
+	case bool:
+		if !v {
+			return fmt.Errorf("health rule %q is not satisfied", rule.expression)
+		}
+	case string:
+		if v != "" {
+			return errors.New(v)
+		}
+	}
+	return nil
+}
+
+// IsProgressingWithCELRule returns whether the given object is progressing according to the given rule. The object is
+// progressing if the rule evaluates to true or to a non-empty string, which is then used as reason.
+func IsProgressingWithCELRule(obj client.Object, rule *CELRule) (bool, string, error) {
+	value, err := rule.evaluate(obj)
+	if err != nil {
+		return false, "", fmt.Errorf("%w: %w", ErrCELRuleEvaluation, err)
+	}
+
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return true, fmt.Sprintf("progressing rule %q is satisfied", rule.expression), nil
+		}
+	case string:
+		if v != "" {
+			return true, v, nil
+		}
+	}
+	return false, "", nil
+}
@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package health_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+var _ = Describe("CEL", func() {
+	var obj *unstructured.Unstructured
+
+	BeforeEach(func() {
+		obj = &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "example.com/v1",
+			"kind":       "Foo",
+			"metadata":   map[string]any{"name": "foo", "generation": int64(2)},
+			"status":     map[string]any{"phase": "Pending", "observedGeneration": int64(1)},
+		}}
+	})
+
+	Describe("#NewCELRule", func() {
+		It("should compile valid expressions", func() {
+			rule, err := health.NewCELRule(`object.status.phase == "Ready"`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rule.String()).To(Equal(`object.status.phase == "Ready"`))
+		})
+
+		It("should fail for invalid expressions", func() {
+			_, err := health.NewCELRule(`object.status.`)
+			Expect(err).To(MatchError(ContainSubstring("failed compiling CEL expression")))
+		})
+
+		It("should fail for expressions not evaluating to bool or string", func() {
+			_, err := health.NewCELRule(`1 + 2`)
+			Expect(err).To(MatchError("CEL expression must evaluate to bool or string, got int"))
+		})
+	})
+
+	Describe("#CheckWithCELRule", func() {
+		It("should succeed if a bool expression evaluates to true", func() {
+			rule, err := health.NewCELRule(`object.status.phase == "Pending"`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(health.CheckWithCELRule(obj, rule)).To(Succeed())
+		})
+
+		It("should fail if a bool expression evaluates to false", func() {
+			rule, err := health.NewCELRule(`object.status.phase == "Ready"`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(health.CheckWithCELRule(obj, rule)).To(MatchError(`health rule "object.status.phase == \"Ready\"" is not satisfied`))
+		})
+
+		It("should use a non-empty string as reason", func() {
+			rule, err := health.NewCELRule(`object.status.phase == "Ready" ? "" : "phase is " + object.status.phase`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(health.CheckWithCELRule(obj, rule)).To(MatchError("phase is Pending"))
+
+			obj.Object["status"].(map[string]any)["phase"] = "Ready"
+			Expect(health.CheckWithCELRule(obj, rule)).To(Succeed())
+		})
+
+		It("should evaluate rules for typed objects", func() {
+			rule, err := health.NewCELRule(`object.status.phase == "Running"`)
+			Expect(err).NotTo(HaveOccurred())
+
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "foo"}, Status: corev1.PodStatus{Phase: corev1.PodRunning}}
+			Expect(health.CheckWithCELRule(pod, rule)).To(Succeed())
+		})
+
+		It("should return an evaluation error if a field does not exist", func() {
+			rule, err := health.NewCELRule(`object.status.ready`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(health.CheckWithCELRule(obj, rule)).To(MatchError(health.ErrCELRuleEvaluation))
+		})
+
+		It("should return an evaluation error if a dynamic expression does not evaluate to bool or string", func() {
+			rule, err := health.NewCELRule(`object.metadata.generation`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(health.CheckWithCELRule(obj, rule)).To(And(
+				MatchError(health.ErrCELRuleEvaluation),
+				MatchError(ContainSubstring("expected bool or string")),
+			))
+		})
+	})
+
+	Describe("#IsProgressingWithCELRule", func() {
+		It("should report progressing if a bool expression evaluates to true", func() {
+			rule, err := health.NewCELRule(`object.status.observedGeneration < object.metadata.generation`)
+			Expect(err).NotTo(HaveOccurred())
+
+			progressing, reason, err := health.IsProgressingWithCELRule(obj, rule)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progressing).To(BeTrue())
+			Expect(reason).To(Equal(`progressing rule "object.status.observedGeneration < object.metadata.generation" is satisfied`))
+		})
+
+		It("should use a non-empty string as reason", func() {
+			rule, err := health.NewCELRule(`object.status.observedGeneration < object.metadata.generation ? "observed generation outdated" : ""`)
+			Expect(err).NotTo(HaveOccurred())
+
+			progressing, reason, err := health.IsProgressingWithCELRule(obj, rule)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progressing).To(BeTrue())
+			Expect(reason).To(Equal("observed generation outdated"))
+
+			obj.Object["status"].(map[string]any)["observedGeneration"] = int64(2)
+			progressing, reason, err = health.IsProgressingWithCELRule(obj, rule)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progressing).To(BeFalse())
+			Expect(reason).To(BeEmpty())
+		})
+
+		It("should return an evaluation error", func() {
+			rule, err := health.NewCELRule(`object.status.foo`)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, err = health.IsProgressingWithCELRule(obj, rule)
+			Expect(err).To(MatchError(health.ErrCELRuleEvaluation))
+		})
+	})
+})
@@ -4,36 +4,261 @@
 
 package oci
 
-import "sync"
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
 
-var defaultCache = newCache()
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+)
 
-type cacher interface {
+// DefaultCacheMaxSize is the default maximum total size in bytes of the blobs kept in the cache.
+const DefaultCacheMaxSize int64 = 100 << 20
+
+// CacheOptions contains options for the cache of pulled Helm charts.
+type CacheOptions struct {
+	// MaxSize is the maximum total size in bytes of the blobs kept in memory. If Directory is set, the same limit applies
+	// to the blobs persisted in it.
+	MaxSize int64
+	// Directory is the path of a directory in which the blobs are persisted, so that they survive restarts. If empty,
+	// blobs are only kept in memory.
+	Directory string
+}
+
+// Cache caches the blobs of pulled artifacts.
+type Cache interface {
+	// Get returns the blob stored for the given key and whether it was found.
 	Get(key string) ([]byte, bool)
-	Set(key string, blob []byte)
+	// Set stores the given blob with the given digest for the given key.
+	Set(key string, digest gcrv1.Hash, blob []byte)
 }
 
-func newCache() *cache {
+// NewCache returns a Cache which evicts the least recently used blobs once their total size exceeds the configured
+// maximum size. It is supposed to be created once per component and shared by all its HelmRegistry instances.
+func NewCache(opts CacheOptions) (Cache, error) {
+	if opts.MaxSize <= 0 {
+		return nil, fmt.Errorf("maximum cache size must be positive, got %d", opts.MaxSize)
+	}
+	if opts.Directory != "" {
+		if err := os.MkdirAll(opts.Directory, 0700); err != nil {
+			return nil, fmt.Errorf("failed creating cache directory %s: %w", opts.Directory, err)
+		}
+	}
+
+	return newCache(opts.MaxSize, opts.Directory), nil
+}
+
+func newCache(maxSize int64, dir string) *cache {
 	return &cache{
-		items: map[string][]byte{},
+		maxSize: maxSize,
+		dir:     dir,
+		entries: list.New(),
+		items:   map[string]*list.Element{},
 	}
 }
 
-// cache is a basic key-value cache i.e. a map protected by a mutex. Items are never removed from the cache.
+// cache is a key-value cache whose items are evicted in least-recently-used order once their total size exceeds the
+// maximum size. If a directory is configured, items are additionally persisted in it and loaded from it on cache
+// misses. Since the keys contain the digest of the artifacts, persisted items never become stale. Each persisted item
+// contains the digest of its blob, which is checked when loading it, so that corrupted or tampered files are discarded.
 type cache struct {
-	mu    sync.RWMutex
-	items map[string][]byte
+	maxSize int64
+	dir     string
+
+	mu      sync.Mutex
+	size    int64
+	entries *list.List
+	items   map[string]*list.Element
+}
+
+type cacheEntry struct {
+	key    string
+	digest gcrv1.Hash
+	blob   []byte
 }
 
 func (c *cache) Get(key string) ([]byte, bool) {
-	c.mu.RLock()
-	blob, found := c.items[key]
-	c.mu.RUnlock()
-	return blob, found
+	c.mu.Lock()
+	if elem, found := c.items[key]; found {
+		c.entries.MoveToFront(elem)
+		c.mu.Unlock()
+		return elem.Value.(*cacheEntry).blob, true
+	}
+	c.mu.Unlock()
+
+	if c.dir == "" {
+		return nil, false
+	}
+
+	path := c.path(key)
+	digest, blob, err := load(path)
+	if err != nil {
+		// The file is either missing or its content does not match the stored digest. In the latter case, it is removed
+		// so that the blob is pulled and persisted again.
+		if !os.IsNotExist(err) {
+			_ = os.Remove(path)
+		}
+		return nil, false
+	}
+	// mark the file as recently used, so that it is pruned last
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+
+	c.mu.Lock()
+	c.add(key, digest, blob)
+	c.mu.Unlock()
+	return blob, true
 }
 
-func (c *cache) Set(key string, blob []byte) {
+func (c *cache) Set(key string, digest gcrv1.Hash, blob []byte) {
 	c.mu.Lock()
-	c.items[key] = blob
+	c.add(key, digest, blob)
 	c.mu.Unlock()
+
+	if c.dir != "" {
+		// Persisting is best effort only, the blob can be pulled again if it is missing.
+		_ = c.persist(key, digest, blob)
+	}
+}
+
+// add adds the given blob to the in-memory items and evicts the least recently used items exceeding the maximum size.
+// Blobs larger than the maximum size are not kept in memory. The caller must hold the lock.
+func (c *cache) add(key string, digest gcrv1.Hash, blob []byte) {
+	if elem, found := c.items[key]; found {
+		c.size -= int64(len(elem.Value.(*cacheEntry).blob))
+		c.entries.Remove(elem)
+		delete(c.items, key)
+	}
+
+	if int64(len(blob)) > c.maxSize {
+		return
+	}
+
+	c.items[key] = c.entries.PushFront(&cacheEntry{key: key, digest: digest, blob: blob})
+	c.size += int64(len(blob))
+
+	for c.size > c.maxSize {
+		oldest := c.entries.Back()
+		entry := oldest.Value.(*cacheEntry)
+		c.entries.Remove(oldest)
+		delete(c.items, entry.key)
+		c.size -= int64(len(entry.blob))
+	}
+}
+
+// persist writes the digest and the blob atomically to the cache directory and prunes the least recently used files
+// exceeding the maximum size afterwards. The first line of the file contains the digest, the rest is the blob.
+func (c *cache) persist(key string, digest gcrv1.Hash, blob []byte) error {
+	if int64(len(blob)) > c.maxSize {
+		return nil
+	}
+
+	tmpFile, err := os.CreateTemp(c.dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(append([]byte(digest.String()+"\n"), blob...)); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFile.Name(), c.path(key)); err != nil {
+		return err
+	}
+
+	return c.prune()
+}
+
+func (c *cache) prune() error {
+	dirEntries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return err
+	}
+
+	type file struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+
+	var (
+		files []file
+		size  int64
+	)
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() || !isCacheFileName(dirEntry.Name()) {
+			continue
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, file{path: filepath.Join(c.dir, dirEntry.Name()), size: info.Size(), modTime: info.ModTime()})
+		size += info.Size()
+	}
+
+	slices.SortFunc(files, func(a, b file) int { return a.modTime.Compare(b.modTime) })
+	for _, f := range files {
+		if size <= c.maxSize {
+			break
+		}
+		if err := os.Remove(f.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		size -= f.size
+	}
+
+	return nil
+}
+
+// load reads the digest and the blob from the given file and returns an error if the blob does not match the digest.
+func load(path string) (gcrv1.Hash, []byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return gcrv1.Hash{}, nil, err
+	}
+
+	header, blob, found := bytes.Cut(content, []byte("\n"))
+	if !found {
+		return gcrv1.Hash{}, nil, fmt.Errorf("cache file %s does not contain a digest", path)
+	}
+	digest, err := gcrv1.NewHash(string(header))
+	if err != nil {
+		return gcrv1.Hash{}, nil, fmt.Errorf("cache file %s contains an invalid digest: %w", path, err)
+	}
+	actual, _, err := gcrv1.SHA256(bytes.NewReader(blob))
+	if err != nil {
+		return gcrv1.Hash{}, nil, err
+	}
+	if actual != digest {
+		return gcrv1.Hash{}, nil, fmt.Errorf("digest %s of cache file %s does not match the expected digest %s", actual, path, digest)
+	}
+
+	return digest, blob, nil
+}
+
+// path returns the path of the file in which the blob for the given key is persisted. Keys are hashed since they
+// contain characters which are not allowed in file names.
+func (c *cache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:]))
+}
+
+func isCacheFileName(name string) bool {
+	if len(name) != 2*sha256.Size {
+		return false
+	}
+	_, err := hex.DecodeString(name)
+	return err == nil
 }
@@ -5,23 +5,147 @@
 package oci
 
 import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("cache", func() {
+	Describe("#NewCache", func() {
+		It("should fail if the maximum size is not positive", func() {
+			_, err := NewCache(CacheOptions{})
+			Expect(err).To(MatchError(ContainSubstring("maximum cache size must be positive")))
+		})
+
+		It("should create the cache directory", func() {
+			dir := filepath.Join(GinkgoT().TempDir(), "charts")
+
+			c, err := NewCache(CacheOptions{MaxSize: DefaultCacheMaxSize, Directory: dir})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c).NotTo(BeNil())
+			Expect(dir).To(BeADirectory())
+		})
+	})
+
 	It("should store and retrieve values", func() {
 		key := "foo"
 		data := []byte("bar")
-		c := newCache()
+		c := newCache(DefaultCacheMaxSize, "")
 
 		_, found := c.Get(key)
 		Expect(found).To(BeFalse())
 
-		c.Set(key, data)
+		c.Set(key, digestOf(data), data)
 
 		out, found := c.Get(key)
 		Expect(found).To(BeTrue())
 		Expect(out).To(Equal(data))
 	})
+
+	It("should evict the least recently used values when exceeding the maximum size", func() {
+		c := newCache(6, "")
+
+		c.Set("foo", digestOf([]byte("foo")), []byte("foo"))
+		c.Set("bar", digestOf([]byte("bar")), []byte("bar"))
+		_, found := c.Get("foo")
+		Expect(found).To(BeTrue())
+
+		c.Set("baz", digestOf([]byte("baz")), []byte("baz"))
+
+		_, found = c.Get("bar")
+		Expect(found).To(BeFalse())
+		_, found = c.Get("foo")
+		Expect(found).To(BeTrue())
+		_, found = c.Get("baz")
+		Expect(found).To(BeTrue())
+		Expect(c.size).To(BeEquivalentTo(6))
+	})
+
+	It("should account the size correctly when overwriting values", func() {
+		c := newCache(6, "")
+
+		c.Set("foo", digestOf([]byte("foo")), []byte("foo"))
+		c.Set("foo", digestOf([]byte("foobar")), []byte("foobar"))
+
+		Expect(c.size).To(BeEquivalentTo(6))
+		Expect(c.entries.Len()).To(Equal(1))
+	})
+
+	It("should not store values larger than the maximum size", func() {
+		c := newCache(2, "")
+
+		c.Set("foo", digestOf([]byte("foo")), []byte("foo"))
+
+		_, found := c.Get("foo")
+		Expect(found).To(BeFalse())
+		Expect(c.size).To(BeZero())
+	})
+
+	Context("with directory", func() {
+		var dir string
+
+		BeforeEach(func() {
+			dir = GinkgoT().TempDir()
+		})
+
+		It("should load persisted values after a restart", func() {
+			newCache(DefaultCacheMaxSize, dir).Set("foo", digestOf([]byte("bar")), []byte("bar"))
+
+			c := newCache(DefaultCacheMaxSize, dir)
+			Expect(c.entries.Len()).To(BeZero())
+
+			out, found := c.Get("foo")
+			Expect(found).To(BeTrue())
+			Expect(out).To(Equal([]byte("bar")))
+			Expect(c.entries.Len()).To(Equal(1))
+		})
+
+		It("should discard persisted values not matching their digest", func() {
+			newCache(DefaultCacheMaxSize, dir).Set("foo", digestOf([]byte("bar")), []byte("bar"))
+
+			c := newCache(DefaultCacheMaxSize, dir)
+			content, err := os.ReadFile(c.path("foo"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.WriteFile(c.path("foo"), bytes.Replace(content, []byte("bar"), []byte("baz"), 1), 0600)).To(Succeed())
+
+			_, found := c.Get("foo")
+			Expect(found).To(BeFalse())
+			Expect(c.path("foo")).NotTo(BeAnExistingFile())
+		})
+
+		It("should discard persisted values without digest", func() {
+			c := newCache(DefaultCacheMaxSize, dir)
+			Expect(os.WriteFile(c.path("foo"), []byte("bar"), 0600)).To(Succeed())
+
+			_, found := c.Get("foo")
+			Expect(found).To(BeFalse())
+			Expect(c.path("foo")).NotTo(BeAnExistingFile())
+		})
+
+		It("should prune the least recently used files when exceeding the maximum size", func() {
+			// each file contains the digest line of 72 bytes and the blob of 3 bytes
+			c := newCache(2*75, dir)
+
+			c.Set("foo", digestOf([]byte("foo")), []byte("foo"))
+			c.Set("bar", digestOf([]byte("bar")), []byte("bar"))
+			c.Set("baz", digestOf([]byte("baz")), []byte("baz"))
+
+			files, err := os.ReadDir(dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(HaveLen(2))
+
+			_, err = os.Stat(c.path("baz"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
 })
+
+func digestOf(blob []byte) gcrv1.Hash {
+	digest, _, err := gcrv1.SHA256(bytes.NewReader(blob))
+	Expect(err).NotTo(HaveOccurred())
+	return digest
+}
@@ -20,6 +20,7 @@ import (
 
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
 const (
@@ -42,22 +43,42 @@ type Interface interface {
 
 // HelmRegistry can pull OCI Helm Charts.
 type HelmRegistry struct {
-	cache  cacher
-	client client.Client
+	cache    Cache
+	client   client.Client
+	mirrors  imagevector.Mirrors
+	verifier *Verifier
+}
+
+// HelmRegistryOptions contains the options for a HelmRegistry.
+type HelmRegistryOptions struct {
+	// Cache is used for the pulled Helm charts. It should be shared by all HelmRegistry instances of a component. If it
+	// is nil, a new in-memory cache with the default maximum size is used.
+	Cache Cache
+	// Verifier verifies the signatures of the pulled Helm charts. If it is nil, signatures are not verified.
+	Verifier *Verifier
+	// Mirrors are the registry mirrors from which the Helm charts are pulled instead of their source registries.
+	Mirrors imagevector.Mirrors
 }
 
 // NewHelmRegistry creates a new HelmRegistry.
 // The client is used to get pull secrets if needed.
-func NewHelmRegistry(c client.Client) *HelmRegistry {
+func NewHelmRegistry(c client.Client, opts HelmRegistryOptions) *HelmRegistry {
+	cache := opts.Cache
+	if cache == nil {
+		cache = newCache(DefaultCacheMaxSize, "")
+	}
+
 	return &HelmRegistry{
-		cache:  defaultCache,
-		client: c,
+		cache:    cache,
+		client:   c,
+		mirrors:  opts.Mirrors,
+		verifier: opts.Verifier,
 	}
 }
 
 // Pull from the repository and return the compressed archive.
 func (r *HelmRegistry) Pull(ctx context.Context, oci *gardencorev1.OCIRepository) ([]byte, error) {
-	ref, err := buildRef(oci)
+	ref, err := buildRef(oci, r.mirrors)
 	if err != nil {
 		return nil, err
 	}
@@ -84,38 +105,54 @@ func (r *HelmRegistry) Pull(ctx context.Context, oci *gardencorev1.OCIRepository
 		remoteOpts = append(remoteOpts, remote.WithAuthFromKeychain(&keychain{pullSecret: string(secret.Data[corev1.DockerConfigJsonKey])}))
 	}
 
-	key, err := cacheKeyFromRef(ref, remoteOpts...)
+	digestRef, err := digestRefFromRef(ref, remoteOpts...)
 	if err != nil {
 		return nil, err
 	}
-	if key != "" {
-		if blob, found := r.cache.Get(key); found {
-			return blob, nil
-		}
+	if blob, found := r.cache.Get(r.cacheKey(digestRef)); found {
+		return blob, nil
 	}
 
 	img, err := remote.Image(ref, remoteOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to pull artifact %s: %w", ref, err)
 	}
-	blob, err := extractHelmLayer(img)
+	digest, err := img.Digest()
 	if err != nil {
 		return nil, err
 	}
 
-	// construct cache key based on digest of the pulled artifact
-	digest, err := img.Digest()
+	// Only verified artifacts are added to the cache and the cache key contains the fingerprint of the verification
+	// config, hence verification is not needed on cache hits.
+	if r.verifier != nil {
+		if err := r.verifier.verify(ref.Context(), digest, remoteOpts...); err != nil {
+			return nil, fmt.Errorf("failed to verify artifact %s: %w", ref, err)
+		}
+	}
+
+	blob, layerDigest, err := extractHelmLayer(img)
 	if err != nil {
 		return nil, err
 	}
-	key = ref.Context().Digest(digest.String()).Name()
-	r.cache.Set(key, blob)
+
+	// construct cache key based on digest of the pulled artifact
+	r.cache.Set(r.cacheKey(ref.Context().Digest(digest.String()).Name()), layerDigest, blob)
 
 	return blob, nil
 }
 
-func buildRef(oci *gardencorev1.OCIRepository) (name.Reference, error) {
-	ref := oci.GetURL()
+// cacheKey returns the key of the blob of the artifact with the given digest reference. If signature verification is
+// enabled, the key contains the fingerprint of the public keys, so that blobs cached without verification or verified
+// with other keys are not returned.
+func (r *HelmRegistry) cacheKey(digestRef string) string {
+	if r.verifier == nil {
+		return digestRef
+	}
+	return digestRef + "#verified-" + r.verifier.fingerprint
+}
+
+func buildRef(oci *gardencorev1.OCIRepository, mirrors imagevector.Mirrors) (name.Reference, error) {
+	ref := mirrors.Rewrite(oci.GetURL())
 
 	opts := []name.Option{
 		name.StrictValidation,
@@ -129,9 +166,9 @@ func buildRef(oci *gardencorev1.OCIRepository) (name.Reference, error) {
 	return name.ParseReference(ref, opts...)
 }
 
-// cacheKeyFromRef returns "repo@sha256:digest". If the ref is not a digest, the remote repository is queried to
+// digestRefFromRef returns "repo@sha256:digest". If the ref is not a digest, the remote repository is queried to
 // retrieve the digest pointed to by the ref.
-func cacheKeyFromRef(ref name.Reference, opts ...remote.Option) (string, error) {
+func digestRefFromRef(ref name.Reference, opts ...remote.Option) (string, error) {
 	if ref, ok := ref.(name.Digest); ok {
 		return ref.Name(), nil
 	}
@@ -150,21 +187,21 @@ func cacheKeyFromRef(ref name.Reference, opts ...remote.Option) (string, error)
 	return ref.Context().Digest(digest.String()).Name(), nil
 }
 
-func extractHelmLayer(image gcrv1.Image) ([]byte, error) {
+func extractHelmLayer(image gcrv1.Image) ([]byte, gcrv1.Hash, error) {
 	layers, err := image.Layers()
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse layers: %w", err)
+		return nil, gcrv1.Hash{}, fmt.Errorf("failed to parse layers: %w", err)
 	}
 
 	if len(layers) < 1 {
-		return nil, fmt.Errorf("no layers found")
+		return nil, gcrv1.Hash{}, fmt.Errorf("no layers found")
 	}
 
 	var layer gcrv1.Layer
 	for _, l := range layers {
 		mt, err := l.MediaType()
 		if err != nil {
-			return nil, err
+			return nil, gcrv1.Hash{}, err
 		}
 		if string(mt) == mediaTypeHelm {
 			layer = l
@@ -172,15 +209,19 @@ func extractHelmLayer(image gcrv1.Image) ([]byte, error) {
 		}
 	}
 	if layer == nil {
-		return nil, fmt.Errorf("no helm layer found in artifact")
+		return nil, gcrv1.Hash{}, fmt.Errorf("no helm layer found in artifact")
+	}
+	digest, err := layer.Digest()
+	if err != nil {
+		return nil, gcrv1.Hash{}, fmt.Errorf("failed to get digest of helm layer: %w", err)
 	}
 	blob, err := layer.Compressed()
 	if err != nil {
-		return nil, fmt.Errorf("failed to extract layer from artifact: %w", err)
+		return nil, gcrv1.Hash{}, fmt.Errorf("failed to extract layer from artifact: %w", err)
 	}
 	raw, err := io.ReadAll(blob)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read content of helm layer: %w", err)
+		return nil, gcrv1.Hash{}, fmt.Errorf("failed to read content of helm layer: %w", err)
 	}
-	return raw, nil
+	return raw, digest, nil
 }
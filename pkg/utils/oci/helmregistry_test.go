@@ -11,6 +11,7 @@ import (
 
 	_ "github.com/distribution/distribution/v3/registry/storage/driver/inmemory"
 	"github.com/google/go-containerregistry/pkg/name"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
@@ -21,6 +22,7 @@ import (
 
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
 var _ = Describe("helmregistry", func() {
@@ -32,7 +34,7 @@ var _ = Describe("helmregistry", func() {
 
 	BeforeEach(func() {
 		ctx = context.Background()
-		rc = &recordingCache{cache: newCache()}
+		rc = &recordingCache{cache: newCache(DefaultCacheMaxSize, "")}
 		hr = &HelmRegistry{cache: rc}
 	})
 
@@ -107,6 +109,16 @@ var _ = Describe("helmregistry", func() {
 		Expect(rc.cacheHits).To(Equal(1))
 	})
 
+	It("should pull the chart from the mirror", func() {
+		hr.mirrors = imagevector.Mirrors{{Source: "upstream.example.com/gardener", Mirror: registryAddress}}
+
+		out, err := hr.Pull(ctx, &gardencorev1.OCIRepository{
+			Ref: ptr.To(fmt.Sprintf("upstream.example.com/gardener/charts/example:0.1.0@%s", exampleChartDigest)),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).NotTo(BeEmpty())
+	})
+
 	It("should pull the chart with pull secret", func() {
 		hr := newHelmRegistryWithPullSecret(rc, registryAddress)
 
@@ -135,7 +147,7 @@ var _ = Describe("helmregistry", func() {
 	})
 })
 
-func newHelmRegistryWithPullSecret(cache cacher, registryAddress string) *HelmRegistry {
+func newHelmRegistryWithPullSecret(cache Cache, registryAddress string) *HelmRegistry {
 	return &HelmRegistry{
 		cache: cache,
 		client: fake.NewFakeClient(&corev1.Secret{
@@ -150,7 +162,7 @@ func newHelmRegistryWithPullSecret(cache cacher, registryAddress string) *HelmRe
 }
 
 type recordingCache struct {
-	cache     cacher
+	cache     Cache
 	cacheHits int
 }
 
@@ -162,35 +174,45 @@ func (rc *recordingCache) Get(k string) ([]byte, bool) {
 	return out, found
 }
 
-func (rc *recordingCache) Set(k string, blob []byte) {
-	rc.cache.Set(k, blob)
+func (rc *recordingCache) Set(k string, digest gcrv1.Hash, blob []byte) {
+	rc.cache.Set(k, digest, blob)
 }
 
 var _ = Describe("buildRef", func() {
 	const digest = "sha256:7a855a6d69033dd3240d9648e8bd46a67a528059158e098c7794ac9227735b4a"
 
 	DescribeTable("buildRef",
-		func(oci *gardencorev1.OCIRepository, want name.Reference) {
-			Expect(buildRef(oci)).To(Equal(want))
+		func(oci *gardencorev1.OCIRepository, mirrors imagevector.Mirrors, want name.Reference) {
+			Expect(buildRef(oci, mirrors)).To(Equal(want))
 		},
 		Entry("ref without digest",
-			&gardencorev1.OCIRepository{Ref: ptr.To("example.com/foo:1.0.0")},
+			&gardencorev1.OCIRepository{Ref: ptr.To("example.com/foo:1.0.0")}, nil,
 			mustNewTag("example.com/foo:1.0.0"),
 		),
 		Entry("ref with tag and digest",
-			&gardencorev1.OCIRepository{Ref: ptr.To("example.com/foo:1.0.0@" + digest)},
+			&gardencorev1.OCIRepository{Ref: ptr.To("example.com/foo:1.0.0@" + digest)}, nil,
 			mustNewDigest("example.com/foo:1.0.0@"+digest),
 		),
 		Entry("repository with tag",
-			&gardencorev1.OCIRepository{Repository: ptr.To("example.com/foo"), Tag: ptr.To("1.0.0")},
+			&gardencorev1.OCIRepository{Repository: ptr.To("example.com/foo"), Tag: ptr.To("1.0.0")}, nil,
 			mustNewTag("example.com/foo:1.0.0"),
 		),
 		Entry("repository with tag and digest",
-			&gardencorev1.OCIRepository{Repository: ptr.To("oci://example.com/foo"), Tag: ptr.To("1.0.0"), Digest: ptr.To(digest)},
+			&gardencorev1.OCIRepository{Repository: ptr.To("oci://example.com/foo"), Tag: ptr.To("1.0.0"), Digest: ptr.To(digest)}, nil,
 			mustNewDigest("example.com/foo@"+digest),
 		),
+		Entry("ref rewritten to mirror",
+			&gardencorev1.OCIRepository{Ref: ptr.To("example.com/foo:1.0.0@" + digest)},
+			imagevector.Mirrors{{Source: "example.com", Mirror: "mirror.example.com/example"}},
+			mustNewDigest("mirror.example.com/example/foo:1.0.0@"+digest),
+		),
+		Entry("repository rewritten to mirror",
+			&gardencorev1.OCIRepository{Repository: ptr.To("oci://example.com/foo"), Tag: ptr.To("1.0.0")},
+			imagevector.Mirrors{{Source: "example.com", Mirror: "mirror.example.com/example"}},
+			mustNewTag("mirror.example.com/example/foo:1.0.0"),
+		),
 		Entry("configure insecure in local setup when using registry.local.gardener.cloud",
-			&gardencorev1.OCIRepository{Ref: ptr.To("registry.local.gardener.cloud:5001/foo:1.0.0")},
+			&gardencorev1.OCIRepository{Ref: ptr.To("registry.local.gardener.cloud:5001/foo:1.0.0")}, nil,
 			name.MustParseReference("registry.local.gardener.cloud:5001/foo:1.0.0", name.Insecure),
 		),
 	)
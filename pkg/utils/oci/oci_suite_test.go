@@ -6,6 +6,7 @@ package oci
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"strings"
@@ -42,6 +43,13 @@ var _ = BeforeSuite(func() {
 	var err error
 	registryAddress, err = startTestRegistry(ctx)
 	Expect(err).NotTo(HaveOccurred())
+	Eventually(func() error {
+		conn, err := net.Dial("tcp", registryAddress)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}).Should(Succeed())
 
 	c, err := helmregistry.NewClient()
 	Expect(err).NotTo(HaveOccurred())
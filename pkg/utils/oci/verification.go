@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+const (
+	// mediaTypeCosignSimpleSigning is the media type of the layers of cosign signature artifacts.
+	mediaTypeCosignSimpleSigning = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// annotationCosignSignature is the annotation of a cosign signature layer which contains the base64 encoded
+	// signature of the layer's payload.
+	annotationCosignSignature = "dev.cosignproject.cosign/signature"
+)
+
+// Verifier verifies cosign signatures of artifacts with a set of public keys. Signatures are expected to be stored
+// in the same repository as the artifact with the tag `<algorithm>-<hex>.sig` derived from the artifact's digest.
+// Only key-based signatures are supported, i.e., neither certificates nor transparency logs are checked.
+type Verifier struct {
+	publicKeys []crypto.PublicKey
+	// fingerprint identifies the set of public keys independent of their order.
+	fingerprint string
+}
+
+// NewVerifier returns a Verifier which only accepts artifacts with a cosign signature which can be verified with one of
+// the given PEM-encoded public keys.
+func NewVerifier(publicKeys []string) (*Verifier, error) {
+	if len(publicKeys) == 0 {
+		return nil, errors.New("at least one public key is required")
+	}
+
+	var (
+		v            = &Verifier{}
+		fingerprints []string
+	)
+
+	for i, publicKey := range publicKeys {
+		key, err := utils.DecodePublicKey([]byte(publicKey))
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing public key %d: %w", i, err)
+		}
+		der, err := x509.MarshalPKIXPublicKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed marshalling public key %d: %w", i, err)
+		}
+		sum := sha256.Sum256(der)
+
+		v.publicKeys = append(v.publicKeys, key)
+		fingerprints = append(fingerprints, hex.EncodeToString(sum[:]))
+	}
+
+	slices.Sort(fingerprints)
+	sum := sha256.Sum256([]byte(strings.Join(fingerprints, ",")))
+	v.fingerprint = hex.EncodeToString(sum[:])
+
+	return v, nil
+}
+
+// simpleSigningPayload is the payload signed by cosign.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// verify returns an error if the artifact with the given digest in the given repository has no signature which can be
+// verified with one of the public keys.
+func (v *Verifier) verify(repo name.Repository, digest gcrv1.Hash, opts ...remote.Option) error {
+	signatureTag := repo.Tag(fmt.Sprintf("%s-%s.sig", digest.Algorithm, digest.Hex))
+
+	signatureImage, err := remote.Image(signatureTag, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to pull signature %s: %w", signatureTag, err)
+	}
+	manifest, err := signatureImage.Manifest()
+	if err != nil {
+		return fmt.Errorf("failed to read manifest of signature %s: %w", signatureTag, err)
+	}
+
+	for _, desc := range manifest.Layers {
+		if desc.MediaType != mediaTypeCosignSimpleSigning {
+			continue
+		}
+
+		signature, err := base64.StdEncoding.DecodeString(desc.Annotations[annotationCosignSignature])
+		if err != nil || len(signature) == 0 {
+			continue
+		}
+
+		layer, err := signatureImage.LayerByDigest(desc.Digest)
+		if err != nil {
+			return fmt.Errorf("failed to get layer %s of signature %s: %w", desc.Digest, signatureTag, err)
+		}
+		payload, err := readLayer(layer)
+		if err != nil {
+			return fmt.Errorf("failed to read layer %s of signature %s: %w", desc.Digest, signatureTag, err)
+		}
+
+		if !v.verifySignature(payload, signature) {
+			continue
+		}
+
+		var p simpleSigningPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			continue
+		}
+		if p.Critical.Image.DockerManifestDigest == digest.String() {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no valid signature found for %s", repo.Digest(digest.String()))
+}
+
+// verifySignature returns true if the signature of the payload can be verified with one of the public keys.
+func (v *Verifier) verifySignature(payload, signature []byte) bool {
+	hash := sha256.Sum256(payload)
+
+	for _, publicKey := range v.publicKeys {
+		switch key := publicKey.(type) {
+		case *ecdsa.PublicKey:
+			if ecdsa.VerifyASN1(key, hash[:], signature) {
+				return true
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], signature) == nil {
+				return true
+			}
+		case ed25519.PublicKey:
+			if ed25519.Verify(key, payload, signature) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func readLayer(layer gcrv1.Layer) ([]byte, error) {
+	rc, err := layer.Uncompressed()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return io.ReadAll(rc)
+}
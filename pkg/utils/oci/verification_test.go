@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	helmregistry "helm.sh/helm/v3/pkg/registry"
+	"k8s.io/utils/ptr"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+)
+
+var _ = Describe("verification", func() {
+	var (
+		ctx        context.Context
+		privateKey *ecdsa.PrivateKey
+		hr         *HelmRegistry
+		repository string
+		digest     string
+
+		pushChart = func() {
+			c, err := helmregistry.NewClient()
+			Expect(err).NotTo(HaveOccurred())
+
+			// each test uses its own repository, so that signatures pushed by other tests are not found
+			repository = fmt.Sprintf("%s/signed-%d/example", registryAddress, CurrentSpecReport().LeafNodeLocation.LineNumber)
+			res, err := c.Push(rawChart, repository+":0.1.0")
+			Expect(err).NotTo(HaveOccurred())
+			digest = res.Manifest.Digest
+		}
+
+		pushSignature = func(signer crypto.Signer, signedDigest string) {
+			payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":%q},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, repository, signedDigest))
+
+			var (
+				signature []byte
+				err       error
+			)
+			if _, ok := signer.(ed25519.PrivateKey); ok {
+				signature, err = signer.Sign(rand.Reader, payload, crypto.Hash(0))
+			} else {
+				hash := sha256.Sum256(payload)
+				signature, err = signer.Sign(rand.Reader, hash[:], crypto.SHA256)
+			}
+			Expect(err).NotTo(HaveOccurred())
+
+			img, err := mutate.Append(empty.Image, mutate.Addendum{
+				Layer:       static.NewLayer(payload, types.MediaType(mediaTypeCosignSimpleSigning)),
+				Annotations: map[string]string{annotationCosignSignature: base64.StdEncoding.EncodeToString(signature)},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			repo, err := name.NewRepository(repository)
+			Expect(err).NotTo(HaveOccurred())
+			hash, err := gcrv1.NewHash(digest)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(remote.Write(repo.Tag(fmt.Sprintf("%s-%s.sig", hash.Algorithm, hash.Hex)), img)).To(Succeed())
+		}
+
+		pull = func() ([]byte, error) {
+			return hr.Pull(ctx, &gardencorev1.OCIRepository{Repository: ptr.To(repository), Tag: ptr.To("0.1.0")})
+		}
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		v, err := NewVerifier([]string{encodePublicKey(privateKey.Public())})
+		Expect(err).NotTo(HaveOccurred())
+		hr = &HelmRegistry{cache: newCache(DefaultCacheMaxSize, ""), verifier: v}
+
+		pushChart()
+	})
+
+	It("should pull the chart if it has a valid signature", func() {
+		pushSignature(privateKey, digest)
+
+		out, err := pull()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(Equal(rawChart))
+	})
+
+	It("should pull the chart if it has a valid signature created with any of the public keys", func() {
+		_, otherPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		hr.verifier, err = NewVerifier([]string{encodePublicKey(privateKey.Public()), encodePublicKey(otherPrivateKey.Public())})
+		Expect(err).NotTo(HaveOccurred())
+
+		pushSignature(otherPrivateKey, digest)
+
+		_, err = pull()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should not return charts cached without verification", func() {
+		v := hr.verifier
+		hr.verifier = nil
+		_, err := pull()
+		Expect(err).NotTo(HaveOccurred())
+
+		hr.verifier = v
+		_, err = pull()
+		Expect(err).To(MatchError(ContainSubstring("failed to pull signature")))
+	})
+
+	It("should not return charts cached after verification with other keys", func() {
+		otherPrivateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		pushSignature(otherPrivateKey, digest)
+
+		v := hr.verifier
+		hr.verifier, err = NewVerifier([]string{encodePublicKey(otherPrivateKey.Public())})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = pull()
+		Expect(err).NotTo(HaveOccurred())
+
+		hr.verifier = v
+		_, err = pull()
+		Expect(err).To(MatchError(ContainSubstring("no valid signature found")))
+	})
+
+	It("should fail to create a verifier without public keys", func() {
+		_, err := NewVerifier(nil)
+		Expect(err).To(MatchError("at least one public key is required"))
+	})
+
+	It("should fail if the chart has no signature", func() {
+		_, err := pull()
+		Expect(err).To(MatchError(ContainSubstring("failed to pull signature")))
+	})
+
+	It("should fail if the signature was created with another key", func() {
+		otherPrivateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		pushSignature(otherPrivateKey, digest)
+
+		_, err = pull()
+		Expect(err).To(MatchError(ContainSubstring("no valid signature found")))
+	})
+
+	It("should fail if the signature is for another digest", func() {
+		pushSignature(privateKey, "sha256:7a855a6d69033dd3240d9648e8bd46a67a528059158e098c7794ac9227735b4a")
+
+		_, err := pull()
+		Expect(err).To(MatchError(ContainSubstring("no valid signature found")))
+	})
+})
+
+func encodePublicKey(publicKey crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	Expect(err).NotTo(HaveOccurred())
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
@@ -5,10 +5,13 @@
 package secrets
 
 import (
+	"context"
+	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"fmt"
 	"math/big"
 	"net"
 	"os"
@@ -54,7 +57,7 @@ const (
 )
 
 // CertificateSecretConfig contains the specification a to-be-generated CA, server, or client certificate.
-// It always contains a 3072-bit RSA private key.
+// It contains a 3072-bit RSA private key unless another key algorithm is configured.
 type CertificateSecretConfig struct {
 	Name string
 
@@ -66,6 +69,16 @@ type CertificateSecretConfig struct {
 	CertType  CertType
 	SigningCA *Certificate
 	PKCS      int
+	// KeyAlgorithm is the algorithm of the generated private key. Defaults to KeyAlgorithmRSA. The algorithm can differ
+	// from the one of the signing CA.
+	KeyAlgorithm KeyAlgorithm
+	// KeyBackend is an optional backend holding the private key of a CA certificate. If set, only a reference to the
+	// private key is part of the secret data. It is not considered for the config checksum.
+	KeyBackend KeyBackend `hash:"ignore"`
+	// ExportablePrivateKey states that the private key of a CA certificate must be part of the secret data since
+	// consumers need it, e.g., for signing certificates themselves. Such CAs never use the CA key backend of the secrets
+	// manager. It is not considered for the config checksum.
+	ExportablePrivateKey bool `hash:"ignore"`
 
 	Validity                          *time.Duration
 	SkipPublishingCACertificate       bool
@@ -82,8 +95,10 @@ type Certificate struct {
 	SkipPublishingCACertificate       bool
 	IncludeCACertificateInServerChain bool
 
-	PrivateKey    *rsa.PrivateKey
+	PrivateKey    crypto.Signer
 	PrivateKeyPEM []byte
+	// PrivateKeyReference is set instead of PrivateKeyPEM if the private key is held by a KeyBackend.
+	PrivateKeyReference *KeyReference
 
 	Certificate    *x509.Certificate
 	CertificatePEM []byte
@@ -101,6 +116,12 @@ func (s *CertificateSecretConfig) Generate() (DataInterface, error) {
 
 // GenerateCertificate is the same as Generate but returns a *Certificate instead of the DataInterface.
 func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
+	return s.GenerateCertificateWithContext(context.Background())
+}
+
+// GenerateCertificateWithContext is the same as GenerateCertificate but uses the given context for the requests to the
+// key backend (if any).
+func (s *CertificateSecretConfig) GenerateCertificateWithContext(ctx context.Context) (*Certificate, error) {
 	certificateObj := &Certificate{
 		Name:                              s.Name,
 		CA:                                s.SigningCA,
@@ -111,13 +132,13 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 
 	// If no cert type is given then we only return a certificate object that contains the CA.
 	if s.CertType != "" {
-		privateKey, err := GenerateKey(rand.Reader, 3072)
+		privateKey, privateKeyReference, err := s.generatePrivateKey(ctx)
 		if err != nil {
 			return nil, err
 		}
 
 		var (
-			certificate       = s.generateCertificateTemplate()
+			certificate       = s.generateCertificateTemplate(privateKey)
 			certificateSigner = certificate
 			privateKeySigner  = privateKey
 		)
@@ -127,18 +148,14 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 			privateKeySigner = s.SigningCA.PrivateKey
 		}
 
-		certificatePEM, err := signCertificate(certificate, privateKey, certificateSigner, privateKeySigner)
+		certificatePEM, err := signCertificate(certificate, privateKey.Public(), certificateSigner, privateKeySigner)
 		if err != nil {
 			return nil, err
 		}
 
 		var pk []byte
-		switch s.PKCS {
-		case PKCS1:
-			pk = utils.EncodePrivateKey(privateKey)
-		case PKCS8:
-			pk, err = utils.EncodePrivateKeyInPKCS8(privateKey)
-
+		if privateKeyReference == nil {
+			pk, err = encodePrivateKey(privateKey, s.PKCS)
 			if err != nil {
 				return nil, err
 			}
@@ -146,6 +163,7 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 
 		certificateObj.PrivateKey = privateKey
 		certificateObj.PrivateKeyPEM = pk
+		certificateObj.PrivateKeyReference = privateKeyReference
 		certificateObj.Certificate = certificate
 		certificateObj.CertificatePEM = certificatePEM
 	}
@@ -153,6 +171,29 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 	return certificateObj, nil
 }
 
+func (s *CertificateSecretConfig) generatePrivateKey(ctx context.Context) (crypto.Signer, *KeyReference, error) {
+	if s.KeyBackend == nil {
+		privateKey, err := generatePrivateKey(s.KeyAlgorithm, 3072)
+		return privateKey, nil, err
+	}
+
+	if s.CertType != CACert {
+		return nil, nil, fmt.Errorf("key backends are only supported for CA certificates, got certificate type %q", s.CertType)
+	}
+
+	keyID, err := s.KeyBackend.CreateKey(ctx, s.KeyAlgorithm)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating private key in key backend %q: %w", s.KeyBackend.Name(), err)
+	}
+
+	privateKey, err := s.KeyBackend.Signer(ctx, keyID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed getting signer for private key %q from key backend %q: %w", keyID, s.KeyBackend.Name(), err)
+	}
+
+	return privateKey, &KeyReference{Backend: s.KeyBackend.Name(), KeyID: keyID}, nil
+}
+
 // SecretData computes the data map which can be used in a Kubernetes secret.
 func (c *Certificate) SecretData() map[string][]byte {
 	data := map[string][]byte{}
@@ -162,7 +203,11 @@ func (c *Certificate) SecretData() map[string][]byte {
 		// The certificate is a CA certificate itself, so we use different keys in the secret data (for backwards-
 		// compatibility).
 		data[DataKeyCertificateCA] = c.CertificatePEM
-		data[DataKeyPrivateKeyCA] = c.PrivateKeyPEM
+		if c.PrivateKeyReference != nil {
+			data[DataKeyPrivateKeyCAReference] = []byte(c.PrivateKeyReference.String())
+		} else {
+			data[DataKeyPrivateKeyCA] = c.PrivateKeyPEM
+		}
 
 	case c.CA != nil:
 		cert := c.CertificatePEM
@@ -185,7 +230,7 @@ func (c *Certificate) SecretData() map[string][]byte {
 // LoadCertificate takes a byte slice representation of a certificate and the corresponding private key, and returns its de-serialized private
 // key, certificate template and PEM certificate which can be used to sign other x509 certificates.
 func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (*Certificate, error) {
-	privateKey, err := utils.DecodePrivateKey(privateKeyPEM)
+	privateKey, err := utils.DecodePrivateKeySigner(privateKeyPEM)
 	if err != nil {
 		return nil, err
 	}
@@ -209,7 +254,7 @@ func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (*Certif
 // common name, organization, SANs (DNS names and IP addresses). It can create a server or a client certificate
 // or both, depending on the <certType> value. If <isCACert> is true, then a CA certificate is being created.
 // The certificates a valid for 10 years.
-func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificate {
+func (s *CertificateSecretConfig) generateCertificateTemplate(privateKey crypto.Signer) *x509.Certificate {
 	now := Clock.Now()
 
 	expiration := now.AddDate(10, 0, 0) // + 10 years
@@ -227,7 +272,7 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 			SerialNumber:          serialNumber,
 			NotBefore:             AdjustToClockSkew(now),
 			NotAfter:              expiration,
-			KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+			KeyUsage:              x509.KeyUsageDigitalSignature,
 			Subject: pkix.Name{
 				CommonName:   s.CommonName,
 				Organization: s.Organization,
@@ -237,6 +282,11 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 		}
 	)
 
+	// Key encipherment is only applicable for RSA keys, ECDSA and Ed25519 keys are only used for signatures.
+	if _, ok := privateKey.(*rsa.PrivateKey); ok {
+		template.KeyUsage |= x509.KeyUsageKeyEncipherment
+	}
+
 	switch s.CertType {
 	case CACert:
 		template.KeyUsage |= x509.KeyUsageCertSign | x509.KeyUsageCRLSign
@@ -252,10 +302,11 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 }
 
 // SignCertificate takes a <certificateTemplate> and a <certificateTemplateSigner> which is used to sign
-// the first. It also requires the corresponding private keys of both certificates. The created certificate
-// is returned as byte slice.
-func signCertificate(certificateTemplate *x509.Certificate, privateKey *rsa.PrivateKey, certificateTemplateSigner *x509.Certificate, privateKeySigner *rsa.PrivateKey) ([]byte, error) {
-	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, &privateKey.PublicKey, privateKeySigner)
+// the first. It also requires the public key of the first and the private key of the signing certificate. The
+// signature algorithm is derived from the type of the signing private key, hence, the keys of both certificates
+// can be of different types. The created certificate is returned as byte slice.
+func signCertificate(certificateTemplate *x509.Certificate, publicKey crypto.PublicKey, certificateTemplateSigner *x509.Certificate, privateKeySigner crypto.Signer) ([]byte, error) {
+	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, publicKey, privateKeySigner)
 	if err != nil {
 		return nil, err
 	}
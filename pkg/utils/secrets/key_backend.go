@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// DataKeyPrivateKeyCAReference is the key in a secret data holding the reference to the CA private key in case it is
+// held by a KeyBackend.
+const DataKeyPrivateKeyCAReference = "ca.key-ref"
+
+// KeyBackend manages private keys outside of Kubernetes secrets, e.g., in an external key management service (KMS) or
+// a hardware security module (HSM). The private keys never leave the backend, it only exposes signers for them.
+type KeyBackend interface {
+	// Name returns the name of the backend. It is part of the key references stored in secrets and used to detect that
+	// a key is referenced which is held by another backend.
+	Name() string
+	// CreateKey creates a new private key with the given algorithm and returns its ID.
+	CreateKey(ctx context.Context, algorithm KeyAlgorithm) (string, error)
+	// Signer returns a signer for the private key with the given ID. Since crypto.Signer does not accept a context, the
+	// signer may use the given context for its signing operations.
+	Signer(ctx context.Context, keyID string) (crypto.Signer, error)
+	// DeleteKey deletes the private key with the given ID. It does not return an error if the key does not exist.
+	DeleteKey(ctx context.Context, keyID string) error
+}
+
+// KeyReference references a private key held by a KeyBackend.
+type KeyReference struct {
+	// Backend is the name of the backend holding the key.
+	Backend string
+	// KeyID is the ID of the key in the backend.
+	KeyID string
+}
+
+// String returns the serialized form of the reference which is stored in secret data.
+func (r KeyReference) String() string {
+	return r.Backend + ":" + r.KeyID
+}
+
+// ParseKeyReference parses a serialized key reference.
+func ParseKeyReference(data []byte) (KeyReference, error) {
+	backend, keyID, ok := strings.Cut(string(data), ":")
+	if !ok || backend == "" || keyID == "" {
+		return KeyReference{}, fmt.Errorf("invalid key reference %q, expected format <backend>:<key-id>", string(data))
+	}
+	return KeyReference{Backend: backend, KeyID: keyID}, nil
+}
+
+// LoadCertificateWithKeyBackend takes a serialized reference to a private key held by the given key backend and a PEM
+// certificate, and returns a *Certificate which signs other x509 certificates via the key backend.
+func LoadCertificateWithKeyBackend(ctx context.Context, name string, keyReference, certificatePEM []byte, backend KeyBackend) (*Certificate, error) {
+	if backend == nil {
+		return nil, fmt.Errorf("private key of certificate %q is held by a key backend but no key backend is configured", name)
+	}
+
+	reference, err := ParseKeyReference(keyReference)
+	if err != nil {
+		return nil, err
+	}
+	if reference.Backend != backend.Name() {
+		return nil, fmt.Errorf("private key of certificate %q is held by key backend %q but key backend %q is configured", name, reference.Backend, backend.Name())
+	}
+
+	privateKey, err := backend.Signer(ctx, reference.KeyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting signer for private key %q of certificate %q: %w", reference.KeyID, name, err)
+	}
+	certificate, err := utils.DecodeCertificate(certificatePEM)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Certificate{
+		Name: name,
+
+		PrivateKey:          privateKey,
+		PrivateKeyReference: &reference,
+
+		Certificate:    certificate,
+		CertificatePEM: certificatePEM,
+	}, nil
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// KeyBackendNameFile is the name of the file-based key backend.
+const KeyBackendNameFile = "file"
+
+type fileKeyBackend struct {
+	dir string
+}
+
+// NewFileKeyBackend returns a KeyBackend which stores the private keys as PEM files in the given directory. The
+// directory is created if it does not exist. Signing happens in the local process, hence, this backend is meant for
+// tests and development setups, or for keeping the keys on a (protected) volume instead of in Kubernetes secrets.
+func NewFileKeyBackend(dir string) (KeyBackend, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed creating directory for key backend: %w", err)
+	}
+	return &fileKeyBackend{dir: dir}, nil
+}
+
+func (f *fileKeyBackend) Name() string {
+	return KeyBackendNameFile
+}
+
+func (f *fileKeyBackend) CreateKey(_ context.Context, algorithm KeyAlgorithm) (string, error) {
+	privateKey, err := generatePrivateKey(algorithm, 3072)
+	if err != nil {
+		return "", err
+	}
+
+	privateKeyPEM, err := encodePrivateKey(privateKey, PKCS8)
+	if err != nil {
+		return "", err
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	keyID := hex.EncodeToString(id)
+
+	if err := os.WriteFile(f.path(keyID), privateKeyPEM, 0600); err != nil {
+		return "", fmt.Errorf("failed writing private key %q: %w", keyID, err)
+	}
+	return keyID, nil
+}
+
+func (f *fileKeyBackend) Signer(_ context.Context, keyID string) (crypto.Signer, error) {
+	privateKeyPEM, err := os.ReadFile(f.path(keyID))
+	if err != nil {
+		return nil, fmt.Errorf("failed reading private key %q: %w", keyID, err)
+	}
+	return utils.DecodePrivateKeySigner(privateKeyPEM)
+}
+
+func (f *fileKeyBackend) DeleteKey(_ context.Context, keyID string) error {
+	if err := os.Remove(f.path(keyID)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed deleting private key %q: %w", keyID, err)
+	}
+	return nil
+}
+
+func (f *fileKeyBackend) path(keyID string) string {
+	return filepath.Join(f.dir, filepath.Base(keyID)+".pem")
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils"
+	. "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("Key Backend", func() {
+	var (
+		ctx     = context.Background()
+		dir     string
+		backend KeyBackend
+	)
+
+	BeforeEach(func() {
+		dir = filepath.Join(GinkgoT().TempDir(), "keys")
+
+		var err error
+		backend, err = NewFileKeyBackend(dir)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#NewFileKeyBackend", func() {
+		It("should create the directory and use the file backend name", func() {
+			Expect(dir).To(BeADirectory())
+			Expect(backend.Name()).To(Equal(KeyBackendNameFile))
+		})
+
+		It("should create, use and delete keys", func() {
+			keyID, err := backend.CreateKey(ctx, KeyAlgorithmECDSAP256)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filepath.Join(dir, keyID+".pem")).To(BeARegularFile())
+
+			signer, err := backend.Signer(ctx, keyID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signer).To(BeAssignableToTypeOf(&ecdsa.PrivateKey{}))
+
+			Expect(backend.DeleteKey(ctx, keyID)).To(Succeed())
+			Expect(filepath.Join(dir, keyID+".pem")).NotTo(BeAnExistingFile())
+
+			_, err = backend.Signer(ctx, keyID)
+			Expect(err).To(MatchError(os.ErrNotExist))
+
+			By("Delete key again")
+			Expect(backend.DeleteKey(ctx, keyID)).To(Succeed())
+		})
+	})
+
+	Describe("#ParseKeyReference", func() {
+		It("should parse a valid reference", func() {
+			reference, err := ParseKeyReference([]byte("file:abc:def"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reference).To(Equal(KeyReference{Backend: "file", KeyID: "abc:def"}))
+			Expect(reference.String()).To(Equal("file:abc:def"))
+		})
+
+		It("should fail for invalid references", func() {
+			for _, data := range []string{"", "file", "file:", ":abc"} {
+				_, err := ParseKeyReference([]byte(data))
+				Expect(err).To(MatchError(ContainSubstring("invalid key reference")), data)
+			}
+		})
+	})
+
+	Describe("CA certificates", func() {
+		var caConfig *CertificateSecretConfig
+
+		BeforeEach(func() {
+			caConfig = &CertificateSecretConfig{
+				Name:         "ca",
+				CommonName:   "ca",
+				CertType:     CACert,
+				KeyAlgorithm: KeyAlgorithmECDSAP256,
+				KeyBackend:   backend,
+			}
+		})
+
+		It("should only put a key reference into the secret data", func() {
+			ca, err := caConfig.GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ca.PrivateKeyPEM).To(BeNil())
+			Expect(ca.PrivateKeyReference).NotTo(BeNil())
+			Expect(ca.PrivateKeyReference.Backend).To(Equal(KeyBackendNameFile))
+
+			data := ca.SecretData()
+			Expect(data).NotTo(HaveKey(DataKeyPrivateKeyCA))
+			Expect(data).To(HaveKeyWithValue(DataKeyPrivateKeyCAReference, []byte(ca.PrivateKeyReference.String())))
+			Expect(data).To(HaveKeyWithValue(DataKeyCertificateCA, ca.CertificatePEM))
+		})
+
+		It("should fail for non-CA certificates", func() {
+			_, err := (&CertificateSecretConfig{
+				Name:       "server",
+				CommonName: "server",
+				CertType:   ServerCert,
+				KeyBackend: backend,
+			}).GenerateCertificate()
+			Expect(err).To(MatchError(ContainSubstring("key backends are only supported for CA certificates")))
+		})
+
+		It("should load the CA and sign certificates via the key backend", func() {
+			ca, err := caConfig.GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			data := ca.SecretData()
+
+			loadedCA, err := LoadCertificateWithKeyBackend(ctx, "ca", data[DataKeyPrivateKeyCAReference], data[DataKeyCertificateCA], backend)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loadedCA.PrivateKeyReference).To(Equal(ca.PrivateKeyReference))
+
+			certificate, err := (&CertificateSecretConfig{
+				Name:       "server",
+				CommonName: "server",
+				DNSNames:   []string{"server.example.com"},
+				CertType:   ServerCert,
+				SigningCA:  loadedCA,
+			}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			cert, err := utils.DecodeCertificate(certificate.CertificatePEM)
+			Expect(err).NotTo(HaveOccurred())
+
+			roots := x509.NewCertPool()
+			roots.AddCert(loadedCA.Certificate)
+			_, err = cert.Verify(x509.VerifyOptions{DNSName: "server.example.com", Roots: roots, CurrentTime: cert.NotBefore.Add(time.Hour)})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail loading the CA if no or another key backend is configured", func() {
+			ca, err := caConfig.GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			data := ca.SecretData()
+
+			_, err = LoadCertificateWithKeyBackend(ctx, "ca", data[DataKeyPrivateKeyCAReference], data[DataKeyCertificateCA], nil)
+			Expect(err).To(MatchError(ContainSubstring("no key backend is configured")))
+
+			_, err = LoadCertificateWithKeyBackend(ctx, "ca", []byte("kms:foo"), data[DataKeyCertificateCA], backend)
+			Expect(err).To(MatchError(`private key of certificate "ca" is held by key backend "kms" but key backend "file" is configured`))
+		})
+	})
+})
@@ -0,0 +1,319 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// KeyBackendNameVault is the name of the key backend using the transit secrets engine of HashiCorp Vault.
+const KeyBackendNameVault = "vault"
+
+// VaultKeyBackendOptions are options for the key backend using the transit secrets engine of HashiCorp Vault.
+type VaultKeyBackendOptions struct {
+	// Address is the address of the Vault server, e.g., `https://vault.example.com:8200`.
+	Address string
+	// MountPath is the path at which the transit secrets engine is mounted. Defaults to `transit`.
+	MountPath string
+	// TokenFile is the path to the file containing the token for authenticating against Vault. It is read for every
+	// request, so that the token can be rotated without restarting the process.
+	TokenFile string
+	// CAFile is the optional path to a file containing the PEM-encoded CA bundle for verifying the server certificate
+	// of Vault. If it is not set, the system trust store is used.
+	CAFile string
+	// Timeout is the timeout for requests to Vault. Defaults to 30s.
+	Timeout time.Duration
+}
+
+type vaultKeyBackend struct {
+	address    string
+	mountPath  string
+	tokenFile  string
+	httpClient *http.Client
+}
+
+// NewVaultKeyBackend returns a KeyBackend which holds the private keys in the transit secrets engine of HashiCorp Vault
+// (or a compatible implementation like OpenBao). The keys are created as non-exportable keys, i.e., they never leave
+// Vault, and all signing operations are performed by Vault.
+func NewVaultKeyBackend(opts VaultKeyBackendOptions) (KeyBackend, error) {
+	if _, err := url.ParseRequestURI(opts.Address); err != nil {
+		return nil, fmt.Errorf("invalid Vault address %q: %w", opts.Address, err)
+	}
+	if opts.TokenFile == "" {
+		return nil, fmt.Errorf("token file for Vault must be set")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if opts.CAFile != "" {
+		caBundle, err := os.ReadFile(opts.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading CA bundle for Vault: %w", err)
+		}
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(caBundle) {
+			return nil, fmt.Errorf("CA bundle file %q for Vault does not contain any PEM-encoded certificate", opts.CAFile)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}
+	}
+
+	mountPath := strings.Trim(opts.MountPath, "/")
+	if mountPath == "" {
+		mountPath = "transit"
+	}
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = 30 * time.Second
+	}
+
+	return &vaultKeyBackend{
+		address:    strings.TrimSuffix(opts.Address, "/"),
+		mountPath:  mountPath,
+		tokenFile:  opts.TokenFile,
+		httpClient: &http.Client{Transport: transport, Timeout: timeout},
+	}, nil
+}
+
+func (v *vaultKeyBackend) Name() string {
+	return KeyBackendNameVault
+}
+
+func (v *vaultKeyBackend) CreateKey(ctx context.Context, algorithm KeyAlgorithm) (string, error) {
+	keyType, err := vaultKeyType(algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	keyID := hex.EncodeToString(id)
+
+	if err := v.do(ctx, http.MethodPost, "keys/"+keyID, map[string]any{"type": keyType, "exportable": false}, nil); err != nil {
+		return "", fmt.Errorf("failed creating private key %q: %w", keyID, err)
+	}
+	// Keys in the transit secrets engine can only be deleted if this is explicitly allowed.
+	if err := v.do(ctx, http.MethodPost, "keys/"+keyID+"/config", map[string]any{"deletion_allowed": true}, nil); err != nil {
+		return "", fmt.Errorf("failed allowing deletion of private key %q: %w", keyID, err)
+	}
+	return keyID, nil
+}
+
+func (v *vaultKeyBackend) Signer(ctx context.Context, keyID string) (crypto.Signer, error) {
+	var response struct {
+		Data struct {
+			Type string `json:"type"`
+			Keys map[string]struct {
+				PublicKey string `json:"public_key"`
+			} `json:"keys"`
+			LatestVersion int `json:"latest_version"`
+		} `json:"data"`
+	}
+	if err := v.do(ctx, http.MethodGet, "keys/"+keyID, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed reading private key %q: %w", keyID, err)
+	}
+
+	version := fmt.Sprint(response.Data.LatestVersion)
+	key, ok := response.Data.Keys[version]
+	if !ok {
+		return nil, fmt.Errorf("version %s of private key %q not found", version, keyID)
+	}
+
+	publicKey, err := parseVaultPublicKey(response.Data.Type, key.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing public key of private key %q: %w", keyID, err)
+	}
+
+	return &vaultSigner{ctx: ctx, backend: v, keyID: keyID, publicKey: publicKey}, nil
+}
+
+func (v *vaultKeyBackend) DeleteKey(ctx context.Context, keyID string) error {
+	if err := v.do(ctx, http.MethodDelete, "keys/"+keyID, nil, nil); err != nil && !isVaultNotFound(err) {
+		return fmt.Errorf("failed deleting private key %q: %w", keyID, err)
+	}
+	return nil
+}
+
+type vaultError struct {
+	statusCode int
+	errors     []string
+}
+
+func (e *vaultError) Error() string {
+	return fmt.Sprintf("unexpected status code %d from Vault: %s", e.statusCode, strings.Join(e.errors, ", "))
+}
+
+func isVaultNotFound(err error) bool {
+	vaultErr, ok := err.(*vaultError)
+	return ok && vaultErr.statusCode == http.StatusNotFound
+}
+
+func (v *vaultKeyBackend) do(ctx context.Context, method, path string, body, result any) error {
+	token, err := os.ReadFile(v.tokenFile)
+	if err != nil {
+		return fmt.Errorf("failed reading Vault token: %w", err)
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, v.address+"/v1/"+v.mountPath+"/"+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", strings.TrimSpace(string(token)))
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var response struct {
+			Errors []string `json:"errors"`
+		}
+		// The error details are optional, hence, decoding errors are ignored.
+		_ = json.NewDecoder(resp.Body).Decode(&response)
+		return &vaultError{statusCode: resp.StatusCode, errors: response.Errors}
+	}
+
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+type vaultSigner struct {
+	// ctx is the context the signer was created with. It is used for the signing requests since crypto.Signer does not
+	// accept a context.
+	ctx       context.Context
+	backend   *vaultKeyBackend
+	keyID     string
+	publicKey crypto.PublicKey
+}
+
+func (s *vaultSigner) Public() crypto.PublicKey {
+	return s.publicKey
+}
+
+func (s *vaultSigner) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	path := "sign/" + s.keyID
+	body := map[string]any{
+		"input":                base64.StdEncoding.EncodeToString(digest),
+		"marshaling_algorithm": "asn1",
+	}
+
+	if hash := opts.HashFunc(); hash != 0 {
+		hashAlgorithm, err := vaultHashAlgorithm(hash)
+		if err != nil {
+			return nil, err
+		}
+		path += "/" + hashAlgorithm
+		body["prehashed"] = true
+	}
+
+	if _, ok := s.publicKey.(*rsa.PublicKey); ok {
+		body["signature_algorithm"] = "pkcs1v15"
+		if pssOpts, ok := opts.(*rsa.PSSOptions); ok {
+			if pssOpts.SaltLength != rsa.PSSSaltLengthEqualsHash {
+				return nil, fmt.Errorf("unsupported PSS salt length %d", pssOpts.SaltLength)
+			}
+			body["signature_algorithm"] = "pss"
+			body["salt_length"] = "hash"
+		}
+	}
+
+	var response struct {
+		Data struct {
+			Signature string `json:"signature"`
+		} `json:"data"`
+	}
+	if err := s.backend.do(s.ctx, http.MethodPost, path, body, &response); err != nil {
+		return nil, fmt.Errorf("failed signing with private key %q: %w", s.keyID, err)
+	}
+
+	// Signatures have the format `vault:v<key-version>:<base64-encoded-signature>`.
+	parts := strings.SplitN(response.Data.Signature, ":", 3)
+	if len(parts) != 3 || parts[0] != "vault" {
+		return nil, fmt.Errorf("unexpected signature format returned for private key %q", s.keyID)
+	}
+	return base64.StdEncoding.DecodeString(parts[2])
+}
+
+func vaultKeyType(algorithm KeyAlgorithm) (string, error) {
+	switch algorithm {
+	case "", KeyAlgorithmRSA:
+		return "rsa-3072", nil
+	case KeyAlgorithmECDSAP256:
+		return "ecdsa-p256", nil
+	case KeyAlgorithmECDSAP384:
+		return "ecdsa-p384", nil
+	case KeyAlgorithmEd25519:
+		return "ed25519", nil
+	}
+
+	return "", fmt.Errorf("unsupported key algorithm %q", algorithm)
+}
+
+func vaultHashAlgorithm(hash crypto.Hash) (string, error) {
+	switch hash {
+	case crypto.SHA256:
+		return "sha2-256", nil
+	case crypto.SHA384:
+		return "sha2-384", nil
+	case crypto.SHA512:
+		return "sha2-512", nil
+	}
+
+	return "", fmt.Errorf("unsupported hash algorithm %s", hash)
+}
+
+func parseVaultPublicKey(keyType, publicKey string) (crypto.PublicKey, error) {
+	// Vault returns the raw Ed25519 public keys base64-encoded, while all other public keys are PEM-encoded.
+	if keyType == "ed25519" {
+		data, err := base64.StdEncoding.DecodeString(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid Ed25519 public key size %d", len(data))
+		}
+		return ed25519.PublicKey(data), nil
+	}
+
+	block, _ := pem.Decode([]byte(publicKey))
+	if block == nil {
+		return nil, fmt.Errorf("public key is not PEM-encoded")
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
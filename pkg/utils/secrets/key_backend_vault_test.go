@@ -0,0 +1,257 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils"
+	. "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("Vault Key Backend", func() {
+	const token = "s.token"
+
+	var (
+		ctx       = context.Background()
+		vault     *fakeVaultTransit
+		server    *httptest.Server
+		tokenFile string
+		backend   KeyBackend
+	)
+
+	BeforeEach(func() {
+		vault = &fakeVaultTransit{token: token, keys: map[string]crypto.Signer{}}
+		server = httptest.NewServer(vault)
+		DeferCleanup(server.Close)
+
+		tokenFile = filepath.Join(GinkgoT().TempDir(), "token")
+		Expect(os.WriteFile(tokenFile, []byte(token+"\n"), 0600)).To(Succeed())
+
+		var err error
+		backend, err = NewVaultKeyBackend(VaultKeyBackendOptions{Address: server.URL, TokenFile: tokenFile})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#NewVaultKeyBackend", func() {
+		It("should use the vault backend name", func() {
+			Expect(backend.Name()).To(Equal(KeyBackendNameVault))
+		})
+
+		It("should fail for invalid options", func() {
+			_, err := NewVaultKeyBackend(VaultKeyBackendOptions{Address: "foo", TokenFile: tokenFile})
+			Expect(err).To(MatchError(ContainSubstring("invalid Vault address")))
+
+			_, err = NewVaultKeyBackend(VaultKeyBackendOptions{Address: server.URL})
+			Expect(err).To(MatchError("token file for Vault must be set"))
+
+			_, err = NewVaultKeyBackend(VaultKeyBackendOptions{Address: server.URL, TokenFile: tokenFile, CAFile: tokenFile})
+			Expect(err).To(MatchError(ContainSubstring("does not contain any PEM-encoded certificate")))
+		})
+	})
+
+	DescribeTable("should create CAs and sign certificates via Vault",
+		func(algorithm KeyAlgorithm) {
+			ca, err := (&CertificateSecretConfig{
+				Name:         "ca",
+				CommonName:   "ca",
+				CertType:     CACert,
+				KeyAlgorithm: algorithm,
+				KeyBackend:   backend,
+			}).GenerateCertificateWithContext(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ca.PrivateKeyReference.Backend).To(Equal(KeyBackendNameVault))
+			Expect(vault.keys).To(HaveKey(ca.PrivateKeyReference.KeyID))
+
+			data := ca.SecretData()
+			loadedCA, err := LoadCertificateWithKeyBackend(ctx, "ca", data[DataKeyPrivateKeyCAReference], data[DataKeyCertificateCA], backend)
+			Expect(err).NotTo(HaveOccurred())
+
+			certificate, err := (&CertificateSecretConfig{
+				Name:       "server",
+				CommonName: "server",
+				DNSNames:   []string{"server.example.com"},
+				CertType:   ServerCert,
+				SigningCA:  loadedCA,
+			}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			cert, err := utils.DecodeCertificate(certificate.CertificatePEM)
+			Expect(err).NotTo(HaveOccurred())
+
+			roots := x509.NewCertPool()
+			roots.AddCert(loadedCA.Certificate)
+			_, err = cert.Verify(x509.VerifyOptions{DNSName: "server.example.com", Roots: roots, CurrentTime: cert.NotBefore.Add(time.Hour)})
+			Expect(err).NotTo(HaveOccurred())
+		},
+
+		Entry("RSA", KeyAlgorithmRSA),
+		Entry("ECDSA P-256", KeyAlgorithmECDSAP256),
+		Entry("Ed25519", KeyAlgorithmEd25519),
+	)
+
+	It("should delete keys", func() {
+		keyID, err := backend.CreateKey(ctx, KeyAlgorithmECDSAP256)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vault.deletionAllowed).To(ContainElement(keyID))
+
+		Expect(backend.DeleteKey(ctx, keyID)).To(Succeed())
+		Expect(vault.keys).NotTo(HaveKey(keyID))
+
+		_, err = backend.Signer(ctx, keyID)
+		Expect(err).To(MatchError(ContainSubstring("unexpected status code 404")))
+
+		By("Delete key again")
+		Expect(backend.DeleteKey(ctx, keyID)).To(Succeed())
+	})
+
+	It("should use the given context for the requests", func() {
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := backend.CreateKey(canceledCtx, KeyAlgorithmECDSAP256)
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(vault.keys).To(BeEmpty())
+	})
+
+	It("should read the token for every request", func() {
+		Expect(os.WriteFile(tokenFile, []byte("invalid"), 0600)).To(Succeed())
+
+		_, err := backend.CreateKey(ctx, KeyAlgorithmECDSAP256)
+		Expect(err).To(MatchError(ContainSubstring("unexpected status code 403 from Vault: permission denied")))
+	})
+})
+
+// fakeVaultTransit implements the parts of the transit secrets engine API of Vault which are used by the key backend.
+type fakeVaultTransit struct {
+	lock            sync.Mutex
+	token           string
+	keys            map[string]crypto.Signer
+	deletionAllowed []string
+}
+
+func (f *fakeVaultTransit) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if r.Header.Get("X-Vault-Token") != f.token {
+		writeVaultResponse(w, http.StatusForbidden, map[string]any{"errors": []string{"permission denied"}})
+		return
+	}
+
+	var body map[string]any
+	if r.Body != nil {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/v1/transit/"), "/")
+	switch {
+	case parts[0] == "keys" && len(parts) == 2 && r.Method == http.MethodPost:
+		var (
+			key crypto.Signer
+			err error
+		)
+		switch body["type"] {
+		case "rsa-3072":
+			key, err = rsa.GenerateKey(rand.Reader, 3072)
+		case "ecdsa-p256":
+			key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		case "ed25519":
+			_, key, err = ed25519.GenerateKey(rand.Reader)
+		}
+		if key == nil || err != nil {
+			writeVaultResponse(w, http.StatusBadRequest, map[string]any{"errors": []string{"invalid key type"}})
+			return
+		}
+		f.keys[parts[1]] = key
+		w.WriteHeader(http.StatusNoContent)
+
+	case parts[0] == "keys" && len(parts) == 3 && parts[2] == "config" && r.Method == http.MethodPost:
+		if body["deletion_allowed"] == true {
+			f.deletionAllowed = append(f.deletionAllowed, parts[1])
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	case parts[0] == "keys" && len(parts) == 2 && r.Method == http.MethodGet:
+		key, ok := f.keys[parts[1]]
+		if !ok {
+			writeVaultResponse(w, http.StatusNotFound, map[string]any{"errors": []string{}})
+			return
+		}
+
+		keyType, publicKey := "rsa-3072", ""
+		if edKey, ok := key.Public().(ed25519.PublicKey); ok {
+			keyType, publicKey = "ed25519", base64.StdEncoding.EncodeToString(edKey)
+		} else {
+			der, _ := x509.MarshalPKIXPublicKey(key.Public())
+			publicKey = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+		}
+		writeVaultResponse(w, http.StatusOK, map[string]any{"data": map[string]any{
+			"type":           keyType,
+			"latest_version": 1,
+			"keys":           map[string]any{"1": map[string]any{"public_key": publicKey}},
+		}})
+
+	case parts[0] == "keys" && len(parts) == 2 && r.Method == http.MethodDelete:
+		if _, ok := f.keys[parts[1]]; !ok {
+			writeVaultResponse(w, http.StatusNotFound, map[string]any{"errors": []string{}})
+			return
+		}
+		delete(f.keys, parts[1])
+		w.WriteHeader(http.StatusNoContent)
+
+	case parts[0] == "sign" && r.Method == http.MethodPost:
+		key, ok := f.keys[parts[1]]
+		if !ok {
+			writeVaultResponse(w, http.StatusNotFound, map[string]any{"errors": []string{}})
+			return
+		}
+
+		input, _ := base64.StdEncoding.DecodeString(body["input"].(string))
+		var opts crypto.SignerOpts = crypto.Hash(0)
+		if len(parts) == 3 {
+			opts = map[string]crypto.Hash{"sha2-256": crypto.SHA256, "sha2-384": crypto.SHA384, "sha2-512": crypto.SHA512}[parts[2]]
+		}
+		if body["signature_algorithm"] == "pss" {
+			opts = &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: opts.HashFunc()}
+		}
+
+		signature, err := key.Sign(rand.Reader, input, opts)
+		if err != nil {
+			writeVaultResponse(w, http.StatusBadRequest, map[string]any{"errors": []string{err.Error()}})
+			return
+		}
+		writeVaultResponse(w, http.StatusOK, map[string]any{"data": map[string]any{"signature": "vault:v1:" + base64.StdEncoding.EncodeToString(signature)}})
+
+	default:
+		writeVaultResponse(w, http.StatusNotFound, map[string]any{"errors": []string{}})
+	}
+}
+
+func writeVaultResponse(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
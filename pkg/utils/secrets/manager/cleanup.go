@@ -35,9 +35,17 @@ func (m *manager) Cleanup(ctx context.Context) error {
 
 		fns = append(fns, func(ctx context.Context) error {
 			m.logger.Info("Deleting stale secret", "secret", client.ObjectKeyFromObject(&secret))
-			return client.IgnoreNotFound(m.client.Delete(ctx, &secret))
+			if err := m.client.Delete(ctx, &secret); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+			return m.deleteKeyFromBackend(ctx, secret.Data)
 		})
 	}
 
-	return flow.Parallel(fns...)(ctx)
+	if err := flow.Parallel(fns...)(ctx); err != nil {
+		return err
+	}
+
+	m.recordMetrics()
+	return nil
 }
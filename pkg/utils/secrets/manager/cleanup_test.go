@@ -6,6 +6,7 @@ package manager
 
 import (
 	"context"
+	"os"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -17,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
@@ -108,6 +110,31 @@ var _ = Describe("Cleanup", func() {
 			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secretsInNamespace2[7]), &corev1.Secret{})).To(BeNotFoundError())
 		})
 
+		It("should delete the private keys of stale CA secrets from the key backend", func() {
+			keyBackend, err := secretsutils.NewFileKeyBackend(GinkgoT().TempDir())
+			Expect(err).NotTo(HaveOccurred())
+
+			mgr, err := New(ctx, logr.Discard(), clock.RealClock{}, fakeClient, testIdentity, Config{CAKeyBackend: keyBackend}, namespace)
+			Expect(err).NotTo(HaveOccurred())
+			m = mgr.(*manager)
+
+			keyID, err := keyBackend.CreateKey(ctx, secretsutils.KeyAlgorithmECDSAP256)
+			Expect(err).NotTo(HaveOccurred())
+
+			secret := secretList(testIdentity, namespace)[0]
+			secret.Data = map[string][]byte{
+				"ca.crt":     []byte("cert"),
+				"ca.key-ref": []byte("file:" + keyID),
+			}
+			Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+
+			Expect(m.Cleanup(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(BeNotFoundError())
+			_, err = keyBackend.Signer(ctx, keyID)
+			Expect(err).To(MatchError(os.ErrNotExist))
+		})
+
 		It("should not touch secrets from other manager instance", func() {
 			secrets := secretList(testIdentity, "other")
 			for i := range secrets {
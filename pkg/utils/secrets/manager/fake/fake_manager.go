@@ -103,6 +103,10 @@ func (m *fakeManager) Generate(ctx context.Context, config secretsutils.ConfigIn
 	return secret, nil
 }
 
+func (m *fakeManager) List() []secretsmanager.ManagedSecret {
+	return nil
+}
+
 func (m *fakeManager) Cleanup(_ context.Context) error {
 	return nil
 }
@@ -29,6 +29,16 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 		return nil, fmt.Errorf("failed applying generate options for config %s: %w", config.GetName(), err)
 	}
 
+	if options.signingCA != nil {
+		// The CA is loaded here instead of in the SignedByCA option since its private key might be held by the key
+		// backend which is contacted with the context of this request.
+		ca, err := m.loadCA(ctx, options.signingCA.name, options.signingCA.data)
+		if err != nil {
+			return nil, fmt.Errorf("failed loading signing CA for config %s: %w", config.GetName(), err)
+		}
+		certificateSecretConfig(config).SigningCA = ca
+	}
+
 	var bundleFor *string
 	if options.isBundleSecret {
 		bundleFor = ptr.To(strings.TrimSuffix(config.GetName(), nameSuffixBundle))
@@ -102,9 +112,12 @@ func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.Con
 	// Use secret name as common name to make sure the x509 subject names in the CA certificates are always unique.
 	if certConfig := certificateSecretConfig(config); certConfig != nil && certConfig.CertType == secretsutils.CACert {
 		certConfig.CommonName = objectMeta.Name
+		if certConfig.KeyBackend == nil && !certConfig.ExportablePrivateKey {
+			certConfig.KeyBackend = m.caKeyBackend
+		}
 	}
 
-	data, err := config.Generate()
+	data, err := generate(ctx, config)
 	if err != nil {
 		return nil, fmt.Errorf("failed generating data: %w", err)
 	}
@@ -116,6 +129,12 @@ func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.Con
 
 	secret := Secret(objectMeta, dataMap)
 	if err := m.client.Create(ctx, secret); err != nil {
+		// The private key might have been created in the key backend already, so it must be deleted again since the
+		// secret referencing it was not created.
+		if deleteErr := m.deleteKeyFromBackend(ctx, dataMap); deleteErr != nil {
+			m.logger.Error(deleteErr, "Failed deleting private key from key backend", "secret", client.ObjectKeyFromObject(secret))
+		}
+
 		if !apierrors.IsAlreadyExists(err) {
 			return nil, fmt.Errorf("failed creating new secret: %w", err)
 		}
@@ -392,10 +411,16 @@ type GenerateOptions struct {
 	// Namespace overwrites the namespace in which the secret should be created.
 	Namespace string
 
+	signingCA         *signingCA
 	signingCAChecksum *string
 	isBundleSecret    bool
 }
 
+type signingCA struct {
+	name string
+	data map[string][]byte
+}
+
 type rotationStrategy string
 
 const (
@@ -491,12 +516,7 @@ func SignedByCA(name string, opts ...SignedByCAOption) GenerateOption {
 			}
 		}
 
-		ca, err := secretsutils.LoadCertificate(name, secret.obj.Data[secretsutils.DataKeyPrivateKeyCA], secret.obj.Data[secretsutils.DataKeyCertificateCA])
-		if err != nil {
-			return err
-		}
-
-		certificateConfig.SigningCA = ca
+		options.signingCA = &signingCA{name: name, data: secret.obj.Data}
 		options.signingCAChecksum = ptr.To(kubernetesutils.TruncateLabelValue(secret.dataChecksum))
 		return nil
 	}
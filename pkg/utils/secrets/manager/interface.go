@@ -20,6 +20,13 @@ type Reader interface {
 	Get(string, ...GetOption) (*corev1.Secret, bool)
 }
 
+// Lister is part of the SecretsManager interface and allows enumerating the secrets managed by a SecretsManager.
+type Lister interface {
+	// List returns information about all secrets managed by the SecretsManager, sorted by their names and classes. Note
+	// that only those secrets are known which were detected or generated by prior Generate calls.
+	List() []ManagedSecret
+}
+
 // Interface describes the methods for managing secrets.
 type Interface interface {
 	// Generate generates a secret based on the provided configuration. If the secret for the provided configuration
@@ -28,9 +35,11 @@ type Interface interface {
 	Generate(context.Context, secretsutils.ConfigInterface, ...GenerateOption) (*corev1.Secret, error)
 
 	Reader
+	Lister
 
 	// Cleanup deletes no longer required secrets. No longer required secrets are those still existing in the system
 	// which weren't detected by prior Generate calls. Consequently, only call Cleanup after you have executed Generate
-	// calls for all desired secrets.
+	// calls for all desired secrets. If the metrics of this package are registered, Cleanup also updates the
+	// certificate expiration metrics for the managed secrets.
 	Cleanup(context.Context) error
 }
@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"bytes"
+	"crypto/x509"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/utils"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// ManagedSecret contains information about a secret managed by a SecretsManager.
+type ManagedSecret struct {
+	// Name is the name of the secret config.
+	Name string
+	// Class is the class of the secret, i.e., 'current', 'old', or 'bundle'.
+	Class string
+	// Secret is the secret object.
+	Secret *corev1.Secret
+	// ConfigChecksum is the checksum of the config used to generate the secret data.
+	ConfigChecksum string
+	// SigningCAChecksum is the checksum of the CA which signed the certificate in the secret data (if any).
+	SigningCAChecksum string
+	// IssuerChain contains the common names of the issuers of the certificate in the secret data, starting with the
+	// direct issuer up to the root CA (as far as it is known to the SecretsManager). Common names of CAs generated by
+	// the SecretsManager are equal to the names of their secrets. The chain is empty for secrets w/o certificates.
+	IssuerChain []string
+	// NotBefore is the time from which on the secret data is valid. For secrets w/o certificates, it is only set if the
+	// secret was generated with a validity.
+	NotBefore *time.Time
+	// NotAfter is the time until which the secret data is valid. For secrets w/o certificates, it is only set if the
+	// secret was generated with a validity.
+	NotAfter *time.Time
+	// LastRotationInitiationTime is the time when the last rotation of the secret was initiated (if any).
+	LastRotationInitiationTime *time.Time
+	// Bundle is the name of the bundle secret containing the secret data. It is empty if the secret data is not part of
+	// a bundle.
+	Bundle string
+}
+
+// HasCertificate returns true if the secret data contains a certificate.
+func (s ManagedSecret) HasCertificate() bool {
+	return len(s.IssuerChain) > 0
+}
+
+func (m *manager) List() []ManagedSecret {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	var (
+		secrets      []ManagedSecret
+		certificates = make(map[string]*x509.Certificate)
+	)
+
+	for name, infos := range m.store {
+		for _, item := range []struct {
+			class secretClass
+			info  *secretInfo
+		}{
+			{current, &infos.current},
+			{old, infos.old},
+			{bundle, infos.bundle},
+		} {
+			if item.info == nil || item.info.obj == nil {
+				continue
+			}
+
+			secret := managedSecret(name, item.class, item.info)
+			if item.class != bundle && infos.bundle != nil && isPartOfBundle(item.info.obj, infos.bundle.obj) {
+				secret.Bundle = infos.bundle.obj.Name
+			}
+
+			if certificate := certificateForSecret(item.info.obj); certificate != nil {
+				secret.NotBefore = &certificate.NotBefore
+				secret.NotAfter = &certificate.NotAfter
+				secret.IssuerChain = []string{certificate.Issuer.CommonName}
+				if certificate.IsCA {
+					certificates[certificate.Subject.CommonName] = certificate
+				}
+			}
+
+			secrets = append(secrets, secret)
+		}
+	}
+
+	// Complete the issuer chains once all CA certificates are known.
+	for i := range secrets {
+		secrets[i].IssuerChain = issuerChain(secrets[i].IssuerChain, certificates)
+	}
+
+	slices.SortFunc(secrets, func(a, b ManagedSecret) int {
+		if c := strings.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Class, b.Class)
+	})
+
+	return secrets
+}
+
+func managedSecret(name string, class secretClass, info *secretInfo) ManagedSecret {
+	secret := ManagedSecret{
+		Name:              name,
+		Class:             string(class),
+		Secret:            info.obj,
+		ConfigChecksum:    info.obj.Labels[LabelKeyChecksumConfig],
+		SigningCAChecksum: info.obj.Labels[LabelKeyChecksumSigningCA],
+		NotBefore:         timeFromLabel(info.obj, LabelKeyIssuedAtTime),
+		NotAfter:          timeFromLabel(info.obj, LabelKeyValidUntilTime),
+	}
+
+	if info.lastRotationInitiationTime > 0 {
+		secret.LastRotationInitiationTime = ptr.To(time.Unix(info.lastRotationInitiationTime, 0).UTC())
+	}
+
+	return secret
+}
+
+func timeFromLabel(secret *corev1.Secret, key string) *time.Time {
+	unix, err := strconv.ParseInt(secret.Labels[key], 10, 64)
+	if err != nil {
+		return nil
+	}
+	return ptr.To(time.Unix(unix, 0).UTC())
+}
+
+func certificateForSecret(secret *corev1.Secret) *x509.Certificate {
+	data := secret.Data[secretsutils.DataKeyCertificate]
+	if isCASecret(secret.Data) {
+		data = secret.Data[secretsutils.DataKeyCertificateCA]
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	certificate, err := utils.DecodeCertificate(data)
+	if err != nil {
+		return nil
+	}
+	return certificate
+}
+
+func isPartOfBundle(secret, bundleSecret *corev1.Secret) bool {
+	for _, keys := range [][2]string{
+		{secretsutils.DataKeyCertificateCA, secretsutils.DataKeyCertificateBundle},
+		{secretsutils.DataKeyRSAPrivateKey, secretsutils.DataKeyPrivateKeyBundle},
+	} {
+		if data := secret.Data[keys[0]]; len(data) > 0 && bytes.Contains(bundleSecret.Data[keys[1]], data) {
+			return true
+		}
+	}
+	return false
+}
+
+func issuerChain(chain []string, caCertificates map[string]*x509.Certificate) []string {
+	for len(chain) > 0 && len(chain) <= len(caCertificates) {
+		ca, ok := caCertificates[chain[len(chain)-1]]
+		if !ok || ca.Issuer.CommonName == ca.Subject.CommonName {
+			break
+		}
+		chain = append(chain, ca.Issuer.CommonName)
+	}
+	return chain
+}
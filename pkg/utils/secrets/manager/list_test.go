@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/utils"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("List", func() {
+	var (
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+		identity  = "test"
+
+		m          *manager
+		fakeClient client.Client
+		fakeClock  = testclock.NewFakeClock(time.Unix(1700000000, 0))
+
+		caConfig, serverConfig *secretsutils.CertificateSecretConfig
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+
+		mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, identity, Config{}, namespace)
+		Expect(err).NotTo(HaveOccurred())
+		m = mgr.(*manager)
+
+		caConfig = &secretsutils.CertificateSecretConfig{
+			Name:       "ca",
+			CommonName: "ca",
+			CertType:   secretsutils.CACert,
+		}
+		serverConfig = &secretsutils.CertificateSecretConfig{
+			Name:                        "server",
+			CommonName:                  "server",
+			CertType:                    secretsutils.ServerCert,
+			SkipPublishingCACertificate: true,
+		}
+	})
+
+	Describe("#List", func() {
+		It("should return nothing if no secrets are managed", func() {
+			Expect(m.List()).To(BeEmpty())
+		})
+
+		It("should return all managed secrets with their validity and issuers", func() {
+			caSecret, err := m.Generate(ctx, caConfig)
+			Expect(err).NotTo(HaveOccurred())
+			caBundleSecret, found := m.Get("ca", Bundle)
+			Expect(found).To(BeTrue())
+
+			serverSecret, err := m.Generate(ctx, serverConfig, SignedByCA("ca"))
+			Expect(err).NotTo(HaveOccurred())
+
+			basicAuthSecret, err := m.Generate(ctx, &secretsutils.BasicAuthSecretConfig{Name: "basic-auth", Format: secretsutils.BasicAuthFormatNormal, PasswordLength: 32}, Validity(time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+
+			caCert, err := utils.DecodeCertificate(caSecret.Data["ca.crt"])
+			Expect(err).NotTo(HaveOccurred())
+			serverCert, err := utils.DecodeCertificate(serverSecret.Data["tls.crt"])
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(m.List()).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{
+					"Name":           Equal("basic-auth"),
+					"Class":          Equal("current"),
+					"Secret":         Equal(basicAuthSecret),
+					"ConfigChecksum": Equal(basicAuthSecret.Labels["checksum-of-config"]),
+					"IssuerChain":    BeEmpty(),
+					"NotBefore":      PointTo(BeTemporally("~", fakeClock.Now(), time.Minute)),
+					"NotAfter":       PointTo(BeTemporally("==", fakeClock.Now().Add(time.Hour))),
+					"Bundle":         BeEmpty(),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"Name":        Equal("ca"),
+					"Class":       Equal("bundle"),
+					"Secret":      Equal(caBundleSecret),
+					"IssuerChain": BeEmpty(),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"Name":           Equal("ca"),
+					"Class":          Equal("current"),
+					"Secret":         Equal(caSecret),
+					"ConfigChecksum": Equal(caSecret.Labels["checksum-of-config"]),
+					"IssuerChain":    Equal([]string{caSecret.Name}),
+					"NotBefore":      PointTo(Equal(caCert.NotBefore)),
+					"NotAfter":       PointTo(Equal(caCert.NotAfter)),
+					"Bundle":         Equal(caBundleSecret.Name),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"Name":              Equal("server"),
+					"Class":             Equal("current"),
+					"Secret":            Equal(serverSecret),
+					"SigningCAChecksum": Equal(serverSecret.Labels["checksum-of-signing-ca"]),
+					"IssuerChain":       Equal([]string{caSecret.Name}),
+					"NotBefore":         PointTo(Equal(serverCert.NotBefore)),
+					"NotAfter":          PointTo(Equal(serverCert.NotAfter)),
+					"Bundle":            BeEmpty(),
+				}),
+			))
+		})
+
+		It("should return the old secret with the last rotation initiation time and the issuers of CAs signed by other CAs", func() {
+			_, err := m.Generate(ctx, caConfig, Rotate(KeepOld))
+			Expect(err).NotTo(HaveOccurred())
+
+			rotationTime := fakeClock.Now().Add(time.Hour)
+			mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, identity, Config{SecretNamesToTimes: map[string]time.Time{"ca": rotationTime}}, namespace)
+			Expect(err).NotTo(HaveOccurred())
+			m = mgr.(*manager)
+
+			caSecret, err := m.Generate(ctx, caConfig, Rotate(KeepOld))
+			Expect(err).NotTo(HaveOccurred())
+			caBundleSecret, _ := m.Get("ca", Bundle)
+
+			intermediateCASecret, err := m.Generate(ctx, &secretsutils.CertificateSecretConfig{Name: "intermediate-ca", CommonName: "intermediate-ca", CertType: secretsutils.CACert}, SignedByCA("ca"))
+			Expect(err).NotTo(HaveOccurred())
+
+			list := m.List()
+			Expect(list).To(HaveLen(4))
+			Expect(list[0]).To(MatchFields(IgnoreExtras, Fields{"Name": Equal("ca"), "Class": Equal("bundle")}))
+			Expect(list[1]).To(MatchFields(IgnoreExtras, Fields{
+				"Name":                       Equal("ca"),
+				"Class":                      Equal("current"),
+				"Secret":                     Equal(caSecret),
+				"LastRotationInitiationTime": PointTo(BeTemporally("==", rotationTime)),
+				"Bundle":                     Equal(caBundleSecret.Name),
+			}))
+			Expect(list[2]).To(MatchFields(IgnoreExtras, Fields{
+				"Name":                       Equal("ca"),
+				"Class":                      Equal("old"),
+				"LastRotationInitiationTime": BeNil(),
+				"Bundle":                     Equal(caBundleSecret.Name),
+			}))
+			Expect(list[3]).To(MatchFields(IgnoreExtras, Fields{
+				"Name":        Equal("intermediate-ca"),
+				"Class":       Equal("current"),
+				"Secret":      Equal(intermediateCASecret),
+				"IssuerChain": Equal([]string{caSecret.Name}),
+			}))
+
+		})
+	})
+})
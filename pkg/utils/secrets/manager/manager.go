@@ -79,6 +79,7 @@ type (
 		namespaces                  []string
 		identity                    string
 		lastRotationInitiationTimes nameToUnixTime
+		caKeyBackend                secretsutils.KeyBackend
 	}
 
 	nameToUnixTime map[string]string
@@ -102,6 +103,9 @@ type (
 		// SecretNamesToTimes is a map whose keys are secret names and whose values are the last rotation initiation
 		// times.
 		SecretNamesToTimes map[string]time.Time
+		// CAKeyBackend is an optional backend holding the private keys of newly generated CA secrets. If set, the CA
+		// secrets only contain references to the private keys.
+		CAKeyBackend secretsutils.KeyBackend
 	}
 )
 
@@ -140,6 +144,7 @@ func New(
 		namespaces:                  namespaces,
 		identity:                    identity,
 		lastRotationInitiationTimes: make(nameToUnixTime),
+		caKeyBackend:                rotation.CAKeyBackend,
 	}
 
 	if err := m.initialize(ctx, rotation); err != nil {
@@ -387,7 +392,42 @@ func unixTime(in time.Time) string {
 }
 
 func isCASecret(data map[string][]byte) bool {
-	return data[secretsutils.DataKeyCertificateCA] != nil && data[secretsutils.DataKeyPrivateKeyCA] != nil
+	return data[secretsutils.DataKeyCertificateCA] != nil && (data[secretsutils.DataKeyPrivateKeyCA] != nil || data[secretsutils.DataKeyPrivateKeyCAReference] != nil)
+}
+
+// loadCA loads the CA certificate from the given secret data. The private key is either part of the data or held by
+// the key backend of the manager.
+func (m *manager) loadCA(ctx context.Context, name string, data map[string][]byte) (*secretsutils.Certificate, error) {
+	if keyReference, ok := data[secretsutils.DataKeyPrivateKeyCAReference]; ok {
+		return secretsutils.LoadCertificateWithKeyBackend(ctx, name, keyReference, data[secretsutils.DataKeyCertificateCA], m.caKeyBackend)
+	}
+	return secretsutils.LoadCertificate(name, data[secretsutils.DataKeyPrivateKeyCA], data[secretsutils.DataKeyCertificateCA])
+}
+
+// deleteKeyFromBackend deletes the CA private key referenced in the given secret data from the key backend (if any).
+func (m *manager) deleteKeyFromBackend(ctx context.Context, data map[string][]byte) error {
+	keyReference, ok := data[secretsutils.DataKeyPrivateKeyCAReference]
+	if !ok || m.caKeyBackend == nil {
+		return nil
+	}
+
+	reference, err := secretsutils.ParseKeyReference(keyReference)
+	if err != nil {
+		return err
+	}
+	if reference.Backend != m.caKeyBackend.Name() {
+		return nil
+	}
+	return m.caKeyBackend.DeleteKey(ctx, reference.KeyID)
+}
+
+// generate generates the data for the given config. Certificates are generated with the given context since their
+// private keys might be created in the key backend.
+func generate(ctx context.Context, config secretsutils.ConfigInterface) (secretsutils.DataInterface, error) {
+	if certificateConfig, ok := config.(*secretsutils.CertificateSecretConfig); ok {
+		return certificateConfig.GenerateCertificateWithContext(ctx)
+	}
+	return config.Generate()
 }
 
 func certificateSecretConfig(config secretsutils.ConfigInterface) *secretsutils.CertificateSecretConfig {
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	registerOnce = make(chan struct{})
+
+	certificateExpirationTimestampSeconds *prometheus.GaugeVec
+)
+
+const metricsNamespace = "secrets_manager"
+
+// RegisterMetrics registers the metrics for the SecretsManager on the passed registry.
+// This function can only be called once.
+// If this function is not called, no metrics are collected in this package.
+func RegisterMetrics(r prometheus.Registerer) {
+	close(registerOnce) // Metrics can only be registered once on a registry.
+
+	factory := promauto.With(r)
+
+	certificateExpirationTimestampSeconds = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "certificate_expiration_timestamp_seconds",
+			Help:      "Unix timestamp of the end of the validity of certificates managed by a SecretsManager.",
+		},
+		[]string{
+			// The namespace of the secrets is not exposed as `namespace` label since this label is typically overwritten
+			// with the namespace of the scrape target by Prometheus.
+			"secret_namespace",
+			"manager_identity",
+			"name",
+			"class",
+			"secret",
+			"issuer_chain",
+		},
+	)
+}
+
+// DeleteMetrics deletes the metrics of all SecretsManagers for the given namespace. It should be called when the
+// namespace is deleted, e.g., after the deletion or migration of a shoot or the deletion of the garden.
+func DeleteMetrics(namespace string) {
+	if certificateExpirationTimestampSeconds == nil {
+		return
+	}
+	certificateExpirationTimestampSeconds.DeletePartialMatch(prometheus.Labels{"secret_namespace": namespace})
+}
+
+// recordMetrics replaces the metrics of this manager with the data of the currently managed secrets.
+func (m *manager) recordMetrics() {
+	if certificateExpirationTimestampSeconds == nil {
+		return
+	}
+
+	for _, namespace := range m.namespaces {
+		certificateExpirationTimestampSeconds.DeletePartialMatch(prometheus.Labels{"secret_namespace": namespace, "manager_identity": m.identity})
+	}
+
+	for _, secret := range m.List() {
+		if !secret.HasCertificate() || secret.NotAfter == nil {
+			continue
+		}
+
+		certificateExpirationTimestampSeconds.
+			WithLabelValues(secret.Secret.Namespace, m.identity, secret.Name, secret.Class, secret.Secret.Name, strings.Join(secret.IssuerChain, ",")).
+			Set(float64(secret.NotAfter.Unix()))
+	}
+}
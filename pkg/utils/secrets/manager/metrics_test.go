@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/utils"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Metrics", func() {
+	var (
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+		identity  = "test"
+
+		fakeClient client.Client
+		fakeClock  = testclock.NewFakeClock(time.Unix(1700000000, 0))
+		gauge      *prometheus.GaugeVec
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+
+		gauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "certificate_expiration_timestamp_seconds"}, []string{"secret_namespace", "manager_identity", "name", "class", "secret", "issuer_chain"})
+		DeferCleanup(test.WithVar(&certificateExpirationTimestampSeconds, gauge))
+	})
+
+	It("should record the certificate expiration on cleanup and delete the metrics of the namespace", func() {
+		m, err := New(ctx, logr.Discard(), fakeClock, fakeClient, identity, Config{}, namespace)
+		Expect(err).NotTo(HaveOccurred())
+
+		caSecret, err := m.Generate(ctx, &secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = m.Generate(ctx, &secretsutils.BasicAuthSecretConfig{Name: "basic-auth", Format: secretsutils.BasicAuthFormatNormal, PasswordLength: 32}, Validity(time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(m.Cleanup(ctx)).To(Succeed())
+
+		caCert, err := utils.DecodeCertificate(caSecret.Data["ca.crt"])
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(testutil.CollectAndCount(gauge)).To(Equal(1))
+		Expect(testutil.ToFloat64(gauge.WithLabelValues(namespace, identity, "ca", "current", caSecret.Name, caSecret.Name))).To(Equal(float64(caCert.NotAfter.Unix())))
+
+		By("Drop metrics of secrets which are no longer managed")
+		m, err = New(ctx, logr.Discard(), fakeClock, fakeClient, identity, Config{}, namespace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(m.Cleanup(ctx)).To(Succeed())
+		Expect(testutil.CollectAndCount(gauge)).To(BeZero())
+
+		By("Delete metrics for namespace")
+		gauge.WithLabelValues(namespace, identity, "ca", "current", caSecret.Name, caSecret.Name).Set(1)
+		gauge.WithLabelValues("other", identity, "ca", "current", caSecret.Name, caSecret.Name).Set(1)
+		DeleteMetrics(namespace)
+		Expect(testutil.CollectAndCompare(gauge, strings.NewReader(`
+# HELP certificate_expiration_timestamp_seconds
+# TYPE certificate_expiration_timestamp_seconds gauge
+certificate_expiration_timestamp_seconds{class="current",issuer_chain="`+caSecret.Name+`",manager_identity="test",name="ca",secret="`+caSecret.Name+`",secret_namespace="other"} 1
+`))).To(Succeed())
+	})
+})
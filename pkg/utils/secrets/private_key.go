@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// KeyAlgorithm is a string alias for private key algorithms.
+type KeyAlgorithm string
+
+const (
+	// KeyAlgorithmRSA indicates that an RSA private key should be generated.
+	KeyAlgorithmRSA KeyAlgorithm = "RSA"
+	// KeyAlgorithmECDSAP256 indicates that an ECDSA private key on the NIST P-256 curve should be generated.
+	KeyAlgorithmECDSAP256 KeyAlgorithm = "ECDSA-P256"
+	// KeyAlgorithmECDSAP384 indicates that an ECDSA private key on the NIST P-384 curve should be generated.
+	KeyAlgorithmECDSAP384 KeyAlgorithm = "ECDSA-P384"
+	// KeyAlgorithmEd25519 indicates that an Ed25519 private key should be generated.
+	KeyAlgorithmEd25519 KeyAlgorithm = "Ed25519"
+)
+
+// generatePrivateKey generates a private key for the given algorithm. An empty algorithm defaults to RSA. The number of
+// bits is only considered for RSA keys.
+func generatePrivateKey(algorithm KeyAlgorithm, rsaBits int) (crypto.Signer, error) {
+	switch algorithm {
+	case "", KeyAlgorithmRSA:
+		return GenerateKey(rand.Reader, rsaBits)
+	case KeyAlgorithmECDSAP256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case KeyAlgorithmECDSAP384:
+		return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case KeyAlgorithmEd25519:
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		return privateKey, err
+	}
+
+	return nil, fmt.Errorf("unsupported key algorithm %q", algorithm)
+}
+
+// encodePrivateKey encodes the given private key to the PEM format. RSA keys are encoded in the given PKCS format, ECDSA
+// keys are encoded in the SEC 1 format unless PKCS8 is requested, and Ed25519 keys are always encoded in the PKCS8
+// format.
+func encodePrivateKey(privateKey crypto.Signer, pkcs int) ([]byte, error) {
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		if pkcs == PKCS8 {
+			return utils.EncodePrivateKeyInPKCS8(key)
+		}
+		return utils.EncodePrivateKey(key), nil
+	case *ecdsa.PrivateKey:
+		if pkcs == PKCS8 {
+			return utils.EncodePKCS8PrivateKey(key)
+		}
+		return utils.EncodeECPrivateKey(key)
+	case ed25519.PrivateKey:
+		return utils.EncodePKCS8PrivateKey(key)
+	}
+
+	return nil, fmt.Errorf("unsupported private key type %T", privateKey)
+}
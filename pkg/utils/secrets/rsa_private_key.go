@@ -6,7 +6,7 @@ package secrets
 
 import (
 	"bytes"
-	"crypto/rand"
+	"crypto"
 	"crypto/rsa"
 
 	"golang.org/x/crypto/ssh"
@@ -27,14 +27,22 @@ type RSASecretConfig struct {
 
 	Bits       int
 	UsedForSSH bool
+	// KeyAlgorithm is the algorithm of the generated private key. Defaults to KeyAlgorithmRSA. Despite the name of this
+	// config, ECDSA and Ed25519 keys are supported as well, they are stored under the same data keys. Bits is only
+	// considered for RSA keys.
+	KeyAlgorithm KeyAlgorithm
 }
 
 // RSAKeys contains the private key, the public key, and optionally the OpenSSH-formatted authorized keys file data.
 type RSAKeys struct {
 	Name string
 
+	// PrivateKey and PublicKey are only set for RSA keys.
 	PrivateKey *rsa.PrivateKey
 	PublicKey  *rsa.PublicKey
+	// Signer is the private key of any supported algorithm.
+	Signer        crypto.Signer
+	PrivateKeyPEM []byte
 
 	OpenSSHAuthorizedKey []byte
 }
@@ -46,33 +54,48 @@ func (s *RSASecretConfig) GetName() string {
 
 // Generate implements ConfigInterface.
 func (s *RSASecretConfig) Generate() (DataInterface, error) {
-	privateKey, err := GenerateKey(rand.Reader, s.Bits)
+	privateKey, err := generatePrivateKey(s.KeyAlgorithm, s.Bits)
 	if err != nil {
 		return nil, err
 	}
 
-	rsa := &RSAKeys{
+	privateKeyPEM, err := encodePrivateKey(privateKey, PKCS1)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := &RSAKeys{
 		Name: s.Name,
 
-		PrivateKey: privateKey,
-		PublicKey:  &privateKey.PublicKey,
+		Signer:        privateKey,
+		PrivateKeyPEM: privateKeyPEM,
+	}
+
+	if rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey); ok {
+		keys.PrivateKey = rsaPrivateKey
+		keys.PublicKey = &rsaPrivateKey.PublicKey
 	}
 
 	if s.UsedForSSH {
-		sshPublicKey, err := generateSSHAuthorizedKeys(rsa.PrivateKey)
+		sshPublicKey, err := generateSSHAuthorizedKeys(privateKey.Public())
 		if err != nil {
 			return nil, err
 		}
-		rsa.OpenSSHAuthorizedKey = sshPublicKey
+		keys.OpenSSHAuthorizedKey = sshPublicKey
 	}
 
-	return rsa, nil
+	return keys, nil
 }
 
 // SecretData computes the data map which can be used in a Kubernetes secret.
 func (r *RSAKeys) SecretData() map[string][]byte {
+	privateKeyPEM := r.PrivateKeyPEM
+	if privateKeyPEM == nil {
+		privateKeyPEM = utils.EncodePrivateKey(r.PrivateKey)
+	}
+
 	data := map[string][]byte{
-		DataKeyRSAPrivateKey: utils.EncodePrivateKey(r.PrivateKey),
+		DataKeyRSAPrivateKey: privateKeyPEM,
 	}
 
 	if r.OpenSSHAuthorizedKey != nil {
@@ -82,14 +105,12 @@ func (r *RSAKeys) SecretData() map[string][]byte {
 	return data
 }
 
-// generateSSHAuthorizedKeys takes a RSA private key <privateKey> and generates the corresponding public key.
-// It serializes the public key for inclusion in an OpenSSH `authorized_keys` file and it trims the new-
-// line at the end.
-func generateSSHAuthorizedKeys(privateKey *rsa.PrivateKey) ([]byte, error) {
-	pubKey, err := ssh.NewPublicKey(&privateKey.PublicKey)
+// generateSSHAuthorizedKeys takes a public key <publicKey> and serializes it for inclusion in an OpenSSH
+// `authorized_keys` file and it trims the new-line at the end.
+func generateSSHAuthorizedKeys(publicKey crypto.PublicKey) ([]byte, error) {
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
 	if err != nil {
 		return nil, err
 	}
-	publicKey := ssh.MarshalAuthorizedKey(pubKey)
-	return bytes.Trim(publicKey, "\x0a"), nil
+	return bytes.Trim(ssh.MarshalAuthorizedKey(sshPublicKey), "\x0a"), nil
 }
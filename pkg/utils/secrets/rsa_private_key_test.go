@@ -47,6 +47,31 @@ var _ = Describe("RSA Private Key Secrets", func() {
 				Expect(ok).To(BeTrue())
 				Expect(rsaSecret.OpenSSHAuthorizedKey).NotTo(BeNil())
 			})
+
+			DescribeTable("should generate keys with the configured key algorithm",
+				func(algorithm KeyAlgorithm, pemType, sshKeyType string) {
+					rsaPrivateKeyConfig.KeyAlgorithm = algorithm
+					rsaPrivateKeyConfig.UsedForSSH = true
+
+					obj, err := rsaPrivateKeyConfig.Generate()
+					Expect(err).NotTo(HaveOccurred())
+
+					keys, ok := obj.(*RSAKeys)
+					Expect(ok).To(BeTrue())
+					Expect(keys.PrivateKey).To(BeNil())
+					Expect(keys.PublicKey).To(BeNil())
+					Expect(keys.Signer).NotTo(BeNil())
+					Expect(string(keys.OpenSSHAuthorizedKey)).To(HavePrefix(sshKeyType + " "))
+
+					privateKeyPEM := keys.SecretData()[DataKeyRSAPrivateKey]
+					Expect(string(privateKeyPEM)).To(HavePrefix("-----BEGIN " + pemType + "-----"))
+					Expect(utils.DecodePrivateKeySigner(privateKeyPEM)).To(Equal(keys.Signer))
+				},
+
+				Entry("ECDSA P-256", KeyAlgorithmECDSAP256, "EC PRIVATE KEY", "ecdsa-sha2-nistp256"),
+				Entry("ECDSA P-384", KeyAlgorithmECDSAP384, "EC PRIVATE KEY", "ecdsa-sha2-nistp384"),
+				Entry("Ed25519", KeyAlgorithmEd25519, "PRIVATE KEY", "ssh-ed25519"),
+			)
 		})
 	})
 
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// SSHUserCertificateConfig contains the information for issuing an OpenSSH user certificate.
+type SSHUserCertificateConfig struct {
+	// PublicKey is the public key to certify in the OpenSSH authorized_keys format.
+	PublicKey []byte
+	// KeyID identifies the certificate, it is logged by sshd when the certificate is used for authentication.
+	KeyID string
+	// Serial is the serial number of the certificate.
+	Serial uint64
+	// Principals are the user names the certificate is valid for.
+	Principals []string
+	// ValidAfter and ValidBefore define the validity period of the certificate.
+	ValidAfter  time.Time
+	ValidBefore time.Time
+	// Extensions are the permissions granted by the certificate, e.g. `permit-pty`.
+	Extensions []string
+}
+
+// SignSSHUserCertificate issues an OpenSSH user certificate for the given config. It is signed by the certificate
+// authority with the given PEM-encoded private key. The certificate is returned in the OpenSSH authorized_keys format
+// without the trailing new-line.
+func SignSSHUserCertificate(caPrivateKeyPEM []byte, config SSHUserCertificateConfig) ([]byte, error) {
+	caSigner, err := ssh.ParsePrivateKey(caPrivateKeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing private key of SSH certificate authority: %w", err)
+	}
+
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey(config.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing SSH public key: %w", err)
+	}
+	if _, ok := publicKey.(*ssh.Certificate); ok {
+		return nil, fmt.Errorf("SSH public key must not be a certificate")
+	}
+
+	if !config.ValidBefore.After(config.ValidAfter) {
+		return nil, fmt.Errorf("end of validity period (%s) must be after its start (%s)", config.ValidBefore, config.ValidAfter)
+	}
+
+	extensions := make(map[string]string, len(config.Extensions))
+	for _, extension := range config.Extensions {
+		extensions[extension] = ""
+	}
+
+	certificate := &ssh.Certificate{
+		Key:             publicKey,
+		Serial:          config.Serial,
+		CertType:        ssh.UserCert,
+		KeyId:           config.KeyID,
+		ValidPrincipals: config.Principals,
+		ValidAfter:      uint64(config.ValidAfter.Unix()),  // #nosec G115 -- Unix timestamps of valid certificates are positive.
+		ValidBefore:     uint64(config.ValidBefore.Unix()), // #nosec G115 -- Unix timestamps of valid certificates are positive.
+		Permissions:     ssh.Permissions{Extensions: extensions},
+	}
+
+	if err := certificate.SignCert(rand.Reader, caSigner); err != nil {
+		return nil, fmt.Errorf("failed signing SSH certificate: %w", err)
+	}
+
+	return bytes.Trim(ssh.MarshalAuthorizedKey(certificate), "\x0a"), nil
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+
+	. "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("SSH Certificates", func() {
+	Describe("#SignSSHUserCertificate", func() {
+		var (
+			ca, user *RSAKeys
+			config   SSHUserCertificateConfig
+
+			now = time.Unix(1700000000, 0)
+		)
+
+		BeforeEach(func() {
+			obj, err := (&RSASecretConfig{Name: "ssh-ca", Bits: 2048, UsedForSSH: true}).Generate()
+			Expect(err).NotTo(HaveOccurred())
+			ca = obj.(*RSAKeys)
+
+			obj, err = (&RSASecretConfig{Name: "user", Bits: 2048, UsedForSSH: true}).Generate()
+			Expect(err).NotTo(HaveOccurred())
+			user = obj.(*RSAKeys)
+
+			config = SSHUserCertificateConfig{
+				PublicKey:   user.OpenSSHAuthorizedKey,
+				KeyID:       "foo@example.com",
+				Serial:      42,
+				Principals:  []string{"gardener"},
+				ValidAfter:  now,
+				ValidBefore: now.Add(time.Hour),
+				Extensions:  []string{"permit-pty"},
+			}
+		})
+
+		It("should issue a user certificate signed by the certificate authority", func() {
+			data, err := SignSSHUserCertificate(ca.PrivateKeyPEM, config)
+			Expect(err).NotTo(HaveOccurred())
+
+			publicKey, _, _, _, err := ssh.ParseAuthorizedKey(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(publicKey).To(BeAssignableToTypeOf(&ssh.Certificate{}))
+
+			certificate := publicKey.(*ssh.Certificate)
+			Expect(certificate.CertType).To(Equal(uint32(ssh.UserCert)))
+			Expect(certificate.KeyId).To(Equal("foo@example.com"))
+			Expect(certificate.Serial).To(Equal(uint64(42)))
+			Expect(certificate.ValidPrincipals).To(ConsistOf("gardener"))
+			Expect(certificate.ValidAfter).To(Equal(uint64(now.Unix())))
+			Expect(certificate.ValidBefore).To(Equal(uint64(now.Add(time.Hour).Unix())))
+			Expect(certificate.Permissions.Extensions).To(Equal(map[string]string{"permit-pty": ""}))
+			Expect(ssh.MarshalAuthorizedKey(certificate.Key)).To(Equal(append(user.OpenSSHAuthorizedKey, '\n')))
+			Expect(ssh.MarshalAuthorizedKey(certificate.SignatureKey)).To(Equal(append(ca.OpenSSHAuthorizedKey, '\n')))
+
+			checker := &ssh.CertChecker{
+				IsUserAuthority: func(auth ssh.PublicKey) bool {
+					return string(ssh.MarshalAuthorizedKey(auth)) == string(ca.OpenSSHAuthorizedKey)+"\n"
+				},
+				Clock: func() time.Time { return now.Add(time.Minute) },
+			}
+			Expect(checker.CheckCert("gardener", certificate)).To(Succeed())
+			Expect(checker.CheckCert("root", certificate)).NotTo(Succeed())
+		})
+
+		It("should fail for an invalid private key", func() {
+			_, err := SignSSHUserCertificate([]byte("foo"), config)
+			Expect(err).To(MatchError(ContainSubstring("failed parsing private key of SSH certificate authority")))
+		})
+
+		It("should fail for an invalid public key", func() {
+			config.PublicKey = []byte("foo")
+			_, err := SignSSHUserCertificate(ca.PrivateKeyPEM, config)
+			Expect(err).To(MatchError(ContainSubstring("failed parsing SSH public key")))
+		})
+
+		It("should fail if the public key is a certificate", func() {
+			data, err := SignSSHUserCertificate(ca.PrivateKeyPEM, config)
+			Expect(err).NotTo(HaveOccurred())
+
+			config.PublicKey = data
+			_, err = SignSSHUserCertificate(ca.PrivateKeyPEM, config)
+			Expect(err).To(MatchError("SSH public key must not be a certificate"))
+		})
+
+		It("should fail for an invalid validity period", func() {
+			config.ValidBefore = now
+			_, err := SignSSHUserCertificate(ca.PrivateKeyPEM, config)
+			Expect(err).To(MatchError(ContainSubstring("must be after its start")))
+		})
+	})
+})
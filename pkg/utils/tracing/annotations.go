@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const headerTraceParent = "traceparent"
+
+var propagator = propagation.TraceContext{}
+
+// annotationCarrier is a propagation.TextMapCarrier which stores the `traceparent` header of the W3C trace context in
+// the annotations of an object. All other headers are dropped.
+type annotationCarrier struct {
+	obj metav1.Object
+}
+
+var _ propagation.TextMapCarrier = annotationCarrier{}
+
+func (c annotationCarrier) Get(key string) string {
+	if key != headerTraceParent {
+		return ""
+	}
+	return c.obj.GetAnnotations()[v1beta1constants.GardenerTraceParent]
+}
+
+func (c annotationCarrier) Set(key, value string) {
+	if key != headerTraceParent {
+		return
+	}
+	kubernetesutils.SetMetaDataAnnotation(c.obj, v1beta1constants.GardenerTraceParent, value)
+}
+
+func (c annotationCarrier) Keys() []string {
+	return []string{headerTraceParent}
+}
+
+// InjectIntoAnnotations stores the span context of the given context in the annotations of the given object, so that
+// the controller reconciling the object can continue the trace. The span context is only stored if the context contains
+// a span which is recorded and sampled, i.e., if tracing is enabled. Otherwise, a previously stored span context is
+// removed from the annotations, so that objects are not patched with new span IDs on every reconciliation without
+// anybody recording the trace.
+func InjectIntoAnnotations(ctx context.Context, obj metav1.Object) {
+	if span := trace.SpanFromContext(ctx); !span.IsRecording() || !span.SpanContext().IsSampled() {
+		annotations := obj.GetAnnotations()
+		delete(annotations, v1beta1constants.GardenerTraceParent)
+		obj.SetAnnotations(annotations)
+		return
+	}
+
+	propagator.Inject(ctx, annotationCarrier{obj})
+}
+
+// ContextFromAnnotations returns a copy of the given context which contains the remote span context stored in the
+// annotations of the given object. If the object does not have a valid span context annotation, the given context is
+// returned unchanged.
+func ContextFromAnnotations(ctx context.Context, obj metav1.Object) context.Context {
+	return propagator.Extract(ctx, annotationCarrier{obj})
+}
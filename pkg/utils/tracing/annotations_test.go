@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	. "github.com/gardener/gardener/pkg/utils/tracing"
+)
+
+var _ = Describe("Annotations", func() {
+	var (
+		ctx         context.Context
+		obj         *corev1.ConfigMap
+		spanContext trace.SpanContext
+	)
+
+	BeforeEach(func() {
+		spanContext = trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+			SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+			TraceFlags: trace.FlagsSampled,
+		})
+		ctx = trace.ContextWithSpanContext(context.Background(), spanContext)
+		obj = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"foo": "bar"}}}
+	})
+
+	Describe("#InjectIntoAnnotations", func() {
+		var span trace.Span
+
+		BeforeEach(func() {
+			tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSampler(sdktrace.AlwaysSample()))
+			ctx, span = tracerProvider.Tracer("test").Start(ctx, "test")
+			DeferCleanup(func() { span.End() })
+		})
+
+		It("should store the span context in the annotations", func() {
+			InjectIntoAnnotations(ctx, obj)
+
+			Expect(obj.Annotations).To(Equal(map[string]string{
+				"foo":                                "bar",
+				v1beta1constants.GardenerTraceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-" + span.SpanContext().SpanID().String() + "-01",
+			}))
+		})
+
+		It("should remove a stale span context if the context does not contain a span", func() {
+			obj.Annotations[v1beta1constants.GardenerTraceParent] = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+			InjectIntoAnnotations(context.Background(), obj)
+
+			Expect(obj.Annotations).To(Equal(map[string]string{"foo": "bar"}))
+		})
+
+		It("should not store the span context if the span is not recorded", func() {
+			InjectIntoAnnotations(trace.ContextWithSpanContext(context.Background(), spanContext), obj)
+
+			Expect(obj.Annotations).To(Equal(map[string]string{"foo": "bar"}))
+		})
+
+		It("should not store the span context if the span is not sampled", func() {
+			tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSampler(sdktrace.NeverSample()))
+			ctx, span := tracerProvider.Tracer("test").Start(context.Background(), "test")
+			defer span.End()
+
+			InjectIntoAnnotations(ctx, obj)
+
+			Expect(obj.Annotations).To(Equal(map[string]string{"foo": "bar"}))
+		})
+	})
+
+	Describe("#ContextFromAnnotations", func() {
+		It("should return a context with the remote span context", func() {
+			obj.Annotations[v1beta1constants.GardenerTraceParent] = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+			Expect(trace.SpanContextFromContext(ContextFromAnnotations(context.Background(), obj))).To(Equal(spanContext.WithRemote(true)))
+		})
+
+		It("should return a context without span context if the annotation is missing", func() {
+			Expect(trace.SpanContextFromContext(ContextFromAnnotations(context.Background(), obj)).IsValid()).To(BeFalse())
+		})
+
+		It("should return a context without span context if the annotation is invalid", func() {
+			obj.Annotations[v1beta1constants.GardenerTraceParent] = "invalid"
+
+			Expect(trace.SpanContextFromContext(ContextFromAnnotations(context.Background(), obj)).IsValid()).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tracing contains utilities for recording and exporting OpenTelemetry traces and for propagating the trace
+// context between components via annotations on Kubernetes objects.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"k8s.io/component-base/version"
+)
+
+// NewTracerProvider returns a tracer provider which exports the recorded spans in batches via OTLP/gRPC to the given
+// endpoint (`host:port`). If insecure is true, the connection to the endpoint does not use TLS. All spans are
+// associated with a resource describing the given service.
+// The returned provider must be shut down to flush the remaining spans before the process exits.
+func NewTracerProvider(ctx context.Context, serviceName, endpoint string, insecure bool) (*sdktrace.TracerProvider, error) {
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+	if insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating OTLP trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(version.Get().GitVersion),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed creating trace resource: %w", err)
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	), nil
+}
+
+// SetupGlobalTracerProvider creates a tracer provider via NewTracerProvider and registers it as the global tracer
+// provider, which is used by all tracers obtained via `otel.Tracer`. The returned function shuts down the tracer
+// provider and must be called before the process exits to flush the remaining spans.
+func SetupGlobalTracerProvider(ctx context.Context, serviceName, endpoint string, insecure bool) (func(context.Context) error, error) {
+	tracerProvider, err := NewTracerProvider(ctx, serviceName, endpoint, insecure)
+	if err != nil {
+		return nil, err
+	}
+
+	otel.SetTracerProvider(tracerProvider)
+	return tracerProvider.Shutdown, nil
+}
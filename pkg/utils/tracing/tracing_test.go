@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing_test
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+
+	. "github.com/gardener/gardener/pkg/utils/tracing"
+)
+
+// fakeCollector is a stand-in for an OTLP collector which records all received spans.
+type fakeCollector struct {
+	coltracepb.UnimplementedTraceServiceServer
+
+	lock  sync.Mutex
+	spans []*tracepb.ResourceSpans
+}
+
+func (c *fakeCollector) Export(_ context.Context, req *coltracepb.ExportTraceServiceRequest) (*coltracepb.ExportTraceServiceResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.spans = append(c.spans, req.GetResourceSpans()...)
+	return &coltracepb.ExportTraceServiceResponse{}, nil
+}
+
+func (c *fakeCollector) ResourceSpans() []*tracepb.ResourceSpans {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return append([]*tracepb.ResourceSpans(nil), c.spans...)
+}
+
+var _ = Describe("Tracing", func() {
+	Describe("#NewTracerProvider", func() {
+		var (
+			ctx       = context.Background()
+			collector *fakeCollector
+			endpoint  string
+		)
+
+		BeforeEach(func() {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			endpoint = listener.Addr().String()
+
+			collector = &fakeCollector{}
+			server := grpc.NewServer()
+			coltracepb.RegisterTraceServiceServer(server, collector)
+
+			go func() {
+				defer GinkgoRecover()
+				Expect(server.Serve(listener)).To(Succeed())
+			}()
+			DeferCleanup(server.Stop)
+		})
+
+		It("should export the recorded spans to the endpoint", func() {
+			tracerProvider, err := NewTracerProvider(ctx, "test-service", endpoint, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, span := tracerProvider.Tracer("test").Start(ctx, "test-span")
+			span.End()
+
+			Expect(tracerProvider.Shutdown(ctx)).To(Succeed())
+
+			resourceSpans := collector.ResourceSpans()
+			Expect(resourceSpans).To(HaveLen(1))
+
+			var serviceName string
+			for _, attr := range resourceSpans[0].GetResource().GetAttributes() {
+				if attr.GetKey() == "service.name" {
+					serviceName = attr.GetValue().GetStringValue()
+				}
+			}
+			Expect(serviceName).To(Equal("test-service"))
+
+			Expect(resourceSpans[0].GetScopeSpans()).To(HaveLen(1))
+			Expect(resourceSpans[0].GetScopeSpans()[0].GetSpans()).To(ConsistOf(
+				HaveField("Name", "test-span"),
+			))
+		})
+	})
+})
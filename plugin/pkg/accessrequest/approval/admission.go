@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package approval
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/apiserver/pkg/authentication/user"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameAccessRequestApproval, func(_ io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// AccessRequestApproval contains the admission handler and listers.
+type AccessRequestApproval struct {
+	*admission.Handler
+
+	projectLister gardencorev1beta1listers.ProjectLister
+	readyFunc     admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsCoreInformerFactory(&AccessRequestApproval{})
+
+	readyFuncs []admission.ReadyFunc
+)
+
+// New creates a new AccessRequestApproval admission plugin.
+func New() (*AccessRequestApproval, error) {
+	return &AccessRequestApproval{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (a *AccessRequestApproval) AssignReadyFunc(f admission.ReadyFunc) {
+	a.readyFunc = f
+	a.SetReadyFunc(f)
+}
+
+// SetCoreInformerFactory gets Lister from SharedInformerFactory.
+func (a *AccessRequestApproval) SetCoreInformerFactory(f gardencoreinformers.SharedInformerFactory) {
+	projectInformer := f.Core().V1beta1().Projects()
+	a.projectLister = projectInformer.Lister()
+
+	readyFuncs = append(readyFuncs, projectInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (a *AccessRequestApproval) ValidateInitialization() error {
+	if a.projectLister == nil {
+		return errors.New("missing project lister")
+	}
+	return nil
+}
+
+var (
+	_ admission.MutationInterface   = (*AccessRequestApproval)(nil)
+	_ admission.ValidationInterface = (*AccessRequestApproval)(nil)
+)
+
+// Admit maintains the access.gardener.cloud/requested-by and access.gardener.cloud/approved-by annotations.
+func (a *AccessRequestApproval) Admit(_ context.Context, attrs admission.Attributes, _ admission.ObjectInterfaces) error {
+	accessRequest, oldAccessRequest, err := accessRequestsFromAttributes(attrs)
+	if err != nil || accessRequest == nil {
+		return err
+	}
+
+	switch attrs.GetOperation() {
+	case admission.Create:
+		kubernetesutils.SetMetaDataAnnotation(accessRequest, v1beta1constants.AccessRequestRequestedBy, attrs.GetUserInfo().GetName())
+		delete(accessRequest.Annotations, v1beta1constants.AccessRequestApprovedBy)
+
+	case admission.Update:
+		if requestedBy, ok := oldAccessRequest.Annotations[v1beta1constants.AccessRequestRequestedBy]; ok {
+			kubernetesutils.SetMetaDataAnnotation(accessRequest, v1beta1constants.AccessRequestRequestedBy, requestedBy)
+		} else {
+			delete(accessRequest.Annotations, v1beta1constants.AccessRequestRequestedBy)
+		}
+
+		if !isApproved(oldAccessRequest) && isApproved(accessRequest) {
+			kubernetesutils.SetMetaDataAnnotation(accessRequest, v1beta1constants.AccessRequestApprovedBy, attrs.GetUserInfo().GetName())
+		} else if isApproved(oldAccessRequest) && isApproved(accessRequest) {
+			kubernetesutils.SetMetaDataAnnotation(accessRequest, v1beta1constants.AccessRequestApprovedBy, oldAccessRequest.Annotations[v1beta1constants.AccessRequestApprovedBy])
+		} else {
+			delete(accessRequest.Annotations, v1beta1constants.AccessRequestApprovedBy)
+		}
+	}
+
+	return nil
+}
+
+// Validate ensures that AccessRequests are only approved by the owner of the project and that the owner neither
+// approves their own requests nor requests on behalf of themselves.
+func (a *AccessRequestApproval) Validate(_ context.Context, attrs admission.Attributes, _ admission.ObjectInterfaces) error {
+	accessRequest, oldAccessRequest, err := accessRequestsFromAttributes(attrs)
+	if err != nil || accessRequest == nil {
+		return err
+	}
+
+	switch attrs.GetOperation() {
+	case admission.Create:
+		if isApproved(accessRequest) {
+			return admission.NewForbidden(attrs, fmt.Errorf("access requests cannot be approved on creation - the owner of the project must approve the request by setting the %q annotation afterwards", v1beta1constants.AccessRequestApproved))
+		}
+		return nil
+
+	case admission.Update:
+		if isApproved(oldAccessRequest) && !isApproved(accessRequest) {
+			return admission.NewForbidden(attrs, errors.New("the approval of an access request cannot be withdrawn - delete the access request instead"))
+		}
+		if isApproved(oldAccessRequest) || !isApproved(accessRequest) {
+			return nil
+		}
+	}
+
+	// Wait until the caches have been synced
+	if a.readyFunc == nil {
+		a.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !a.WaitForReady() {
+		return admission.NewForbidden(attrs, errors.New("not yet ready to handle request"))
+	}
+
+	project, err := admissionutils.ProjectForNamespaceFromLister(a.projectLister, accessRequest.Namespace)
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not find project for namespace %s: %w", accessRequest.Namespace, err))
+	}
+
+	if err := checkApproval(project, accessRequest, oldAccessRequest.Annotations[v1beta1constants.AccessRequestRequestedBy], attrs.GetUserInfo()); err != nil {
+		return admission.NewForbidden(attrs, err)
+	}
+	return nil
+}
+
+func checkApproval(project *gardencorev1beta1.Project, accessRequest *core.AccessRequest, requestedBy string, userInfo user.Info) error {
+	if project.Spec.Owner == nil || !subjectMatchesUser(*project.Spec.Owner, userInfo) {
+		return fmt.Errorf("only the owner of project %s is allowed to approve access requests", project.Name)
+	}
+
+	if requestedBy == userInfo.GetName() {
+		return errors.New("you are not allowed to both request the access and approve the request - another subject must request the access")
+	}
+
+	if subjectMatchesUser(accessRequest.Spec.Subject, userInfo) {
+		return errors.New("you are not allowed to approve an access request for yourself")
+	}
+
+	if slices.ContainsFunc(project.Spec.Members, func(member gardencorev1beta1.ProjectMember) bool {
+		return member.Subject == accessRequest.Spec.Subject && member.ExpirationTimestamp == nil
+	}) {
+		return fmt.Errorf("%s %q is already a permanent member of project %s", accessRequest.Spec.Subject.Kind, accessRequest.Spec.Subject.Name, project.Name)
+	}
+
+	return nil
+}
+
+func accessRequestsFromAttributes(attrs admission.Attributes) (*core.AccessRequest, *core.AccessRequest, error) {
+	// Ignore all kinds other than AccessRequest
+	if attrs.GetKind().GroupKind() != core.Kind("AccessRequest") {
+		return nil, nil, nil
+	}
+
+	// Ignore updates to status or other subresources
+	if attrs.GetSubresource() != "" {
+		return nil, nil, nil
+	}
+
+	accessRequest, ok := attrs.GetObject().(*core.AccessRequest)
+	if !ok {
+		return nil, nil, apierrors.NewBadRequest("could not convert object to AccessRequest")
+	}
+
+	if attrs.GetOperation() != admission.Update {
+		return accessRequest, nil, nil
+	}
+
+	oldAccessRequest, ok := attrs.GetOldObject().(*core.AccessRequest)
+	if !ok {
+		return nil, nil, apierrors.NewBadRequest("could not convert old object to AccessRequest")
+	}
+
+	return accessRequest, oldAccessRequest, nil
+}
+
+func isApproved(accessRequest *core.AccessRequest) bool {
+	return accessRequest.Annotations[v1beta1constants.AccessRequestApproved] == "true"
+}
+
+func subjectMatchesUser(subject rbacv1.Subject, userInfo user.Info) bool {
+	switch subject.Kind {
+	case rbacv1.UserKind:
+		return subject.Name == userInfo.GetName()
+	case rbacv1.GroupKind:
+		return slices.Contains(userInfo.GetGroups(), subject.Name)
+	case rbacv1.ServiceAccountKind:
+		return serviceaccount.MakeUsername(subject.Namespace, subject.Name) == userInfo.GetName()
+	}
+	return false
+}
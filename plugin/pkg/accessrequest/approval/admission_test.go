@@ -0,0 +1,253 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package approval_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	. "github.com/gardener/gardener/plugin/pkg/accessrequest/approval"
+)
+
+var _ = Describe("AccessRequestApproval", func() {
+	var (
+		ctx = context.TODO()
+
+		admissionHandler *AccessRequestApproval
+		projectStore     cache.Store
+
+		project          *gardencorev1beta1.Project
+		accessRequest    *core.AccessRequest
+		oldAccessRequest *core.AccessRequest
+
+		owner     = &user.DefaultInfo{Name: "owner"}
+		requester = &user.DefaultInfo{Name: "requester"}
+		subject   = rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "alice"}
+	)
+
+	BeforeEach(func() {
+		admissionHandler, _ = New()
+		admissionHandler.AssignReadyFunc(func() bool { return true })
+
+		coreInformerFactory := gardencoreinformers.NewSharedInformerFactory(nil, 0)
+		admissionHandler.SetCoreInformerFactory(coreInformerFactory)
+		projectStore = coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore()
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+			Spec: gardencorev1beta1.ProjectSpec{
+				Namespace: ptr.To("garden-dev"),
+				Owner:     &rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: owner.Name},
+			},
+		}
+		Expect(projectStore.Add(project)).To(Succeed())
+
+		oldAccessRequest = &core.AccessRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "request",
+				Namespace:   "garden-dev",
+				Annotations: map[string]string{"access.gardener.cloud/requested-by": requester.Name},
+			},
+			Spec: core.AccessRequestSpec{
+				Subject:  subject,
+				Roles:    []string{core.ProjectMemberAdmin},
+				Duration: metav1.Duration{Duration: time.Hour},
+				Reason:   "incident",
+			},
+		}
+		accessRequest = oldAccessRequest.DeepCopy()
+	})
+
+	createAttributes := func(userInfo user.Info) admission.Attributes {
+		return admission.NewAttributesRecord(accessRequest, nil, core.Kind("AccessRequest").WithVersion("version"), accessRequest.Namespace, accessRequest.Name, core.Resource("accessrequests").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+	}
+
+	updateAttributes := func(userInfo user.Info) admission.Attributes {
+		return admission.NewAttributesRecord(accessRequest, oldAccessRequest, core.Kind("AccessRequest").WithVersion("version"), accessRequest.Namespace, accessRequest.Name, core.Resource("accessrequests").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+	}
+
+	approve := func(obj *core.AccessRequest) {
+		metav1.SetMetaDataAnnotation(&obj.ObjectMeta, "access.gardener.cloud/approved", "true")
+	}
+
+	Describe("#Admit", func() {
+		It("should ignore other kinds", func() {
+			attrs := admission.NewAttributesRecord(nil, nil, core.Kind("Foo").WithVersion("version"), "", "", core.Resource("foos").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, owner)
+
+			Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+		})
+
+		It("should ignore subresources", func() {
+			attrs := admission.NewAttributesRecord(accessRequest, oldAccessRequest, core.Kind("AccessRequest").WithVersion("version"), accessRequest.Namespace, accessRequest.Name, core.Resource("accessrequests").WithVersion("version"), "status", admission.Update, &metav1.UpdateOptions{}, false, owner)
+			accessRequest.Annotations["access.gardener.cloud/requested-by"] = "foo"
+
+			Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+			Expect(accessRequest.Annotations).To(HaveKeyWithValue("access.gardener.cloud/requested-by", "foo"))
+		})
+
+		It("should set the requester and remove the approver on creation", func() {
+			accessRequest.Annotations = map[string]string{
+				"access.gardener.cloud/requested-by": "foo",
+				"access.gardener.cloud/approved-by":  "bar",
+			}
+
+			Expect(admissionHandler.Admit(ctx, createAttributes(requester), nil)).To(Succeed())
+			Expect(accessRequest.Annotations).To(Equal(map[string]string{"access.gardener.cloud/requested-by": requester.Name}))
+		})
+
+		It("should keep the requester on updates", func() {
+			accessRequest.Annotations["access.gardener.cloud/requested-by"] = "foo"
+
+			Expect(admissionHandler.Admit(ctx, updateAttributes(owner), nil)).To(Succeed())
+			Expect(accessRequest.Annotations).To(HaveKeyWithValue("access.gardener.cloud/requested-by", requester.Name))
+		})
+
+		It("should set the approver when the request gets approved", func() {
+			approve(accessRequest)
+
+			Expect(admissionHandler.Admit(ctx, updateAttributes(owner), nil)).To(Succeed())
+			Expect(accessRequest.Annotations).To(HaveKeyWithValue("access.gardener.cloud/approved-by", owner.Name))
+		})
+
+		It("should keep the approver when the request was already approved", func() {
+			approve(oldAccessRequest)
+			oldAccessRequest.Annotations["access.gardener.cloud/approved-by"] = owner.Name
+			approve(accessRequest)
+			accessRequest.Annotations["access.gardener.cloud/approved-by"] = "foo"
+
+			Expect(admissionHandler.Admit(ctx, updateAttributes(requester), nil)).To(Succeed())
+			Expect(accessRequest.Annotations).To(HaveKeyWithValue("access.gardener.cloud/approved-by", owner.Name))
+		})
+
+		It("should remove the approver when the request is not approved", func() {
+			accessRequest.Annotations["access.gardener.cloud/approved-by"] = "foo"
+
+			Expect(admissionHandler.Admit(ctx, updateAttributes(requester), nil)).To(Succeed())
+			Expect(accessRequest.Annotations).NotTo(HaveKey("access.gardener.cloud/approved-by"))
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should allow creating requests", func() {
+			Expect(admissionHandler.Validate(ctx, createAttributes(requester), nil)).To(Succeed())
+		})
+
+		It("should forbid approving requests on creation", func() {
+			approve(accessRequest)
+
+			Expect(admissionHandler.Validate(ctx, createAttributes(owner), nil)).To(BeForbiddenError())
+		})
+
+		It("should allow updates which do not approve the request", func() {
+			accessRequest.Labels = map[string]string{"foo": "bar"}
+
+			Expect(admissionHandler.Validate(ctx, updateAttributes(requester), nil)).To(Succeed())
+		})
+
+		It("should allow the owner to approve the request", func() {
+			approve(accessRequest)
+
+			Expect(admissionHandler.Validate(ctx, updateAttributes(owner), nil)).To(Succeed())
+		})
+
+		It("should allow members of the owner group to approve the request", func() {
+			project.Spec.Owner = &rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: "owners"}
+			approve(accessRequest)
+
+			Expect(admissionHandler.Validate(ctx, updateAttributes(&user.DefaultInfo{Name: "bob", Groups: []string{"owners"}}), nil)).To(Succeed())
+		})
+
+		It("should forbid other subjects to approve the request", func() {
+			approve(accessRequest)
+
+			Expect(admissionHandler.Validate(ctx, updateAttributes(requester), nil)).To(BeForbiddenError())
+		})
+
+		It("should forbid the owner to approve requests created by the owner", func() {
+			oldAccessRequest.Annotations["access.gardener.cloud/requested-by"] = owner.Name
+			approve(accessRequest)
+
+			Expect(admissionHandler.Validate(ctx, updateAttributes(owner), nil)).To(BeForbiddenError())
+		})
+
+		It("should forbid the owner to approve requests for the owner", func() {
+			accessRequest.Spec.Subject.Name = owner.Name
+			approve(accessRequest)
+
+			Expect(admissionHandler.Validate(ctx, updateAttributes(owner), nil)).To(BeForbiddenError())
+		})
+
+		It("should forbid approving requests for permanent members", func() {
+			project.Spec.Members = []gardencorev1beta1.ProjectMember{{Subject: subject, Roles: []string{"viewer"}}}
+			approve(accessRequest)
+
+			Expect(admissionHandler.Validate(ctx, updateAttributes(owner), nil)).To(BeForbiddenError())
+		})
+
+		It("should allow approving requests for expiring members", func() {
+			project.Spec.Members = []gardencorev1beta1.ProjectMember{{Subject: subject, Roles: []string{"viewer"}, ExpirationTimestamp: &metav1.Time{Time: time.Now().Add(time.Hour)}}}
+			approve(accessRequest)
+
+			Expect(admissionHandler.Validate(ctx, updateAttributes(owner), nil)).To(Succeed())
+		})
+
+		It("should forbid withdrawing the approval", func() {
+			approve(oldAccessRequest)
+
+			Expect(admissionHandler.Validate(ctx, updateAttributes(owner), nil)).To(BeForbiddenError())
+		})
+	})
+
+	Describe("#Register", func() {
+		It("should register the plugin", func() {
+			plugins := admission.NewPlugins()
+			Register(plugins)
+
+			registered := plugins.Registered()
+			Expect(registered).To(HaveLen(1))
+			Expect(registered).To(ContainElement("AccessRequestApproval"))
+		})
+	})
+
+	Describe("#New", func() {
+		It("should only handle CREATE and UPDATE operations", func() {
+			a, err := New()
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(a.Handles(admission.Create)).To(BeTrue())
+			Expect(a.Handles(admission.Update)).To(BeTrue())
+			Expect(a.Handles(admission.Connect)).NotTo(BeTrue())
+			Expect(a.Handles(admission.Delete)).NotTo(BeTrue())
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should return error if no ProjectLister is set", func() {
+			a, _ := New()
+
+			Expect(a.ValidateInitialization()).To(HaveOccurred())
+		})
+
+		It("should not return error if ProjectLister is set", func() {
+			a, _ := New()
+			a.SetCoreInformerFactory(gardencoreinformers.NewSharedInformerFactory(nil, 0))
+
+			Expect(a.ValidateInitialization()).To(Succeed())
+		})
+	})
+})
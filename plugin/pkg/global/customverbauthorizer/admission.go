@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
@@ -340,8 +341,9 @@ func mustCheckProjectMembers(oldMembers, members []core.ProjectMember, owner *rb
 }
 
 type humanMembership struct {
-	member string
-	role   string
+	member              string
+	role                string
+	expirationTimestamp string
 }
 
 func findHumanUsersWithRoles(members []core.ProjectMember) sets.Set[humanMembership] {
@@ -350,13 +352,18 @@ func findHumanUsersWithRoles(members []core.ProjectMember) sets.Set[humanMembers
 	for _, member := range members {
 		if isHumanUser(member.Subject) {
 			memberKey := humanMemberKey(member.Subject)
+			// Changing the expiration of a membership is equivalent to granting access for a different time period.
+			var expirationTimestamp string
+			if member.ExpirationTimestamp != nil {
+				expirationTimestamp = member.ExpirationTimestamp.UTC().Format(time.RFC3339)
+			}
 			// Create a unique key that includes both subject and roles
 			for _, role := range member.Roles {
-				result.Insert(humanMembership{member: memberKey, role: role})
+				result.Insert(humanMembership{member: memberKey, role: role, expirationTimestamp: expirationTimestamp})
 			}
 			// If no roles are specified, still create an entry with empty role
 			if len(member.Roles) == 0 {
-				result.Insert(humanMembership{member: memberKey, role: ""})
+				result.Insert(humanMembership{member: memberKey, role: "", expirationTimestamp: expirationTimestamp})
 			}
 		}
 	}
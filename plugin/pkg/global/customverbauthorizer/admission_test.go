@@ -375,6 +375,26 @@ var _ = Describe("customverbauthorizer", func() {
 							attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
 							Expect(admissionHandler.Validate(ctx, attrs, nil)).NotTo(Succeed())
 						})
+
+						It("should trigger authorization check when only the membership expiration is changed (user!=owner)", func() {
+							project.Spec.Owner = &owner
+							project.Spec.Members = []core.ProjectMember{
+								{
+									Subject: rbacv1.Subject{
+										Kind: rbacv1.UserKind,
+										Name: "test-user",
+									},
+									Roles:               []string{"admin"},
+									ExpirationTimestamp: &metav1.Time{Time: time.Now().Add(time.Hour)},
+								},
+							}
+							oldProject := project.DeepCopy()
+							// Only remove the expiration, keep the subject and roles the same
+							project.Spec.Members[0].ExpirationTimestamp = nil
+
+							attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+							Expect(admissionHandler.Validate(ctx, attrs, nil)).NotTo(Succeed())
+						})
 					})
 				})
 			})
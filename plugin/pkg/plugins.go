@@ -15,6 +15,8 @@ import (
 )
 
 const (
+	// PluginNameAccessRequestApproval is the name of the AccessRequestApproval admission plugin.
+	PluginNameAccessRequestApproval = "AccessRequestApproval"
 	// PluginNameBastion is the name of the Bastion admission plugin.
 	PluginNameBastion = "Bastion"
 	// PluginNameControllerRegistrationResources is the name of the ControllerRegistrationResources admission plugin.
@@ -95,6 +97,7 @@ func AllOrderedPluginNames() []string {
 		PluginNameNamespacedCloudProfileValidator,   // NamespacedCloudProfileValidator
 		PluginNameProjectMutator,                    // ProjectMutator
 		PluginNameDeletionConfirmation,              // DeletionConfirmation
+		PluginNameAccessRequestApproval,             // AccessRequestApproval
 		PluginNameFinalizerRemoval,                  // FinalizerRemoval
 		PluginNameOpenIDConnectPreset,               // OpenIDConnectPreset
 		PluginNameClusterOpenIDConnectPreset,        // ClusterOpenIDConnectPreset
@@ -142,6 +145,7 @@ func DefaultOnPlugins() sets.Set[string] {
 		PluginNameNamespacedCloudProfileValidator, // NamespacedCloudProfileValidator
 		PluginNameProjectMutator,                  // ProjectMutator
 		PluginNameDeletionConfirmation,            // DeletionConfirmation
+		PluginNameAccessRequestApproval,           // AccessRequestApproval
 		PluginNameFinalizerRemoval,                // FinalizerRemoval
 		PluginNameOpenIDConnectPreset,             // OpenIDConnectPreset
 		PluginNameClusterOpenIDConnectPreset,      // ClusterOpenIDConnectPreset
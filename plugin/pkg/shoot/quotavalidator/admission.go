@@ -18,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/warning"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -34,16 +35,6 @@ import (
 	plugin "github.com/gardener/gardener/plugin/pkg"
 )
 
-var (
-	quotaMetricNames = [6]corev1.ResourceName{
-		core.QuotaMetricCPU,
-		core.QuotaMetricGPU,
-		core.QuotaMetricMemory,
-		core.QuotaMetricStorageStandard,
-		core.QuotaMetricStoragePremium,
-		core.QuotaMetricLoadbalancer}
-)
-
 // Register registers a plugin.
 func Register(plugins *admission.Plugins) {
 	plugins.Register(plugin.PluginNameShootQuotaValidator, func(_ io.Reader) (admission.Interface, error) {
@@ -140,7 +131,7 @@ func (q *QuotaValidator) ValidateInitialization() error {
 var _ admission.ValidationInterface = (*QuotaValidator)(nil)
 
 // Validate checks that the requested Shoot resources do not exceed the quota limits.
-func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+func (q *QuotaValidator) Validate(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
 	// Wait until the caches have been synced
 	if q.readyFunc == nil {
 		q.AssignReadyFunc(func() bool {
@@ -229,7 +220,7 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 		}
 
 		if checkQuota {
-			exceededMetrics, err := q.isQuotaExceeded(*shoot, *quota)
+			exceededMetrics, requiredResources, err := q.isQuotaExceeded(*shoot, *quota)
 			if err != nil {
 				return apierrors.NewInternalError(err)
 			}
@@ -240,6 +231,11 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 				}
 				return admission.NewForbidden(a, fmt.Errorf("quota limits exceeded. Unable to allocate further %s", message))
 			}
+
+			// Warn the user before the hard limits are hit.
+			if metrics := gardenerutils.QuotaMetricsAboveWarningThreshold(quota, requiredResources); len(metrics) > 0 {
+				warning.AddWarning(ctx, "", fmt.Sprintf("more than %d%% of the limits of quota %s/%s are allocated for %v", *quota.Spec.WarningThresholdPercentage, quota.Namespace, quota.Name, metrics))
+			}
 		}
 	}
 
@@ -264,18 +260,18 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 	return nil
 }
 
-func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota gardencorev1beta1.Quota) (*[]corev1.ResourceName, error) {
+func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota gardencorev1beta1.Quota) (*[]corev1.ResourceName, corev1.ResourceList, error) {
 	allocatedResources, err := q.determineAllocatedResources(quota, shoot)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	requiredResources, err := q.determineRequiredResources(allocatedResources, shoot)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	exceededMetrics := make([]corev1.ResourceName, 0)
-	for _, metric := range quotaMetricNames {
+	for _, metric := range gardenerutils.QuotaMetricNames {
 		if _, ok := quota.Spec.Metrics[metric]; !ok {
 			continue
 		}
@@ -284,9 +280,9 @@ func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota gardencorev1bet
 		}
 	}
 	if len(exceededMetrics) != 0 {
-		return &exceededMetrics, nil
+		return &exceededMetrics, requiredResources, nil
 	}
-	return nil, nil
+	return nil, requiredResources, nil
 }
 
 func (q *QuotaValidator) determineAllocatedResources(quota gardencorev1beta1.Quota, shoot core.Shoot) (corev1.ResourceList, error) {
@@ -303,7 +299,7 @@ func (q *QuotaValidator) determineAllocatedResources(quota gardencorev1beta1.Quo
 			return nil, err
 		}
 
-		for _, metric := range quotaMetricNames {
+		for _, metric := range gardenerutils.QuotaMetricNames {
 			allocatedResources[metric] = sumQuantity(allocatedResources[metric], shootResources[metric])
 		}
 	}
@@ -399,7 +395,7 @@ func (q *QuotaValidator) determineRequiredResources(allocatedResources corev1.Re
 	}
 
 	requiredResources := make(corev1.ResourceList)
-	for _, metric := range quotaMetricNames {
+	for _, metric := range gardenerutils.QuotaMetricNames {
 		requiredResources[metric] = sumQuantity(allocatedResources[metric], shootResources[metric])
 	}
 	return requiredResources, nil
@@ -414,104 +410,7 @@ func (q *QuotaValidator) getShootResources(shoot core.Shoot) (corev1.ResourceLis
 		return nil, fmt.Errorf("no cloudprofile reference has been provided")
 	}
 
-	var (
-		countLB      int64 = 1
-		resources          = make(corev1.ResourceList)
-		workers            = getShootWorkerResources(&shoot, cloudProfileSpec)
-		machineTypes       = cloudProfileSpec.MachineTypes
-		volumeTypes        = cloudProfileSpec.VolumeTypes
-	)
-
-	for _, worker := range workers {
-		var (
-			machineType *gardencorev1beta1.MachineType
-			volumeType  *gardencorev1beta1.VolumeType
-		)
-
-		// Get the proper machineType
-		for _, e := range machineTypes {
-			element := e
-			if element.Name == worker.Machine.Type {
-				machineType = &element
-				break
-			}
-		}
-		if machineType == nil {
-			return nil, fmt.Errorf("machineType %s not found in CloudProfile", worker.Machine.Type)
-		}
-
-		if worker.Volume != nil {
-			if machineType.Storage != nil {
-				volumeType = &gardencorev1beta1.VolumeType{
-					Class: machineType.Storage.Class,
-				}
-			} else {
-				// Get the proper VolumeType
-				for _, e := range volumeTypes {
-					element := e
-					if worker.Volume.Type != nil && element.Name == *worker.Volume.Type {
-						volumeType = &element
-						break
-					}
-				}
-			}
-		}
-		if volumeType == nil {
-			return nil, fmt.Errorf("VolumeType %s not found in CloudProfile", worker.Machine.Type)
-		}
-
-		// For now we always use the max. amount of resources for quota calculation
-		resources[core.QuotaMetricCPU] = sumQuantity(resources[core.QuotaMetricCPU], multiplyQuantity(machineType.CPU, worker.Maximum))
-		resources[core.QuotaMetricGPU] = sumQuantity(resources[core.QuotaMetricGPU], multiplyQuantity(machineType.GPU, worker.Maximum))
-		resources[core.QuotaMetricMemory] = sumQuantity(resources[core.QuotaMetricMemory], multiplyQuantity(machineType.Memory, worker.Maximum))
-
-		size, _ := resource.ParseQuantity("0Gi")
-		if worker.Volume != nil {
-			size, err = resource.ParseQuantity(worker.Volume.VolumeSize)
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		switch volumeType.Class {
-		case core.VolumeClassStandard:
-			resources[core.QuotaMetricStorageStandard] = sumQuantity(resources[core.QuotaMetricStorageStandard], multiplyQuantity(size, worker.Maximum))
-		case core.VolumeClassPremium:
-			resources[core.QuotaMetricStoragePremium] = sumQuantity(resources[core.QuotaMetricStoragePremium], multiplyQuantity(size, worker.Maximum))
-		default:
-			return nil, fmt.Errorf("unknown volumeType class %s", volumeType.Class)
-		}
-	}
-
-	if helper.NginxIngressEnabled(shoot.Spec.Addons) {
-		countLB++
-	}
-	resources[core.QuotaMetricLoadbalancer] = *resource.NewQuantity(countLB, resource.DecimalSI)
-
-	return resources, nil
-}
-
-func getShootWorkerResources(shoot *core.Shoot, cloudProfile *gardencorev1beta1.CloudProfileSpec) []core.Worker {
-	workers := make([]core.Worker, 0, len(shoot.Spec.Provider.Workers))
-
-	for _, worker := range shoot.Spec.Provider.Workers {
-		workerCopy := worker.DeepCopy()
-
-		if worker.Volume == nil {
-			for _, machineType := range cloudProfile.MachineTypes {
-				if worker.Machine.Type == machineType.Name && machineType.Storage != nil && machineType.Storage.StorageSize != nil {
-					workerCopy.Volume = &core.Volume{
-						Type:       &machineType.Storage.Type,
-						VolumeSize: machineType.Storage.StorageSize.String(),
-					}
-				}
-			}
-		}
-
-		workers = append(workers, *workerCopy)
-	}
-
-	return workers
+	return gardenerutils.ShootQuotaResources(&shoot, cloudProfileSpec)
 }
 
 func lifetimeVerificationNeeded(new, old core.Shoot) bool {
@@ -562,11 +461,3 @@ func sumQuantity(values ...resource.Quantity) resource.Quantity {
 	}
 	return res
 }
-
-func multiplyQuantity(quantity resource.Quantity, multiplier int32) resource.Quantity {
-	res := resource.Quantity{}
-	for i := 0; i < int(multiplier); i++ {
-		res.Add(quantity)
-	}
-	return res
-}
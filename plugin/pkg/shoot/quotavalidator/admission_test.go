@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/warning"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -379,6 +380,34 @@ var _ = Describe("quotavalidator", func() {
 					Expect(err.Error()).To(ContainSubstring("quota limits exceeded"))
 				})
 			})
+
+			Context("warning threshold", func() {
+				var (
+					recorder *fakeWarningRecorder
+					ctx      context.Context
+				)
+
+				BeforeEach(func() {
+					recorder = &fakeWarningRecorder{}
+					ctx = warning.WithWarningRecorder(context.TODO(), recorder)
+				})
+
+				It("should pass with a warning because the usage exceeds the warning threshold", func() {
+					quotaSecret.Spec.WarningThresholdPercentage = ptr.To[int32](40)
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+					Expect(recorder.warnings).To(ConsistOf("more than 40% of the limits of quota trial/secret-quota are allocated for [cpu memory storage.standard loadbalancer]"))
+				})
+
+				It("should pass without a warning because the usage does not exceed the warning threshold", func() {
+					quotaSecret.Spec.WarningThresholdPercentage = ptr.To[int32](50)
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+					Expect(recorder.warnings).To(BeEmpty())
+				})
+			})
 		})
 
 		Context("tests for Quota validation corner cases", func() {
@@ -489,3 +518,11 @@ var _ = Describe("quotavalidator", func() {
 		})
 	})
 })
+
+type fakeWarningRecorder struct {
+	warnings []string
+}
+
+func (f *fakeWarningRecorder) AddWarning(_, text string) {
+	f.warnings = append(f.warnings, text)
+}
@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/warning"
 	kubeinformers "k8s.io/client-go/informers"
 	kubecorev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/utils/ptr"
@@ -346,6 +347,12 @@ func (v *ValidateShoot) Validate(ctx context.Context, a admission.Attributes, _
 		return admission.NewForbidden(a, allErrs.ToAggregate())
 	}
 
+	if a.GetOperation() != admission.Delete && !reflect.DeepEqual(validationContext.shoot.Spec, validationContext.oldShoot.Spec) {
+		if err := validationContext.warnIfCostBudgetExceeded(ctx, v.shootLister); err != nil {
+			return apierrors.NewInternalError(err)
+		}
+	}
+
 	return nil
 }
 
@@ -359,6 +366,46 @@ type validationContext struct {
 	oldShoot           *core.Shoot
 }
 
+// warnIfCostBudgetExceeded adds a warning to the response if the change increases the estimated monthly cost of the
+// Shoot and the estimated monthly cost of all Shoots in the project exceeds the cost budget of the project. The budget
+// is not enforced, hence the request is never denied.
+func (c *validationContext) warnIfCostBudgetExceeded(ctx context.Context, shootLister gardencorev1beta1listers.ShootLister) error {
+	budget := c.project.Spec.CostBudget
+	if budget == nil {
+		return nil
+	}
+
+	estimate, err := gardenerutils.EstimateShootCost(c.shoot, c.cloudProfileSpec)
+	if err != nil || estimate == nil || estimate.Currency != budget.Currency {
+		return nil
+	}
+
+	if oldEstimate, err := gardenerutils.EstimateShootCost(c.oldShoot, c.cloudProfileSpec); err == nil && oldEstimate != nil &&
+		oldEstimate.Currency == estimate.Currency && estimate.MonthlyMaximum.Cmp(oldEstimate.MonthlyMaximum) <= 0 {
+		return nil
+	}
+
+	shoots, err := shootLister.Shoots(c.shoot.Namespace).List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	total := estimate.MonthlyMaximum.DeepCopy()
+	for _, shoot := range shoots {
+		if shoot.Name == c.shoot.Name || shoot.Status.EstimatedCost == nil || shoot.Status.EstimatedCost.Currency != budget.Currency {
+			continue
+		}
+		total.Add(shoot.Status.EstimatedCost.MonthlyMaximum)
+	}
+
+	if total.Cmp(budget.Monthly) > 0 {
+		warning.AddWarning(ctx, "", fmt.Sprintf("the estimated monthly cost of all shoots in project %q (%.2f %s) exceeds the cost budget of the project (%.2f %s)",
+			c.project.Name, total.AsApproximateFloat64(), budget.Currency, budget.Monthly.AsApproximateFloat64(), budget.Currency))
+	}
+
+	return nil
+}
+
 func (c *validationContext) validateProjectMembership(a admission.Attributes) error {
 	if a.GetOperation() != admission.Create {
 		return nil
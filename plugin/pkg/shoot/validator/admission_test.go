@@ -21,6 +21,7 @@ import (
 	"k8s.io/apiserver/pkg/admission"
 	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/warning"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
@@ -544,6 +545,83 @@ var _ = Describe("validator", func() {
 			)
 		})
 
+		Context("cost budget checks", func() {
+			var (
+				recorder *fakeWarningRecorder
+				oldShoot *core.Shoot
+			)
+
+			BeforeEach(func() {
+				recorder = &fakeWarningRecorder{}
+				ctx = warning.WithWarningRecorder(ctx, recorder)
+
+				cloudProfile.Spec.MachineTypes[0].Price = &gardencorev1beta1.Price{Currency: "EUR", Hourly: resource.MustParse("0.5")}
+				project.Spec.CostBudget = &gardencorev1beta1.ProjectCostBudget{Currency: "EUR", Monthly: resource.MustParse("500")}
+				oldShoot = shoot.DeepCopy()
+				oldShoot.Spec.Provider.Workers[0].Maximum = 2
+
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				Expect(securityInformerFactory.Security().V1alpha1().CredentialsBindings().Informer().GetStore().Add(&credentialsBinding)).To(Succeed())
+			})
+
+			It("should admit the Shoot without warning if the budget is not exceeded", func() {
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				Expect(recorder.warnings).To(BeEmpty())
+			})
+
+			It("should admit the Shoot with a warning if the estimated cost of all Shoots exceeds the budget", func() {
+				otherShoot := &gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: namespaceName},
+					Status: gardencorev1beta1.ShootStatus{EstimatedCost: &gardencorev1beta1.ShootCostEstimate{
+						Currency:       "EUR",
+						MonthlyMaximum: resource.MustParse("200"),
+					}},
+				}
+				Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(otherShoot)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				Expect(recorder.warnings).To(ConsistOf(`the estimated monthly cost of all shoots in project "my-project" (565.00 EUR) exceeds the cost budget of the project (500.00 EUR)`))
+			})
+
+			It("should not warn if the update does not increase the estimated cost", func() {
+				project.Spec.CostBudget.Monthly = resource.MustParse("100")
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Update(&project)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				Expect(recorder.warnings).To(BeEmpty())
+			})
+
+			It("should warn if the update increases the estimated cost", func() {
+				project.Spec.CostBudget.Monthly = resource.MustParse("100")
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Update(&project)).To(Succeed())
+				shoot.Spec.Provider.Workers[0].Maximum = 3
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				Expect(recorder.warnings).To(ConsistOf(ContainSubstring("(1095.00 EUR) exceeds the cost budget of the project (100.00 EUR)")))
+			})
+
+			It("should not warn if the budget is specified in a different currency", func() {
+				project.Spec.CostBudget = &gardencorev1beta1.ProjectCostBudget{Currency: "USD", Monthly: resource.MustParse("1")}
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Update(&project)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				Expect(recorder.warnings).To(BeEmpty())
+			})
+		})
+
 		Context("checks for shoots referencing a deleted seed", func() {
 			var oldShoot *core.Shoot
 
@@ -6331,3 +6409,11 @@ var _ = Describe("validator", func() {
 		})
 	})
 })
+
+type fakeWarningRecorder struct {
+	warnings []string
+}
+
+func (f *fakeWarningRecorder) AddWarning(_, text string) {
+	f.warnings = append(f.warnings, text)
+}
@@ -84,7 +84,7 @@ var _ = Describe("gardenadm unmanaged infrastructure scenario tests", Label("gar
 		})
 
 		It("should initialize as control plane node", func(ctx SpecContext) {
-			stdOut, _, err := execute(ctx, 0, "gardenadm", "--log-level=debug", "init", "-d", configDirectory)
+			stdOut, _, err := execute(ctx, 0, "gardenadm", "--log-level=debug", "init", "-d", configDirectory, "--ignore-preflight-errors=TimeSync")
 			Expect(err).NotTo(HaveOccurred())
 
 			Eventually(ctx, stdOut).Should(gbytes.Say("Your Shoot cluster control-plane has initialized successfully!"))
@@ -217,7 +217,7 @@ var _ = Describe("gardenadm unmanaged infrastructure scenario tests", Label("gar
 			Expect(err).NotTo(HaveOccurred())
 			joinCommand := strings.Split(strings.ReplaceAll(string(stdOut.Contents()), `"`, ``), " ")
 
-			stdOut, _, err = execute(ctx, 1, append(joinCommand, "--log-level=debug")...)
+			stdOut, _, err = execute(ctx, 1, append(joinCommand, "--log-level=debug", "--ignore-preflight-errors=TimeSync")...)
 			Expect(err).NotTo(HaveOccurred())
 
 			Eventually(ctx, stdOut).Should(gbytes.Say("Your node has successfully been instructed to join the cluster as a worker!"))
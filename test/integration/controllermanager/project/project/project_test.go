@@ -499,6 +499,34 @@ var _ = Describe("Project controller tests", func() {
 			}).Should(Succeed())
 		})
 
+		It("should revoke the access of members whose membership expired", func() {
+			By("Add admin with expiring membership to project")
+			patch := client.MergeFrom(project.DeepCopy())
+			project.Spec.Members = append(project.Spec.Members, gardencorev1beta1.ProjectMember{
+				Subject: rbacv1.Subject{
+					APIGroup: rbacv1.GroupName,
+					Kind:     rbacv1.UserKind,
+					Name:     testUserName,
+				},
+				Role:                "admin",
+				ExpirationTimestamp: &metav1.Time{Time: time.Now().Add(5 * time.Second)},
+			})
+			Expect(testClient.Patch(ctx, project, patch)).To(Succeed())
+
+			By("Ensure new admin has access to project")
+			Eventually(func(g Gomega) {
+				g.Expect(testUserClient.Get(ctx, projectNamespaceKey, &corev1.Namespace{})).To(Succeed())
+			}).Should(Succeed())
+
+			By("Ensure access is revoked after membership expired")
+			Eventually(func(g Gomega) {
+				g.Expect(testUserClient.Get(ctx, projectNamespaceKey, &corev1.Namespace{})).To(BeForbiddenError())
+			}).WithTimeout(15 * time.Second).Should(Succeed())
+
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			Expect(project.Spec.Members).NotTo(ContainElement(HaveField("Name", testUserName)))
+		})
+
 		It("should recreate deleted well-known RoleBindings", func() {
 			By("Delete RoleBindings")
 			var roleBindings []client.Object
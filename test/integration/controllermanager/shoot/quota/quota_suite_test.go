@@ -120,6 +120,12 @@ var _ = BeforeSuite(func() {
 		Clock: fakeClock,
 	}).AddToManager(mgr)).To(Succeed())
 
+	Expect((&quota.StatusReconciler{
+		Config: controllermanagerconfigv1alpha1.ShootQuotaControllerConfiguration{
+			ConcurrentSyncs: ptr.To(5),
+		},
+	}).AddToManager(mgr)).To(Succeed())
+
 	By("Start manager")
 	mgrContext, mgrCancel := context.WithCancel(ctx)
 
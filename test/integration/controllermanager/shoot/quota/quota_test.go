@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -204,4 +205,23 @@ var _ = Describe("Shoot Quota controller tests", func() {
 			return testClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)
 		}).Should(BeNotFoundError())
 	})
+
+	It("should report the limits and usage in the status of the quota", func() {
+		By("Add metrics to Quota")
+		patch := client.MergeFrom(quota.DeepCopy())
+		quota.Spec.Metrics = corev1.ResourceList{
+			"cpu":    resource.MustParse("10"),
+			"memory": resource.MustParse("20Gi"),
+		}
+		Expect(testClient.Patch(ctx, quota, patch)).To(Succeed())
+
+		// The shoot references a cloud profile which does not exist, hence it is skipped when computing the usage.
+		Eventually(func(g Gomega) {
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+			g.Expect(quota.Status.Hard).To(HaveLen(2))
+			g.Expect(quota.Status.Hard.Cpu().String()).To(Equal("10"))
+			g.Expect(quota.Status.Used).To(HaveLen(2))
+			g.Expect(quota.Status.Used.Cpu().IsZero()).To(BeTrue())
+		}).Should(Succeed())
+	})
 })
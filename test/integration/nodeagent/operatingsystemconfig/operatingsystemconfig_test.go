@@ -882,6 +882,31 @@ units: {}
 		})
 	})
 
+	Context("when the update is on hold", func() {
+		BeforeEach(func() {
+			By("Put update of operating system config on hold")
+			patch := client.MergeFrom(node.DeepCopy())
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, "node-agent.gardener.cloud/hold-operating-system-config-update", "true")
+			Expect(testClient.Patch(ctx, node, patch)).To(Succeed())
+		})
+
+		It("should apply the configuration only after the hold has been released", func() {
+			Consistently(func(g Gomega) map[string]string {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				return node.Annotations
+			}).ShouldNot(HaveKey("checksum/cloud-config-data"))
+			test.AssertNoFileOnDisk(fakeFS, file1.Path)
+
+			By("Release update of operating system config")
+			patch := client.MergeFrom(node.DeepCopy())
+			delete(node.Annotations, "node-agent.gardener.cloud/hold-operating-system-config-update")
+			Expect(testClient.Patch(ctx, node, patch)).To(Succeed())
+
+			waitForUpdatedNodeAnnotationCloudConfig(node, oscSecret, utils.ComputeSHA256Hex(oscRaw))
+			test.AssertFileOnDisk(fakeFS, file1.Path, "file1", 0777)
+		})
+	})
+
 	Context("node-role label", func() {
 		When("no static Kubernetes control-plane manifests are part of the OSC files", func() {
 			It("should add the 'worker' role label", func() {
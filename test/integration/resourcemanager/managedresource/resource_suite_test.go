@@ -124,10 +124,11 @@ var _ = BeforeSuite(func() {
 			SyncPeriod:          &metav1.Duration{Duration: time.Minute},
 			ManagedByLabelValue: ptr.To("gardener"),
 		},
-		Clock:                         fakeClock,
-		ClassFilter:                   filter,
-		RequeueAfterOnDeletionPending: ptr.To(50 * time.Millisecond),
-		GarbageCollectorActivated:     true,
+		Clock:                          fakeClock,
+		ClassFilter:                    filter,
+		RequeueAfterOnDeletionPending:  ptr.To(50 * time.Millisecond),
+		RequeueAfterOnApplyWavePending: ptr.To(50 * time.Millisecond),
+		GarbageCollectorActivated:      true,
 	}).AddToManager(mgr, mgr, mgr)).To(Succeed())
 
 	By("Start manager")
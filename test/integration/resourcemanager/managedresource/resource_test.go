@@ -23,6 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
@@ -778,6 +779,177 @@ var _ = Describe("ManagedResource controller tests", func() {
 			})
 		})
 
+		Describe("Preview on ManagedResource", func() {
+			It("should report the changes without applying them", func() {
+				Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+					return managedResource.Status.Conditions
+				}).Should(
+					ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(resourcesv1alpha1.ConditionApplySucceeded)),
+				)
+
+				patch := client.MergeFrom(managedResource.DeepCopy())
+				managedResource.SetAnnotations(map[string]string{resourcesv1alpha1.Preview: "true"})
+				Expect(testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+
+				newConfigMap := configMap.DeepCopy()
+				newConfigMap.Data = map[string]string{"abc": "def"}
+				patch = client.MergeFrom(secretForManagedResource.DeepCopy())
+				secretForManagedResource.Data = secretDataForObject(newConfigMap, dataKey)
+				Expect(testClient.Patch(ctx, secretForManagedResource, patch)).To(Succeed())
+
+				Eventually(func(g Gomega) []resourcesv1alpha1.ObjectChange {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+					g.Expect(managedResource.Status.Preview).NotTo(BeNil())
+					return managedResource.Status.Preview.Changes
+				}).Should(ConsistOf(resourcesv1alpha1.ObjectChange{
+					ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: configMap.Namespace, Name: configMap.Name},
+					Operation:       resourcesv1alpha1.ObjectOperationUpdate,
+					Fields:          []string{"data.abc"},
+				}))
+
+				Consistently(func(g Gomega) map[string]string {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+					return configMap.Data
+				}).Should(HaveKeyWithValue("abc", "xyz"))
+
+				patch = client.MergeFrom(managedResource.DeepCopy())
+				delete(managedResource.Annotations, resourcesv1alpha1.Preview)
+				Expect(testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+
+				Eventually(func(g Gomega) {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+					g.Expect(managedResource.Status.Preview).To(BeNil())
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+					g.Expect(configMap.Data).To(HaveKeyWithValue("abc", "def"))
+				}).Should(Succeed())
+			})
+		})
+
+		Describe("Apply Waves", func() {
+			var deployment *appsv1.Deployment
+
+			BeforeEach(func() {
+				deployment = &appsv1.Deployment{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: appsv1.SchemeGroupVersion.String(),
+						Kind:       "Deployment",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      resourceName,
+						Namespace: testNamespace.Name,
+					},
+					Spec: appsv1.DeploymentSpec{
+						Selector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"foo": "bar"},
+						},
+						Replicas: ptr.To[int32](1),
+						Template: corev1.PodTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"foo": "bar"}},
+							Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "foo-container", Image: "foo"}}},
+						},
+					},
+				}
+
+				metav1.SetMetaDataAnnotation(&configMap.ObjectMeta, resourcesv1alpha1.ApplyWave, "1")
+				secretForManagedResource.Data = secretDataForObject(configMap, dataKey)
+				secretForManagedResource.Data["deployment.yaml"] = jsonDataForObject(deployment)
+			})
+
+			AfterEach(func() {
+				By("Delete ManagedResource")
+				Expect(testClient.Delete(ctx, managedResource)).To(Or(Succeed(), BeNotFoundError()))
+
+				// resource-manager deletes Deployments with foreground deletion, which causes API server to add the
+				// foregroundDeletion finalizer. It is removed by kube-controller-manager's garbage collector, which is not
+				// running in envtest, so we me might need to remove it ourselves.
+				Eventually(func(g Gomega) bool {
+					err := testClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)
+					if apierrors.IsNotFound(err) {
+						return true
+					}
+					g.Expect(err).To(Succeed())
+					g.Expect(controllerutils.RemoveFinalizers(ctx, testClient, deployment, metav1.FinalizerDeleteDependents)).To(Succeed())
+					return false
+				}).Should(BeTrue())
+			})
+
+			It("should only apply the next wave after the objects of the previous wave are healthy", func() {
+				Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+					return managedResource.Status.Conditions
+				}).Should(
+					ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionProgressing), WithReason(resourcesv1alpha1.ConditionApplyWavePending)),
+				)
+
+				Expect(testClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				Consistently(func() error {
+					return testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)
+				}).Should(BeNotFoundError())
+
+				By("Mark Deployment as healthy")
+				patch := client.MergeFrom(deployment.DeepCopy())
+				deployment.Status.ObservedGeneration = deployment.Generation
+				deployment.Status.Conditions = []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue}}
+				Expect(testClient.Status().Patch(ctx, deployment, patch)).To(Succeed())
+
+				Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+					return managedResource.Status.Conditions
+				}).Should(
+					ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(resourcesv1alpha1.ConditionApplySucceeded)),
+				)
+				Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			})
+		})
+
+		Describe("Drift Detection", func() {
+			It("should detect and revert out-of-band modifications", func() {
+				Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+					return managedResource.Status.Conditions
+				}).Should(
+					ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(resourcesv1alpha1.ConditionApplySucceeded)),
+				)
+
+				By("Reconcile ManagedResource without modifications")
+				patch := client.MergeFrom(managedResource.DeepCopy())
+				metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+				Expect(testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+
+				Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+					return managedResource.Status.Conditions
+				}).Should(
+					ContainCondition(OfType(resourcesv1alpha1.ResourcesDrifted), WithStatus(gardencorev1beta1.ConditionFalse), WithReason(resourcesv1alpha1.ConditionNoDriftDetected)),
+				)
+
+				By("Modify ConfigMap out-of-band")
+				Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+				patch = client.MergeFrom(configMap.DeepCopy())
+				configMap.Data["abc"] = "modified"
+				Expect(testClient.Patch(ctx, configMap, patch)).To(Succeed())
+
+				By("Reconcile ManagedResource again")
+				patch = client.MergeFrom(managedResource.DeepCopy())
+				delete(managedResource.Annotations, v1beta1constants.GardenerOperation)
+				Expect(testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+				patch = client.MergeFrom(managedResource.DeepCopy())
+				metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+				Expect(testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+
+				Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+					return managedResource.Status.Conditions
+				}).Should(
+					ContainCondition(OfType(resourcesv1alpha1.ResourcesDrifted), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(resourcesv1alpha1.ConditionDriftDetected), WithMessageSubstrings("data.abc")),
+				)
+
+				Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+				Expect(configMap.Data).To(HaveKeyWithValue("abc", "xyz"))
+			})
+		})
+
 		Describe("Ensure resources.gardener.cloud/managed-by label", func() {
 			var (
 				defaultPodTemplateSpec *corev1.PodTemplateSpec